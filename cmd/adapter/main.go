@@ -3,36 +3,66 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
 	"time"
 
+	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapter-framework/pkg/connector/client"
 	grpc_proxy_v1 "github.com/sgnl-ai/adapter-framework/pkg/grpc_proxy/v1"
 	"github.com/sgnl-ai/adapter-framework/server"
+	"github.com/sgnl-ai/adapters/pkg/adapterchain"
+	"github.com/sgnl-ai/adapters/pkg/admin"
 	aws "github.com/sgnl-ai/adapters/pkg/aws"
 	aws_s3 "github.com/sgnl-ai/adapters/pkg/aws-s3"
+	azureblob "github.com/sgnl-ai/adapters/pkg/azure-blob"
 	"github.com/sgnl-ai/adapters/pkg/azuread"
 	"github.com/sgnl-ai/adapters/pkg/bamboohr"
+	beyondtrustpasswordsafe "github.com/sgnl-ai/adapters/pkg/beyondtrust-passwordsafe"
+	"github.com/sgnl-ai/adapters/pkg/bitbucket"
+	bitbucketdatacenter "github.com/sgnl-ai/adapters/pkg/bitbucket-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/confluence"
 	"github.com/sgnl-ai/adapters/pkg/crowdstrike"
+	"github.com/sgnl-ai/adapters/pkg/databricks"
+	delineasecretserver "github.com/sgnl-ai/adapters/pkg/delinea-secretserver"
+	"github.com/sgnl-ai/adapters/pkg/deprecation"
 	"github.com/sgnl-ai/adapters/pkg/duo"
+	"github.com/sgnl-ai/adapters/pkg/egress"
+	"github.com/sgnl-ai/adapters/pkg/gcs"
 	"github.com/sgnl-ai/adapters/pkg/github"
+	"github.com/sgnl-ai/adapters/pkg/gitlab"
 	googleworkspace "github.com/sgnl-ai/adapters/pkg/google-workspace"
 	"github.com/sgnl-ai/adapters/pkg/hashicorp"
 	"github.com/sgnl-ai/adapters/pkg/identitynow"
+	"github.com/sgnl-ai/adapters/pkg/intune"
 	"github.com/sgnl-ai/adapters/pkg/jira"
 	jiradatacenter "github.com/sgnl-ai/adapters/pkg/jira-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/kafka"
+	"github.com/sgnl-ai/adapters/pkg/kandji"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	mysql_0_0_1_alpha "github.com/sgnl-ai/adapters/pkg/my-sql/0.0.1-alpha"
 	mysql_0_0_2_alpha "github.com/sgnl-ai/adapters/pkg/my-sql/0.0.2-alpha"
 	"github.com/sgnl-ai/adapters/pkg/okta"
 	"github.com/sgnl-ai/adapters/pkg/pagerduty"
+	"github.com/sgnl-ai/adapters/pkg/pingone"
+	rapid7insightvm "github.com/sgnl-ai/adapters/pkg/rapid7-insightvm"
+	"github.com/sgnl-ai/adapters/pkg/recovery"
+	"github.com/sgnl-ai/adapters/pkg/redact"
+	"github.com/sgnl-ai/adapters/pkg/responselimit"
 	"github.com/sgnl-ai/adapters/pkg/rootly"
 	"github.com/sgnl-ai/adapters/pkg/salesforce"
 	"github.com/sgnl-ai/adapters/pkg/scim"
+	"github.com/sgnl-ai/adapters/pkg/serverauth"
 	"github.com/sgnl-ai/adapters/pkg/servicenow"
+	"github.com/sgnl-ai/adapters/pkg/slack"
+	"github.com/sgnl-ai/adapters/pkg/statestore"
 	"github.com/sgnl-ai/adapters/pkg/workday"
 	"go.uber.org/zap"
 
@@ -43,7 +73,25 @@ import (
 
 const MiB = 1024 * 1024
 
+// version is the version of the adapter build, set with -ldflags "-X main.version=<version>". The cursors are
+// stamped with it, so that the cursors issued by a build of another major version are rejected.
+var version = adapterchain.DevVersion
+
 func main() {
+	selfTestMode := flag.Bool("selftest", false,
+		"Validate the configuration and instantiate every adapter, then exit with a report instead of serving requests")
+	selfTestPingConnector := flag.Bool("selftest-ping-connector", false,
+		"In self-test mode, also check that the connector service is reachable")
+
+	flag.Parse()
+
+	// st is the report of the self-test mode, nil outside of it.
+	var st *selfTest
+
+	if *selfTestMode {
+		st = &selfTest{}
+	}
+
 	viper.AutomaticEnv()
 	viper.SetEnvPrefix("ADAPTER")
 
@@ -53,6 +101,7 @@ func main() {
 	viper.SetDefault("TIMEOUT", 30)
 	// ADAPTER_MAX_CONCURRENCY: The number of goroutines run concurrently in AWS adapter (default: 20)
 	viper.SetDefault("MAX_CONCURRENCY", 20)
+	// The ADAPTER_MAX_S3_* limits also apply to the Azure Blob Storage and Google Cloud Storage adapters.
 	// ADAPTER_MAX_S3_CSV_ROW_SIZE_BYTES: The maximum size of a CSV row in bytes (default: 1MiB)
 	viper.SetDefault("MAX_S3_CSV_ROW_SIZE_BYTES", 1*MiB)
 	// ADAPTER_MAX_S3_BYTES_TO_PROCESS_PER_PAGE: The maximum number of bytes to process per page (default: 1MiB)
@@ -60,10 +109,40 @@ func main() {
 	// With default page size of 100, this supports rows up to ~10 KB wide.
 	// If a page doesn't fit within 1 MiB, reduce the page size.
 	viper.SetDefault("MAX_S3_BYTES_TO_PROCESS_PER_PAGE", 1*MiB)
+	// ADAPTER_MAX_S3_CONCURRENT_RANGE_READS: The number of ranged GET requests issued in parallel to fetch
+	// the data of a single S3 page (default: 1, i.e. a single connection per page)
+	viper.SetDefault("MAX_S3_CONCURRENT_RANGE_READS", 1)
 	// ADAPTER_MAX_CALL_RECV_MSG_SIZE_MB: Maximum gRPC receive message size in MB (default: 8MB, matches ingestion)
 	viper.SetDefault("MAX_CALL_RECV_MSG_SIZE_MB", 8)
 	// ADAPTER_MAX_CALL_SEND_MSG_SIZE_MB: Maximum gRPC send message size in MB (default: 8MB, matches ingestion)
 	viper.SetDefault("MAX_CALL_SEND_MSG_SIZE_MB", 8)
+	// ADAPTER_TLS_CERT_PATH: The path of the PEM encoded certificate chain of the gRPC server (default: no TLS)
+	// ADAPTER_TLS_KEY_PATH: The path of the PEM encoded private key of the gRPC server (default: no TLS)
+	// ADAPTER_TLS_CLIENT_CA_PATH: The path of the PEM encoded CA certificates verifying client certificates.
+	// The gRPC server requires mutual TLS when the certificate, key and client CA paths are set.
+	// ADAPTER_TLS_ALLOWED_CLIENT_IDENTITIES: A comma separated list of the identities (subject common name,
+	// DNS name or URI SAN) of the client certificates allowed to call the gRPC server (default: any)
+	// ADAPTER_EGRESS_ALLOWLISTS_PATH: The path of a JSON file mapping datasource types to the hosts the HTTP
+	// clients of their adapters can send requests to (default: no restriction). See egress.LoadAllowlists.
+	// ADAPTER_MAX_RESPONSE_BODY_SIZE_BYTES: The maximum size of a response body read from a datasource, in bytes.
+	// Requests are retried with a smaller page size when exceeded (default: 0, i.e. no limit)
+	viper.SetDefault("MAX_RESPONSE_BODY_SIZE_BYTES", 0)
+	// ADAPTER_MAX_RESPONSE_BODY_SIZE_BYTES_BY_DATASOURCE_TYPE: A comma separated list of maximum sizes of a
+	// response body overriding ADAPTER_MAX_RESPONSE_BODY_SIZE_BYTES per datasource type, e.g. "Okta-1.0.1=16777216"
+	// ADAPTER_REDACTION_RULES_PATH: The path of a JSON file mapping datasource types, entities and attributes to
+	// the hash, mask or drop transformation of their values (default: no redaction). See redact.Load.
+	// ADAPTER_REDACTION_HASH_KEY_PATH: The path of the file containing the key of the hashed values' HMAC.
+	// ADAPTER_DIRECT_EGRESS_DATASOURCE_TYPES: A comma separated list of the datasource types whose adapters send
+	// requests directly to the datasource instead of through the connector proxy, e.g. "Okta-1.0.1,Slack-1.0.0".
+	// Only for datasources reachable from the network of the adapter (default: none)
+	// ADAPTER_STATE_STORE_URL: The URL of the store of the state the adapters persist across pages and syncs,
+	// e.g. "redis://:password@redis:6379/0" or "file:///var/lib/adapters/state" (default: no store).
+	// See statestore.Open.
+	// ADAPTER_DEPRECATION_VENDOR_HEADERS: A comma separated list of the vendor-specific headers of the datasource
+	// responses logged as deprecation notices, in addition to the standard Deprecation, Sunset, Link and Warning
+	// headers, e.g. "Asana-Change" (default: none). See the deprecation package.
+	// ADAPTER_ADMIN_PORT: The port at which the admin HTTP server listens, serving the registered adapters and their
+	// versions, the build info and the enabled features at /adapters (default: 0, disabled). See the admin package.
 	// Read config from environment variables
 	var (
 		port                     = viper.GetInt("PORT")                        // ADAPTER_PORT
@@ -73,12 +152,55 @@ func main() {
 		maxCSVRowSizeBytes       = viper.GetInt64("MAX_S3_CSV_ROW_SIZE_BYTES") // ADAPTER_MAX_S3_CSV_ROW_SIZE_BYTES
 		maxBytesToProcessPerPage = viper.GetInt64(
 			"MAX_S3_BYTES_TO_PROCESS_PER_PAGE") // ADAPTER_MAX_S3_BYTES_TO_PROCESS_PER_PAGE
+		maxConcurrentRangeReads = viper.GetInt(
+			"MAX_S3_CONCURRENT_RANGE_READS") // ADAPTER_MAX_S3_CONCURRENT_RANGE_READS
 		maxCallRecvMsgSizeMB = viper.GetInt("MAX_CALL_RECV_MSG_SIZE_MB") // ADAPTER_MAX_CALL_RECV_MSG_SIZE_MB
 		maxCallSendMsgSizeMB = viper.GetInt("MAX_CALL_SEND_MSG_SIZE_MB") // ADAPTER_MAX_CALL_SEND_MSG_SIZE_MB
+		egressAllowlistsPath = viper.GetString("EGRESS_ALLOWLISTS_PATH") // ADAPTER_EGRESS_ALLOWLISTS_PATH
+		maxResponseBodySize  = viper.GetInt64(
+			"MAX_RESPONSE_BODY_SIZE_BYTES") // ADAPTER_MAX_RESPONSE_BODY_SIZE_BYTES
+		maxResponseBodySizeByDatasourceType = viper.GetString(
+			"MAX_RESPONSE_BODY_SIZE_BYTES_BY_DATASOURCE_TYPE") // ADAPTER_MAX_RESPONSE_BODY_SIZE_BYTES_BY_DATASOURCE_TYPE
+		redactionRulesPath   = viper.GetString("REDACTION_RULES_PATH")    // ADAPTER_REDACTION_RULES_PATH
+		redactionHashKeyPath = viper.GetString("REDACTION_HASH_KEY_PATH") // ADAPTER_REDACTION_HASH_KEY_PATH
+		directEgress         = egress.ParseDirectDatasourceTypes(
+			viper.GetString("DIRECT_EGRESS_DATASOURCE_TYPES")) // ADAPTER_DIRECT_EGRESS_DATASOURCE_TYPES
+		stateStoreURL            = viper.GetString("STATE_STORE_URL") // ADAPTER_STATE_STORE_URL
+		deprecationVendorHeaders = deprecation.ParseHeaders(
+			viper.GetString("DEPRECATION_VENDOR_HEADERS")) // ADAPTER_DEPRECATION_VENDOR_HEADERS
+		adminPort = viper.GetInt("ADMIN_PORT") // ADAPTER_ADMIN_PORT
 	)
 
+	serverAuthConfig := &serverauth.Config{
+		CertPath:     viper.GetString("TLS_CERT_PATH"),      // ADAPTER_TLS_CERT_PATH
+		KeyPath:      viper.GetString("TLS_KEY_PATH"),       // ADAPTER_TLS_KEY_PATH
+		ClientCAPath: viper.GetString("TLS_CLIENT_CA_PATH"), // ADAPTER_TLS_CLIENT_CA_PATH
+		AllowedClientIdentities: serverauth.ParseIdentities(
+			viper.GetString("TLS_ALLOWED_CLIENT_IDENTITIES"), // ADAPTER_TLS_ALLOWED_CLIENT_IDENTITIES
+		),
+	}
+
 	if connectorServiceURL == "" {
-		log.Fatal("ADAPTER_CONNECTOR_SERVICE_URL environment variable is required")
+		if st == nil {
+			log.Fatal("ADAPTER_CONNECTOR_SERVICE_URL environment variable is required")
+		}
+
+		st.configError("Invalid environment", errors.New("ADAPTER_CONNECTOR_SERVICE_URL is required"))
+	}
+
+	if st != nil {
+		for _, err := range validatePositive(map[string]int64{
+			"ADAPTER_PORT":                             int64(port),
+			"ADAPTER_TIMEOUT":                          int64(timeout),
+			"ADAPTER_MAX_CONCURRENCY":                  int64(maxConcurrency),
+			"ADAPTER_MAX_S3_CSV_ROW_SIZE_BYTES":        maxCSVRowSizeBytes,
+			"ADAPTER_MAX_S3_BYTES_TO_PROCESS_PER_PAGE": maxBytesToProcessPerPage,
+			"ADAPTER_MAX_S3_CONCURRENT_RANGE_READS":    int64(maxConcurrentRangeReads),
+			"ADAPTER_MAX_CALL_RECV_MSG_SIZE_MB":        int64(maxCallRecvMsgSizeMB),
+			"ADAPTER_MAX_CALL_SEND_MSG_SIZE_MB":        int64(maxCallSendMsgSizeMB),
+		}) {
+			st.configError("Invalid environment", err)
+		}
 	}
 
 	loggerCfg, err := zaplogger.LoadConfig()
@@ -94,16 +216,52 @@ func main() {
 		}
 	}()
 
-	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
-	if err != nil {
-		logger.Fatal(fmt.Sprintf("Failed to open server port: %d", port), zap.Error(err))
+	// fatal logs the error and exits, or records it in self-test mode so that all the errors are reported.
+	fatal := func(msg string, err error) {
+		if st != nil {
+			st.configError(msg, err)
+
+			return
+		}
+
+		logger.Fatal(msg, zap.Error(err))
+	}
+
+	// The cursors issued by the builds without a version are never rejected as incompatible with each other.
+	if version == adapterchain.DevVersion {
+		if st != nil {
+			st.configError("Invalid build", errors.New(
+				`the build version is not set, build with -ldflags "-X main.version=<version>"`,
+			))
+		} else {
+			logger.Warn("The build version is not set, the cursors issued by incompatible builds aren't rejected")
+		}
+	}
+
+	// The self-test doesn't serve requests, and mustn't fail if the port is used by a running server.
+	var listener net.Listener
+
+	if st == nil {
+		if listener, err = net.Listen("tcp", fmt.Sprintf(":%d", port)); err != nil {
+			logger.Fatal(fmt.Sprintf("Failed to open server port: %d", port), zap.Error(err))
+		}
 	}
 
 	timeoutDuration := time.Duration(timeout) * time.Second
 
-	s := grpc.NewServer()
+	serverOpts, err := serverauth.ServerOptions(serverAuthConfig)
+	if err != nil {
+		fatal("Failed to configure mutual TLS on the gRPC server", err)
+	}
+
+	// Recover from the panics of the adapters before any other interceptor runs.
+	s := grpc.NewServer(append(recovery.ServerOptions(logger), serverOpts...)...)
 	stop := make(chan struct{})
-	adapterServer := server.New(stop, server.WithLogger(zaplogger.NewFrameworkLogger(logger)))
+
+	adapterServer, err := newAdapterServer(stop, logger)
+	if err != nil {
+		fatal("Failed to create the adapter server", err)
+	}
 
 	connectorServiceClient, err := grpc.NewClient(
 		connectorServiceURL,
@@ -114,197 +272,376 @@ func main() {
 		),
 	)
 	if err != nil {
-		logger.Fatal("Failed to create a grpc client to the connector service", zap.Error(err))
+		fatal("Failed to create a grpc client to the connector service", err)
+	}
+
+	var egressAllowlists egress.Allowlists
+
+	if egressAllowlistsPath != "" {
+		if egressAllowlists, err = egress.LoadAllowlists(egressAllowlistsPath); err != nil {
+			fatal("Failed to load the egress allowlists", err)
+		}
+	}
+
+	responseBodySizeLimits := &responselimit.Limits{Default: maxResponseBodySize}
+
+	if responseBodySizeLimits.ByDatasourceType, err = responselimit.ParseLimits(
+		maxResponseBodySizeByDatasourceType,
+	); err != nil {
+		fatal("Failed to parse the maximum response body sizes", err)
+	}
+
+	redactor, err := redact.Load(redactionRulesPath, redactionHashKeyPath)
+	if err != nil {
+		fatal("Failed to load the redaction rules", err)
+	}
+
+	store, err := statestore.Open(context.Background(), stateStoreURL)
+	if err != nil {
+		fatal("Failed to open the state store", err)
+	}
+
+	if store != nil {
+		defer store.Close()
+	}
+
+	proxyServiceClient := grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient)
+
+	// newHTTPClient returns an HTTP client for the adapter of the datasource type, proxying requests through
+	// the connector service when needed unless the datasource type uses direct egress, restricted to the
+	// egress allowlist and the maximum response body size of the datasource type, and logging the deprecation
+	// notices of the responses.
+	newHTTPClient := func(datasourceType, userAgent string) *http.Client {
+		proxyClient := proxyServiceClient
+
+		// Without a proxy client, requests are sent directly even with a connector in the request context.
+		if directEgress.Has(datasourceType) {
+			proxyClient = nil
+		}
+
+		return responselimit.WrapClient(
+			egress.WrapClient(
+				deprecation.WrapClient(
+					client.NewSGNLHTTPClientWithProxy(timeoutDuration, userAgent, proxyClient),
+					datasourceType,
+					deprecationVendorHeaders,
+					logger,
+				),
+				egressAllowlists.For(datasourceType),
+			),
+			responseBodySizeLimits.For(datasourceType),
+		)
 	}
 
 	// Initialize the client to fetch data from AWS S3.
 	s3Client, err := aws_s3.NewClient(
-		client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-S3/1.0.0",
-			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-		),
+		newHTTPClient("S3-1.0.0", "sgnl-S3/1.0.0"),
 		nil,
 		maxCSVRowSizeBytes,
 		maxBytesToProcessPerPage,
+		maxConcurrentRangeReads,
 	)
 	if err != nil {
-		logger.Fatal("Failed to create a datasource to query AWS S3", zap.Error(err))
+		fatal("Failed to create a datasource to query AWS S3", err)
 	}
 
 	// Initialize the client to fetch data from AWS.
 	awsClient, err := aws.NewClient(
-		client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-AWS/1.0.0",
-			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-		), nil, maxConcurrency,
+		newHTTPClient("AWS-1.0.0", "sgnl-AWS/1.0.0"), nil, maxConcurrency,
 	)
 	if err != nil {
-		logger.Fatal("Failed to create a datasource to query AWS", zap.Error(err))
+		fatal("Failed to create a datasource to query AWS", err)
+	}
+
+	registrar := &adapterRegistrar{
+		server: adapterServer,
+		opts:   adapterchain.Options{Redactor: redactor, Store: store, Version: version},
 	}
 
 	// Register adapters here alphabetically.
-	server.RegisterAdapter(adapterServer, "AWS-1.0.0", aws.NewAdapter(awsClient))
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(registrar, "AWS-1.0.0", aws.NewAdapter(awsClient))
+	registerAdapter(
+		registrar,
 		"AzureAD-1.0.1",
 		azuread.NewAdapter(azuread.NewClient(
-			client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-AzureAD/1.0.1",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			),
+			newHTTPClient("AzureAD-1.0.1", "sgnl-AzureAD/1.0.1"),
+		)),
+	)
+	registerAdapter(
+		registrar,
+		"AzureBlobStorage-1.0.0",
+		azureblob.NewAdapter(azureblob.NewClient(
+			newHTTPClient("AzureBlobStorage-1.0.0", "sgnl-AzureBlobStorage/1.0.0"),
+			maxCSVRowSizeBytes,
+			maxBytesToProcessPerPage,
+			maxConcurrentRangeReads,
 		)),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"BambooHR-1.0.0",
-		bamboohr.NewAdapter(bamboohr.NewClient(client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-BambooHR/1.0.0",
-			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-		))),
+		bamboohr.NewAdapter(bamboohr.NewClient(newHTTPClient("BambooHR-1.0.0", "sgnl-BambooHR/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
+		"BeyondTrustPasswordSafe-1.0.0",
+		beyondtrustpasswordsafe.NewAdapter(beyondtrustpasswordsafe.NewClient(
+			newHTTPClient("BeyondTrustPasswordSafe-1.0.0", "sgnl-BeyondTrustPasswordSafe/1.0.0"),
+		)),
+	)
+	registerAdapter(
+		registrar,
+		"Bitbucket-1.0.0",
+		bitbucket.NewAdapter(bitbucket.NewClient(newHTTPClient("Bitbucket-1.0.0", "sgnl-Bitbucket/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
+		"BitbucketDatacenter-1.0.0",
+		bitbucketdatacenter.NewAdapter(bitbucketdatacenter.NewClient(
+			newHTTPClient("BitbucketDatacenter-1.0.0", "sgnl-BitbucketDatacenter/1.0.0"),
+		)),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
+		"Confluence-1.0.0",
+		confluence.NewAdapter(confluence.NewClient(newHTTPClient("Confluence-1.0.0", "sgnl-Confluence/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
 		"CrowdStrike-1.0.0",
 		crowdstrike.NewAdapter(
-			crowdstrike.NewClient(client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-CrowdStrike/1.0.0",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			)),
+			crowdstrike.NewClient(newHTTPClient("CrowdStrike-1.0.0", "sgnl-CrowdStrike/1.0.0")),
 		),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
+		"Databricks-1.0.0",
+		databricks.NewAdapter(databricks.NewClient(newHTTPClient("Databricks-1.0.0", "sgnl-Databricks/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
+		"DelineaSecretServer-1.0.0",
+		delineasecretserver.NewAdapter(delineasecretserver.NewClient(
+			newHTTPClient("DelineaSecretServer-1.0.0", "sgnl-DelineaSecretServer/1.0.0"),
+		)),
+	)
+	registerAdapter(
+		registrar,
 		"Duo-1.0.0",
-		duo.NewAdapter(duo.NewClient(client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-Duo/1.0.0",
-			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-		))),
+		duo.NewAdapter(duo.NewClient(newHTTPClient("Duo-1.0.0", "sgnl-Duo/1.0.0"))),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"GitHub-1.0.0",
-		github.NewAdapter(github.NewClient(client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-GitHub/1.0.0",
-			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-		))),
+		github.NewAdapter(github.NewClient(newHTTPClient("GitHub-1.0.0", "sgnl-GitHub/1.0.0"))),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
+		"GitLab-1.0.0",
+		gitlab.NewAdapter(gitlab.NewClient(newHTTPClient("GitLab-1.0.0", "sgnl-GitLab/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
+		"GoogleCloudStorage-1.0.0",
+		gcs.NewAdapter(gcs.NewClient(
+			newHTTPClient("GoogleCloudStorage-1.0.0", "sgnl-GoogleCloudStorage/1.0.0"),
+			maxCSVRowSizeBytes,
+			maxBytesToProcessPerPage,
+			maxConcurrentRangeReads,
+		)),
+	)
+	registerAdapter(
+		registrar,
 		"GoogleWorkspace-1.0.0",
 		googleworkspace.NewAdapter(
-			googleworkspace.NewClient(client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-GoogleWorkspace/1.0.0",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			)),
+			googleworkspace.NewClient(newHTTPClient("GoogleWorkspace-1.0.0", "sgnl-GoogleWorkspace/1.0.0")),
 		),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"HashiCorpBoundary-1.0.0",
 		hashicorp.NewAdapter(
-			hashicorp.NewClient(client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-HashiCorpBoundary/1.0.0",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			)),
+			hashicorp.NewClient(newHTTPClient("HashiCorpBoundary-1.0.0", "sgnl-HashiCorpBoundary/1.0.0")),
 		),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"IdentityNow-1.0.0",
 		identitynow.NewAdapter(identitynow.NewClient(
-			client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-IdentityNow/1.0.0",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			), identitynow.DefaultAccountCollectionPageSize,
+			newHTTPClient("IdentityNow-1.0.0", "sgnl-IdentityNow/1.0.0"), identitynow.DefaultAccountCollectionPageSize,
 		)),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
+		"Intune-1.0.0",
+		intune.NewAdapter(intune.NewClient(newHTTPClient("Intune-1.0.0", "sgnl-Intune/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
 		"Jira-1.0.0",
-		jira.NewAdapter(jira.NewClient(client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-Jira/1.0.0",
-			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-		))),
+		jira.NewAdapter(jira.NewClient(newHTTPClient("Jira-1.0.0", "sgnl-Jira/1.0.0"))),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"JiraDatacenter-1.0.0",
 		jiradatacenter.NewAdapter(jiradatacenter.NewClient(
-			client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-JiraDatacenter/1.0.0",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			),
+			newHTTPClient("JiraDatacenter-1.0.0", "sgnl-JiraDatacenter/1.0.0"),
 		)),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
+		"Kafka-1.0.0",
+		kafka.NewAdapter(kafka.NewClient(newHTTPClient("Kafka-1.0.0", "sgnl-Kafka/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
+		"Kandji-1.0.0",
+		kandji.NewAdapter(kandji.NewClient(newHTTPClient("Kandji-1.0.0", "sgnl-Kandji/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
 		"MySQL-0.0.1-alpha",
 		mysql_0_0_1_alpha.NewAdapter(mysql_0_0_1_alpha.NewClient(mysql_0_0_1_alpha.NewDefaultSQLClient(
 			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
 		))),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"MySQL-0.0.2-alpha",
 		mysql_0_0_2_alpha.NewAdapter(mysql_0_0_2_alpha.NewClient(mysql_0_0_2_alpha.NewDefaultSQLClient(
 			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
 		))),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"Okta-1.0.1",
-		okta.NewAdapter(okta.NewClient(client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-Okta/1.0.1",
-			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-		))),
+		okta.NewAdapter(okta.NewClient(newHTTPClient("Okta-1.0.1", "sgnl-Okta/1.0.1"))),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"PagerDuty-1.0.0",
 		pagerduty.NewAdapter(pagerduty.NewClient(
-			client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-PagerDuty/1.0.0",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			)),
+			newHTTPClient("PagerDuty-1.0.0", "sgnl-PagerDuty/1.0.0")),
 		),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
+		"PingOne-1.0.0",
+		pingone.NewAdapter(pingone.NewClient(newHTTPClient("PingOne-1.0.0", "sgnl-PingOne/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
+		"Rapid7InsightVM-1.0.0",
+		rapid7insightvm.NewAdapter(rapid7insightvm.NewClient(
+			newHTTPClient("Rapid7InsightVM-1.0.0", "sgnl-Rapid7InsightVM/1.0.0"),
+		)),
+	)
+	registerAdapter(
+		registrar,
 		"Rootly-1.0.0",
 		rootly.NewAdapter(rootly.NewClient(
-			client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-Rootly/1.0.0",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			)),
+			newHTTPClient("Rootly-1.0.0", "sgnl-Rootly/1.0.0")),
 		),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"Salesforce-1.0.1",
 		salesforce.NewAdapter(salesforce.NewClient(
-			client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-Salesforce/1.0.1",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			)),
+			newHTTPClient("Salesforce-1.0.1", "sgnl-Salesforce/1.0.1")),
 		),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"SCIM2.0-1.0.0",
-		scim.NewAdapter(scim.NewClient(client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-SCIM2.0/1.0.0",
-			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-		))),
+		scim.NewAdapter(scim.NewClient(newHTTPClient("SCIM2.0-1.0.0", "sgnl-SCIM2.0/1.0.0"))),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
+		"Slack-1.0.0",
+		slack.NewAdapter(slack.NewClient(newHTTPClient("Slack-1.0.0", "sgnl-Slack/1.0.0"))),
+	)
+	registerAdapter(
+		registrar,
 		"S3-1.0.0",
 		aws_s3.NewAdapter(s3Client),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"ServiceNow-1.0.1",
 		servicenow.NewAdapter(servicenow.NewClient(
-			client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-ServiceNow/1.0.1",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			),
+			newHTTPClient("ServiceNow-1.0.1", "sgnl-ServiceNow/1.0.1"),
 		)),
 	)
-	server.RegisterAdapter(
-		adapterServer,
+	registerAdapter(
+		registrar,
 		"Workday-1.0.0",
 		workday.NewAdapter(workday.NewClient(
-			client.NewSGNLHTTPClientWithProxy(timeoutDuration, "sgnl-Workday/1.0.0",
-				grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
-			),
+			newHTTPClient("Workday-1.0.0", "sgnl-Workday/1.0.0"),
 		)),
 	)
 
+	if st != nil {
+		st.adapters = registrar.results
+
+		if *selfTestPingConnector && connectorServiceClient != nil {
+			st.connector = &selfTestResult{
+				name: connectorServiceURL,
+				err:  pingConnectorService(context.Background(), connectorServiceClient),
+			}
+		}
+
+		st.WriteReport(os.Stdout)
+
+		if st.Failed() {
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	for _, result := range registrar.results {
+		if result.err != nil {
+			logger.Error("Failed to register adapter", zap.String("datasourceType", result.name), zap.Error(result.err))
+		}
+	}
+
 	api_adapter_v1.RegisterAdapterServer(s, adapterServer)
 
+	if adminPort > 0 {
+		var datasourceTypes []string
+
+		for _, result := range registrar.results {
+			if result.err == nil {
+				datasourceTypes = append(datasourceTypes, result.name)
+			}
+		}
+
+		adminInfo := admin.NewInfo(datasourceTypes, map[string]bool{
+			"deprecationVendorHeaders": len(deprecationVendorHeaders) > 0,
+			"directEgress":             len(directEgress) > 0,
+			"egressAllowlists":         egressAllowlistsPath != "",
+			"mutualTLS":                serverAuthConfig.Enabled(),
+			"redaction":                redactionRulesPath != "",
+			"responseBodySizeLimits":   maxResponseBodySize > 0 || len(responseBodySizeLimits.ByDatasourceType) > 0,
+			"stateStore":               store != nil,
+		})
+
+		// The admin server is best effort: the adapters keep serving requests if it fails.
+		go func() {
+			logger.Info(fmt.Sprintf("Started admin HTTP server on port %d", adminPort))
+
+			adminServer := &http.Server{
+				Addr:              fmt.Sprintf(":%d", adminPort),
+				Handler:           admin.NewHandler(adminInfo),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			if err := adminServer.ListenAndServe(); err != nil {
+				logger.Error(fmt.Sprintf("Failed to serve the admin HTTP server on port %d", adminPort), zap.Error(err))
+			}
+		}()
+	}
+
 	logger.Info(fmt.Sprintf("Started adapter gRPC server on port %d", port))
 
 	if err := s.Serve(listener); err != nil {
@@ -313,3 +650,37 @@ func main() {
 		logger.Fatal(fmt.Sprintf("Failed to listen on server port: %d", port), zap.Error(err))
 	}
 }
+
+// newAdapterServer returns the adapter server, reading the auth tokens from the file at AUTH_TOKENS_PATH.
+// The framework panics if the file can't be watched, which is returned as an error.
+func newAdapterServer(stop <-chan struct{}, logger *zap.Logger) (s api_adapter_v1.AdapterServer, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return server.New(stop, server.WithLogger(zaplogger.NewFrameworkLogger(logger))), nil
+}
+
+// adapterRegistrar registers the adapters with the server, see registerAdapter.
+type adapterRegistrar struct {
+	server api_adapter_v1.AdapterServer
+	opts   adapterchain.Options
+
+	// results are the results of the registration of each adapter, in registration order.
+	// Empty if the server couldn't be created.
+	results []selfTestResult
+}
+
+// registerAdapter registers the adapter with the server, wrapped with the cross-adapter features, see
+// adapterchain.Wrap, recording the result in the registrar.
+func registerAdapter[Config any](r *adapterRegistrar, datasourceType string, adapter framework.Adapter[Config]) {
+	if r.server == nil {
+		return
+	}
+
+	err := server.RegisterAdapter(r.server, datasourceType, adapterchain.Wrap(adapter, datasourceType, r.opts))
+
+	r.results = append(r.results, selfTestResult{name: datasourceType, err: err})
+}
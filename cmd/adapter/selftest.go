@@ -0,0 +1,132 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// selfTestPingTimeout is the time the self-test waits for the connection to the connector service.
+const selfTestPingTimeout = 10 * time.Second
+
+// selfTest is the report of the self-test mode of the binary, enabled by the -selftest flag.
+//
+// In self-test mode, the binary validates its configuration and instantiates and registers every adapter, then
+// prints the report and exits instead of serving requests, with a non-zero status if any check failed. With the
+// -selftest-ping-connector flag, it also checks the connector service is reachable. Run it before routing traffic
+// to a deployment, e.g. in an init container, to catch invalid configurations before the first sync fails.
+type selfTest struct {
+	// configErrors are the errors of the configuration, e.g. invalid environment variables or unreadable files.
+	configErrors []error
+
+	// adapters are the results of the registration of each adapter, in registration order.
+	// Empty if the adapter server couldn't be created.
+	adapters []selfTestResult
+
+	// connector is the result of the ping of the connector service, nil if not pinged.
+	connector *selfTestResult
+}
+
+// selfTestResult is the result of a check of the self-test.
+type selfTestResult struct {
+	name string
+	err  error
+}
+
+// configError records an error of the configuration, wrapping it with the message describing the failed step.
+func (t *selfTest) configError(msg string, err error) {
+	t.configErrors = append(t.configErrors, fmt.Errorf("%s: %w", msg, err))
+}
+
+// Failed returns whether any check of the self-test failed.
+func (t *selfTest) Failed() bool {
+	if len(t.configErrors) > 0 || (t.connector != nil && t.connector.err != nil) {
+		return true
+	}
+
+	return slices.ContainsFunc(t.adapters, func(r selfTestResult) bool { return r.err != nil })
+}
+
+// WriteReport writes the report of the self-test, with a line per check.
+func (t *selfTest) WriteReport(w io.Writer) {
+	fmt.Fprintln(w, "Adapter self-test report:")
+
+	if len(t.configErrors) == 0 {
+		fmt.Fprintln(w, "  ok    configuration")
+	}
+
+	for _, err := range t.configErrors {
+		fmt.Fprintf(w, "  FAIL  configuration: %v\n", err)
+	}
+
+	if len(t.adapters) == 0 {
+		fmt.Fprintln(w, "  SKIP  adapters: the adapter server couldn't be created")
+	}
+
+	for _, result := range t.adapters {
+		writeResult(w, "adapter "+result.name, result.err)
+	}
+
+	if t.connector != nil {
+		writeResult(w, "connector service "+t.connector.name, t.connector.err)
+	}
+
+	if t.Failed() {
+		fmt.Fprintln(w, "Self-test failed.")
+	} else {
+		fmt.Fprintln(w, "Self-test passed.")
+	}
+}
+
+func writeResult(w io.Writer, name string, err error) {
+	if err != nil {
+		fmt.Fprintf(w, "  FAIL  %s: %v\n", name, err)
+	} else {
+		fmt.Fprintf(w, "  ok    %s\n", name)
+	}
+}
+
+// validatePositive returns an error for each of the settings which isn't positive, by environment variable.
+func validatePositive(settings map[string]int64) []error {
+	var errs []error
+
+	for _, name := range slices.Sorted(maps.Keys(settings)) {
+		if settings[name] <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be positive, got %d", name, settings[name]))
+		}
+	}
+
+	return errs
+}
+
+// pingConnectorService connects the client to the connector service, and waits until the connection is ready.
+func pingConnectorService(ctx context.Context, conn *grpc.ClientConn) error {
+	ctx, cancel := context.WithTimeout(ctx, selfTestPingTimeout)
+	defer cancel()
+
+	conn.Connect()
+
+	for {
+		state := conn.GetState()
+
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Shutdown:
+			return errors.New("the connection was shut down")
+		}
+
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("not connected after %v, connection state: %s", selfTestPingTimeout, state)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSelfTestReport(t *testing.T) {
+	tests := map[string]struct {
+		selfTest   *selfTest
+		wantFailed bool
+		wantReport string
+	}{
+		"passed": {
+			selfTest: &selfTest{
+				adapters:  []selfTestResult{{name: "Okta-1.0.1"}, {name: "Slack-1.0.0"}},
+				connector: &selfTestResult{name: "connector:8080"},
+			},
+			wantReport: `Adapter self-test report:
+  ok    configuration
+  ok    adapter Okta-1.0.1
+  ok    adapter Slack-1.0.0
+  ok    connector service connector:8080
+Self-test passed.
+`,
+		},
+		"invalid_configuration": {
+			selfTest: &selfTest{
+				configErrors: []error{errors.New("Failed to load the redaction rules: file not found")},
+				adapters:     []selfTestResult{{name: "Okta-1.0.1"}},
+			},
+			wantFailed: true,
+			wantReport: `Adapter self-test report:
+  FAIL  configuration: Failed to load the redaction rules: file not found
+  ok    adapter Okta-1.0.1
+Self-test failed.
+`,
+		},
+		"adapter_server_not_created": {
+			selfTest: &selfTest{
+				configErrors: []error{errors.New("Failed to create the adapter server: AUTH_TOKENS_PATH environment variable not set")},
+			},
+			wantFailed: true,
+			wantReport: `Adapter self-test report:
+  FAIL  configuration: Failed to create the adapter server: AUTH_TOKENS_PATH environment variable not set
+  SKIP  adapters: the adapter server couldn't be created
+Self-test failed.
+`,
+		},
+		"adapter_registration_failed": {
+			selfTest: &selfTest{
+				adapters: []selfTestResult{
+					{name: "Okta-1.0.1"},
+					{name: "Okta-1.0.1", err: errors.New("duplicate datasource type provided: Okta-1.0.1")},
+				},
+			},
+			wantFailed: true,
+			wantReport: `Adapter self-test report:
+  ok    configuration
+  ok    adapter Okta-1.0.1
+  FAIL  adapter Okta-1.0.1: duplicate datasource type provided: Okta-1.0.1
+Self-test failed.
+`,
+		},
+		"connector_service_unreachable": {
+			selfTest: &selfTest{
+				adapters:  []selfTestResult{{name: "Okta-1.0.1"}},
+				connector: &selfTestResult{name: "connector:8080", err: errors.New("not connected after 10s")},
+			},
+			wantFailed: true,
+			wantReport: `Adapter self-test report:
+  ok    configuration
+  ok    adapter Okta-1.0.1
+  FAIL  connector service connector:8080: not connected after 10s
+Self-test failed.
+`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if gotFailed := tt.selfTest.Failed(); gotFailed != tt.wantFailed {
+				t.Errorf("gotFailed: %v, wantFailed: %v", gotFailed, tt.wantFailed)
+			}
+
+			var report strings.Builder
+
+			tt.selfTest.WriteReport(&report)
+
+			if gotReport := report.String(); gotReport != tt.wantReport {
+				t.Errorf("gotReport:\n%s\nwantReport:\n%s", gotReport, tt.wantReport)
+			}
+		})
+	}
+}
+
+func TestValidatePositive(t *testing.T) {
+	gotErrs := validatePositive(map[string]int64{
+		"ADAPTER_TIMEOUT":         0,
+		"ADAPTER_PORT":            8080,
+		"ADAPTER_MAX_CONCURRENCY": -1,
+	})
+
+	wantErrs := []string{
+		"ADAPTER_MAX_CONCURRENCY must be positive, got -1",
+		"ADAPTER_TIMEOUT must be positive, got 0",
+	}
+
+	if len(gotErrs) != len(wantErrs) {
+		t.Fatalf("gotErrs: %v, wantErrs: %v", gotErrs, wantErrs)
+	}
+
+	for i, err := range gotErrs {
+		if err.Error() != wantErrs[i] {
+			t.Errorf("gotErr: %v, wantErr: %v", err, wantErrs[i])
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Command config-schema writes the JSON Schema of the config of each adapter to a file named after its
+// datasource type, e.g. Okta-1.0.1.schema.json, in the output directory. It runs when the adapter image
+// is built, so that the schemas always match the Config structs of the adapters.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	aws "github.com/sgnl-ai/adapters/pkg/aws"
+	aws_s3 "github.com/sgnl-ai/adapters/pkg/aws-s3"
+	azureblob "github.com/sgnl-ai/adapters/pkg/azure-blob"
+	"github.com/sgnl-ai/adapters/pkg/azuread"
+	"github.com/sgnl-ai/adapters/pkg/bamboohr"
+	beyondtrustpasswordsafe "github.com/sgnl-ai/adapters/pkg/beyondtrust-passwordsafe"
+	"github.com/sgnl-ai/adapters/pkg/bitbucket"
+	bitbucketdatacenter "github.com/sgnl-ai/adapters/pkg/bitbucket-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/configschema"
+	"github.com/sgnl-ai/adapters/pkg/confluence"
+	"github.com/sgnl-ai/adapters/pkg/crowdstrike"
+	"github.com/sgnl-ai/adapters/pkg/databricks"
+	delineasecretserver "github.com/sgnl-ai/adapters/pkg/delinea-secretserver"
+	"github.com/sgnl-ai/adapters/pkg/duo"
+	"github.com/sgnl-ai/adapters/pkg/gcs"
+	"github.com/sgnl-ai/adapters/pkg/github"
+	"github.com/sgnl-ai/adapters/pkg/gitlab"
+	googleworkspace "github.com/sgnl-ai/adapters/pkg/google-workspace"
+	"github.com/sgnl-ai/adapters/pkg/hashicorp"
+	"github.com/sgnl-ai/adapters/pkg/identitynow"
+	"github.com/sgnl-ai/adapters/pkg/intune"
+	"github.com/sgnl-ai/adapters/pkg/jira"
+	jiradatacenter "github.com/sgnl-ai/adapters/pkg/jira-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/kafka"
+	"github.com/sgnl-ai/adapters/pkg/kandji"
+	ldap_v1 "github.com/sgnl-ai/adapters/pkg/ldap/v1.0.0"
+	ldap_v2 "github.com/sgnl-ai/adapters/pkg/ldap/v2.0.0"
+	mysql_0_0_1_alpha "github.com/sgnl-ai/adapters/pkg/my-sql/0.0.1-alpha"
+	mysql_0_0_2_alpha "github.com/sgnl-ai/adapters/pkg/my-sql/0.0.2-alpha"
+	"github.com/sgnl-ai/adapters/pkg/okta"
+	"github.com/sgnl-ai/adapters/pkg/pagerduty"
+	"github.com/sgnl-ai/adapters/pkg/pingone"
+	rapid7insightvm "github.com/sgnl-ai/adapters/pkg/rapid7-insightvm"
+	"github.com/sgnl-ai/adapters/pkg/rootly"
+	"github.com/sgnl-ai/adapters/pkg/salesforce"
+	"github.com/sgnl-ai/adapters/pkg/scim"
+	"github.com/sgnl-ai/adapters/pkg/servicenow"
+	"github.com/sgnl-ai/adapters/pkg/slack"
+	"github.com/sgnl-ai/adapters/pkg/workday"
+)
+
+// configs maps the datasource types registered by cmd/adapter and cmd/ldap-adapter to the Config struct
+// of their adapter. Add adapters here alphabetically when registering them.
+var configs = map[string]any{
+	"AWS-1.0.0":                     aws.Config{},
+	"AzureAD-1.0.1":                 azuread.Config{},
+	"AzureBlobStorage-1.0.0":        azureblob.Config{},
+	"BambooHR-1.0.0":                bamboohr.Config{},
+	"BeyondTrustPasswordSafe-1.0.0": beyondtrustpasswordsafe.Config{},
+	"Bitbucket-1.0.0":               bitbucket.Config{},
+	"BitbucketDatacenter-1.0.0":     bitbucketdatacenter.Config{},
+	"Confluence-1.0.0":              confluence.Config{},
+	"CrowdStrike-1.0.0":             crowdstrike.Config{},
+	"Databricks-1.0.0":              databricks.Config{},
+	"DelineaSecretServer-1.0.0":     delineasecretserver.Config{},
+	"Duo-1.0.0":                     duo.Config{},
+	"GitHub-1.0.0":                  github.Config{},
+	"GitLab-1.0.0":                  gitlab.Config{},
+	"GoogleCloudStorage-1.0.0":      gcs.Config{},
+	"GoogleWorkspace-1.0.0":         googleworkspace.Config{},
+	"HashiCorpBoundary-1.0.0":       hashicorp.Config{},
+	"IdentityNow-1.0.0":             identitynow.Config{},
+	"Intune-1.0.0":                  intune.Config{},
+	"Jira-1.0.0":                    jira.Config{},
+	"JiraDatacenter-1.0.0":          jiradatacenter.Config{},
+	"Kafka-1.0.0":                   kafka.Config{},
+	"Kandji-1.0.0":                  kandji.Config{},
+	"LDAP-1.0.0":                    ldap_v1.Config{},
+	"LDAP-2.0.0":                    ldap_v2.Config{},
+	"MySQL-0.0.1-alpha":             mysql_0_0_1_alpha.Config{},
+	"MySQL-0.0.2-alpha":             mysql_0_0_2_alpha.Config{},
+	"Okta-1.0.1":                    okta.Config{},
+	"PagerDuty-1.0.0":               pagerduty.Config{},
+	"PingOne-1.0.0":                 pingone.Config{},
+	"Rapid7InsightVM-1.0.0":         rapid7insightvm.Config{},
+	"Rootly-1.0.0":                  rootly.Config{},
+	"Salesforce-1.0.1":              salesforce.Config{},
+	"SCIM2.0-1.0.0":                 scim.Config{},
+	"Slack-1.0.0":                   slack.Config{},
+	"S3-1.0.0":                      aws_s3.Config{},
+	"ServiceNow-1.0.1":              servicenow.Config{},
+	"Workday-1.0.0":                 workday.Config{},
+}
+
+func main() {
+	outputDir := flag.String("out", "schemas", "directory to write the JSON Schema files to")
+	flag.Parse()
+
+	if err := os.MkdirAll(*outputDir, 0o755); err != nil {
+		log.Fatalf("Failed to create the output directory %s: %v", *outputDir, err)
+	}
+
+	for datasourceType, config := range configs {
+		schema, err := configschema.Generate(datasourceType, config)
+		if err != nil {
+			log.Fatalf("Failed to generate the config schema of %s: %v", datasourceType, err)
+		}
+
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to marshal the config schema of %s: %v", datasourceType, err)
+		}
+
+		path := filepath.Join(*outputDir, datasourceType+".schema.json")
+
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+			log.Fatalf("Failed to write the config schema of %s to %s: %v", datasourceType, path, err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/configschema"
+)
+
+// TestConfigs verifies that the schema of the config of every adapter can be generated, so that adding an
+// unsupported field type to a Config struct fails the tests rather than the image build.
+func TestConfigs(t *testing.T) {
+	for datasourceType, config := range configs {
+		t.Run(datasourceType, func(t *testing.T) {
+			schema, err := configschema.Generate(datasourceType, config)
+			if err != nil {
+				t.Fatalf("failed to generate the config schema: %v", err)
+			}
+
+			if _, found := schema.Properties["requestTimeoutSeconds"]; !found {
+				t.Errorf("the config schema is missing the common config properties: %v", schema.Properties)
+			}
+		})
+	}
+}
@@ -6,14 +6,20 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapter-framework/server"
+	"github.com/sgnl-ai/adapters/pkg/adapterchain"
 	"github.com/sgnl-ai/adapters/pkg/db2"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/recovery"
+	"github.com/sgnl-ai/adapters/pkg/redact"
+	"github.com/sgnl-ai/adapters/pkg/serverauth"
+	"github.com/sgnl-ai/adapters/pkg/statestore"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -21,6 +27,10 @@ import (
 
 const MiB = 1024 * 1024
 
+// version is the version of the adapter build, set with -ldflags "-X main.version=<version>". The cursors are
+// stamped with it, so that the cursors issued by a build of another major version are rejected.
+var version = adapterchain.DevVersion
+
 func main() {
 	viper.AutomaticEnv()
 	viper.SetEnvPrefix("DB2_ADAPTER")
@@ -31,13 +41,36 @@ func main() {
 	viper.SetDefault("MAX_CALL_RECV_MSG_SIZE_MB", 8)
 	// DB2_ADAPTER_MAX_CALL_SEND_MSG_SIZE_MB: Maximum gRPC send message size in MB (default: 8MB)
 	viper.SetDefault("MAX_CALL_SEND_MSG_SIZE_MB", 8)
+	// DB2_ADAPTER_TLS_CERT_PATH: The path of the PEM encoded certificate chain of the gRPC server (default: no TLS)
+	// DB2_ADAPTER_TLS_KEY_PATH: The path of the PEM encoded private key of the gRPC server (default: no TLS)
+	// DB2_ADAPTER_TLS_CLIENT_CA_PATH: The path of the PEM encoded CA certificates verifying client certificates.
+	// The gRPC server requires mutual TLS when the certificate, key and client CA paths are set.
+	// DB2_ADAPTER_TLS_ALLOWED_CLIENT_IDENTITIES: A comma separated list of the identities (subject common name,
+	// DNS name or URI SAN) of the client certificates allowed to call the gRPC server (default: any)
+	// DB2_ADAPTER_REDACTION_RULES_PATH: The path of a JSON file mapping datasource types, entities and attributes
+	// to the hash, mask or drop transformation of their values (default: no redaction). See redact.Load.
+	// DB2_ADAPTER_REDACTION_HASH_KEY_PATH: The path of the file containing the key of the hashed values' HMAC.
+	// DB2_ADAPTER_STATE_STORE_URL: The URL of the store of the state the adapter persists across pages and syncs
+	// (default: no store). See statestore.Open.
 
 	var (
-		port                 = viper.GetInt("PORT")                      // DB2_ADAPTER_PORT
-		maxCallRecvMsgSizeMB = viper.GetInt("MAX_CALL_RECV_MSG_SIZE_MB") // DB2_ADAPTER_MAX_CALL_RECV_MSG_SIZE_MB
-		maxCallSendMsgSizeMB = viper.GetInt("MAX_CALL_SEND_MSG_SIZE_MB") // DB2_ADAPTER_MAX_CALL_SEND_MSG_SIZE_MB
+		port                 = viper.GetInt("PORT")                       // DB2_ADAPTER_PORT
+		maxCallRecvMsgSizeMB = viper.GetInt("MAX_CALL_RECV_MSG_SIZE_MB")  // DB2_ADAPTER_MAX_CALL_RECV_MSG_SIZE_MB
+		maxCallSendMsgSizeMB = viper.GetInt("MAX_CALL_SEND_MSG_SIZE_MB")  // DB2_ADAPTER_MAX_CALL_SEND_MSG_SIZE_MB
+		redactionRulesPath   = viper.GetString("REDACTION_RULES_PATH")    // DB2_ADAPTER_REDACTION_RULES_PATH
+		redactionHashKeyPath = viper.GetString("REDACTION_HASH_KEY_PATH") // DB2_ADAPTER_REDACTION_HASH_KEY_PATH
+		stateStoreURL        = viper.GetString("STATE_STORE_URL")         // DB2_ADAPTER_STATE_STORE_URL
 	)
 
+	serverAuthConfig := &serverauth.Config{
+		CertPath:     viper.GetString("TLS_CERT_PATH"),      // DB2_ADAPTER_TLS_CERT_PATH
+		KeyPath:      viper.GetString("TLS_KEY_PATH"),       // DB2_ADAPTER_TLS_KEY_PATH
+		ClientCAPath: viper.GetString("TLS_CLIENT_CA_PATH"), // DB2_ADAPTER_TLS_CLIENT_CA_PATH
+		AllowedClientIdentities: serverauth.ParseIdentities(
+			viper.GetString("TLS_ALLOWED_CLIENT_IDENTITIES"), // DB2_ADAPTER_TLS_ALLOWED_CLIENT_IDENTITIES
+		),
+	}
+
 	loggerCfg, err := zaplogger.LoadConfig()
 	if err != nil {
 		log.Fatalf("Failed to load logger configuration: %v", err)
@@ -51,24 +84,52 @@ func main() {
 		}
 	}()
 
+	if version == adapterchain.DevVersion {
+		logger.Warn("The build version is not set, the cursors issued by incompatible builds aren't rejected")
+	}
+
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Failed to open server port: %d", port), zap.Error(err))
 	}
 
-	s := grpc.NewServer(
+	serverOpts, err := serverauth.ServerOptions(serverAuthConfig)
+	if err != nil {
+		logger.Fatal("Failed to configure mutual TLS on the gRPC server", zap.Error(err))
+	}
+
+	// Recover from the panics of the adapter before any other interceptor runs.
+	s := grpc.NewServer(append(append(recovery.ServerOptions(logger), serverOpts...),
 		grpc.MaxRecvMsgSize(maxCallRecvMsgSizeMB*MiB),
 		grpc.MaxSendMsgSize(maxCallSendMsgSizeMB*MiB),
-	)
+	)...)
 	stop := make(chan struct{})
 	adapterServer := server.New(stop, server.WithLogger(zaplogger.NewFrameworkLogger(logger)))
 
+	redactor, err := redact.Load(redactionRulesPath, redactionHashKeyPath)
+	if err != nil {
+		logger.Fatal("Failed to load the redaction rules", zap.Error(err))
+	}
+
+	store, err := statestore.Open(context.Background(), stateStoreURL)
+	if err != nil {
+		logger.Fatal("Failed to open the state store", zap.Error(err))
+	}
+
+	if store != nil {
+		defer store.Close()
+	}
+
 	// Register DB2 adapter. The DB2 adapter connects directly to the database
 	// and does not require a connector service proxy.
 	if err := server.RegisterAdapter(
 		adapterServer,
 		"DB2-1.0.0",
-		db2.NewAdapter(db2.NewClient(db2.NewDefaultSQLClient())),
+		adapterchain.Wrap(
+			db2.NewAdapter(db2.NewClient(db2.NewDefaultSQLClient())),
+			"DB2-1.0.0",
+			adapterchain.Options{Redactor: redactor, Store: store, Version: version},
+		),
 	); err != nil {
 		logger.Fatal("Failed to register DB2 adapter", zap.Error(err))
 	}
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -11,15 +12,24 @@ import (
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	grpc_proxy_v1 "github.com/sgnl-ai/adapter-framework/pkg/grpc_proxy/v1"
 	"github.com/sgnl-ai/adapter-framework/server"
+	"github.com/sgnl-ai/adapters/pkg/adapterchain"
 	adapter_v1 "github.com/sgnl-ai/adapters/pkg/ldap/v1.0.0"
 	adapter_v2 "github.com/sgnl-ai/adapters/pkg/ldap/v2.0.0"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/recovery"
+	"github.com/sgnl-ai/adapters/pkg/redact"
+	"github.com/sgnl-ai/adapters/pkg/serverauth"
+	"github.com/sgnl-ai/adapters/pkg/statestore"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// version is the version of the adapter build, set with -ldflags "-X main.version=<version>". The cursors are
+// stamped with it, so that the cursors issued by a build of another major version are rejected.
+var version = adapterchain.DevVersion
+
 func main() {
 	viper.AutomaticEnv()
 	viper.SetEnvPrefix("LDAP_ADAPTER")
@@ -30,11 +40,34 @@ func main() {
 	viper.SetDefault("SESSION_TTL", 30)
 	// LDAP_ADAPTER_SESSION_CLEANUP_INTERVAL: The session pool cleanup interval in minutes (default: 1)
 	viper.SetDefault("SESSION_CLEANUP_INTERVAL", 1)
+	// LDAP_ADAPTER_TLS_CERT_PATH: The path of the PEM encoded certificate chain of the gRPC server (default: no TLS)
+	// LDAP_ADAPTER_TLS_KEY_PATH: The path of the PEM encoded private key of the gRPC server (default: no TLS)
+	// LDAP_ADAPTER_TLS_CLIENT_CA_PATH: The path of the PEM encoded CA certificates verifying client certificates.
+	// The gRPC server requires mutual TLS when the certificate, key and client CA paths are set.
+	// LDAP_ADAPTER_TLS_ALLOWED_CLIENT_IDENTITIES: A comma separated list of the identities (subject common name,
+	// DNS name or URI SAN) of the client certificates allowed to call the gRPC server (default: any)
+	// LDAP_ADAPTER_REDACTION_RULES_PATH: The path of a JSON file mapping datasource types, entities and attributes
+	// to the hash, mask or drop transformation of their values (default: no redaction). See redact.Load.
+	// LDAP_ADAPTER_REDACTION_HASH_KEY_PATH: The path of the file containing the key of the hashed values' HMAC.
+	// LDAP_ADAPTER_STATE_STORE_URL: The URL of the store of the state the adapters persist across pages and syncs
+	// (default: no store). See statestore.Open.
 	// Read config from environment variables
 	port := viper.GetInt("PORT")                                       // LDAP_ADAPTER_PORT
 	adapterTTL := viper.GetInt("SESSION_TTL")                          // LDAP_ADAPTER_SESSION_TTL
 	adapterCleanupInterval := viper.GetInt("SESSION_CLEANUP_INTERVAL") // LDAP_ADAPTER_SESSION_CLEANUP_INTERVAL
 	connectorServiceURL := viper.GetString("CONNECTOR_SERVICE_URL")    // LDAP_ADAPTER_CONNECTOR_SERVICE_URL
+	redactionRulesPath := viper.GetString("REDACTION_RULES_PATH")      // LDAP_ADAPTER_REDACTION_RULES_PATH
+	redactionHashKeyPath := viper.GetString("REDACTION_HASH_KEY_PATH") // LDAP_ADAPTER_REDACTION_HASH_KEY_PATH
+	stateStoreURL := viper.GetString("STATE_STORE_URL")                // LDAP_ADAPTER_STATE_STORE_URL
+
+	serverAuthConfig := &serverauth.Config{
+		CertPath:     viper.GetString("TLS_CERT_PATH"),      // LDAP_ADAPTER_TLS_CERT_PATH
+		KeyPath:      viper.GetString("TLS_KEY_PATH"),       // LDAP_ADAPTER_TLS_KEY_PATH
+		ClientCAPath: viper.GetString("TLS_CLIENT_CA_PATH"), // LDAP_ADAPTER_TLS_CLIENT_CA_PATH
+		AllowedClientIdentities: serverauth.ParseIdentities(
+			viper.GetString("TLS_ALLOWED_CLIENT_IDENTITIES"), // LDAP_ADAPTER_TLS_ALLOWED_CLIENT_IDENTITIES
+		),
+	}
 
 	if connectorServiceURL == "" {
 		log.Fatal("LDAP_ADAPTER_CONNECTOR_SERVICE_URL environment variable is required")
@@ -53,12 +86,22 @@ func main() {
 		}
 	}()
 
+	if version == adapterchain.DevVersion {
+		logger.Warn("The build version is not set, the cursors issued by incompatible builds aren't rejected")
+	}
+
 	list, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Failed to open server port: %d", port), zap.Error(err))
 	}
 
-	s := grpc.NewServer()
+	serverOpts, err := serverauth.ServerOptions(serverAuthConfig)
+	if err != nil {
+		logger.Fatal("Failed to configure mutual TLS on the gRPC server", zap.Error(err))
+	}
+
+	// Recover from the panics of the adapter before any other interceptor runs.
+	s := grpc.NewServer(append(recovery.ServerOptions(logger), serverOpts...)...)
 	stop := make(chan struct{})
 	adapterServer := server.New(stop, server.WithLogger(zaplogger.NewFrameworkLogger(logger)))
 
@@ -70,24 +113,42 @@ func main() {
 		logger.Fatal("Failed to create a grpc client to the connector service", zap.Error(err))
 	}
 
+	redactor, err := redact.Load(redactionRulesPath, redactionHashKeyPath)
+	if err != nil {
+		logger.Fatal("Failed to load the redaction rules", zap.Error(err))
+	}
+
+	store, err := statestore.Open(context.Background(), stateStoreURL)
+	if err != nil {
+		logger.Fatal("Failed to open the state store", zap.Error(err))
+	}
+
+	if store != nil {
+		defer store.Close()
+	}
+
+	chainOpts := adapterchain.Options{Redactor: redactor, Store: store, Version: version}
+
 	// Register LDAP-v1.0.0 adapter.
 	server.RegisterAdapter(
 		adapterServer,
 		"LDAP-1.0.0",
-		adapter_v1.NewAdapter(
+		adapterchain.Wrap(adapter_v1.NewAdapter(
 			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
 			time.Duration(adapterTTL)*time.Minute,
-			time.Duration(adapterCleanupInterval)*time.Minute),
+			time.Duration(adapterCleanupInterval)*time.Minute,
+		), "LDAP-1.0.0", chainOpts),
 	)
 
 	// Register LDAP-v2.0.0 adapter.
 	server.RegisterAdapter(
 		adapterServer,
 		"LDAP-2.0.0",
-		adapter_v2.NewAdapter(
+		adapterchain.Wrap(adapter_v2.NewAdapter(
 			grpc_proxy_v1.NewProxyServiceClient(connectorServiceClient),
 			time.Duration(adapterTTL)*time.Minute,
-			time.Duration(adapterCleanupInterval)*time.Minute),
+			time.Duration(adapterCleanupInterval)*time.Minute,
+		), "LDAP-2.0.0", chainOpts),
 	)
 
 	api_adapter_v1.RegisterAdapterServer(s, adapterServer)
@@ -0,0 +1,69 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package adapterchain wraps the adapters served by the adapter binaries with the cross-adapter features, in the
+// same order in every binary, so that no binary misses a feature its adapters are configured with.
+package adapterchain
+
+import (
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/cursorstamp"
+	"github.com/sgnl-ai/adapters/pkg/dedup"
+	"github.com/sgnl-ai/adapters/pkg/identity"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/normalize"
+	"github.com/sgnl-ai/adapters/pkg/redact"
+	"github.com/sgnl-ai/adapters/pkg/responselimit"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
+	"github.com/sgnl-ai/adapters/pkg/statestore"
+	"github.com/sgnl-ai/adapters/pkg/syncsummary"
+)
+
+// DevVersion is the build version of the binaries built without a version, see Options.
+const DevVersion = "dev"
+
+// Options are the dependencies of the wrapped adapters shared by all the adapters of a binary.
+type Options struct {
+	// Redactor applies the redaction rules of the datasource types. Nil means no redaction.
+	Redactor *redact.Redactor
+
+	// Store is the store of the state the adapters persist across pages and syncs. Nil means no store.
+	Store statestore.Store
+
+	// Version is the build version of the binary, e.g. "1.4.2", the cursors are stamped with. The cursors issued
+	// by the builds with the DevVersion are never rejected as incompatible with each other, see cursorstamp.
+	Version string
+}
+
+// Wrap wraps the adapter of the datasource type. The state store, if any, is available to the adapter with its keys
+// prefixed with the datasource type, see statestore.FromContext. Its requests are retried with a smaller page size
+// when a response of the datasource exceeds the maximum response body size, the normalized identity attributes
+// requested are mapped from the attributes of the datasource, and the normalization rules of the request config then
+// the redaction rules of the datasource type are applied to the objects it returns. If enabled in the request config,
+// the schema drift of the returned objects is logged, the objects returned more than once during a sync are
+// suppressed, and a summary of the returned objects is logged at the end of the sync of each entity. Its cursors are
+// stamped with the build version. The standard request fields are attached to all the entries logged while serving
+// its requests.
+func Wrap[Config any](
+	adapter framework.Adapter[Config], datasourceType string, opts Options,
+) framework.Adapter[Config] {
+	return zaplogger.NewAdapter(
+		cursorstamp.NewAdapter(
+			syncsummary.NewAdapter(
+				dedup.NewAdapter(
+					redact.NewAdapter(
+						normalize.NewAdapter(identity.NewAdapter(
+							schemadrift.NewAdapter(
+								responselimit.NewAdapter(statestore.NewAdapter(adapter, opts.Store, datasourceType)),
+							),
+							datasourceType,
+						)),
+						opts.Redactor,
+						datasourceType,
+					),
+				),
+			),
+			opts.Version,
+		),
+		datasourceType,
+	)
+}
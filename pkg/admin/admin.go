@@ -0,0 +1,149 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package admin serves the admin HTTP endpoints of the adapter servers.
+//
+// The endpoints are read-only and describe the running server, e.g. the adapters it serves, so that operators can
+// confirm which adapter versions a given pod serves without reading its logs. They are served on a separate port,
+// which shouldn't be exposed outside of the cluster.
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime/debug"
+	"slices"
+	"strings"
+)
+
+// AdaptersPath is the path of the endpoint returning the Info of the server.
+const AdaptersPath = "/adapters"
+
+// Info describes the adapters served by an adapter server, and how the server was built and configured.
+type Info struct {
+	// Adapters are the adapters registered with the server, sorted by datasource type.
+	Adapters []Adapter `json:"adapters"`
+
+	// Build is the build info of the binary.
+	Build Build `json:"build"`
+
+	// Features are the names of the optional features enabled on the server, sorted, e.g. "mutualTLS".
+	Features []string `json:"features"`
+}
+
+// Adapter is an adapter registered with the server.
+type Adapter struct {
+	// DatasourceType is the datasource type the adapter is registered for, e.g. "Okta-1.0.1".
+	DatasourceType string `json:"datasourceType"`
+
+	// ID is the ID of the adapter, e.g. "Okta".
+	ID string `json:"id"`
+
+	// Version is the version of the adapter, e.g. "1.0.1".
+	Version string `json:"version"`
+}
+
+// Build is the build info of the binary, read from the info embedded by the Go toolchain.
+type Build struct {
+	// GoVersion is the version of the Go toolchain which built the binary.
+	GoVersion string `json:"goVersion,omitempty"`
+
+	// Path is the path of the main package of the binary.
+	Path string `json:"path,omitempty"`
+
+	// Version is the version of the main module, "(devel)" if built from a local checkout.
+	Version string `json:"version,omitempty"`
+
+	// Revision is the VCS revision the binary was built from, if known.
+	Revision string `json:"revision,omitempty"`
+
+	// Time is the time of the VCS revision, in RFC 3339 format, if known.
+	Time string `json:"time,omitempty"`
+
+	// Modified is true if the VCS checkout had local modifications.
+	Modified bool `json:"modified,omitempty"`
+}
+
+// NewAdapter returns the Adapter registered for the datasource type, whose ID and version are separated by the
+// first "-", e.g. "MySQL-0.0.1-alpha" has the version "0.0.1-alpha".
+func NewAdapter(datasourceType string) Adapter {
+	id, version, _ := strings.Cut(datasourceType, "-")
+
+	return Adapter{DatasourceType: datasourceType, ID: id, Version: version}
+}
+
+// NewInfo returns the Info of a server serving the adapters of the datasource types, built into the running binary,
+// with the features whose value is true enabled.
+func NewInfo(datasourceTypes []string, features map[string]bool) *Info {
+	info := &Info{
+		Adapters: make([]Adapter, 0, len(datasourceTypes)),
+		Build:    ReadBuild(),
+		Features: make([]string, 0, len(features)),
+	}
+
+	for _, datasourceType := range datasourceTypes {
+		info.Adapters = append(info.Adapters, NewAdapter(datasourceType))
+	}
+
+	slices.SortFunc(info.Adapters, func(a, b Adapter) int {
+		return strings.Compare(a.DatasourceType, b.DatasourceType)
+	})
+
+	for feature, enabled := range features {
+		if enabled {
+			info.Features = append(info.Features, feature)
+		}
+	}
+
+	slices.Sort(info.Features)
+
+	return info
+}
+
+// ReadBuild returns the build info of the running binary, or an empty Build if the binary wasn't built with
+// module support.
+func ReadBuild() Build {
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return Build{}
+	}
+
+	build := Build{
+		GoVersion: buildInfo.GoVersion,
+		Path:      buildInfo.Path,
+		Version:   buildInfo.Main.Version,
+	}
+
+	for _, setting := range buildInfo.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			build.Revision = setting.Value
+		case "vcs.time":
+			build.Time = setting.Value
+		case "vcs.modified":
+			build.Modified = setting.Value == "true"
+		}
+	}
+
+	return build
+}
+
+// NewHandler returns the handler of the admin endpoints, serving the info as JSON at AdaptersPath.
+func NewHandler(info *Info) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET "+AdaptersPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// The info is encoded to a buffer first, so a failure can't send a partial body with a 200 status.
+		body, err := json.Marshal(info)
+		if err != nil {
+			http.Error(w, "failed to encode the adapter server info", http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Write(body)
+	})
+
+	return mux
+}
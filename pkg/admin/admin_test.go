@@ -0,0 +1,128 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/admin"
+)
+
+func TestNewInfo(t *testing.T) {
+	tests := map[string]struct {
+		datasourceTypes []string
+		features        map[string]bool
+		wantAdapters    []admin.Adapter
+		wantFeatures    []string
+	}{
+		"adapters_and_features": {
+			datasourceTypes: []string{"Okta-1.0.1", "MySQL-0.0.1-alpha", "AzureAD-1.0.1"},
+			features: map[string]bool{
+				"stateStore": true,
+				"mutualTLS":  true,
+				"redaction":  false,
+			},
+			wantAdapters: []admin.Adapter{
+				{DatasourceType: "AzureAD-1.0.1", ID: "AzureAD", Version: "1.0.1"},
+				{DatasourceType: "MySQL-0.0.1-alpha", ID: "MySQL", Version: "0.0.1-alpha"},
+				{DatasourceType: "Okta-1.0.1", ID: "Okta", Version: "1.0.1"},
+			},
+			wantFeatures: []string{"mutualTLS", "stateStore"},
+		},
+		"datasource_type_without_version": {
+			datasourceTypes: []string{"Custom"},
+			wantAdapters:    []admin.Adapter{{DatasourceType: "Custom", ID: "Custom"}},
+			wantFeatures:    []string{},
+		},
+		"no_adapters": {
+			wantAdapters: []admin.Adapter{},
+			wantFeatures: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotInfo := admin.NewInfo(tt.datasourceTypes, tt.features)
+
+			if !reflect.DeepEqual(gotInfo.Adapters, tt.wantAdapters) {
+				t.Errorf("gotAdapters: %v, wantAdapters: %v", gotInfo.Adapters, tt.wantAdapters)
+			}
+
+			if !reflect.DeepEqual(gotInfo.Features, tt.wantFeatures) {
+				t.Errorf("gotFeatures: %v, wantFeatures: %v", gotInfo.Features, tt.wantFeatures)
+			}
+
+			// Test binaries embed the build info too.
+			if gotInfo.Build.GoVersion == "" {
+				t.Errorf("gotBuild: %+v, want the Go version", gotInfo.Build)
+			}
+		})
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	info := &admin.Info{
+		Adapters: []admin.Adapter{{DatasourceType: "Okta-1.0.1", ID: "Okta", Version: "1.0.1"}},
+		Build:    admin.Build{GoVersion: "go1.26.0", Path: "github.com/sgnl-ai/adapters/cmd/adapter", Revision: "49e03a1"},
+		Features: []string{"mutualTLS"},
+	}
+
+	tests := map[string]struct {
+		method         string
+		path           string
+		wantStatusCode int
+		wantBody       string
+	}{
+		"get_adapters": {
+			method:         http.MethodGet,
+			path:           "/adapters",
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"adapters":[{"datasourceType":"Okta-1.0.1","id":"Okta","version":"1.0.1"}],"build":{"goVersion":"go1.26.0","path":"github.com/sgnl-ai/adapters/cmd/adapter","revision":"49e03a1"},"features":["mutualTLS"]}`,
+		},
+		"post_adapters": {
+			method:         http.MethodPost,
+			path:           "/adapters",
+			wantStatusCode: http.StatusMethodNotAllowed,
+		},
+		"unknown_path": {
+			method:         http.MethodGet,
+			path:           "/debug",
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			admin.NewHandler(info).ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if recorder.Code != tt.wantStatusCode {
+				t.Fatalf("gotStatusCode: %d, wantStatusCode: %d", recorder.Code, tt.wantStatusCode)
+			}
+
+			if tt.wantBody == "" {
+				return
+			}
+
+			if gotContentType := recorder.Header().Get("Content-Type"); gotContentType != "application/json" {
+				t.Errorf("gotContentType: %s, wantContentType: application/json", gotContentType)
+			}
+
+			if gotBody := recorder.Body.String(); gotBody != tt.wantBody {
+				t.Errorf("gotBody: %s, wantBody: %s", gotBody, tt.wantBody)
+			}
+
+			var gotInfo admin.Info
+
+			if err := json.Unmarshal(recorder.Body.Bytes(), &gotInfo); err != nil || !reflect.DeepEqual(&gotInfo, info) {
+				t.Errorf("gotInfo: %+v, err: %v, wantInfo: %+v", gotInfo, err, info)
+			}
+		})
+	}
+}
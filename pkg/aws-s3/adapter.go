@@ -11,6 +11,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -52,7 +53,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	if err != nil {
 		// Fallback: try to unmarshal using the old pagination.CompositeCursor format.
 		// This handles cursors created before headers were cached in the cursor.
-		legacyCursor, legacyErr := pagination.UnmarshalCursor[int64](request.Cursor)
+		legacyCursor, legacyErr := pagination.UnmarshalCursor[int64](request.Cursor, request.Entity.ExternalId)
 		if legacyErr != nil || legacyCursor == nil {
 			// Both formats failed, return the original error.
 			return framework.NewGetPageResponseError(err)
@@ -66,9 +67,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		}
 	}
 
-	if request.Config.FileType == nil {
-		request.Config.FileType = &DefaultFileType
-	}
+	// The dialect is validated with the config.
+	dialect, _ := request.Config.CSVDialect()
 
 	awsReq := &Request{
 		Auth: Auth{
@@ -78,8 +78,10 @@ func (a *Adapter) RequestPageFromDatasource(
 		},
 		Bucket:                request.Config.Bucket,
 		PathPrefix:            request.Config.Prefix,
-		FileType:              *request.Config.FileType,
-		PageSize:              request.PageSize,
+		FileType:              request.Config.FileTypeOrDefault(),
+		SchemaFiles:           request.Config.SchemaFiles,
+		Dialect:               dialect,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		Cursor:                cursor,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
@@ -97,6 +99,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
 		&request.Entity,
 		resp.Objects,
@@ -4,13 +4,9 @@ package awss3
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
-	"fmt"
 
 	framework "github.com/sgnl-ai/adapter-framework"
-	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
-	"go.uber.org/zap/zapcore"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
 )
 
 // Client is a client that allows querying the datasource which contains JSON objects.
@@ -30,80 +26,17 @@ type Auth struct {
 }
 
 // S3Cursor contains pagination state for S3 CSV files.
-// Headers are cached to avoid re-fetching on subsequent pages.
-type S3Cursor struct {
-	// Cursor is the byte position offset in the file where the next S3 fetch should start.
-	Cursor *int64 `json:"cursor,omitempty"`
-
-	// Headers contains the parsed CSV headers from the first page.
-	// Cached to avoid re-fetching headers on subsequent pages.
-	Headers []string `json:"headers,omitempty"`
-
-	// Remainder contains unprocessed bytes from the previous fetch.
-	// These bytes are prepended to the next S3 fetch to avoid data loss.
-	Remainder []byte `json:"remainder,omitempty"`
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler to control cursor logging.
-// Logs metadata (byte position, headers count, remainder length) without exposing actual data.
-func (c *S3Cursor) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	if c == nil {
-		return nil
-	}
-
-	if c.Cursor != nil {
-		enc.AddInt64("cursor", *c.Cursor)
-	}
-
-	enc.AddInt("headersCount", len(c.Headers))
-	enc.AddInt("remainderLength", len(c.Remainder))
-
-	return nil
-}
+type S3Cursor = filestream.Cursor
 
 // UnmarshalS3Cursor unmarshals the cursor from a base64 encoded JSON string.
 // Returns nil cursor if the input is empty.
 func UnmarshalS3Cursor(cursor string) (*S3Cursor, *framework.Error) {
-	if cursor == "" {
-		return nil, nil
-	}
-
-	cursorBytes, err := base64.StdEncoding.DecodeString(cursor)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to decode base64 cursor: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
-		}
-	}
-
-	unmarshaledCursor := &S3Cursor{}
-
-	unmarshalErr := json.Unmarshal(cursorBytes, unmarshaledCursor)
-	if unmarshalErr != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to unmarshal JSON cursor: %v.", unmarshalErr),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
-		}
-	}
-
-	return unmarshaledCursor, nil
+	return filestream.UnmarshalCursor(cursor)
 }
 
 // MarshalS3Cursor marshals the cursor into a base64 encoded JSON string.
 func MarshalS3Cursor(cursor *S3Cursor) (string, *framework.Error) {
-	if cursor == nil {
-		return "", nil
-	}
-
-	nextCursorBytes, marshalErr := json.Marshal(cursor)
-	if marshalErr != nil {
-		return "", &framework.Error{
-			Message: fmt.Sprintf("Failed to marshal cursor into JSON: %v.", marshalErr),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
-	return base64.StdEncoding.EncodeToString(nextCursorBytes), nil
+	return filestream.MarshalCursor(cursor)
 }
 
 // Request is a request to the datasource.
@@ -119,6 +52,12 @@ type Request struct {
 	// FileType is the extension of the files containing the entity data.
 	FileType string
 
+	// SchemaFiles is whether the schema of the entity file is read from its schema sidecar file.
+	SchemaFiles bool
+
+	// Dialect is the dialect of the CSV files.
+	Dialect filestream.CSVDialect
+
 	// PageSize is the maximum number of objects to return from the entity.
 	PageSize int64
 
@@ -7,29 +7,24 @@ import (
 	"errors"
 
 	"github.com/sgnl-ai/adapters/pkg/config"
-)
-
-var (
-	DefaultFileType    = FileTypeCSV
-	SupportedFileTypes = map[string]struct{}{FileTypeCSV: {}}
+	"github.com/sgnl-ai/adapters/pkg/filestream"
 )
 
 type Config struct {
 	// Common configuration
 	*config.CommonConfig
 
+	// File format configuration
+	filestream.FileConfig
+
 	// Region is the AWS region to query.
-	Region string `json:"region"`
+	Region string `json:"region" validate:"required"`
 
 	// Bucket is the AWS S3 bucket containing the files with entity data.
-	Bucket string `json:"bucket"`
+	Bucket string `json:"bucket" validate:"required"`
 
 	// Prefix is the prefix of the path containing the files with entity data.
 	Prefix string `json:"prefix"`
-
-	// FileType is the extension of the files containing the entity data.
-	// This defaults to "csv".
-	FileType *string `json:"fileType,omitempty"`
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
@@ -41,7 +36,7 @@ func (c *Config) Validate(_ context.Context) error {
 		return errors.New("the AWS Region is not set in the configuration")
 	case c.Bucket == "":
 		return errors.New("the request contains an empty AWS S3 bucket name in the configuration")
-	default:
-		return nil
 	}
+
+	return c.FileConfig.Validate()
 }
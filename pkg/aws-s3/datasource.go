@@ -3,14 +3,9 @@
 package awss3
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"errors"
-	"fmt"
-	"io"
 	"net/http"
-	"path/filepath"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -19,30 +14,30 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	framework "github.com/sgnl-ai/adapter-framework"
-	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
-	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
 )
 
-// BOM (Byte Order Mark) patterns for different encodings.
-var (
-	UTF8BOM    = []byte{0xEF, 0xBB, 0xBF}
-	UTF16LEBOM = []byte{0xFF, 0xFE}
-	UTF16BEBOM = []byte{0xFE, 0xFF}
-	UTF32LEBOM = []byte{0xFF, 0xFE, 0x00, 0x00}
-	UTF32BEBOM = []byte{0x00, 0x00, 0xFE, 0xFF}
-)
-
 type Datasource struct {
 	Client                   *http.Client
 	AWSConfig                *aws.Config
 	MaxCSVRowSizeBytes       int64
 	MaxBytesToProcessPerPage int64
+
+	// MaxConcurrentRangeReads is the maximum number of ranged GET requests issued in parallel
+	// to fetch the data of a single page. Values less than or equal to 1 disable parallel reads
+	// and the page data is fetched over a single connection.
+	MaxConcurrentRangeReads int
 }
 
 // NewClient returns a Client to query the datasource.
-func NewClient(client *http.Client, awsConfig *aws.Config, maxRowSizeBytes, maxPageSizeBytes int64) (Client, error) {
+func NewClient(
+	client *http.Client,
+	awsConfig *aws.Config,
+	maxRowSizeBytes, maxPageSizeBytes int64,
+	maxConcurrentRangeReads int,
+) (Client, error) {
 	if awsConfig == nil {
 		cfg, err := aws_config.LoadDefaultConfig(context.TODO())
 		if err != nil {
@@ -57,40 +52,10 @@ func NewClient(client *http.Client, awsConfig *aws.Config, maxRowSizeBytes, maxP
 		Client:                   client,
 		MaxCSVRowSizeBytes:       maxRowSizeBytes,
 		MaxBytesToProcessPerPage: maxPageSizeBytes,
+		MaxConcurrentRangeReads:  maxConcurrentRangeReads,
 	}, nil
 }
 
-func stripBOM(reader *bufio.Reader) (bomLength int, err error) {
-	peekedBytes, peekErr := reader.Peek(len(UTF32LEBOM))
-	if peekErr != nil && peekErr != io.EOF && peekErr != bufio.ErrBufferFull {
-		return 0, fmt.Errorf("error peeking for BOM: %w", peekErr)
-	}
-
-	identifiedBomLength := 0
-	if bytes.HasPrefix(peekedBytes, UTF32LEBOM) {
-		identifiedBomLength = len(UTF32LEBOM)
-	} else if bytes.HasPrefix(peekedBytes, UTF32BEBOM) {
-		identifiedBomLength = len(UTF32BEBOM)
-	} else if bytes.HasPrefix(peekedBytes, UTF8BOM) {
-		identifiedBomLength = len(UTF8BOM)
-	} else if bytes.HasPrefix(peekedBytes, UTF16LEBOM) {
-		identifiedBomLength = len(UTF16LEBOM)
-	} else if bytes.HasPrefix(peekedBytes, UTF16BEBOM) {
-		identifiedBomLength = len(UTF16BEBOM)
-	}
-
-	if identifiedBomLength > 0 {
-		_, discardErr := reader.Discard(identifiedBomLength)
-		if discardErr != nil {
-			return 0, fmt.Errorf("error discarding BOM (length %d): %w", identifiedBomLength, discardErr)
-		}
-
-		return identifiedBomLength, nil
-	}
-
-	return 0, nil
-}
-
 func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
 	logger := zaplogger.FromContext(ctx).With(
 		fields.RequestEntityExternalID(request.EntityExternalID),
@@ -99,8 +64,6 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 
 	logger.Info("Starting datasource request")
 
-	entityName := request.EntityExternalID
-
 	// Timeout API calls that take longer than the configured timeout.
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
 	defer cancel()
@@ -113,181 +76,37 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 
 	handler := &S3Handler{Client: s3.NewFromConfig(awsConfig)}
 
-	// Create the object key using entity name and path prefix
-	objectKey := GetObjectKeyFromRequest(request)
-
-	fileSize, err := handler.GetFileSize(ctx, request.Bucket, objectKey)
-	if err != nil {
-		return nil, customerror.UpdateError(&framework.Error{
-			Message: fmt.Sprintf("Failed to fetch entity from AWS S3: %s, error: %v.", entityName, err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}, customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds))
-	}
-
-	if fileSize == 0 {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("The file for entity %s is empty.", entityName),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
-		}
-	}
-
-	var (
-		parsedHeaders []string
-		startBytePos  int64
-	)
-
-	// For the first page or old cursor format without headers, two S3 requests are made:
-	// 1. Fetch the headers (the first line of the file) to determine the CSV column names.
-	// 2. Fetch the actual data starting from the byte position after the headers.
-	// For subsequent pages, only one S3 request is made to fetch the data starting from
-	// the byte position indicated by the cursor.
-
-	// Step 1: Get headers - either from cursor cache or fetch from S3.
-	if request.Cursor != nil && len(request.Cursor.Headers) > 0 {
-		// Use cached headers from cursor - skip S3 header fetch.
-		parsedHeaders = request.Cursor.Headers
-	} else {
-		// Fetch headers from S3 (first page or old cursor format without headers).
-		// Use a bounded range for the header fetch to avoid S3 streaming the entire file.
-		// Without a Range header, S3 starts streaming the full file, and even though we only
-		// read the header line before closing, TCP buffering causes significant data transfer.
-		// We need enough bytes to read the BOM (up to 4 bytes) and the header row, so we use
-		// 2x MaxCSVRowSizeBytes as a safe buffer that's consistent with the data fetch approach.
-		headerRangeHeader := fmt.Sprintf("bytes=0-%d", (2*d.MaxCSVRowSizeBytes)-1)
-
-		s3HeaderStreamOutput, err := handler.GetObjectStream(ctx, request.Bucket, objectKey, &headerRangeHeader)
-		if err != nil {
-			return nil, customerror.UpdateError(&framework.Error{
-				Message: fmt.Sprintf("Failed to fetch entity from AWS S3: %s, error: %v.", entityName, err),
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-			}, customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds))
-		}
-		defer s3HeaderStreamOutput.Body.Close()
-
-		headerBufReader := bufio.NewReader(s3HeaderStreamOutput.Body)
-
-		bomLength, bomErr := stripBOM(headerBufReader)
-		if bomErr != nil {
-			return nil, customerror.UpdateError(&framework.Error{
-				Message: fmt.Sprintf("Failed to fetch entity from AWS S3: %s, error processing BOM: %v", entityName, bomErr),
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-			}, customerror.WithRequestTimeoutMessage(bomErr, request.RequestTimeoutSeconds))
-		}
-
-		var bytesReadForHeaderLine int64
-
-		parsedHeaders, bytesReadForHeaderLine, err = CSVHeaders(headerBufReader, d.MaxCSVRowSizeBytes)
-		if err != nil {
-			return nil, customerror.UpdateError(&framework.Error{
-				Message: fmt.Sprintf("Unable to parse CSV file headers: %v", err),
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-			}, customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds))
-		}
-
-		s3HeaderStreamOutput.Body.Close()
-
-		// Default start position is after headers (for first page).
-		startBytePos = int64(bomLength) + bytesReadForHeaderLine
+	reader := &filestream.Reader{
+		StoreName:                "AWS S3",
+		MaxCSVRowSizeBytes:       d.MaxCSVRowSizeBytes,
+		MaxBytesToProcessPerPage: d.MaxBytesToProcessPerPage,
+		MaxConcurrentRangeReads:  d.MaxConcurrentRangeReads,
 	}
 
-	// Step 2: Override start position if cursor has one (for pagination).
-	// This handles both new cursor format (with headers) and old cursor format (without headers).
-	if request.Cursor != nil && request.Cursor.Cursor != nil {
-		startBytePos = *request.Cursor.Cursor
+	streamRequest := &filestream.Request{
+		Key:                   GetObjectKeyFromRequest(request),
+		FileType:              request.FileType,
+		Dialect:               request.Dialect,
+		EntityExternalID:      request.EntityExternalID,
+		PageSize:              request.PageSize,
+		Cursor:                request.Cursor,
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		AttributeConfig:       request.AttributeConfig,
 	}
 
-	// Step 3: Get remainder from cursor (unprocessed bytes from previous fetch).
-	var remainder []byte
-	if request.Cursor != nil && len(request.Cursor.Remainder) > 0 {
-		remainder = request.Cursor.Remainder
-	}
-
-	// Step 4: Fetch data from S3 if needed.
-	var s3FetchedData []byte
-
-	if startBytePos < fileSize {
-		// Calculate how much to fetch from S3 to bring total buffer to MaxBytesToProcessPerPage.
-		remainderSize := int64(len(remainder))
-		fetchSize := d.MaxBytesToProcessPerPage - remainderSize
-
-		if fetchSize > 0 {
-			endBytePos := startBytePos + fetchSize - 1
-			if endBytePos >= fileSize {
-				endBytePos = fileSize - 1
-			}
-
-			rangeHeaderForData := fmt.Sprintf("bytes=%d-%d", startBytePos, endBytePos)
-
-			s3DataStreamOutput, err := handler.GetObjectStream(ctx, request.Bucket, objectKey, &rangeHeaderForData)
-			if err != nil {
-				return nil, customerror.UpdateError(&framework.Error{
-					Message: fmt.Sprintf("Failed to fetch entity from AWS S3: %v", err),
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}, customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds))
-			}
-			defer s3DataStreamOutput.Body.Close()
-
-			// Read all fetched data into memory.
-			s3FetchedData, err = io.ReadAll(s3DataStreamOutput.Body)
-			if err != nil {
-				return nil, customerror.UpdateError(&framework.Error{
-					Message: fmt.Sprintf("Failed to read data from AWS S3: %v", err),
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}, customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds))
-			}
-		}
+	if request.SchemaFiles {
+		streamRequest.SchemaKey = filestream.SchemaKey(request.PathPrefix, request.EntityExternalID)
 	}
 
-	// Step 5: Combine remainder + newly fetched data into a single buffer.
-	combinedData := append(remainder, s3FetchedData...)
-
-	// If there's no data to process, return an empty response.
-	if len(combinedData) == 0 {
-		return &Response{
-			StatusCode: 200,
-			Objects:    []map[string]any{},
-		}, nil
-	}
-
-	// Create a reader from the combined buffer for CSV processing.
-	dataBufReader := bufio.NewReader(bytes.NewReader(combinedData))
-
-	objects, bytesConsumed, _, processErr := StreamingCSVToPage(
-		dataBufReader,
-		parsedHeaders,
-		request.PageSize,
-		request.AttributeConfig,
-		d.MaxBytesToProcessPerPage,
-		d.MaxCSVRowSizeBytes,
-	)
-	if processErr != nil {
-		return nil, customerror.UpdateError(&framework.Error{
-			Message: fmt.Sprintf("Failed to fetch entity from AWS S3: %s, error: %v.", entityName, processErr),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}, customerror.WithRequestTimeoutMessage(processErr, request.RequestTimeoutSeconds))
+	page, err := reader.GetPage(ctx, &s3Store{handler: handler, bucket: request.Bucket}, streamRequest)
+	if err != nil {
+		return nil, err
 	}
 
 	response := &Response{
 		StatusCode: 200,
-		Objects:    objects,
-	}
-
-	// Step 6: Calculate new remainder (unprocessed bytes from combined buffer).
-	var newRemainder []byte
-	if bytesConsumed < int64(len(combinedData)) {
-		newRemainder = combinedData[bytesConsumed:]
-	}
-
-	// Step 7: Build next cursor if there's more data to process.
-	// More data exists if: there's remainder OR we haven't reached EOF in S3.
-	nextBytePos := startBytePos + int64(len(s3FetchedData))
-
-	if len(newRemainder) > 0 || nextBytePos < fileSize {
-		response.NextCursor = &S3Cursor{
-			Cursor:    &nextBytePos,
-			Headers:   parsedHeaders,
-			Remainder: newRemainder,
-		}
+		Objects:    page.Objects,
+		NextCursor: page.NextCursor,
 	}
 
 	logger.Info("Datasource request completed successfully",
@@ -311,8 +130,5 @@ func httpResponseFromError(err error) (*awshttp.ResponseError, error) {
 }
 
 func GetObjectKeyFromRequest(request *Request) string {
-	return filepath.Join(
-		filepath.Clean(request.PathPrefix),
-		filepath.Clean(fmt.Sprintf("%s.%s", request.EntityExternalID, request.FileType)),
-	)
+	return filestream.ObjectKey(request.PathPrefix, request.EntityExternalID, request.FileType)
 }
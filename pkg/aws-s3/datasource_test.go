@@ -6,7 +6,9 @@ package awss3_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
@@ -327,6 +329,7 @@ func TestDatasource_GetPage(t *testing.T) {
 				currentMockS3Config,
 				MaxCSVRowSizeBytes,
 				MaxBytesToProcessPerPage,
+				1,
 			)
 			if err != nil {
 				t.Fatalf("Failed to create datasource: %v", err)
@@ -462,7 +465,7 @@ func TestS3RangeHeaders(t *testing.T) {
 	// validCSVData is ~1095 bytes, header is 121 bytes
 	maxRowSize := int64(300)      // Enough for CSV rows
 	maxBytesPerPage := int64(200) // Small so calculated end < file size
-	datasource, _ := s3_adapter.NewClient(http.DefaultClient, mockConfig, maxRowSize, maxBytesPerPage)
+	datasource, _ := s3_adapter.NewClient(http.DefaultClient, mockConfig, maxRowSize, maxBytesPerPage, 1)
 
 	startByte := int64(200)
 
@@ -517,7 +520,7 @@ func TestCursorWithHeadersSkipsHeaderFetch(t *testing.T) {
 
 	maxRowSize := int64(300)
 	maxBytesPerPage := int64(200)
-	datasource, _ := s3_adapter.NewClient(http.DefaultClient, mockConfig, maxRowSize, maxBytesPerPage)
+	datasource, _ := s3_adapter.NewClient(http.DefaultClient, mockConfig, maxRowSize, maxBytesPerPage, 1)
 
 	startByte := int64(200)
 
@@ -566,7 +569,7 @@ func TestBackwardCompatibilityOldCursorFormat(t *testing.T) {
 
 	maxRowSize := int64(300)
 	maxBytesPerPage := int64(200)
-	datasource, _ := s3_adapter.NewClient(http.DefaultClient, mockConfig, maxRowSize, maxBytesPerPage)
+	datasource, _ := s3_adapter.NewClient(http.DefaultClient, mockConfig, maxRowSize, maxBytesPerPage, 1)
 
 	startByte := int64(200)
 
@@ -613,3 +616,149 @@ func TestBackwardCompatibilityOldCursorFormat(t *testing.T) {
 		t.Error("Expected NextCursor to include cached headers for subsequent requests")
 	}
 }
+
+// TestConcurrentRangeReads verifies that when MaxConcurrentRangeReads is greater than 1, the page
+// data is fetched with multiple contiguous ranged GET requests and produces the same page as a
+// single-connection read.
+func TestConcurrentRangeReads(t *testing.T) {
+	startByte := int64(validCSVDataHeaderLength)
+
+	newRequest := func() *s3_adapter.Request {
+		return &s3_adapter.Request{
+			Auth:                  s3_adapter.Auth{AccessKey: "key", SecretKey: "secret", Region: "us-west-1"},
+			Bucket:                "test-bucket",
+			PathPrefix:            "data",
+			FileType:              "csv",
+			EntityExternalID:      "customers",
+			PageSize:              3,
+			RequestTimeoutSeconds: 30,
+			Cursor: &s3_adapter.S3Cursor{
+				Cursor:  &startByte,
+				Headers: expectedCSVHeaders,
+			},
+			AttributeConfig: []*framework.AttributeConfig{
+				{ExternalId: "Email", Type: framework.AttributeTypeString, UniqueId: true},
+				{ExternalId: "Score", Type: framework.AttributeTypeDouble},
+			},
+		}
+	}
+
+	ctxWithLogger, _ := testutil.NewContextWithObservableLogger(context.Background())
+
+	// The page spans the rest of the file, as the mock serves ranges until the end of the file.
+	singleDatasource, _ := s3_adapter.NewClient(
+		http.DefaultClient, mockS3Config(http.StatusOK, http.StatusOK), 300, 2000, 1,
+	)
+
+	want, err := singleDatasource.GetPage(ctxWithLogger, newRequest())
+	if err != nil {
+		t.Fatalf("Unexpected error for single-connection read: %v", err)
+	}
+
+	mockConfig, tracker := newRangeTrackingConfig(http.StatusOK, http.StatusOK)
+
+	concurrentDatasource, _ := s3_adapter.NewClient(http.DefaultClient, mockConfig, 300, 2000, 4)
+
+	got, err := concurrentDatasource.GetPage(ctxWithLogger, newRequest())
+	if err != nil {
+		t.Fatalf("Unexpected error for concurrent read: %v", err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Response mismatch between single and concurrent reads (-want +got):\n%s", diff)
+	}
+
+	// The fetched range is bytes=121-1340 (1220 bytes), split into 4 parts of 305 bytes.
+	wantRanges := []string{
+		"bytes=121-425",
+		"bytes=426-730",
+		"bytes=731-1035",
+		"bytes=1036-1340",
+	}
+
+	gotRanges := append([]string(nil), tracker.CapturedRanges...)
+	sort.Slice(gotRanges, func(i, j int) bool {
+		return rangeStart(gotRanges[i]) < rangeStart(gotRanges[j])
+	})
+
+	if diff := cmp.Diff(wantRanges, gotRanges); diff != "" {
+		t.Errorf("Range mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConcurrentRangeReadsError(t *testing.T) {
+	startByte := int64(validCSVDataHeaderLength)
+
+	datasource, _ := s3_adapter.NewClient(
+		http.DefaultClient, mockS3Config(http.StatusOK, http.StatusForbidden), 300, 1000, 4,
+	)
+
+	request := &s3_adapter.Request{
+		Auth:                  s3_adapter.Auth{AccessKey: "key", SecretKey: "secret", Region: "us-west-1"},
+		Bucket:                "test-bucket",
+		FileType:              "csv",
+		EntityExternalID:      "customers",
+		PageSize:              3,
+		RequestTimeoutSeconds: 30,
+		Cursor: &s3_adapter.S3Cursor{
+			Cursor:  &startByte,
+			Headers: expectedCSVHeaders,
+		},
+	}
+
+	ctxWithLogger, _ := testutil.NewContextWithObservableLogger(context.Background())
+
+	_, err := datasource.GetPage(ctxWithLogger, request)
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+
+	if err.Code != api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL {
+		t.Errorf("Expected error code %v, got %v", api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL, err.Code)
+	}
+
+	if !strings.Contains(err.Message, "access denied") {
+		t.Errorf("Expected error message to contain the S3 error, got %q", err.Message)
+	}
+}
+
+func rangeStart(rangeHeader string) int64 {
+	start, _ := strconv.ParseInt(strings.SplitN(strings.TrimPrefix(rangeHeader, "bytes="), "-", 2)[0], 10, 64)
+
+	return start
+}
+
+func BenchmarkGetPageRangeReads(b *testing.B) {
+	for _, concurrency := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("concurrency_%d", concurrency), func(b *testing.B) {
+			datasource, _ := s3_adapter.NewClient(
+				http.DefaultClient,
+				mockS3Config(largeFileHeaderIndicatorCode, largeCSVFileCode),
+				MaxCSVRowSizeBytes,
+				MaxBytesToProcessPerPage,
+				concurrency,
+			)
+
+			ctxWithLogger, _ := testutil.NewContextWithObservableLogger(context.Background())
+			startByte := int64(0)
+
+			for b.Loop() {
+				_, err := datasource.GetPage(ctxWithLogger, &s3_adapter.Request{
+					Auth:                  s3_adapter.Auth{AccessKey: "key", SecretKey: "secret", Region: "us-west-1"},
+					Bucket:                "test-bucket",
+					FileType:              "csv",
+					EntityExternalID:      "customers",
+					PageSize:              1000,
+					RequestTimeoutSeconds: 30,
+					Cursor: &s3_adapter.S3Cursor{
+						Cursor:  &startByte,
+						Headers: expectedCSVHeaders,
+					},
+				})
+				if err != nil {
+					b.Fatalf("Unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -27,6 +28,10 @@ const (
 	largeFileHeaderIndicatorCode = -301
 )
 
+// largeCSVData caches the generated large CSV file so that concurrent and repeated
+// GetObject calls do not regenerate it.
+var largeCSVData = sync.OnceValue(generateLargeCSVData)
+
 type mockS3Middleware struct {
 	headStatusCode int
 	getStatusCode  int
@@ -160,7 +165,7 @@ func (m *mockS3Middleware) mockGetObject(
 	case headersOnlyCSVFileCode:
 		fullDataString = headersOnlyCSVData
 	case largeCSVFileCode:
-		fullDataString = generateLargeCSVData()
+		fullDataString = largeCSVData()
 	case -200:
 		fullDataString = corruptCSVData
 	case http.StatusOK:
@@ -226,8 +231,10 @@ func mockS3Config(headStatusCode, getStatusCode int) *aws.Config {
 }
 
 // rangeTrackingMiddleware wraps mockS3Middleware and captures range headers for testing.
+// Ranges are captured under a mutex as GetObject calls may be issued concurrently.
 type rangeTrackingMiddleware struct {
 	mockS3Middleware
+	mu             sync.Mutex
 	CapturedRanges []string
 }
 
@@ -246,7 +253,9 @@ func (m *rangeTrackingMiddleware) HandleSerialize(
 			rangeHeader = *getObjectInput.Range
 		}
 
+		m.mu.Lock()
 		m.CapturedRanges = append(m.CapturedRanges, rangeHeader)
+		m.mu.Unlock()
 	}
 
 	return m.mockS3Middleware.HandleSerialize(ctx, in, next)
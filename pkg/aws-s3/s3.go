@@ -5,7 +5,9 @@ package awss3
 import (
 	"context"
 	"fmt"
+	"io"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
@@ -73,3 +75,30 @@ func (s *S3Handler) GetFileSize(ctx context.Context, bucket string, key string)
 
 	return *response.ContentLength, nil
 }
+
+// s3Store implements filestream.Store for the objects of an AWS S3 bucket.
+type s3Store struct {
+	handler *S3Handler
+	bucket  string
+}
+
+func (s *s3Store) Size(ctx context.Context, key string) (int64, error) {
+	return s.handler.GetFileSize(ctx, s.bucket, key)
+}
+
+func (s *s3Store) Read(ctx context.Context, key string, start, end int64) (io.ReadCloser, error) {
+	var rangeHeader *string
+
+	if end >= 0 {
+		rangeHeader = aws.String(fmt.Sprintf("bytes=%d-%d", start, end))
+	} else if start > 0 {
+		rangeHeader = aws.String(fmt.Sprintf("bytes=%d-", start))
+	}
+
+	output, err := s.handler.GetObjectStream(ctx, s.bucket, key, rangeHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Body, nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+
+package awss3_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	s3_adapter "github.com/sgnl-ai/adapters/pkg/aws-s3"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+const (
+	validSchema = `{"columns": [{"name": "id"}, {"name": "name", "type": "string"}, {"name": "age", "type": "int64"}]}`
+
+	headerlessCSVData = "\xEF\xBB\xBF1,Alice,30\n2,Bob,40\n3,Carol,50\n"
+)
+
+// mockS3FilesMiddleware serves the objects of a bucket by key.
+type mockS3FilesMiddleware struct {
+	files map[string]string
+}
+
+func (m *mockS3FilesMiddleware) ID() string {
+	return "MockS3FilesMiddleware"
+}
+
+func (m *mockS3FilesMiddleware) HandleSerialize(
+	ctx context.Context,
+	in middleware.SerializeInput,
+	next middleware.SerializeHandler,
+) (
+	out middleware.SerializeOutput,
+	metadata middleware.Metadata,
+	err error,
+) {
+	notFound := &smithyhttp.ResponseError{
+		Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+		Err:      errors.New("no such key: The specified key does not exist"),
+	}
+
+	switch params := in.Parameters.(type) {
+	case *s3.HeadObjectInput:
+		data, found := m.files[*params.Key]
+		if !found {
+			return out, metadata, notFound
+		}
+
+		out.Result = &s3.HeadObjectOutput{ContentLength: aws.Int64(int64(len(data)))}
+	case *s3.GetObjectInput:
+		data, found := m.files[*params.Key]
+		if !found {
+			return out, metadata, notFound
+		}
+
+		if params.Range != nil {
+			start := min(rangeStart(*params.Range), int64(len(data)))
+			end := min(rangeEnd(*params.Range)+1, int64(len(data)))
+			data = data[start:end]
+		}
+
+		out.Result = &s3.GetObjectOutput{
+			Body:          io.NopCloser(strings.NewReader(data)),
+			ContentLength: aws.Int64(int64(len(data))),
+		}
+	default:
+		return next.HandleSerialize(ctx, in)
+	}
+
+	return out, metadata, nil
+}
+
+func rangeEnd(rangeHeader string) int64 {
+	parts := strings.SplitN(strings.TrimPrefix(rangeHeader, "bytes="), "-", 2)
+	if len(parts) < 2 {
+		return 0
+	}
+
+	end, _ := strconv.ParseInt(parts[1], 10, 64)
+
+	return end
+}
+
+func mockS3FilesConfig(files map[string]string) *aws.Config {
+	return &aws.Config{
+		Region: "us-west-2",
+		APIOptions: []func(*middleware.Stack) error{
+			func(s *middleware.Stack) error {
+				return s.Serialize.Add(&mockS3FilesMiddleware{files: files}, middleware.After)
+			},
+		},
+	}
+}
+
+func TestDatasourceGetPageWithSchema(t *testing.T) {
+	attributes := []*framework.AttributeConfig{
+		{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+		{ExternalId: "age", Type: framework.AttributeTypeInt64},
+	}
+
+	tests := map[string]struct {
+		files        map[string]string
+		attributes   []*framework.AttributeConfig
+		cursor       *s3_adapter.S3Cursor
+		wantResponse *s3_adapter.Response
+		wantErr      *framework.Error
+	}{
+		"headerless_first_page": {
+			files: map[string]string{
+				"data/users.csv":         headerlessCSVData,
+				"data/users.schema.json": validSchema,
+			},
+			attributes: attributes,
+			wantResponse: &s3_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{"id": "1", "name": "Alice", "age": float64(30)},
+					{"id": "2", "name": "Bob", "age": float64(40)},
+				},
+				NextCursor: &s3_adapter.S3Cursor{
+					Cursor:    testutil.GenPtr(int64(len(headerlessCSVData))),
+					Headers:   []string{"id", "name", "age"},
+					Remainder: []byte("3,Carol,50\n"),
+				},
+			},
+		},
+		"headerless_next_page_uses_cached_headers": {
+			files: map[string]string{
+				"data/users.csv": headerlessCSVData,
+			},
+			attributes: attributes,
+			cursor: &s3_adapter.S3Cursor{
+				Cursor:    testutil.GenPtr(int64(len(headerlessCSVData))),
+				Headers:   []string{"id", "name", "age"},
+				Remainder: []byte("3,Carol,50\n"),
+			},
+			wantResponse: &s3_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{"id": "3", "name": "Carol", "age": float64(50)},
+				},
+			},
+		},
+		"header_matching_schema": {
+			files: map[string]string{
+				"data/users.csv": "id,name,age\n1,Alice,30\n",
+				"data/users.schema.json": `{"hasHeader": true, "columns": [` +
+					`{"name": "id"}, {"name": "name"}, {"name": "age", "type": "int64"}]}`,
+			},
+			attributes: attributes,
+			wantResponse: &s3_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{"id": "1", "name": "Alice", "age": float64(30)},
+				},
+			},
+		},
+		"header_not_matching_schema": {
+			files: map[string]string{
+				"data/users.csv": "id,full_name,age\n1,Alice,30\n",
+				"data/users.schema.json": `{"hasHeader": true, "columns": [` +
+					`{"name": "id"}, {"name": "name"}, {"name": "age", "type": "int64"}]}`,
+			},
+			attributes: attributes,
+			wantErr: &framework.Error{
+				Message: "The CSV file headers of entity users don't match its schema file data/users.schema.json: " +
+					`column 2 of the header is "full_name", but the schema defines "name".`,
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+		"attribute_not_in_schema": {
+			files: map[string]string{
+				"data/users.csv":         headerlessCSVData,
+				"data/users.schema.json": validSchema,
+			},
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+				{ExternalId: "email", Type: framework.AttributeTypeString},
+			},
+			wantErr: &framework.Error{
+				Message: "The requested attributes of entity users don't match its schema file " +
+					`data/users.schema.json: attribute "email" is not a column of the schema.`,
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_schema": {
+			files: map[string]string{
+				"data/users.csv":         headerlessCSVData,
+				"data/users.schema.json": `{"columns": []}`,
+			},
+			attributes: attributes,
+			wantErr: &framework.Error{
+				Message: "The schema file data/users.schema.json is invalid: no columns are defined.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			datasource, err := s3_adapter.NewClient(
+				http.DefaultClient, mockS3FilesConfig(tt.files), MaxCSVRowSizeBytes, MaxBytesToProcessPerPage, 1,
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			ctxWithLogger, _ := testutil.NewContextWithObservableLogger(context.Background())
+
+			gotResponse, gotErr := datasource.GetPage(ctxWithLogger, &s3_adapter.Request{
+				Auth:                  s3_adapter.Auth{AccessKey: "key", SecretKey: "secret", Region: "us-west-1"},
+				Bucket:                "test-bucket",
+				PathPrefix:            "data",
+				FileType:              "csv",
+				SchemaFiles:           true,
+				EntityExternalID:      "users",
+				PageSize:              2,
+				RequestTimeoutSeconds: 30,
+				Cursor:                tt.cursor,
+				AttributeConfig:       tt.attributes,
+			})
+
+			if diff := cmp.Diff(tt.wantResponse, gotResponse); diff != "" {
+				t.Errorf("GetPage() response mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantErr, gotErr); diff != "" {
+				t.Errorf("GetPage() error mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
 )
 
 const (
@@ -61,7 +62,7 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 	}
 
 	if request.Config.FileType != nil {
-		if _, found := SupportedFileTypes[*request.Config.FileType]; !found {
+		if _, found := filestream.SupportedFileTypes[*request.Config.FileType]; !found {
 			return &framework.Error{
 				Message: fmt.Sprintf(
 					"The filetype %s in config.fileType is not supported.", *request.Config.FileType,
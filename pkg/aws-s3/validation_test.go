@@ -11,6 +11,7 @@ import (
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	s3_adapter "github.com/sgnl-ai/adapters/pkg/aws-s3"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
 	"github.com/sgnl-ai/adapters/pkg/testutil"
 )
 
@@ -93,6 +94,88 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"invalid_request_multi_character_delimiter": {
+			request: &framework.Request[s3_adapter.Config]{
+				Auth: validAuthCredentials,
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "Arn",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+				},
+				Config: &s3_adapter.Config{
+					Region: "us-west-1",
+					Bucket: "test-adapter-bucket",
+					FileConfig: filestream.FileConfig{
+						Delimiter: testutil.GenPtr("||"),
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "AWS config is invalid: the delimiter in the configuration is invalid: \"||\" is not a single ASCII character.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_delimiter_same_as_quote": {
+			request: &framework.Request[s3_adapter.Config]{
+				Auth: validAuthCredentials,
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "Arn",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+				},
+				Config: &s3_adapter.Config{
+					Region: "us-west-1",
+					Bucket: "test-adapter-bucket",
+					FileConfig: filestream.FileConfig{
+						Delimiter: testutil.GenPtr("'"),
+						QuoteChar: testutil.GenPtr("'"),
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "AWS config is invalid: the delimiter and the quote character in the configuration must be different.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_unsupported_charset": {
+			request: &framework.Request[s3_adapter.Config]{
+				Auth: validAuthCredentials,
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "Arn",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+				},
+				Config: &s3_adapter.Config{
+					Region: "us-west-1",
+					Bucket: "test-adapter-bucket",
+					FileConfig: filestream.FileConfig{
+						Charset: testutil.GenPtr("utf-32"),
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "AWS config is invalid: the charset utf-32 in the configuration is not supported.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_request_unsupported_file_type": {
 			request: &framework.Request[s3_adapter.Config]{
 				Auth: validAuthCredentials,
@@ -111,9 +194,11 @@ func TestValidateGetPageRequest(t *testing.T) {
 					},
 				},
 				Config: &s3_adapter.Config{
-					Region:   "us-west-2",
-					Bucket:   "bucket",
-					FileType: testutil.GenPtr("json"),
+					Region: "us-west-2",
+					Bucket: "bucket",
+					FileConfig: filestream.FileConfig{
+						FileType: testutil.GenPtr("json"),
+					},
 				},
 				Ordered:  false,
 				PageSize: 100,
@@ -12,6 +12,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -49,7 +50,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
 
 	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[string](request.Cursor)
+	cursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
@@ -72,7 +73,7 @@ func (a *Adapter) RequestPageFromDatasource(
 			SecretKey: request.Auth.Basic.Password,
 			Region:    request.Config.Region,
 		},
-		MaxItems:              int32(request.PageSize),
+		MaxItems:              int32(commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize)),
 		EntityExternalID:      request.Entity.ExternalId,
 		Cursor:                cursor,
 		AccountIDRequested:    accountIDRequested,
@@ -92,6 +93,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
 		&request.Entity,
 		resp.Objects,
@@ -406,6 +406,50 @@ func TestAdapterGetUserPage(t *testing.T) {
 				Cursor: testutil.GenPtr("4"),
 			},
 		},
+		"valid_request_with_tags": {
+			ctx: context.Background(),
+			request: &framework.Request[aws_adapter.Config]{
+				Auth:   validAuthCredentials,
+				Config: validCommonConfig,
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "Arn",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "Tag.owner",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "Tag.cost-center",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"Arn":             "arn:aws:iam::000000000000:user/user1",
+							"Tag.owner":       "alice",
+							"Tag.cost-center": "engineering",
+						},
+						{
+							"Arn": "arn:aws:iam::000000000000:user/user2",
+						},
+					},
+					NextCursor: "eyJjdXJzb3IiOiIyIn0=",
+				},
+			},
+			wantCursor: &pagination.CompositeCursor[string]{
+				Cursor: testutil.GenPtr("2"),
+			},
+		},
 		"valid_request_sdk_error": {
 			ctx: context.Background(),
 			request: &framework.Request[aws_adapter.Config]{
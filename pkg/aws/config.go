@@ -17,6 +17,7 @@ type ResourceAccount struct {
 // EntityConfig enables filtering of entities.
 type EntityConfig struct {
 	// PathPrefix is the path prefix to filter the entities.
+	// Not supported by the IdentityProvider entity and the Organizations entities.
 	PathPrefix *string `json:"pathPrefix,omitempty"`
 }
 
@@ -38,12 +39,15 @@ type Config struct {
 	*config.CommonConfig
 
 	// Region is the AWS region to query.
-	Region string `json:"region"`
+	Region string `json:"region" validate:"required"`
 
 	// EntityConfig is a map containing the config required for each entity associated with this
 	EntityConfig map[string]*EntityConfig `json:"entityConfig,omitempty"`
 
 	// ResourceAccountRoles is a list of roleARNs.
+	// The Organizations entities (ServiceControlPolicy, OrganizationalUnit and ServiceControlPolicyAttachment)
+	// are not queried from the resource accounts, but with the configured credentials, which must belong to
+	// the management account of the organization or to a delegated administrator account.
 	ResourceAccountRoles []string `json:"resourceAccountRoles,omitempty"`
 }
 
@@ -19,6 +19,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizations_types "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	framework "github.com/sgnl-ai/adapter-framework"
@@ -40,12 +42,24 @@ const (
 	UserPolicy       string = "UserPolicy"
 	GroupPolicy      string = "GroupPolicy"
 
+	// Organizations entities.
+	ServiceControlPolicy           string = "ServiceControlPolicy"
+	OrganizationalUnit             string = "OrganizationalUnit"
+	ServiceControlPolicyAttachment string = "ServiceControlPolicyAttachment"
+
 	unhandledStatusCode int    = -1
 	uniqueIDAttribute   string = "id"
 	UserID              string = "UserId"
 	PolicyArn           string = "PolicyArn"
 	GroupID             string = "GroupId"
 	AccountID           string = "AccountId"
+	PolicyID            string = "PolicyId"
+	TargetID            string = "TargetId"
+	TagsAttribute       string = "Tags"
+
+	// TagAttributePrefix is the prefix of the attributes expanded from the tags of an entity.
+	// Each tag is expanded into the attribute `Tag.<key>`, e.g. `Tag.owner`, holding the tag value.
+	TagAttributePrefix string = "Tag."
 
 	SessionName = "SGNLSession"
 )
@@ -155,6 +169,38 @@ var (
 				return &s
 			}(),
 		},
+		ServiceControlPolicy: {
+			Identifiers: &Identifiers{
+				ArnAttribute: "Arn",
+				UniqueName:   "Id",
+			},
+		},
+		OrganizationalUnit: {
+			Identifiers: &Identifiers{
+				ArnAttribute: "Arn",
+				UniqueName:   "Id",
+			},
+		},
+		ServiceControlPolicyAttachment: {
+			CollectionAttribute: func() *string {
+				s := "Id"
+
+				return &s
+			}(),
+			MemberOf: func() *string {
+				s := ServiceControlPolicy
+
+				return &s
+			}(),
+		},
+	}
+
+	// OrganizationsEntities is the set of entities queried from the Organizations API of the organization,
+	// rather than from the IAM API of each account.
+	OrganizationsEntities = map[string]struct{}{
+		ServiceControlPolicy:           {},
+		OrganizationalUnit:             {},
+		ServiceControlPolicyAttachment: {},
 	}
 )
 
@@ -224,11 +270,19 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
 	defer cancel()
 
-	iamClient, accountCursor, err := d.GetIamClient(ctx, request)
+	// [Organizations] The entities of the organization are queried once, with the configured credentials of the
+	// management account or of a delegated administrator account, instead of once per resource account.
+	if _, found := OrganizationsEntities[entityName]; found {
+		request.ResourceAccountRoles = nil
+	}
+
+	awsConfig, accountCursor, err := d.GetAWSConfig(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
+	iamClient := iam.NewFromConfig(awsConfig)
+
 	// [MemberEntities] For member entities, we need to set the `CollectionID` and `CollectionCursor`.
 	memberOf := ValidEntityExternalIDs[entityName].MemberOf
 	if memberOf != nil {
@@ -320,6 +374,17 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	case UserPolicy:
 		handler := &AttachedUserPoliciesHandler{Client: iamClient}
 		objects, statusCode, nextMarker, fetchErr = FetchEntities[types.AttachedPolicy](ctx, handler, opts)
+	case ServiceControlPolicy:
+		handler := &ServiceControlPolicyHandler{Client: organizations.NewFromConfig(awsConfig)}
+		objects, statusCode, nextMarker, fetchErr = FetchEntities[PolicyWithContent](ctx, handler, opts)
+	case OrganizationalUnit:
+		handler := &OrganizationalUnitHandler{Client: organizations.NewFromConfig(awsConfig)}
+		objects, statusCode, nextMarker, fetchErr = FetchEntities[OrganizationalUnitWithParent](ctx, handler, opts)
+	case ServiceControlPolicyAttachment:
+		handler := &ServiceControlPolicyAttachmentHandler{Client: organizations.NewFromConfig(awsConfig)}
+		objects, statusCode, nextMarker, fetchErr = FetchEntities[organizations_types.PolicyTargetSummary](
+			ctx, handler, opts,
+		)
 	default:
 		return nil, &framework.Error{
 			Message: fmt.Sprintf("Unsupported entity type: %s", entityName),
@@ -335,6 +400,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(fetchErr, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(fetchErr),
 		)
 	}
 
@@ -376,6 +442,10 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			memberUniqueIDAttribute = PolicyArn
 		case GroupMember:
 			memberUniqueIDAttribute = UserID
+		case ServiceControlPolicyAttachment:
+			// The attachment holds the ID of its policy as `PolicyId`, as it has no `Id` of its own.
+			memberUniqueIDAttribute = TargetID
+			memberOfUniqueIDAttribute = PolicyID
 		default:
 			return nil, &framework.Error{
 				Message: fmt.Sprintf(
@@ -572,6 +642,9 @@ func FetchEntities[T any](
 				return
 			}
 
+			// [User, Role, Policy] Expand the tags returned by the Get operation into attributes.
+			ExpandTags(object)
+
 			// If AccountId is requested, insert it into the map object.
 			if opts.AccountIDRequested {
 				if err := ArnToAccountID(&object, opts.EntityName); err != nil {
@@ -602,8 +675,8 @@ func FetchEntities[T any](
 		return nil, http.StatusInternalServerError, nil, err
 	}
 
-	// [IdentityProvider] No pagination support from the AWS side for this entity.
-	if opts.EntityName == IdentityProvider {
+	// [IdentityProvider, OrganizationalUnit] No pagination support from the AWS side for these entities.
+	if opts.EntityName == IdentityProvider || opts.EntityName == OrganizationalUnit {
 		var paginationErr *framework.Error
 
 		objects, nextMarker, paginationErr = pagination.PaginateObjects(
@@ -633,6 +706,32 @@ func EntityToObjects(entity interface{}) (map[string]interface{}, error) {
 	return object, nil
 }
 
+// ExpandTags adds each tag in the `Tags` list of the entity map as a top-level attribute, named by
+// TagAttributePrefix followed by the tag key, with the tag value. The tag key is used as is, so the name of
+// the attribute is stable across syncs and entities, e.g. the tag `{"Key": "owner", "Value": "alice"}`
+// is expanded into `"Tag.owner": "alice"`.
+// The `Tags` list is kept for backward compatibility. Malformed tags are skipped.
+func ExpandTags(entity map[string]interface{}) {
+	tags, ok := entity[TagsAttribute].([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, rawTag := range tags {
+		tag, ok := rawTag.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key, ok := tag["Key"].(string)
+		if !ok || key == "" {
+			continue
+		}
+
+		entity[TagAttributePrefix+key] = tag["Value"]
+	}
+}
+
 // arnToAccountId adds the AccountID to the entity map using Entity Arn.
 func ArnToAccountID(entity *map[string]interface{}, entityType string) error {
 	if entity == nil {
@@ -671,10 +770,10 @@ func statusCodeFromResponseError(err error) int {
 	return unhandledStatusCode
 }
 
-// GetIamClient returns an IAM client for the given request.
-// If resource accounts are provided, it assumes the role for the account and returns the client.
+// GetAWSConfig returns the AWS configuration to create the service clients for the given request.
+// If resource accounts are provided, it assumes the role for the account and returns its configuration.
 // nolint:lll
-func (d *Datasource) GetIamClient(ctx context.Context, request *Request) (*iam.Client, *AccountCursor, *framework.Error) {
+func (d *Datasource) GetAWSConfig(ctx context.Context, request *Request) (aws.Config, *AccountCursor, *framework.Error) {
 	// Deep copy of the AWS configuration object ensures that each request operates with
 	// its own independent configuration, preventing race conditions.
 	awsConfig := d.AWSConfig.Copy()
@@ -682,7 +781,7 @@ func (d *Datasource) GetIamClient(ctx context.Context, request *Request) (*iam.C
 	awsConfig.Region = request.Region
 
 	if len(request.ResourceAccountRoles) == 0 {
-		return iam.NewFromConfig(awsConfig), nil, nil
+		return awsConfig, nil, nil
 	}
 
 	var (
@@ -696,7 +795,7 @@ func (d *Datasource) GetIamClient(ctx context.Context, request *Request) (*iam.C
 		if request.Cursor.Cursor != nil {
 			accountCursor, decodeErr = decodeAccountCursor(*request.Cursor.Cursor)
 			if decodeErr != nil {
-				return nil, nil, &framework.Error{
+				return aws.Config{}, nil, &framework.Error{
 					Message: fmt.Sprintf("Error decoding cursor: %v", decodeErr),
 					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 				}
@@ -710,7 +809,7 @@ func (d *Datasource) GetIamClient(ctx context.Context, request *Request) (*iam.C
 		if request.Cursor.CollectionCursor != nil {
 			accountCursor, decodeErr = decodeAccountCursor(*request.Cursor.CollectionCursor)
 			if decodeErr != nil {
-				return nil, nil, &framework.Error{
+				return aws.Config{}, nil, &framework.Error{
 					Message: fmt.Sprintf("Error decoding collection cursor: %v", decodeErr),
 					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 				}
@@ -728,7 +827,7 @@ func (d *Datasource) GetIamClient(ctx context.Context, request *Request) (*iam.C
 		RoleSessionName: aws.String(fmt.Sprintf("%s-%d", SessionName, accountCursor.Offset)),
 	})
 	if err != nil {
-		return nil, nil, &framework.Error{
+		return aws.Config{}, nil, &framework.Error{
 			Message: fmt.Sprintf("Failed to assume role: %v", err),
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		}
@@ -742,7 +841,7 @@ func (d *Datasource) GetIamClient(ctx context.Context, request *Request) (*iam.C
 	)
 	configWithAssumedRole.Region = request.Region
 
-	return iam.NewFromConfig(configWithAssumedRole), accountCursor, nil
+	return configWithAssumedRole, accountCursor, nil
 }
 
 func decodeAccountCursor(cursor string) (*AccountCursor, error) {
@@ -91,3 +91,59 @@ func TestArnToAccountID(t *testing.T) {
 		assert.Contains(t, err.Error(), tc.ExpectedError)
 	}
 }
+
+func TestExpandTags(t *testing.T) {
+	testCases := []struct {
+		Entity         map[string]interface{}
+		ExpectedEntity map[string]interface{}
+	}{
+		{
+			Entity: map[string]interface{}{
+				"Arn": "arn:aws:iam::123456789012:user/test-user",
+				"Tags": []interface{}{
+					map[string]interface{}{"Key": "owner", "Value": "alice"},
+					map[string]interface{}{"Key": "aws:cloudformation:stack-name", "Value": "iam-stack"},
+					map[string]interface{}{"Key": "empty", "Value": ""},
+				},
+			},
+			ExpectedEntity: map[string]interface{}{
+				"Arn": "arn:aws:iam::123456789012:user/test-user",
+				"Tags": []interface{}{
+					map[string]interface{}{"Key": "owner", "Value": "alice"},
+					map[string]interface{}{"Key": "aws:cloudformation:stack-name", "Value": "iam-stack"},
+					map[string]interface{}{"Key": "empty", "Value": ""},
+				},
+				"Tag.owner":                         "alice",
+				"Tag.aws:cloudformation:stack-name": "iam-stack",
+				"Tag.empty":                         "",
+			},
+		},
+		{
+			Entity: map[string]interface{}{
+				"Arn": "arn:aws:iam::123456789012:role/test-role",
+			},
+			ExpectedEntity: map[string]interface{}{
+				"Arn": "arn:aws:iam::123456789012:role/test-role",
+			},
+		},
+		{
+			Entity: map[string]interface{}{
+				"Tags": []interface{}{
+					"owner",
+					map[string]interface{}{"Value": "alice"},
+				},
+			},
+			ExpectedEntity: map[string]interface{}{
+				"Tags": []interface{}{
+					"owner",
+					map[string]interface{}{"Value": "alice"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		aws_adapter.ExpandTags(tc.Entity)
+		assert.Equal(t, tc.ExpectedEntity, tc.Entity)
+	}
+}
@@ -75,16 +75,18 @@ var (
 
 func (h *UserHandler) List(ctx context.Context, opts *Options,
 ) ([]types.User, *string, error) {
-	output, err := h.Client.ListUsers(ctx, &iam.ListUsersInput{
-		MaxItems:   opts.MaxItems,
-		PathPrefix: opts.PathPrefix,
-		Marker:     opts.Marker,
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[types.User], error) {
+		output, err := h.Client.ListUsers(ctx, &iam.ListUsersInput{
+			MaxItems:   opts.MaxItems,
+			PathPrefix: opts.PathPrefix,
+			Marker:     opts.Marker,
+		})
+		if err != nil {
+			return listPage[types.User]{}, err
+		}
+
+		return iamListPage(output.Users, output.IsTruncated, output.Marker), nil
 	})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return output.Users, output.Marker, nil
 }
 
 func (h *UserHandler) Get(ctx context.Context, user types.User) (types.User, error) {
@@ -100,16 +102,18 @@ func (h *UserHandler) Get(ctx context.Context, user types.User) (types.User, err
 
 func (h *GroupHandler) List(ctx context.Context, opts *Options,
 ) ([]types.Group, *string, error) {
-	output, err := h.Client.ListGroups(ctx, &iam.ListGroupsInput{
-		MaxItems:   opts.MaxItems,
-		PathPrefix: opts.PathPrefix,
-		Marker:     opts.Marker,
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[types.Group], error) {
+		output, err := h.Client.ListGroups(ctx, &iam.ListGroupsInput{
+			MaxItems:   opts.MaxItems,
+			PathPrefix: opts.PathPrefix,
+			Marker:     opts.Marker,
+		})
+		if err != nil {
+			return listPage[types.Group]{}, err
+		}
+
+		return iamListPage(output.Groups, output.IsTruncated, output.Marker), nil
 	})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return output.Groups, output.Marker, nil
 }
 
 func (h *GroupHandler) Get(ctx context.Context, group types.Group,
@@ -126,16 +130,18 @@ func (h *GroupHandler) Get(ctx context.Context, group types.Group,
 
 func (h *RoleHandler) List(ctx context.Context, opts *Options,
 ) ([]types.Role, *string, error) {
-	output, err := h.Client.ListRoles(ctx, &iam.ListRolesInput{
-		MaxItems:   opts.MaxItems,
-		PathPrefix: opts.PathPrefix,
-		Marker:     opts.Marker,
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[types.Role], error) {
+		output, err := h.Client.ListRoles(ctx, &iam.ListRolesInput{
+			MaxItems:   opts.MaxItems,
+			PathPrefix: opts.PathPrefix,
+			Marker:     opts.Marker,
+		})
+		if err != nil {
+			return listPage[types.Role]{}, err
+		}
+
+		return iamListPage(output.Roles, output.IsTruncated, output.Marker), nil
 	})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return output.Roles, output.Marker, nil
 }
 
 func (h *RoleHandler) Get(ctx context.Context, role types.Role) (types.Role, error) {
@@ -151,16 +157,18 @@ func (h *RoleHandler) Get(ctx context.Context, role types.Role) (types.Role, err
 
 func (h *PolicyHandler) List(ctx context.Context, opts *Options,
 ) ([]types.Policy, *string, error) {
-	output, err := h.Client.ListPolicies(ctx, &iam.ListPoliciesInput{
-		MaxItems:   opts.MaxItems,
-		PathPrefix: opts.PathPrefix,
-		Marker:     opts.Marker,
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[types.Policy], error) {
+		output, err := h.Client.ListPolicies(ctx, &iam.ListPoliciesInput{
+			MaxItems:   opts.MaxItems,
+			PathPrefix: opts.PathPrefix,
+			Marker:     opts.Marker,
+		})
+		if err != nil {
+			return listPage[types.Policy]{}, err
+		}
+
+		return iamListPage(output.Policies, output.IsTruncated, output.Marker), nil
 	})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return output.Policies, output.Marker, nil
 }
 
 func (h *PolicyHandler) Get(ctx context.Context, policy types.Policy,
@@ -187,56 +195,64 @@ func (h *IDPHandler) List(ctx context.Context, _ *Options,
 
 func (h *AttachedGroupPoliciesHandler) List(ctx context.Context, opts *Options,
 ) ([]types.AttachedPolicy, *string, error) {
-	output, err := h.Client.ListAttachedGroupPolicies(ctx, &iam.ListAttachedGroupPoliciesInput{
-		GroupName: opts.UniqueName,
-		MaxItems:  opts.MaxItems,
-		Marker:    opts.Marker,
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[types.AttachedPolicy], error) {
+		output, err := h.Client.ListAttachedGroupPolicies(ctx, &iam.ListAttachedGroupPoliciesInput{
+			GroupName: opts.UniqueName,
+			MaxItems:  opts.MaxItems,
+			Marker:    opts.Marker,
+		})
+		if err != nil {
+			return listPage[types.AttachedPolicy]{}, err
+		}
+
+		return iamListPage(output.AttachedPolicies, output.IsTruncated, output.Marker), nil
 	})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return output.AttachedPolicies, output.Marker, nil
 }
 
 func (h *AttachedRolePoliciesHandler) List(ctx context.Context, opts *Options,
 ) ([]types.AttachedPolicy, *string, error) {
-	output, err := h.Client.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{
-		RoleName: opts.UniqueName,
-		MaxItems: opts.MaxItems,
-		Marker:   opts.Marker,
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[types.AttachedPolicy], error) {
+		output, err := h.Client.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{
+			RoleName: opts.UniqueName,
+			MaxItems: opts.MaxItems,
+			Marker:   opts.Marker,
+		})
+		if err != nil {
+			return listPage[types.AttachedPolicy]{}, err
+		}
+
+		return iamListPage(output.AttachedPolicies, output.IsTruncated, output.Marker), nil
 	})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return output.AttachedPolicies, output.Marker, nil
 }
 
 func (h *AttachedUserPoliciesHandler) List(ctx context.Context, opts *Options,
 ) ([]types.AttachedPolicy, *string, error) {
-	output, err := h.Client.ListAttachedUserPolicies(ctx, &iam.ListAttachedUserPoliciesInput{
-		UserName: opts.UniqueName,
-		MaxItems: opts.MaxItems,
-		Marker:   opts.Marker,
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[types.AttachedPolicy], error) {
+		output, err := h.Client.ListAttachedUserPolicies(ctx, &iam.ListAttachedUserPoliciesInput{
+			UserName: opts.UniqueName,
+			MaxItems: opts.MaxItems,
+			Marker:   opts.Marker,
+		})
+		if err != nil {
+			return listPage[types.AttachedPolicy]{}, err
+		}
+
+		return iamListPage(output.AttachedPolicies, output.IsTruncated, output.Marker), nil
 	})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return output.AttachedPolicies, output.Marker, nil
 }
 
 func (h *GroupMemberHandler) List(ctx context.Context, opts *Options,
 ) ([]types.User, *string, error) {
-	output, err := h.Client.GetGroup(ctx, &iam.GetGroupInput{
-		GroupName: opts.UniqueName,
-		MaxItems:  opts.MaxItems,
-		Marker:    opts.Marker,
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[types.User], error) {
+		output, err := h.Client.GetGroup(ctx, &iam.GetGroupInput{
+			GroupName: opts.UniqueName,
+			MaxItems:  opts.MaxItems,
+			Marker:    opts.Marker,
+		})
+		if err != nil {
+			return listPage[types.User]{}, err
+		}
+
+		return iamListPage(output.Users, output.IsTruncated, output.Marker), nil
 	})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return output.Users, output.Marker, nil
 }
@@ -24,7 +24,7 @@ var (
 	// SAML Providers, RolePolicies, GroupPolicies, UserPolicies and GroupMember along with their relationship.
 	//
 	// Users:
-	// There are 6 users (user1 through user6).
+	// There are 6 users (user1 through user6). User1 is tagged with owner and cost-center.
 	//
 	// Groups:
 	// There are 4 groups (Group1 through Group4).
@@ -56,6 +56,10 @@ var (
 			Path:             testutil.GenPtr("/"),
 			CreateDate:       aws.Time(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
 			PasswordLastUsed: aws.Time(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)),
+			Tags: []types.Tag{
+				{Key: testutil.GenPtr("owner"), Value: testutil.GenPtr("alice")},
+				{Key: testutil.GenPtr("cost-center"), Value: testutil.GenPtr("engineering")},
+			},
 		},
 		{
 			Arn:              testutil.GenPtr("arn:aws:iam::000000000000:user/user2"),
@@ -0,0 +1,172 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+// The Organizations API returns at most 20 results per page for the List operations.
+//
+// ref: https://docs.aws.amazon.com/organizations/latest/APIReference/API_ListPolicies.html
+const maxOrganizationsPageSize int32 = 20
+
+// PolicyWithContent is a policy of the organization, such as a service control policy (SCP), along with
+// its content.
+type PolicyWithContent struct {
+	types.PolicySummary
+
+	// Content is the JSON policy document of the policy.
+	Content *string
+}
+
+// OrganizationalUnitWithParent is an organizational unit (OU) of the organization, along with the ID of
+// its parent, either the root of the organization or another OU.
+type OrganizationalUnitWithParent struct {
+	types.OrganizationalUnit
+
+	// ParentID is the ID of the parent of the OU.
+	ParentID *string `json:"ParentId"`
+}
+
+// Implementation of EntityHandler for Organizations Service Control Policy.
+type ServiceControlPolicyHandler struct {
+	Client *organizations.Client
+}
+
+// Implementation of EntityHandler for Organizations Organizational Unit.
+type OrganizationalUnitHandler struct {
+	Client *organizations.Client
+}
+
+// Implementation of ServiceControlPolicyAttachment.
+type ServiceControlPolicyAttachmentHandler struct {
+	Client *organizations.Client
+}
+
+var (
+	// List + Get for Organizations entities.
+	_ EntityLister[PolicyWithContent] = (*ServiceControlPolicyHandler)(nil)
+	_ EntityGetter[PolicyWithContent] = (*ServiceControlPolicyHandler)(nil)
+
+	// List for Organizations entities.
+	_ EntityLister[OrganizationalUnitWithParent] = (*OrganizationalUnitHandler)(nil)
+	_ EntityLister[types.PolicyTargetSummary]    = (*ServiceControlPolicyAttachmentHandler)(nil)
+)
+
+// organizationsMaxResults caps the number of items requested from the Organizations API to its maximum page size.
+func organizationsMaxResults(maxItems *int32) *int32 {
+	if maxItems == nil || *maxItems <= maxOrganizationsPageSize {
+		return maxItems
+	}
+
+	maxResults := maxOrganizationsPageSize
+
+	return &maxResults
+}
+
+func (h *ServiceControlPolicyHandler) List(ctx context.Context, opts *Options,
+) ([]PolicyWithContent, *string, error) {
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[PolicyWithContent], error) {
+		output, err := h.Client.ListPolicies(ctx, &organizations.ListPoliciesInput{
+			Filter:     types.PolicyTypeServiceControlPolicy,
+			MaxResults: organizationsMaxResults(opts.MaxItems),
+			NextToken:  opts.Marker,
+		})
+		if err != nil {
+			return listPage[PolicyWithContent]{}, err
+		}
+
+		policies := make([]PolicyWithContent, 0, len(output.Policies))
+		for _, policy := range output.Policies {
+			policies = append(policies, PolicyWithContent{PolicySummary: policy})
+		}
+
+		return organizationsListPage(policies, output.NextToken), nil
+	})
+}
+
+func (h *ServiceControlPolicyHandler) Get(ctx context.Context, policy PolicyWithContent,
+) (PolicyWithContent, error) {
+	output, err := h.Client.DescribePolicy(ctx, &organizations.DescribePolicyInput{
+		PolicyId: policy.Id,
+	})
+	if err != nil {
+		return PolicyWithContent{}, err
+	}
+
+	if output.Policy.PolicySummary != nil {
+		policy.PolicySummary = *output.Policy.PolicySummary
+	}
+
+	policy.Content = output.Policy.Content
+
+	return policy, nil
+}
+
+// List returns all the OUs of the organization, walking the OU tree from each root, since the Organizations API
+// only lists the OUs of a single parent. The OUs are paginated by the caller.
+func (h *OrganizationalUnitHandler) List(ctx context.Context, _ *Options,
+) ([]OrganizationalUnitWithParent, *string, error) {
+	var (
+		units     []OrganizationalUnitWithParent
+		parentIDs []*string
+	)
+
+	roots := organizations.NewListRootsPaginator(h.Client, &organizations.ListRootsInput{})
+	for roots.HasMorePages() {
+		output, err := roots.NextPage(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, root := range output.Roots {
+			parentIDs = append(parentIDs, root.Id)
+		}
+	}
+
+	for len(parentIDs) > 0 {
+		parentID := parentIDs[0]
+		parentIDs = parentIDs[1:]
+
+		children := organizations.NewListOrganizationalUnitsForParentPaginator(
+			h.Client,
+			&organizations.ListOrganizationalUnitsForParentInput{
+				ParentId: parentID,
+			},
+		)
+
+		for children.HasMorePages() {
+			output, err := children.NextPage(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for _, unit := range output.OrganizationalUnits {
+				units = append(units, OrganizationalUnitWithParent{OrganizationalUnit: unit, ParentID: parentID})
+				parentIDs = append(parentIDs, unit.Id)
+			}
+		}
+	}
+
+	return units, nil, nil
+}
+
+func (h *ServiceControlPolicyAttachmentHandler) List(ctx context.Context, opts *Options,
+) ([]types.PolicyTargetSummary, *string, error) {
+	return listWithRetries(ctx, func(ctx context.Context) (listPage[types.PolicyTargetSummary], error) {
+		output, err := h.Client.ListTargetsForPolicy(ctx, &organizations.ListTargetsForPolicyInput{
+			PolicyId:   opts.UniqueName,
+			MaxResults: organizationsMaxResults(opts.MaxItems),
+			NextToken:  opts.Marker,
+		})
+		if err != nil {
+			return listPage[types.PolicyTargetSummary]{}, err
+		}
+
+		return organizationsListPage(output.Targets, output.NextToken), nil
+	})
+}
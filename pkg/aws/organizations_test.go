@@ -0,0 +1,364 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+
+package aws_test
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/smithy-go/middleware"
+	framework "github.com/sgnl-ai/adapter-framework"
+	aws_adapter "github.com/sgnl-ai/adapters/pkg/aws"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+var (
+	// Dummy Data Organizations Entities and Their Relationships
+	//
+	// Service Control Policies:
+	// There are 2 SCPs (p-FullAWSAccess and p-examplepolicy).
+	//
+	// Organizational Units:
+	// The root r-examplerootid has 1 OU (ou-engineering), which has 1 OU (ou-platform).
+	//
+	// Relationships:
+	// - p-FullAWSAccess is attached to: (r-examplerootid, 111111111111)
+	// - p-examplepolicy is attached to: (ou-engineering).
+	mockServiceControlPolicies = []types.Policy{
+		{
+			PolicySummary: &types.PolicySummary{
+				Arn:        testutil.GenPtr("arn:aws:organizations::aws:policy/service_control_policy/p-FullAWSAccess"),
+				Id:         testutil.GenPtr("p-FullAWSAccess"),
+				Name:       testutil.GenPtr("FullAWSAccess"),
+				AwsManaged: true,
+				Type:       types.PolicyTypeServiceControlPolicy,
+			},
+			Content: testutil.GenPtr(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`),
+		},
+		{
+			PolicySummary: &types.PolicySummary{
+				Arn:  testutil.GenPtr("arn:aws:organizations::000000000000:policy/o-exampleorgid/service_control_policy/p-examplepolicy"),
+				Id:   testutil.GenPtr("p-examplepolicy"),
+				Name: testutil.GenPtr("DenyLeaveOrganization"),
+				Type: types.PolicyTypeServiceControlPolicy,
+			},
+			Content: testutil.GenPtr(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"organizations:LeaveOrganization","Resource":"*"}]}`),
+		},
+	}
+
+	mockOrganizationalUnits = map[string][]types.OrganizationalUnit{
+		"r-examplerootid": {
+			{
+				Arn:  testutil.GenPtr("arn:aws:organizations::000000000000:ou/o-exampleorgid/ou-engineering"),
+				Id:   testutil.GenPtr("ou-engineering"),
+				Name: testutil.GenPtr("Engineering"),
+			},
+		},
+		"ou-engineering": {
+			{
+				Arn:  testutil.GenPtr("arn:aws:organizations::000000000000:ou/o-exampleorgid/ou-platform"),
+				Id:   testutil.GenPtr("ou-platform"),
+				Name: testutil.GenPtr("Platform"),
+			},
+		},
+	}
+
+	mockPolicyTargets = map[string][]types.PolicyTargetSummary{
+		"p-FullAWSAccess": {
+			{
+				Arn:      testutil.GenPtr("arn:aws:organizations::000000000000:root/o-exampleorgid/r-examplerootid"),
+				Name:     testutil.GenPtr("Root"),
+				TargetId: testutil.GenPtr("r-examplerootid"),
+				Type:     types.TargetTypeRoot,
+			},
+			{
+				Arn:      testutil.GenPtr("arn:aws:organizations::000000000000:account/o-exampleorgid/111111111111"),
+				Name:     testutil.GenPtr("Production"),
+				TargetId: testutil.GenPtr("111111111111"),
+				Type:     types.TargetTypeAccount,
+			},
+		},
+		"p-examplepolicy": {
+			{
+				Arn:      testutil.GenPtr("arn:aws:organizations::000000000000:ou/o-exampleorgid/ou-engineering"),
+				Name:     testutil.GenPtr("Engineering"),
+				TargetId: testutil.GenPtr("ou-engineering"),
+				Type:     types.TargetTypeOrganizationalUnit,
+			},
+		},
+	}
+)
+
+// OrganizationsMocker is a middleware that mocks the Organizations API calls by returning the mock data
+// before the request is sent.
+func OrganizationsMocker(stack *middleware.Stack) error {
+	return stack.Initialize.Add(
+		middleware.InitializeMiddlewareFunc(
+			"OrganizationsMocker",
+			func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+			) (middleware.InitializeOutput, middleware.Metadata, error) {
+				var result any
+
+				switch v := in.Parameters.(type) {
+				case *organizations.ListPoliciesInput:
+					policies, nextToken := paginate(mockServiceControlPolicies, v.NextToken, int(*v.MaxResults))
+
+					summaries := make([]types.PolicySummary, 0, len(policies))
+					for _, policy := range policies {
+						summaries = append(summaries, *policy.PolicySummary)
+					}
+
+					result = &organizations.ListPoliciesOutput{Policies: summaries, NextToken: nextToken}
+				case *organizations.DescribePolicyInput:
+					for _, policy := range mockServiceControlPolicies {
+						if *policy.PolicySummary.Id == *v.PolicyId {
+							result = &organizations.DescribePolicyOutput{Policy: &policy}
+						}
+					}
+				case *organizations.ListRootsInput:
+					result = &organizations.ListRootsOutput{
+						Roots: []types.Root{{Id: testutil.GenPtr("r-examplerootid")}},
+					}
+				case *organizations.ListOrganizationalUnitsForParentInput:
+					result = &organizations.ListOrganizationalUnitsForParentOutput{
+						OrganizationalUnits: mockOrganizationalUnits[*v.ParentId],
+					}
+				case *organizations.ListTargetsForPolicyInput:
+					targets, nextToken := paginate(mockPolicyTargets[*v.PolicyId], v.NextToken, int(*v.MaxResults))
+
+					result = &organizations.ListTargetsForPolicyOutput{Targets: targets, NextToken: nextToken}
+				default:
+					return next.HandleInitialize(ctx, in)
+				}
+
+				return middleware.InitializeOutput{Result: result}, middleware.Metadata{}, nil
+			},
+		),
+		middleware.Before,
+	)
+}
+
+func TestAdapterGetOrganizationsPage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Duration(defaultTimeout)*time.Second)
+	defer cancel()
+
+	cfg, err := SetupTestConfig(ctx, OrganizationsMocker)
+	if err != nil {
+		log.Fatalf("Failed to load aws test config: %v", err)
+	}
+
+	adapter, err := ProvideAWSTestClient(cfg)
+	if err != nil {
+		log.Fatalf("Failed to load aws test client: %v", err)
+	}
+
+	tests := map[string]struct {
+		request            *framework.Request[aws_adapter.Config]
+		inputRequestCursor *pagination.CompositeCursor[string]
+		wantResponse       framework.Response
+	}{
+		"service_control_policy_page_1": {
+			request: &framework.Request[aws_adapter.Config]{
+				Auth:   validAuthCredentials,
+				Config: validCommonConfigWithAccounts,
+				Entity: framework.EntityConfig{
+					ExternalId: "ServiceControlPolicy",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "Id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "Name",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "AwsManaged",
+							Type:       framework.AttributeTypeBool,
+						},
+						{
+							ExternalId: "Content",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"Id":         "p-FullAWSAccess",
+							"Name":       "FullAWSAccess",
+							"AwsManaged": true,
+							"Content":    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+						},
+					},
+					NextCursor: "eyJjdXJzb3IiOiIxIn0=",
+				},
+			},
+		},
+		"organizational_units": {
+			request: &framework.Request[aws_adapter.Config]{
+				Auth:   validAuthCredentials,
+				Config: validCommonConfig,
+				Entity: framework.EntityConfig{
+					ExternalId: "OrganizationalUnit",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "Id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "Name",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "ParentId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 10,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"Id":       "ou-engineering",
+							"Name":     "Engineering",
+							"ParentId": "r-examplerootid",
+						},
+						{
+							"Id":       "ou-platform",
+							"Name":     "Platform",
+							"ParentId": "ou-engineering",
+						},
+					},
+				},
+			},
+		},
+		"service_control_policy_attachments_of_policy_1": {
+			request: &framework.Request[aws_adapter.Config]{
+				Auth:   validAuthCredentials,
+				Config: validCommonConfig,
+				Entity: framework.EntityConfig{
+					ExternalId: "ServiceControlPolicyAttachment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "PolicyId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "TargetId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "Type",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 10,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":       "r-examplerootid-p-FullAWSAccess",
+							"PolicyId": "p-FullAWSAccess",
+							"TargetId": "r-examplerootid",
+							"Type":     "ROOT",
+						},
+						{
+							"id":       "111111111111-p-FullAWSAccess",
+							"PolicyId": "p-FullAWSAccess",
+							"TargetId": "111111111111",
+							"Type":     "ACCOUNT",
+						},
+					},
+					NextCursor: "eyJjb2xsZWN0aW9uSWQiOiJwLUZ1bGxBV1NBY2Nlc3MiLCJjb2xsZWN0aW9uQ3Vyc29yIjoiMSJ9",
+				},
+			},
+		},
+		"service_control_policy_attachments_of_policy_2": {
+			request: &framework.Request[aws_adapter.Config]{
+				Auth:   validAuthCredentials,
+				Config: validCommonConfig,
+				Entity: framework.EntityConfig{
+					ExternalId: "ServiceControlPolicyAttachment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "PolicyId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "TargetId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "Type",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 10,
+			},
+			inputRequestCursor: &pagination.CompositeCursor[string]{
+				CollectionID:     testutil.GenPtr("p-FullAWSAccess"),
+				CollectionCursor: testutil.GenPtr("1"),
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":       "ou-engineering-p-examplepolicy",
+							"PolicyId": "p-examplepolicy",
+							"TargetId": "ou-engineering",
+							"Type":     "ORGANIZATIONAL_UNIT",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tt.inputRequestCursor != nil {
+				encodedCursor, err := pagination.MarshalCursor(tt.inputRequestCursor)
+				if err != nil {
+					t.Error(err)
+				}
+
+				tt.request.Cursor = encodedCursor
+			}
+
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+		})
+	}
+}
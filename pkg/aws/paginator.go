@@ -0,0 +1,105 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+const (
+	// listPageMaxAttempts is the maximum number of attempts to request a page of a list API, on top of the
+	// retries of the SDK for each attempt.
+	listPageMaxAttempts = 3
+
+	// listPageRetryDelay is the delay before the second attempt to request a page of a list API. The delay is
+	// doubled for each subsequent attempt.
+	listPageRetryDelay = 100 * time.Millisecond
+)
+
+// errTruncatedWithoutMarker is returned when a list API returns a truncated page without the marker of the next
+// page. Ending the sync there would silently skip the remaining pages, so the page is requested again instead.
+var errTruncatedWithoutMarker = errors.New("the page is truncated but has no marker for the next page")
+
+// listPageRetryables are the errors of a list API that the page is requested again for: the throttling and
+// transient errors retried by the SDK, and truncated pages without a marker.
+var listPageRetryables = retry.IsErrorRetryables(append([]retry.IsErrorRetryable{
+	retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
+		if errors.Is(err, errTruncatedWithoutMarker) {
+			return aws.TrueTernary
+		}
+
+		return aws.UnknownTernary
+	}),
+}, retry.DefaultRetryables...))
+
+// listPage is a page of a list API, normalized across the IAM APIs, which are paginated by IsTruncated and Marker,
+// and the Organizations APIs, which are paginated by NextToken.
+type listPage[T any] struct {
+	items []T
+
+	// isTruncated is true if there are more items after this page.
+	isTruncated bool
+
+	// nextMarker is the Marker or NextToken to request the next page with.
+	nextMarker *string
+}
+
+// iamListPage returns the page of an IAM list API. IAM may return fewer items than MaxItems, or even none, before
+// the last page, so only IsTruncated tells whether there are more items.
+func iamListPage[T any](items []T, isTruncated bool, marker *string) listPage[T] {
+	return listPage[T]{
+		items:       items,
+		isTruncated: isTruncated || (marker != nil && *marker != ""),
+		nextMarker:  marker,
+	}
+}
+
+// organizationsListPage returns the page of an Organizations list API, which is truncated if it has a NextToken.
+func organizationsListPage[T any](items []T, nextToken *string) listPage[T] {
+	return listPage[T]{
+		items:       items,
+		isTruncated: nextToken != nil && *nextToken != "",
+		nextMarker:  nextToken,
+	}
+}
+
+// listWithRetries requests a page of a list API with fetchPage and returns its items and the marker of the next
+// page, or nil if this is the last page. The page is requested again on throttling and transient errors, and if
+// it is truncated without a marker for the next page, up to listPageMaxAttempts times.
+func listWithRetries[T any](
+	ctx context.Context, fetchPage func(ctx context.Context) (listPage[T], error),
+) ([]T, *string, error) {
+	delay := listPageRetryDelay
+
+	for attempt := 1; ; attempt++ {
+		page, err := fetchPage(ctx)
+		if err == nil {
+			if !page.isTruncated {
+				return page.items, nil, nil
+			}
+
+			if page.nextMarker != nil && *page.nextMarker != "" {
+				return page.items, page.nextMarker, nil
+			}
+
+			err = errTruncatedWithoutMarker
+		}
+
+		if attempt == listPageMaxAttempts || listPageRetryables.IsErrorRetryable(err) != aws.TrueTernary {
+			return nil, nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, err
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+	}
+}
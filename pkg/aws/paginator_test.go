@@ -0,0 +1,249 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+
+package aws_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsMiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizations_types "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	aws_adapter "github.com/sgnl-ai/adapters/pkg/aws"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// markerKey is the stack value key of the marker or token of a mocked request.
+type markerKey struct{}
+
+// truncationMocker returns a middleware mocking the list APIs with the responses of responder, called with the
+// operation name and the marker or token of each request, in order.
+func truncationMocker(
+	responder func(operationName string, marker *string) (any, error),
+) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		err := stack.Initialize.Add(
+			middleware.InitializeMiddlewareFunc(
+				"GetInputMarker",
+				func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+				) (middleware.InitializeOutput, middleware.Metadata, error) {
+					var marker *string
+
+					switch v := in.Parameters.(type) {
+					case *iam.ListRolesInput:
+						marker = v.Marker
+					case *organizations.ListTargetsForPolicyInput:
+						marker = v.NextToken
+					}
+
+					return next.HandleInitialize(middleware.WithStackValue(ctx, markerKey{}, marker), in)
+				},
+			),
+			middleware.Before,
+		)
+		if err != nil {
+			return err
+		}
+
+		return stack.Finalize.Add(
+			middleware.FinalizeMiddlewareFunc(
+				"TruncationMocker",
+				func(ctx context.Context, _ middleware.FinalizeInput, _ middleware.FinalizeHandler,
+				) (middleware.FinalizeOutput, middleware.Metadata, error) {
+					marker, _ := middleware.GetStackValue(ctx, markerKey{}).(*string)
+
+					result, err := responder(awsMiddleware.GetOperationName(ctx), marker)
+
+					return middleware.FinalizeOutput{Result: result}, middleware.Metadata{}, err
+				},
+			),
+			middleware.Before,
+		)
+	}
+}
+
+func TestListWithSimulatedTruncation(t *testing.T) {
+	roles := []types.Role{
+		{RoleName: testutil.GenPtr("role1")},
+		{RoleName: testutil.GenPtr("role2")},
+		{RoleName: testutil.GenPtr("role3")},
+		{RoleName: testutil.GenPtr("role4")},
+	}
+
+	tests := map[string]struct {
+		// responses are the responses of the successive requests of the sync.
+		responses []struct {
+			wantMarker *string
+			output     *iam.ListRolesOutput
+			err        error
+		}
+		wantRoles []types.Role
+		wantErr   bool
+	}{
+		"short_and_empty_truncated_pages": {
+			// IAM may return fewer items than MaxItems, or none, before the last page.
+			responses: []struct {
+				wantMarker *string
+				output     *iam.ListRolesOutput
+				err        error
+			}{
+				{nil, &iam.ListRolesOutput{Roles: roles[:1], IsTruncated: true, Marker: testutil.GenPtr("m1")}, nil},
+				{testutil.GenPtr("m1"), &iam.ListRolesOutput{IsTruncated: true, Marker: testutil.GenPtr("m2")}, nil},
+				{testutil.GenPtr("m2"), &iam.ListRolesOutput{Roles: roles[1:], IsTruncated: false}, nil},
+			},
+			wantRoles: roles,
+		},
+		"truncated_page_without_marker_is_requested_again": {
+			responses: []struct {
+				wantMarker *string
+				output     *iam.ListRolesOutput
+				err        error
+			}{
+				{nil, &iam.ListRolesOutput{Roles: roles[:2], IsTruncated: true}, nil},
+				{nil, &iam.ListRolesOutput{Roles: roles[:2], IsTruncated: true, Marker: testutil.GenPtr("")}, nil},
+				{nil, &iam.ListRolesOutput{Roles: roles[:2], IsTruncated: true, Marker: testutil.GenPtr("m2")}, nil},
+				{testutil.GenPtr("m2"), &iam.ListRolesOutput{Roles: roles[2:]}, nil},
+			},
+			wantRoles: roles,
+		},
+		"throttled_page_is_requested_again": {
+			responses: []struct {
+				wantMarker *string
+				output     *iam.ListRolesOutput
+				err        error
+			}{
+				{nil, &iam.ListRolesOutput{Roles: roles[:2], IsTruncated: true, Marker: testutil.GenPtr("m2")}, nil},
+				{testutil.GenPtr("m2"), nil, &smithy.GenericAPIError{Code: "Throttling", Message: "Rate exceeded"}},
+				{testutil.GenPtr("m2"), &iam.ListRolesOutput{Roles: roles[2:]}, nil},
+			},
+			wantRoles: roles,
+		},
+		"truncated_page_without_marker_fails_after_max_attempts": {
+			responses: []struct {
+				wantMarker *string
+				output     *iam.ListRolesOutput
+				err        error
+			}{
+				{nil, &iam.ListRolesOutput{Roles: roles[:2], IsTruncated: true}, nil},
+				{nil, &iam.ListRolesOutput{Roles: roles[:2], IsTruncated: true}, nil},
+				{nil, &iam.ListRolesOutput{Roles: roles[:2], IsTruncated: true}, nil},
+			},
+			wantErr: true,
+		},
+		"non_retryable_error": {
+			responses: []struct {
+				wantMarker *string
+				output     *iam.ListRolesOutput
+				err        error
+			}{
+				{nil, nil, &smithy.GenericAPIError{Code: "AccessDenied", Message: "Not authorized"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests int
+
+			cfg, err := SetupTestConfig(context.Background(), truncationMocker(
+				func(_ string, marker *string) (any, error) {
+					if requests >= len(tt.responses) {
+						t.Fatalf("Unexpected request %d", requests+1)
+					}
+
+					response := tt.responses[requests]
+					requests++
+
+					if !reflect.DeepEqual(marker, response.wantMarker) {
+						t.Errorf("Request %d: gotMarker: %v, wantMarker: %v", requests, aws.ToString(marker),
+							aws.ToString(response.wantMarker))
+					}
+
+					return response.output, response.err
+				},
+			))
+			if err != nil {
+				t.Fatalf("Failed to load aws test config: %v", err)
+			}
+
+			handler := &aws_adapter.RoleHandler{Client: iam.NewFromConfig(*cfg)}
+
+			var (
+				gotRoles []types.Role
+				marker   *string
+			)
+
+			for {
+				page, nextMarker, listErr := handler.List(context.Background(), &aws_adapter.Options{
+					InputParams: aws_adapter.InputParams{
+						MaxItems: testutil.GenPtr(int32(2)),
+						Marker:   marker,
+					},
+				})
+				if listErr != nil {
+					err = listErr
+
+					break
+				}
+
+				gotRoles = append(gotRoles, page...)
+
+				if nextMarker == nil {
+					break
+				}
+
+				marker = nextMarker
+			}
+
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(gotRoles, tt.wantRoles) {
+				t.Errorf("gotRoles: %v, wantRoles: %v", gotRoles, tt.wantRoles)
+			}
+
+			if requests != len(tt.responses) {
+				t.Errorf("gotRequests: %d, wantRequests: %d", requests, len(tt.responses))
+			}
+		})
+	}
+}
+
+func TestListOrganizationsWithEmptyNextToken(t *testing.T) {
+	cfg, err := SetupTestConfig(context.Background(), truncationMocker(
+		func(_ string, _ *string) (any, error) {
+			// An empty NextToken must end the pagination instead of restarting it from the first page.
+			return &organizations.ListTargetsForPolicyOutput{
+				Targets:   []organizations_types.PolicyTargetSummary{{TargetId: testutil.GenPtr("ou-1")}},
+				NextToken: testutil.GenPtr(""),
+			}, nil
+		},
+	))
+	if err != nil {
+		t.Fatalf("Failed to load aws test config: %v", err)
+	}
+
+	handler := &aws_adapter.ServiceControlPolicyAttachmentHandler{Client: organizations.NewFromConfig(*cfg)}
+
+	targets, nextMarker, err := handler.List(context.Background(), &aws_adapter.Options{
+		InputParams: aws_adapter.InputParams{MaxItems: testutil.GenPtr(int32(20))},
+		UniqueName:  testutil.GenPtr("p-1"),
+	})
+	if err != nil {
+		t.Fatalf("gotErr: %v, wantErr: nil", err)
+	}
+
+	if len(targets) != 1 || nextMarker != nil {
+		t.Errorf("gotTargets: %v, gotNextMarker: %v, want 1 target and no next marker", targets, nextMarker)
+	}
+}
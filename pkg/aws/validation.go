@@ -74,6 +74,17 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
+	// [Organizations] The Organizations API doesn't support filtering by path.
+	if _, found := OrganizationsEntities[request.Entity.ExternalId]; found {
+		if entityConfig := request.Config.EntityConfig[request.Entity.ExternalId]; entityConfig != nil &&
+			entityConfig.PathPrefix != nil && *entityConfig.PathPrefix != "" {
+			return &framework.Error{
+				Message: fmt.Sprintf("Entity %v does not supports filtering.", request.Entity.ExternalId),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+	}
+
 	if request.Ordered {
 		return &framework.Error{
 			Message: "Ordered must be set to false.",
@@ -0,0 +1,125 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azureblob
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
+)
+
+// Adapter implements the framework.Adapter interface to query pages of objects
+// from datasources.
+type Adapter struct {
+	Client Client
+}
+
+// NewAdapter instantiates a new Adapter.
+func NewAdapter(client Client) framework.Adapter[Config] {
+	return &Adapter{
+		Client: client,
+	}
+}
+
+// GetPage is called by SGNL's ingestion service to query a page of objects
+// from a datasource.
+func (a *Adapter) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	if err := a.ValidateGetPageRequest(ctx, request); err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return a.RequestPageFromDatasource(ctx, request)
+}
+
+// RequestPageFromDatasource requests a page of objects from a datasource.
+func (a *Adapter) RequestPageFromDatasource(
+	ctx context.Context, request *framework.Request[Config],
+) framework.Response {
+	var commonConfig *config.CommonConfig
+	if request.Config != nil {
+		commonConfig = request.Config.CommonConfig
+	}
+
+	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
+
+	// Unmarshal the current cursor.
+	cursor, err := filestream.UnmarshalCursor(request.Cursor)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	// The dialect is validated with the config.
+	dialect, _ := request.Config.CSVDialect()
+
+	var auth Auth
+
+	switch {
+	case strings.HasPrefix(request.Auth.HTTPAuthorization, SASAuthScheme):
+		auth.SASToken = strings.TrimPrefix(strings.TrimPrefix(request.Auth.HTTPAuthorization, SASAuthScheme), "?")
+	case request.Auth.HTTPAuthorization != "":
+		auth.Token = request.Auth.HTTPAuthorization
+	default:
+		auth.AccountName = request.Auth.Basic.Username
+		auth.AccountKey = request.Auth.Basic.Password
+	}
+
+	blobReq := &Request{
+		Auth:                  auth,
+		BaseURL:               request.Address,
+		Container:             request.Config.Container,
+		PathPrefix:            request.Config.Prefix,
+		FileType:              request.Config.FileTypeOrDefault(),
+		SchemaFiles:           request.Config.SchemaFiles,
+		Dialect:               dialect,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
+		EntityExternalID:      request.Entity.ExternalId,
+		Cursor:                cursor,
+		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
+		AttributeConfig:       request.Entity.Attributes,
+	}
+
+	resp, err := a.Client.GetPage(ctx, blobReq)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	// An adapter error message is generated if the response status code is not
+	// successful (i.e. if not statusCode >= 200 && statusCode < 300).
+	if adapterErr := web.HTTPError(resp.StatusCode, resp.RetryAfterHeader); adapterErr != nil {
+		return framework.NewGetPageResponseError(adapterErr)
+	}
+
+	schemadrift.Observe(ctx, resp.Objects)
+
+	parsedObjects, parserErr := web.ConvertJSONObjectList(
+		&request.Entity,
+		resp.Objects,
+		web.WithLocalTimeZoneOffset(commonConfig.LocalTimeZoneOffset),
+	)
+	if parserErr != nil {
+		return framework.NewGetPageResponseError(
+			&framework.Error{
+				Message: fmt.Sprintf("Failed to convert datasource response objects: %v.", parserErr),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		)
+	}
+
+	// Marshal the next cursor.
+	nextCursor, err := filestream.MarshalCursor(resp.NextCursor)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return framework.NewGetPageResponseSuccess(&framework.Page{
+		Objects:    parsedObjects,
+		NextCursor: nextCursor,
+	})
+}
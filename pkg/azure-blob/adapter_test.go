@@ -0,0 +1,331 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package azureblob_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	azureblob "github.com/sgnl-ai/adapters/pkg/azure-blob"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+const (
+	MaxCSVRowSizeBytes       = 1 * 1024 * 1024 // 1MiB
+	MaxBytesToProcessPerPage = 1 * 1024 * 1024 // 1MiB
+
+	usersCSVData = "id,name,age\n1,Alice,30\n2,Bob,40\n3,Carol,50\n"
+
+	usersJSONLData = `{"id": "1", "name": "Alice", "age": 30}` + "\n" +
+		`{"id": "2", "name": "Bob", "age": 40}` + "\n" +
+		`{"id": "3", "name": "Carol", "age": 50}` + "\n"
+)
+
+// testBlobs are the blobs served by the mock Blob service, by path.
+var testBlobs = map[string]string{
+	"/sgnl/data/users.csv":   usersCSVData,
+	"/sgnl/data/users.jsonl": usersJSONLData,
+}
+
+// TestServerHandler mocks the Blob service, serving testBlobs to requests authorized with a bearer token,
+// the account key or a shared access signature.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if auth != "Bearer testtoken" && !strings.HasPrefix(auth, "SharedKey testaccount:") &&
+		(auth != "" || r.URL.Query().Get("sig") != "testsig") {
+		w.WriteHeader(http.StatusForbidden)
+
+		return
+	}
+
+	if r.Header.Get("x-ms-version") != azureblob.APIVersion {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	data, found := testBlobs[r.URL.EscapedPath()]
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	if r.Method == http.MethodHead {
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	if rangeHeader := r.Header.Get("x-ms-range"); rangeHeader != "" {
+		var start, end int
+
+		if _, err := fmt.Sscanf(rangeHeader, "bytes=%d-%d", &start, &end); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		end = min(end, len(data)-1)
+
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write([]byte(data[start : end+1]))
+
+		return
+	}
+
+	w.Write([]byte(data))
+})
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	defer server.Close()
+
+	adapter := azureblob.NewAdapter(azureblob.NewClient(
+		server.Client(), MaxCSVRowSizeBytes, MaxBytesToProcessPerPage, 1,
+	))
+
+	attributes := []*framework.AttributeConfig{
+		{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+		{ExternalId: "name", Type: framework.AttributeTypeString},
+		{ExternalId: "age", Type: framework.AttributeTypeInt64},
+	}
+
+	tokenAuth := &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"}
+
+	firstPageCursor, _ := filestream.MarshalCursor(&filestream.Cursor{
+		Cursor:    testutil.GenPtr(int64(len(usersCSVData))),
+		Headers:   []string{"id", "name", "age"},
+		Remainder: []byte("3,Carol,50\n"),
+	})
+
+	firstPageJSONLCursor, _ := filestream.MarshalCursor(&filestream.Cursor{
+		Cursor:    testutil.GenPtr(int64(len(usersJSONLData))),
+		Remainder: []byte(`{"id": "3", "name": "Carol", "age": 50}` + "\n"),
+	})
+
+	tests := map[string]struct {
+		request      *framework.Request[azureblob.Config]
+		wantResponse framework.Response
+	}{
+		"first_page_token_auth": {
+			request: &framework.Request[azureblob.Config]{
+				Address: server.URL,
+				Auth:    tokenAuth,
+				Config:  &azureblob.Config{Container: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "1", "name": "Alice", "age": int64(30)},
+						{"id": "2", "name": "Bob", "age": int64(40)},
+					},
+					NextCursor: firstPageCursor,
+				},
+			},
+		},
+		"first_page_account_key_auth": {
+			request: &framework.Request[azureblob.Config]{
+				Address: strings.TrimPrefix(server.URL, "https://"),
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "testaccount",
+						Password: testAccountKey,
+					},
+				},
+				Config: &azureblob.Config{Container: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "1", "name": "Alice", "age": int64(30)},
+						{"id": "2", "name": "Bob", "age": int64(40)},
+					},
+					NextCursor: firstPageCursor,
+				},
+			},
+		},
+		"first_page_sas_auth": {
+			request: &framework.Request[azureblob.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "SharedAccessSignature ?sv=2022-11-02&sp=rl&sig=testsig",
+				},
+				Config: &azureblob.Config{Container: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "1", "name": "Alice", "age": int64(30)},
+						{"id": "2", "name": "Bob", "age": int64(40)},
+					},
+					NextCursor: firstPageCursor,
+				},
+			},
+		},
+		"invalid_sas": {
+			request: &framework.Request[azureblob.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "SharedAccessSignature sv=2022-11-02&sp=rl&sig=invalid",
+				},
+				Config: &azureblob.Config{Container: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to fetch entity from Azure Blob Storage: users, error: " +
+						"the blob service responded with HTTP status 403.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+		"first_page_jsonl": {
+			request: &framework.Request[azureblob.Config]{
+				Address: server.URL,
+				Auth:    tokenAuth,
+				Config: &azureblob.Config{
+					Container:  "sgnl",
+					Prefix:     "data",
+					FileConfig: filestream.FileConfig{FileType: testutil.GenPtr("jsonl")},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "1", "name": "Alice", "age": int64(30)},
+						{"id": "2", "name": "Bob", "age": int64(40)},
+					},
+					NextCursor: firstPageJSONLCursor,
+				},
+			},
+		},
+		"last_page_jsonl": {
+			request: &framework.Request[azureblob.Config]{
+				Address: server.URL,
+				Auth:    tokenAuth,
+				Config: &azureblob.Config{
+					Container:  "sgnl",
+					Prefix:     "data",
+					FileConfig: filestream.FileConfig{FileType: testutil.GenPtr("jsonl")},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+				Cursor:   firstPageJSONLCursor,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "3", "name": "Carol", "age": int64(50)},
+					},
+				},
+			},
+		},
+		"last_page": {
+			request: &framework.Request[azureblob.Config]{
+				Address: server.URL,
+				Auth:    tokenAuth,
+				Config:  &azureblob.Config{Container: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+				Cursor:   firstPageCursor,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "3", "name": "Carol", "age": int64(50)},
+					},
+				},
+			},
+		},
+		"missing_blob": {
+			request: &framework.Request[azureblob.Config]{
+				Address: server.URL,
+				Auth:    tokenAuth,
+				Config:  &azureblob.Config{Container: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "groups",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to fetch entity from Azure Blob Storage: groups, error: " +
+						"the blob service responded with HTTP status 404.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				},
+			},
+		},
+		"invalid_token": {
+			request: &framework.Request[azureblob.Config]{
+				Address: server.URL,
+				Auth:    &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer invalid"},
+				Config:  &azureblob.Config{Container: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to fetch entity from Azure Blob Storage: users, error: " +
+						"the blob service responded with HTTP status 403.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azureblob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+)
+
+// APIVersion is the version of the Blob service REST API, sent in the x-ms-version header.
+// OAuth authentication requires version 2017-11-09 or later.
+const APIVersion = "2021-08-06"
+
+// blobStore implements filestream.Store for the blobs of an Azure Blob Storage container.
+type blobStore struct {
+	client    *http.Client
+	auth      Auth
+	baseURL   string
+	container string
+}
+
+func (s *blobStore) Size(ctx context.Context, key string) (int64, error) {
+	res, err := s.do(ctx, http.MethodHead, key, "")
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	size, err := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to determine file size")
+	}
+
+	return size, nil
+}
+
+func (s *blobStore) Read(ctx context.Context, key string, start, end int64) (io.ReadCloser, error) {
+	var rangeHeader string
+
+	if end >= 0 {
+		rangeHeader = fmt.Sprintf("bytes=%d-%d", start, end)
+	} else if start > 0 {
+		rangeHeader = fmt.Sprintf("bytes=%d-", start)
+	}
+
+	res, err := s.do(ctx, http.MethodGet, key, rangeHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+// do sends an authenticated request for the blob with the given key, and returns the response if successful.
+func (s *blobStore) do(ctx context.Context, method, key, rangeHeader string) (*http.Response, error) {
+	blobURL := fmt.Sprintf("%s/%s/%s", s.baseURL, url.PathEscape(s.container), escapeKey(key))
+
+	// A shared access signature authorizes the request with its query parameters.
+	if s.auth.SASToken != "" {
+		blobURL += "?" + s.auth.SASToken
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, blobURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", APIVersion)
+
+	if rangeHeader != "" {
+		req.Header.Set("x-ms-range", rangeHeader)
+	}
+
+	switch {
+	case s.auth.SASToken != "":
+		// The request is authorized by the query parameters of the shared access signature.
+	case s.auth.Token != "":
+		req.Header.Set("Authorization", s.auth.Token)
+	default:
+		if err := SignSharedKey(req, s.auth.AccountName, s.auth.AccountKey); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusPartialContent {
+		res.Body.Close()
+
+		return nil, &customerror.HTTPStatusError{
+			Service:          "the blob service",
+			StatusCode:       res.StatusCode,
+			RetryAfterHeader: res.Header.Get("Retry-After"),
+		}
+	}
+
+	return res, nil
+}
+
+// SignSharedKey sets the Authorization header of a request to the Blob service, signed with
+// the key of the storage account.
+// See https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key.
+func SignSharedKey(req *http.Request, accountName, accountKey string) error {
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return fmt.Errorf("the account key is not base64 encoded: %w", err)
+	}
+
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = fmt.Sprint(req.ContentLength)
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, superseded by x-ms-date.
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+	}, "\n") + "\n" + canonicalizedHeaders(req.Header) + canonicalizedResource(req.URL, accountName)
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"SharedKey %s:%s", accountName, base64.StdEncoding.EncodeToString(mac.Sum(nil)),
+	))
+
+	return nil
+}
+
+// canonicalizedHeaders returns the x-ms- headers of a request, in the format signed with Shared Key.
+func canonicalizedHeaders(header http.Header) string {
+	names := make([]string, 0, len(header))
+
+	for name := range header {
+		if lowerName := strings.ToLower(name); strings.HasPrefix(lowerName, "x-ms-") {
+			names = append(names, lowerName)
+		}
+	}
+
+	sort.Strings(names)
+
+	var builder strings.Builder
+
+	for _, name := range names {
+		fmt.Fprintf(&builder, "%s:%s\n", name, strings.TrimSpace(header.Get(name)))
+	}
+
+	return builder.String()
+}
+
+// canonicalizedResource returns the resource of a request, in the format signed with Shared Key.
+func canonicalizedResource(requestURL *url.URL, accountName string) string {
+	resource := "/" + accountName + requestURL.EscapedPath()
+
+	query := requestURL.Query()
+	names := make([]string, 0, len(query))
+
+	for name := range query {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		values := query[name]
+		sort.Strings(values)
+
+		resource += fmt.Sprintf("\n%s:%s", strings.ToLower(name), strings.Join(values, ","))
+	}
+
+	return resource
+}
+
+// escapeKey escapes each segment of the path of a blob key.
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azureblob_test
+
+import (
+	"net/http"
+	"testing"
+
+	azureblob "github.com/sgnl-ai/adapters/pkg/azure-blob"
+)
+
+const (
+	// testAccountKey is the base64 encoded key of the test storage account.
+	testAccountKey = "c2dubC10ZXN0LWFjY291bnQta2V5"
+)
+
+func TestSignSharedKey(t *testing.T) {
+	tests := map[string]struct {
+		method     string
+		url        string
+		headers    map[string]string
+		accountKey string
+		wantAuth   string
+		wantErr    string
+	}{
+		"ranged_get": {
+			method: http.MethodGet,
+			url:    "https://myaccount.blob.core.windows.net/mycontainer/data/users%20list.csv",
+			headers: map[string]string{
+				"x-ms-date":    "Fri, 16 Oct 2026 00:00:00 GMT",
+				"x-ms-version": azureblob.APIVersion,
+				"x-ms-range":   "bytes=0-99",
+			},
+			accountKey: testAccountKey,
+			wantAuth:   "SharedKey myaccount:0++ZQchhJlXpqQTksnjb5t8ZIxxnYQNXkJQBHU/thdA=",
+		},
+		"head_with_query": {
+			method: http.MethodHead,
+			url:    "https://myaccount.blob.core.windows.net/mycontainer/data/users.csv?comp=metadata",
+			headers: map[string]string{
+				"x-ms-date":    "Fri, 16 Oct 2026 00:00:00 GMT",
+				"x-ms-version": azureblob.APIVersion,
+			},
+			accountKey: testAccountKey,
+			wantAuth:   "SharedKey myaccount:GRq4tdah0H9jME3X58FtElPyqZLTuAUSbgld7lLWia8=",
+		},
+		"invalid_account_key": {
+			method:     http.MethodGet,
+			url:        "https://myaccount.blob.core.windows.net/mycontainer/data/users.csv",
+			accountKey: "not base64!",
+			wantErr:    "the account key is not base64 encoded: illegal base64 data at input byte 3",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, tt.url, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+
+			gotErr := azureblob.SignSharedKey(req, "myaccount", tt.accountKey)
+
+			if gotErr == nil && tt.wantErr != "" || gotErr != nil && gotErr.Error() != tt.wantErr {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if gotAuth := req.Header.Get("Authorization"); gotAuth != tt.wantAuth {
+				t.Errorf("gotAuth: %v, wantAuth: %v", gotAuth, tt.wantAuth)
+			}
+		})
+	}
+}
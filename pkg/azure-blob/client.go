@@ -0,0 +1,95 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azureblob
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+)
+
+// Client is a client that allows querying the datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+}
+
+// SASAuthScheme is the prefix of the auth tokens which are shared access signatures (SAS) of the container or
+// storage account, e.g. "SharedAccessSignature sv=2022-11-02&ss=b&srt=co&sp=rl&se=...&sig=...".
+const SASAuthScheme = "SharedAccessSignature "
+
+// Auth contains the credentials to authenticate with the Azure Blob Storage service.
+// Either the account name and key, the token, or the shared access signature are set.
+type Auth struct {
+	// AccountName is the name of the storage account, used to sign requests with the account key.
+	AccountName string
+
+	// AccountKey is the base64 encoded access key of the storage account.
+	AccountKey string
+
+	// Token is the Microsoft Entra ID OAuth token, including the "Bearer " prefix, e.g. of a service principal.
+	Token string
+
+	// SASToken is the query string of the shared access signature, without the leading "?".
+	SASToken string
+}
+
+// Request is a request to the datasource.
+type Request struct {
+	Auth
+
+	// BaseURL is the Base URL of the Blob service of the storage account,
+	// e.g. https://account.blob.core.windows.net.
+	BaseURL string
+
+	// Container is the Azure Blob Storage container containing the files with entity data.
+	Container string
+
+	// PathPrefix is the prefix of the path containing the files with entity data.
+	PathPrefix string
+
+	// FileType is the extension of the files containing the entity data.
+	FileType string
+
+	// SchemaFiles is whether the schema of the entity file is read from its schema sidecar file.
+	SchemaFiles bool
+
+	// Dialect is the dialect of the CSV files.
+	Dialect filestream.CSVDialect
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	// The external ID should match the file name.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity.
+	// nil in the request for the first page.
+	Cursor *filestream.Cursor
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+
+	// AttributeConfig is the list of attributes requested by the datasource.
+	AttributeConfig []*framework.AttributeConfig
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *filestream.Cursor
+}
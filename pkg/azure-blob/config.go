@@ -0,0 +1,50 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azureblob
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+)
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Azure Blob Storage Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "container": "sgnl-data",
+    "prefix": "exports/identities",
+    "fileType": "csv",
+    "delimiter": ";"
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// File format configuration
+	filestream.FileConfig
+
+	// Container is the Azure Blob Storage container containing the files with entity data.
+	Container string `json:"container" validate:"required"`
+
+	// Prefix is the prefix of the path containing the files with entity data.
+	Prefix string `json:"prefix"`
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	switch {
+	case c == nil:
+		return errors.New("the request contains an empty configuration")
+	case c.Container == "":
+		return errors.New("the request contains an empty Azure Blob Storage container name in the configuration")
+	}
+
+	return c.FileConfig.Validate()
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package azureblob_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	azureblob "github.com/sgnl-ai/adapters/pkg/azure-blob"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: "users",
+		Attributes: []*framework.AttributeConfig{
+			{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+		},
+	}
+
+	tests := map[string]struct {
+		request *framework.Request[azureblob.Config]
+		wantErr *framework.Error
+	}{
+		"valid_request_token_auth": {
+			request: &framework.Request[azureblob.Config]{
+				Address:  "account.blob.core.windows.net",
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config:   &azureblob.Config{Container: "sgnl"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+		},
+		"valid_request_account_key_auth": {
+			request: &framework.Request[azureblob.Config]{
+				Address: "https://account.blob.core.windows.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{Username: "account", Password: testAccountKey},
+				},
+				Config:   &azureblob.Config{Container: "sgnl", Prefix: "data"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+		},
+		"valid_request_sas_auth": {
+			request: &framework.Request[azureblob.Config]{
+				Address: "account.blob.core.windows.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "SharedAccessSignature ?sv=2022-11-02&sp=rl&sig=testsig",
+				},
+				Config:   &azureblob.Config{Container: "sgnl"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+		},
+		"valid_request_jsonl": {
+			request: &framework.Request[azureblob.Config]{
+				Address: "account.blob.core.windows.net",
+				Auth:    &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config: &azureblob.Config{
+					Container:  "sgnl",
+					FileConfig: filestream.FileConfig{FileType: testutil.GenPtr("jsonl")},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+		},
+		"invalid_request_missing_container": {
+			request: &framework.Request[azureblob.Config]{
+				Address:  "account.blob.core.windows.net",
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config:   &azureblob.Config{},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Azure Blob Storage config is invalid: " +
+					"the request contains an empty Azure Blob Storage container name in the configuration.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_token_missing_bearer_prefix": {
+			request: &framework.Request[azureblob.Config]{
+				Address:  "account.blob.core.windows.net",
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "testtoken"},
+				Config:   &azureblob.Config{Container: "sgnl"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " or "SharedAccessSignature " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_sas_missing_signature": {
+			request: &framework.Request[azureblob.Config]{
+				Address: "account.blob.core.windows.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "SharedAccessSignature sv=2022-11-02&sp=rl",
+				},
+				Config:   &azureblob.Config{Container: "sgnl"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided shared access signature is invalid: it must be the query string of the SAS URL, including its signature.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_account_key": {
+			request: &framework.Request[azureblob.Config]{
+				Address: "account.blob.core.windows.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{Username: "account"},
+				},
+				Config:   &azureblob.Config{Container: "sgnl"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided datasource auth is missing required Azure Blob Storage authorization credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_delimiter_same_as_quote": {
+			request: &framework.Request[azureblob.Config]{
+				Address: "account.blob.core.windows.net",
+				Auth:    &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config: &azureblob.Config{
+					Container: "sgnl",
+					FileConfig: filestream.FileConfig{
+						Delimiter: testutil.GenPtr("'"),
+						QuoteChar: testutil.GenPtr("'"),
+					},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Azure Blob Storage config is invalid: " +
+					"the delimiter and the quote character in the configuration must be different.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_unsupported_file_type": {
+			request: &framework.Request[azureblob.Config]{
+				Address: "account.blob.core.windows.net",
+				Auth:    &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config: &azureblob.Config{
+					Container:  "sgnl",
+					FileConfig: filestream.FileConfig{FileType: testutil.GenPtr("json")},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "The filetype json in config.fileType is not supported.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_jsonl_with_schema_files": {
+			request: &framework.Request[azureblob.Config]{
+				Address: "account.blob.core.windows.net",
+				Auth:    &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config: &azureblob.Config{
+					Container: "sgnl",
+					FileConfig: filestream.FileConfig{
+						FileType:    testutil.GenPtr("jsonl"),
+						SchemaFiles: true,
+					},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Azure Blob Storage config is invalid: schema files are not supported for JSON Lines files.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[azureblob.Config]{
+				Address:  "account.blob.core.windows.net",
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config:   &azureblob.Config{Container: "sgnl"},
+				Entity:   validEntity,
+				PageSize: 1001,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1001) exceeds the maximum allowed (1000).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &azureblob.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
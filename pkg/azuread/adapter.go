@@ -12,19 +12,33 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
 // from datasources.
 type Adapter struct {
 	AzureADClient Client
+
+	// Now returns the current time, used as the end of the syncs of the audit log entities.
+	// Defaults to time.Now if nil.
+	Now func() time.Time
 }
 
 // NewAdapter instantiates a new Adapter.
 func NewAdapter(client Client) framework.Adapter[Config] {
 	return &Adapter{
 		AzureADClient: client,
+		Now:           time.Now,
+	}
+}
+
+func (a *Adapter) now() time.Time {
+	if a.Now == nil {
+		return time.Now()
 	}
+
+	return a.Now()
 }
 
 // GetPage is called by SGNL's ingestion service to query a page of objects
@@ -34,6 +48,10 @@ func (a *Adapter) GetPage(ctx context.Context, request *framework.Request[Config
 		return framework.NewGetPageResponseError(err)
 	}
 
+	if len(request.Config.Tenants) > 0 {
+		return a.requestPageFromTenants(ctx, request)
+	}
+
 	return a.RequestPageFromDatasource(ctx, request)
 }
 
@@ -51,6 +69,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	var (
 		curFilter, parentFilter *string
 		cursor                  *pagination.CompositeCursor[string]
+		timeWindowCursor        *TimeWindowCursor
 
 		advancedFilterCursor           = AdvancedFilterCursor{}
 		advancedFilters                = []EntityFilter{}
@@ -121,9 +140,32 @@ func (a *Adapter) RequestPageFromDatasource(
 		} else {
 			curFilter = &parentAdvancedFilterConfig.ScopeEntityFilter
 		}
+	} else if isAuditLogEntity(request.Entity.ExternalId) {
+		parsedTimeWindowCursor, err := UnmarshalTimeWindowCursor(request.Cursor, request.Entity.ExternalId)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		if parsedTimeWindowCursor == nil {
+			parsedTimeWindowCursor = firstTimeWindowCursor(
+				commonConfig.ChangedSince(),
+				a.now(),
+				request.Config.auditLogLookbackDays(),
+				request.Config.auditLogWindowHours(),
+			)
+		}
+
+		timeWindowCursor = parsedTimeWindowCursor
+		cursor = timeWindowCursor.Cursor
+
+		if request.Config.Filters != nil {
+			if filter, found := request.Config.Filters[request.Entity.ExternalId]; found {
+				curFilter = &filter
+			}
+		}
 	} else {
 		// Unmarshal the current cursor.
-		parsedCursor, err := pagination.UnmarshalCursor[string](request.Cursor)
+		parsedCursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
 		if err != nil {
 			return framework.NewGetPageResponseError(err)
 		}
@@ -150,7 +192,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	azureadReq := &Request{
 		BaseURL:                        request.Address,
 		Token:                          request.Auth.HTTPAuthorization,
-		PageSize:                       request.PageSize,
+		PageSize:                       commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:               request.Entity.ExternalId,
 		APIVersion:                     request.Config.APIVersion,
 		Attributes:                     request.Entity.Attributes,
@@ -162,6 +204,10 @@ func (a *Adapter) RequestPageFromDatasource(
 		AdvancedFilterMemberExternalID: advancedFilterMemberExternalID,
 	}
 
+	if timeWindowCursor != nil {
+		azureadReq.TimeWindow = &TimeWindow{Start: timeWindowCursor.WindowStart, End: timeWindowCursor.WindowEnd}
+	}
+
 	resp, err := a.AzureADClient.GetPage(ctx, azureadReq)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
@@ -173,6 +219,16 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	if request.Entity.ExternalId == SchemaExtension {
+		AddSchemaExtensionAttributes(resp.Objects)
+	}
+
+	if isUserAssignmentEntity(request.Entity.ExternalId) {
+		resp.Objects = ExpandUserAssignments(request.Entity.ExternalId, resp.Objects)
+	}
+
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
@@ -209,12 +265,18 @@ func (a *Adapter) RequestPageFromDatasource(
 	// Marshal the next cursor. Wrap the cursor with a AdvancedFilterCursor if applicable
 	var nextCursorStr string
 
-	if useAdvancedFilters {
+	switch {
+	case useAdvancedFilters:
 		nextAdvancedFilterCursor := populateNextAdvancedFilterCursor(advancedFilterCursor, advancedFilters, resp.NextCursor)
 		if nextCursorStr, err = MarshalAdvancedFilterCursor(nextAdvancedFilterCursor); err != nil {
 			return framework.NewGetPageResponseError(err)
 		}
-	} else {
+	case timeWindowCursor != nil:
+		nextCursor := nextTimeWindowCursor(timeWindowCursor, resp.NextCursor, request.Config.auditLogWindowHours())
+		if nextCursorStr, err = MarshalTimeWindowCursor(nextCursor); err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+	default:
 		if nextCursorStr, err = pagination.MarshalCursor(resp.NextCursor); err != nil {
 			return framework.NewGetPageResponseError(err)
 		}
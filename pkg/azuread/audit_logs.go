@@ -0,0 +1,193 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azuread
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+const (
+	// DefaultAuditLogLookbackDays is the number of days of audit logs returned by a full sync, if not configured.
+	DefaultAuditLogLookbackDays = 7
+
+	// DefaultAuditLogWindowHours is the duration of the time windows of audit logs, if not configured.
+	DefaultAuditLogWindowHours = 24
+
+	// maxAuditLogLookbackDays is the retention of the audit logs with a Microsoft Entra ID P1 or P2 license.
+	// https://learn.microsoft.com/en-us/entra/identity/monitoring-health/reference-reports-data-retention
+	maxAuditLogLookbackDays = 30
+
+	// timeWindowCursorShape is the expected JSON shape of a TimeWindowCursor, used in cursor errors.
+	timeWindowCursorShape = `{"windowStart":<RFC 3339 string>,"windowEnd":<RFC 3339 string>,` +
+		`"syncEnd":<RFC 3339 string>,"cursor":{"cursor":<string>}}`
+)
+
+// auditLogDateTimeAttributes maps the external IDs of the audit log entities, returned by the Graph reporting
+// APIs, to the attribute containing the time of each event, used to restrict the events to a time window.
+// Sign-ins: https://learn.microsoft.com/en-us/graph/api/signin-list?view=graph-rest-1.0
+// Directory audits: https://learn.microsoft.com/en-us/graph/api/directoryaudit-list?view=graph-rest-1.0
+var auditLogDateTimeAttributes = map[string]string{
+	SignIn:         "createdDateTime",
+	DirectoryAudit: "activityDateTime",
+}
+
+// TimeWindow is a time range of audit log events, with an inclusive start and an exclusive end.
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+/*
+TimeWindowCursor is the cursor of the audit log entities. It wraps the composite cursor containing the
+next link of the Graph API within the current time window.
+
+The events of a sync are requested one time window at a time, from the start of the sync, i.e. the time of
+the last sync during an incremental sync, or the configured lookback otherwise, to the end of the sync, i.e.
+the time of the first page. Events occurring after the end of the sync are left to the next sync. Bounding
+each request to a time window keeps each chain of next links short, which matters as the reporting APIs can
+be slow to page through a large number of events.
+*/
+type TimeWindowCursor struct {
+	WindowStart time.Time                           `json:"windowStart"`
+	WindowEnd   time.Time                           `json:"windowEnd"`
+	SyncEnd     time.Time                           `json:"syncEnd"`
+	Cursor      *pagination.CompositeCursor[string] `json:"cursor,omitempty"`
+}
+
+// isAuditLogEntity returns whether the entity is an audit log entity, paged by time windows.
+func isAuditLogEntity(entityExternalID string) bool {
+	_, found := auditLogDateTimeAttributes[entityExternalID]
+
+	return found
+}
+
+// MarshalTimeWindowCursor marshals the struct and b64 encodes it.
+func MarshalTimeWindowCursor(cursor *TimeWindowCursor) (string, *framework.Error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	nextCursorBytes, marshalErr := json.Marshal(cursor)
+	if marshalErr != nil {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal time window cursor into JSON: %v.", marshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(nextCursorBytes), nil
+}
+
+// UnmarshalTimeWindowCursor decodes the b64 encoded string and unmarshals it.
+// nil is returned for the first page.
+func UnmarshalTimeWindowCursor(cursor string, entityExternalID string) (*TimeWindowCursor, *framework.Error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	timeWindowCursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, timeWindowCursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var timeWindowCursor TimeWindowCursor
+
+	if err := json.Unmarshal(timeWindowCursorBytes, &timeWindowCursor); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, timeWindowCursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	if !timeWindowCursor.WindowStart.Before(timeWindowCursor.WindowEnd) ||
+		timeWindowCursor.WindowEnd.After(timeWindowCursor.SyncEnd) {
+		return nil, pagination.NewCursorError(
+			entityExternalID, timeWindowCursorShape, "windowStart must be before windowEnd, and windowEnd not after syncEnd",
+		)
+	}
+
+	return &timeWindowCursor, nil
+}
+
+// firstTimeWindowCursor returns the cursor of the first page of a sync of an audit log entity, ending at now.
+func firstTimeWindowCursor(syncStart *time.Time, now time.Time, lookbackDays, windowHours int) *TimeWindowCursor {
+	syncEnd := now.UTC().Truncate(time.Second)
+
+	start := syncEnd.AddDate(0, 0, -lookbackDays)
+	if syncStart != nil {
+		start = syncStart.UTC()
+	}
+
+	// An incremental sync started in the future returns no events.
+	if !start.Before(syncEnd) {
+		start = syncEnd.Add(-time.Second)
+	}
+
+	return &TimeWindowCursor{
+		WindowStart: start,
+		WindowEnd:   minTime(start.Add(time.Duration(windowHours)*time.Hour), syncEnd),
+		SyncEnd:     syncEnd,
+	}
+}
+
+// nextTimeWindowCursor returns the cursor of the page following the current page of an audit log entity, given
+// the composite cursor of the next page within the time window. nil is returned after the last time window.
+func nextTimeWindowCursor(
+	current *TimeWindowCursor, nextCursor *pagination.CompositeCursor[string], windowHours int,
+) *TimeWindowCursor {
+	if nextCursor != nil {
+		return &TimeWindowCursor{
+			WindowStart: current.WindowStart,
+			WindowEnd:   current.WindowEnd,
+			SyncEnd:     current.SyncEnd,
+			Cursor:      nextCursor,
+		}
+	}
+
+	if !current.WindowEnd.Before(current.SyncEnd) {
+		return nil
+	}
+
+	return &TimeWindowCursor{
+		WindowStart: current.WindowEnd,
+		WindowEnd:   minTime(current.WindowEnd.Add(time.Duration(windowHours)*time.Hour), current.SyncEnd),
+		SyncEnd:     current.SyncEnd,
+	}
+}
+
+// AuditLogFilter returns the filter restricting the events of an audit log entity to the time window,
+// combined with the filter configured for the entity, if any.
+func AuditLogFilter(entityExternalID string, filter *string, window *TimeWindow) *string {
+	if window == nil {
+		return filter
+	}
+
+	attribute := auditLogDateTimeAttributes[entityExternalID]
+
+	windowFilter := fmt.Sprintf(
+		"%s ge %s and %s lt %s",
+		attribute, window.Start.UTC().Format(time.RFC3339), attribute, window.End.UTC().Format(time.RFC3339),
+	)
+
+	if filter != nil {
+		windowFilter = "(" + *filter + ") and " + windowFilter
+	}
+
+	return &windowFilter
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package azuread_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/azuread"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// auditLogClient returns a page with the next cursor, and records the requests.
+type auditLogClient struct {
+	nextCursor  *pagination.CompositeCursor[string]
+	gotRequests []*azuread.Request
+}
+
+func (c *auditLogClient) GetPage(_ context.Context, request *azuread.Request) (*azuread.Response, *framework.Error) {
+	c.gotRequests = append(c.gotRequests, request)
+
+	return &azuread.Response{
+		StatusCode: http.StatusOK,
+		Objects:    []map[string]any{{"id": "66ea54eb-6301-4ee5-be62-ff5a759b0100"}},
+		NextCursor: c.nextCursor,
+	}, nil
+}
+
+func (c *auditLogClient) GetToken(_ context.Context, _ *azuread.TokenRequest) (string, *framework.Error) {
+	return "", nil
+}
+
+func mustMarshalTimeWindowCursor(t *testing.T, cursor *azuread.TimeWindowCursor) string {
+	t.Helper()
+
+	cursorStr, err := azuread.MarshalTimeWindowCursor(cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return cursorStr
+}
+
+func TestAdapterGetAuditLogPage(t *testing.T) {
+	now := time.Date(2026, 1, 8, 12, 0, 0, 500, time.UTC)
+	syncEnd := time.Date(2026, 1, 8, 12, 0, 0, 0, time.UTC)
+	nextLink := "https://graph.microsoft.com/v1.0/auditLogs/signIns?$top=100&$skiptoken=abc"
+
+	tests := map[string]struct {
+		config         *azuread.Config
+		cursor         func(t *testing.T) string
+		respNextCursor *pagination.CompositeCursor[string]
+		wantTimeWindow *azuread.TimeWindow
+		wantCursor     *pagination.CompositeCursor[string]
+		wantNextCursor func(t *testing.T) string
+		wantErr        *framework.Error
+	}{
+		"first_page_full_sync": {
+			config: &azuread.Config{APIVersion: "v1.0"},
+			wantTimeWindow: &azuread.TimeWindow{
+				Start: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC),
+			},
+			wantNextCursor: func(t *testing.T) string {
+				return mustMarshalTimeWindowCursor(t, &azuread.TimeWindowCursor{
+					WindowStart: time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC),
+					WindowEnd:   time.Date(2026, 1, 3, 12, 0, 0, 0, time.UTC),
+					SyncEnd:     syncEnd,
+				})
+			},
+		},
+		"first_page_incremental_sync": {
+			config: &azuread.Config{
+				CommonConfig: &config.CommonConfig{
+					SyncMode:             config.SyncModeIncremental,
+					IncrementalSyncSince: testutil.GenPtr(time.Date(2026, 1, 8, 9, 0, 0, 0, time.UTC)),
+				},
+				APIVersion:          "v1.0",
+				AuditLogWindowHours: testutil.GenPtr(1),
+			},
+			wantTimeWindow: &azuread.TimeWindow{
+				Start: time.Date(2026, 1, 8, 9, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, 1, 8, 10, 0, 0, 0, time.UTC),
+			},
+			wantNextCursor: func(t *testing.T) string {
+				return mustMarshalTimeWindowCursor(t, &azuread.TimeWindowCursor{
+					WindowStart: time.Date(2026, 1, 8, 10, 0, 0, 0, time.UTC),
+					WindowEnd:   time.Date(2026, 1, 8, 11, 0, 0, 0, time.UTC),
+					SyncEnd:     syncEnd,
+				})
+			},
+		},
+		"next_link_within_window": {
+			config: &azuread.Config{APIVersion: "v1.0"},
+			cursor: func(t *testing.T) string {
+				return mustMarshalTimeWindowCursor(t, &azuread.TimeWindowCursor{
+					WindowStart: time.Date(2026, 1, 3, 12, 0, 0, 0, time.UTC),
+					WindowEnd:   time.Date(2026, 1, 4, 12, 0, 0, 0, time.UTC),
+					SyncEnd:     syncEnd,
+				})
+			},
+			respNextCursor: &pagination.CompositeCursor[string]{Cursor: &nextLink},
+			wantTimeWindow: &azuread.TimeWindow{
+				Start: time.Date(2026, 1, 3, 12, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, 1, 4, 12, 0, 0, 0, time.UTC),
+			},
+			wantNextCursor: func(t *testing.T) string {
+				return mustMarshalTimeWindowCursor(t, &azuread.TimeWindowCursor{
+					WindowStart: time.Date(2026, 1, 3, 12, 0, 0, 0, time.UTC),
+					WindowEnd:   time.Date(2026, 1, 4, 12, 0, 0, 0, time.UTC),
+					SyncEnd:     syncEnd,
+					Cursor:      &pagination.CompositeCursor[string]{Cursor: &nextLink},
+				})
+			},
+		},
+		"last_page_of_last_window": {
+			config: &azuread.Config{APIVersion: "v1.0"},
+			cursor: func(t *testing.T) string {
+				return mustMarshalTimeWindowCursor(t, &azuread.TimeWindowCursor{
+					WindowStart: time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC),
+					WindowEnd:   syncEnd,
+					SyncEnd:     syncEnd,
+					Cursor:      &pagination.CompositeCursor[string]{Cursor: &nextLink},
+				})
+			},
+			wantTimeWindow: &azuread.TimeWindow{
+				Start: time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC),
+				End:   syncEnd,
+			},
+			wantCursor: &pagination.CompositeCursor[string]{Cursor: &nextLink},
+			wantNextCursor: func(_ *testing.T) string {
+				return ""
+			},
+		},
+		"invalid_cursor": {
+			config: &azuread.Config{APIVersion: "v1.0"},
+			cursor: func(t *testing.T) string {
+				return mustMarshalTimeWindowCursor(t, &azuread.TimeWindowCursor{
+					WindowStart: time.Date(2026, 1, 4, 12, 0, 0, 0, time.UTC),
+					WindowEnd:   time.Date(2026, 1, 3, 12, 0, 0, 0, time.UTC),
+					SyncEnd:     syncEnd,
+				})
+			},
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity SignIn: windowStart must be before windowEnd, and windowEnd not after syncEnd. " +
+					`Expected cursor shape: {"windowStart":<RFC 3339 string>,"windowEnd":<RFC 3339 string>,"syncEnd":<RFC 3339 string>,"cursor":{"cursor":<string>}}. ` +
+					"Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &auditLogClient{nextCursor: tt.respNextCursor}
+
+			adapter := &azuread.Adapter{
+				AzureADClient: client,
+				Now:           func() time.Time { return now },
+			}
+
+			request := &framework.Request[azuread.Config]{
+				Address: "https://graph.microsoft.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Config: tt.config,
+				Entity: framework.EntityConfig{
+					ExternalId: azuread.SignIn,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 100,
+			}
+
+			if tt.cursor != nil {
+				request.Cursor = tt.cursor(t)
+			}
+
+			response := adapter.GetPage(context.Background(), request)
+
+			if !reflect.DeepEqual(response.Error, tt.wantErr) {
+				t.Fatalf("gotErr: %v, wantErr: %v", response.Error, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if len(client.gotRequests) != 1 {
+				t.Fatalf("got %d requests, want 1", len(client.gotRequests))
+			}
+
+			if !reflect.DeepEqual(client.gotRequests[0].TimeWindow, tt.wantTimeWindow) {
+				t.Errorf("gotTimeWindow: %v, wantTimeWindow: %v", client.gotRequests[0].TimeWindow, tt.wantTimeWindow)
+			}
+
+			if !reflect.DeepEqual(client.gotRequests[0].Cursor, tt.wantCursor) {
+				t.Errorf("gotCursor: %v, wantCursor: %v", client.gotRequests[0].Cursor, tt.wantCursor)
+			}
+
+			if wantNextCursor := tt.wantNextCursor(t); response.Success.NextCursor != wantNextCursor {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", response.Success.NextCursor, wantNextCursor)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTimeWindowCursor(t *testing.T) {
+	tests := map[string]struct {
+		cursor     string
+		wantCursor *azuread.TimeWindowCursor
+		wantErr    *framework.Error
+	}{
+		"empty": {
+			cursor: "",
+		},
+		"valid": {
+			// {"windowStart":"2026-01-01T00:00:00Z","windowEnd":"2026-01-02T00:00:00Z","syncEnd":"2026-01-08T00:00:00Z"}
+			cursor: "eyJ3aW5kb3dTdGFydCI6IjIwMjYtMDEtMDFUMDA6MDA6MDBaIiwid2luZG93RW5kIjoiMjAyNi0wMS0wMlQwMDowMDowMFoiLCJzeW5jRW5kIjoiMjAyNi0wMS0wOFQwMDowMDowMFoifQ==",
+			wantCursor: &azuread.TimeWindowCursor{
+				WindowStart: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				WindowEnd:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+				SyncEnd:     time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"invalid_base64": {
+			cursor: "%%%",
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity SignIn: failed to decode base64 cursor: illegal base64 data at input byte 0. " +
+					`Expected cursor shape: {"windowStart":<RFC 3339 string>,"windowEnd":<RFC 3339 string>,"syncEnd":<RFC 3339 string>,"cursor":{"cursor":<string>}}. ` +
+					"Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"window_after_sync_end": {
+			// {"windowStart":"2026-01-01T00:00:00Z","windowEnd":"2026-01-09T00:00:00Z","syncEnd":"2026-01-08T00:00:00Z"}
+			cursor: "eyJ3aW5kb3dTdGFydCI6IjIwMjYtMDEtMDFUMDA6MDA6MDBaIiwid2luZG93RW5kIjoiMjAyNi0wMS0wOVQwMDowMDowMFoiLCJzeW5jRW5kIjoiMjAyNi0wMS0wOFQwMDowMDowMFoifQ==",
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity SignIn: windowStart must be before windowEnd, and windowEnd not after syncEnd. " +
+					`Expected cursor shape: {"windowStart":<RFC 3339 string>,"windowEnd":<RFC 3339 string>,"syncEnd":<RFC 3339 string>,"cursor":{"cursor":<string>}}. ` +
+					"Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotCursor, gotErr := azuread.UnmarshalTimeWindowCursor(tt.cursor, azuread.SignIn)
+
+			if !reflect.DeepEqual(gotCursor, tt.wantCursor) {
+				t.Errorf("gotCursor: %v, wantCursor: %v", gotCursor, tt.wantCursor)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -12,6 +12,10 @@ import (
 // Client is a client that allows querying the datasource which contains JSON objects.
 type Client interface {
 	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+
+	// GetToken returns the Authorization header value to query the Graph API of a tenant configured in
+	// Config.Tenants.
+	GetToken(ctx context.Context, request *TokenRequest) (string, *framework.Error)
 }
 
 // Request is a request to the datasource.
@@ -41,6 +45,10 @@ type Request struct {
 	// Filter contains the optional filter to apply to the current request.
 	Filter *string
 
+	// TimeWindow restricts the events of an audit log entity to the time window of the current page.
+	// This is only set for the SignIn and DirectoryAudit entities.
+	TimeWindow *TimeWindow
+
 	// ParentFilter contains the optional filter to apply when retrieving parent objects for a member entity.
 	// This will only be set if the current entity has a parent and ApplyFiltersToMembers is enabled.
 	ParentFilter *string
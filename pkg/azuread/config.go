@@ -25,7 +25,18 @@ var supportedAPIVersions = map[string]struct{}{
     "filters": {
         "users": "displayName ne null"
     },
-    "applyFiltersToMembers": true
+    "applyFiltersToMembers": true,
+    "auditLogLookbackDays": 7,
+    "auditLogWindowHours": 24,
+    "tenants": [
+        {
+            "tenantId": "a1b2c3d4-0000-0000-0000-000000000000",
+            "clientId": "e5f6a7b8-0000-0000-0000-000000000000",
+            "clientSecret": "secret"
+        }
+    ],
+    "syncMode": "INCREMENTAL",
+    "incrementalSyncSince": "2026-01-01T00:00:00Z"
 }
 */
 type Config struct {
@@ -46,6 +57,38 @@ type Config struct {
 	// Optional advanced filters to apply to the request.
 	// See advanced_filters.go for more information.
 	AdvancedFilters *AdvancedFilters `json:"advancedFilters,omitempty"`
+
+	// AuditLogLookbackDays is the number of days of events returned by a full sync of the audit log entities,
+	// SignIn and DirectoryAudit. Defaults to DefaultAuditLogLookbackDays, and can't exceed the 30 days retention
+	// of the audit logs. During an incremental sync, the events since the last sync are returned instead.
+	AuditLogLookbackDays *int `json:"auditLogLookbackDays,omitempty"`
+
+	// AuditLogWindowHours is the duration of the time windows in which the events of the audit log entities
+	// are requested. Defaults to DefaultAuditLogWindowHours. See TimeWindowCursor.
+	AuditLogWindowHours *int `json:"auditLogWindowHours,omitempty"`
+
+	// Tenants are the tenants to sync, in order, each with its own client credentials, e.g. for a managed
+	// service provider managing many tenants. If set, the datasource auth isn't used, and the ID of the tenant
+	// of each object is set in the TenantIDAttribute attribute, if requested. See TenantCursor.
+	Tenants []Tenant `json:"tenants,omitempty"`
+}
+
+// auditLogLookbackDays returns the configured AuditLogLookbackDays, or the default.
+func (c *Config) auditLogLookbackDays() int {
+	if c.AuditLogLookbackDays == nil {
+		return DefaultAuditLogLookbackDays
+	}
+
+	return *c.AuditLogLookbackDays
+}
+
+// auditLogWindowHours returns the configured AuditLogWindowHours, or the default.
+func (c *Config) auditLogWindowHours() int {
+	if c.AuditLogWindowHours == nil {
+		return DefaultAuditLogWindowHours
+	}
+
+	return *c.AuditLogWindowHours
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
@@ -60,6 +103,38 @@ func (c *Config) Validate(_ context.Context) error {
 			return fmt.Errorf("apiVersion is not supported: %v", c.APIVersion)
 		}
 
-		return nil
+		if c.AuditLogLookbackDays != nil &&
+			(*c.AuditLogLookbackDays < 1 || *c.AuditLogLookbackDays > maxAuditLogLookbackDays) {
+			return fmt.Errorf("auditLogLookbackDays must be between 1 and %d", maxAuditLogLookbackDays)
+		}
+
+		if c.AuditLogWindowHours != nil && *c.AuditLogWindowHours < 1 {
+			return errors.New("auditLogWindowHours must be greater than 0")
+		}
+
+		if err := validateTenants(c.Tenants); err != nil {
+			return err
+		}
+
+		return c.CommonConfig.ValidateSyncMode()
 	}
 }
+
+// validateTenants validates that each tenant has an ID and client credentials, and is configured once.
+func validateTenants(tenants []Tenant) error {
+	tenantIDs := make(map[string]struct{}, len(tenants))
+
+	for i, tenant := range tenants {
+		if tenant.TenantID == "" || tenant.ClientID == "" || tenant.ClientSecret == "" {
+			return fmt.Errorf("tenants[%d] must have a tenantId, clientId and clientSecret", i)
+		}
+
+		if _, found := tenantIDs[tenant.TenantID]; found {
+			return fmt.Errorf("tenant %s is specified more than once", tenant.TenantID)
+		}
+
+		tenantIDs[tenant.TenantID] = struct{}{}
+	}
+
+	return nil
+}
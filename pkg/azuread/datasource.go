@@ -4,7 +4,6 @@ package azuread
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,28 +11,22 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
-	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/jsonstream"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
-	"go.uber.org/zap"
 )
 
 // Datasource directly implements a Client interface to allow querying an external datasource.
 type Datasource struct {
 	Client *http.Client
-}
 
-// Graph API response format example:
-// User Response: https://learn.microsoft.com/en-us/graph/api/user-list?view=graph-rest-1.0&tabs=http#examples
-// Paging: https://learn.microsoft.com/en-us/graph/paging?tabs=http
-type DatasourceResponse struct {
-	Values   []map[string]any `json:"value"`
-	NextLink *string          `json:"@odata.nextLink"`
+	// tokens caches the access tokens of the tenants configured in Config.Tenants.
+	tokens tokenCache
 }
 
 type EntityInfo struct {
@@ -53,6 +46,22 @@ const (
 	// Use a combination of $top and $skip to paginate the response for these two PIM entities.
 	RoleAssignmentScheduleRequest  string = "RoleAssignmentScheduleRequest"
 	GroupAssignmentScheduleRequest string = "GroupAssignmentScheduleRequest"
+
+	// Audit log entities from the Graph reporting APIs, paged by time windows. See TimeWindowCursor.
+	SignIn         string = "SignIn"
+	DirectoryAudit string = "DirectoryAudit"
+
+	// SchemaExtension are the schema extensions, whose properties are the extension attributes of the extended
+	// entities. The extensions of all the tenants are listed, so a filter is usually set, e.g. by owner app ID.
+	SchemaExtension string = "SchemaExtension"
+
+	// The licenses and service plans assigned to the users, expanded from the assignedLicenses and assignedPlans
+	// properties of the users into one object per user and license or service plan. See ExpandUserAssignments.
+	UserLicense     string = "UserLicense"
+	UserServicePlan string = "UserServicePlan"
+
+	// odataNextLink is the Graph API response member containing the URL of the next page.
+	odataNextLink = "@odata.nextLink"
 )
 
 var (
@@ -76,6 +85,11 @@ var (
 		RoleAssignment:                 {},
 		RoleAssignmentScheduleRequest:  {},
 		GroupAssignmentScheduleRequest: {},
+		SignIn:                         {},
+		DirectoryAudit:                 {},
+		SchemaExtension:                {},
+		UserLicense:                    {},
+		UserServicePlan:                {},
 	}
 
 	// Advanced query operators that require the `ConsistencyLevel: eventual` header.
@@ -140,8 +154,25 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 
 	var nextCursor *pagination.CompositeCursor[string]
 
+	// Each request to the datasource has its own timeout, but accumulating members across many groups
+	// can take longer than the deadline of the page. Return a partial page with a cursor to resume from
+	// instead of letting the whole page time out.
+	deadlineGuard := pagination.NewDeadlineGuard(ctx)
+
 	for int64(len(accumulatedObjects)) < request.PageSize {
+		if len(accumulatedObjects) > 0 && nextCursor != nil && deadlineGuard.Expiring() {
+			zaplogger.FromContext(ctx).Info("Returning a partial page as the request deadline is about to expire",
+				fields.RequestEntityExternalID(request.EntityExternalID),
+			)
+
+			break
+		}
+
+		doneTracking := deadlineGuard.Track()
 		response, err := d.getPageBase(ctx, &currentRequest)
+
+		doneTracking()
+
 		if err != nil {
 			return nil, err
 		}
@@ -274,77 +305,44 @@ func (d *Datasource) getPageBase(ctx context.Context, request *Request) (*Respon
 		return nil, endpointErr
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
-	// Timeout API calls that take longer than the configured timeout.
-	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
-	defer cancel()
-
-	req = req.WithContext(apiCtx)
-
-	req.Header.Add("Authorization", request.Token)
+	header := http.Header{"Authorization": {request.Token}}
 
 	// Enhanced advanced query detection - check if we need ConsistencyLevel: eventual
 	if IsAdvancedQuery(request, endpoint) {
-		req.Header.Add("ConsistencyLevel", "eventual")
+		header.Set("ConsistencyLevel", "eventual")
 	}
 
-	logger.Info("Sending request to datasource", fields.RequestURL(endpoint))
+	var (
+		objects  []map[string]any
+		nextLink *string
+	)
 
-	res, err := d.Client.Do(req)
-	if err != nil {
-		logger.Error("Request to datasource failed",
-			fields.RequestURL(endpoint),
-			fields.SGNLEventTypeError(),
-			zap.Error(err),
-		)
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL:                   endpoint,
+		Header:                header,
+		DatasourceName:        "Azure AD",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		var parseErr *framework.Error
 
-		return nil, customerror.UpdateError(&framework.Error{
-			Message: fmt.Sprintf("Failed to execute Azure AD request: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		},
-			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
-		)
-	}
+		objects, nextLink, parseErr = ParseResponse(body)
 
-	defer res.Body.Close()
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
 
 	response := &Response{
-		StatusCode:       res.StatusCode,
-		RetryAfterHeader: res.Header.Get("Retry-After"),
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
 	}
 
-	if res.StatusCode != http.StatusOK {
-		logger.Error("Datasource responded with an error",
-			fields.RequestURL(endpoint),
-			fields.ResponseStatusCode(response.StatusCode),
-			fields.ResponseRetryAfterHeader(response.RetryAfterHeader),
-			fields.ResponseBody(res.Body),
-			fields.SGNLEventTypeError(),
-		)
-
+	if response.StatusCode != http.StatusOK {
 		return response, nil
 	}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to read Azure AD response: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
-	objects, nextLink, frameworkErr := ParseResponse(body)
-	if frameworkErr != nil {
-		return nil, frameworkErr
-	}
-
 	// [Roles] No pagination support from the server side for this entity.
 	if request.EntityExternalID == Role {
 		objects, nextLink, frameworkErr = pagination.PaginateObjects(objects, request.PageSize, request.Cursor)
@@ -449,17 +447,24 @@ func getParentEntityExternalID(request *Request) *string {
 	return parentEntityExternalID
 }
 
-func ParseResponse(body []byte) (objects []map[string]any, nextLink *string, err *framework.Error) {
-	var data *DatasourceResponse
-
-	if unmarshalErr := json.Unmarshal(body, &data); unmarshalErr != nil || data == nil {
+// ParseResponse decodes the objects in the `value` member and the `@odata.nextLink` member
+// of a Graph API response.
+// User Response: https://learn.microsoft.com/en-us/graph/api/user-list?view=graph-rest-1.0&tabs=http#examples
+// Paging: https://learn.microsoft.com/en-us/graph/paging?tabs=http
+func ParseResponse(body io.Reader) (objects []map[string]any, nextLink *string, err *framework.Error) {
+	objects, values, decodeErr := jsonstream.DecodeList(body, "value", odataNextLink)
+	if decodeErr != nil {
 		return nil, nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", unmarshalErr),
+			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", decodeErr),
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		}
 	}
 
-	return data.Values, data.NextLink, nil
+	if link, found := values[odataNextLink]; found {
+		nextLink = &link
+	}
+
+	return objects, nextLink, nil
 }
 
 // nolint: lll
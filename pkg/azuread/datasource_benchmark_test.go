@@ -0,0 +1,61 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package azuread_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/azuread"
+)
+
+// BenchmarkParseResponse benchmarks parsing a multi-MB page of Microsoft Graph users, to catch performance
+// regressions in the parsing of the responses.
+//
+//	go test -run '^$' -bench BenchmarkParse -benchmem ./pkg/azuread/
+func BenchmarkParseResponse(b *testing.B) {
+	body := generateUsersPage(5000)
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		objects, nextLink, err := azuread.ParseResponse(bytes.NewReader(body))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if len(objects) != 5000 || nextLink == nil {
+			b.Fatalf("got %d objects and nextLink %v, want 5000 objects and a nextLink", len(objects), nextLink)
+		}
+	}
+}
+
+// generateUsersPage returns a response of the List Users endpoint with objectCount users.
+func generateUsersPage(objectCount int) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(`{"@odata.context":"https://graph.microsoft.com/v1.0/$metadata#users","value":[`)
+
+	for i := range objectCount {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		fmt.Fprintf(&buf,
+			`{"id":"%08d-1111-2222-3333-444444444444","displayName":"User %d","givenName":"First%d","surname":"Last%d",`+
+				`"userPrincipalName":"user%d@example.onmicrosoft.com","mail":"user%d@example.com","jobTitle":"Engineer",`+
+				`"department":"Engineering","officeLocation":"Building %d","mobilePhone":null,"businessPhones":["+1 555 0100"],`+
+				`"accountEnabled":true,"createdDateTime":"2026-01-02T03:04:05Z","preferredLanguage":"en-US",`+
+				`"employeeOrgData":{"division":"Engineering","costCenter":"CC-%d"},`+
+				`"manager":{"id":"%08d-5555-6666-7777-888888888888","displayName":"Manager %d"}}`,
+			i, i, i, i, i, i, i%20, i%50, i%100, i%100,
+		)
+	}
+
+	buf.WriteString(`],"@odata.nextLink":"https://graph.microsoft.com/v1.0/users?$top=999&$skiptoken=RFNwdAIAAQAAAB"}`)
+
+	return buf.Bytes()
+}
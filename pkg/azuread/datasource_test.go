@@ -4,6 +4,7 @@
 package azuread_test
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 	"net/http/httptest"
@@ -38,7 +39,7 @@ func TestParseResponse(t *testing.T) {
 		"invalid_object_structure": {
 			body: []byte(`[{"id": "00ub0oNGTSWTBKOLGLNR","status": "ACTIVE"}, {"id": "00ub0oNGTSWTBKOCHDKE","status": "ACTIVE"}]`),
 			wantErr: testutil.GenPtr(framework.Error{
-				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type azuread.DatasourceResponse.",
+				Message: "Failed to unmarshal the datasource response: expected start of JSON object but found [.",
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 			}),
 		},
@@ -53,7 +54,7 @@ func TestParseResponse(t *testing.T) {
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotObjects, gotNextLink, gotErr := azuread.ParseResponse(tt.body)
+			gotObjects, gotNextLink, gotErr := azuread.ParseResponse(bytes.NewReader(tt.body))
 
 			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
 				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
@@ -93,6 +93,13 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 	// [GroupAssignmentScheduleRequest] baseURL + "/" + apiVersion
 	// 					+ "/identityGovernance/privilegedAccess/group/assignmentScheduleRequests"
 	// 					+ formAttributeParams(...)
+	// [SignIn]         baseURL + "/" + apiVersion + "/auditLogs/signIns" + formAuditLogParams(...)
+	// [DirectoryAudit] baseURL + "/" + apiVersion + "/auditLogs/directoryAudits" + formAuditLogParams(...)
+	// [SchemaExtension] baseURL + "/" + apiVersion + "/schemaExtensions" + formAttributeParams(...)
+	// [UserLicense]     baseURL + "/" + apiVersion + "/users" + "?$select=id,userPrincipalName,assignedLicenses"
+	//                   + "&$top=" + pageSize + ["&$filter=" + filter]
+	// [UserServicePlan] baseURL + "/" + apiVersion + "/users" + "?$select=id,userPrincipalName,assignedPlans"
+	//                   + "&$top=" + pageSize + ["&$filter=" + filter]
 
 	sb.Grow(12 + len(request.BaseURL) + len(request.APIVersion) + len(formattedPageSize))
 
@@ -135,6 +142,23 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 		sb.WriteString("/roleManagement/directory/roleAssignmentScheduleRequests")
 	case GroupAssignmentScheduleRequest:
 		sb.WriteString("/identityGovernance/privilegedAccess/group/assignmentScheduleRequests")
+	case SchemaExtension:
+		sb.WriteString("/schemaExtensions")
+	case SignIn:
+		sb.WriteString("/auditLogs/signIns")
+		sb.WriteString(formAuditLogParams(request))
+
+		return sb.String(), nil
+	case DirectoryAudit:
+		sb.WriteString("/auditLogs/directoryAudits")
+		sb.WriteString(formAuditLogParams(request))
+
+		return sb.String(), nil
+	case UserLicense, UserServicePlan:
+		sb.WriteString("/users")
+		sb.WriteString(formUserAssignmentParams(request))
+
+		return sb.String(), nil
 	case GroupMember:
 		if request.Cursor == nil || request.Cursor.CollectionID == nil {
 			return "", &framework.Error{
@@ -314,6 +338,23 @@ func formAttributeParams(
 					continue
 				}
 
+				if isComplexProperty(entityExternalID, parentExternalID) {
+					// Select the complex property directly, if not already present.
+					if _, found := complexAttrs[parentExternalID]; !found {
+						complexAttrs[parentExternalID] = &framework.AttributeConfig{
+							ExternalId: parentExternalID,
+						}
+
+						if idx > 0 || defaultAttribute != "" {
+							sb.WriteRune(',')
+						}
+
+						sb.WriteString(url.QueryEscape(parentExternalID))
+					}
+
+					continue
+				}
+
 				return "", &framework.Error{
 					Message: fmt.Sprintf(
 						"Unsupported parent attribute provided for the current entity type: %q.",
@@ -427,7 +468,8 @@ func formAttributeParams(
 	// In case of a Role, the API returns an error if pageSize ($top) is used
 	//
 	// Ref: https://learn.microsoft.com/en-us/graph/paging?tabs=http#how-paging-works:~:text=Different%20APIs%20might%20behave,might%20return%20an%20error.
-	if entityExternalID != "Role" {
+	// The schema extensions don't support $top either.
+	if entityExternalID != "Role" && entityExternalID != SchemaExtension {
 		pageSizeStr := strconv.FormatInt(pageSize, 10)
 		sb.Grow(6 + len(pageSizeStr))
 		sb.WriteString("&$top=")
@@ -456,6 +498,28 @@ func formAttributeParams(
 	return sb.String(), nil
 }
 
+// formAuditLogParams returns the query parameters of the first page of a time window of an audit log entity.
+// The reporting APIs don't support $select, so all the attributes of the events are returned.
+func formAuditLogParams(request *Request) string {
+	var sb strings.Builder
+
+	pageSizeStr := strconv.FormatInt(request.PageSize, 10)
+
+	sb.Grow(6 + len(pageSizeStr))
+	sb.WriteString("?$top=")
+	sb.WriteString(pageSizeStr)
+
+	if filter := AuditLogFilter(request.EntityExternalID, request.Filter, request.TimeWindow); filter != nil {
+		escapedFilter := url.QueryEscape(*filter)
+
+		sb.Grow(9 + len(escapedFilter))
+		sb.WriteString("&$filter=")
+		sb.WriteString(escapedFilter)
+	}
+
+	return sb.String()
+}
+
 func isPIMEntity(entityExternalID string) bool {
 	return entityExternalID == RoleAssignmentScheduleRequest || entityExternalID == GroupAssignmentScheduleRequest
 }
@@ -6,6 +6,7 @@ package azuread_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
@@ -430,6 +431,94 @@ func TestConstructEndpoint(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
 			},
 		},
+		"json_path_extension_attributes": {
+			request: &azuread.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				EntityExternalID: "User",
+				PageSize:         100,
+				Token:            "SSWS testtoken",
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "id",
+						Type:       framework.AttributeTypeString,
+					},
+					{
+						ExternalId: "$.onPremisesExtensionAttributes.extensionAttribute1",
+						Type:       framework.AttributeTypeString,
+					},
+					{
+						ExternalId: "$.onPremisesExtensionAttributes.extensionAttribute15",
+						Type:       framework.AttributeTypeString,
+					},
+					{
+						ExternalId: "$.contoso_employeeInfo.costCenter",
+						Type:       framework.AttributeTypeString,
+					},
+					{
+						ExternalId: "$.extension_b7d8e648520f41d3b9c0fdeb91768a0a_hireDate",
+						Type:       framework.AttributeTypeDateTime,
+					},
+				},
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/users?$select=id,onPremisesExtensionAttributes,contoso_employeeInfo,extension_b7d8e648520f41d3b9c0fdeb91768a0a_hireDate&$top=100",
+		},
+		"schema_extensions": {
+			request: &azuread.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				EntityExternalID: "SchemaExtension",
+				PageSize:         100,
+				Token:            "SSWS testtoken",
+				Filter:           testutil.GenPtr("owner eq 'e5f6a7b8-0000-0000-0000-000000000000'"),
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "id",
+						Type:       framework.AttributeTypeString,
+					},
+					{
+						ExternalId: "$.properties[*].attributeExternalId",
+						Type:       framework.AttributeTypeString,
+						List:       true,
+					},
+				},
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/schemaExtensions?$select=id,properties&$filter=owner+eq+%27e5f6a7b8-0000-0000-0000-000000000000%27",
+		},
+		"user_licenses": {
+			request: &azuread.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				EntityExternalID: "UserLicense",
+				PageSize:         100,
+				Token:            "SSWS testtoken",
+				Filter:           testutil.GenPtr("accountEnabled eq false"),
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "id",
+						Type:       framework.AttributeTypeString,
+					},
+					{
+						ExternalId: "skuId",
+						Type:       framework.AttributeTypeString,
+					},
+				},
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/users?$select=id,userPrincipalName,assignedLicenses&$top=100&$filter=accountEnabled+eq+false",
+		},
+		"user_service_plans_cursor": {
+			request: &azuread.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				EntityExternalID: "UserServicePlan",
+				PageSize:         100,
+				Token:            "SSWS testtoken",
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://graph.microsoft.com/v1.0/users?$select=id,userPrincipalName,assignedPlans&$top=100&$skiptoken=RFNwdAIAAQAAAA"),
+				},
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/users?$select=id,userPrincipalName,assignedPlans&$top=100&$skiptoken=RFNwdAIAAQAAAA",
+		},
 		"roles_simple": {
 			request: &azuread.Request{
 				BaseURL:          "https://graph.microsoft.com",
@@ -664,6 +753,61 @@ func TestConstructEndpoint(t *testing.T) {
 			},
 			wantEndpoint: `https://graph.microsoft.com/v1.0/groups?$select=id,displayName&$top=100&$filter=startsWith%28displayName%2C+%27Infra%27%29&$count=true`,
 		},
+		"sign_ins": {
+			request: &azuread.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				EntityExternalID: azuread.SignIn,
+				PageSize:         100,
+				TimeWindow: &azuread.TimeWindow{
+					Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					End:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "id",
+						Type:       framework.AttributeTypeString,
+					},
+				},
+			},
+			wantEndpoint: `https://graph.microsoft.com/v1.0/auditLogs/signIns?$top=100&$filter=createdDateTime+ge+2026-01-01T00%3A00%3A00Z+and+createdDateTime+lt+2026-01-02T00%3A00%3A00Z`,
+		},
+		"directory_audits_with_filter": {
+			request: &azuread.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				EntityExternalID: azuread.DirectoryAudit,
+				PageSize:         100,
+				Filter:           testutil.GenPtr("category eq 'UserManagement'"),
+				TimeWindow: &azuread.TimeWindow{
+					Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					End:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "id",
+						Type:       framework.AttributeTypeString,
+					},
+				},
+			},
+			wantEndpoint: `https://graph.microsoft.com/v1.0/auditLogs/directoryAudits?$top=100&$filter=%28category+eq+%27UserManagement%27%29+and+activityDateTime+ge+2026-01-01T00%3A00%3A00Z+and+activityDateTime+lt+2026-01-02T00%3A00%3A00Z`,
+		},
+		"sign_ins_with_cursor": {
+			request: &azuread.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				EntityExternalID: azuread.SignIn,
+				PageSize:         100,
+				TimeWindow: &azuread.TimeWindow{
+					Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					End:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://graph.microsoft.com/v1.0/auditLogs/signIns?$top=100&$skiptoken=abc"),
+				},
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/auditLogs/signIns?$top=100&$skiptoken=abc",
+		},
 	}
 
 	for name, tt := range tests {
@@ -0,0 +1,83 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azuread
+
+import (
+	"regexp"
+)
+
+var (
+	// complexProperties are the properties of the entities whose values are objects rather than relationships,
+	// so their nested attributes, e.g. $.onPremisesExtensionAttributes.extensionAttribute1, are synced by
+	// selecting the property itself instead of expanding it.
+	complexProperties = map[string]map[string]struct{}{
+		// https://learn.microsoft.com/en-us/graph/api/resources/onpremisesextensionattributes?view=graph-rest-1.0
+		User: {
+			"onPremisesExtensionAttributes": {},
+			"employeeOrgData":               {},
+		},
+		Device: {
+			"extensionAttributes": {},
+		},
+		SchemaExtension: {
+			"properties": {},
+		},
+	}
+
+	// schemaExtensionIDRegex matches the IDs of the schema extensions, which are also the names of the properties
+	// of their values on the extended objects, e.g. "contoso_employeeInfo" or "extkhvdkdh3_employeeInfo".
+	// The directory extensions, e.g. "extension_b7d8e648520f41d3b9c0fdeb91768a0a_costCenter", are scalar
+	// properties and are selected like the other properties.
+	// https://learn.microsoft.com/en-us/graph/extensibility-overview
+	schemaExtensionIDRegex = regexp.MustCompile(`^[A-Za-z0-9]+_[A-Za-z0-9]+$`)
+
+	// schemaExtensionAttributeTypes maps the types of the properties of the schema extensions to the types of the
+	// SGNL attributes. Binary values are returned as base64 strings.
+	// https://learn.microsoft.com/en-us/graph/api/resources/extensionschemaproperty?view=graph-rest-1.0
+	schemaExtensionAttributeTypes = map[string]string{
+		"Binary":   "String",
+		"Boolean":  "Bool",
+		"DateTime": "DateTime",
+		"Integer":  "Int64",
+		"String":   "String",
+	}
+)
+
+// isComplexProperty returns whether the property of the entity is an object whose nested attributes are synced by
+// selecting the property: the known complex properties of the entity, and the values of the schema extensions.
+func isComplexProperty(entityExternalID, property string) bool {
+	if _, found := complexProperties[entityExternalID][property]; found {
+		return true
+	}
+
+	switch entityExternalID {
+	case User, Group, Device:
+		return schemaExtensionIDRegex.MatchString(property)
+	default:
+		return false
+	}
+}
+
+// AddSchemaExtensionAttributes sets the attributeExternalId and attributeType of each property of the schema
+// extensions of the SchemaExtension entity: the external ID and the type of the attribute syncing the property on
+// the extended entities, e.g. "$.contoso_employeeInfo.costCenter" and "String". The properties are selectable as
+// lists, e.g. $.properties[*].attributeExternalId and $.properties[*].attributeType, in the same order.
+func AddSchemaExtensionAttributes(objects []map[string]any) {
+	for _, object := range objects {
+		id, _ := object["id"].(string)
+		properties, _ := object["properties"].([]any)
+
+		for _, property := range properties {
+			propertyMap, ok := property.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			name, _ := propertyMap["name"].(string)
+			propertyType, _ := propertyMap["type"].(string)
+
+			propertyMap["attributeExternalId"] = "$." + id + "." + name
+			propertyMap["attributeType"] = schemaExtensionAttributeTypes[propertyType]
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package azuread_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	azuread_adapter "github.com/sgnl-ai/adapters/pkg/azuread"
+)
+
+func TestAdapterGetPageWithExtensionAttributes(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/v1.0/users?$select=id,onPremisesExtensionAttributes,contoso_employeeInfo,extension_b7d8e648520f41d3b9c0fdeb91768a0a_hireDate&$top=10":
+			w.Write([]byte(`{
+				"value": [
+					{
+						"id": "u1",
+						"onPremisesExtensionAttributes": {"extensionAttribute1": "Finance", "extensionAttribute2": null},
+						"contoso_employeeInfo": {"@odata.type": "#microsoft.graph.ComplexExtensionValue", "costCenter": "CC-42", "level": 7},
+						"extension_b7d8e648520f41d3b9c0fdeb91768a0a_hireDate": "2024-03-05T00:00:00Z"
+					}
+				]
+			}`))
+		case "/v1.0/schemaExtensions?$select=id,status,properties":
+			w.Write([]byte(`{
+				"value": [
+					{
+						"id": "contoso_employeeInfo",
+						"status": "Available",
+						"properties": [
+							{"name": "costCenter", "type": "String"},
+							{"name": "level", "type": "Integer"},
+							{"name": "badge", "type": "Binary"}
+						]
+					}
+				]
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	adapter := azuread_adapter.NewAdapter(&azuread_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	newRequest := func(entity string, attributes ...*framework.AttributeConfig) *framework.Request[azuread_adapter.Config] {
+		return &framework.Request[azuread_adapter.Config]{
+			Address: server.URL,
+			Auth: &framework.DatasourceAuthCredentials{
+				HTTPAuthorization: "Bearer Testtoken",
+			},
+			Config: &azuread_adapter.Config{
+				APIVersion: "v1.0",
+			},
+			Entity: framework.EntityConfig{
+				ExternalId: entity,
+				Attributes: append([]*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString},
+				}, attributes...),
+			},
+			PageSize: 10,
+		}
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[azuread_adapter.Config]
+		wantResponse framework.Response
+	}{
+		"users_with_extension_attributes": {
+			request: newRequest(azuread_adapter.User,
+				&framework.AttributeConfig{ExternalId: "$.onPremisesExtensionAttributes.extensionAttribute1", Type: framework.AttributeTypeString},
+				&framework.AttributeConfig{ExternalId: "$.contoso_employeeInfo.costCenter", Type: framework.AttributeTypeString},
+				&framework.AttributeConfig{ExternalId: "$.contoso_employeeInfo.level", Type: framework.AttributeTypeInt64},
+				&framework.AttributeConfig{ExternalId: "$.extension_b7d8e648520f41d3b9c0fdeb91768a0a_hireDate", Type: framework.AttributeTypeDateTime},
+			),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id": "u1",
+							"$.onPremisesExtensionAttributes.extensionAttribute1":   "Finance",
+							"$.contoso_employeeInfo.costCenter":                     "CC-42",
+							"$.contoso_employeeInfo.level":                          int64(7),
+							"$.extension_b7d8e648520f41d3b9c0fdeb91768a0a_hireDate": time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+		},
+		"schema_extensions": {
+			request: newRequest(azuread_adapter.SchemaExtension,
+				&framework.AttributeConfig{ExternalId: "status", Type: framework.AttributeTypeString},
+				&framework.AttributeConfig{ExternalId: "$.properties[*].attributeExternalId", Type: framework.AttributeTypeString, List: true},
+				&framework.AttributeConfig{ExternalId: "$.properties[*].attributeType", Type: framework.AttributeTypeString, List: true},
+			),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                                  "contoso_employeeInfo",
+							"status":                              "Available",
+							"$.properties[*].attributeExternalId": []string{"$.contoso_employeeInfo.costCenter", "$.contoso_employeeInfo.level", "$.contoso_employeeInfo.badge"},
+							"$.properties[*].attributeType":       []string{"String", "Int64", "String"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(tt.wantResponse, gotResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
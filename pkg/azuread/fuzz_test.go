@@ -0,0 +1,31 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package azuread_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/azuread"
+)
+
+// FuzzParseResponse checks that malformed Microsoft Graph responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"@odata.nextLink":"https://graph.microsoft.com/v1.0/users?$skiptoken=RFNwdAIAAQAAAA","value":[{"id":"6e7b768e-07e2-4810-8459-485f84f8f204"}]}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, _, err := azuread.ParseResponse(bytes.NewReader(body))
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azuread
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// userAssignmentProperties are the properties of the users expanded into the objects of the UserLicense and
+// UserServicePlan entities.
+// https://learn.microsoft.com/en-us/graph/api/resources/assignedlicense?view=graph-rest-1.0
+// https://learn.microsoft.com/en-us/graph/api/resources/assignedplan?view=graph-rest-1.0
+var userAssignmentProperties = map[string]string{
+	UserLicense:     "assignedLicenses",
+	UserServicePlan: "assignedPlans",
+}
+
+// userAssignmentIDAttributes are the attributes identifying the licenses and service plans of a user.
+var userAssignmentIDAttributes = map[string]string{
+	UserLicense:     "skuId",
+	UserServicePlan: "servicePlanId",
+}
+
+// formUserAssignmentParams returns the query parameters of the users requested for the UserLicense and
+// UserServicePlan entities. The attributes of the entities aren't properties of the users, so the properties to
+// expand are selected instead. The filter of the entity is applied to the users, e.g. "accountEnabled eq false".
+func formUserAssignmentParams(request *Request) string {
+	var sb strings.Builder
+
+	pageSizeStr := strconv.FormatInt(request.PageSize, 10)
+
+	sb.WriteString("?$select=id,userPrincipalName,")
+	sb.WriteString(userAssignmentProperties[request.EntityExternalID])
+	sb.WriteString("&$top=")
+	sb.WriteString(pageSizeStr)
+
+	if request.Filter != nil {
+		escapedFilter := url.QueryEscape(*request.Filter)
+
+		sb.Grow(9 + len(escapedFilter))
+		sb.WriteString("&$filter=")
+		sb.WriteString(escapedFilter)
+	}
+
+	return sb.String()
+}
+
+// isUserAssignmentEntity returns whether the entity is expanded from the licenses or service plans of the users.
+func isUserAssignmentEntity(entityExternalID string) bool {
+	_, found := userAssignmentProperties[entityExternalID]
+
+	return found
+}
+
+// ExpandUserAssignments expands the users of a page of the UserLicense or UserServicePlan entity into one object per
+// license or service plan assigned to each user, e.g. for the UserLicense entity:
+//
+//	{"id": "{userId}-{skuId}", "userId": "{userId}", "userPrincipalName": "...", "skuId": "...", "disabledPlans": [...]}
+//
+// and for the UserServicePlan entity:
+//
+//	{"id": "{userId}-{servicePlanId}", "userId": "{userId}", "userPrincipalName": "...", "servicePlanId": "...",
+//	 "service": "...", "capabilityStatus": "...", "assignedDateTime": "..."}
+//
+// A service plan can be listed more than once in the assignedPlans of a user, e.g. once deleted and once enabled
+// after the license was removed and assigned again, so only its latest assignment is kept.
+// A page can therefore contain more objects than the page size, up to the number of licenses or service plans
+// of the users of the page.
+func ExpandUserAssignments(entityExternalID string, users []map[string]any) []map[string]any {
+	property := userAssignmentProperties[entityExternalID]
+	idAttribute := userAssignmentIDAttributes[entityExternalID]
+
+	objects := make([]map[string]any, 0, len(users))
+
+	for _, user := range users {
+		userID, _ := user["id"].(string)
+		assignments, _ := user[property].([]any)
+
+		// The index in objects of the assignment of each ID of the user, to keep only the latest one.
+		assignmentIndexes := make(map[string]int, len(assignments))
+
+		for _, assignment := range assignments {
+			assignmentMap, ok := assignment.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			assignmentID, _ := assignmentMap[idAttribute].(string)
+			if assignmentID == "" {
+				continue
+			}
+
+			object := make(map[string]any, len(assignmentMap)+3)
+			for key, value := range assignmentMap {
+				object[key] = value
+			}
+
+			object["id"] = userID + "-" + assignmentID
+			object["userId"] = userID
+			object["userPrincipalName"] = user["userPrincipalName"]
+
+			idx, found := assignmentIndexes[assignmentID]
+			if !found {
+				assignmentIndexes[assignmentID] = len(objects)
+				objects = append(objects, object)
+
+				continue
+			}
+
+			// The assignedDateTime values are RFC 3339 UTC date-times, which sort lexicographically.
+			current, _ := objects[idx]["assignedDateTime"].(string)
+			if assignedDateTime, _ := object["assignedDateTime"].(string); assignedDateTime > current {
+				objects[idx] = object
+			}
+		}
+	}
+
+	return objects
+}
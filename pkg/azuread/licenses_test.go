@@ -0,0 +1,158 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package azuread_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	azuread_adapter "github.com/sgnl-ai/adapters/pkg/azuread"
+)
+
+func TestAdapterGetPageWithUserAssignments(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/v1.0/users?$select=id,userPrincipalName,assignedLicenses&$top=10":
+			w.Write([]byte(`{
+				"value": [
+					{
+						"id": "u1",
+						"userPrincipalName": "alice@contoso.com",
+						"assignedLicenses": [
+							{"skuId": "6fd2c87f-b296-42f0-b197-1e91e994b900", "disabledPlans": ["efb87545-963c-4e0d-99df-69c6916d9eb0"]},
+							{"skuId": "f30db892-07e9-47e9-837c-80727f46fd3d", "disabledPlans": []}
+						]
+					},
+					{
+						"id": "u2",
+						"userPrincipalName": "bob@contoso.com",
+						"assignedLicenses": []
+					}
+				]
+			}`))
+		case "/v1.0/users?$select=id,userPrincipalName,assignedPlans&$top=10&$filter=accountEnabled+eq+false":
+			w.Write([]byte(`{
+				"value": [
+					{
+						"id": "u3",
+						"userPrincipalName": "carol@contoso.com",
+						"assignedPlans": [
+							{"assignedDateTime": "2024-01-10T08:00:00Z", "capabilityStatus": "Deleted", "service": "exchange", "servicePlanId": "efb87545-963c-4e0d-99df-69c6916d9eb0"},
+							{"assignedDateTime": "2024-03-05T09:30:00Z", "capabilityStatus": "Enabled", "service": "exchange", "servicePlanId": "efb87545-963c-4e0d-99df-69c6916d9eb0"},
+							{"assignedDateTime": "2024-03-05T09:30:00Z", "capabilityStatus": "Enabled", "service": "TeamspaceAPI", "servicePlanId": "57ff2da0-773e-42df-b2af-ffb7a2317929"}
+						]
+					}
+				]
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	adapter := azuread_adapter.NewAdapter(&azuread_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	newRequest := func(entity string, filters map[string]string, attributes ...*framework.AttributeConfig) *framework.Request[azuread_adapter.Config] {
+		return &framework.Request[azuread_adapter.Config]{
+			Address: server.URL,
+			Auth: &framework.DatasourceAuthCredentials{
+				HTTPAuthorization: "Bearer Testtoken",
+			},
+			Config: &azuread_adapter.Config{
+				APIVersion: "v1.0",
+				Filters:    filters,
+			},
+			Entity: framework.EntityConfig{
+				ExternalId: entity,
+				Attributes: append([]*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString},
+					{ExternalId: "userId", Type: framework.AttributeTypeString},
+					{ExternalId: "userPrincipalName", Type: framework.AttributeTypeString},
+				}, attributes...),
+			},
+			PageSize: 10,
+		}
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[azuread_adapter.Config]
+		wantResponse framework.Response
+	}{
+		"user_licenses": {
+			request: newRequest(azuread_adapter.UserLicense, nil,
+				&framework.AttributeConfig{ExternalId: "skuId", Type: framework.AttributeTypeString},
+				&framework.AttributeConfig{ExternalId: "disabledPlans", Type: framework.AttributeTypeString, List: true},
+			),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                "u1-6fd2c87f-b296-42f0-b197-1e91e994b900",
+							"userId":            "u1",
+							"userPrincipalName": "alice@contoso.com",
+							"skuId":             "6fd2c87f-b296-42f0-b197-1e91e994b900",
+							"disabledPlans":     []string{"efb87545-963c-4e0d-99df-69c6916d9eb0"},
+						},
+						{
+							"id":                "u1-f30db892-07e9-47e9-837c-80727f46fd3d",
+							"userId":            "u1",
+							"userPrincipalName": "alice@contoso.com",
+							"skuId":             "f30db892-07e9-47e9-837c-80727f46fd3d",
+							"disabledPlans":     []any{},
+						},
+					},
+				},
+			},
+		},
+		"user_service_plans_filtered": {
+			request: newRequest(azuread_adapter.UserServicePlan, map[string]string{azuread_adapter.UserServicePlan: "accountEnabled eq false"},
+				&framework.AttributeConfig{ExternalId: "servicePlanId", Type: framework.AttributeTypeString},
+				&framework.AttributeConfig{ExternalId: "service", Type: framework.AttributeTypeString},
+				&framework.AttributeConfig{ExternalId: "capabilityStatus", Type: framework.AttributeTypeString},
+				&framework.AttributeConfig{ExternalId: "assignedDateTime", Type: framework.AttributeTypeDateTime},
+			),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                "u3-efb87545-963c-4e0d-99df-69c6916d9eb0",
+							"userId":            "u3",
+							"userPrincipalName": "carol@contoso.com",
+							"servicePlanId":     "efb87545-963c-4e0d-99df-69c6916d9eb0",
+							"service":           "exchange",
+							"capabilityStatus":  "Enabled",
+							"assignedDateTime":  time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC),
+						},
+						{
+							"id":                "u3-57ff2da0-773e-42df-b2af-ffb7a2317929",
+							"userId":            "u3",
+							"userPrincipalName": "carol@contoso.com",
+							"servicePlanId":     "57ff2da0-773e-42df-b2af-ffb7a2317929",
+							"service":           "TeamspaceAPI",
+							"capabilityStatus":  "Enabled",
+							"assignedDateTime":  time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(tt.wantResponse, gotResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azuread
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// DefaultTokenBaseURL is the base URL of the Microsoft identity platform, from which the access tokens of the
+// configured tenants are requested.
+var DefaultTokenBaseURL = "https://login.microsoftonline.com"
+
+const (
+	// TenantIDAttribute is the attribute set on each object synced from the configured tenants, with the ID of
+	// the tenant the object was retrieved from. Object IDs are only unique within a tenant.
+	TenantIDAttribute = "tenantId"
+
+	// tenantCursorShape is the expected JSON shape of a TenantCursor, used in cursor errors.
+	tenantCursorShape = `{"tenantId":<string>,"cursor":<string>}`
+
+	// tokenExpiryLeeway is subtracted from the lifetime of the cached access tokens, so that a token doesn't
+	// expire while a page is being requested.
+	tokenExpiryLeeway = time.Minute
+)
+
+// Tenant is an Azure AD tenant synced with its own client credentials, see Config.Tenants.
+type Tenant struct {
+	// TenantID is the ID of the tenant, e.g. "a1b2c3d4-0000-0000-0000-000000000000".
+	TenantID string `json:"tenantId"`
+
+	// ClientID is the application (client) ID of the app registration in the tenant.
+	ClientID string `json:"clientId"`
+
+	// ClientSecret is a client secret of the app registration in the tenant.
+	ClientSecret string `json:"clientSecret"`
+}
+
+// TokenRequest is a request for an access token of a tenant, using the OAuth 2.0 client credentials grant:
+// https://learn.microsoft.com/en-us/entra/identity-platform/v2-oauth2-client-creds-grant-flow.
+type TokenRequest struct {
+	// BaseURL is the base URL of the Microsoft identity platform, e.g. DefaultTokenBaseURL.
+	BaseURL string
+
+	// Scope is the scope of the token, i.e. the Graph API address followed by "/.default".
+	Scope string
+
+	Tenant Tenant
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	RequestTimeoutSeconds int
+}
+
+// tokenResponse is the response of the token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// cachedToken is an access token cached by the Datasource until it expires.
+type cachedToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// tokenCache caches the access tokens of the tenants across pages.
+type tokenCache struct {
+	mu     sync.Mutex
+	tokens map[TokenRequest]cachedToken
+}
+
+func (c *tokenCache) get(request TokenRequest) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, found := c.tokens[request]
+	if !found || !time.Now().Before(cached.expiresAt) {
+		return "", false
+	}
+
+	return cached.token, true
+}
+
+func (c *tokenCache) set(request TokenRequest, token string, expiresIn int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tokens == nil {
+		c.tokens = make(map[TokenRequest]cachedToken)
+	}
+
+	c.tokens[request] = cachedToken{
+		token:     token,
+		expiresAt: time.Now().Add(time.Duration(expiresIn)*time.Second - tokenExpiryLeeway),
+	}
+}
+
+// GetToken returns the Authorization header value, i.e. "Bearer <token>", to query the Graph API of the tenant
+// of the request. Tokens are cached until they expire.
+func (d *Datasource) GetToken(ctx context.Context, request *TokenRequest) (string, *framework.Error) {
+	if token, found := d.tokens.get(*request); found {
+		return token, nil
+	}
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {request.Tenant.ClientID},
+		"client_secret": {request.Tenant.ClientSecret},
+		"scope":         {request.Scope},
+	}
+
+	var token tokenResponse
+
+	httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+		Method:                http.MethodPost,
+		URL:                   request.BaseURL + "/" + url.PathEscape(request.Tenant.TenantID) + "/oauth2/v2.0/token",
+		Body:                  []byte(form.Encode()),
+		Header:                http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+		DatasourceName:        "Azure AD token",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, err := httpds.ReadAll(body, "Azure AD token")
+		if err != nil {
+			return err
+		}
+
+		return httpds.UnmarshalJSON(bodyBytes, &token)
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if adapterErr := web.HTTPError(httpResponse.StatusCode, httpResponse.RetryAfterHeader); adapterErr != nil {
+		adapterErr.Message = fmt.Sprintf(
+			"Failed to get an access token for Azure AD tenant %s: %s", request.Tenant.TenantID, adapterErr.Message,
+		)
+
+		return "", adapterErr
+	}
+
+	if token.AccessToken == "" {
+		return "", &framework.Error{
+			Message: fmt.Sprintf(
+				"Azure AD token response for tenant %s is missing an access token.", request.Tenant.TenantID,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	authorization := "Bearer " + token.AccessToken
+
+	d.tokens.set(*request, authorization, token.ExpiresIn)
+
+	return authorization, nil
+}
+
+/*
+TenantCursor is the cursor of the entities synced from the configured tenants. It wraps the cursor of the
+next page within the current tenant, i.e. the cursor returned when syncing a single tenant, e.g.:
+
+	{
+	    "tenantId": "a1b2c3d4-0000-0000-0000-000000000000",
+	    "cursor": "eyJjdXJzb3IiOiJodHRwczovL2dyYXBoLm1pY3Jvc29mdC5jb20vdjEuMC91c2Vycz8kc2tpcHRva2VuPTEifQ=="
+	}
+
+The tenants are synced one after the other, in the configured order. A cursor without a cursor identifies the
+first page of the tenant.
+*/
+type TenantCursor struct {
+	TenantID string `json:"tenantId"`
+	Cursor   string `json:"cursor,omitempty"`
+}
+
+// MarshalTenantCursor marshals the struct and b64 encodes it.
+func MarshalTenantCursor(cursor *TenantCursor) (string, *framework.Error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	cursorBytes, err := json.Marshal(cursor)
+	if err != nil {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal tenant cursor into JSON: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(cursorBytes), nil
+}
+
+// UnmarshalTenantCursor decodes the b64 encoded string and unmarshals it.
+// nil is returned for the first page.
+func UnmarshalTenantCursor(cursor string, entityExternalID string) (*TenantCursor, *framework.Error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	cursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, tenantCursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var tenantCursor TenantCursor
+
+	if err := json.Unmarshal(cursorBytes, &tenantCursor); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, tenantCursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	return &tenantCursor, nil
+}
+
+// requestPageFromTenants requests a page of objects from one of the configured tenants, with the access token
+// of the tenant. The cursor of the page within the tenant is handled by RequestPageFromDatasource.
+func (a *Adapter) requestPageFromTenants(
+	ctx context.Context, request *framework.Request[Config],
+) framework.Response {
+	tenants := request.Config.Tenants
+
+	cursor, err := UnmarshalTenantCursor(request.Cursor, request.Entity.ExternalId)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	tenantIndex := 0
+
+	if cursor != nil {
+		tenantIndex = -1
+
+		for i, tenant := range tenants {
+			if tenant.TenantID == cursor.TenantID {
+				tenantIndex = i
+
+				break
+			}
+		}
+
+		if tenantIndex == -1 {
+			return framework.NewGetPageResponseError(pagination.NewCursorError(
+				request.Entity.ExternalId,
+				tenantCursorShape,
+				fmt.Sprintf("tenant %s is not configured", cursor.TenantID),
+			))
+		}
+	}
+
+	tenant := tenants[tenantIndex]
+
+	requestTimeoutSeconds := *config.SetMissingCommonConfigDefaults(request.Config.CommonConfig).RequestTimeoutSeconds
+
+	token, err := a.AzureADClient.GetToken(ctx, &TokenRequest{
+		BaseURL:               DefaultTokenBaseURL,
+		Scope:                 strings.TrimSuffix(request.Address, "/") + "/.default",
+		Tenant:                tenant,
+		RequestTimeoutSeconds: requestTimeoutSeconds,
+	})
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	tenantRequest := *request
+	tenantRequest.Auth = &framework.DatasourceAuthCredentials{HTTPAuthorization: token}
+	tenantRequest.Cursor = ""
+
+	if cursor != nil {
+		tenantRequest.Cursor = cursor.Cursor
+	}
+
+	response := a.RequestPageFromDatasource(ctx, &tenantRequest)
+	if response.Success == nil {
+		return response
+	}
+
+	if requestsAttribute(request.Entity, TenantIDAttribute) {
+		for _, object := range response.Success.Objects {
+			object[TenantIDAttribute] = tenant.TenantID
+		}
+	}
+
+	var nextCursor *TenantCursor
+
+	switch {
+	case response.Success.NextCursor != "":
+		nextCursor = &TenantCursor{TenantID: tenant.TenantID, Cursor: response.Success.NextCursor}
+	case tenantIndex+1 < len(tenants):
+		nextCursor = &TenantCursor{TenantID: tenants[tenantIndex+1].TenantID}
+	}
+
+	if response.Success.NextCursor, err = MarshalTenantCursor(nextCursor); err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return response
+}
+
+// requestsAttribute returns whether the attribute is requested for the entity.
+func requestsAttribute(entity framework.EntityConfig, attributeExternalID string) bool {
+	for _, attribute := range entity.Attributes {
+		if attribute.ExternalId == attributeExternalID {
+			return true
+		}
+	}
+
+	return false
+}
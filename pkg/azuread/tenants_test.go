@@ -0,0 +1,241 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package azuread_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/azuread"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// tenantClient returns a page of users with the token of the request, and a next cursor for the first page of
+// each tenant.
+type tenantClient struct {
+	gotTokenRequests []*azuread.TokenRequest
+}
+
+func (c *tenantClient) GetPage(_ context.Context, request *azuread.Request) (*azuread.Response, *framework.Error) {
+	response := &azuread.Response{
+		StatusCode: http.StatusOK,
+		Objects:    []map[string]any{{"id": "user1", "displayName": request.Token}},
+	}
+
+	if request.Cursor == nil {
+		response.NextCursor = &pagination.CompositeCursor[string]{Cursor: testutil.GenPtr("page2")}
+	}
+
+	return response, nil
+}
+
+func (c *tenantClient) GetToken(_ context.Context, request *azuread.TokenRequest) (string, *framework.Error) {
+	c.gotTokenRequests = append(c.gotTokenRequests, request)
+
+	return "Bearer " + request.Tenant.TenantID + "-token", nil
+}
+
+func TestAdapterGetPageWithTenants(t *testing.T) {
+	encodeCursor := func(cursor string) string {
+		return base64.StdEncoding.EncodeToString([]byte(cursor))
+	}
+
+	// The cursor of the second page within a tenant, i.e. {"cursor":"page2"}.
+	page2Cursor := encodeCursor(`{"cursor":"page2"}`)
+
+	tests := map[string]struct {
+		cursor       string
+		wantResponse framework.Response
+		wantTenantID string
+	}{
+		"first_page_of_first_tenant": {
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "user1", "displayName": "Bearer tenant1-token", "tenantId": "tenant1"},
+					},
+					NextCursor: encodeCursor(`{"tenantId":"tenant1","cursor":"` + page2Cursor + `"}`),
+				},
+			},
+			wantTenantID: "tenant1",
+		},
+		"last_page_of_first_tenant": {
+			cursor: encodeCursor(`{"tenantId":"tenant1","cursor":"` + page2Cursor + `"}`),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "user1", "displayName": "Bearer tenant1-token", "tenantId": "tenant1"},
+					},
+					NextCursor: encodeCursor(`{"tenantId":"tenant2"}`),
+				},
+			},
+			wantTenantID: "tenant1",
+		},
+		"first_page_of_last_tenant": {
+			cursor: encodeCursor(`{"tenantId":"tenant2"}`),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "user1", "displayName": "Bearer tenant2-token", "tenantId": "tenant2"},
+					},
+					NextCursor: encodeCursor(`{"tenantId":"tenant2","cursor":"` + page2Cursor + `"}`),
+				},
+			},
+			wantTenantID: "tenant2",
+		},
+		"last_page_of_last_tenant": {
+			cursor: encodeCursor(`{"tenantId":"tenant2","cursor":"` + page2Cursor + `"}`),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "user1", "displayName": "Bearer tenant2-token", "tenantId": "tenant2"},
+					},
+				},
+			},
+			wantTenantID: "tenant2",
+		},
+		"unknown_tenant": {
+			cursor: encodeCursor(`{"tenantId":"tenant3"}`),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Invalid cursor for entity User: tenant tenant3 is not configured. " +
+						`Expected cursor shape: {"tenantId":<string>,"cursor":<string>}. ` + pagination.RestartSyncHint,
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &tenantClient{}
+			adapter := azuread.NewAdapter(client)
+
+			request := &framework.Request[azuread.Config]{
+				Address: "https://graph.microsoft.com",
+				Config: &azuread.Config{
+					APIVersion: "v1.0",
+					Tenants: []azuread.Tenant{
+						{TenantID: "tenant1", ClientID: "client1", ClientSecret: "secret1"},
+						{TenantID: "tenant2", ClientID: "client2", ClientSecret: "secret2"},
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: azuread.User,
+					Attributes: []*framework.AttributeConfig{
+						{ExternalId: "id", Type: framework.AttributeTypeString},
+						{ExternalId: "displayName", Type: framework.AttributeTypeString},
+						{ExternalId: azuread.TenantIDAttribute, Type: framework.AttributeTypeString},
+					},
+				},
+				PageSize: 1,
+				Cursor:   tt.cursor,
+			}
+
+			gotResponse := adapter.GetPage(context.Background(), request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if tt.wantTenantID == "" {
+				return
+			}
+
+			if len(client.gotTokenRequests) != 1 {
+				t.Fatalf("got %d token requests, want 1", len(client.gotTokenRequests))
+			}
+
+			if got := client.gotTokenRequests[0]; got.Tenant.TenantID != tt.wantTenantID ||
+				got.Scope != "https://graph.microsoft.com/.default" {
+				t.Errorf("got token request for tenant %s with scope %s, want tenant %s",
+					got.Tenant.TenantID, got.Scope, tt.wantTenantID)
+			}
+		})
+	}
+}
+
+func TestDatasourceGetToken(t *testing.T) {
+	var gotRequests int
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequests++
+
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("failed to parse form: %v", err)
+		}
+
+		switch {
+		case r.URL.Path != "/tenant1/oauth2/v2.0/token":
+			w.WriteHeader(http.StatusNotFound)
+		case r.PostForm.Get("grant_type") != "client_credentials" ||
+			r.PostForm.Get("scope") != "https://graph.microsoft.com/.default":
+			w.WriteHeader(http.StatusBadRequest)
+		case r.PostForm.Get("client_id") != "client1" || r.PostForm.Get("client_secret") != "secret1":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.Write([]byte(`{"token_type":"Bearer","expires_in":3599,"access_token":"token1"}`))
+		}
+	}))
+	defer server.Close()
+
+	tests := map[string]struct {
+		tenant       azuread.Tenant
+		wantToken    string
+		wantErr      *framework.Error
+		wantRequests int
+	}{
+		"valid_credentials_are_cached": {
+			tenant:       azuread.Tenant{TenantID: "tenant1", ClientID: "client1", ClientSecret: "secret1"},
+			wantToken:    "Bearer token1",
+			wantRequests: 1,
+		},
+		"invalid_credentials": {
+			tenant: azuread.Tenant{TenantID: "tenant1", ClientID: "client1", ClientSecret: "invalid"},
+			wantErr: &framework.Error{
+				Message: "Failed to get an access token for Azure AD tenant tenant1: " +
+					"Failed to authenticate with datasource. Check datasource configuration details and try again.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+			wantRequests: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRequests = 0
+			datasource := &azuread.Datasource{Client: server.Client()}
+
+			request := &azuread.TokenRequest{
+				BaseURL:               server.URL,
+				Scope:                 "https://graph.microsoft.com/.default",
+				Tenant:                tt.tenant,
+				RequestTimeoutSeconds: 5,
+			}
+
+			// The second request uses the cached token, if any.
+			for range 2 {
+				gotToken, gotErr := datasource.GetToken(context.Background(), request)
+
+				if gotToken != tt.wantToken {
+					t.Errorf("gotToken: %v, wantToken: %v", gotToken, tt.wantToken)
+				}
+
+				if !reflect.DeepEqual(gotErr, tt.wantErr) {
+					t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+				}
+			}
+
+			if gotRequests != tt.wantRequests {
+				t.Errorf("gotRequests: %v, wantRequests: %v", gotRequests, tt.wantRequests)
+			}
+		})
+	}
+}
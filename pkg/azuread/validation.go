@@ -53,6 +53,11 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		request.Address = trimmedAddress
 	}
 
+	// The access tokens of the configured tenants are requested with their own client credentials.
+	if len(request.Config.Tenants) > 0 {
+		return validateEntityRequest(request)
+	}
+
 	if request.Auth == nil || request.Auth.HTTPAuthorization == "" {
 		return &framework.Error{
 			Message: "Provided datasource auth is missing required http authorization credentials.",
@@ -67,6 +72,11 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
+	return validateEntityRequest(request)
+}
+
+// validateEntityRequest validates the entity and page of the GetPage Request.
+func validateEntityRequest(request *framework.Request[Config]) *framework.Error {
 	if _, found := ValidEntityExternalIDs[request.Entity.ExternalId]; !found {
 		return &framework.Error{
 			Message: "Provided entity external ID is invalid.",
@@ -10,6 +10,7 @@ import (
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapters/pkg/azuread"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
 )
 
 func TestValidateGetPageRequest(t *testing.T) {
@@ -1007,6 +1008,107 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"invalid_audit_log_lookback_days": {
+			request: &framework.Request[azuread.Config]{
+				Address: "https://graph.microsoft.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "SignIn",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &azuread.Config{
+					APIVersion:           "v1.0",
+					AuditLogLookbackDays: testutil.GenPtr(31),
+				},
+				Ordered:  false,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Azure AD config is invalid: auditLogLookbackDays must be between 1 and 30.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"valid_tenants_without_auth": {
+			request: &framework.Request[azuread.Config]{
+				Address: "https://graph.microsoft.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &azuread.Config{
+					APIVersion: "v1.0",
+					Tenants: []azuread.Tenant{
+						{TenantID: "tenant1", ClientID: "client1", ClientSecret: "secret1"},
+						{TenantID: "tenant2", ClientID: "client2", ClientSecret: "secret2"},
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: nil,
+		},
+		"invalid_tenant_missing_client_secret": {
+			request: &framework.Request[azuread.Config]{
+				Address: "https://graph.microsoft.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &azuread.Config{
+					APIVersion: "v1.0",
+					Tenants: []azuread.Tenant{
+						{TenantID: "tenant1", ClientID: "client1"},
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Azure AD config is invalid: tenants[0] must have a tenantId, clientId and clientSecret.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_duplicate_tenants": {
+			request: &framework.Request[azuread.Config]{
+				Address: "https://graph.microsoft.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &azuread.Config{
+					APIVersion: "v1.0",
+					Tenants: []azuread.Tenant{
+						{TenantID: "tenant1", ClientID: "client1", ClientSecret: "secret1"},
+						{TenantID: "tenant1", ClientID: "client2", ClientSecret: "secret2"},
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Azure AD config is invalid: tenant tenant1 is specified more than once.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 	}
 
 	adapter := &azuread.Adapter{}
@@ -12,6 +12,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -49,7 +50,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
 
 	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor)
+	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
@@ -68,10 +69,11 @@ func (a *Adapter) RequestPageFromDatasource(
 		APIVersion:            request.Config.APIVersion,
 		CompanyDomain:         request.Config.CompanyDomain,
 		OnlyCurrent:           request.Config.OnlyCurrent,
+		ChangedSince:          commonConfig.ChangedSince(),
 		APIKey:                request.Auth.Basic.Username,
 		BasicAuthPassword:     request.Auth.Basic.Password,
 		AttributeMappings:     request.Config.AttributeMappings,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityConfig:          &request.Entity,
 		Cursor:                cursor,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
@@ -88,6 +90,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
@@ -255,8 +255,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Failed to unmarshal JSON cursor: json: cannot unmarshal string into Go struct field CompositeCursor[int64].cursor of type int64.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+					Message: "Invalid cursor for entity Employee: failed to unmarshal JSON cursor: json: cannot unmarshal string into Go struct field CompositeCursor[int64].cursor of type int64. " +
+						`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
 		},
@@ -283,8 +285,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Cursor must not contain CollectionID or CollectionCursor fields for entity Employee.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+					Message: "Invalid cursor for entity Employee: cursor must not contain CollectionID or CollectionCursor fields. " +
+						`Expected cursor shape: {"cursor":<int64>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
 		},
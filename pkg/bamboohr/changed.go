@@ -0,0 +1,228 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bamboohr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"go.uber.org/zap"
+)
+
+const (
+	// statusField is the field of the employee status, "Active" or "Inactive".
+	statusField = "status"
+
+	// inactiveStatus is the status of employees which are not current employees.
+	inactiveStatus = "Inactive"
+
+	// deletedAction is the action of the changed employees which were deleted.
+	deletedAction = "Deleted"
+)
+
+// ChangedResponse is the response of the changed employees endpoint.
+type ChangedResponse struct {
+	// Latest is the time of the latest change, in ISO 8601 format.
+	Latest string `json:"latest"`
+
+	// Employees maps the ID of each changed employee to the change.
+	Employees map[string]ChangedEmployee `json:"employees"`
+}
+
+// ChangedEmployee is a change of an employee returned by the changed employees endpoint.
+type ChangedEmployee struct {
+	ID          string `json:"id"`
+	Action      string `json:"action"`
+	LastChanged string `json:"lastChanged"`
+}
+
+// getChangedPage returns a page of the employees changed since request.ChangedSince.
+//
+// The IDs of the changed employees are requested first and sorted, then the fields of the employees of the page
+// are requested one employee at a time. The cursor is the ID of the last employee of the previous page, so that
+// employees changed during the sync do not shift the pages. Deleted employees, employees deleted during the
+// sync and, if request.OnlyCurrent is set, inactive employees are skipped.
+func (d *Datasource) getChangedPage(
+	ctx context.Context, request *Request, logger *zap.Logger,
+) (*Response, *framework.Error) {
+	url := ConstructChangedEndpoint(request)
+
+	statusCode, retryAfterHeader, body, err := d.get(ctx, request, url, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return &Response{StatusCode: statusCode, RetryAfterHeader: retryAfterHeader}, nil
+	}
+
+	var changed ChangedResponse
+
+	if unmarshalErr := json.Unmarshal(body, &changed); unmarshalErr != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal the changed employees response: %v.", unmarshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	employeeIDs, idErr := changedEmployeeIDs(changed, request.Cursor)
+	if idErr != nil {
+		return nil, idErr
+	}
+
+	response := &Response{
+		StatusCode: http.StatusOK,
+		Objects:    make([]map[string]any, 0, min(int64(len(employeeIDs)), request.PageSize)),
+	}
+
+	if int64(len(employeeIDs)) > request.PageSize {
+		employeeIDs = employeeIDs[:request.PageSize]
+		response.NextCursor = &pagination.CompositeCursor[int64]{Cursor: &employeeIDs[len(employeeIDs)-1]}
+	}
+
+	for _, employeeID := range employeeIDs {
+		url := ConstructEmployeeEndpoint(request, strconv.FormatInt(employeeID, 10))
+
+		statusCode, retryAfterHeader, body, err := d.get(ctx, request, url, logger)
+		if err != nil {
+			return nil, err
+		}
+
+		// The employee was deleted after the changed employees were requested.
+		if statusCode == http.StatusNotFound {
+			continue
+		}
+
+		if statusCode != http.StatusOK {
+			return &Response{StatusCode: statusCode, RetryAfterHeader: retryAfterHeader}, nil
+		}
+
+		var employee map[string]any
+
+		if unmarshalErr := json.Unmarshal(body, &employee); unmarshalErr != nil || employee == nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Failed to unmarshal the employee response: %v.", unmarshalErr),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		if request.OnlyCurrent && employee[statusField] == inactiveStatus {
+			continue
+		}
+
+		if processErr := ProcessObject(request, employee); processErr != nil {
+			return nil, processErr
+		}
+
+		response.Objects = append(response.Objects, employee)
+	}
+
+	return response, nil
+}
+
+// changedEmployeeIDs returns the sorted IDs of the changed employees which were not deleted, after the ID in
+// the cursor, if any.
+func changedEmployeeIDs(
+	changed ChangedResponse, cursor *pagination.CompositeCursor[int64],
+) ([]int64, *framework.Error) {
+	employeeIDs := make([]int64, 0, len(changed.Employees))
+
+	for id, employee := range changed.Employees {
+		if employee.Action == deletedAction {
+			continue
+		}
+
+		employeeID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Failed to parse the ID of the changed employee: %s.", id),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		if cursor != nil && cursor.Cursor != nil && employeeID <= *cursor.Cursor {
+			continue
+		}
+
+		employeeIDs = append(employeeIDs, employeeID)
+	}
+
+	slices.Sort(employeeIDs)
+
+	return employeeIDs, nil
+}
+
+// get sends a GET request to the datasource and returns the status code, the Retry-After header and the
+// body of the response. The body is only read if the status code is 200.
+func (d *Datasource) get(
+	ctx context.Context, request *Request, url string, logger *zap.Logger,
+) (int, string, []byte, *framework.Error) {
+	// Timeout API calls that take longer than the configured timeout.
+	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(apiCtx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, "", nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	req.SetBasicAuth(request.APIKey, request.BasicAuthPassword)
+	req.Header.Set("Accept", "application/json")
+
+	logger.Info("Sending request to datasource", fields.RequestURL(url))
+
+	res, err := d.Client.Do(req)
+	if err != nil {
+		logger.Error("Request to datasource failed",
+			fields.RequestURL(url),
+			fields.SGNLEventTypeError(),
+			zap.Error(err),
+		)
+
+		return 0, "", nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to execute BambooHR request: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
+		)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		logger.Error("Datasource responded with an error",
+			fields.RequestURL(url),
+			fields.ResponseStatusCode(res.StatusCode),
+			fields.ResponseRetryAfterHeader(res.Header.Get("Retry-After")),
+			fields.ResponseBody(res.Body),
+			fields.SGNLEventTypeError(),
+		)
+
+		return res.StatusCode, res.Header.Get("Retry-After"), nil, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return 0, "", nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to read BambooHR response body: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return res.StatusCode, "", body, nil
+}
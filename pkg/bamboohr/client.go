@@ -4,6 +4,7 @@ package bamboohr
 
 import (
 	"context"
+	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
@@ -27,6 +28,11 @@ type Request struct {
 	// https://documentation.bamboohr.com/reference/request-custom-report-1
 	OnlyCurrent bool
 
+	// ChangedSince restricts the returned employees to those changed since this time during an
+	// incremental sync. nil during a full sync.
+	// https://documentation.bamboohr.com/reference/get-employees-changed
+	ChangedSince *time.Time
+
 	// APIVersion the API version to use when building the request.
 	APIVersion string
 
@@ -43,7 +49,8 @@ type Request struct {
 	EntityConfig *framework.EntityConfig `json:"entityConfig"`
 
 	// Cursor identifies the first object of the page to return, as returned by
-	// the last request for the entity.
+	// the last request for the entity. During an incremental sync, this is the ID of the
+	// last employee of the previous page.
 	// nil in the request for the first page.
 	Cursor *pagination.CompositeCursor[int64]
 
@@ -30,6 +30,8 @@ var supportedDateFormats = map[string]web.DateTimeFormatWithTimeZone{
     "apiVersion": "v1",
 	"companyDomain": "sgnl",
 	"onlyCurrent": true,
+	"syncMode": "INCREMENTAL",
+	"incrementalSyncSince": "2026-01-01T00:00:00Z",
 	"attributeMappings": {
 		"date": "yyyy-mm-dd",
 		"bool": {
@@ -83,6 +85,10 @@ func (c *Config) Validate(_ context.Context) error {
 	case c.CompanyDomain == "":
 		return errors.New("companyDomain is not set")
 	default:
+		if err := c.CommonConfig.ValidateSyncMode(); err != nil {
+			return err
+		}
+
 		if _, found := supportedAPIVersions[c.APIVersion]; !found {
 			return fmt.Errorf("apiVersion is not supported: %v", c.APIVersion)
 		}
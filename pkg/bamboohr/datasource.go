@@ -9,12 +9,12 @@ import (
 	"io"
 	"net/http"
 	"slices"
-	"strconv"
 	"strings"
 	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
 	customerror "github.com/sgnl-ai/adapters/pkg/errors"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
@@ -97,6 +97,22 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		return nil, validationErr
 	}
 
+	// During an incremental sync, only the changed employees are requested instead of the whole report.
+	if request.ChangedSince != nil {
+		response, err := d.getChangedPage(ctx, request, logger)
+		if err != nil || response.StatusCode != http.StatusOK {
+			return response, err
+		}
+
+		logger.Info("Datasource request completed successfully",
+			fields.ResponseStatusCode(response.StatusCode),
+			fields.ResponseObjectCount(len(response.Objects)),
+			fields.ResponseNextCursor(response.NextCursor),
+		)
+
+		return response, nil
+	}
+
 	endpointInfo, endpointErr := ConstructEndpoint(request)
 	if endpointErr != nil {
 		return nil, endpointErr
@@ -133,6 +149,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -301,7 +318,7 @@ func ProcessAttribute(
 
 	switch attr.Type {
 	case framework.AttributeTypeInt64, framework.AttributeTypeDouble:
-		convertedVal, err = strconv.ParseFloat(attrStringValue, 64)
+		convertedVal, err = commonutil.ParseFloat(attrStringValue)
 	case framework.AttributeTypeBool:
 		convertedVal, err = ParseBool(attrStringValue, mappings.BoolMappings)
 	default:
@@ -320,7 +337,7 @@ func ProcessAttribute(
 }
 
 func ParseBool(value string, mappings *BoolAttributeMappings) (bool, error) {
-	convertedVal, err := strconv.ParseBool(value)
+	convertedVal, err := commonutil.ParseBool(value)
 	if err == nil {
 		return convertedVal, nil
 	}
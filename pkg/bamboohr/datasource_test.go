@@ -678,6 +678,37 @@ func TestParseResponse(t *testing.T) {
 			},
 			wantNextCursor: nil,
 		},
+		"locale_formatted_values": {
+			request: &bamboohr.Request{
+				PageSize: 100,
+				EntityConfig: &framework.EntityConfig{
+					ExternalId: "employee",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "payRate",
+							Type:       framework.AttributeTypeDouble,
+						},
+						{
+							ExternalId: "isPhotoUploaded",
+							Type:       framework.AttributeTypeBool,
+						},
+					},
+				},
+				AttributeMappings: &bamboohr.AttributeMappings{},
+			},
+			body: []byte(`{
+				"title": "Report",
+				"employees": [
+					{"id": "4", "payRate": "1.234,50", "isPhotoUploaded": " TRUE "},
+					{"id": "5", "payRate": "1,234.50", "isPhotoUploaded": "0"}
+				]
+			}`),
+			wantObjects: []map[string]any{
+				{"id": "4", "payRate": 1234.5, "isPhotoUploaded": true},
+				{"id": "5", "payRate": 1234.5, "isPhotoUploaded": false},
+			},
+			wantNextCursor: nil,
+		},
 		"invalid_requested_bool_type_conversion": {
 			request: &bamboohr.Request{
 				PageSize: 100,
@@ -3003,3 +3034,100 @@ func TestGetEmployeePage(t *testing.T) {
 		})
 	}
 }
+
+func TestGetChangedEmployeePage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/sgnltestdev/v1/employees/changed?since=2026-01-01T00%3A00%3A00Z":
+			w.Write([]byte(`{
+				"latest": "2026-01-05T10:00:00+00:00",
+				"employees": {
+					"9": {"id": "9", "action": "Updated", "lastChanged": "2026-01-05T10:00:00+00:00"},
+					"4": {"id": "4", "action": "Updated", "lastChanged": "2026-01-02T10:00:00+00:00"},
+					"6": {"id": "6", "action": "Deleted", "lastChanged": "2026-01-03T10:00:00+00:00"},
+					"5": {"id": "5", "action": "Inserted", "lastChanged": "2026-01-02T11:00:00+00:00"},
+					"7": {"id": "7", "action": "Updated", "lastChanged": "2026-01-04T10:00:00+00:00"}
+				}
+			}`))
+		case "/sgnltestdev/v1/employees/4?fields=id%2CbestEmail%2CisPhotoUploaded%2Cstatus":
+			w.Write([]byte(`{"id": "4", "bestEmail": "cabbott@efficientoffice.com", "isPhotoUploaded": "true", "status": "Active"}`))
+		case "/sgnltestdev/v1/employees/5?fields=id%2CbestEmail%2CisPhotoUploaded%2Cstatus":
+			w.Write([]byte(`{"id": "5", "bestEmail": "aadams@efficientoffice.com", "isPhotoUploaded": "false", "status": "Active"}`))
+		case "/sgnltestdev/v1/employees/7?fields=id%2CbestEmail%2CisPhotoUploaded%2Cstatus":
+			w.Write([]byte(`{"id": "7", "bestEmail": "sanderson@efficientoffice.com", "isPhotoUploaded": "true", "status": "Inactive"}`))
+		case "/sgnltestdev/v1/employees/9?fields=id%2CbestEmail%2CisPhotoUploaded%2Cstatus":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	bamboohrClient := bamboohr.NewClient(&http.Client{
+		Timeout: time.Duration(10) * time.Second,
+	})
+
+	entityConfig := &framework.EntityConfig{
+		ExternalId: bamboohr.Employee,
+		Attributes: []*framework.AttributeConfig{
+			{ExternalId: "id", Type: framework.AttributeTypeInt64},
+			{ExternalId: "bestEmail", Type: framework.AttributeTypeString},
+			{ExternalId: "isPhotoUploaded", Type: framework.AttributeTypeBool},
+		},
+	}
+
+	tests := map[string]struct {
+		cursor  *pagination.CompositeCursor[int64]
+		wantRes *bamboohr.Response
+		wantErr *framework.Error
+	}{
+		"first_page": {
+			wantRes: &bamboohr.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(4), "bestEmail": "cabbott@efficientoffice.com", "isPhotoUploaded": true, "status": "Active"},
+					{"id": float64(5), "bestEmail": "aadams@efficientoffice.com", "isPhotoUploaded": false, "status": "Active"},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](5),
+				},
+			},
+		},
+		"last_page_skips_inactive_and_deleted_employees": {
+			cursor: &pagination.CompositeCursor[int64]{
+				Cursor: testutil.GenPtr[int64](5),
+			},
+			wantRes: &bamboohr.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]any{},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := bamboohrClient.GetPage(context.Background(), &bamboohr.Request{
+				BaseURL:               server.URL,
+				APIKey:                "apiKey123",
+				BasicAuthPassword:     "randomString",
+				PageSize:              2,
+				APIVersion:            "v1",
+				CompanyDomain:         "sgnltestdev",
+				OnlyCurrent:           true,
+				ChangedSince:          testutil.GenPtr(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          entityConfig,
+				AttributeMappings:     &bamboohr.AttributeMappings{},
+				Cursor:                tt.cursor,
+			})
+
+			if !reflect.DeepEqual(gotRes, tt.wantRes) {
+				t.Errorf("gotRes: %+v, wantRes: %+v", gotRes, tt.wantRes)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
@@ -72,3 +74,37 @@ func ConstructEndpoint(request *Request) (*EndpointInfo, *framework.Error) {
 		Body: string(jsonData),
 	}, nil
 }
+
+// ConstructChangedEndpoint constructs and returns the endpoint to query the employees changed since
+// request.ChangedSince.
+// https://documentation.bamboohr.com/reference/get-employees-changed
+func ConstructChangedEndpoint(request *Request) string {
+	params := url.Values{}
+	params.Add("since", request.ChangedSince.UTC().Format(time.RFC3339))
+
+	return employeesEndpoint(request) + "/changed?" + params.Encode()
+}
+
+// ConstructEmployeeEndpoint constructs and returns the endpoint to query the fields of a single employee.
+// The status field is always requested to filter out inactive employees if request.OnlyCurrent is set.
+// https://documentation.bamboohr.com/reference/get-employee
+func ConstructEmployeeEndpoint(request *Request, employeeID string) string {
+	fields := make([]string, 0, len(request.EntityConfig.Attributes)+1)
+
+	for _, attr := range request.EntityConfig.Attributes {
+		fields = append(fields, attr.ExternalId)
+	}
+
+	if !slices.Contains(fields, statusField) {
+		fields = append(fields, statusField)
+	}
+
+	params := url.Values{}
+	params.Add("fields", strings.Join(fields, ","))
+
+	return employeesEndpoint(request) + "/" + url.PathEscape(employeeID) + "?" + params.Encode()
+}
+
+func employeesEndpoint(request *Request) string {
+	return request.BaseURL + "/" + request.CompanyDomain + "/" + request.APIVersion + "/employees"
+}
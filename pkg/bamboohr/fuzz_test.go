@@ -0,0 +1,53 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package bamboohr_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/bamboohr"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// FuzzParseResponse checks that malformed BambooHR custom report responses are rejected with an error instead of
+// a panic, including when the values of the employees don't match the types of their attributes.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"title":"Report","fields":[{"id":"id","type":"int","name":"EEID"}],"employees":[{"id":"4","bestEmail":"cabbott@efficientoffice.com","dateOfBirth":"1996-09-02","isPhotoUploaded":"true","customcustomBoolField":"yes","supervisorEId":"9"}]}`,
+		`{"employees":[{"id":"4","dateOfBirth":"0000-00-00","isPhotoUploaded":"maybe"}]}`,
+		`{"employees":[null]}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), int64(1), int64(0))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, pageSize int64, cursor int64) {
+		// The page size is validated before the request is sent.
+		if pageSize <= 0 {
+			t.Skip()
+		}
+
+		request := &bamboohr.Request{
+			PageSize:     pageSize,
+			EntityConfig: PopulateDefaultEmployeeEntityConfig(),
+			AttributeMappings: &bamboohr.AttributeMappings{
+				BoolMappings: &bamboohr.BoolAttributeMappings{
+					True:  []string{"yes"},
+					False: []string{"no"},
+				},
+			},
+			Cursor: &pagination.CompositeCursor[int64]{
+				Cursor: &cursor,
+			},
+		}
+
+		_, _, err := bamboohr.ParseResponse(body, request)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapters/pkg/bamboohr"
+	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/testutil"
 )
 
@@ -280,6 +281,39 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"incremental_sync_without_since": {
+			request: &framework.Request[bamboohr.Config]{
+				Address: "https://api.bamboohr.com/api/gateway.php/SGNL",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test API Key",
+						Password: "xxx",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Employee",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &bamboohr.Config{
+					CommonConfig: &config.CommonConfig{
+						SyncMode: config.SyncModeIncremental,
+					},
+					APIVersion:    "v1",
+					CompanyDomain: "SGNL",
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "BambooHR config is invalid: incrementalSyncSince is required when syncMode is INCREMENTAL.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"missing_optional_fields": {
 			request: &framework.Request[bamboohr.Config]{
 				Address: "https://api.bamboohr.com/api/gateway.php/SGNL",
@@ -0,0 +1,146 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package beyondtrustpasswordsafe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
+)
+
+// Adapter implements the framework.Adapter interface to query pages of objects
+// from datasources.
+type Adapter struct {
+	PasswordSafeClient Client
+}
+
+// NewAdapter instantiates a new Adapter.
+func NewAdapter(client Client) framework.Adapter[Config] {
+	return &Adapter{
+		PasswordSafeClient: client,
+	}
+}
+
+// GetPage is called by SGNL's ingestion service to query a page of objects
+// from a datasource.
+func (a *Adapter) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	if err := a.ValidateGetPageRequest(ctx, request); err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return a.RequestPageFromDatasource(ctx, request)
+}
+
+// RequestPageFromDatasource requests a page of objects from a datasource.
+func (a *Adapter) RequestPageFromDatasource(
+	ctx context.Context, request *framework.Request[Config],
+) framework.Response {
+	var commonConfig *config.CommonConfig
+	if request.Config != nil {
+		commonConfig = request.Config.CommonConfig
+	}
+
+	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
+
+	// Unmarshal the current cursor.
+	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor, request.Entity.ExternalId)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	signInReq := &SignInRequest{
+		BaseURL:               request.Address,
+		Authorization:         request.Auth.HTTPAuthorization,
+		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
+	}
+
+	if request.Auth.Basic != nil {
+		signInReq.ClientID = request.Auth.Basic.Username
+		signInReq.ClientSecret = request.Auth.Basic.Password
+	}
+
+	// The sessions are cached across pages.
+	sessionCookie, err := a.PasswordSafeClient.SignIn(ctx, signInReq, false)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	passwordSafeReq := &Request{
+		BaseURL:               request.Address,
+		SessionCookie:         sessionCookie,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
+		EntityExternalID:      request.Entity.ExternalId,
+		Cursor:                cursor,
+		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
+	}
+
+	resp, err := a.PasswordSafeClient.GetPage(ctx, passwordSafeReq)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	// Password Safe ends the sessions after a period of inactivity, so a cached session may have expired.
+	// Sign in again and retry the request once.
+	if resp.StatusCode == http.StatusUnauthorized {
+		passwordSafeReq.SessionCookie, err = a.PasswordSafeClient.SignIn(ctx, signInReq, true)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		resp, err = a.PasswordSafeClient.GetPage(ctx, passwordSafeReq)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+	}
+
+	// An adapter error message is generated if the response status code is not
+	// successful (i.e. if not statusCode >= 200 && statusCode < 300).
+	if adapterErr := web.HTTPError(resp.StatusCode, resp.RetryAfterHeader); adapterErr != nil {
+		return framework.NewGetPageResponseError(adapterErr)
+	}
+
+	schemadrift.Observe(ctx, resp.Objects)
+
+	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
+	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
+	parsedObjects, parserErr := web.ConvertJSONObjectList(
+		&request.Entity,
+		resp.Objects,
+		web.WithJSONPathAttributeNames(),
+		web.WithDateTimeFormats(
+			[]web.DateTimeFormatWithTimeZone{
+				{Format: time.RFC3339, HasTimeZone: true},
+				// Password Safe returns the times without a time zone, e.g. "LastLoginDate": "2024-03-05T14:12:37.123".
+				{Format: "2006-01-02T15:04:05", HasTimeZone: false},
+			}...,
+		),
+		web.WithLocalTimeZoneOffset(commonConfig.LocalTimeZoneOffset),
+	)
+	if parserErr != nil {
+		return framework.NewGetPageResponseError(
+			&framework.Error{
+				Message: fmt.Sprintf("Failed to convert datasource response objects: %v.", parserErr),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		)
+	}
+
+	// Marshal the next cursor.
+	nextCursor, err := pagination.MarshalCursor(resp.NextCursor)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return framework.NewGetPageResponseSuccess(&framework.Page{
+		Objects:    parsedObjects,
+		NextCursor: nextCursor,
+	})
+}
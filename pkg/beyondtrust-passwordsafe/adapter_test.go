@@ -0,0 +1,303 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package beyondtrustpasswordsafe_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	beyondtrustpasswordsafe "github.com/sgnl-ai/adapters/pkg/beyondtrust-passwordsafe"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := beyondtrustpasswordsafe.NewAdapter(&beyondtrustpasswordsafe.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[int64]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	apiKeyAuth := &framework.DatasourceAuthCredentials{
+		HTTPAuthorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[beyondtrustpasswordsafe.Config]
+		wantResponse framework.Response
+	}{
+		"users_first_page": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: server.URL,
+				Auth:    apiKeyAuth,
+				Config:  &beyondtrustpasswordsafe.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: beyondtrustpasswordsafe.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "UserID",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "UserName",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "IsActive",
+							Type:       framework.AttributeTypeBool,
+						},
+						{
+							ExternalId: "LastLoginDate",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"UserID":        int64(1),
+							"UserName":      "svc-sgnl",
+							"IsActive":      true,
+							"LastLoginDate": time.Date(2024, 3, 5, 14, 12, 37, 123000000, time.UTC),
+						},
+						{
+							"UserID":        int64(2),
+							"UserName":      "alice",
+							"IsActive":      true,
+							"LastLoginDate": time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[int64]{
+						Cursor: testutil.GenPtr[int64](2),
+					}),
+				},
+			},
+		},
+		"managed_accounts_client_credentials": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "bt-client",
+						Password: "bt-secret",
+					},
+				},
+				Config: &beyondtrustpasswordsafe.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: beyondtrustpasswordsafe.ManagedAccount,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "AccountId",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "AccountName",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "SystemId",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 2,
+				Cursor: marshalCursor(&pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				}),
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"AccountId":   int64(23),
+							"AccountName": "Administrator",
+							"SystemId":    int64(13),
+						},
+					},
+				},
+			},
+		},
+		"access_policies_schedules": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: server.URL,
+				Auth:    apiKeyAuth,
+				Config:  &beyondtrustpasswordsafe.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: beyondtrustpasswordsafe.AccessPolicy,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "AccessPolicyID",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "$.Schedules[*].ScheduleID",
+							Type:       framework.AttributeTypeInt64,
+							List:       true,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"AccessPolicyID":            int64(1),
+							"$.Schedules[*].ScheduleID": []int64{3},
+						},
+					},
+				},
+			},
+		},
+		"invalid_api_key": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "PS-Auth key=invalid; runas=svc-sgnl;",
+				},
+				Config: &beyondtrustpasswordsafe.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: beyondtrustpasswordsafe.UserGroup,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "GroupID",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to sign in to BeyondTrust Password Safe: Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+		"invalid_client_secret": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "bt-client",
+						Password: "invalid",
+					},
+				},
+				Config: &beyondtrustpasswordsafe.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: beyondtrustpasswordsafe.SmartRule,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "SmartRuleID",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to get an access token for BeyondTrust Password Safe client bt-client: invalid client ID or secret.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdapterGetPageExpiredSession(t *testing.T) {
+	var (
+		signIns         atomic.Int32
+		sessionsExpired atomic.Bool
+	)
+
+	// The sessions signed in before sessionsExpired is set are rejected, as if Password Safe had ended them.
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/BeyondTrust/api/public/v3/Auth/SignAppin" {
+			signIns.Add(1)
+			sessionsExpired.Store(false)
+		} else if sessionsExpired.Load() {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		TestServerHandler(w, r)
+	}))
+	defer server.Close()
+
+	adapter := beyondtrustpasswordsafe.NewAdapter(&beyondtrustpasswordsafe.Datasource{
+		Client: server.Client(),
+	})
+
+	request := &framework.Request[beyondtrustpasswordsafe.Config]{
+		Address: server.URL,
+		Auth: &framework.DatasourceAuthCredentials{
+			HTTPAuthorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+		},
+		Config: &beyondtrustpasswordsafe.Config{},
+		Entity: framework.EntityConfig{
+			ExternalId: beyondtrustpasswordsafe.UserGroup,
+			Attributes: []*framework.AttributeConfig{
+				{
+					ExternalId: "GroupID",
+					Type:       framework.AttributeTypeInt64,
+				},
+			},
+		},
+		PageSize: 10,
+	}
+
+	wantResponse := framework.Response{
+		Success: &framework.Page{
+			Objects: []framework.Object{
+				{"GroupID": int64(1)},
+				{"GroupID": int64(4)},
+			},
+		},
+	}
+
+	for i, sessionExpired := range []bool{false, false, true} {
+		sessionsExpired.Store(sessionExpired)
+
+		gotResponse := adapter.GetPage(context.Background(), request)
+
+		if diff := cmp.Diff(gotResponse, wantResponse); diff != "" {
+			t.Errorf("page %d: adapter.GetPage() mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+
+	// The session is signed in for the first page, reused by the second one, and signed in again once expired.
+	if got := signIns.Load(); got != 2 {
+		t.Errorf("gotSignIns: %d, wantSignIns: 2", got)
+	}
+}
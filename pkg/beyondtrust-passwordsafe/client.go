@@ -0,0 +1,62 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package beyondtrustpasswordsafe
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Client is a client that allows querying the BeyondTrust Password Safe datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+
+	// SignIn returns the Cookie header value of a session of the Password Safe API, signing in with the API key or
+	// the OAuth client credentials of the request. Sessions are cached, unless refresh is set, e.g. once a cached
+	// session has expired.
+	SignIn(ctx context.Context, request *SignInRequest, refresh bool) (string, *framework.Error)
+}
+
+// Request is a request to the Password Safe API.
+type Request struct {
+	// BaseURL is the base URL of the Password Safe instance, e.g. "https://acme.ps.beyondtrustcloud.com" or
+	// "https://pam.acme.com". The API is requested under /BeyondTrust/api/public/v3.
+	BaseURL string
+
+	// SessionCookie is the Cookie header value of the session to authenticate a request, as returned by SignIn.
+	SessionCookie string
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity. The cursor is the offset of the first object of the page.
+	// nil in the request for the first page.
+	Cursor *pagination.CompositeCursor[int64]
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *pagination.CompositeCursor[int64]
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package beyondtrustpasswordsafe
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// apiPath is the path of the Password Safe API, relative to the address of the instance.
+const apiPath = "/BeyondTrust/api/public/v3"
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+
+	// sessions caches the sessions of the API keys and OAuth client credentials.
+	sessions sessionCache
+}
+
+// PagedResponse is the format of the responses of the endpoints paginated with the "limit" and "offset"
+// parameters, e.g. GET /ManagedAccounts?limit=100&offset=0.
+type PagedResponse struct {
+	TotalCount int64            `json:"TotalCount"`
+	Data       []map[string]any `json:"Data"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// path is the path of the endpoint of the entity, relative to the API.
+	path string
+	// paged is whether the endpoint is paginated with the "limit" and "offset" parameters. The other endpoints
+	// return all their objects at once, which are paginated by the adapter.
+	paged bool
+}
+
+const (
+	User           string = "User"
+	UserGroup      string = "UserGroup"
+	SmartRule      string = "SmartRule"
+	ManagedAccount string = "ManagedAccount"
+	AccessPolicy   string = "AccessPolicy"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// GET /BeyondTrust/api/public/v3/Users.
+		User: {
+			uniqueIDAttrExternalID: "UserID",
+			path:                   "/Users",
+		},
+		// GET /BeyondTrust/api/public/v3/UserGroups.
+		UserGroup: {
+			uniqueIDAttrExternalID: "GroupID",
+			path:                   "/UserGroups",
+		},
+		// GET /BeyondTrust/api/public/v3/SmartRules.
+		SmartRule: {
+			uniqueIDAttrExternalID: "SmartRuleID",
+			path:                   "/SmartRules",
+		},
+		// GET /BeyondTrust/api/public/v3/ManagedAccounts?limit={limit}&offset={offset}.
+		// The managed accounts that can be requested by the user of the session.
+		ManagedAccount: {
+			uniqueIDAttrExternalID: "AccountId",
+			path:                   "/ManagedAccounts",
+			paged:                  true,
+		},
+		// GET /BeyondTrust/api/public/v3/AccessPolicies.
+		AccessPolicy: {
+			uniqueIDAttrExternalID: "AccessPolicyID",
+			path:                   "/AccessPolicies",
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects        []map[string]any
+		nextPageCursor *int64
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpoint,
+		Header: http.Header{
+			"Cookie": {request.SessionCookie},
+			"Accept": {"application/json"},
+		},
+		DatasourceName:        "BeyondTrust Password Safe",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "BeyondTrust Password Safe")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		if entity.paged {
+			objects, nextPageCursor, parseErr = ParsePagedResponse(bodyBytes, request.Cursor)
+		} else {
+			objects, nextPageCursor, parseErr = ParseResponse(bodyBytes, request.PageSize, request.Cursor)
+		}
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	response.Objects = objects
+
+	if nextPageCursor != nil {
+		response.NextCursor = &pagination.CompositeCursor[int64]{
+			Cursor: nextPageCursor,
+		}
+	}
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the objects of an endpoint returning all its objects at once, e.g. GET /Users, and returns
+// the page of the objects starting at the offset of the cursor, with the offset of the next page, if any.
+func ParseResponse(
+	body []byte, pageSize int64, cursor *pagination.CompositeCursor[int64],
+) (objects []map[string]any, nextCursor *int64, err *framework.Error) {
+	var data []map[string]any
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	return pagination.PaginateObjects(data, pageSize, cursor)
+}
+
+// ParsePagedResponse parses the objects of a response of an endpoint paginated with the "limit" and "offset"
+// parameters, and returns the offset of the next page, if any.
+func ParsePagedResponse(
+	body []byte, cursor *pagination.CompositeCursor[int64],
+) (objects []map[string]any, nextCursor *int64, err *framework.Error) {
+	var data PagedResponse
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	offset, err := cursor.ParseOffsetValue()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextOffset := offset + int64(len(data.Data))
+	if len(data.Data) == 0 || nextOffset >= data.TotalCount {
+		return data.Data, nil, nil
+	}
+
+	return data.Data, &nextOffset, nil
+}
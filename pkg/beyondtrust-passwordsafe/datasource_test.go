@@ -0,0 +1,442 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package beyondtrustpasswordsafe_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	beyondtrustpasswordsafe "github.com/sgnl-ai/adapters/pkg/beyondtrust-passwordsafe"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock Password Safe API server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	// Access token of the bt-client OAuth client credentials.
+	case "/BeyondTrust/api/public/v3/Auth/connect/token":
+		if r.Method != http.MethodPost || r.PostFormValue("grant_type") != "client_credentials" ||
+			r.PostFormValue("client_id") != "bt-client" || r.PostFormValue("client_secret") != "bt-secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "invalid_client"}`))
+
+			return
+		}
+
+		w.Write([]byte(`{"access_token": "bt-token", "expires_in": 3600, "token_type": "Bearer", "scope": "publicapi"}`))
+
+		return
+
+	// Session of either the API key running as svc-sgnl, or the access token of the OAuth client credentials.
+	case "/BeyondTrust/api/public/v3/Auth/SignAppin":
+		authorization := r.Header.Get("Authorization")
+
+		if r.Method != http.MethodPost ||
+			authorization != "PS-Auth key=bt-test; runas=svc-sgnl;" && authorization != "Bearer bt-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`"User not authenticated"`))
+
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{Name: "ASP.NET_SessionId", Value: "bt-session", HttpOnly: true})
+		w.Write([]byte(`{"UserId": 1, "SID": null, "EmailAddress": "svc-sgnl@acme.com", "UserName": "svc-sgnl", "Name": "SGNL Service"}`))
+
+		return
+	}
+
+	if cookie, err := r.Cookie("ASP.NET_SessionId"); err != nil || cookie.Value != "bt-session" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`"User not authenticated"`))
+
+		return
+	}
+
+	switch r.URL.RequestURI() {
+	// Users, paginated by the adapter.
+	case "/BeyondTrust/api/public/v3/Users":
+		w.Write([]byte(`[
+			{"UserID": 1, "UserName": "svc-sgnl", "FirstName": "SGNL", "LastName": "Service", "EmailAddress": "svc-sgnl@acme.com", "IsActive": true, "LastLoginDate": "2024-03-05T14:12:37.123"},
+			{"UserID": 2, "UserName": "alice", "FirstName": "Alice", "LastName": "Smith", "EmailAddress": "alice@acme.com", "IsActive": true, "LastLoginDate": "2024-03-06T09:00:00"},
+			{"UserID": 5, "UserName": "bob", "FirstName": "Bob", "LastName": "Jones", "EmailAddress": "bob@acme.com", "IsActive": false, "LastLoginDate": null}
+		]`))
+
+	// User Groups
+	case "/BeyondTrust/api/public/v3/UserGroups":
+		w.Write([]byte(`[
+			{"GroupID": 1, "Name": "Administrators", "GroupType": "BeyondInsight", "DistinguishedName": null, "IsActive": true},
+			{"GroupID": 4, "Name": "ACME\\Vault Admins", "GroupType": "ActiveDirectory", "DistinguishedName": "CN=Vault Admins,OU=Groups,DC=acme,DC=com", "IsActive": true}
+		]`))
+
+	// Smart Rules
+	case "/BeyondTrust/api/public/v3/SmartRules":
+		w.Write([]byte(`[
+			{"SmartRuleID": 1, "Title": "All Managed Systems", "Category": "Managed Systems", "Status": 1, "LastProcessedDate": "2024-03-05T14:00:00", "IsReadOnly": true, "RuleType": "ManagedSystem"},
+			{"SmartRuleID": 7, "Title": "Database Accounts", "Category": "Managed Accounts", "Status": 1, "LastProcessedDate": "2024-03-05T14:05:00", "IsReadOnly": false, "RuleType": "ManagedAccount"}
+		]`))
+
+	// Managed Accounts Page 1
+	case "/BeyondTrust/api/public/v3/ManagedAccounts?limit=2&offset=0":
+		w.Write([]byte(`{"TotalCount": 3, "Data": [
+			{"PlatformID": 10, "SystemId": 11, "SystemName": "db01", "DomainName": null, "AccountId": 21, "AccountName": "sa", "DefaultReleaseDuration": 120, "MaximumReleaseDuration": 525600, "LastChangeDate": "2024-03-01T00:00:00", "IsChanging": false},
+			{"PlatformID": 2, "SystemId": 12, "SystemName": "web01", "DomainName": null, "AccountId": 22, "AccountName": "root", "DefaultReleaseDuration": 120, "MaximumReleaseDuration": 525600, "LastChangeDate": null, "IsChanging": false}
+		]}`))
+
+	// Managed Accounts Page 2
+	case "/BeyondTrust/api/public/v3/ManagedAccounts?limit=2&offset=2":
+		w.Write([]byte(`{"TotalCount": 3, "Data": [
+			{"PlatformID": 1, "SystemId": 13, "SystemName": "dc01", "DomainName": "acme.com", "AccountId": 23, "AccountName": "Administrator", "DefaultReleaseDuration": 60, "MaximumReleaseDuration": 240, "LastChangeDate": "2024-03-02T00:00:00", "IsChanging": true}
+		]}`))
+
+	// Access Policies
+	case "/BeyondTrust/api/public/v3/AccessPolicies":
+		w.Write([]byte(`[
+			{"AccessPolicyID": 1, "Name": "Business Hours", "Description": "Requests during business hours", "Schedules": [{"ScheduleID": 3, "ViewAccess": true, "RequestAccess": true, "ApproversRequired": 1, "RecordSessionsFlag": true}]}
+		]`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body           []byte
+		pageSize       int64
+		cursor         *pagination.CompositeCursor[int64]
+		wantObjects    []map[string]any
+		wantNextCursor *int64
+		wantErr        *framework.Error
+	}{
+		"first_page": {
+			body:           []byte(`[{"UserID": 1}, {"UserID": 2}, {"UserID": 5}]`),
+			pageSize:       2,
+			wantObjects:    []map[string]any{{"UserID": float64(1)}, {"UserID": float64(2)}},
+			wantNextCursor: testutil.GenPtr[int64](2),
+		},
+		"last_page": {
+			body:     []byte(`[{"UserID": 1}, {"UserID": 2}, {"UserID": 5}]`),
+			pageSize: 2,
+			cursor: &pagination.CompositeCursor[int64]{
+				Cursor: testutil.GenPtr[int64](2),
+			},
+			wantObjects: []map[string]any{{"UserID": float64(5)}},
+		},
+		"no_objects": {
+			body:        []byte(`[]`),
+			pageSize:    2,
+			wantObjects: []map[string]any{},
+		},
+		"cursor_out_of_range": {
+			body:     []byte(`[{"UserID": 1}]`),
+			pageSize: 2,
+			cursor: &pagination.CompositeCursor[int64]{
+				Cursor: testutil.GenPtr[int64](2),
+			},
+			wantErr: &framework.Error{
+				Message: "The cursor value: 2, is out of range for number of objects: 1",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"invalid_response": {
+			body:     []byte(`{"TotalCount": 0, "Data": []}`),
+			pageSize: 2,
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal object into Go value of type []map[string]interface {}.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextCursor, gotErr := beyondtrustpasswordsafe.ParseResponse(tt.body, tt.pageSize, tt.cursor)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextCursor, tt.wantNextCursor) {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", gotNextCursor, tt.wantNextCursor)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePagedResponse(t *testing.T) {
+	tests := map[string]struct {
+		body           []byte
+		cursor         *pagination.CompositeCursor[int64]
+		wantObjects    []map[string]any
+		wantNextCursor *int64
+		wantErr        *framework.Error
+	}{
+		"first_page": {
+			body:           []byte(`{"TotalCount": 3, "Data": [{"AccountId": 21}, {"AccountId": 22}]}`),
+			wantObjects:    []map[string]any{{"AccountId": float64(21)}, {"AccountId": float64(22)}},
+			wantNextCursor: testutil.GenPtr[int64](2),
+		},
+		"last_page": {
+			body: []byte(`{"TotalCount": 3, "Data": [{"AccountId": 23}]}`),
+			cursor: &pagination.CompositeCursor[int64]{
+				Cursor: testutil.GenPtr[int64](2),
+			},
+			wantObjects: []map[string]any{{"AccountId": float64(23)}},
+		},
+		"no_objects": {
+			body:        []byte(`{"TotalCount": 0, "Data": []}`),
+			wantObjects: []map[string]any{},
+		},
+		"invalid_response": {
+			body: []byte(`[]`),
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type beyondtrustpasswordsafe.PagedResponse.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextCursor, gotErr := beyondtrustpasswordsafe.ParsePagedResponse(tt.body, tt.cursor)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextCursor, tt.wantNextCursor) {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", gotNextCursor, tt.wantNextCursor)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := beyondtrustpasswordsafe.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *beyondtrustpasswordsafe.Request
+		wantResponse *beyondtrustpasswordsafe.Response
+		wantErr      *framework.Error
+	}{
+		"users_first_page": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          server.URL,
+				SessionCookie:    "ASP.NET_SessionId=bt-session",
+				PageSize:         2,
+				EntityExternalID: beyondtrustpasswordsafe.User,
+			},
+			wantResponse: &beyondtrustpasswordsafe.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"UserID": float64(1), "UserName": "svc-sgnl", "FirstName": "SGNL", "LastName": "Service", "EmailAddress": "svc-sgnl@acme.com", "IsActive": true, "LastLoginDate": "2024-03-05T14:12:37.123"},
+					{"UserID": float64(2), "UserName": "alice", "FirstName": "Alice", "LastName": "Smith", "EmailAddress": "alice@acme.com", "IsActive": true, "LastLoginDate": "2024-03-06T09:00:00"},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+		},
+		"users_last_page": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          server.URL,
+				SessionCookie:    "ASP.NET_SessionId=bt-session",
+				PageSize:         2,
+				EntityExternalID: beyondtrustpasswordsafe.User,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+			wantResponse: &beyondtrustpasswordsafe.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"UserID": float64(5), "UserName": "bob", "FirstName": "Bob", "LastName": "Jones", "EmailAddress": "bob@acme.com", "IsActive": false, "LastLoginDate": nil},
+				},
+			},
+		},
+		"managed_accounts_first_page": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          server.URL,
+				SessionCookie:    "ASP.NET_SessionId=bt-session",
+				PageSize:         2,
+				EntityExternalID: beyondtrustpasswordsafe.ManagedAccount,
+			},
+			wantResponse: &beyondtrustpasswordsafe.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"PlatformID": float64(10), "SystemId": float64(11), "SystemName": "db01", "DomainName": nil, "AccountId": float64(21), "AccountName": "sa", "DefaultReleaseDuration": float64(120), "MaximumReleaseDuration": float64(525600), "LastChangeDate": "2024-03-01T00:00:00", "IsChanging": false},
+					{"PlatformID": float64(2), "SystemId": float64(12), "SystemName": "web01", "DomainName": nil, "AccountId": float64(22), "AccountName": "root", "DefaultReleaseDuration": float64(120), "MaximumReleaseDuration": float64(525600), "LastChangeDate": nil, "IsChanging": false},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+		},
+		"managed_accounts_last_page": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          server.URL,
+				SessionCookie:    "ASP.NET_SessionId=bt-session",
+				PageSize:         2,
+				EntityExternalID: beyondtrustpasswordsafe.ManagedAccount,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+			wantResponse: &beyondtrustpasswordsafe.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"PlatformID": float64(1), "SystemId": float64(13), "SystemName": "dc01", "DomainName": "acme.com", "AccountId": float64(23), "AccountName": "Administrator", "DefaultReleaseDuration": float64(60), "MaximumReleaseDuration": float64(240), "LastChangeDate": "2024-03-02T00:00:00", "IsChanging": true},
+				},
+			},
+		},
+		"access_policies": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          server.URL,
+				SessionCookie:    "ASP.NET_SessionId=bt-session",
+				PageSize:         2,
+				EntityExternalID: beyondtrustpasswordsafe.AccessPolicy,
+			},
+			wantResponse: &beyondtrustpasswordsafe.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"AccessPolicyID": float64(1),
+						"Name":           "Business Hours",
+						"Description":    "Requests during business hours",
+						"Schedules": []any{
+							map[string]any{"ScheduleID": float64(3), "ViewAccess": true, "RequestAccess": true, "ApproversRequired": float64(1), "RecordSessionsFlag": true},
+						},
+					},
+				},
+			},
+		},
+		"expired_session": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          server.URL,
+				SessionCookie:    "ASP.NET_SessionId=bt-expired",
+				PageSize:         2,
+				EntityExternalID: beyondtrustpasswordsafe.UserGroup,
+			},
+			wantResponse: &beyondtrustpasswordsafe.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSignIn(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := beyondtrustpasswordsafe.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request    *beyondtrustpasswordsafe.SignInRequest
+		wantCookie string
+		wantErr    *framework.Error
+	}{
+		"api_key": {
+			request: &beyondtrustpasswordsafe.SignInRequest{
+				BaseURL:       server.URL,
+				Authorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+			},
+			wantCookie: "ASP.NET_SessionId=bt-session",
+		},
+		"client_credentials": {
+			request: &beyondtrustpasswordsafe.SignInRequest{
+				BaseURL:      server.URL,
+				ClientID:     "bt-client",
+				ClientSecret: "bt-secret",
+			},
+			wantCookie: "ASP.NET_SessionId=bt-session",
+		},
+		"invalid_api_key": {
+			request: &beyondtrustpasswordsafe.SignInRequest{
+				BaseURL:       server.URL,
+				Authorization: "PS-Auth key=invalid; runas=svc-sgnl;",
+			},
+			wantErr: &framework.Error{
+				Message: "Failed to sign in to BeyondTrust Password Safe: Failed to authenticate with datasource. Check datasource configuration details and try again.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+		},
+		"invalid_client_secret": {
+			request: &beyondtrustpasswordsafe.SignInRequest{
+				BaseURL:      server.URL,
+				ClientID:     "bt-client",
+				ClientSecret: "invalid",
+			},
+			wantErr: &framework.Error{
+				Message: "Failed to get an access token for BeyondTrust Password Safe client bt-client: invalid client ID or secret.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotCookie, gotErr := client.SignIn(context.Background(), tt.request, false)
+
+			if gotCookie != tt.wantCookie {
+				t.Errorf("gotCookie: %v, wantCookie: %v", gotCookie, tt.wantCookie)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("cached_session", func(t *testing.T) {
+		request := &beyondtrustpasswordsafe.SignInRequest{
+			BaseURL:       server.URL,
+			Authorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+		}
+
+		if _, err := client.SignIn(context.Background(), request, false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// The cached session is returned without signing in.
+		server.Close()
+
+		gotCookie, gotErr := client.SignIn(context.Background(), request, false)
+		if gotErr != nil || gotCookie != "ASP.NET_SessionId=bt-session" {
+			t.Errorf("gotCookie: %v, gotErr: %v, wantCookie: ASP.NET_SessionId=bt-session", gotCookie, gotErr)
+		}
+
+		// A refreshed session is requested even if one is cached.
+		if _, gotErr = client.SignIn(context.Background(), request, true); gotErr == nil {
+			t.Error("gotErr: nil, want an error signing in to the closed server")
+		}
+	})
+}
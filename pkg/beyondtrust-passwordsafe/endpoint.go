@@ -0,0 +1,51 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package beyondtrustpasswordsafe
+
+import (
+	"net/url"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// For example, the endpoint of the second page of 100 managed accounts is:
+// https://acme.ps.beyondtrustcloud.com/BeyondTrust/api/public/v3/ManagedAccounts?limit=100&offset=100.
+// The endpoints which aren't paginated return all their objects, e.g. the users with
+// https://acme.ps.beyondtrustcloud.com/BeyondTrust/api/public/v3/Users.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	endpoint := request.BaseURL + apiPath + entity.path
+
+	if !entity.paged {
+		return endpoint, nil
+	}
+
+	var offset int64
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		offset = *request.Cursor.Cursor
+	}
+
+	params := url.Values{
+		"limit":  {strconv.FormatInt(request.PageSize, 10)},
+		"offset": {strconv.FormatInt(offset, 10)},
+	}
+
+	return endpoint + "?" + params.Encode(), nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package beyondtrustpasswordsafe_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	beyondtrustpasswordsafe "github.com/sgnl-ai/adapters/pkg/beyondtrust-passwordsafe"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *beyondtrustpasswordsafe.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          "https://acme.ps.beyondtrustcloud.com",
+				PageSize:         100,
+				EntityExternalID: "Secret",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"users": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          "https://acme.ps.beyondtrustcloud.com",
+				PageSize:         100,
+				EntityExternalID: beyondtrustpasswordsafe.User,
+			},
+			wantEndpoint: "https://acme.ps.beyondtrustcloud.com/BeyondTrust/api/public/v3/Users",
+		},
+		"user_groups_next_page": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          "https://acme.ps.beyondtrustcloud.com",
+				PageSize:         100,
+				EntityExternalID: beyondtrustpasswordsafe.UserGroup,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](100),
+				},
+			},
+			wantEndpoint: "https://acme.ps.beyondtrustcloud.com/BeyondTrust/api/public/v3/UserGroups",
+		},
+		"smart_rules": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          "https://acme.ps.beyondtrustcloud.com",
+				PageSize:         100,
+				EntityExternalID: beyondtrustpasswordsafe.SmartRule,
+			},
+			wantEndpoint: "https://acme.ps.beyondtrustcloud.com/BeyondTrust/api/public/v3/SmartRules",
+		},
+		"managed_accounts": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          "https://acme.ps.beyondtrustcloud.com",
+				PageSize:         100,
+				EntityExternalID: beyondtrustpasswordsafe.ManagedAccount,
+			},
+			wantEndpoint: "https://acme.ps.beyondtrustcloud.com/BeyondTrust/api/public/v3/ManagedAccounts?limit=100&offset=0",
+		},
+		"managed_accounts_next_page_on_premises": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          "https://pam.acme.com",
+				PageSize:         100,
+				EntityExternalID: beyondtrustpasswordsafe.ManagedAccount,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](200),
+				},
+			},
+			wantEndpoint: "https://pam.acme.com/BeyondTrust/api/public/v3/ManagedAccounts?limit=100&offset=200",
+		},
+		"access_policies": {
+			request: &beyondtrustpasswordsafe.Request{
+				BaseURL:          "https://acme.ps.beyondtrustcloud.com",
+				PageSize:         100,
+				EntityExternalID: beyondtrustpasswordsafe.AccessPolicy,
+			},
+			wantEndpoint: "https://acme.ps.beyondtrustcloud.com/BeyondTrust/api/public/v3/AccessPolicies",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := beyondtrustpasswordsafe.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package beyondtrustpasswordsafe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+)
+
+// sessionTTL is how long the sessions are cached by the Datasource. Password Safe ends the sessions after a period
+// of inactivity, so a session expiring earlier is rejected with a 401 and the adapter signs in again.
+const sessionTTL = 15 * time.Minute
+
+// SignInRequest is a request to sign in to the Password Safe API, either with the PS-Auth Authorization header
+// of an API key, or with the client ID and secret of an API registration using OAuth client credentials:
+// https://docs.beyondtrust.com/bips/docs/ps-api-authentication.
+type SignInRequest struct {
+	// BaseURL is the base URL of the Password Safe instance.
+	BaseURL string
+
+	// Authorization is the PS-Auth Authorization header value of an API key, e.g.
+	// "PS-Auth key=c479a66f...; runas=svc-sgnl;", with the password of the user if the API registration requires
+	// it, e.g. "PS-Auth key=c479a66f...; runas=svc-sgnl; pwd=[secret];". Empty if ClientID is set.
+	Authorization string
+
+	// ClientID is the client ID of an API registration using OAuth client credentials.
+	ClientID string
+
+	// ClientSecret is the client secret of an API registration using OAuth client credentials.
+	ClientSecret string
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	RequestTimeoutSeconds int
+}
+
+// tokenResponse is the response of the OAuth token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	Error       string `json:"error"`
+}
+
+// cachedSession is the Cookie header value of a session cached by the Datasource.
+type cachedSession struct {
+	cookie    string
+	expiresAt time.Time
+}
+
+// sessionCache caches the sessions of the credentials across pages.
+type sessionCache struct {
+	mu       sync.Mutex
+	sessions map[SignInRequest]cachedSession
+}
+
+func (c *sessionCache) get(request SignInRequest) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, found := c.sessions[request]
+	if !found || !time.Now().Before(cached.expiresAt) {
+		return "", false
+	}
+
+	return cached.cookie, true
+}
+
+func (c *sessionCache) set(request SignInRequest, cookie string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sessions == nil {
+		c.sessions = make(map[SignInRequest]cachedSession)
+	}
+
+	c.sessions[request] = cachedSession{
+		cookie:    cookie,
+		expiresAt: time.Now().Add(sessionTTL),
+	}
+}
+
+// SignIn returns the Cookie header value of a session of the Password Safe API, signing in with
+// POST /Auth/SignAppin. The OAuth client credentials are first exchanged for an access token, which is only used
+// to sign in. Sessions are cached for sessionTTL, unless refresh is set.
+// The sessions aren't signed out, so that they're reused by the following pages.
+func (d *Datasource) SignIn(ctx context.Context, request *SignInRequest, refresh bool) (string, *framework.Error) {
+	if !refresh {
+		if cookie, found := d.sessions.get(*request); found {
+			return cookie, nil
+		}
+	}
+
+	authorization := request.Authorization
+
+	if request.ClientID != "" {
+		token, err := d.getToken(ctx, request)
+		if err != nil {
+			return "", err
+		}
+
+		authorization = "Bearer " + token
+	}
+
+	var cookies []string
+
+	httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+		Method: http.MethodPost,
+		URL:    request.BaseURL + apiPath + "/Auth/SignAppin",
+		Header: http.Header{
+			"Authorization": {authorization},
+		},
+		DatasourceName:        "BeyondTrust Password Safe sign-in",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+	}, nil, &httpds.Hooks{
+		InspectResponse: func(res *http.Response) {
+			for _, cookie := range res.Cookies() {
+				cookies = append(cookies, cookie.Name+"="+cookie.Value)
+			}
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if adapterErr := web.HTTPError(httpResponse.StatusCode, httpResponse.RetryAfterHeader); adapterErr != nil {
+		adapterErr.Message = "Failed to sign in to BeyondTrust Password Safe: " + adapterErr.Message
+
+		return "", adapterErr
+	}
+
+	if len(cookies) == 0 {
+		return "", &framework.Error{
+			Message: "BeyondTrust Password Safe sign-in response is missing a session cookie.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	cookie := strings.Join(cookies, "; ")
+
+	d.sessions.set(*request, cookie)
+
+	return cookie, nil
+}
+
+// getToken returns an access token of the OAuth client credentials of the request, requested with
+// POST /Auth/connect/token.
+func (d *Datasource) getToken(ctx context.Context, request *SignInRequest) (string, *framework.Error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {request.ClientID},
+		"client_secret": {request.ClientSecret},
+	}
+
+	var token tokenResponse
+
+	httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+		Method: http.MethodPost,
+		URL:    request.BaseURL + apiPath + "/Auth/connect/token",
+		Body:   []byte(form.Encode()),
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		DatasourceName:        "BeyondTrust Password Safe token",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, err := httpds.ReadAll(body, "BeyondTrust Password Safe token")
+		if err != nil {
+			return err
+		}
+
+		return httpds.UnmarshalJSON(bodyBytes, &token)
+	}, &httpds.Hooks{
+		// The body of the 400 responses is read to tell invalid client credentials from other errors.
+		IsSuccess: func(statusCode int) bool {
+			return statusCode == http.StatusOK || statusCode == http.StatusBadRequest
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Invalid client credentials are rejected with a 400 invalid_client error, rather than a 401.
+	if httpResponse.StatusCode == http.StatusBadRequest && token.Error == "invalid_client" {
+		return "", &framework.Error{
+			Message: fmt.Sprintf(
+				"Failed to get an access token for BeyondTrust Password Safe client %s: invalid client ID or secret.",
+				request.ClientID,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+		}
+	}
+
+	if adapterErr := web.HTTPError(httpResponse.StatusCode, httpResponse.RetryAfterHeader); adapterErr != nil {
+		adapterErr.Message = fmt.Sprintf(
+			"Failed to get an access token for BeyondTrust Password Safe client %s: %s",
+			request.ClientID, adapterErr.Message,
+		)
+
+		return "", adapterErr
+	}
+
+	if token.AccessToken == "" {
+		return "", &framework.Error{
+			Message: fmt.Sprintf(
+				"BeyondTrust Password Safe token response for client %s is missing an access token.", request.ClientID,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return token.AccessToken, nil
+}
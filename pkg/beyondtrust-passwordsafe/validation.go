@@ -0,0 +1,102 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package beyondtrustpasswordsafe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+
+	"github.com/sgnl-ai/adapters/pkg/validation"
+)
+
+const (
+	// MaxPageSize is the maximum page size allowed in a GetPage request.
+	MaxPageSize = 1000
+)
+
+// ValidateGetPageRequest validates the fields of the GetPage Request.
+func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework.Request[Config]) *framework.Error {
+	if err := request.Config.Validate(ctx); err != nil {
+		return &framework.Error{
+			Message: fmt.Sprintf("BeyondTrust Password Safe config is invalid: %v.", err.Error()),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	trimmedAddress, parsed, err := validation.ParseAndValidateAddress(request.Address, []string{"https"})
+	if err != nil {
+		return err
+	}
+
+	// Normalize address with https:// scheme if not provided
+	if parsed.Scheme == "" {
+		request.Address = "https://" + trimmedAddress
+	} else {
+		request.Address = trimmedAddress
+	}
+
+	// The Password Safe API is authenticated with sessions, signed in with the credentials of an API registration.
+	// Two types of credentials are supported:
+	// 1. The PS-Auth Authorization header of an API key, with the username of the user to run as, e.g.
+	//    "PS-Auth key=c479a66f...; runas=svc-sgnl;" - should be supplied as request.Auth.HTTPAuthorization.
+	// 2. The client ID and client secret of an API registration using OAuth client credentials - should be supplied
+	//    as request.Auth.Basic. They are exchanged for an access token to sign in.
+	if request.Auth == nil || request.Auth.Basic == nil && request.Auth.HTTPAuthorization == "" {
+		return &framework.Error{
+			Message: "Request to BeyondTrust Password Safe is missing Basic credentials or PS-Auth API key credentials.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	if request.Auth.HTTPAuthorization != "" && !strings.HasPrefix(request.Auth.HTTPAuthorization, "PS-Auth ") {
+		return &framework.Error{
+			Message: `Provided auth token is missing required "PS-Auth " prefix.`,
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.Entity.ExternalId]
+	if !found {
+		return &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	var uniqueIDAttributeFound bool
+
+	for _, attribute := range request.Entity.Attributes {
+		if attribute.ExternalId == entity.uniqueIDAttrExternalID {
+			uniqueIDAttributeFound = true
+
+			break
+		}
+	}
+
+	if !uniqueIDAttributeFound {
+		return &framework.Error{
+			Message: "Requested entity attributes are missing unique ID attribute.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if request.Ordered {
+		return &framework.Error{
+			Message: "Ordered must be set to false.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if request.PageSize > MaxPageSize {
+		return &framework.Error{
+			Message: fmt.Sprintf("Provided page size (%d) exceeds the maximum allowed (%d).", request.PageSize, MaxPageSize),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	return nil
+}
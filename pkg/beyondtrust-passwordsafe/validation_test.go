@@ -0,0 +1,204 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package beyondtrustpasswordsafe_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	beyondtrustpasswordsafe "github.com/sgnl-ai/adapters/pkg/beyondtrust-passwordsafe"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: beyondtrustpasswordsafe.User,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "UserID",
+				Type:       framework.AttributeTypeInt64,
+			},
+			{
+				ExternalId: "UserName",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[beyondtrustpasswordsafe.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: "acme.ps.beyondtrustcloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+				},
+				Entity:   validEntity,
+				Config:   &beyondtrustpasswordsafe.Config{},
+				PageSize: 100,
+			},
+			wantAddress: "https://acme.ps.beyondtrustcloud.com",
+		},
+		"valid_request_basic_auth": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: "https://pam.acme.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "bt-client",
+						Password: "bt-secret",
+					},
+				},
+				Entity:   validEntity,
+				Config:   &beyondtrustpasswordsafe.Config{},
+				PageSize: 100,
+			},
+			wantAddress: "https://pam.acme.com",
+		},
+		"invalid_request_nil_config": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: "https://acme.ps.beyondtrustcloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "BeyondTrust Password Safe config is invalid: request contains no config.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: "http://pam.acme.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+				},
+				Entity:   validEntity,
+				Config:   &beyondtrustpasswordsafe.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address:  "https://acme.ps.beyondtrustcloud.com",
+				Auth:     &framework.DatasourceAuthCredentials{},
+				Entity:   validEntity,
+				Config:   &beyondtrustpasswordsafe.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Request to BeyondTrust Password Safe is missing Basic credentials or PS-Auth API key credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_ps_auth_prefix": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: "https://acme.ps.beyondtrustcloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bt-test",
+				},
+				Entity:   validEntity,
+				Config:   &beyondtrustpasswordsafe.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "PS-Auth " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: "https://acme.ps.beyondtrustcloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Secret",
+					Attributes: validEntity.Attributes,
+				},
+				Config:   &beyondtrustpasswordsafe.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: "https://acme.ps.beyondtrustcloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: beyondtrustpasswordsafe.User,
+					Attributes: validEntity.Attributes[1:],
+				},
+				Config:   &beyondtrustpasswordsafe.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: "https://acme.ps.beyondtrustcloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+				},
+				Entity:   validEntity,
+				Config:   &beyondtrustpasswordsafe.Config{},
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[beyondtrustpasswordsafe.Config]{
+				Address: "https://acme.ps.beyondtrustcloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "PS-Auth key=bt-test; runas=svc-sgnl;",
+				},
+				Entity:   validEntity,
+				Config:   &beyondtrustpasswordsafe.Config{},
+				PageSize: 1001,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1001) exceeds the maximum allowed (1000).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &beyondtrustpasswordsafe.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
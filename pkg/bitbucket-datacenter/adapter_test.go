@@ -0,0 +1,250 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package bitbucketdatacenter_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	bitbucketdatacenter "github.com/sgnl-ai/adapters/pkg/bitbucket-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := bitbucketdatacenter.NewAdapter(&bitbucketdatacenter.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[int64]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[bitbucketdatacenter.Config]
+		wantResponse framework.Response
+	}{
+		"users_first_page_basic_auth": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "admin",
+						Password: "secret",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucketdatacenter.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "slug",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "active",
+							Type:       framework.AttributeTypeBool,
+						},
+						{
+							ExternalId: "lastAuthenticationTimestamp",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                          int64(101),
+							"slug":                        "alice",
+							"active":                      true,
+							"lastAuthenticationTimestamp": time.UnixMilli(1709647957123).UTC(),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[int64]{
+						Cursor: testutil.GenPtr[int64](1),
+					}),
+				},
+			},
+		},
+		"group_members_first_page_pat": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucketdatacenter.GroupMember,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "userId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "groupName",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":        "developers-101",
+							"userId":    "101",
+							"groupName": "developers",
+						},
+						{
+							"id":        "developers-102",
+							"userId":    "102",
+							"groupName": "developers",
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[int64]{
+						Cursor:           testutil.GenPtr[int64](2),
+						CollectionID:     testutil.GenPtr("developers"),
+						CollectionCursor: testutil.GenPtr[int64](1),
+					}),
+				},
+			},
+		},
+		"repository_permissions": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucketdatacenter.RepositoryPermission,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "repositoryFullName",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.user.id",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "permission",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                 "PROJ/api-101",
+							"repositoryFullName": "PROJ/api",
+							"$.user.id":          int64(101),
+							"permission":         "REPO_ADMIN",
+						},
+					},
+				},
+			},
+		},
+		"projects_api_version_1_0": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Config: &bitbucketdatacenter.Config{
+					APIVersion: "1.0",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucketdatacenter.Project,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "key",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "type",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"key":  "PROJ",
+							"type": "NORMAL",
+						},
+						{
+							"key":  "~ALICE",
+							"type": "PERSONAL",
+						},
+					},
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-invalid",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucketdatacenter.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "name",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bitbucketdatacenter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// DefaultAPIVersion is the version of the REST API queried if not configured.
+const DefaultAPIVersion = "latest"
+
+var supportedAPIVersions = map[string]struct{}{
+	"1.0":    {},
+	"latest": {},
+}
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Bitbucket Data Center Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "apiVersion": "1.0"
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// APIVersion is the version of the REST API to query: "1.0" or "latest". Defaults to DefaultAPIVersion.
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// apiVersion returns the configured APIVersion, or the default.
+func (c *Config) apiVersion() string {
+	if c == nil || c.APIVersion == "" {
+		return DefaultAPIVersion
+	}
+
+	return c.APIVersion
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	if _, found := supportedAPIVersions[c.apiVersion()]; !found {
+		return fmt.Errorf("apiVersion must be either '1.0' or 'latest', got '%s'", c.APIVersion)
+	}
+
+	return c.CommonConfig.ValidateSyncMode()
+}
@@ -0,0 +1,316 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bitbucketdatacenter
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+}
+
+// DatasourceResponse is the format of the paged Bitbucket Data Center REST API responses.
+// https://developer.atlassian.com/server/bitbucket/rest/v906/intro/#paged-apis.
+type DatasourceResponse struct {
+	Values     []map[string]any `json:"values"`
+	IsLastPage bool             `json:"isLastPage"`
+	// NextPageStart is the start parameter of the next page, absent on the last page.
+	NextPageStart *int64 `json:"nextPageStart,omitempty"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// memberOf is the external ID of the collection entity the entity is listed for, if any.
+	memberOf *string
+	// collectionID returns the identifier of a collection object in the endpoint of the entity, e.g. the name of
+	// a group.
+	collectionID func(collection map[string]any) string
+	// setMemberAttributes sets the unique ID of an object listed for the collection, and the attributes
+	// referencing the collection and the user.
+	setMemberAttributes func(collectionID string, object map[string]any)
+}
+
+const (
+	User                 string = "User"
+	Group                string = "Group"
+	GroupMember          string = "GroupMember"
+	Project              string = "Project"
+	Repository           string = "Repository"
+	RepositoryPermission string = "RepositoryPermission"
+
+	// collectionIDAttribute is the attribute of the collection objects, as returned to
+	// UpdateNextCursorFromCollectionAPI, containing the identifier of the collection.
+	collectionIDAttribute = "collectionId"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// https://developer.atlassian.com/server/bitbucket/rest/v906/api-group-system-maintenance/#api-api-latest-admin-users-get.
+		User: {
+			uniqueIDAttrExternalID: "id",
+		},
+		// https://developer.atlassian.com/server/bitbucket/rest/v906/api-group-permission-management/#api-api-latest-admin-groups-get.
+		Group: {
+			uniqueIDAttrExternalID: "name",
+			collectionID: func(group map[string]any) string {
+				name, _ := group["name"].(string)
+
+				return name
+			},
+		},
+		// https://developer.atlassian.com/server/bitbucket/rest/v906/api-group-permission-management/#api-api-latest-admin-groups-more-members-get.
+		// Connection entity for Groups <-> Users. The members are the users of the group.
+		GroupMember: {
+			uniqueIDAttrExternalID: "id",
+			memberOf: func() *string {
+				s := Group
+
+				return &s
+			}(),
+			setMemberAttributes: func(groupName string, user map[string]any) {
+				userID := commonutil.FormatID(user["id"])
+
+				user["id"] = groupName + "-" + userID
+				user["userId"] = userID
+				user["groupName"] = groupName
+			},
+		},
+		// https://developer.atlassian.com/server/bitbucket/rest/v906/api-group-project/#api-api-latest-projects-get.
+		Project: {
+			uniqueIDAttrExternalID: "key",
+		},
+		// https://developer.atlassian.com/server/bitbucket/rest/v906/api-group-repository/#api-api-latest-repos-get.
+		// Every repository the account has access to.
+		Repository: {
+			uniqueIDAttrExternalID: "id",
+			collectionID: func(repository map[string]any) string {
+				return repositoryFullName(repository)
+			},
+		},
+		// https://developer.atlassian.com/server/bitbucket/rest/v906/api-group-permission-management/#api-api-latest-projects-projectkey-repos-repositoryslug-permissions-users-get.
+		// Connection entity for Repositories <-> Users, with the permission explicitly granted to the user on the
+		// repository. Requires the account to be an administrator of the repositories.
+		RepositoryPermission: {
+			uniqueIDAttrExternalID: "id",
+			memberOf: func() *string {
+				s := Repository
+
+				return &s
+			}(),
+			setMemberAttributes: func(fullName string, permission map[string]any) {
+				user, _ := permission["user"].(map[string]any)
+
+				permission["id"] = fullName + "-" + commonutil.FormatID(user["id"])
+				permission["repositoryFullName"] = fullName
+			},
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	// [MemberEntities] The members are listed one group or repository at a time, so set the `CollectionID`
+	// to the name of the current group or the full name of the current repository, e.g. "PROJ/repo", and the
+	// `CollectionCursor` to the start of the next one.
+	if entity.memberOf != nil {
+		collectionEntity := ValidEntityExternalIDs[*entity.memberOf]
+
+		collectionReq := &Request{
+			BaseURL:               request.BaseURL,
+			AuthorizationHeader:   request.AuthorizationHeader,
+			PageSize:              1,
+			EntityExternalID:      *entity.memberOf,
+			APIVersion:            request.APIVersion,
+			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		}
+
+		if request.Cursor != nil && request.Cursor.CollectionCursor != nil {
+			collectionReq.Cursor = &pagination.CompositeCursor[int64]{
+				Cursor: request.Cursor.CollectionCursor,
+			}
+		}
+
+		if request.Cursor == nil {
+			request.Cursor = &pagination.CompositeCursor[int64]{}
+		}
+
+		isEmptyLastPage, cursorErr := pagination.UpdateNextCursorFromCollectionAPI(
+			ctx,
+			request.Cursor,
+			func(ctx context.Context, collectionReq *Request) (
+				int, string, []map[string]any, *pagination.CompositeCursor[int64], *framework.Error,
+			) {
+				resp, err := d.GetPage(ctx, collectionReq)
+				if err != nil {
+					return 0, "", nil, nil, err
+				}
+
+				// Only the identifier of the collection is needed to list its members.
+				collections := make([]map[string]any, 0, len(resp.Objects))
+
+				for _, collection := range resp.Objects {
+					collections = append(collections, map[string]any{
+						collectionIDAttribute: collectionEntity.collectionID(collection),
+					})
+				}
+
+				return resp.StatusCode, resp.RetryAfterHeader, collections, resp.NextCursor, nil
+			},
+			collectionReq,
+			collectionIDAttribute,
+		)
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		if isEmptyLastPage {
+			return &Response{
+				StatusCode: http.StatusOK,
+			}, nil
+		}
+	}
+
+	validationErr := pagination.ValidateCompositeCursor(
+		request.Cursor,
+		request.EntityExternalID,
+		// Send a bool indicating if the entity is a member of a collection.
+		entity.memberOf != nil,
+	)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects       []map[string]any
+		nextPageStart *int64
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL:                   endpoint,
+		Header:                http.Header{"Authorization": {request.AuthorizationHeader}},
+		DatasourceName:        "Bitbucket",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Bitbucket")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, nextPageStart, parseErr = ParseResponse(bodyBytes)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	if nextPageStart != nil {
+		response.NextCursor = &pagination.CompositeCursor[int64]{
+			Cursor: nextPageStart,
+		}
+	}
+
+	// [MemberEntities] Set `id` and the attributes referencing the collection, and the cursor of the next page of
+	// members, or of the next collection.
+	if entity.memberOf != nil {
+		collectionID := *request.Cursor.CollectionID
+
+		for _, member := range objects {
+			entity.setMemberAttributes(collectionID, member)
+		}
+
+		request.Cursor.Cursor = nextPageStart
+		response.NextCursor = nil
+
+		if request.Cursor.Cursor != nil || request.Cursor.CollectionCursor != nil {
+			response.NextCursor = request.Cursor
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the objects, and the start of the next page, from a paged Bitbucket Data Center REST API
+// response.
+func ParseResponse(body []byte) (objects []map[string]any, nextPageStart *int64, err *framework.Error) {
+	var data DatasourceResponse
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	if !data.IsLastPage {
+		nextPageStart = data.NextPageStart
+	}
+
+	return data.Values, nextPageStart, nil
+}
+
+// repositoryFullName returns the full name of a repository, made of the key of its project and its slug,
+// e.g. "PROJ/repo".
+func repositoryFullName(repository map[string]any) string {
+	slug, _ := repository["slug"].(string)
+	project, _ := repository["project"].(map[string]any)
+	projectKey, _ := project["key"].(string)
+
+	if slug == "" || projectKey == "" {
+		return ""
+	}
+
+	return projectKey + "/" + slug
+}
@@ -0,0 +1,334 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package bitbucketdatacenter_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/auth"
+	bitbucketdatacenter "github.com/sgnl-ai/adapters/pkg/bitbucket-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock Bitbucket Data Center server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	switch r.Header.Get("Authorization") {
+	case auth.BasicAuthHeader("admin", "secret"), "Bearer bbdc-test":
+	default:
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errors": [{"message": "Authentication failed. Please check your credentials and try again."}]}`))
+
+		return
+	}
+
+	switch r.URL.RequestURI() {
+	// Users Page 1
+	case "/rest/api/latest/admin/users?limit=1":
+		w.Write([]byte(`{"size": 1, "limit": 1, "start": 0, "isLastPage": false, "nextPageStart": 1, "values": [
+			{"id": 101, "name": "alice", "slug": "alice", "emailAddress": "alice@example.com", "active": true, "lastAuthenticationTimestamp": 1709647957123}
+		]}`))
+
+	// Users Page 2
+	case "/rest/api/latest/admin/users?limit=1&start=1":
+		w.Write([]byte(`{"size": 1, "limit": 1, "start": 1, "isLastPage": true, "values": [
+			{"id": 102, "name": "bob", "slug": "bob", "emailAddress": "bob@example.com", "active": false}
+		]}`))
+
+	// Groups Page 1
+	case "/rest/api/latest/admin/groups?limit=1":
+		w.Write([]byte(`{"size": 1, "limit": 1, "start": 0, "isLastPage": false, "nextPageStart": 1, "values": [
+			{"name": "developers", "deletable": true}
+		]}`))
+
+	// Groups Page 2
+	case "/rest/api/latest/admin/groups?limit=1&start=1":
+		w.Write([]byte(`{"size": 1, "limit": 1, "start": 1, "isLastPage": true, "values": [
+			{"name": "stash-users", "deletable": false}
+		]}`))
+
+	// Group Members of developers Page 1
+	case "/rest/api/latest/admin/groups/more-members?context=developers&limit=2":
+		w.Write([]byte(`{"size": 2, "limit": 2, "start": 0, "isLastPage": false, "nextPageStart": 2, "values": [
+			{"id": 101, "name": "alice", "slug": "alice"},
+			{"id": 102, "name": "bob", "slug": "bob"}
+		]}`))
+
+	// Group Members of developers Page 2
+	case "/rest/api/latest/admin/groups/more-members?context=developers&limit=2&start=2":
+		w.Write([]byte(`{"size": 1, "limit": 2, "start": 2, "isLastPage": true, "values": [
+			{"id": 103, "name": "carol", "slug": "carol"}
+		]}`))
+
+	// Group Members of stash-users
+	case "/rest/api/latest/admin/groups/more-members?context=stash-users&limit=2":
+		w.Write([]byte(`{"size": 1, "limit": 2, "start": 0, "isLastPage": true, "values": [
+			{"id": 101, "name": "alice", "slug": "alice"}
+		]}`))
+
+	// Projects
+	case "/rest/api/1.0/projects?limit=2":
+		w.Write([]byte(`{"size": 2, "limit": 2, "start": 0, "isLastPage": true, "values": [
+			{"id": 1, "key": "PROJ", "name": "Project", "public": false, "type": "NORMAL"},
+			{"id": 2, "key": "~ALICE", "name": "Alice", "public": false, "type": "PERSONAL"}
+		]}`))
+
+	// Repositories
+	case "/rest/api/latest/repos?limit=1":
+		w.Write([]byte(`{"size": 1, "limit": 1, "start": 0, "isLastPage": true, "values": [
+			{"id": 11, "slug": "api", "name": "API", "project": {"id": 1, "key": "PROJ"}}
+		]}`))
+
+	// Repository Permissions of PROJ/api
+	case "/rest/api/latest/projects/PROJ/repos/api/permissions/users?limit=2":
+		w.Write([]byte(`{"size": 1, "limit": 2, "start": 0, "isLastPage": true, "values": [
+			{"user": {"id": 101, "name": "alice", "slug": "alice"}, "permission": "REPO_ADMIN"}
+		]}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body              []byte
+		wantObjects       []map[string]any
+		wantNextPageStart *int64
+		wantErr           *framework.Error
+	}{
+		"first_page": {
+			body:              []byte(`{"size": 1, "limit": 1, "start": 0, "isLastPage": false, "nextPageStart": 1, "values": [{"name": "developers"}]}`),
+			wantObjects:       []map[string]any{{"name": "developers"}},
+			wantNextPageStart: testutil.GenPtr[int64](1),
+		},
+		"last_page": {
+			body:        []byte(`{"size": 1, "limit": 1, "start": 1, "isLastPage": true, "values": [{"name": "stash-users"}]}`),
+			wantObjects: []map[string]any{{"name": "stash-users"}},
+		},
+		"last_page_with_next_page_start": {
+			body:        []byte(`{"size": 0, "limit": 1, "start": 2, "isLastPage": true, "nextPageStart": 3, "values": []}`),
+			wantObjects: []map[string]any{},
+		},
+		"invalid_response": {
+			body: []byte(`[]`),
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type bitbucketdatacenter.DatasourceResponse.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextPageStart, gotErr := bitbucketdatacenter.ParseResponse(tt.body)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextPageStart, tt.wantNextPageStart) {
+				t.Errorf("gotNextPageStart: %v, wantNextPageStart: %v", gotNextPageStart, tt.wantNextPageStart)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := bitbucketdatacenter.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *bitbucketdatacenter.Request
+		wantResponse *bitbucketdatacenter.Response
+		wantErr      *framework.Error
+	}{
+		"users_first_page": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bbdc-test",
+				PageSize:            1,
+				EntityExternalID:    bitbucketdatacenter.User,
+				APIVersion:          "latest",
+			},
+			wantResponse: &bitbucketdatacenter.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(101), "name": "alice", "slug": "alice", "emailAddress": "alice@example.com", "active": true, "lastAuthenticationTimestamp": float64(1709647957123)},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](1),
+				},
+			},
+		},
+		"users_last_page": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: auth.BasicAuthHeader("admin", "secret"),
+				PageSize:            1,
+				EntityExternalID:    bitbucketdatacenter.User,
+				APIVersion:          "latest",
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](1),
+				},
+			},
+			wantResponse: &bitbucketdatacenter.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(102), "name": "bob", "slug": "bob", "emailAddress": "bob@example.com", "active": false},
+				},
+			},
+		},
+		"projects_api_version_1_0": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bbdc-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucketdatacenter.Project,
+				APIVersion:          "1.0",
+			},
+			wantResponse: &bitbucketdatacenter.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(1), "key": "PROJ", "name": "Project", "public": false, "type": "NORMAL"},
+					{"id": float64(2), "key": "~ALICE", "name": "Alice", "public": false, "type": "PERSONAL"},
+				},
+			},
+		},
+		"group_members_first_page": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bbdc-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucketdatacenter.GroupMember,
+				APIVersion:          "latest",
+			},
+			wantResponse: &bitbucketdatacenter.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "developers-101", "userId": "101", "groupName": "developers", "name": "alice", "slug": "alice"},
+					{"id": "developers-102", "userId": "102", "groupName": "developers", "name": "bob", "slug": "bob"},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor:           testutil.GenPtr[int64](2),
+					CollectionID:     testutil.GenPtr("developers"),
+					CollectionCursor: testutil.GenPtr[int64](1),
+				},
+			},
+		},
+		"group_members_last_page_of_group": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bbdc-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucketdatacenter.GroupMember,
+				APIVersion:          "latest",
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor:           testutil.GenPtr[int64](2),
+					CollectionID:     testutil.GenPtr("developers"),
+					CollectionCursor: testutil.GenPtr[int64](1),
+				},
+			},
+			wantResponse: &bitbucketdatacenter.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "developers-103", "userId": "103", "groupName": "developers", "name": "carol", "slug": "carol"},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					CollectionID:     testutil.GenPtr("developers"),
+					CollectionCursor: testutil.GenPtr[int64](1),
+				},
+			},
+		},
+		"group_members_last_group": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bbdc-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucketdatacenter.GroupMember,
+				APIVersion:          "latest",
+				Cursor: &pagination.CompositeCursor[int64]{
+					CollectionID:     testutil.GenPtr("developers"),
+					CollectionCursor: testutil.GenPtr[int64](1),
+				},
+			},
+			wantResponse: &bitbucketdatacenter.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "stash-users-101", "userId": "101", "groupName": "stash-users", "name": "alice", "slug": "alice"},
+				},
+			},
+		},
+		"repository_permissions": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bbdc-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucketdatacenter.RepositoryPermission,
+				APIVersion:          "latest",
+			},
+			wantResponse: &bitbucketdatacenter.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "PROJ/api-101", "repositoryFullName": "PROJ/api", "user": map[string]any{"id": float64(101), "name": "alice", "slug": "alice"}, "permission": "REPO_ADMIN"},
+				},
+			},
+		},
+		"invalid_cursor_for_member_entity": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bbdc-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucketdatacenter.User,
+				APIVersion:          "latest",
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor:       testutil.GenPtr[int64](2),
+					CollectionID: testutil.GenPtr("developers"),
+				},
+			},
+			wantErr: pagination.NewCursorError(
+				bitbucketdatacenter.User,
+				pagination.CompositeCursorShape[int64](false),
+				"cursor must not contain CollectionID or CollectionCursor fields",
+			),
+		},
+		"unauthorized": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: auth.BasicAuthHeader("admin", "wrong"),
+				PageSize:            1,
+				EntityExternalID:    bitbucketdatacenter.Group,
+				APIVersion:          "latest",
+			},
+			wantResponse: &bitbucketdatacenter.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bitbucketdatacenter
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// For example, the endpoint of the second page of the members of a group is:
+// https://bitbucket.example.com/rest/api/latest/admin/groups/more-members?context=developers&limit=100&start=100.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if entity.memberOf != nil && (request.Cursor == nil || request.Cursor.CollectionID == nil) {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Unable to construct the %s endpoint without a collection ID.", request.EntityExternalID),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	var (
+		path   string
+		params = url.Values{}
+	)
+
+	params.Set("limit", strconv.FormatInt(request.PageSize, 10))
+
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		params.Set("start", strconv.FormatInt(*request.Cursor.Cursor, 10))
+	}
+
+	switch request.EntityExternalID {
+	case User:
+		path = "/admin/users"
+	case Group:
+		path = "/admin/groups"
+	case GroupMember:
+		path = "/admin/groups/more-members"
+
+		params.Set("context", *request.Cursor.CollectionID)
+	case Project:
+		path = "/projects"
+	case Repository:
+		path = "/repos"
+	case RepositoryPermission:
+		// The collection ID is the full name of the repository, e.g. "PROJ/repo".
+		projectKey, slug, _ := strings.Cut(*request.Cursor.CollectionID, "/")
+
+		path = "/projects/" + url.PathEscape(projectKey) + "/repos/" + url.PathEscape(slug) + "/permissions/users"
+	}
+
+	return request.BaseURL + "/rest/api/" + request.APIVersion + path + "?" + params.Encode(), nil
+}
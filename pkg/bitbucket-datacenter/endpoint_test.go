@@ -0,0 +1,142 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package bitbucketdatacenter_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	bitbucketdatacenter "github.com/sgnl-ai/adapters/pkg/bitbucket-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *bitbucketdatacenter.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:          "https://bitbucket.example.com",
+				PageSize:         100,
+				EntityExternalID: "Branch",
+				APIVersion:       "latest",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"users": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:          "https://bitbucket.example.com",
+				PageSize:         100,
+				EntityExternalID: bitbucketdatacenter.User,
+				APIVersion:       "latest",
+			},
+			wantEndpoint: "https://bitbucket.example.com/rest/api/latest/admin/users?limit=100",
+		},
+		"users_next_page": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:          "https://bitbucket.example.com",
+				PageSize:         100,
+				EntityExternalID: bitbucketdatacenter.User,
+				APIVersion:       "latest",
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](100),
+				},
+			},
+			wantEndpoint: "https://bitbucket.example.com/rest/api/latest/admin/users?limit=100&start=100",
+		},
+		"groups_api_version_1_0": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:          "https://bitbucket.example.com",
+				PageSize:         100,
+				EntityExternalID: bitbucketdatacenter.Group,
+				APIVersion:       "1.0",
+			},
+			wantEndpoint: "https://bitbucket.example.com/rest/api/1.0/admin/groups?limit=100",
+		},
+		"group_members": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:          "https://bitbucket.example.com",
+				PageSize:         100,
+				EntityExternalID: bitbucketdatacenter.GroupMember,
+				APIVersion:       "latest",
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor:       testutil.GenPtr[int64](100),
+					CollectionID: testutil.GenPtr("site admins"),
+				},
+			},
+			wantEndpoint: "https://bitbucket.example.com/rest/api/latest/admin/groups/more-members?context=site+admins&limit=100&start=100",
+		},
+		"group_members_without_collection_id": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:          "https://bitbucket.example.com",
+				PageSize:         100,
+				EntityExternalID: bitbucketdatacenter.GroupMember,
+				APIVersion:       "latest",
+			},
+			wantErr: &framework.Error{
+				Message: "Unable to construct the GroupMember endpoint without a collection ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"projects": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:          "https://bitbucket.example.com",
+				PageSize:         100,
+				EntityExternalID: bitbucketdatacenter.Project,
+				APIVersion:       "latest",
+			},
+			wantEndpoint: "https://bitbucket.example.com/rest/api/latest/projects?limit=100",
+		},
+		"repositories": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:          "https://bitbucket.example.com",
+				PageSize:         100,
+				EntityExternalID: bitbucketdatacenter.Repository,
+				APIVersion:       "latest",
+			},
+			wantEndpoint: "https://bitbucket.example.com/rest/api/latest/repos?limit=100",
+		},
+		"repository_permissions_personal_project": {
+			request: &bitbucketdatacenter.Request{
+				BaseURL:          "https://bitbucket.example.com",
+				PageSize:         100,
+				EntityExternalID: bitbucketdatacenter.RepositoryPermission,
+				APIVersion:       "latest",
+				Cursor: &pagination.CompositeCursor[int64]{
+					CollectionID: testutil.GenPtr("~ALICE/dot files"),
+				},
+			},
+			wantEndpoint: "https://bitbucket.example.com/rest/api/latest/projects/~ALICE/repos/dot%20files/permissions/users?limit=100",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := bitbucketdatacenter.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
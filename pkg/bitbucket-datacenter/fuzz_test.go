@@ -0,0 +1,31 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package bitbucketdatacenter_test
+
+import (
+	"testing"
+
+	bitbucketdatacenter "github.com/sgnl-ai/adapters/pkg/bitbucket-datacenter"
+)
+
+// FuzzParseResponse checks that malformed Bitbucket Data Center responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"values":[{"id":1,"name":"admins"}],"isLastPage":false,"nextPageStart":25}`,
+		`{"values":[],"isLastPage":true}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, _, err := bitbucketdatacenter.ParseResponse(body)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bitbucketdatacenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+
+	"github.com/sgnl-ai/adapters/pkg/validation"
+)
+
+const (
+	// MaxPageSize is the maximum page size allowed in a GetPage request.
+	// Bitbucket Data Center caps the "limit" parameter of the paged APIs to 1000 by default, see the "page.max.*"
+	// properties: https://confluence.atlassian.com/bitbucketserver/bitbucket-config-properties-776640155.html.
+	MaxPageSize = 1000
+)
+
+// ValidateGetPageRequest validates the fields of the GetPage Request.
+func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework.Request[Config]) *framework.Error {
+	if err := request.Config.Validate(ctx); err != nil {
+		return &framework.Error{
+			Message: fmt.Sprintf("Bitbucket config is invalid: %v.", err.Error()),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	trimmedAddress, parsed, err := validation.ParseAndValidateAddress(request.Address, []string{"https"})
+	if err != nil {
+		return err
+	}
+
+	// Normalize address with https:// scheme if not provided
+	if parsed.Scheme == "" {
+		request.Address = "https://" + trimmedAddress
+	} else {
+		request.Address = trimmedAddress
+	}
+
+	// The Bitbucket Data Center REST API supports two types of authentication:
+	// 1. Basic HTTP Authentication - should be supplied as request.Auth.Basic, with the username and password.
+	// 2. Personal Access Tokens (PAT) and HTTP access tokens - should be supplied as request.Auth.HTTPAuthorization
+	//    with prefix "Bearer ".
+	// https://confluence.atlassian.com/bitbucketserver/http-access-tokens-939515499.html.
+	if request.Auth == nil || request.Auth.Basic == nil && request.Auth.HTTPAuthorization == "" {
+		return &framework.Error{
+			Message: "Request to Bitbucket is missing Basic Auth or Personal Access Token (PAT) credentials.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	if request.Auth.HTTPAuthorization != "" && !strings.HasPrefix(request.Auth.HTTPAuthorization, "Bearer ") {
+		return &framework.Error{
+			Message: `Provided auth token is missing required "Bearer " prefix.`,
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.Entity.ExternalId]
+	if !found {
+		return &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	var uniqueIDAttributeFound bool
+
+	for _, attribute := range request.Entity.Attributes {
+		if attribute.ExternalId == entity.uniqueIDAttrExternalID {
+			uniqueIDAttributeFound = true
+
+			break
+		}
+	}
+
+	if !uniqueIDAttributeFound {
+		return &framework.Error{
+			Message: "Requested entity attributes are missing unique ID attribute.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if request.Ordered {
+		return &framework.Error{
+			Message: "Ordered must be set to false.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if request.PageSize > MaxPageSize {
+		return &framework.Error{
+			Message: fmt.Sprintf("Provided page size (%d) exceeds the maximum allowed (%d).", request.PageSize, MaxPageSize),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	return nil
+}
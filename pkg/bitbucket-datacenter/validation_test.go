@@ -0,0 +1,205 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package bitbucketdatacenter_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	bitbucketdatacenter "github.com/sgnl-ai/adapters/pkg/bitbucket-datacenter"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: bitbucketdatacenter.Group,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "name",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "deletable",
+				Type:       framework.AttributeTypeBool,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[bitbucketdatacenter.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request_bearer_token": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: "bitbucket.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Entity: validEntity,
+				Config: &bitbucketdatacenter.Config{
+					APIVersion: "1.0",
+				},
+				PageSize: 1000,
+			},
+			wantAddress: "https://bitbucket.example.com",
+		},
+		"valid_request_basic_auth_nil_config": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: "https://bitbucket.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "admin",
+						Password: "secret",
+					},
+				},
+				Entity:   validEntity,
+				PageSize: 1000,
+			},
+			wantAddress: "https://bitbucket.example.com",
+		},
+		"invalid_request_unsupported_api_version": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: "https://bitbucket.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Entity: validEntity,
+				Config: &bitbucketdatacenter.Config{
+					APIVersion: "2.0",
+				},
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "Bitbucket config is invalid: apiVersion must be either '1.0' or 'latest', got '2.0'.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: "http://bitbucket.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Entity:   validEntity,
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address:  "https://bitbucket.example.com",
+				Auth:     &framework.DatasourceAuthCredentials{},
+				Entity:   validEntity,
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "Request to Bitbucket is missing Basic Auth or Personal Access Token (PAT) credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: "https://bitbucket.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "bbdc-test",
+				},
+				Entity:   validEntity,
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: "https://bitbucket.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Branch",
+				},
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: "https://bitbucket.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucketdatacenter.GroupMember,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "userId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: "https://bitbucket.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Entity:   validEntity,
+				Ordered:  true,
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[bitbucketdatacenter.Config]{
+				Address: "https://bitbucket.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bbdc-test",
+				},
+				Entity:   validEntity,
+				PageSize: 1001,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1001) exceeds the maximum allowed (1000).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &bitbucketdatacenter.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
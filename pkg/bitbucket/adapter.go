@@ -0,0 +1,125 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/auth"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
+)
+
+// Adapter implements the framework.Adapter interface to query pages of objects
+// from datasources.
+type Adapter struct {
+	BitbucketClient Client
+}
+
+// NewAdapter instantiates a new Adapter.
+func NewAdapter(client Client) framework.Adapter[Config] {
+	return &Adapter{
+		BitbucketClient: client,
+	}
+}
+
+// GetPage is called by SGNL's ingestion service to query a page of objects
+// from a datasource.
+func (a *Adapter) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	if err := a.ValidateGetPageRequest(ctx, request); err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return a.RequestPageFromDatasource(ctx, request)
+}
+
+// RequestPageFromDatasource requests a page of objects from a datasource.
+func (a *Adapter) RequestPageFromDatasource(
+	ctx context.Context, request *framework.Request[Config],
+) framework.Response {
+	var commonConfig *config.CommonConfig
+	if request.Config != nil {
+		commonConfig = request.Config.CommonConfig
+	}
+
+	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
+
+	var authorizationHeader string
+
+	switch {
+	case request.Auth.Basic != nil:
+		authorizationHeader = auth.BasicAuthHeader(request.Auth.Basic.Username, request.Auth.Basic.Password)
+	case request.Auth.HTTPAuthorization != "":
+		authorizationHeader = request.Auth.HTTPAuthorization
+	}
+
+	// Unmarshal the current cursor.
+	cursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	bitbucketReq := &Request{
+		BaseURL:               request.Address,
+		AuthorizationHeader:   authorizationHeader,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
+		EntityExternalID:      request.Entity.ExternalId,
+		Cursor:                cursor,
+		PullRequestStates:     request.Config.pullRequestStates(),
+		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
+	}
+
+	resp, err := a.BitbucketClient.GetPage(ctx, bitbucketReq)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	// An adapter error message is generated if the response status code is not
+	// successful (i.e. if not statusCode >= 200 && statusCode < 300).
+	if adapterErr := web.HTTPError(resp.StatusCode, resp.RetryAfterHeader); adapterErr != nil {
+		return framework.NewGetPageResponseError(adapterErr)
+	}
+
+	schemadrift.Observe(ctx, resp.Objects)
+
+	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
+	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
+	parsedObjects, parserErr := web.ConvertJSONObjectList(
+		&request.Entity,
+		resp.Objects,
+		web.WithJSONPathAttributeNames(),
+		web.WithDateTimeFormats(
+			[]web.DateTimeFormatWithTimeZone{
+				// Bitbucket returns the times in ISO 8601 with microseconds,
+				// e.g. "created_on": "2024-03-05T14:12:37.123456+00:00".
+				{Format: time.RFC3339, HasTimeZone: true},
+			}...,
+		),
+		web.WithLocalTimeZoneOffset(commonConfig.LocalTimeZoneOffset),
+	)
+	if parserErr != nil {
+		return framework.NewGetPageResponseError(
+			&framework.Error{
+				Message: fmt.Sprintf("Failed to convert datasource response objects: %v.", parserErr),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		)
+	}
+
+	// Marshal the next cursor.
+	nextCursor, err := pagination.MarshalCursor(resp.NextCursor)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return framework.NewGetPageResponseSuccess(&framework.Page{
+		Objects:    parsedObjects,
+		NextCursor: nextCursor,
+	})
+}
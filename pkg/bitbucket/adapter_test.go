@@ -0,0 +1,202 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package bitbucket_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/bitbucket"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := bitbucket.NewAdapter(&bitbucket.Datasource{
+		Client: server.Client(),
+	})
+
+	// marshalCursor returns the cursor of a page, which contains the URL of the test server.
+	marshalCursor := func(cursor *pagination.CompositeCursor[string]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[bitbucket.Config]
+		wantResponse framework.Response
+	}{
+		"workspaces_first_page": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucket.Workspace,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uuid",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "slug",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "created_on",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"uuid":       "{ws-1}",
+							"slug":       "acme",
+							"created_on": time.Date(2020, 1, 2, 3, 4, 5, 123456000, time.UTC),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						Cursor: testutil.GenPtr(server.URL + "/2.0/workspaces?pagelen=1&page=2"),
+					}),
+				},
+			},
+		},
+		"workspace_members_first_page": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucket.WorkspaceMember,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.user.uuid",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.workspace.slug",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":               "acme-{user-1}",
+							"$.user.uuid":      "{user-1}",
+							"$.workspace.slug": "acme",
+						},
+						{
+							"id":               "acme-{user-2}",
+							"$.user.uuid":      "{user-2}",
+							"$.workspace.slug": "acme",
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						Cursor:           testutil.GenPtr(server.URL + "/2.0/workspaces/acme/members?pagelen=2&page=2"),
+						CollectionID:     testutil.GenPtr("acme"),
+						CollectionCursor: testutil.GenPtr(server.URL + "/2.0/workspaces?pagelen=1&page=2"),
+					}),
+				},
+			},
+		},
+		"pull_requests": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Config: &bitbucket.Config{
+					PullRequestStates: []string{"OPEN", "MERGED"},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucket.PullRequest,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "pullRequestId",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "$.author.uuid",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":            "acme/api-7",
+							"pullRequestId": int64(7),
+							"$.author.uuid": "{user-2}",
+						},
+					},
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-invalid",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucket.Repository,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uuid",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bitbucket
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Client is a client that allows querying the Bitbucket Cloud datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+}
+
+// Request is a request to the Bitbucket Cloud REST API.
+type Request struct {
+	// BaseURL is the base URL of the Bitbucket Cloud REST API. Should always be "https://api.bitbucket.org".
+	BaseURL string
+
+	// AuthorizationHeader is the Authorization header value to authenticate a request: the Basic credentials of
+	// an Atlassian account and an API token or app password, or the Bearer token of an access token or an OAuth2
+	// consumer.
+	AuthorizationHeader string
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity.
+	// nil in the request for the first page.
+	Cursor *pagination.CompositeCursor[string]
+
+	// PullRequestStates are the states of the pull requests to query, e.g. "OPEN".
+	PullRequestStates []string
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *pagination.CompositeCursor[string]
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// DefaultPullRequestStates are the states of the pull requests synced if not configured.
+var DefaultPullRequestStates = []string{"OPEN"}
+
+var supportedPullRequestStates = map[string]struct{}{
+	"OPEN":       {},
+	"MERGED":     {},
+	"DECLINED":   {},
+	"SUPERSEDED": {},
+}
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Bitbucket Cloud Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "pullRequestStates": ["OPEN", "MERGED"]
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// PullRequestStates are the states of the pull requests to sync: "OPEN", "MERGED", "DECLINED" or
+	// "SUPERSEDED". Defaults to DefaultPullRequestStates.
+	PullRequestStates []string `json:"pullRequestStates,omitempty"`
+}
+
+// pullRequestStates returns the configured PullRequestStates, or the default.
+func (c *Config) pullRequestStates() []string {
+	if c == nil || len(c.PullRequestStates) == 0 {
+		return DefaultPullRequestStates
+	}
+
+	return c.PullRequestStates
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	for _, state := range c.PullRequestStates {
+		if _, found := supportedPullRequestStates[state]; !found {
+			return fmt.Errorf("pullRequestStates contains an unsupported state: %v", state)
+		}
+	}
+
+	return c.CommonConfig.ValidateSyncMode()
+}
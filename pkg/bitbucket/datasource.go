@@ -0,0 +1,312 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bitbucket
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+}
+
+// DatasourceResponse is the format of the paginated Bitbucket Cloud REST API responses.
+// https://developer.atlassian.com/cloud/bitbucket/rest/intro/#pagination.
+type DatasourceResponse struct {
+	Values []map[string]any `json:"values"`
+	// Next is the link to the next page, absent on the last page.
+	Next string `json:"next,omitempty"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// maxPageSize is the maximum value of the pagelen parameter accepted by the endpoint.
+	maxPageSize int64
+	// memberOf is the external ID of the collection entity the entity is listed for, if any.
+	memberOf *string
+	// collectionIDAttrExternalID is the attribute of the collection objects identifying them in the endpoint of
+	// the entity, e.g. the slug of the workspaces.
+	collectionIDAttrExternalID string
+	// memberID returns the unique ID of an object listed for the collection.
+	memberID func(collectionID string, object map[string]any) string
+}
+
+const (
+	Workspace            string = "Workspace"
+	Repository           string = "Repository"
+	WorkspaceMember      string = "WorkspaceMember"
+	RepositoryPermission string = "RepositoryPermission"
+	PullRequest          string = "PullRequest"
+
+	// pullRequestIDAttribute is the attribute set on the pull requests with their ID, which is only unique
+	// within their repository.
+	pullRequestIDAttribute = "pullRequestId"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-get.
+		Workspace: {
+			uniqueIDAttrExternalID: "uuid",
+			maxPageSize:            100,
+		},
+		// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-get.
+		// The repositories of every workspace the account is a member of.
+		Repository: {
+			uniqueIDAttrExternalID: "uuid",
+			maxPageSize:            100,
+		},
+		// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-members-get.
+		// Connection entity for Workspaces <-> Users.
+		WorkspaceMember: {
+			uniqueIDAttrExternalID: "id",
+			maxPageSize:            100,
+			memberOf: func() *string {
+				s := Workspace
+
+				return &s
+			}(),
+			collectionIDAttrExternalID: "slug",
+			memberID: func(workspaceSlug string, membership map[string]any) string {
+				return workspaceSlug + "-" + nestedString(membership, "user", "uuid")
+			},
+		},
+		// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-workspaces/#api-workspaces-workspace-permissions-repositories-get.
+		// Connection entity for Repositories <-> Users, with the permission of the user on the repository.
+		// Listed per workspace, which requires the account to be an administrator of the workspace.
+		RepositoryPermission: {
+			uniqueIDAttrExternalID: "id",
+			maxPageSize:            100,
+			memberOf: func() *string {
+				s := Workspace
+
+				return &s
+			}(),
+			collectionIDAttrExternalID: "slug",
+			memberID: func(_ string, permission map[string]any) string {
+				return nestedString(permission, "repository", "uuid") + "-" + nestedString(permission, "user", "uuid")
+			},
+		},
+		// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-get.
+		PullRequest: {
+			uniqueIDAttrExternalID: "id",
+			maxPageSize:            50,
+			memberOf: func() *string {
+				s := Repository
+
+				return &s
+			}(),
+			collectionIDAttrExternalID: "full_name",
+			memberID: func(repositoryFullName string, pullRequest map[string]any) string {
+				return repositoryFullName + "-" + commonutil.FormatID(pullRequest["id"])
+			},
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	// [MemberEntities] The members are listed one workspace or repository at a time, so set the `CollectionID`
+	// to the slug of the current workspace or the full name of the current repository, and the
+	// `CollectionCursor` to the link to the next one.
+	if entity.memberOf != nil {
+		collectionReq := &Request{
+			BaseURL:               request.BaseURL,
+			AuthorizationHeader:   request.AuthorizationHeader,
+			PageSize:              1,
+			EntityExternalID:      *entity.memberOf,
+			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		}
+
+		if request.Cursor != nil && request.Cursor.CollectionCursor != nil {
+			collectionReq.Cursor = &pagination.CompositeCursor[string]{
+				Cursor: request.Cursor.CollectionCursor,
+			}
+		}
+
+		if request.Cursor == nil {
+			request.Cursor = &pagination.CompositeCursor[string]{}
+		}
+
+		isEmptyLastPage, cursorErr := pagination.UpdateNextCursorFromCollectionAPI(
+			ctx,
+			request.Cursor,
+			func(ctx context.Context, collectionReq *Request) (
+				int, string, []map[string]any, *pagination.CompositeCursor[string], *framework.Error,
+			) {
+				resp, err := d.GetPage(ctx, collectionReq)
+				if err != nil {
+					return 0, "", nil, nil, err
+				}
+
+				return resp.StatusCode, resp.RetryAfterHeader, resp.Objects, resp.NextCursor, nil
+			},
+			collectionReq,
+			entity.collectionIDAttrExternalID,
+		)
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		if isEmptyLastPage {
+			return &Response{
+				StatusCode: http.StatusOK,
+			}, nil
+		}
+	}
+
+	validationErr := pagination.ValidateCompositeCursor(
+		request.Cursor,
+		request.EntityExternalID,
+		// Send a bool indicating if the entity is a member of a collection.
+		entity.memberOf != nil,
+	)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects  []map[string]any
+		nextLink *string
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL:                   endpoint,
+		Header:                http.Header{"Authorization": {request.AuthorizationHeader}},
+		DatasourceName:        "Bitbucket",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Bitbucket")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, nextLink, parseErr = ParseResponse(bodyBytes)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	if nextLink != nil {
+		response.NextCursor = &pagination.CompositeCursor[string]{
+			Cursor: nextLink,
+		}
+	}
+
+	// [MemberEntities] Set `id`, and the cursor of the next page of members, or of the next collection.
+	if entity.memberOf != nil {
+		collectionID := *request.Cursor.CollectionID
+
+		for _, member := range objects {
+			if request.EntityExternalID == PullRequest {
+				member[pullRequestIDAttribute] = member["id"]
+			}
+
+			member["id"] = entity.memberID(collectionID, member)
+		}
+
+		request.Cursor.Cursor = nextLink
+		response.NextCursor = nil
+
+		if request.Cursor.Cursor != nil || request.Cursor.CollectionCursor != nil {
+			response.NextCursor = request.Cursor
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the objects, and the link to the next page, from a paginated Bitbucket Cloud REST API
+// response.
+func ParseResponse(body []byte) (objects []map[string]any, nextLink *string, err *framework.Error) {
+	var data DatasourceResponse
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	if data.Next != "" {
+		nextLink = &data.Next
+	}
+
+	return data.Values, nextLink, nil
+}
+
+// nestedString returns the string at the path of nested objects, e.g. the UUID of the user of a workspace
+// membership, or an empty string if not found.
+func nestedString(object map[string]any, path ...string) string {
+	for i, key := range path {
+		value, found := object[key]
+		if !found {
+			return ""
+		}
+
+		if i == len(path)-1 {
+			s, _ := value.(string)
+
+			return s
+		}
+
+		if object, found = value.(map[string]any); !found {
+			return ""
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,314 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package bitbucket_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/bitbucket"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock Bitbucket Cloud server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer bb-test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"type": "error", "error": {"message": "Access token expired."}}`))
+
+		return
+	}
+
+	// The "next" links are absolute URLs on the test server.
+	write := func(body string) {
+		w.Write([]byte(strings.ReplaceAll(body, "{{host}}", "https://"+r.Host)))
+	}
+
+	switch r.URL.RequestURI() {
+	// Workspaces Page 1
+	case "/2.0/workspaces?pagelen=1":
+		write(`{"pagelen": 1, "page": 1, "size": 2, "next": "{{host}}/2.0/workspaces?pagelen=1&page=2", "values": [
+			{"type": "workspace", "uuid": "{ws-1}", "slug": "acme", "name": "Acme", "is_private": true, "created_on": "2020-01-02T03:04:05.123456+00:00"}
+		]}`)
+
+	// Workspaces Page 2
+	case "/2.0/workspaces?pagelen=1&page=2":
+		write(`{"pagelen": 1, "page": 2, "size": 2, "values": [
+			{"type": "workspace", "uuid": "{ws-2}", "slug": "globex", "name": "Globex", "is_private": false, "created_on": "2021-01-02T03:04:05.123456+00:00"}
+		]}`)
+
+	// Repositories
+	case "/2.0/repositories?pagelen=1&role=member":
+		write(`{"pagelen": 1, "page": 1, "values": [
+			{"type": "repository", "uuid": "{repo-1}", "full_name": "acme/api", "name": "api", "is_private": true}
+		]}`)
+
+	// Workspace Members of acme Page 1
+	case "/2.0/workspaces/acme/members?pagelen=2":
+		write(`{"pagelen": 2, "page": 1, "next": "{{host}}/2.0/workspaces/acme/members?pagelen=2&page=2", "values": [
+			{"type": "workspace_membership", "user": {"uuid": "{user-1}", "display_name": "Alice"}, "workspace": {"slug": "acme"}},
+			{"type": "workspace_membership", "user": {"uuid": "{user-2}", "display_name": "Bob"}, "workspace": {"slug": "acme"}}
+		]}`)
+
+	// Workspace Members of acme Page 2
+	case "/2.0/workspaces/acme/members?pagelen=2&page=2":
+		write(`{"pagelen": 2, "page": 2, "values": [
+			{"type": "workspace_membership", "user": {"uuid": "{user-3}", "display_name": "Carol"}, "workspace": {"slug": "acme"}}
+		]}`)
+
+	// Workspace Members of globex
+	case "/2.0/workspaces/globex/members?pagelen=2":
+		write(`{"pagelen": 2, "page": 1, "values": [
+			{"type": "workspace_membership", "user": {"uuid": "{user-1}", "display_name": "Alice"}, "workspace": {"slug": "globex"}}
+		]}`)
+
+	// Repository Permissions of acme
+	case "/2.0/workspaces/acme/permissions/repositories?pagelen=2":
+		write(`{"pagelen": 2, "page": 1, "values": [
+			{"type": "repository_permission", "permission": "admin", "user": {"uuid": "{user-1}"}, "repository": {"uuid": "{repo-1}", "full_name": "acme/api"}}
+		]}`)
+
+	// Pull Requests of acme/api
+	case "/2.0/repositories/acme/api/pullrequests?pagelen=2&state=OPEN&state=MERGED":
+		write(`{"pagelen": 2, "page": 1, "values": [
+			{"type": "pullrequest", "id": 7, "title": "Fix login", "state": "OPEN", "author": {"uuid": "{user-2}"}}
+		]}`)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body         []byte
+		wantObjects  []map[string]any
+		wantNextLink *string
+		wantErr      *framework.Error
+	}{
+		"first_page": {
+			body:         []byte(`{"pagelen": 1, "page": 1, "next": "https://api.bitbucket.org/2.0/workspaces?page=2", "values": [{"uuid": "{ws-1}"}]}`),
+			wantObjects:  []map[string]any{{"uuid": "{ws-1}"}},
+			wantNextLink: testutil.GenPtr("https://api.bitbucket.org/2.0/workspaces?page=2"),
+		},
+		"last_page": {
+			body:        []byte(`{"pagelen": 1, "page": 2, "values": [{"uuid": "{ws-2}"}]}`),
+			wantObjects: []map[string]any{{"uuid": "{ws-2}"}},
+		},
+		"invalid_response": {
+			body: []byte(`[]`),
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type bitbucket.DatasourceResponse.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextLink, gotErr := bitbucket.ParseResponse(tt.body)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextLink, tt.wantNextLink) {
+				t.Errorf("gotNextLink: %v, wantNextLink: %v", gotNextLink, tt.wantNextLink)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := bitbucket.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *bitbucket.Request
+		wantResponse *bitbucket.Response
+		wantErr      *framework.Error
+	}{
+		"workspaces_first_page": {
+			request: &bitbucket.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bb-test",
+				PageSize:            1,
+				EntityExternalID:    bitbucket.Workspace,
+			},
+			wantResponse: &bitbucket.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"type": "workspace", "uuid": "{ws-1}", "slug": "acme", "name": "Acme", "is_private": true, "created_on": "2020-01-02T03:04:05.123456+00:00"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(server.URL + "/2.0/workspaces?pagelen=1&page=2"),
+				},
+			},
+		},
+		"workspaces_last_page": {
+			request: &bitbucket.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bb-test",
+				PageSize:            1,
+				EntityExternalID:    bitbucket.Workspace,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(server.URL + "/2.0/workspaces?pagelen=1&page=2"),
+				},
+			},
+			wantResponse: &bitbucket.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"type": "workspace", "uuid": "{ws-2}", "slug": "globex", "name": "Globex", "is_private": false, "created_on": "2021-01-02T03:04:05.123456+00:00"},
+				},
+			},
+		},
+		"workspaces_cursor_on_another_host": {
+			request: &bitbucket.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bb-test",
+				PageSize:            1,
+				EntityExternalID:    bitbucket.Workspace,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://attacker.example.com/2.0/workspaces?page=2"),
+				},
+			},
+			wantErr: pagination.NewCursorError(
+				bitbucket.Workspace,
+				pagination.CompositeCursorShape[string](false),
+				"cursor link is not an endpoint of "+server.URL+"/2.0",
+			),
+		},
+		"workspace_members_first_page": {
+			request: &bitbucket.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bb-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucket.WorkspaceMember,
+			},
+			wantResponse: &bitbucket.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "acme-{user-1}", "type": "workspace_membership", "user": map[string]any{"uuid": "{user-1}", "display_name": "Alice"}, "workspace": map[string]any{"slug": "acme"}},
+					{"id": "acme-{user-2}", "type": "workspace_membership", "user": map[string]any{"uuid": "{user-2}", "display_name": "Bob"}, "workspace": map[string]any{"slug": "acme"}},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr(server.URL + "/2.0/workspaces/acme/members?pagelen=2&page=2"),
+					CollectionID:     testutil.GenPtr("acme"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/2.0/workspaces?pagelen=1&page=2"),
+				},
+			},
+		},
+		"workspace_members_last_page_of_workspace": {
+			request: &bitbucket.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bb-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucket.WorkspaceMember,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr(server.URL + "/2.0/workspaces/acme/members?pagelen=2&page=2"),
+					CollectionID:     testutil.GenPtr("acme"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/2.0/workspaces?pagelen=1&page=2"),
+				},
+			},
+			wantResponse: &bitbucket.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "acme-{user-3}", "type": "workspace_membership", "user": map[string]any{"uuid": "{user-3}", "display_name": "Carol"}, "workspace": map[string]any{"slug": "acme"}},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("acme"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/2.0/workspaces?pagelen=1&page=2"),
+				},
+			},
+		},
+		"workspace_members_last_workspace": {
+			request: &bitbucket.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bb-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucket.WorkspaceMember,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("acme"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/2.0/workspaces?pagelen=1&page=2"),
+				},
+			},
+			wantResponse: &bitbucket.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "globex-{user-1}", "type": "workspace_membership", "user": map[string]any{"uuid": "{user-1}", "display_name": "Alice"}, "workspace": map[string]any{"slug": "globex"}},
+				},
+			},
+		},
+		"repository_permissions": {
+			request: &bitbucket.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bb-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucket.RepositoryPermission,
+			},
+			wantResponse: &bitbucket.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "{repo-1}-{user-1}", "type": "repository_permission", "permission": "admin", "user": map[string]any{"uuid": "{user-1}"}, "repository": map[string]any{"uuid": "{repo-1}", "full_name": "acme/api"}},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("acme"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/2.0/workspaces?pagelen=1&page=2"),
+				},
+			},
+		},
+		"pull_requests": {
+			request: &bitbucket.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bb-test",
+				PageSize:            2,
+				EntityExternalID:    bitbucket.PullRequest,
+				PullRequestStates:   []string{"OPEN", "MERGED"},
+			},
+			wantResponse: &bitbucket.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "acme/api-7", "pullRequestId": float64(7), "type": "pullrequest", "title": "Fix login", "state": "OPEN", "author": map[string]any{"uuid": "{user-2}"}},
+				},
+			},
+		},
+		"unauthorized": {
+			request: &bitbucket.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer bb-invalid",
+				PageSize:            1,
+				EntityExternalID:    bitbucket.Repository,
+			},
+			wantResponse: &bitbucket.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package bitbucket
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// The endpoint of the next pages is the "next" link returned by Bitbucket, which is stored in the cursor.
+// For example, the endpoint of the first page of pull requests of a repository is:
+// https://api.bitbucket.org/2.0/repositories/acme/api/pullrequests?pagelen=50&state=OPEN.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	baseEndpoint := request.BaseURL + "/2.0"
+
+	// [All Entities] This is the link to the next page of objects.
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		// The link is only followed on the queried API, so that the credentials are never sent to another host.
+		if !strings.HasPrefix(*request.Cursor.Cursor, baseEndpoint+"/") {
+			return "", pagination.NewCursorError(
+				request.EntityExternalID,
+				pagination.CompositeCursorShape[string](request.Cursor.CollectionID != nil),
+				fmt.Sprintf("cursor link is not an endpoint of %s", baseEndpoint),
+			)
+		}
+
+		return *request.Cursor.Cursor, nil
+	}
+
+	if entity.memberOf != nil && (request.Cursor == nil || request.Cursor.CollectionID == nil) {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Unable to construct the %s endpoint without a collection ID.", request.EntityExternalID),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	// Each endpoint accepts a pagelen up to its own maximum, so cap the page size.
+	pageSize := min(request.PageSize, entity.maxPageSize)
+
+	var (
+		path   string
+		params = url.Values{}
+	)
+
+	params.Set("pagelen", strconv.FormatInt(pageSize, 10))
+
+	switch request.EntityExternalID {
+	case Workspace:
+		path = "/workspaces"
+	case Repository:
+		path = "/repositories"
+
+		// Without a role, every public repository of Bitbucket Cloud is listed.
+		params.Set("role", "member")
+	case WorkspaceMember:
+		path = "/workspaces/" + url.PathEscape(*request.Cursor.CollectionID) + "/members"
+	case RepositoryPermission:
+		path = "/workspaces/" + url.PathEscape(*request.Cursor.CollectionID) + "/permissions/repositories"
+	case PullRequest:
+		// The collection ID is the full name of the repository, e.g. "acme/api".
+		workspace, repository, _ := strings.Cut(*request.Cursor.CollectionID, "/")
+
+		path = "/repositories/" + url.PathEscape(workspace) + "/" + url.PathEscape(repository) + "/pullrequests"
+
+		for _, state := range request.PullRequestStates {
+			params.Add("state", state)
+		}
+	}
+
+	return baseEndpoint + path + "?" + params.Encode(), nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package bitbucket_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/bitbucket"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *bitbucket.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &bitbucket.Request{
+				BaseURL:          "https://api.bitbucket.org",
+				PageSize:         100,
+				EntityExternalID: "Commit",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"workspaces": {
+			request: &bitbucket.Request{
+				BaseURL:          "https://api.bitbucket.org",
+				PageSize:         100,
+				EntityExternalID: bitbucket.Workspace,
+			},
+			wantEndpoint: "https://api.bitbucket.org/2.0/workspaces?pagelen=100",
+		},
+		"workspaces_next_page": {
+			request: &bitbucket.Request{
+				BaseURL:          "https://api.bitbucket.org",
+				PageSize:         100,
+				EntityExternalID: bitbucket.Workspace,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://api.bitbucket.org/2.0/workspaces?pagelen=100&page=2"),
+				},
+			},
+			wantEndpoint: "https://api.bitbucket.org/2.0/workspaces?pagelen=100&page=2",
+		},
+		"workspaces_next_page_on_another_host": {
+			request: &bitbucket.Request{
+				BaseURL:          "https://api.bitbucket.org",
+				PageSize:         100,
+				EntityExternalID: bitbucket.Workspace,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://api.bitbucket.org.attacker.com/2.0/workspaces?page=2"),
+				},
+			},
+			wantErr: pagination.NewCursorError(
+				bitbucket.Workspace,
+				pagination.CompositeCursorShape[string](false),
+				"cursor link is not an endpoint of https://api.bitbucket.org/2.0",
+			),
+		},
+		"repositories": {
+			request: &bitbucket.Request{
+				BaseURL:          "https://api.bitbucket.org",
+				PageSize:         100,
+				EntityExternalID: bitbucket.Repository,
+			},
+			wantEndpoint: "https://api.bitbucket.org/2.0/repositories?pagelen=100&role=member",
+		},
+		"workspace_members": {
+			request: &bitbucket.Request{
+				BaseURL:          "https://api.bitbucket.org",
+				PageSize:         100,
+				EntityExternalID: bitbucket.WorkspaceMember,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("acme"),
+				},
+			},
+			wantEndpoint: "https://api.bitbucket.org/2.0/workspaces/acme/members?pagelen=100",
+		},
+		"workspace_members_without_collection_id": {
+			request: &bitbucket.Request{
+				BaseURL:          "https://api.bitbucket.org",
+				PageSize:         100,
+				EntityExternalID: bitbucket.WorkspaceMember,
+			},
+			wantErr: &framework.Error{
+				Message: "Unable to construct the WorkspaceMember endpoint without a collection ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"repository_permissions": {
+			request: &bitbucket.Request{
+				BaseURL:          "https://api.bitbucket.org",
+				PageSize:         100,
+				EntityExternalID: bitbucket.RepositoryPermission,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("acme"),
+				},
+			},
+			wantEndpoint: "https://api.bitbucket.org/2.0/workspaces/acme/permissions/repositories?pagelen=100",
+		},
+		"pull_requests_capped_page_size": {
+			request: &bitbucket.Request{
+				BaseURL:           "https://api.bitbucket.org",
+				PageSize:          100,
+				EntityExternalID:  bitbucket.PullRequest,
+				PullRequestStates: []string{"OPEN", "DECLINED"},
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("acme/web app"),
+				},
+			},
+			wantEndpoint: "https://api.bitbucket.org/2.0/repositories/acme/web%20app/pullrequests?pagelen=50&state=OPEN&state=DECLINED",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := bitbucket.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package bitbucket_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/bitbucket"
+)
+
+// FuzzParseResponse checks that malformed Bitbucket responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"values":[{"uuid":"{d301aafa-d676-4ee0-88be-962be7417567}"}],"next":"https://api.bitbucket.org/2.0/workspaces?page=2"}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, _, err := bitbucket.ParseResponse(body)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
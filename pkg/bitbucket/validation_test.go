@@ -0,0 +1,205 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package bitbucket_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/bitbucket"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: bitbucket.Workspace,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "uuid",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "slug",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[bitbucket.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request_bearer_token": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: "api.bitbucket.org",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Entity: validEntity,
+				Config: &bitbucket.Config{
+					PullRequestStates: []string{"OPEN", "MERGED"},
+				},
+				PageSize: 100,
+			},
+			wantAddress: "https://api.bitbucket.org",
+		},
+		"valid_request_basic_auth_nil_config": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: "https://api.bitbucket.org",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "alice@acme.com",
+						Password: "api-token",
+					},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantAddress: "https://api.bitbucket.org",
+		},
+		"invalid_request_unsupported_pull_request_state": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: "https://api.bitbucket.org",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Entity: validEntity,
+				Config: &bitbucket.Config{
+					PullRequestStates: []string{"OPEN", "CLOSED"},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Bitbucket config is invalid: pullRequestStates contains an unsupported state: CLOSED.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: "http://api.bitbucket.org",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[bitbucket.Config]{
+				Address:  "https://api.bitbucket.org",
+				Auth:     &framework.DatasourceAuthCredentials{},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Request to Bitbucket is missing Basic Auth or Bearer token credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: "https://api.bitbucket.org",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "bb-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: "https://api.bitbucket.org",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Commit",
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: "https://api.bitbucket.org",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: bitbucket.WorkspaceMember,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uuid",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: "https://api.bitbucket.org",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Entity:   validEntity,
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[bitbucket.Config]{
+				Address: "https://api.bitbucket.org",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer bb-test",
+				},
+				Entity:   validEntity,
+				PageSize: 101,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (101) exceeds the maximum allowed (100).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &bitbucket.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
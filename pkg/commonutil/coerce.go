@@ -0,0 +1,216 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package commonutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The coercion helpers convert the values returned by the datasources into the types of their attributes.
+// Datasources return numbers and booleans in many formats: JSON numbers are unmarshalled as float64, and CSV
+// files and HR exports contain strings formatted for the locale of their author, e.g. "1.234,5" for 1234.5,
+// which strconv rejects. Adapters must use these helpers instead of converting the values themselves, so that all
+// the adapters accept the same formats:
+//   - Booleans: true and false, "true" and "false", "t" and "f", "1" and "0", in any case, and the numbers 1 and 0.
+//   - Numbers: JSON numbers, and strings with a "." or "," decimal separator, and with ".", ",", "'" or space
+//     group separators, e.g. "1,234.5", "1.234,5", "1 234,5" and "1'234.5". The decimal separator is the last
+//     separator if the string contains both a "." and a ",", or the only separator if it occurs once, i.e.
+//     "1,234" is 1.234. A separator occurring more than once is a group separator, i.e. "1,234,567" is 1234567.
+//   - Integers: numbers without a fractional part, within the range of int64.
+
+// ParseBool converts a boolean value into a bool.
+func ParseBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "t", "1":
+			return true, nil
+		case "false", "f", "0":
+			return false, nil
+		}
+	case float64:
+		switch v {
+		case 1:
+			return true, nil
+		case 0:
+			return false, nil
+		}
+	case json.Number:
+		return ParseBool(string(v))
+	}
+
+	return false, fmt.Errorf("invalid boolean value: %v", value)
+}
+
+// ParseFloat converts a numeric value into a float64.
+func ParseFloat(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		return parseNumber(string(v))
+	case string:
+		return parseNumber(v)
+	default:
+		return 0, fmt.Errorf("invalid numeric value of type %T: %v", value, value)
+	}
+}
+
+// ParseInt64 converts an integer value into an int64, e.g. the numeric IDs unmarshalled as float64 from JSON
+// responses, like the databaseId of the GitHub objects.
+func ParseInt64(value any) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case json.Number, string:
+		s := normalizeNumberSeparators(fmt.Sprint(v))
+
+		// Parse the integer exactly, as float64 can't represent the integers larger than 2^53.
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i, nil
+		}
+	}
+
+	f, err := ParseFloat(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return floatToInt64(f)
+}
+
+// FormatID formats the value of an ID as a string, e.g. to build the unique ID of an object from the IDs of its
+// parents. Numeric IDs are formatted as integers, e.g. "12345678" rather than "1.2345678e+07" with fmt.Sprint.
+func FormatID(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		if i, err := floatToInt64(v); err == nil {
+			return strconv.FormatInt(i, 10)
+		}
+
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func floatToInt64(f float64) (int64, error) {
+	if math.Trunc(f) != f {
+		return 0, fmt.Errorf("invalid integer value: %v", f)
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which is out of range.
+	if f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, fmt.Errorf("integer value out of range: %v", f)
+	}
+
+	return int64(f), nil
+}
+
+// parseNumber parses a number formatted for any locale, see the formats above.
+func parseNumber(s string) (float64, error) {
+	normalized := normalizeNumberSeparators(s)
+
+	// strconv also accepts "NaN", "Inf", hexadecimal numbers and underscores, which aren't valid in exports.
+	if strings.Trim(normalized, "0123456789.+-eE") != "" {
+		return 0, fmt.Errorf("invalid numeric value: %q", s)
+	}
+
+	f, err := strconv.ParseFloat(normalized, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid numeric value: %q", s)
+	}
+
+	return f, nil
+}
+
+// normalizeNumberSeparators removes the group separators of a number, and replaces its decimal separator with ".".
+// The number is returned as is, except for the surrounding whitespace, if its group separators are misplaced,
+// e.g. "1,23,4", for strconv to reject it.
+func normalizeNumberSeparators(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\u00a0', '\u202f', '\'', '\u2019':
+			return -1
+		default:
+			return r
+		}
+	}, strings.TrimSpace(s))
+
+	lastDot, lastComma := strings.LastIndex(s, "."), strings.LastIndex(s, ",")
+
+	var decimalSeparator, groupSeparator string
+
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		decimalSeparator, groupSeparator = ".", ","
+		if lastComma > lastDot {
+			decimalSeparator, groupSeparator = ",", "."
+		}
+	case lastComma >= 0:
+		decimalSeparator, groupSeparator = ",", "."
+		if strings.Count(s, ",") > 1 {
+			decimalSeparator, groupSeparator = ".", ","
+		}
+	case lastDot >= 0:
+		decimalSeparator, groupSeparator = ".", ","
+		if strings.Count(s, ".") > 1 {
+			decimalSeparator, groupSeparator = ",", "."
+		}
+	default:
+		return s
+	}
+
+	integer, fraction, hasFraction := s, "", false
+	if i := strings.LastIndex(s, decimalSeparator); i >= 0 {
+		integer, fraction, hasFraction = s[:i], s[i+1:], true
+	}
+
+	if strings.Contains(fraction, groupSeparator) {
+		return s
+	}
+
+	if strings.Contains(integer, groupSeparator) {
+		groups := strings.Split(strings.TrimLeft(integer, "+-"), groupSeparator)
+
+		if len(groups[0]) == 0 || len(groups[0]) > 3 {
+			return s
+		}
+
+		for _, group := range groups[1:] {
+			if len(group) != 3 {
+				return s
+			}
+		}
+
+		integer = strings.ReplaceAll(integer, groupSeparator, "")
+	}
+
+	if !hasFraction {
+		return integer
+	}
+
+	return integer + "." + fraction
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package commonutil_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
+)
+
+func TestParseBool(t *testing.T) {
+	tests := map[string]struct {
+		value   any
+		want    bool
+		wantErr bool
+	}{
+		"bool":                  {value: true, want: true},
+		"string_true":           {value: "true", want: true},
+		"string_false":          {value: "false", want: false},
+		"string_uppercase":      {value: "TRUE", want: true},
+		"string_with_spaces":    {value: " False ", want: false},
+		"string_one":            {value: "1", want: true},
+		"string_zero":           {value: "0", want: false},
+		"string_t":              {value: "t", want: true},
+		"number_one":            {value: float64(1), want: true},
+		"number_zero":           {value: float64(0), want: false},
+		"json_number":           {value: json.Number("1"), want: true},
+		"invalid_string":        {value: "yes", wantErr: true},
+		"empty_string":          {value: "", wantErr: true},
+		"invalid_number":        {value: float64(2), wantErr: true},
+		"invalid_type":          {value: []any{true}, wantErr: true},
+		"nil":                   {value: nil, wantErr: true},
+		"string_number_decimal": {value: "1.0", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := commonutil.ParseBool(tt.value)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	tests := map[string]struct {
+		value   any
+		want    float64
+		wantErr bool
+	}{
+		"float64":                         {value: 1234.5, want: 1234.5},
+		"int":                             {value: 12, want: 12},
+		"int64":                           {value: int64(12), want: 12},
+		"json_number":                     {value: json.Number("1234.5"), want: 1234.5},
+		"string":                          {value: "1234.5", want: 1234.5},
+		"string_negative":                 {value: "-1234.5", want: -1234.5},
+		"string_exponent":                 {value: "1.5e3", want: 1500},
+		"string_with_spaces":              {value: " 12 ", want: 12},
+		"comma_decimal":                   {value: "1234,5", want: 1234.5},
+		"dot_group_comma_decimal":         {value: "1.234,5", want: 1234.5},
+		"comma_group_dot_decimal":         {value: "1,234.5", want: 1234.5},
+		"space_group_comma_decimal":       {value: "1 234,5", want: 1234.5},
+		"no_break_space_group":            {value: "1\u00a0234\u00a0567,89", want: 1234567.89},
+		"narrow_no_break_space_group":     {value: "-1\u202f234,5", want: -1234.5},
+		"apostrophe_group":                {value: "1'234.5", want: 1234.5},
+		"multiple_comma_groups":           {value: "1,234,567", want: 1234567},
+		"multiple_dot_groups":             {value: "1.234.567", want: 1234567},
+		"multiple_groups_and_decimal":     {value: "-1.234.567,891", want: -1234567.891},
+		"single_comma_is_decimal":         {value: "1,234", want: 1.234},
+		"misplaced_group_separator":       {value: "1,23,4", wantErr: true},
+		"group_separator_in_fraction":     {value: "1.234,5.6", wantErr: true},
+		"leading_group_separator":         {value: ",123,456", wantErr: true},
+		"empty_string":                    {value: "", wantErr: true},
+		"nan":                             {value: "NaN", wantErr: true},
+		"infinity":                        {value: "Inf", wantErr: true},
+		"hexadecimal":                     {value: "0x1p-2", wantErr: true},
+		"underscores":                     {value: "1_000", wantErr: true},
+		"text":                            {value: "twelve", wantErr: true},
+		"bool":                            {value: true, wantErr: true},
+		"nil":                             {value: nil, wantErr: true},
+		"currency_symbols_are_not_parsed": {value: "$12", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := commonutil.ParseFloat(tt.value)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInt64(t *testing.T) {
+	tests := map[string]struct {
+		value   any
+		want    int64
+		wantErr bool
+	}{
+		"int64":                {value: int64(42), want: 42},
+		"int":                  {value: 42, want: 42},
+		"float64":              {value: float64(12345678), want: 12345678},
+		"float64_negative":     {value: float64(-3), want: -3},
+		"float64_min":          {value: float64(math.MinInt64), want: math.MinInt64},
+		"json_number":          {value: json.Number("9007199254740993"), want: 9007199254740993},
+		"string":               {value: "9007199254740993", want: 9007199254740993},
+		"string_max":           {value: "9223372036854775807", want: math.MaxInt64},
+		"string_with_groups":   {value: "1.234.567", want: 1234567},
+		"string_with_decimals": {value: "1 234,00", want: 1234},
+		"string_exponent":      {value: "1e3", want: 1000},
+		"float64_fraction":     {value: 1.5, wantErr: true},
+		"float64_out_of_range": {value: float64(math.MaxInt64), wantErr: true},
+		"float64_nan":          {value: math.NaN(), wantErr: true},
+		"string_fraction":      {value: "1,5", wantErr: true},
+		"string_out_of_range":  {value: "9223372036854775808", wantErr: true},
+		"invalid_string":       {value: "abc", wantErr: true},
+		"invalid_type":         {value: map[string]any{}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := commonutil.ParseInt64(tt.value)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatID(t *testing.T) {
+	tests := map[string]struct {
+		value any
+		want  string
+	}{
+		"string":           {value: "abc", want: "abc"},
+		"float64":          {value: float64(12345678), want: "12345678"},
+		"float64_large":    {value: float64(1 << 52), want: "4503599627370496"},
+		"float64_fraction": {value: 1.5, want: "1.5"},
+		"json_number":      {value: json.Number("9007199254740993"), want: "9007199254740993"},
+		"int":              {value: 42, want: "42"},
+		"bool":             {value: true, want: "true"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := commonutil.FormatID(tt.value); got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package commonutil
+
+import (
+	"reflect"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+)
+
+// Adapters represent null and missing attribute values the same way: the attribute is omitted from the
+// object. Objects must not contain nil values, nor empty strings standing in for a null value:
+//   - A value which is null or missing in the datasource is omitted.
+//   - An empty string is only returned for a string attribute whose value in the datasource is an
+//     explicit empty string, e.g. '' in a SQL database or "" in a JSON response.
+//   - In text formats which can't represent a null value, e.g. CSV, an empty field is null.
+
+// IsNull returns true if the value represents a null attribute value, i.e. if it is nil or a nil pointer,
+// slice, map or interface.
+func IsNull(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// SetAttribute sets the value of an attribute of an object, unless the value is null, in which case the
+// attribute is omitted from the object.
+//
+// Example:
+//
+//	obj := map[string]any{}
+//	SetAttribute(obj, "email", nil)              // obj: {}
+//	SetAttribute(obj, "manager", (*string)(nil)) // obj: {}
+//	SetAttribute(obj, "name", "")                // obj: {"name": ""}
+func SetAttribute(obj map[string]any, externalID string, value any) {
+	if IsNull(value) {
+		delete(obj, externalID)
+
+		return
+	}
+
+	obj[externalID] = value
+}
+
+// OmitNullAttributes removes the attributes with a null value from the objects and their child objects,
+// in place.
+func OmitNullAttributes(objects []framework.Object) {
+	for _, obj := range objects {
+		for externalID, value := range obj {
+			if childObjects, ok := value.([]framework.Object); ok {
+				OmitNullAttributes(childObjects)
+
+				continue
+			}
+
+			if IsNull(value) {
+				delete(obj, externalID)
+			}
+		}
+	}
+}
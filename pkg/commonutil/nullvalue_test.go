@@ -0,0 +1,82 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package commonutil_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
+)
+
+func TestIsNull(t *testing.T) {
+	tests := map[string]struct {
+		value any
+		want  bool
+	}{
+		"nil":                 {value: nil, want: true},
+		"nil_string_pointer":  {value: (*string)(nil), want: true},
+		"nil_string_slice":    {value: ([]string)(nil), want: true},
+		"nil_map":             {value: (map[string]any)(nil), want: true},
+		"empty_string":        {value: "", want: false},
+		"string_pointer":      {value: new(string), want: false},
+		"empty_string_slice":  {value: []string{}, want: false},
+		"false":               {value: false, want: false},
+		"zero":                {value: int64(0), want: false},
+		"empty_child_objects": {value: []framework.Object{}, want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := commonutil.IsNull(tt.value); got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetAttribute(t *testing.T) {
+	obj := map[string]any{"email": "john@example.com"}
+
+	commonutil.SetAttribute(obj, "email", nil)
+	commonutil.SetAttribute(obj, "manager", (*string)(nil))
+	commonutil.SetAttribute(obj, "name", "")
+	commonutil.SetAttribute(obj, "active", false)
+
+	want := map[string]any{"name": "", "active": false}
+
+	if !reflect.DeepEqual(obj, want) {
+		t.Errorf("got: %v, want: %v", obj, want)
+	}
+}
+
+func TestOmitNullAttributes(t *testing.T) {
+	objects := []framework.Object{
+		{
+			"id":      "1",
+			"email":   nil,
+			"manager": (*string)(nil),
+			"name":    "",
+			"groups": []framework.Object{
+				{"id": "g1", "description": nil},
+			},
+		},
+	}
+
+	want := []framework.Object{
+		{
+			"id":   "1",
+			"name": "",
+			"groups": []framework.Object{
+				{"id": "g1"},
+			},
+		},
+	}
+
+	commonutil.OmitNullAttributes(objects)
+
+	if !reflect.DeepEqual(objects, want) {
+		t.Errorf("got: %v, want: %v", objects, want)
+	}
+}
@@ -2,6 +2,14 @@
 
 package config
 
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sgnl-ai/adapters/pkg/normalize"
+)
+
 var (
 	DefaultRequestTimeout = 10 // 10 seconds
 )
@@ -10,6 +18,20 @@ var (
 // from a database adapter.
 const MaxPageSize = 999
 
+// SyncMode indicates whether a sync retrieves all objects of an entity or only the objects
+// that changed since a point in time.
+type SyncMode string
+
+const (
+	// SyncModeFull is a full backfill of all objects of an entity. This is the default.
+	SyncModeFull SyncMode = "FULL"
+
+	// SyncModeIncremental is an incremental sync of the objects of an entity changed since
+	// CommonConfig.IncrementalSyncSince. Adapters that do not support incremental syncs for an
+	// entity fall back to a full backfill.
+	SyncModeIncremental SyncMode = "INCREMENTAL"
+)
+
 // CommonConfig is a collection of configuration common to all adapters.
 type CommonConfig struct {
 	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
@@ -21,6 +43,42 @@ type CommonConfig struct {
 	// number of seconds east of UTC. If this is set to 0 or not set, this will default to UTC.
 	// Allowed offset is -12 hours to 14 hours, in seconds.
 	LocalTimeZoneOffset int `json:"localTimeZoneOffset,omitempty" validate:"omitempty,gte=-43200,lte=50400"`
+
+	// EntityPageSizes is an optional map of entity external IDs to the maximum number of objects
+	// to request per page for that entity. If set for an entity, the page size requested by SGNL
+	// is capped to this value. Values less than or equal to 0 are ignored.
+	EntityPageSizes map[string]int64 `json:"entityPageSizes,omitempty"`
+
+	// SyncMode is the sync mode of the requests. If not set, this defaults to SyncModeFull.
+	// Adapters may use different query strategies and indexes in each mode.
+	SyncMode SyncMode `json:"syncMode,omitempty"`
+
+	// IncrementalSyncSince is the time since which objects must have changed to be returned
+	// during an incremental sync. Required if SyncMode is SyncModeIncremental.
+	IncrementalSyncSince *time.Time `json:"incrementalSyncSince,omitempty"`
+
+	// EntityNormalization is an optional map of entity external IDs to the normalization rules of their
+	// attributes, applied to the objects returned for the entity, e.g. to lowercase email addresses.
+	EntityNormalization map[string]normalize.EntityRules `json:"entityNormalization,omitempty"`
+
+	// SyncSummary enables the summary of the objects returned for each entity across the pages of a sync,
+	// i.e. the number of objects and a checksum, logged with the last page. See the syncsummary package.
+	SyncSummary bool `json:"syncSummary,omitempty"`
+
+	// SchemaDriftDetection enables the detection of the fields of the objects returned by the datasource that
+	// aren't configured as attributes, and of the configured attributes missing from the objects, logged with
+	// example objects. See the schemadrift package.
+	SchemaDriftDetection bool `json:"schemaDriftDetection,omitempty"`
+
+	// CursorTTLSeconds is the maximum age of the cursors returned by the adapter, in seconds. A cursor older than
+	// this is rejected with an error asking to restart the sync. If not set, the cursors don't expire.
+	// See the cursorstamp package.
+	CursorTTLSeconds int `json:"cursorTTLSeconds,omitempty" validate:"omitempty,gt=0"`
+
+	// DeduplicateObjects enables the suppression of the objects returned more than once for an entity during a
+	// sync, e.g. when offset pagination skips backwards because objects were deleted between pages. The objects
+	// are identified by their unique ID attribute. See the dedup package.
+	DeduplicateObjects bool `json:"deduplicateObjects,omitempty"`
 }
 
 // SetMissingCommonConfigDefaults sets default values for any missing common configuration values.
@@ -37,3 +95,83 @@ func SetMissingCommonConfigDefaults(c *CommonConfig) *CommonConfig {
 
 	return c
 }
+
+// PageSizeForEntity returns the page size to use when requesting a page of the given entity.
+// The returned value is the smaller of the requested page size and the maximum page size
+// configured for the entity in EntityPageSizes, if any.
+func (c *CommonConfig) PageSizeForEntity(entityExternalID string, pageSize int64) int64 {
+	if c == nil {
+		return pageSize
+	}
+
+	if maxPageSize, found := c.EntityPageSizes[entityExternalID]; found && maxPageSize > 0 && maxPageSize < pageSize {
+		return maxPageSize
+	}
+
+	return pageSize
+}
+
+// ValidateSyncMode validates the SyncMode and IncrementalSyncSince fields.
+func (c *CommonConfig) ValidateSyncMode() error {
+	if c == nil {
+		return nil
+	}
+
+	switch c.SyncMode {
+	case "", SyncModeFull:
+		if c.IncrementalSyncSince != nil {
+			return errors.New("incrementalSyncSince must not be set unless syncMode is INCREMENTAL")
+		}
+	case SyncModeIncremental:
+		if c.IncrementalSyncSince == nil {
+			return errors.New("incrementalSyncSince is required when syncMode is INCREMENTAL")
+		}
+	default:
+		return fmt.Errorf("syncMode must be one of %s or %s, got %q", SyncModeFull, SyncModeIncremental, c.SyncMode)
+	}
+
+	return nil
+}
+
+// ChangedSince returns the time since which objects must have changed to be returned if the
+// sync is incremental, or nil if the sync is a full backfill.
+func (c *CommonConfig) ChangedSince() *time.Time {
+	if c == nil || c.SyncMode != SyncModeIncremental {
+		return nil
+	}
+
+	return c.IncrementalSyncSince
+}
+
+// NormalizationRules returns the normalization rules of the entity, or nil if it has none.
+func (c *CommonConfig) NormalizationRules(entityExternalID string) normalize.EntityRules {
+	if c == nil {
+		return nil
+	}
+
+	return c.EntityNormalization[entityExternalID]
+}
+
+// SyncSummaryEnabled returns whether the summary of the syncs is computed and logged.
+func (c *CommonConfig) SyncSummaryEnabled() bool {
+	return c != nil && c.SyncSummary
+}
+
+// SchemaDriftDetectionEnabled returns whether the schema drift of the objects is detected and logged.
+func (c *CommonConfig) SchemaDriftDetectionEnabled() bool {
+	return c != nil && c.SchemaDriftDetection
+}
+
+// DeduplicationEnabled returns whether the objects returned more than once during a sync are suppressed.
+func (c *CommonConfig) DeduplicationEnabled() bool {
+	return c != nil && c.DeduplicateObjects
+}
+
+// CursorTTL returns the maximum age of the cursors, or 0 if the cursors don't expire.
+func (c *CommonConfig) CursorTTL() time.Duration {
+	if c == nil || c.CursorTTLSeconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(c.CursorTTLSeconds) * time.Second
+}
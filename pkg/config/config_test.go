@@ -0,0 +1,178 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+func TestPageSizeForEntity(t *testing.T) {
+	tests := map[string]struct {
+		config           *config.CommonConfig
+		entityExternalID string
+		pageSize         int64
+		want             int64
+	}{
+		"nil_config": {
+			config:           nil,
+			entityExternalID: "User",
+			pageSize:         200,
+			want:             200,
+		},
+		"no_overrides": {
+			config:           &config.CommonConfig{},
+			entityExternalID: "User",
+			pageSize:         200,
+			want:             200,
+		},
+		"override_caps_page_size": {
+			config: &config.CommonConfig{
+				EntityPageSizes: map[string]int64{"GroupMember": 100},
+			},
+			entityExternalID: "GroupMember",
+			pageSize:         200,
+			want:             100,
+		},
+		"override_larger_than_page_size": {
+			config: &config.CommonConfig{
+				EntityPageSizes: map[string]int64{"User": 500},
+			},
+			entityExternalID: "User",
+			pageSize:         200,
+			want:             200,
+		},
+		"override_for_other_entity": {
+			config: &config.CommonConfig{
+				EntityPageSizes: map[string]int64{"GroupMember": 100},
+			},
+			entityExternalID: "User",
+			pageSize:         200,
+			want:             200,
+		},
+		"non_positive_override_ignored": {
+			config: &config.CommonConfig{
+				EntityPageSizes: map[string]int64{"User": 0},
+			},
+			entityExternalID: "User",
+			pageSize:         200,
+			want:             200,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.config.PageSizeForEntity(tt.entityExternalID, tt.pageSize); got != tt.want {
+				t.Errorf("got: %d, want: %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateSyncMode(t *testing.T) {
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := map[string]struct {
+		config  *config.CommonConfig
+		wantErr string
+	}{
+		"nil_config": {
+			config: nil,
+		},
+		"default_mode": {
+			config: &config.CommonConfig{},
+		},
+		"full_mode": {
+			config: &config.CommonConfig{SyncMode: config.SyncModeFull},
+		},
+		"incremental_mode": {
+			config: &config.CommonConfig{SyncMode: config.SyncModeIncremental, IncrementalSyncSince: &since},
+		},
+		"incremental_mode_missing_since": {
+			config:  &config.CommonConfig{SyncMode: config.SyncModeIncremental},
+			wantErr: "incrementalSyncSince is required when syncMode is INCREMENTAL",
+		},
+		"full_mode_with_since": {
+			config:  &config.CommonConfig{SyncMode: config.SyncModeFull, IncrementalSyncSince: &since},
+			wantErr: "incrementalSyncSince must not be set unless syncMode is INCREMENTAL",
+		},
+		"invalid_mode": {
+			config:  &config.CommonConfig{SyncMode: "PARTIAL"},
+			wantErr: `syncMode must be one of FULL or INCREMENTAL, got "PARTIAL"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.config.ValidateSyncMode()
+
+			var gotErr string
+			if err != nil {
+				gotErr = err.Error()
+			}
+
+			if gotErr != tt.wantErr {
+				t.Errorf("gotErr: %q, wantErr: %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChangedSince(t *testing.T) {
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := map[string]struct {
+		config *config.CommonConfig
+		want   *time.Time
+	}{
+		"nil_config": {
+			config: nil,
+		},
+		"full_mode": {
+			config: &config.CommonConfig{SyncMode: config.SyncModeFull},
+		},
+		"incremental_mode": {
+			config: &config.CommonConfig{SyncMode: config.SyncModeIncremental, IncrementalSyncSince: &since},
+			want:   &since,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.config.ChangedSince(); got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorTTL(t *testing.T) {
+	tests := map[string]struct {
+		config *config.CommonConfig
+		want   time.Duration
+	}{
+		"nil_config": {
+			config: nil,
+		},
+		"not_set": {
+			config: &config.CommonConfig{},
+		},
+		"set": {
+			config: &config.CommonConfig{CursorTTLSeconds: 86400},
+			want:   24 * time.Hour,
+		},
+		"negative": {
+			config: &config.CommonConfig{CursorTTLSeconds: -1},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.config.CursorTTL(); got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
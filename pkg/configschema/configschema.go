@@ -0,0 +1,391 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package configschema generates the JSON Schema of the config of an adapter from its Config struct, so that
+// the config can be rendered and validated before it is sent to the adapter, without maintaining a copy of
+// the config fields by hand.
+//
+// The properties of the schema are named after the json tags of the fields, and the constraints are read from
+// their validate tags, using the syntax of github.com/go-playground/validator. Validation rules without a JSON
+// Schema equivalent are ignored. The schemas of all adapters are written at build time by cmd/config-schema.
+package configschema
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Draft is the version of the JSON Schema specification of the generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema, limited to the keywords needed to describe an adapter config.
+type Schema struct {
+	Schema string             `json:"$schema,omitempty"`
+	Title  string             `json:"title,omitempty"`
+	Ref    string             `json:"$ref,omitempty"`
+	Defs   map[string]*Schema `json:"$defs,omitempty"`
+
+	Type   string `json:"type,omitempty"`
+	Format string `json:"format,omitempty"`
+	Enum   []any  `json:"enum,omitempty"`
+
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64 `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64 `json:"exclusiveMaximum,omitempty"`
+
+	MinLength     *int64 `json:"minLength,omitempty"`
+	MaxLength     *int64 `json:"maxLength,omitempty"`
+	MinItems      *int64 `json:"minItems,omitempty"`
+	MaxItems      *int64 `json:"maxItems,omitempty"`
+	MinProperties *int64 `json:"minProperties,omitempty"`
+	MaxProperties *int64 `json:"maxProperties,omitempty"`
+}
+
+var (
+	timeType            = reflect.TypeFor[time.Time]()
+	rawMessageType      = reflect.TypeFor[json.RawMessage]()
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// formats maps the validation rules that constrain the format of a string to the JSON Schema format.
+var formats = map[string]string{
+	"email":    "email",
+	"hostname": "hostname",
+	"http_url": "uri",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+	"uri":      "uri",
+	"url":      "uri",
+	"uuid":     "uuid",
+}
+
+// Generate returns the JSON Schema of the config, which must be a struct or a pointer to a struct, e.g.
+// a zero value of the Config struct of an adapter.
+func Generate(title string, config any) (*Schema, error) {
+	t := reflect.TypeOf(config)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("the config must be a struct, got %T", config)
+	}
+
+	g := newGenerator()
+
+	schema, err := g.schemaFor(t)
+	if err != nil {
+		return nil, err
+	}
+
+	schema.Schema = Draft
+	schema.Title = title
+
+	if len(g.defs) > 0 {
+		schema.Defs = g.defs
+	}
+
+	return schema, nil
+}
+
+type generator struct {
+	// visiting is the set of struct types whose schema is being generated, to detect recursive types.
+	visiting map[reflect.Type]bool
+
+	// recursive is the set of struct types referencing themselves, whose schema is defined in defs.
+	recursive map[reflect.Type]bool
+
+	// defs are the schemas of the recursive types, referenced by name.
+	defs map[string]*Schema
+}
+
+func newGenerator() *generator {
+	return &generator{
+		visiting:  make(map[reflect.Type]bool),
+		recursive: make(map[reflect.Type]bool),
+		defs:      make(map[string]*Schema),
+	}
+}
+
+// schemaFor returns the schema of the values of the type.
+func (g *generator) schemaFor(t reflect.Type) (*Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	case t == rawMessageType:
+		return &Schema{}, nil
+	case reflect.PointerTo(t).Implements(jsonUnmarshalerType):
+		// The JSON representation of types with a custom unmarshaler can't be known from the Go type.
+		return &Schema{}, nil
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		return &Schema{Type: "string"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Slice, reflect.Array:
+		// Byte slices are encoded as base64 strings.
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string"}, nil
+		}
+
+		items, err := g.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		values, err := g.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		return nil, fmt.Errorf("the type %s of kind %s is not supported", t, t.Kind())
+	}
+}
+
+// structSchema returns the schema of a struct. The fields of embedded structs without a json tag are
+// promoted to the struct, as they are by encoding/json. The schemas of recursive types are defined once
+// in $defs and referenced wherever the type is used.
+func (g *generator) structSchema(t reflect.Type) (*Schema, error) {
+	ref := &Schema{Ref: "#/$defs/" + t.String()}
+
+	if _, found := g.defs[t.String()]; found {
+		return ref, nil
+	}
+
+	if g.visiting[t] {
+		g.recursive[t] = true
+
+		return ref, nil
+	}
+
+	g.visiting[t] = true
+	defer delete(g.visiting, t)
+
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	if err := g.addFields(schema, t); err != nil {
+		return nil, err
+	}
+
+	if g.recursive[t] {
+		g.defs[t.String()] = schema
+
+		return ref, nil
+	}
+
+	return schema, nil
+}
+
+func (g *generator) addFields(schema *Schema, t reflect.Type) error {
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			if err := g.addFields(schema, fieldType); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		property, err := g.schemaFor(field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		required, err := applyRules(property, field.Tag.Get("validate"))
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		schema.Properties[name] = property
+
+		if required {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return nil
+}
+
+// applyRules applies the validation rules of a field to its schema, and returns whether the field is required.
+// The rules following "dive" apply to the items of arrays and the values of maps.
+func applyRules(schema *Schema, tag string) (bool, error) {
+	var required bool
+
+	for i, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+
+		switch {
+		case name == "required":
+			required = true
+		case name == "dive":
+			element := schema.Items
+			if element == nil {
+				element = schema.AdditionalProperties
+			}
+
+			if element == nil {
+				return false, fmt.Errorf("the rule dive is not supported for type %s", schema.Type)
+			}
+
+			_, err := applyRules(element, strings.Join(strings.Split(tag, ",")[i+1:], ","))
+
+			return required, err
+		case strings.Contains(rule, "|"):
+			// Alternative rules can't be expressed without duplicating the schema.
+			continue
+		case formats[name] != "":
+			schema.Format = formats[name]
+		case name == "oneof":
+			if err := applyEnum(schema, param); err != nil {
+				return false, err
+			}
+		case name == "gt" || name == "gte" || name == "lt" || name == "lte" ||
+			name == "min" || name == "max" || name == "len":
+			if err := applyBound(schema, name, param); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return required, nil
+}
+
+func applyEnum(schema *Schema, param string) error {
+	for _, value := range strings.Fields(param) {
+		switch schema.Type {
+		case "integer":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("the value %s of the rule oneof is not an integer", value)
+			}
+
+			schema.Enum = append(schema.Enum, parsed)
+		case "number":
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("the value %s of the rule oneof is not a number", value)
+			}
+
+			schema.Enum = append(schema.Enum, parsed)
+		default:
+			schema.Enum = append(schema.Enum, value)
+		}
+	}
+
+	return nil
+}
+
+// applyBound applies a comparison rule, which bounds the value of numbers, the length of strings, and the
+// number of items of arrays and objects.
+func applyBound(schema *Schema, name, param string) error {
+	if schema.Type == "integer" || schema.Type == "number" {
+		bound, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return fmt.Errorf("the parameter %s of the rule %s is not a number", param, name)
+		}
+
+		switch name {
+		case "gt":
+			schema.ExclusiveMinimum = &bound
+		case "gte", "min":
+			schema.Minimum = &bound
+		case "lt":
+			schema.ExclusiveMaximum = &bound
+		case "lte", "max":
+			schema.Maximum = &bound
+		case "len":
+			schema.Minimum, schema.Maximum = &bound, &bound
+		}
+
+		return nil
+	}
+
+	var minimum, maximum **int64
+
+	switch schema.Type {
+	case "string":
+		minimum, maximum = &schema.MinLength, &schema.MaxLength
+	case "array":
+		minimum, maximum = &schema.MinItems, &schema.MaxItems
+	case "object":
+		minimum, maximum = &schema.MinProperties, &schema.MaxProperties
+	default:
+		return fmt.Errorf("the rule %s is not supported for type %s", name, schema.Type)
+	}
+
+	bound, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		return fmt.Errorf("the parameter %s of the rule %s is not an integer", param, name)
+	}
+
+	switch name {
+	case "gt":
+		exclusiveBound := bound + 1
+		*minimum = &exclusiveBound
+	case "gte", "min":
+		*minimum = &bound
+	case "lt":
+		exclusiveBound := bound - 1
+		*maximum = &exclusiveBound
+	case "lte", "max":
+		*maximum = &bound
+	case "len":
+		*minimum, *maximum = &bound, &bound
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package configschema_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/configschema"
+)
+
+type testTopic struct {
+	Name       string `json:"name" validate:"required,min=1,max=249"`
+	Partitions int32  `json:"partitions,omitempty" validate:"omitempty,gte=0"`
+}
+
+type testConfig struct {
+	*config.CommonConfig
+
+	Mode     string               `json:"mode,omitempty" validate:"omitempty,oneof=full delta"`
+	Endpoint string               `json:"endpoint" validate:"required,url"`
+	Topics   map[string]testTopic `json:"topics,omitempty"`
+	Scopes   []string             `json:"scopes,omitempty" validate:"omitempty,max=5,dive,gt=0"`
+	Since    *time.Time           `json:"since,omitempty"`
+	Extra    json.RawMessage      `json:"extra,omitempty"`
+	Ignored  string               `json:"-"`
+	internal string
+}
+
+type recursiveConfig struct {
+	Children []recursiveConfig `json:"children"`
+}
+
+func TestGenerate(t *testing.T) {
+	tests := map[string]struct {
+		config  any
+		want    string
+		wantErr string
+	}{
+		"simple": {
+			config: struct {
+				Host    string `json:"host" validate:"required,hostname"`
+				Port    int    `json:"port,omitempty" validate:"omitempty,gt=0,lte=65535"`
+				Enabled bool
+			}{},
+			want: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"title": "Test-1.0.0",
+				"type": "object",
+				"properties": {
+					"Enabled": {"type": "boolean"},
+					"host": {"type": "string", "format": "hostname"},
+					"port": {"type": "integer", "exclusiveMinimum": 0, "maximum": 65535}
+				},
+				"required": ["host"]
+			}`,
+		},
+		"pointer_with_embedded_common_config": {
+			config: &testConfig{},
+			want: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"title": "Test-1.0.0",
+				"type": "object",
+				"properties": {
+					"requestTimeoutSeconds": {"type": "integer", "exclusiveMinimum": 0, "maximum": 600},
+					"localTimeZoneOffset": {"type": "integer", "minimum": -43200, "maximum": 50400},
+					"entityPageSizes": {"type": "object", "additionalProperties": {"type": "integer"}},
+					"syncMode": {"type": "string"},
+					"incrementalSyncSince": {"type": "string", "format": "date-time"},
+					"entityNormalization": {
+						"type": "object",
+						"additionalProperties": {
+							"type": "object",
+							"additionalProperties": {
+								"type": "object",
+								"properties": {
+									"split": {"type": "string"},
+									"trimSpace": {"type": "boolean"},
+									"lowercase": {"type": "boolean"},
+									"synonyms": {"type": "object", "additionalProperties": {"type": "string"}}
+								}
+							}
+						}
+					},
+					"syncSummary": {"type": "boolean"},
+					"schemaDriftDetection": {"type": "boolean"},
+					"cursorTTLSeconds": {"type": "integer", "exclusiveMinimum": 0},
+					"deduplicateObjects": {"type": "boolean"},
+					"mode": {"type": "string", "enum": ["full", "delta"]},
+					"endpoint": {"type": "string", "format": "uri"},
+					"topics": {
+						"type": "object",
+						"additionalProperties": {
+							"type": "object",
+							"properties": {
+								"name": {"type": "string", "minLength": 1, "maxLength": 249},
+								"partitions": {"type": "integer", "minimum": 0}
+							},
+							"required": ["name"]
+						}
+					},
+					"scopes": {"type": "array", "items": {"type": "string", "minLength": 1}, "maxItems": 5},
+					"since": {"type": "string", "format": "date-time"},
+					"extra": {}
+				},
+				"required": ["endpoint"]
+			}`,
+		},
+		"not_a_struct": {
+			config:  "config",
+			wantErr: "the config must be a struct, got string",
+		},
+		"recursive_type": {
+			config: struct {
+				Root  recursiveConfig   `json:"root"`
+				Roots []recursiveConfig `json:"roots"`
+			}{},
+			want: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"title": "Test-1.0.0",
+				"type": "object",
+				"properties": {
+					"root": {"$ref": "#/$defs/configschema_test.recursiveConfig"},
+					"roots": {"type": "array", "items": {"$ref": "#/$defs/configschema_test.recursiveConfig"}}
+				},
+				"$defs": {
+					"configschema_test.recursiveConfig": {
+						"type": "object",
+						"properties": {
+							"children": {
+								"type": "array",
+								"items": {"$ref": "#/$defs/configschema_test.recursiveConfig"}
+							}
+						}
+					}
+				}
+			}`,
+		},
+		"unsupported_type": {
+			config: struct {
+				Callback func() `json:"callback"`
+			}{},
+			wantErr: "field Callback: the type func() of kind func is not supported",
+		},
+		"invalid_rule_parameter": {
+			config: struct {
+				Port int `json:"port" validate:"gt=zero"`
+			}{},
+			wantErr: "field Port: the parameter zero of the rule gt is not a number",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := configschema.Generate("Test-1.0.0", tt.config)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("gotErr: %v, wantErr: %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var gotJSON, wantJSON any
+
+			gotBytes, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("failed to marshal the schema: %v", err)
+			}
+
+			if err := json.Unmarshal(gotBytes, &gotJSON); err != nil {
+				t.Fatalf("failed to unmarshal the schema: %v", err)
+			}
+
+			if err := json.Unmarshal([]byte(tt.want), &wantJSON); err != nil {
+				t.Fatalf("failed to unmarshal the expected schema: %v", err)
+			}
+
+			if !reflect.DeepEqual(gotJSON, wantJSON) {
+				t.Errorf("got: %s, want: %s", gotBytes, tt.want)
+			}
+		})
+	}
+}
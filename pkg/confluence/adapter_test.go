@@ -0,0 +1,245 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package confluence_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/confluence"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := confluence.NewAdapter(&confluence.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[string]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[confluence.Config]
+		wantResponse framework.Response
+	}{
+		"spaces_first_page": {
+			request: &framework.Request[confluence.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: confluence.Space,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "key",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "createdAt",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":        "101",
+							"key":       "ENG",
+							"createdAt": time.Date(2020, 1, 2, 3, 4, 5, 123000000, time.UTC),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						Cursor: testutil.GenPtr("/wiki/api/v2/spaces?limit=1&cursor=c2"),
+					}),
+				},
+			},
+		},
+		"space_permissions_first_page": {
+			request: &framework.Request[confluence.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: confluence.SpacePermission,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "spaceId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.principal.id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.operation.key",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":              "101-p1",
+							"spaceId":         "101",
+							"$.principal.id":  "acc-1",
+							"$.operation.key": "read",
+						},
+						{
+							"id":              "101-p2",
+							"spaceId":         "101",
+							"$.principal.id":  "g-1",
+							"$.operation.key": "administer",
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						Cursor:           testutil.GenPtr("/wiki/api/v2/spaces/101/permissions?limit=2&cursor=p3"),
+						CollectionID:     testutil.GenPtr("101"),
+						CollectionCursor: testutil.GenPtr("/wiki/api/v2/spaces?limit=1&cursor=c2"),
+					}),
+				},
+			},
+		},
+		"pages": {
+			request: &framework.Request[confluence.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Config: &confluence.Config{
+					PageStatuses: []string{"current", "archived"},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: confluence.Page,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "status",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":     "9001",
+							"status": "current",
+						},
+						{
+							"id":     "9002",
+							"status": "archived",
+						},
+					},
+				},
+			},
+		},
+		"users_first_page": {
+			request: &framework.Request[confluence.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: confluence.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "accountId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "email",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"accountId": "acc-1",
+							"email":     "alice@acme.com",
+						},
+						{
+							"accountId": "acc-2",
+							"email":     "bob@acme.com",
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						Cursor: testutil.GenPtr("/wiki/rest/api/search/user?cql=type%3Duser&limit=2&start=2"),
+					}),
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[confluence.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-invalid",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: confluence.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
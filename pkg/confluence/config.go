@@ -0,0 +1,63 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package confluence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// DefaultPageStatuses are the statuses of the pages synced if not configured.
+var DefaultPageStatuses = []string{"current"}
+
+var supportedPageStatuses = map[string]struct{}{
+	"current":  {},
+	"archived": {},
+	"trashed":  {},
+	"deleted":  {},
+}
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Confluence Cloud Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "pageStatuses": ["current", "archived"]
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// PageStatuses are the statuses of the pages to sync: "current", "archived", "trashed" or "deleted".
+	// Defaults to DefaultPageStatuses.
+	PageStatuses []string `json:"pageStatuses,omitempty"`
+}
+
+// pageStatuses returns the configured PageStatuses, or the default.
+func (c *Config) pageStatuses() []string {
+	if c == nil || len(c.PageStatuses) == 0 {
+		return DefaultPageStatuses
+	}
+
+	return c.PageStatuses
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	for _, status := range c.PageStatuses {
+		if _, found := supportedPageStatuses[status]; !found {
+			return fmt.Errorf("pageStatuses contains an unsupported status: %v", status)
+		}
+	}
+
+	return c.CommonConfig.ValidateSyncMode()
+}
@@ -0,0 +1,331 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package confluence
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// contextPath is the path of Confluence on a Cloud site, which the links of the REST API v1 are relative to.
+const contextPath = "/wiki"
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+}
+
+// DatasourceResponse is the format of the paginated Confluence Cloud REST API responses.
+// https://developer.atlassian.com/cloud/confluence/rest/v2/intro/#using.
+type DatasourceResponse struct {
+	Results []map[string]any `json:"results"`
+	Links   *Links           `json:"_links,omitempty"`
+}
+
+// Links are the links of a paginated response.
+type Links struct {
+	// Next is the link to the next page, absent on the last page. It is relative to the site with the REST
+	// API v2, e.g. "/wiki/api/v2/spaces?cursor=XXX", and relative to the contextPath with the REST API v1,
+	// e.g. "/rest/api/group?limit=200&start=200".
+	Next string `json:"next,omitempty"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// maxPageSize is the maximum value of the limit parameter accepted by the endpoint.
+	maxPageSize int64
+	// v1 is whether the entity is listed with the REST API v1, as the REST API v2 doesn't list it.
+	v1 bool
+	// objectMember is the member of the results containing the objects, if they are wrapped, e.g. the users
+	// of a user search.
+	objectMember string
+	// memberOf is the external ID of the collection entity the entity is listed for, if any.
+	memberOf *string
+	// collectionIDAttrExternalID is the attribute of the collection objects identifying them in the endpoint of
+	// the entity, e.g. the ID of the spaces.
+	collectionIDAttrExternalID string
+	// setMemberAttributes sets the unique ID of an object listed for the collection, and the attribute
+	// referencing the collection.
+	setMemberAttributes func(collectionID string, object map[string]any)
+}
+
+const (
+	Space           string = "Space"
+	SpacePermission string = "SpacePermission"
+	Page            string = "Page"
+	User            string = "User"
+	Group           string = "Group"
+	GroupMember     string = "GroupMember"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// https://developer.atlassian.com/cloud/confluence/rest/v2/api-group-space/#api-spaces-get.
+		Space: {
+			uniqueIDAttrExternalID: "id",
+			maxPageSize:            250,
+		},
+		// https://developer.atlassian.com/cloud/confluence/rest/v2/api-group-space-permissions/#api-spaces-id-permissions-get.
+		// Connection entity for Spaces <-> Users and Groups, with the operation the principal is allowed to perform.
+		SpacePermission: {
+			uniqueIDAttrExternalID: "id",
+			maxPageSize:            250,
+			memberOf: func() *string {
+				s := Space
+
+				return &s
+			}(),
+			collectionIDAttrExternalID: "id",
+			setMemberAttributes: func(spaceID string, permission map[string]any) {
+				permissionID, _ := permission["id"].(string)
+
+				permission["id"] = spaceID + "-" + permissionID
+				permission["permissionId"] = permissionID
+				permission["spaceId"] = spaceID
+			},
+		},
+		// https://developer.atlassian.com/cloud/confluence/rest/v2/api-group-page/#api-pages-get.
+		Page: {
+			uniqueIDAttrExternalID: "id",
+			maxPageSize:            250,
+		},
+		// https://developer.atlassian.com/cloud/confluence/rest/v1/api-group-search/#api-wiki-rest-api-search-user-get.
+		// The users are searched with the "type=user" CQL query, and returned wrapped in the search results.
+		User: {
+			uniqueIDAttrExternalID: "accountId",
+			maxPageSize:            200,
+			v1:                     true,
+			objectMember:           "user",
+		},
+		// https://developer.atlassian.com/cloud/confluence/rest/v1/api-group-group/#api-wiki-rest-api-group-get.
+		Group: {
+			uniqueIDAttrExternalID: "id",
+			maxPageSize:            200,
+			v1:                     true,
+		},
+		// https://developer.atlassian.com/cloud/confluence/rest/v1/api-group-group/#api-wiki-rest-api-group-groupid-membersbygroupid-get.
+		// Connection entity for Groups <-> Users. The members are the users of the group.
+		GroupMember: {
+			uniqueIDAttrExternalID: "id",
+			maxPageSize:            200,
+			v1:                     true,
+			memberOf: func() *string {
+				s := Group
+
+				return &s
+			}(),
+			collectionIDAttrExternalID: "id",
+			setMemberAttributes: func(groupID string, user map[string]any) {
+				accountID, _ := user["accountId"].(string)
+
+				user["id"] = groupID + "-" + accountID
+				user["groupId"] = groupID
+			},
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	// [MemberEntities] The members are listed one space or group at a time, so set the `CollectionID` to the ID of
+	// the current space or group, and the `CollectionCursor` to the link to the next one.
+	if entity.memberOf != nil {
+		collectionReq := &Request{
+			BaseURL:               request.BaseURL,
+			AuthorizationHeader:   request.AuthorizationHeader,
+			PageSize:              1,
+			EntityExternalID:      *entity.memberOf,
+			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		}
+
+		if request.Cursor != nil && request.Cursor.CollectionCursor != nil {
+			collectionReq.Cursor = &pagination.CompositeCursor[string]{
+				Cursor: request.Cursor.CollectionCursor,
+			}
+		}
+
+		if request.Cursor == nil {
+			request.Cursor = &pagination.CompositeCursor[string]{}
+		}
+
+		isEmptyLastPage, cursorErr := pagination.UpdateNextCursorFromCollectionAPI(
+			ctx,
+			request.Cursor,
+			func(ctx context.Context, collectionReq *Request) (
+				int, string, []map[string]any, *pagination.CompositeCursor[string], *framework.Error,
+			) {
+				resp, err := d.GetPage(ctx, collectionReq)
+				if err != nil {
+					return 0, "", nil, nil, err
+				}
+
+				return resp.StatusCode, resp.RetryAfterHeader, resp.Objects, resp.NextCursor, nil
+			},
+			collectionReq,
+			entity.collectionIDAttrExternalID,
+		)
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		if isEmptyLastPage {
+			return &Response{
+				StatusCode: http.StatusOK,
+			}, nil
+		}
+	}
+
+	validationErr := pagination.ValidateCompositeCursor(
+		request.Cursor,
+		request.EntityExternalID,
+		// Send a bool indicating if the entity is a member of a collection.
+		entity.memberOf != nil,
+	)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects  []map[string]any
+		nextLink *string
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpoint,
+		Header: http.Header{
+			"Authorization": {request.AuthorizationHeader},
+			"Accept":        {"application/json"},
+		},
+		DatasourceName:        "Confluence",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Confluence")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, nextLink, parseErr = ParseResponse(bodyBytes, request.EntityExternalID)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	if nextLink != nil {
+		response.NextCursor = &pagination.CompositeCursor[string]{
+			Cursor: nextLink,
+		}
+	}
+
+	// [MemberEntities] Set `id` and the attribute referencing the collection, and the cursor of the next page of
+	// members, or of the next collection.
+	if entity.memberOf != nil {
+		collectionID := *request.Cursor.CollectionID
+
+		for _, member := range objects {
+			entity.setMemberAttributes(collectionID, member)
+		}
+
+		request.Cursor.Cursor = nextLink
+		response.NextCursor = nil
+
+		if request.Cursor.Cursor != nil || request.Cursor.CollectionCursor != nil {
+			response.NextCursor = request.Cursor
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the objects of the entity, and the link to the next page relative to the site, from a
+// paginated Confluence Cloud REST API response.
+func ParseResponse(body []byte, entityExternalID string) (
+	objects []map[string]any,
+	nextLink *string,
+	err *framework.Error,
+) {
+	var data DatasourceResponse
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	entity := ValidEntityExternalIDs[entityExternalID]
+
+	objects = data.Results
+
+	// [Users] Unwrap the users from the search results.
+	if entity.objectMember != "" {
+		objects = make([]map[string]any, 0, len(data.Results))
+
+		for _, result := range data.Results {
+			if object, ok := result[entity.objectMember].(map[string]any); ok {
+				objects = append(objects, object)
+			}
+		}
+	}
+
+	if data.Links != nil && data.Links.Next != "" {
+		link := data.Links.Next
+
+		if entity.v1 {
+			link = contextPath + link
+		}
+
+		nextLink = &link
+	}
+
+	return objects, nextLink, nil
+}
@@ -0,0 +1,359 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package confluence_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/confluence"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock Confluence Cloud server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer cf-test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code": 401, "message": "Unauthorized; scope does not match"}`))
+
+		return
+	}
+
+	switch r.URL.RequestURI() {
+	// Spaces Page 1
+	case "/wiki/api/v2/spaces?limit=1":
+		w.Write([]byte(`{"results": [
+			{"id": "101", "key": "ENG", "name": "Engineering", "type": "global", "status": "current", "createdAt": "2020-01-02T03:04:05.123Z"}
+		], "_links": {"next": "/wiki/api/v2/spaces?limit=1&cursor=c2", "base": "https://acme.atlassian.net/wiki"}}`))
+
+	// Spaces Page 2
+	case "/wiki/api/v2/spaces?limit=1&cursor=c2":
+		w.Write([]byte(`{"results": [
+			{"id": "102", "key": "HR", "name": "Human Resources", "type": "global", "status": "current", "createdAt": "2021-01-02T03:04:05.123Z"}
+		], "_links": {"base": "https://acme.atlassian.net/wiki"}}`))
+
+	// Space Permissions of 101 Page 1
+	case "/wiki/api/v2/spaces/101/permissions?limit=2":
+		w.Write([]byte(`{"results": [
+			{"id": "p1", "principal": {"type": "user", "id": "acc-1"}, "operation": {"key": "read", "targetType": "space"}},
+			{"id": "p2", "principal": {"type": "group", "id": "g-1"}, "operation": {"key": "administer", "targetType": "space"}}
+		], "_links": {"next": "/wiki/api/v2/spaces/101/permissions?limit=2&cursor=p3"}}`))
+
+	// Space Permissions of 101 Page 2
+	case "/wiki/api/v2/spaces/101/permissions?limit=2&cursor=p3":
+		w.Write([]byte(`{"results": [
+			{"id": "p3", "principal": {"type": "user", "id": "acc-2"}, "operation": {"key": "create", "targetType": "page"}}
+		], "_links": {}}`))
+
+	// Space Permissions of 102
+	case "/wiki/api/v2/spaces/102/permissions?limit=2":
+		w.Write([]byte(`{"results": [
+			{"id": "p1", "principal": {"type": "user", "id": "acc-1"}, "operation": {"key": "read", "targetType": "space"}}
+		], "_links": {}}`))
+
+	// Pages
+	case "/wiki/api/v2/pages?limit=2&status=current&status=archived":
+		w.Write([]byte(`{"results": [
+			{"id": "9001", "status": "current", "title": "Onboarding", "spaceId": "101", "authorId": "acc-1", "createdAt": "2022-01-02T03:04:05.123Z"},
+			{"id": "9002", "status": "archived", "title": "Q1 Planning", "spaceId": "101", "authorId": "acc-2", "createdAt": "2022-02-02T03:04:05.123Z"}
+		], "_links": {}}`))
+
+	// Users Page 1
+	case "/wiki/rest/api/search/user?cql=type%3Duser&limit=2":
+		w.Write([]byte(`{"results": [
+			{"user": {"type": "known", "accountId": "acc-1", "accountType": "atlassian", "email": "alice@acme.com", "displayName": "Alice"}, "entityType": "user"},
+			{"user": {"type": "known", "accountId": "acc-2", "accountType": "atlassian", "email": "bob@acme.com", "displayName": "Bob"}, "entityType": "user"}
+		], "start": 0, "limit": 2, "size": 2, "_links": {"next": "/rest/api/search/user?cql=type%3Duser&limit=2&start=2", "context": "/wiki"}}`))
+
+	// Groups Page 1
+	case "/wiki/rest/api/group?limit=1":
+		w.Write([]byte(`{"results": [
+			{"type": "group", "name": "confluence-users", "id": "g-1"}
+		], "start": 0, "limit": 1, "size": 1, "_links": {"next": "/rest/api/group?limit=1&start=1", "context": "/wiki"}}`))
+
+	// Groups Page 2
+	case "/wiki/rest/api/group?limit=1&start=1":
+		w.Write([]byte(`{"results": [
+			{"type": "group", "name": "confluence-admins", "id": "g-2"}
+		], "start": 1, "limit": 1, "size": 1, "_links": {"context": "/wiki"}}`))
+
+	// Group Members of g-1
+	case "/wiki/rest/api/group/g-1/membersByGroupId?limit=2":
+		w.Write([]byte(`{"results": [
+			{"type": "known", "accountId": "acc-1", "displayName": "Alice"},
+			{"type": "known", "accountId": "acc-2", "displayName": "Bob"}
+		], "start": 0, "limit": 2, "size": 2, "_links": {"next": "/rest/api/group/g-1/membersByGroupId?limit=2&start=2", "context": "/wiki"}}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body             []byte
+		entityExternalID string
+		wantObjects      []map[string]any
+		wantNextLink     *string
+		wantErr          *framework.Error
+	}{
+		"v2_first_page": {
+			body:             []byte(`{"results": [{"id": "101"}], "_links": {"next": "/wiki/api/v2/spaces?cursor=c2"}}`),
+			entityExternalID: confluence.Space,
+			wantObjects:      []map[string]any{{"id": "101"}},
+			wantNextLink:     testutil.GenPtr("/wiki/api/v2/spaces?cursor=c2"),
+		},
+		"v2_last_page": {
+			body:             []byte(`{"results": [{"id": "102"}], "_links": {}}`),
+			entityExternalID: confluence.Space,
+			wantObjects:      []map[string]any{{"id": "102"}},
+		},
+		"v1_first_page": {
+			body:             []byte(`{"results": [{"id": "g-1"}], "_links": {"next": "/rest/api/group?limit=1&start=1", "context": "/wiki"}}`),
+			entityExternalID: confluence.Group,
+			wantObjects:      []map[string]any{{"id": "g-1"}},
+			wantNextLink:     testutil.GenPtr("/wiki/rest/api/group?limit=1&start=1"),
+		},
+		"v1_wrapped_users": {
+			body:             []byte(`{"results": [{"user": {"accountId": "acc-1"}, "entityType": "user"}, {"entityType": "user"}]}`),
+			entityExternalID: confluence.User,
+			wantObjects:      []map[string]any{{"accountId": "acc-1"}},
+		},
+		"invalid_response": {
+			body:             []byte(`[]`),
+			entityExternalID: confluence.Space,
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type confluence.DatasourceResponse.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextLink, gotErr := confluence.ParseResponse(tt.body, tt.entityExternalID)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextLink, tt.wantNextLink) {
+				t.Errorf("gotNextLink: %v, wantNextLink: %v", gotNextLink, tt.wantNextLink)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := confluence.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *confluence.Request
+		wantResponse *confluence.Response
+		wantErr      *framework.Error
+	}{
+		"spaces_first_page": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-test",
+				PageSize:            1,
+				EntityExternalID:    confluence.Space,
+			},
+			wantResponse: &confluence.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "101", "key": "ENG", "name": "Engineering", "type": "global", "status": "current", "createdAt": "2020-01-02T03:04:05.123Z"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("/wiki/api/v2/spaces?limit=1&cursor=c2"),
+				},
+			},
+		},
+		"spaces_last_page": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-test",
+				PageSize:            1,
+				EntityExternalID:    confluence.Space,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("/wiki/api/v2/spaces?limit=1&cursor=c2"),
+				},
+			},
+			wantResponse: &confluence.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "102", "key": "HR", "name": "Human Resources", "type": "global", "status": "current", "createdAt": "2021-01-02T03:04:05.123Z"},
+				},
+			},
+		},
+		"spaces_cursor_on_another_host": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-test",
+				PageSize:            1,
+				EntityExternalID:    confluence.Space,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(".attacker.example.com/wiki/api/v2/spaces?cursor=c2"),
+				},
+			},
+			wantErr: pagination.NewCursorError(
+				confluence.Space,
+				pagination.CompositeCursorShape[string](false),
+				"cursor link is not a path of /wiki",
+			),
+		},
+		"space_permissions_first_page": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-test",
+				PageSize:            2,
+				EntityExternalID:    confluence.SpacePermission,
+			},
+			wantResponse: &confluence.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "101-p1", "permissionId": "p1", "spaceId": "101", "principal": map[string]any{"type": "user", "id": "acc-1"}, "operation": map[string]any{"key": "read", "targetType": "space"}},
+					{"id": "101-p2", "permissionId": "p2", "spaceId": "101", "principal": map[string]any{"type": "group", "id": "g-1"}, "operation": map[string]any{"key": "administer", "targetType": "space"}},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr("/wiki/api/v2/spaces/101/permissions?limit=2&cursor=p3"),
+					CollectionID:     testutil.GenPtr("101"),
+					CollectionCursor: testutil.GenPtr("/wiki/api/v2/spaces?limit=1&cursor=c2"),
+				},
+			},
+		},
+		"space_permissions_last_page_of_space": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-test",
+				PageSize:            2,
+				EntityExternalID:    confluence.SpacePermission,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr("/wiki/api/v2/spaces/101/permissions?limit=2&cursor=p3"),
+					CollectionID:     testutil.GenPtr("101"),
+					CollectionCursor: testutil.GenPtr("/wiki/api/v2/spaces?limit=1&cursor=c2"),
+				},
+			},
+			wantResponse: &confluence.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "101-p3", "permissionId": "p3", "spaceId": "101", "principal": map[string]any{"type": "user", "id": "acc-2"}, "operation": map[string]any{"key": "create", "targetType": "page"}},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("101"),
+					CollectionCursor: testutil.GenPtr("/wiki/api/v2/spaces?limit=1&cursor=c2"),
+				},
+			},
+		},
+		"space_permissions_last_space": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-test",
+				PageSize:            2,
+				EntityExternalID:    confluence.SpacePermission,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("101"),
+					CollectionCursor: testutil.GenPtr("/wiki/api/v2/spaces?limit=1&cursor=c2"),
+				},
+			},
+			wantResponse: &confluence.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "102-p1", "permissionId": "p1", "spaceId": "102", "principal": map[string]any{"type": "user", "id": "acc-1"}, "operation": map[string]any{"key": "read", "targetType": "space"}},
+				},
+			},
+		},
+		"pages": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-test",
+				PageSize:            2,
+				EntityExternalID:    confluence.Page,
+				PageStatuses:        []string{"current", "archived"},
+			},
+			wantResponse: &confluence.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "9001", "status": "current", "title": "Onboarding", "spaceId": "101", "authorId": "acc-1", "createdAt": "2022-01-02T03:04:05.123Z"},
+					{"id": "9002", "status": "archived", "title": "Q1 Planning", "spaceId": "101", "authorId": "acc-2", "createdAt": "2022-02-02T03:04:05.123Z"},
+				},
+			},
+		},
+		"users_first_page": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-test",
+				PageSize:            2,
+				EntityExternalID:    confluence.User,
+			},
+			wantResponse: &confluence.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"type": "known", "accountId": "acc-1", "accountType": "atlassian", "email": "alice@acme.com", "displayName": "Alice"},
+					{"type": "known", "accountId": "acc-2", "accountType": "atlassian", "email": "bob@acme.com", "displayName": "Bob"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("/wiki/rest/api/search/user?cql=type%3Duser&limit=2&start=2"),
+				},
+			},
+		},
+		"group_members_first_page": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-test",
+				PageSize:            2,
+				EntityExternalID:    confluence.GroupMember,
+			},
+			wantResponse: &confluence.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "g-1-acc-1", "groupId": "g-1", "type": "known", "accountId": "acc-1", "displayName": "Alice"},
+					{"id": "g-1-acc-2", "groupId": "g-1", "type": "known", "accountId": "acc-2", "displayName": "Bob"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr("/wiki/rest/api/group/g-1/membersByGroupId?limit=2&start=2"),
+					CollectionID:     testutil.GenPtr("g-1"),
+					CollectionCursor: testutil.GenPtr("/wiki/rest/api/group?limit=1&start=1"),
+				},
+			},
+		},
+		"unauthorized": {
+			request: &confluence.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer cf-invalid",
+				PageSize:            1,
+				EntityExternalID:    confluence.Group,
+			},
+			wantResponse: &confluence.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
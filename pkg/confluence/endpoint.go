@@ -0,0 +1,89 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package confluence
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// The endpoint of the next pages is the "next" link returned by Confluence, which is stored in the cursor.
+// For example, the endpoint of the first page of permissions of a space is:
+// https://acme.atlassian.net/wiki/api/v2/spaces/123456/permissions?limit=250.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	// [All Entities] This is the link to the next page of objects, relative to the site.
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		// The link is only followed on the queried site, so that the credentials are never sent to another host.
+		if !strings.HasPrefix(*request.Cursor.Cursor, contextPath+"/") {
+			return "", pagination.NewCursorError(
+				request.EntityExternalID,
+				pagination.CompositeCursorShape[string](request.Cursor.CollectionID != nil),
+				fmt.Sprintf("cursor link is not a path of %s", contextPath),
+			)
+		}
+
+		return request.BaseURL + *request.Cursor.Cursor, nil
+	}
+
+	if entity.memberOf != nil && (request.Cursor == nil || request.Cursor.CollectionID == nil) {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Unable to construct the %s endpoint without a collection ID.", request.EntityExternalID),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	// Each endpoint accepts a limit up to its own maximum, so cap the page size.
+	pageSize := min(request.PageSize, entity.maxPageSize)
+
+	var (
+		path   string
+		params = url.Values{}
+	)
+
+	params.Set("limit", strconv.FormatInt(pageSize, 10))
+
+	switch request.EntityExternalID {
+	case Space:
+		path = "/api/v2/spaces"
+	case SpacePermission:
+		path = "/api/v2/spaces/" + url.PathEscape(*request.Cursor.CollectionID) + "/permissions"
+	case Page:
+		path = "/api/v2/pages"
+
+		for _, status := range request.PageStatuses {
+			params.Add("status", status)
+		}
+	case User:
+		path = "/rest/api/search/user"
+
+		params.Set("cql", "type=user")
+	case Group:
+		path = "/rest/api/group"
+	case GroupMember:
+		path = "/rest/api/group/" + url.PathEscape(*request.Cursor.CollectionID) + "/membersByGroupId"
+	}
+
+	return request.BaseURL + contextPath + path + "?" + params.Encode(), nil
+}
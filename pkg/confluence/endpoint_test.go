@@ -0,0 +1,148 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package confluence_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/confluence"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *confluence.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &confluence.Request{
+				BaseURL:          "https://acme.atlassian.net",
+				PageSize:         100,
+				EntityExternalID: "BlogPost",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"spaces": {
+			request: &confluence.Request{
+				BaseURL:          "https://acme.atlassian.net",
+				PageSize:         100,
+				EntityExternalID: confluence.Space,
+			},
+			wantEndpoint: "https://acme.atlassian.net/wiki/api/v2/spaces?limit=100",
+		},
+		"spaces_next_page": {
+			request: &confluence.Request{
+				BaseURL:          "https://acme.atlassian.net",
+				PageSize:         100,
+				EntityExternalID: confluence.Space,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("/wiki/api/v2/spaces?limit=100&cursor=c2"),
+				},
+			},
+			wantEndpoint: "https://acme.atlassian.net/wiki/api/v2/spaces?limit=100&cursor=c2",
+		},
+		"spaces_next_page_on_another_host": {
+			request: &confluence.Request{
+				BaseURL:          "https://acme.atlassian.net",
+				PageSize:         100,
+				EntityExternalID: confluence.Space,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(".attacker.com/wiki/api/v2/spaces?cursor=c2"),
+				},
+			},
+			wantErr: pagination.NewCursorError(
+				confluence.Space,
+				pagination.CompositeCursorShape[string](false),
+				"cursor link is not a path of /wiki",
+			),
+		},
+		"space_permissions": {
+			request: &confluence.Request{
+				BaseURL:          "https://acme.atlassian.net",
+				PageSize:         100,
+				EntityExternalID: confluence.SpacePermission,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("101"),
+				},
+			},
+			wantEndpoint: "https://acme.atlassian.net/wiki/api/v2/spaces/101/permissions?limit=100",
+		},
+		"space_permissions_without_collection_id": {
+			request: &confluence.Request{
+				BaseURL:          "https://acme.atlassian.net",
+				PageSize:         100,
+				EntityExternalID: confluence.SpacePermission,
+			},
+			wantErr: &framework.Error{
+				Message: "Unable to construct the SpacePermission endpoint without a collection ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"pages": {
+			request: &confluence.Request{
+				BaseURL:          "https://acme.atlassian.net",
+				PageSize:         250,
+				EntityExternalID: confluence.Page,
+				PageStatuses:     []string{"current", "trashed"},
+			},
+			wantEndpoint: "https://acme.atlassian.net/wiki/api/v2/pages?limit=250&status=current&status=trashed",
+		},
+		"users_capped_page_size": {
+			request: &confluence.Request{
+				BaseURL:          "https://acme.atlassian.net",
+				PageSize:         250,
+				EntityExternalID: confluence.User,
+			},
+			wantEndpoint: "https://acme.atlassian.net/wiki/rest/api/search/user?cql=type%3Duser&limit=200",
+		},
+		"groups": {
+			request: &confluence.Request{
+				BaseURL:          "https://api.atlassian.com/ex/confluence/cloud-id",
+				PageSize:         100,
+				EntityExternalID: confluence.Group,
+			},
+			wantEndpoint: "https://api.atlassian.com/ex/confluence/cloud-id/wiki/rest/api/group?limit=100",
+		},
+		"group_members": {
+			request: &confluence.Request{
+				BaseURL:          "https://acme.atlassian.net",
+				PageSize:         100,
+				EntityExternalID: confluence.GroupMember,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("g-1"),
+				},
+			},
+			wantEndpoint: "https://acme.atlassian.net/wiki/rest/api/group/g-1/membersByGroupId?limit=100",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := confluence.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package confluence_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/confluence"
+)
+
+// FuzzParseResponse checks that malformed Confluence responses are rejected with an error instead of a panic, for all
+// the entities.
+func FuzzParseResponse(f *testing.F) {
+	entityExternalIDs := make([]string, 0, len(confluence.ValidEntityExternalIDs))
+	for entityExternalID := range confluence.ValidEntityExternalIDs {
+		entityExternalIDs = append(entityExternalIDs, entityExternalID)
+	}
+
+	slices.Sort(entityExternalIDs)
+
+	for _, seed := range []string{
+		`{"results":[{"id":"229382","key":"SGNL"}],"_links":{"next":"/wiki/api/v2/spaces?cursor=ZXlKcFpDSTZNVEV6TnpVd05qWjk"}}`,
+		`{"results":[{"accountId":"5c5ab5b5b5b5b5b5b5b5b5b5"}],"_links":{}}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), uint8(0))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, entityIndex uint8) {
+		entityExternalID := entityExternalIDs[int(entityIndex)%len(entityExternalIDs)]
+
+		_, _, err := confluence.ParseResponse(body, entityExternalID)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for entity %s and body %q", entityExternalID, body)
+		}
+	})
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package confluence_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/confluence"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: confluence.Space,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "key",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[confluence.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request_bearer_token": {
+			request: &framework.Request[confluence.Config]{
+				Address: "acme.atlassian.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity: validEntity,
+				Config: &confluence.Config{
+					PageStatuses: []string{"current", "archived"},
+				},
+				PageSize: 100,
+			},
+			wantAddress: "https://acme.atlassian.net",
+		},
+		"valid_request_basic_auth_nil_config": {
+			request: &framework.Request[confluence.Config]{
+				Address: "https://acme.atlassian.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "alice@acme.com",
+						Password: "api-token",
+					},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantAddress: "https://acme.atlassian.net",
+		},
+		"invalid_request_unsupported_page_status": {
+			request: &framework.Request[confluence.Config]{
+				Address: "https://acme.atlassian.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity: validEntity,
+				Config: &confluence.Config{
+					PageStatuses: []string{"current", "draft"},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Confluence config is invalid: pageStatuses contains an unsupported status: draft.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[confluence.Config]{
+				Address: "http://acme.atlassian.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[confluence.Config]{
+				Address:  "https://acme.atlassian.net",
+				Auth:     &framework.DatasourceAuthCredentials{},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Request to Confluence is missing Basic Auth or Bearer token credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[confluence.Config]{
+				Address: "https://acme.atlassian.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "cf-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[confluence.Config]{
+				Address: "https://acme.atlassian.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "BlogPost",
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[confluence.Config]{
+				Address: "https://acme.atlassian.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: confluence.GroupMember,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "accountId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[confluence.Config]{
+				Address: "https://acme.atlassian.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity:   validEntity,
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[confluence.Config]{
+				Address: "https://acme.atlassian.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer cf-test",
+				},
+				Entity:   validEntity,
+				PageSize: 251,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (251) exceeds the maximum allowed (250).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &confluence.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -55,7 +56,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	req := &Request{
 		BaseURL:               request.Address,
 		Token:                 request.Auth.HTTPAuthorization,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		EntityConfig:          &request.Entity,
 		Ordered:               request.Ordered,
@@ -65,7 +66,7 @@ func (a *Adapter) RequestPageFromDatasource(
 
 	// Unmarshal the current cursor.
 	if isGraphQLEntity {
-		graphQLCursor, unmarshalErr := pagination.UnmarshalCursor[string](request.Cursor)
+		graphQLCursor, unmarshalErr := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
 		if unmarshalErr != nil {
 			return framework.NewGetPageResponseError(unmarshalErr)
 		}
@@ -74,7 +75,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	}
 
 	if isRESTEntity {
-		restCursor, unmarshalErr := pagination.UnmarshalCursor[string](request.Cursor)
+		restCursor, unmarshalErr := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
 		if unmarshalErr != nil {
 			return framework.NewGetPageResponseError(unmarshalErr)
 		}
@@ -99,6 +100,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
@@ -620,6 +620,156 @@ func TestAdapterIncidentGetPage(t *testing.T) {
 	}
 }
 
+func TestAdapterIdentityDetectionGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestGraphQLServerHandler)
+	adapter := crowdstrike_adapter.NewAdapter(&crowdstrike_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	tests := map[string]struct {
+		request            *framework.Request[crowdstrike_adapter.Config]
+		inputRequestCursor *pagination.CompositeCursor[string]
+		wantResponse       framework.Response
+		wantCursor         *pagination.CompositeCursor[string]
+	}{
+		"first_page": {
+			request: &framework.Request[crowdstrike_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer Testtoken",
+				},
+				Config: &crowdstrike_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity:   *PopulateIdentityDetectionEntityConfig(),
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"eventId":   string("kq81zt"),
+							"alertType": string("PasswordSprayAlert"),
+							"resolved":  false,
+							"timestamp": time.Date(2024, 9, 23, 13, 0, 21, 0, time.UTC),
+
+							// Child Objects
+							`$.entities`: []framework.Object{
+								{
+									"entityId":  string("3c7aebb9-411b-4ee9-b481-e881f29afcc8"),
+									"riskScore": float64(0.46),
+								},
+							},
+						},
+						{
+							"eventId":   string("jx2m9a"),
+							"alertType": string("CredentialScanningAlert"),
+							"resolved":  true,
+							"timestamp": time.Date(2024, 9, 20, 1, 49, 27, 0, time.UTC),
+
+							// Child Objects
+							`$.entities`: []framework.Object{
+								{
+									"entityId":  string("60ee5bb1-805f-46d2-8f3a-9d7cadc52909"),
+									"riskScore": float64(0.64),
+								},
+							},
+						},
+					},
+					NextCursor: "eyJjdXJzb3IiOiJleUowYVcxbGMzUmhiWEFpT2lJeU1ESTBMVEE1TFRJd1ZEQXhPalE1T2pJM0xqQXdNRm9pTENKZmFXUWlPaUpsWldJNFpUSm1NaTAzWVRCbExUUmhOVFV0T0dVMllpMHdZak5pTkdRMVlqVmpNbUVpZlE9PSJ9",
+				},
+			},
+			wantCursor: &pagination.CompositeCursor[string]{
+				Cursor: testutil.GenPtr("eyJ0aW1lc3RhbXAiOiIyMDI0LTA5LTIwVDAxOjQ5OjI3LjAwMFoiLCJfaWQiOiJlZWI4ZTJmMi03YTBlLTRhNTUtOGU2Yi0wYjNiNGQ1YjVjMmEifQ=="),
+			},
+		},
+		"last_page": {
+			request: &framework.Request[crowdstrike_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer Testtoken",
+				},
+				Config: &crowdstrike_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity:   *PopulateIdentityDetectionEntityConfig(),
+				PageSize: 2,
+			},
+			inputRequestCursor: &pagination.CompositeCursor[string]{
+				Cursor: testutil.GenPtr("eyJ0aW1lc3RhbXAiOiIyMDI0LTA5LTIwVDAxOjQ5OjI3LjAwMFoiLCJfaWQiOiJlZWI4ZTJmMi03YTBlLTRhNTUtOGU2Yi0wYjNiNGQ1YjVjMmEifQ=="),
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"eventId":   string("jpppv6"),
+							"alertType": string("LdapReconnaissanceAlert"),
+							"resolved":  false,
+							"timestamp": time.Date(2024, 9, 4, 2, 23, 59, 999000000, time.UTC),
+
+							// Child Objects
+							`$.entities`: []framework.Object{
+								{
+									"entityId":  string("83a49ef1-17a7-4fa4-b90f-9142dfa49577"),
+									"riskScore": float64(0.64),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tt.inputRequestCursor != nil {
+				encodedCursor, err := pagination.MarshalCursor(tt.inputRequestCursor)
+				if err != nil {
+					t.Error(err)
+				}
+
+				tt.request.Cursor = encodedCursor
+			}
+
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+			if tt.wantResponse.Success != nil && gotResponse.Success != nil {
+				if diff := cmp.Diff(tt.wantResponse.Success.Objects, gotResponse.Success.Objects); diff != "" {
+					t.Errorf("Response mismatch (-want +got):\n%s", diff)
+				}
+
+				if gotResponse.Success.NextCursor != tt.wantResponse.Success.NextCursor {
+					t.Errorf("gotNextCursor: %v, wantNextCursor: %v",
+						gotResponse.Success.NextCursor, tt.wantResponse.Success.NextCursor)
+				}
+			} else if tt.wantResponse.Success != nil || gotResponse.Success != nil {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotResponse.Error, tt.wantResponse.Error) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse.Error, tt.wantResponse.Error)
+			}
+
+			if gotResponse.Success != nil && tt.wantCursor != nil {
+				var gotCursor pagination.CompositeCursor[string]
+
+				decodedCursor, err := base64.StdEncoding.DecodeString(gotResponse.Success.NextCursor)
+				if err != nil {
+					t.Errorf("error decoding cursor: %v", err)
+				}
+
+				if err := json.Unmarshal(decodedCursor, &gotCursor); err != nil {
+					t.Errorf("error unmarshalling cursor: %v", err)
+				}
+
+				if !reflect.DeepEqual(*tt.wantCursor, gotCursor) {
+					t.Errorf("gotCursor: %v, wantCursor: %v", gotCursor, tt.wantCursor)
+				}
+			}
+		})
+	}
+}
+
 func TestAdapterAlertGetPage(t *testing.T) {
 	server := httptest.NewTLSServer(TestRESTServerHandler)
 	adapter := crowdstrike_adapter.NewAdapter(&crowdstrike_adapter.Datasource{
@@ -883,7 +1033,7 @@ func TestAdapterAlertGetPage(t *testing.T) {
 
 				// Check cursor comparison
 				if tt.wantCursor != nil && gotResponse.Success.NextCursor != "" {
-					gotCursor, err := pagination.UnmarshalCursor[string](gotResponse.Success.NextCursor)
+					gotCursor, err := pagination.UnmarshalCursor[string](gotResponse.Success.NextCursor, tt.request.Entity.ExternalId)
 					if err != nil {
 						t.Fatalf("error unmarshalling cursor: %v", err)
 					}
@@ -904,6 +1054,113 @@ func TestAdapterAlertGetPage(t *testing.T) {
 	}
 }
 
+func TestAdapterFalconUserManagementGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestRESTServerHandler)
+	defer server.Close()
+
+	adapter := crowdstrike_adapter.NewAdapter(&crowdstrike_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	tests := map[string]struct {
+		entity       *framework.EntityConfig
+		cursor       *pagination.CompositeCursor[string]
+		wantResponse framework.Response
+		wantCursor   *pagination.CompositeCursor[string]
+	}{
+		"users_first_page": {
+			entity: PopulateFalconUserEntityConfig(),
+			wantResponse: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"uuid": "f4ad5d2a-6b8e-4a6c-8d0e-2c4b1f8e7a31", "uid": "john@example.com", "status": "active"},
+					{"uuid": "0b9e1c3d-2f4a-4b6c-9d8e-7f6a5b4c3d21", "uid": "jane@example.com", "status": "inactive"},
+				},
+			}),
+			wantCursor: &pagination.CompositeCursor[string]{
+				Cursor: testutil.GenPtr("2"),
+			},
+		},
+		"users_last_page": {
+			entity: PopulateFalconUserEntityConfig(),
+			cursor: &pagination.CompositeCursor[string]{
+				Cursor: testutil.GenPtr("2"),
+			},
+			wantResponse: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"uuid": "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c65", "uid": "jim@example.com", "status": "active"},
+				},
+			}),
+		},
+		"roles": {
+			entity: PopulateFalconRoleEntityConfig(),
+			wantResponse: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "falconhost_admin", "display_name": "Falcon Administrator"},
+					{"id": "falconhost_read_only", "display_name": "Falcon Read Only"},
+				},
+			}),
+		},
+		"role_assignments": {
+			entity: PopulateFalconRoleAssignmentEntityConfig(),
+			wantResponse: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{
+						"id":      "f4ad5d2a-6b8e-4a6c-8d0e-2c4b1f8e7a31-c1a2b3d4e5f60718293a4b5c6d7e8f90-falconhost_admin",
+						"uuid":    "f4ad5d2a-6b8e-4a6c-8d0e-2c4b1f8e7a31",
+						"role_id": "falconhost_admin",
+					},
+					{
+						"id":         "0b9e1c3d-2f4a-4b6c-9d8e-7f6a5b4c3d21-c1a2b3d4e5f60718293a4b5c6d7e8f90-falconhost_read_only",
+						"uuid":       "0b9e1c3d-2f4a-4b6c-9d8e-7f6a5b4c3d21",
+						"role_id":    "falconhost_read_only",
+						"expires_at": time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC),
+					},
+				},
+			}),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := &framework.Request[crowdstrike_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer Testtoken",
+				},
+				Config: &crowdstrike_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity:   *tt.entity,
+				PageSize: 2,
+			}
+
+			if tt.cursor != nil {
+				encodedCursor, err := pagination.MarshalCursor(tt.cursor)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				request.Cursor = encodedCursor
+			}
+
+			if tt.wantCursor != nil {
+				encodedCursor, err := pagination.MarshalCursor(tt.wantCursor)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				tt.wantResponse.Success.NextCursor = encodedCursor
+			}
+
+			gotResponse := adapter.GetPage(context.Background(), request)
+
+			if diff := cmp.Diff(tt.wantResponse, gotResponse); diff != "" {
+				t.Errorf("Response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestAdapterEndpointIncidentEmptyList(t *testing.T) {
 	server := httptest.NewTLSServer(TestRESTServerHandler)
 	defer server.Close()
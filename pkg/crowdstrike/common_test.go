@@ -64,6 +64,8 @@ func PopulateDefaultRequest(externalID string, pageSize int64, cursor *string) *
 		req.EntityConfig = PopulateEndpointEntityConfig()
 	case crowdstrike.Incident:
 		req.EntityConfig = PopulateIncidentEntityConfig()
+	case crowdstrike.IdentityDetection:
+		req.EntityConfig = PopulateIdentityDetectionEntityConfig()
 	default:
 		return nil
 	}
@@ -197,6 +199,53 @@ func PopulateIncidentEntityConfig() *framework.EntityConfig {
 	}
 }
 
+func PopulateIdentityDetectionEntityConfig() *framework.EntityConfig {
+	return &framework.EntityConfig{
+		ExternalId: crowdstrike.IdentityDetection,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "eventId",
+				Type:       framework.AttributeTypeString,
+				List:       false,
+				UniqueId:   true,
+			},
+			{
+				ExternalId: "alertType",
+				Type:       framework.AttributeTypeString,
+				List:       false,
+			},
+			{
+				ExternalId: "resolved",
+				Type:       framework.AttributeTypeBool,
+				List:       false,
+			},
+			{
+				ExternalId: "timestamp",
+				Type:       framework.AttributeTypeDateTime,
+				List:       false,
+			},
+		},
+		ChildEntities: []*framework.EntityConfig{
+			{
+				ExternalId: "$.entities",
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "entityId",
+						Type:       framework.AttributeTypeString,
+						List:       false,
+						UniqueId:   true,
+					},
+					{
+						ExternalId: "riskScore",
+						Type:       framework.AttributeTypeDouble,
+						List:       false,
+					},
+				},
+			},
+		},
+	}
+}
+
 func PopulateEndpointIncidentEntityConfig() *framework.EntityConfig {
 	return &framework.EntityConfig{
 		ExternalId: crowdstrike.EndpointIncident,
@@ -221,6 +270,69 @@ func PopulateEndpointIncidentEntityConfig() *framework.EntityConfig {
 	}
 }
 
+func PopulateFalconUserEntityConfig() *framework.EntityConfig {
+	return &framework.EntityConfig{
+		ExternalId: crowdstrike.FalconUser,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "uuid",
+				Type:       framework.AttributeTypeString,
+				UniqueId:   true,
+			},
+			{
+				ExternalId: "uid",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "status",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+}
+
+func PopulateFalconRoleEntityConfig() *framework.EntityConfig {
+	return &framework.EntityConfig{
+		ExternalId: crowdstrike.FalconRole,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+				UniqueId:   true,
+			},
+			{
+				ExternalId: "display_name",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+}
+
+func PopulateFalconRoleAssignmentEntityConfig() *framework.EntityConfig {
+	return &framework.EntityConfig{
+		ExternalId: crowdstrike.FalconRoleAssignment,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+				UniqueId:   true,
+			},
+			{
+				ExternalId: "uuid",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "role_id",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "expires_at",
+				Type:       framework.AttributeTypeDateTime,
+			},
+		},
+	}
+}
+
 func PopulateAlertsEntityConfig() *framework.EntityConfig {
 	return &framework.EntityConfig{
 		ExternalId: crowdstrike.Alerts,
@@ -348,6 +460,12 @@ var TestGraphQLServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *h
 		w.Write([]byte(IncidentResponsePage2))
 	case crowdstrike.NormalizeQuery(ValidationQueryBuilder(crowdstrike.Incident, 2, testutil.GenPtr("eyJlbmRUaW1lIjp7IiRkYXRlIjoiMjAyNC0wOS0wOVQxNDoyODowNC4wMDhaIn0sInNlcXVlbmNlSWQiOjEzfQ=="))):
 		w.Write([]byte(IncidentResponsePage3))
+
+	// ****************** Identity Detection Queries ******************
+	case crowdstrike.NormalizeQuery(ValidationQueryBuilder(crowdstrike.IdentityDetection, 2, nil)):
+		w.Write([]byte(IdentityDetectionResponsePage1))
+	case crowdstrike.NormalizeQuery(ValidationQueryBuilder(crowdstrike.IdentityDetection, 2, testutil.GenPtr("eyJ0aW1lc3RhbXAiOiIyMDI0LTA5LTIwVDAxOjQ5OjI3LjAwMFoiLCJfaWQiOiJlZWI4ZTJmMi03YTBlLTRhNTUtOGU2Yi0wYjNiNGQ1YjVjMmEifQ=="))):
+		w.Write([]byte(IdentityDetectionResponsePage2))
 	}
 })
 
@@ -430,6 +548,53 @@ var TestRESTServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http
 			w.WriteHeader(http.StatusMethodNotAllowed)
 		}
 
+	// ************************ Falcon User Management ************************
+	case "/user-management/queries/users/v1?limit=2":
+		w.Write([]byte(FalconUserListFirstPageResponse))
+
+	case "/user-management/queries/users/v1?limit=2&offset=2":
+		w.Write([]byte(FalconUserListLastPageResponse))
+
+	case "/user-management/entities/users/GET/v1?limit=2", "/user-management/entities/users/GET/v1?limit=2&offset=2":
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		var reqBody crowdstrike.DetailedResourceRequestBody
+
+		json.Unmarshal(body, &reqBody)
+
+		if len(reqBody.Identifiers) == 1 && reqBody.Identifiers[0] == "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c65" {
+			w.Write([]byte(FalconUserLastPageResponse))
+		} else {
+			w.Write([]byte(FalconUserFirstPageResponse))
+		}
+
+	case "/user-management/queries/roles/v1?limit=2":
+		w.Write([]byte(FalconRoleListResponse))
+
+	case "/user-management/entities/roles/v1?limit=2&ids=falconhost_admin&ids=falconhost_read_only":
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		w.Write([]byte(FalconRoleResponse))
+
+	case "/user-management/combined/user-roles/v1?limit=2":
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		w.Write([]byte(FalconRoleAssignmentResponse))
+
 	default:
 		w.WriteHeader(http.StatusNotFound)
 		w.Write([]byte(``))
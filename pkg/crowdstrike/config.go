@@ -5,6 +5,7 @@ package crowdstrike
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/sgnl-ai/adapters/pkg/config"
 )
@@ -13,12 +14,22 @@ var (
 	SupportedAPIVersions = map[string]struct{}{
 		"v1": {},
 	}
+
+	// RegionHosts maps the CrowdStrike cloud regions to the host of their API. The API clients of a tenant are only
+	// valid in the region of the tenant, and are rejected with a 401 by the API of the other regions.
+	RegionHosts = map[string]string{
+		"us-1":     "api.crowdstrike.com",
+		"us-2":     "api.us-2.crowdstrike.com",
+		"eu-1":     "api.eu-1.crowdstrike.com",
+		"us-gov-1": "api.laggar.gcw.crowdstrike.com",
+	}
 )
 
 // Example Config:
 //
 //	{
 //	   "apiVersion": "v1",
+//	   "region": "us-2",
 //	   "archived": false,
 //	   "enabled": true,
 //	   "filters": {
@@ -32,10 +43,15 @@ type Config struct {
 	// Common configuration
 	*config.CommonConfig
 
-	APIVersion string            `json:"apiVersion,omitempty"`
-	Archived   bool              `json:"archived,omitempty"`
-	Enabled    bool              `json:"enabled,omitempty"`
-	Filters    map[string]string `json:"filters,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Region is the CrowdStrike cloud region of the tenant: "us-1", "us-2", "eu-1" or "us-gov-1". If set, the
+	// address defaults to the API of the region, and the addresses of the API of another region are rejected.
+	Region string `json:"region,omitempty"`
+
+	Archived bool              `json:"archived,omitempty"`
+	Enabled  bool              `json:"enabled,omitempty"`
+	Filters  map[string]string `json:"filters,omitempty"`
 }
 
 // Validate ensures that a Config received in a GetPage call is valid.
@@ -45,6 +61,8 @@ func (c *Config) Validate(_ context.Context) error {
 		return errors.New("The request contains an empty configuration")
 	case c.APIVersion == "":
 		return errors.New("apiVersion is not set in the configuration")
+	case c.Region != "" && RegionHosts[c.Region] == "":
+		return fmt.Errorf("region is not supported: %s", c.Region)
 	case c.APIVersion != "":
 		if _, found := SupportedAPIVersions[c.APIVersion]; !found {
 			return errors.New("apiVersion is not supported")
@@ -20,6 +20,18 @@ const (
 	Device           string = "endpoint_protection_device"
 	EndpointIncident string = "endpoint_protection_incident"
 	Alerts           string = "endpoint_protection_alert"
+
+	// IdentityDetection is a detection raised by Identity Protection, e.g. a suspicious authentication.
+	IdentityDetection string = "identity_detection"
+
+	// FalconUser is a user of the Falcon console, as opposed to the users of the Identity Protection graph.
+	FalconUser string = "falcon_user"
+
+	// FalconRole is a role that can be granted to the users of the Falcon console.
+	FalconRole string = "falcon_role"
+
+	// FalconRoleAssignment is a role granted to a user of the Falcon console in a CID.
+	FalconRoleAssignment string = "falcon_role_assignment"
 )
 
 // Datasource directly implements a Client interface to allow querying
@@ -56,12 +68,19 @@ var (
 			UniqueIDAttrExternalID: "entityId",
 			OrderByAttribute:       "RISK_SCORE",
 		},
+		IdentityDetection: {
+			UniqueIDAttrExternalID: "eventId",
+		},
 	}
 
 	ValidRESTEntityExternalIDs = map[string]Entity{
 		Device:           {},
 		EndpointIncident: {UseIntCursor: true},
 		Alerts:           {},
+
+		FalconUser:           {UseIntCursor: true},
+		FalconRole:           {UseIntCursor: true},
+		FalconRoleAssignment: {UseIntCursor: true},
 	}
 )
 
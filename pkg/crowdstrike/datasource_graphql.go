@@ -28,6 +28,7 @@ type PageInfo struct {
 type DatasourceResponse struct {
 	Entities  ResponseItems `json:"entities"`
 	Incidents ResponseItems `json:"incidents"`
+	Timeline  ResponseItems `json:"timeline"`
 }
 
 type ResponseItems struct {
@@ -109,9 +110,15 @@ func parseGraphQLResponse(
 		}
 	}
 
-	items := data.Entities
-	if entityExternalID == Incident {
+	var items ResponseItems
+
+	switch entityExternalID {
+	case Incident:
 		items = data.Incidents
+	case IdentityDetection:
+		items = data.Timeline
+	default:
+		items = data.Entities
 	}
 
 	if items.PageInfo.HasNextPage {
@@ -9,21 +9,19 @@ package crowdstrike
 // If a REST API has only one endpoint, it is considered as a get endpoint.
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	neturl "net/url"
 	"strconv"
 	"strings"
-	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
-	"go.uber.org/zap"
 
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
-	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
@@ -102,6 +100,8 @@ type AlertsPagination struct {
 var usesListThenGet = map[string]bool{
 	Device:           true,
 	EndpointIncident: true,
+	FalconUser:       true,
+	FalconRole:       true,
 }
 
 // generateRequestBodyBytes creates the request body bytes based on the entity type and request parameters.
@@ -146,7 +146,7 @@ func (d *Datasource) getRESTPage(ctx context.Context, request *Request) (*Respon
 	if httpResp != nil && httpResp.StatusCode != http.StatusOK {
 		return &Response{
 			StatusCode:       httpResp.StatusCode,
-			RetryAfterHeader: httpResp.Header.Get("Retry-After"),
+			RetryAfterHeader: httpResp.RetryAfterHeader,
 		}, nil
 	}
 
@@ -179,81 +179,63 @@ func (d *Datasource) getRESTPage(ctx context.Context, request *Request) (*Respon
 		}
 	}
 
-	// Timeout API calls that take longer than the configured timeout.
-	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
-	defer cancel()
+	method := http.MethodPost
 
-	req, err := http.NewRequestWithContext(apiCtx, http.MethodPost, *url, bytes.NewBuffer(bodyBytes))
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+	// The get endpoints implemented over HTTP GET receive the IDs in the query instead of the body.
+	if endpointInfo.GetMethod == http.MethodGet {
+		method = http.MethodGet
+		bodyBytes = nil
+
+		for _, id := range resourceIDs {
+			*url += "&ids=" + neturl.QueryEscape(id)
 		}
 	}
 
-	req.Header.Add("Authorization", request.Token)
-	req.Header.Set("Content-Type", "application/json")
+	var objects []map[string]any
 
-	logger.Info("Sending request to datasource", fields.RequestURL(*url))
-
-	res, err := d.Client.Do(req)
-	if err != nil {
-		logger.Error("Request to datasource failed",
-			fields.RequestURL(*url),
-			fields.SGNLEventTypeError(),
-			zap.Error(err),
-		)
-
-		return nil, customerror.UpdateError(&framework.Error{
-			Message: fmt.Sprintf("Failed to execute CrowdStrike request: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		Method: method,
+		URL:    *url,
+		Body:   bodyBytes,
+		Header: http.Header{
+			"Authorization": {request.Token},
+			"Content-Type":  {"application/json"},
 		},
-			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
-		)
-	}
+		DatasourceName:        "CrowdStrike",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		responseBytes, readErr := httpds.ReadAll(body, "CrowdStrike")
+		if readErr != nil {
+			return readErr
+		}
 
-	defer res.Body.Close()
+		var parseErr *framework.Error
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to read CrowdStrike response: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		switch request.EntityExternalID {
+		case Alerts:
+			objects, nextCursor, parseErr = parseAlertsResponse(responseBytes)
+		case FalconRoleAssignment:
+			objects, nextCursor, parseErr = parseRoleAssignmentsResponse(responseBytes, request)
+		default:
+			objects, parseErr = parseDetailedResponse(responseBytes)
 		}
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
 	}
 
 	response := &Response{
-		StatusCode:       res.StatusCode,
-		RetryAfterHeader: res.Header.Get("Retry-After"),
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
 	}
 
-	if res.StatusCode != http.StatusOK {
-		logger.Error("Datasource responded with an error",
-			fields.RequestURL(*url),
-			fields.ResponseStatusCode(res.StatusCode),
-			fields.ResponseRetryAfterHeader(response.RetryAfterHeader),
-			fields.ResponseBody(body),
-			fields.SGNLEventTypeError(),
-		)
-
+	if response.StatusCode != http.StatusOK {
 		return response, nil
 	}
 
-	var (
-		objects      []map[string]any
-		frameworkErr *framework.Error
-	)
-
-	if request.EntityExternalID == Alerts {
-		objects, nextCursor, frameworkErr = parseAlertsResponse(body)
-	} else {
-		objects, frameworkErr = parseDetailedResponse(body)
-	}
-
-	if frameworkErr != nil {
-		return nil, frameworkErr
-	}
-
 	response.NextRESTCursor = nextCursor
 	response.Objects = objects
 
@@ -269,12 +251,13 @@ func (d *Datasource) getRESTPage(ctx context.Context, request *Request) (*Respon
 func (d *Datasource) getResourceIDs(ctx context.Context, request *Request) (
 	[]string,
 	*pagination.CompositeCursor[string],
-	*http.Response,
+	*httpds.Response,
 	*framework.Error,
 ) {
 	endpointInfo := EntityExternalIDToEndpoint[request.EntityExternalID]
 
-	if request.EntityExternalID == Alerts && endpointInfo.ListEndpoint == "" {
+	// The combined endpoints, e.g. Alerts, return the detailed entities without listing their IDs first.
+	if endpointInfo.ListEndpoint == "" {
 		return []string{}, nil, nil, nil
 	}
 
@@ -286,65 +269,41 @@ func (d *Datasource) getResourceIDs(ctx context.Context, request *Request) (
 		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, *url, nil)
-	if err != nil {
-		return nil, nil, nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
-	// Timeout API calls that take longer than the configured timeout.
-	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
-	defer cancel()
-
-	req = req.WithContext(apiCtx)
-
-	req.Header.Add("Authorization", request.Token)
-	req.Header.Set("Content-Type", "application/json")
-
-	res, err := d.Client.Do(req)
-	if err != nil {
-		return nil, nil, nil, customerror.UpdateError(&framework.Error{
-			Message: fmt.Sprintf("Failed to execute CrowdStrike request: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		},
-			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
-		)
-	}
-
-	statusCode := res.StatusCode
-
-	if statusCode != http.StatusOK {
-		return nil, nil, res, nil
-	}
-
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, nil, res, &framework.Error{
-			Message: fmt.Sprintf("Failed to read CrowdStrike response: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
 	var (
 		resourceIDs []string
 		nextCursor  *pagination.CompositeCursor[string]
-		listErr     *framework.Error
 	)
 
-	if ValidRESTEntityExternalIDs[request.EntityExternalID].UseIntCursor {
-		resourceIDs, nextCursor, listErr = parseListResponse(body, request)
-		if listErr != nil {
-			return nil, nil, res, listErr
+	res, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: *url,
+		Header: http.Header{
+			"Authorization": {request.Token},
+			"Content-Type":  {"application/json"},
+		},
+		DatasourceName:        "CrowdStrike",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "CrowdStrike")
+		if readErr != nil {
+			return readErr
 		}
-	} else {
-		resourceIDs, nextCursor, listErr = parseListScrollResponse(body, request)
-		if listErr != nil {
-			return nil, nil, res, listErr
+
+		var listErr *framework.Error
+
+		if ValidRESTEntityExternalIDs[request.EntityExternalID].UseIntCursor {
+			resourceIDs, nextCursor, listErr = parseListResponse(bodyBytes, request)
+		} else {
+			resourceIDs, nextCursor, listErr = parseListScrollResponse(bodyBytes, request)
 		}
+
+		return listErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, nil, nil, frameworkErr
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, nil, res, nil
 	}
 
 	if len(resourceIDs) == 0 {
@@ -381,14 +340,24 @@ func parseListResponse(body []byte, request *Request) (
 		}
 	}
 
+	nextCursor, err = nextOffsetCursor(request, int64(data.Meta.PaginationInfo.Total))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data.Resources, nextCursor, nil
+}
+
+// nextOffsetCursor returns the cursor of the next page of an endpoint with an integer offset, or nil if the
+// current page is the last one of the total number of entities.
+func nextOffsetCursor(request *Request, total int64) (*pagination.CompositeCursor[string], *framework.Error) {
 	nextOffset := request.PageSize
-	total := int64(data.Meta.PaginationInfo.Total)
 
 	// If the cursor is not nil, increment the next offset by the last offset value.
 	if request.RESTCursor != nil && request.RESTCursor.Cursor != nil {
 		prevOffset, parseErr := strconv.ParseInt(*request.RESTCursor.Cursor, 10, 64)
 		if parseErr != nil {
-			return nil, nil, &framework.Error{
+			return nil, &framework.Error{
 				Message: fmt.Sprintf("Expected a numeric cursor for entity: %s.", request.EntityExternalID),
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
 			}
@@ -397,13 +366,13 @@ func parseListResponse(body []byte, request *Request) (
 		nextOffset += prevOffset
 	}
 
-	if total > nextOffset {
-		offsetStr := strconv.Itoa(int(nextOffset))
-
-		nextCursor = &pagination.CompositeCursor[string]{Cursor: &offsetStr}
+	if total <= nextOffset {
+		return nil, nil
 	}
 
-	return data.Resources, nextCursor, nil
+	offsetStr := strconv.Itoa(int(nextOffset))
+
+	return &pagination.CompositeCursor[string]{Cursor: &offsetStr}, nil
 }
 
 /*
@@ -527,6 +496,46 @@ func parseAlertsResponse(body []byte) (
 	return data.Resources, nextCursor, nil
 }
 
+// parseRoleAssignmentsResponse parses the response of the combined user roles endpoint, which returns the grants
+// of the users with offset pagination. A grant has no ID, so the `id` attribute is set to the user UUID, the CID
+// and the role ID of the grant, e.g. "f4ad5d2a-6b8e-4a6c-8d0e-2c4b1f8e7a31-c1a2b3-falconhost_admin".
+func parseRoleAssignmentsResponse(body []byte, request *Request) (
+	objects []map[string]any,
+	nextCursor *pagination.CompositeCursor[string],
+	err *framework.Error,
+) {
+	var data *DetailedResourceResponse
+
+	if unmarshalErr := json.Unmarshal(body, &data); unmarshalErr != nil || data == nil {
+		return nil, nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal the role assignments response: %v.", unmarshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	if len(data.Errors) != 0 {
+		return nil, nil, ParseError(data.Errors)
+	}
+
+	if data.Resources == nil {
+		return nil, nil, &framework.Error{
+			Message: "Missing resources in the role assignments response.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	for _, object := range data.Resources {
+		object["id"] = fmt.Sprintf("%v-%v-%v", object["uuid"], object["cid"], object["role_id"])
+	}
+
+	nextCursor, err = nextOffsetCursor(request, int64(data.Meta.PaginationInfo.Total))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data.Resources, nextCursor, nil
+}
+
 func ParseError(errors []ErrorItem) *framework.Error {
 	errorMessages := make([]string, 0, len(errors)+1)
 
@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
 
@@ -21,6 +22,10 @@ import (
 type EndpointInfo struct {
 	ListEndpoint string
 	GetEndpoint  string
+
+	// GetMethod is the HTTP method of the get endpoint. The get endpoints implemented over HTTP GET receive the
+	// entity IDs in the `ids` query parameters instead of the request body. Defaults to HTTP POST.
+	GetMethod string
 }
 
 var (
@@ -37,6 +42,21 @@ var (
 		Alerts: {
 			GetEndpoint: "alerts/combined/alerts/v1", // This is implemented over HTTP POST by CRWD
 		},
+		// https://www.falconpy.io/Service-Collections/User-Management.html
+		FalconUser: {
+			ListEndpoint: "user-management/queries/users/v1",      // This is implemented over HTTP GET by CRWD
+			GetEndpoint:  "user-management/entities/users/GET/v1", // This is implemented over HTTP POST by CRWD
+		},
+		FalconRole: {
+			ListEndpoint: "user-management/queries/roles/v1",
+			GetEndpoint:  "user-management/entities/roles/v1",
+			GetMethod:    http.MethodGet,
+		},
+		// The combined endpoint returns the grants of all the users, with offset pagination.
+		FalconRoleAssignment: {
+			GetEndpoint: "user-management/combined/user-roles/v1",
+			GetMethod:   http.MethodGet,
+		},
 	}
 )
 
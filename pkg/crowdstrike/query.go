@@ -34,6 +34,10 @@ type EndpointQueryBuilder struct {
 	First    int64
 }
 
+type IdentityDetectionQueryBuilder struct {
+	PageSize int64
+}
+
 func SetAfterParameter(value *string) string {
 	if value == nil {
 		return ""
@@ -118,6 +122,20 @@ func (b *UserQueryBuilder) Build(request *Request) (string, *framework.Error) {
 								userAccountControl
 								userAccountControlFlags
 							}
+							... on SsoUserAccountDescriptor {
+								archived
+								containingGroupIds
+								creationTime
+								dataSource
+								dataSourceParticipantIdentifier
+								department
+								description
+								enabled
+								flattenedContainingGroupIds
+								mostRecentActivity
+								tenant
+								title
+							}
 						}
 						primaryDisplayName
 					}
@@ -299,6 +317,61 @@ func (b *EndpointQueryBuilder) Build(request *Request) (string, *framework.Error
 	return query, nil
 }
 
+func (b *IdentityDetectionQueryBuilder) Build(request *Request) (string, *framework.Error) {
+	var cursor string
+	if request.GraphQLCursor != nil {
+		cursor = SetAfterParameter(request.GraphQLCursor.Cursor)
+	}
+
+	// Identity Protection detections are exposed as the alert events of the timeline.
+	query := fmt.Sprintf(
+		`{
+		    timeline(
+		        types: [ALERT]
+		        sortOrder: DESCENDING
+		        first: %d
+		        %s
+		    ) {
+		        pageInfo {
+		            hasNextPage
+		            endCursor
+		        }
+		        nodes {
+		            ... on TimelineAlertEvent {
+		                alertId
+		                alertType
+		                endTime
+		                eventId
+		                eventLabel
+		                eventSeverity
+		                eventType
+		                patternId
+		                resolved
+		                startTime
+		                timestamp
+		                entities {
+		                    archived
+		                    creationTime
+		                    entityId
+		                    hasADDomainAdminRole
+		                    hasRole
+		                    learned
+		                    markTime
+		                    primaryDisplayName
+		                    riskScore
+		                    riskScoreSeverity
+		                    secondaryDisplayName
+		                    type
+		                    watched
+		                }
+		            }
+		        }
+		    }
+		}`, b.PageSize, cursor)
+
+	return query, nil
+}
+
 func GetQueryBuilder(request *Request, _ *PageInfo) (QueryBuilder, *framework.Error) {
 	var builder QueryBuilder
 
@@ -319,6 +392,10 @@ func GetQueryBuilder(request *Request, _ *PageInfo) (QueryBuilder, *framework.Er
 			Enabled:  request.Config.Enabled,
 			PageSize: request.PageSize,
 		}
+	case IdentityDetection:
+		builder = &IdentityDetectionQueryBuilder{
+			PageSize: request.PageSize,
+		}
 
 	default:
 		return nil, &framework.Error{
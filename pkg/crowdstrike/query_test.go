@@ -315,6 +315,20 @@ func TestUserQueryBuilder_Build(t *testing.T) {
 									userAccountControl
 									userAccountControlFlags
 								}
+								... on SsoUserAccountDescriptor {
+									archived
+									containingGroupIds
+									creationTime
+									dataSource
+									dataSourceParticipantIdentifier
+									department
+									description
+									enabled
+									flattenedContainingGroupIds
+									mostRecentActivity
+									tenant
+									title
+								}
 							}
 							primaryDisplayName
 						}
@@ -399,6 +413,20 @@ func TestUserQueryBuilder_Build(t *testing.T) {
 									userAccountControl
 									userAccountControlFlags
 								}
+								... on SsoUserAccountDescriptor {
+									archived
+									containingGroupIds
+									creationTime
+									dataSource
+									dataSourceParticipantIdentifier
+									department
+									description
+									enabled
+									flattenedContainingGroupIds
+									mostRecentActivity
+									tenant
+									title
+								}
 							}
 							primaryDisplayName
 						}
@@ -592,6 +620,128 @@ func TestIncidentQueryBuilder_Build(t *testing.T) {
 	}
 }
 
+func TestIdentityDetectionQueryBuilder_Build(t *testing.T) {
+	tests := map[string]struct {
+		builder  *IdentityDetectionQueryBuilder
+		request  *Request
+		expected string
+		wantErr  error
+	}{
+		"with cursor": {
+			builder: &IdentityDetectionQueryBuilder{
+				PageSize: 100,
+			},
+			request: &Request{
+				GraphQLCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("cursor123"),
+				},
+			},
+			expected: `{
+					timeline(
+						types: [ALERT]
+						sortOrder: DESCENDING
+						first: 100
+						after: "cursor123"
+					) {
+						pageInfo { hasNextPage endCursor }
+						nodes {
+							... on TimelineAlertEvent {
+								alertId
+								alertType
+								endTime
+								eventId
+								eventLabel
+								eventSeverity
+								eventType
+								patternId
+								resolved
+								startTime
+								timestamp
+								entities {
+									archived
+									creationTime
+									entityId
+									hasADDomainAdminRole
+									hasRole
+									learned
+									markTime
+									primaryDisplayName
+									riskScore
+									riskScoreSeverity
+									secondaryDisplayName
+									type
+									watched
+								}
+							}
+						}
+					}
+				}`,
+		},
+		"no cursor": {
+			builder: &IdentityDetectionQueryBuilder{
+				PageSize: 100,
+			},
+			request: &Request{
+				GraphQLCursor: nil,
+			},
+			expected: `{
+					timeline(
+						types: [ALERT]
+						sortOrder: DESCENDING
+						first: 100
+					) {
+						pageInfo { hasNextPage endCursor }
+						nodes {
+							... on TimelineAlertEvent {
+								alertId
+								alertType
+								endTime
+								eventId
+								eventLabel
+								eventSeverity
+								eventType
+								patternId
+								resolved
+								startTime
+								timestamp
+								entities {
+									archived
+									creationTime
+									entityId
+									hasADDomainAdminRole
+									hasRole
+									learned
+									markTime
+									primaryDisplayName
+									riskScore
+									riskScoreSeverity
+									secondaryDisplayName
+									type
+									watched
+								}
+							}
+						}
+					}
+				}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			query, err := tt.builder.Build(tt.request)
+			if err != nil {
+				if diff := cmp.Diff(tt.wantErr, err); diff != "" {
+					t.Fatal(diff)
+				}
+			}
+
+			if diff := cmp.Diff(NormalizeQuery(tt.expected), NormalizeQuery(query)); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
 // Remove multiple spaces, commas, newlines, and tabs.
 func NormalizeQuery(query string) string {
 	normalized := strings.ReplaceAll(query, "\\n", " ")
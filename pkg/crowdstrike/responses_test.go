@@ -1219,6 +1219,128 @@ var (
     }
 }`
 
+	IdentityDetectionResponsePage1 = `{
+    "data": {
+        "timeline": {
+            "pageInfo": {
+                "hasNextPage": true,
+                "endCursor": "eyJ0aW1lc3RhbXAiOiIyMDI0LTA5LTIwVDAxOjQ5OjI3LjAwMFoiLCJfaWQiOiJlZWI4ZTJmMi03YTBlLTRhNTUtOGU2Yi0wYjNiNGQ1YjVjMmEifQ=="
+            },
+            "nodes": [
+                {
+                    "alertId": "5f0c1e0a-3d2b-4c55-9f0e-6a7b8c9d0e1f",
+                    "alertType": "PasswordSprayAlert",
+                    "endTime": "2024-09-23T13:05:00.000Z",
+                    "eventId": "kq81zt",
+                    "eventLabel": "Password spraying",
+                    "eventSeverity": "IMPORTANT",
+                    "eventType": "ALERT",
+                    "patternId": 51127,
+                    "resolved": false,
+                    "startTime": "2024-09-23T13:00:21.000Z",
+                    "timestamp": "2024-09-23T13:00:21.000Z",
+                    "entities": [
+                        {
+                            "archived": false,
+                            "creationTime": "2024-05-15T15:29:10.000Z",
+                            "entityId": "3c7aebb9-411b-4ee9-b481-e881f29afcc8",
+                            "hasADDomainAdminRole": false,
+                            "hasRole": true,
+                            "learned": false,
+                            "markTime": null,
+                            "primaryDisplayName": "mj-dc",
+                            "riskScore": 0.46,
+                            "riskScoreSeverity": "MEDIUM",
+                            "secondaryDisplayName": "CORP.SGNL.AI\\mj-dc",
+                            "type": "ENDPOINT",
+                            "watched": false
+                        }
+                    ]
+                },
+                {
+                    "alertId": "eeb8e2f2-7a0e-4a55-8e6b-0b3b4d5b5c2a",
+                    "alertType": "CredentialScanningAlert",
+                    "endTime": "2024-09-20T01:55:10.274Z",
+                    "eventId": "jx2m9a",
+                    "eventLabel": "Credential scanning",
+                    "eventSeverity": "IMPORTANT",
+                    "eventType": "ALERT",
+                    "patternId": 51101,
+                    "resolved": true,
+                    "startTime": "2024-09-20T01:49:27.000Z",
+                    "timestamp": "2024-09-20T01:49:27.000Z",
+                    "entities": [
+                        {
+                            "archived": false,
+                            "creationTime": "2024-05-15T15:29:10.000Z",
+                            "entityId": "60ee5bb1-805f-46d2-8f3a-9d7cadc52909",
+                            "hasADDomainAdminRole": false,
+                            "hasRole": false,
+                            "learned": false,
+                            "markTime": null,
+                            "primaryDisplayName": "Alice Wu",
+                            "riskScore": 0.64,
+                            "riskScoreSeverity": "MEDIUM",
+                            "secondaryDisplayName": "CORP.SGNL.AI\\alice.wu",
+                            "type": "USER",
+                            "watched": false
+                        }
+                    ]
+                }
+            ]
+        }
+    },
+    "extensions": {
+        "runTime": 24
+    }
+}`
+
+	IdentityDetectionResponsePage2 = `{
+    "data": {
+        "timeline": {
+            "pageInfo": {
+                "hasNextPage": false,
+                "endCursor": null
+            },
+            "nodes": [
+                {
+                    "alertId": "0b41c631-6b41-4d8f-abd5-3946aaf45652",
+                    "alertType": "LdapReconnaissanceAlert",
+                    "endTime": "2024-09-04T02:23:59.999Z",
+                    "eventId": "jpppv6",
+                    "eventLabel": "Suspicious LDAP search (Kerberos misconfiguration)",
+                    "eventSeverity": "IMPORTANT",
+                    "eventType": "ALERT",
+                    "patternId": 51106,
+                    "resolved": false,
+                    "startTime": "2024-09-04T02:23:59.999Z",
+                    "timestamp": "2024-09-04T02:23:59.999Z",
+                    "entities": [
+                        {
+                            "archived": false,
+                            "creationTime": "2024-08-25T18:18:00.000Z",
+                            "entityId": "83a49ef1-17a7-4fa4-b90f-9142dfa49577",
+                            "hasADDomainAdminRole": true,
+                            "hasRole": true,
+                            "learned": false,
+                            "markTime": null,
+                            "primaryDisplayName": "sgnl sor",
+                            "riskScore": 0.64,
+                            "riskScoreSeverity": "MEDIUM",
+                            "secondaryDisplayName": "WHOLESALECHIPS.CO\\sgnl.sor",
+                            "type": "USER",
+                            "watched": false
+                        }
+                    ]
+                }
+            ]
+        }
+    },
+    "extensions": {
+        "runTime": 19
+    }
+}`
+
 	// Alerts API responses.
 	AlertResponseFirstPage = `{
   "meta": {
@@ -2508,4 +2630,156 @@ var (
 		],
 		"errors": []
 	}`
+
+	FalconUserListFirstPageResponse = `{
+		"meta": {
+			"query_time": 0.004,
+			"pagination": {
+				"offset": 0,
+				"limit": 2,
+				"total": 3
+			},
+			"powered_by": "csam",
+			"trace_id": "6c0f6e44-6b4b-4c3a-9f0e-1a5d2e8c9b10"
+		},
+		"resources": [
+			"f4ad5d2a-6b8e-4a6c-8d0e-2c4b1f8e7a31",
+			"0b9e1c3d-2f4a-4b6c-9d8e-7f6a5b4c3d21"
+		],
+		"errors": []
+	}`
+
+	FalconUserListLastPageResponse = `{
+		"meta": {
+			"query_time": 0.003,
+			"pagination": {
+				"offset": 2,
+				"limit": 2,
+				"total": 3
+			},
+			"powered_by": "csam",
+			"trace_id": "7d1a7f55-7c5c-4d4b-a01f-2b6e3f9dac21"
+		},
+		"resources": [
+			"9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c65"
+		],
+		"errors": []
+	}`
+
+	FalconUserFirstPageResponse = `{
+		"meta": {
+			"query_time": 0.005,
+			"powered_by": "csam",
+			"trace_id": "8e2b8066-8d6d-4e5c-b120-3c7f40aebd32"
+		},
+		"resources": [
+			{
+				"uuid": "f4ad5d2a-6b8e-4a6c-8d0e-2c4b1f8e7a31",
+				"cid": "c1a2b3d4e5f60718293a4b5c6d7e8f90",
+				"uid": "john@example.com",
+				"first_name": "John",
+				"last_name": "Doe",
+				"status": "active",
+				"created_at": "2024-03-08T04:18:47Z",
+				"last_login_at": "2026-10-01T12:00:00Z"
+			},
+			{
+				"uuid": "0b9e1c3d-2f4a-4b6c-9d8e-7f6a5b4c3d21",
+				"cid": "c1a2b3d4e5f60718293a4b5c6d7e8f90",
+				"uid": "jane@example.com",
+				"first_name": "Jane",
+				"last_name": "Doe",
+				"status": "inactive",
+				"created_at": "2024-05-10T09:30:00Z"
+			}
+		],
+		"errors": []
+	}`
+
+	FalconUserLastPageResponse = `{
+		"meta": {
+			"query_time": 0.004,
+			"powered_by": "csam",
+			"trace_id": "9f3c9177-9e7e-4f6d-c231-4d8051bfce43"
+		},
+		"resources": [
+			{
+				"uuid": "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c65",
+				"cid": "c1a2b3d4e5f60718293a4b5c6d7e8f90",
+				"uid": "jim@example.com",
+				"first_name": "Jim",
+				"last_name": "Beam",
+				"status": "active",
+				"created_at": "2025-01-01T10:00:00Z"
+			}
+		],
+		"errors": []
+	}`
+
+	FalconRoleListResponse = `{
+		"meta": {
+			"query_time": 0.002,
+			"powered_by": "csam",
+			"trace_id": "a04da288-af8f-4a7e-d342-5e9162c0df54"
+		},
+		"resources": [
+			"falconhost_admin",
+			"falconhost_read_only"
+		],
+		"errors": []
+	}`
+
+	FalconRoleResponse = `{
+		"meta": {
+			"query_time": 0.002,
+			"powered_by": "csam",
+			"trace_id": "b15eb399-b09a-4b8f-e453-6fa273d1e065"
+		},
+		"resources": [
+			{
+				"id": "falconhost_admin",
+				"cid": "c1a2b3d4e5f60718293a4b5c6d7e8f90",
+				"display_name": "Falcon Administrator",
+				"description": "Manage users, configure Falcon and respond to detections."
+			},
+			{
+				"id": "falconhost_read_only",
+				"cid": "c1a2b3d4e5f60718293a4b5c6d7e8f90",
+				"display_name": "Falcon Read Only",
+				"description": "View the Falcon console."
+			}
+		],
+		"errors": []
+	}`
+
+	FalconRoleAssignmentResponse = `{
+		"meta": {
+			"query_time": 0.006,
+			"pagination": {
+				"offset": 0,
+				"limit": 2,
+				"total": 2
+			},
+			"powered_by": "csam",
+			"trace_id": "c26fc4aa-c1ab-4c9a-f564-70b384e2f176"
+		},
+		"resources": [
+			{
+				"uuid": "f4ad5d2a-6b8e-4a6c-8d0e-2c4b1f8e7a31",
+				"cid": "c1a2b3d4e5f60718293a4b5c6d7e8f90",
+				"role_id": "falconhost_admin",
+				"role_name": "Falcon Administrator",
+				"grant_type": "direct"
+			},
+			{
+				"uuid": "0b9e1c3d-2f4a-4b6c-9d8e-7f6a5b4c3d21",
+				"cid": "c1a2b3d4e5f60718293a4b5c6d7e8f90",
+				"role_id": "falconhost_read_only",
+				"role_name": "Falcon Read Only",
+				"grant_type": "direct",
+				"expires_at": "2026-12-31T23:59:59Z"
+			}
+		],
+		"errors": []
+	}`
 )
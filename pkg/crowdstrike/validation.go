@@ -5,6 +5,7 @@ package crowdstrike
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
@@ -26,6 +27,13 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
+	region := request.Config.Region
+
+	// The address defaults to the API of the region, if set.
+	if strings.TrimSpace(request.Address) == "" && region != "" {
+		request.Address = RegionHosts[region]
+	}
+
 	trimmedAddress, parsed, err := validation.ParseAndValidateAddress(request.Address, []string{"https"})
 	if err != nil {
 		return err
@@ -38,6 +46,10 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		request.Address = trimmedAddress
 	}
 
+	if region != "" && !strings.EqualFold(parsed.Hostname(), RegionHosts[region]) {
+		return regionAddressError(request.Address, parsed.Hostname(), region)
+	}
+
 	if request.Auth == nil || request.Auth.HTTPAuthorization == "" {
 		return &framework.Error{
 			Message: "Provided datasource auth is missing required credentials.",
@@ -107,3 +119,27 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 
 	return nil
 }
+
+// regionAddressError returns the error of an address which isn't the API of the region of the config, naming the
+// region of the address, if it's the API of another region.
+func regionAddressError(address, host, region string) *framework.Error {
+	message := fmt.Sprintf(
+		"Address %s does not match the CrowdStrike API of region %s: %s.", address, region, RegionHosts[region],
+	)
+
+	for otherRegion, otherHost := range RegionHosts {
+		if strings.EqualFold(host, otherHost) {
+			message = fmt.Sprintf(
+				"Address %s is the CrowdStrike API of region %s, not of the configured region %s: %s.",
+				address, otherRegion, region, RegionHosts[region],
+			)
+
+			break
+		}
+	}
+
+	return &framework.Error{
+		Message: message,
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+	}
+}
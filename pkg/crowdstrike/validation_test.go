@@ -15,8 +15,9 @@ import (
 
 func TestValidateGetPageRequest(t *testing.T) {
 	tests := map[string]struct {
-		request *framework.Request[crowdstrike_adapter.Config]
-		wantErr *framework.Error
+		request     *framework.Request[crowdstrike_adapter.Config]
+		wantErr     *framework.Error
+		wantAddress string
 	}{
 		"valid_request": {
 			request: &framework.Request[crowdstrike_adapter.Config]{
@@ -54,6 +55,125 @@ func TestValidateGetPageRequest(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"valid_region_default_address": {
+			request: &framework.Request[crowdstrike_adapter.Config]{
+				Auth:    validAuthCredentials,
+				Address: "",
+				Entity: framework.EntityConfig{
+					ExternalId: "user",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "entityId",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+				},
+				Config: &crowdstrike_adapter.Config{
+					APIVersion: "v1",
+					Region:     "eu-1",
+				},
+				PageSize: 2,
+			},
+			wantAddress: "https://api.eu-1.crowdstrike.com",
+		},
+		"valid_region_matching_address": {
+			request: &framework.Request[crowdstrike_adapter.Config]{
+				Auth:    validAuthCredentials,
+				Address: "https://API.US-2.crowdstrike.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "user",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "entityId",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+				},
+				Config: &crowdstrike_adapter.Config{
+					APIVersion: "v1",
+					Region:     "us-2",
+				},
+				PageSize: 2,
+			},
+			wantAddress: "https://API.US-2.crowdstrike.com",
+		},
+		"invalid_region_address_of_another_region": {
+			request: &framework.Request[crowdstrike_adapter.Config]{
+				Auth:    validAuthCredentials,
+				Address: "api.crowdstrike.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "user",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "entityId",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+				},
+				Config: &crowdstrike_adapter.Config{
+					APIVersion: "v1",
+					Region:     "eu-1",
+				},
+				PageSize: 2,
+			},
+			wantErr: &framework.Error{
+				Message: "Address https://api.crowdstrike.com is the CrowdStrike API of region us-1, not of the configured region eu-1: api.eu-1.crowdstrike.com.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_region_unknown_address": {
+			request: &framework.Request[crowdstrike_adapter.Config]{
+				Auth:    validAuthCredentials,
+				Address: "https://falcon.acme.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "user",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "entityId",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+				},
+				Config: &crowdstrike_adapter.Config{
+					APIVersion: "v1",
+					Region:     "us-gov-1",
+				},
+				PageSize: 2,
+			},
+			wantErr: &framework.Error{
+				Message: "Address https://falcon.acme.com does not match the CrowdStrike API of region us-gov-1: api.laggar.gcw.crowdstrike.com.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_region_unsupported": {
+			request: &framework.Request[crowdstrike_adapter.Config]{
+				Auth:    validAuthCredentials,
+				Address: "api.crowdstrike.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "user",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "entityId",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+				},
+				Config: &crowdstrike_adapter.Config{
+					APIVersion: "v1",
+					Region:     "eu-2",
+				},
+				PageSize: 2,
+			},
+			wantErr: &framework.Error{
+				Message: "CrowdStrike config is invalid: region is not supported: eu-2.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_request_empty_config": {
 			request: &framework.Request[crowdstrike_adapter.Config]{
 				Auth:    validAuthCredentials,
@@ -260,6 +380,10 @@ func TestValidateGetPageRequest(t *testing.T) {
 			if !reflect.DeepEqual(gotErr, tt.wantErr) {
 				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
 			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
 		})
 	}
 }
@@ -0,0 +1,193 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package cursorstamp stamps the cursors returned by the adapters with the time they were issued at and the
+// build version of the adapter which issued them, so that stale cursors are rejected with an error asking to restart
+// the sync, instead of being interpreted by an adapter which changed their format since, e.g. after an upgrade.
+//
+// The cursors of the adapters wrapped with NewAdapter carry the cursor of the wrapped adapter along with the stamp.
+// A cursor issued by a build of another major version is always rejected, so the major version must be bumped when
+// the format of the cursors of an adapter changes incompatibly. A cursor older than the TTL of the
+// datasource config is rejected too, see config.CommonConfig.
+package cursorstamp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// CursorPrefix is the prefix of the stamped cursors, followed by the base64 encoded JSON Stamp.
+const CursorPrefix = "stamped:"
+
+// cursorShape is the expected JSON shape of a Stamp, used in cursor errors.
+const cursorShape = `{"cursor":<string>,"issuedAt":<int64>,"adapterVersion":<string>}`
+
+// Provider provides the maximum age of the cursors. It is implemented by config.CommonConfig, and therefore by the
+// configs of all adapters.
+type Provider interface {
+	// CursorTTL returns the maximum age of the cursors, or 0 if the cursors don't expire.
+	CursorTTL() time.Duration
+}
+
+// Stamp is a cursor of the wrapped adapter stamped with its issuance time and adapter version.
+type Stamp struct {
+	// Cursor is the cursor of the wrapped adapter.
+	Cursor string `json:"cursor"`
+
+	// IssuedAt is the time the cursor was issued at, in seconds since the Unix epoch.
+	IssuedAt int64 `json:"issuedAt"`
+
+	// AdapterVersion is the build version of the adapter which issued the cursor, e.g. "1.4.2".
+	AdapterVersion string `json:"adapterVersion"`
+}
+
+// MarshalCursor returns the stamped cursor.
+func (s *Stamp) MarshalCursor() (string, error) {
+	stampJSON, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+
+	return CursorPrefix + base64.StdEncoding.EncodeToString(stampJSON), nil
+}
+
+// UnmarshalCursor returns the stamp of a cursor, or nil if the cursor isn't stamped, i.e. it was returned by the
+// wrapped adapter before the cursors were stamped.
+func UnmarshalCursor(cursor, entityExternalID string) (*Stamp, *framework.Error) {
+	encoded, found := strings.CutPrefix(cursor, CursorPrefix)
+	if !found {
+		return nil, nil
+	}
+
+	stampJSON, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, cursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var stamp Stamp
+
+	if err := json.Unmarshal(stampJSON, &stamp); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, cursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	return &stamp, nil
+}
+
+// Validate returns an error if the cursor was issued by an adapter version incompatible with adapterVersion, or if
+// it is older than the TTL, unless the TTL is 0.
+func (s *Stamp) Validate(entityExternalID, adapterVersion string, ttl time.Duration, now time.Time) *framework.Error {
+	if majorVersion(s.AdapterVersion) != majorVersion(adapterVersion) {
+		return pagination.NewCursorError(entityExternalID, "", fmt.Sprintf(
+			"the cursor was issued by adapter version %q, which is incompatible with adapter version %q",
+			s.AdapterVersion, adapterVersion,
+		))
+	}
+
+	issuedAt := time.Unix(s.IssuedAt, 0)
+
+	if age := now.Sub(issuedAt); ttl > 0 && age > ttl {
+		return pagination.NewCursorError(entityExternalID, "", fmt.Sprintf(
+			"the cursor was issued at %s, %s ago, and has expired after %s",
+			issuedAt.UTC().Format(time.RFC3339), age.Truncate(time.Second), ttl,
+		))
+	}
+
+	return nil
+}
+
+// majorVersion returns the major version of a semantic version, e.g. "1" for "v1.0.1" and "0" for "0.0.1-alpha".
+// Versions which don't start with a number are returned as is, so they're only compatible with themselves.
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(strings.TrimPrefix(version, "v"), ".")
+
+	if _, err := strconv.ParseUint(major, 10, 64); err != nil {
+		return version
+	}
+
+	return major
+}
+
+// adapter stamps the cursors returned by the next adapter, and rejects the stale cursors.
+type adapter[Config any] struct {
+	next           framework.Adapter[Config]
+	adapterVersion string
+	now            func() time.Time
+}
+
+// NewAdapter wraps an adapter to stamp the cursors it returns with the time and the build version of the adapter,
+// e.g. "1.4.2", and to reject the cursors issued by a build of an incompatible version or older than the TTL of the
+// config, if the config implements Provider. The build version must change with the adapter build, unlike the
+// version of the datasource type, e.g. "Okta-1.0.1", which is fixed at registration.
+//
+// Cursors which aren't stamped are passed to the wrapped adapter unchanged, so that the syncs in progress while
+// the stamping is rolled out aren't restarted.
+func NewAdapter[Config any](next framework.Adapter[Config], adapterVersion string) framework.Adapter[Config] {
+	return &adapter[Config]{
+		next:           next,
+		adapterVersion: adapterVersion,
+		now:            time.Now,
+	}
+}
+
+// GetPage implements framework.Adapter.
+func (a *adapter[Config]) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	stamp, cursorErr := UnmarshalCursor(request.Cursor, request.Entity.ExternalId)
+	if cursorErr != nil {
+		return framework.NewGetPageResponseError(cursorErr)
+	}
+
+	nextRequest := request
+
+	if stamp != nil {
+		var ttl time.Duration
+
+		if request.Config != nil {
+			if provider, ok := any(request.Config).(Provider); ok {
+				ttl = provider.CursorTTL()
+			}
+		}
+
+		if err := stamp.Validate(request.Entity.ExternalId, a.adapterVersion, ttl, a.now()); err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		unstamped := *request
+		unstamped.Cursor = stamp.Cursor
+		nextRequest = &unstamped
+	}
+
+	response := a.next.GetPage(ctx, nextRequest)
+	if response.Success == nil || response.Success.NextCursor == "" {
+		return response
+	}
+
+	nextStamp := &Stamp{
+		Cursor:         response.Success.NextCursor,
+		IssuedAt:       a.now().Unix(),
+		AdapterVersion: a.adapterVersion,
+	}
+
+	nextCursor, err := nextStamp.MarshalCursor()
+	if err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: fmt.Sprintf("Failed to marshal the stamped cursor: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		})
+	}
+
+	response.Success.NextCursor = nextCursor
+
+	return response
+}
@@ -0,0 +1,318 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll
+package cursorstamp_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/cursorstamp"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func mustMarshalCursor(t *testing.T, stamp *cursorstamp.Stamp) string {
+	t.Helper()
+
+	cursor, err := stamp.MarshalCursor()
+	if err != nil {
+		t.Fatalf("failed to marshal cursor: %v", err)
+	}
+
+	return cursor
+}
+
+func TestNewAdapter(t *testing.T) {
+	pages := map[string]*framework.Page{
+		"": {
+			Objects:    []framework.Object{{"id": "00u1"}},
+			NextCursor: "page2",
+		},
+		"page2": {
+			Objects: []framework.Object{{"id": "00u2"}},
+		},
+	}
+
+	entity := framework.EntityConfig{ExternalId: "User"}
+	ttlConfig := &testutil.Config{CommonConfig: &config.CommonConfig{CursorTTLSeconds: 3600}}
+
+	tests := map[string]struct {
+		config         *testutil.Config
+		cursor         func(t *testing.T) string
+		wantNextCursor bool
+		wantCursors    []string
+		wantObjects    []framework.Object
+		wantErrPrefix  string
+	}{
+		"first_page_is_stamped": {
+			config:         ttlConfig,
+			cursor:         func(*testing.T) string { return "" },
+			wantNextCursor: true,
+			wantCursors:    []string{""},
+			wantObjects:    pages[""].Objects,
+		},
+		"stamped_cursor_is_unstamped": {
+			config: ttlConfig,
+			cursor: func(t *testing.T) string {
+				return mustMarshalCursor(t, &cursorstamp.Stamp{
+					Cursor:         "page2",
+					IssuedAt:       time.Now().Add(-time.Minute).Unix(),
+					AdapterVersion: "1.0.1",
+				})
+			},
+			wantCursors: []string{"page2"},
+			wantObjects: pages["page2"].Objects,
+		},
+		"cursor_of_compatible_version": {
+			config: ttlConfig,
+			cursor: func(t *testing.T) string {
+				return mustMarshalCursor(t, &cursorstamp.Stamp{
+					Cursor:         "page2",
+					IssuedAt:       time.Now().Unix(),
+					AdapterVersion: "1.0.0",
+				})
+			},
+			wantCursors: []string{"page2"},
+			wantObjects: pages["page2"].Objects,
+		},
+		"cursor_not_stamped": {
+			config:      ttlConfig,
+			cursor:      func(*testing.T) string { return "page2" },
+			wantCursors: []string{"page2"},
+			wantObjects: pages["page2"].Objects,
+		},
+		"old_cursor_without_ttl": {
+			config: &testutil.Config{},
+			cursor: func(t *testing.T) string {
+				return mustMarshalCursor(t, &cursorstamp.Stamp{
+					Cursor:         "page2",
+					IssuedAt:       time.Now().Add(-30 * 24 * time.Hour).Unix(),
+					AdapterVersion: "1.0.1",
+				})
+			},
+			wantCursors: []string{"page2"},
+			wantObjects: pages["page2"].Objects,
+		},
+		"expired_cursor": {
+			config: ttlConfig,
+			cursor: func(t *testing.T) string {
+				return mustMarshalCursor(t, &cursorstamp.Stamp{
+					Cursor:         "page2",
+					IssuedAt:       time.Now().Add(-2 * time.Hour).Unix(),
+					AdapterVersion: "1.0.1",
+				})
+			},
+			wantErrPrefix: "Invalid cursor for entity User: the cursor was issued at ",
+		},
+		"cursor_of_incompatible_version": {
+			config: ttlConfig,
+			cursor: func(t *testing.T) string {
+				return mustMarshalCursor(t, &cursorstamp.Stamp{
+					Cursor:         "page2",
+					IssuedAt:       time.Now().Unix(),
+					AdapterVersion: "2.0.0",
+				})
+			},
+			wantErrPrefix: `Invalid cursor for entity User: the cursor was issued by adapter version "2.0.0", which is incompatible with adapter version "1.0.1". Restart the sync for this entity to discard the invalid cursor.`,
+		},
+		"invalid_stamped_cursor": {
+			config:        ttlConfig,
+			cursor:        func(*testing.T) string { return cursorstamp.CursorPrefix + "not base64" },
+			wantErrPrefix: "Invalid cursor for entity User: failed to decode base64 cursor: ",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := &testutil.Adapter{Pages: pages}
+			adapter := cursorstamp.NewAdapter[testutil.Config](next, "1.0.1")
+
+			response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{
+				Config: tt.config,
+				Entity: entity,
+				Cursor: tt.cursor(t),
+			})
+
+			if tt.wantErrPrefix != "" {
+				if response.Error == nil || !strings.HasPrefix(response.Error.Message, tt.wantErrPrefix) ||
+					response.Error.Code != api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG {
+					t.Fatalf("gotResponse: %v, want an error starting with %q", response, tt.wantErrPrefix)
+				}
+
+				if len(next.GotCursors) != 0 {
+					t.Errorf("the wrapped adapter was called with the rejected cursor: %v", next.GotCursors)
+				}
+
+				return
+			}
+
+			if response.Error != nil {
+				t.Fatalf("unexpected error: %v", response.Error)
+			}
+
+			if !reflect.DeepEqual(next.GotCursors, tt.wantCursors) {
+				t.Errorf("gotCursors: %v, wantCursors: %v", next.GotCursors, tt.wantCursors)
+			}
+
+			if !reflect.DeepEqual(response.Success.Objects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", response.Success.Objects, tt.wantObjects)
+			}
+
+			if !tt.wantNextCursor {
+				if response.Success.NextCursor != "" {
+					t.Errorf("unexpected next cursor: %s", response.Success.NextCursor)
+				}
+
+				return
+			}
+
+			stamp, err := cursorstamp.UnmarshalCursor(response.Success.NextCursor, entity.ExternalId)
+			if err != nil || stamp == nil {
+				t.Fatalf("next cursor is not stamped: %s, err: %v", response.Success.NextCursor, err)
+			}
+
+			if stamp.Cursor != "page2" || stamp.AdapterVersion != "1.0.1" || time.Since(time.Unix(stamp.IssuedAt, 0)) > time.Minute {
+				t.Errorf("gotStamp: %+v, want the cursor page2 issued now by version 1.0.1", stamp)
+			}
+		})
+	}
+}
+
+func TestStampValidate(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		stamp          *cursorstamp.Stamp
+		adapterVersion string
+		ttl            time.Duration
+		wantErr        *framework.Error
+	}{
+		"same_version": {
+			stamp:          &cursorstamp.Stamp{IssuedAt: now.Unix(), AdapterVersion: "1.0.1"},
+			adapterVersion: "1.0.1",
+		},
+		"same_major_version": {
+			stamp:          &cursorstamp.Stamp{IssuedAt: now.Unix(), AdapterVersion: "1.2.0"},
+			adapterVersion: "1.0.1",
+		},
+		"version_with_v_prefix": {
+			stamp:          &cursorstamp.Stamp{IssuedAt: now.Unix(), AdapterVersion: "v1.2.0"},
+			adapterVersion: "1.0.1",
+		},
+		"pre_release_version": {
+			stamp:          &cursorstamp.Stamp{IssuedAt: now.Unix(), AdapterVersion: "0.0.1-alpha"},
+			adapterVersion: "0.0.2-alpha",
+		},
+		"different_major_version": {
+			stamp:          &cursorstamp.Stamp{IssuedAt: now.Unix(), AdapterVersion: "1.0.1"},
+			adapterVersion: "2.0.0",
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity User: the cursor was issued by adapter version "1.0.1", which is incompatible with adapter version "2.0.0". Restart the sync for this entity to discard the invalid cursor.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"version_not_a_number": {
+			stamp:          &cursorstamp.Stamp{IssuedAt: now.Unix(), AdapterVersion: "latest"},
+			adapterVersion: "1.0.1",
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity User: the cursor was issued by adapter version "latest", which is incompatible with adapter version "1.0.1". Restart the sync for this entity to discard the invalid cursor.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"within_ttl": {
+			stamp:          &cursorstamp.Stamp{IssuedAt: now.Add(-time.Hour).Unix(), AdapterVersion: "1.0.1"},
+			adapterVersion: "1.0.1",
+			ttl:            time.Hour,
+		},
+		"expired": {
+			stamp:          &cursorstamp.Stamp{IssuedAt: now.Add(-25 * time.Hour).Unix(), AdapterVersion: "1.0.1"},
+			adapterVersion: "1.0.1",
+			ttl:            24 * time.Hour,
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity User: the cursor was issued at 2024-03-09T11:00:00Z, 25h0m0s ago, and has expired after 24h0m0s. Restart the sync for this entity to discard the invalid cursor.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"no_ttl": {
+			stamp:          &cursorstamp.Stamp{IssuedAt: now.Add(-365 * 24 * time.Hour).Unix(), AdapterVersion: "1.0.1"},
+			adapterVersion: "1.0.1",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tt.stamp.Validate("User", tt.adapterVersion, tt.ttl, now)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewAdapterAfterUpgrade(t *testing.T) {
+	pages := map[string]*framework.Page{
+		"": {
+			Objects:    []framework.Object{{"id": "00u1"}},
+			NextCursor: "page2",
+		},
+		"page2": {
+			Objects: []framework.Object{{"id": "00u2"}},
+		},
+	}
+
+	request := &framework.Request[testutil.Config]{
+		Config: &testutil.Config{CommonConfig: &config.CommonConfig{}},
+		Entity: framework.EntityConfig{ExternalId: "User"},
+	}
+
+	// The first page is returned by a build of version 1.4.2, the next page is requested after an upgrade.
+	firstResponse := cursorstamp.NewAdapter[testutil.Config](&testutil.Adapter{Pages: pages}, "1.4.2").GetPage(
+		context.Background(), request,
+	)
+	if firstResponse.Error != nil {
+		t.Fatalf("unexpected error: %v", firstResponse.Error)
+	}
+
+	tests := map[string]struct {
+		upgradedVersion string
+		wantErr         *framework.Error
+	}{
+		"same_major_version": {
+			upgradedVersion: "1.5.0",
+		},
+		"different_major_version": {
+			upgradedVersion: "2.0.0",
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity User: the cursor was issued by adapter version "1.4.2", which is incompatible with adapter version "2.0.0". Restart the sync for this entity to discard the invalid cursor.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := &testutil.Adapter{Pages: pages}
+			nextRequest := *request
+			nextRequest.Cursor = firstResponse.Success.NextCursor
+
+			response := cursorstamp.NewAdapter[testutil.Config](next, tt.upgradedVersion).GetPage(
+				context.Background(), &nextRequest,
+			)
+
+			if !reflect.DeepEqual(response.Error, tt.wantErr) {
+				t.Fatalf("gotErr: %v, wantErr: %v", response.Error, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && !reflect.DeepEqual(next.GotCursors, []string{"page2"}) {
+				t.Errorf("gotCursors: %v, wantCursors: [page2]", next.GotCursors)
+			}
+		})
+	}
+}
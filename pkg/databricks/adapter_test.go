@@ -0,0 +1,291 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package databricks_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/databricks"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := databricks.NewAdapter(&databricks.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[int64]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	config := &databricks.Config{
+		AccountID: "acct-1",
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[databricks.Config]
+		wantResponse framework.Response
+	}{
+		"users_first_page": {
+			request: &framework.Request[databricks.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: databricks.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "userName",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "active",
+							Type:       framework.AttributeTypeBool,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":       "7535194597985784",
+							"userName": "alice@acme.com",
+							"active":   true,
+						},
+						{
+							"id":       "7535194597985785",
+							"userName": "bob@acme.com",
+							"active":   false,
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[int64]{
+						Cursor: testutil.GenPtr[int64](3),
+					}),
+				},
+			},
+		},
+		"workspaces": {
+			request: &framework.Request[databricks.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: databricks.Workspace,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "workspace_name",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "creation_time",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":             "9007199254740993",
+							"workspace_name": "prod",
+							"creation_time":  int64(1700000000000),
+						},
+						{
+							"id":             "1002",
+							"workspace_name": "dev",
+							"creation_time":  int64(1700000001000),
+						},
+					},
+				},
+			},
+		},
+		"workspace_permission_assignments_first_page": {
+			request: &framework.Request[databricks.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: databricks.WorkspacePermissionAssignment,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "workspaceId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "principalId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "permissions",
+							Type:       framework.AttributeTypeString,
+							List:       true,
+						},
+						{
+							ExternalId: "$.principal.user_name",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                    "9007199254740993-7535194597985784",
+							"workspaceId":           "9007199254740993",
+							"principalId":           "7535194597985784",
+							"permissions":           []string{"ADMIN"},
+							"$.principal.user_name": "alice@acme.com",
+						},
+						{
+							"id":          "9007199254740993-1010",
+							"workspaceId": "9007199254740993",
+							"principalId": "1010",
+							"permissions": []string{"USER"},
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[int64]{
+						Cursor:           testutil.GenPtr[int64](2),
+						CollectionID:     testutil.GenPtr("9007199254740993"),
+						CollectionCursor: testutil.GenPtr[int64](1),
+					}),
+				},
+			},
+		},
+		"groups_client_credentials": {
+			request: &framework.Request[databricks.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "sp-client",
+						Password: "sp-secret",
+					},
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: databricks.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "displayName",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":          "1010",
+							"displayName": "data-engineers",
+						},
+					},
+				},
+			},
+		},
+		"invalid_client_credentials": {
+			request: &framework.Request[databricks.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "sp-client",
+						Password: "invalid",
+					},
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: databricks.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to get an access token for Databricks account acct-1: Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[databricks.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-invalid",
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: databricks.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
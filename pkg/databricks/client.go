@@ -0,0 +1,66 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package databricks
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Client is a client that allows querying the Databricks datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+
+	// GetToken returns the Authorization header value to query the Account API with the OAuth client
+	// credentials of a service principal.
+	GetToken(ctx context.Context, request *TokenRequest) (string, *framework.Error)
+}
+
+// Request is a request to the Databricks Account API.
+type Request struct {
+	// BaseURL is the base URL of the Databricks account console, e.g. "https://accounts.cloud.databricks.com".
+	BaseURL string
+
+	// AuthorizationHeader is the Authorization header value to authenticate a request: the Bearer token of an
+	// OAuth access token of an account admin, or of a service principal with the account admin role.
+	// The access tokens of the client credentials of the service principals are requested with GetToken.
+	AuthorizationHeader string
+
+	// AccountID is the ID of the Databricks account.
+	AccountID string
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity. The cursor is the 1-based SCIM start index of the next page for the SCIM
+	// entities, and the offset of the next page in the objects returned by Databricks for the other entities.
+	// nil in the request for the first page.
+	Cursor *pagination.CompositeCursor[int64]
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *pagination.CompositeCursor[int64]
+}
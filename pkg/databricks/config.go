@@ -0,0 +1,40 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package databricks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Databricks Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "accountId": "0d26daa6-5e44-4c97-a497-ef015f91254a"
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// AccountID is the ID of the Databricks account, shown in the account console.
+	AccountID string `json:"accountId,omitempty"`
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	switch {
+	case c == nil:
+		return errors.New("request contains no config")
+	case c.AccountID == "":
+		return errors.New("accountId is not set")
+	default:
+		return c.CommonConfig.ValidateSyncMode()
+	}
+}
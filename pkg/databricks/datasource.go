@@ -0,0 +1,377 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package databricks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+
+	// tokens caches the access tokens of the client credentials of the service principals.
+	tokens tokenCache
+}
+
+// SCIMResponse is the format of the SCIM list responses of the Account SCIM API.
+// https://docs.databricks.com/api/account/accountusers/list.
+type SCIMResponse struct {
+	TotalResults int64            `json:"totalResults"`
+	StartIndex   int64            `json:"startIndex"`
+	ItemsPerPage int64            `json:"itemsPerPage"`
+	Resources    []map[string]any `json:"Resources"`
+}
+
+// PermissionAssignmentsResponse is the format of the response listing the permission assignments of a workspace.
+// https://docs.databricks.com/api/account/workspaceassignment/list.
+type PermissionAssignmentsResponse struct {
+	PermissionAssignments []map[string]any `json:"permission_assignments"`
+}
+
+// workspaceID is the ID of a workspace. The IDs are numbers that may exceed the precision of a float64, so
+// they are parsed separately from the objects.
+type workspaceID struct {
+	WorkspaceID json.Number `json:"workspace_id"`
+}
+
+// principalIDs are the IDs of the principals of the permission assignments, parsed like the workspace IDs.
+type principalIDs struct {
+	PermissionAssignments []struct {
+		Principal struct {
+			PrincipalID json.Number `json:"principal_id"`
+		} `json:"principal"`
+	} `json:"permission_assignments"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// scim is whether the entity is listed with the Account SCIM API, which paginates the objects. The other
+	// endpoints return all the objects at once, which the adapter paginates.
+	scim bool
+	// path is the path of the endpoint of the entity, relative to the account.
+	path string
+	// memberOf is the external ID of the collection entity the entity is listed for, if any.
+	memberOf *string
+	// collectionIDAttrExternalID is the attribute of the collection objects identifying them in the endpoint of
+	// the entity, e.g. the ID of the workspaces.
+	collectionIDAttrExternalID string
+	// setMemberAttributes sets the unique ID of an object listed for the collection, and the attribute
+	// referencing the collection.
+	setMemberAttributes func(collectionID string, object map[string]any)
+}
+
+const (
+	User                          string = "User"
+	Group                         string = "Group"
+	ServicePrincipal              string = "ServicePrincipal"
+	Workspace                     string = "Workspace"
+	WorkspacePermissionAssignment string = "WorkspacePermissionAssignment"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// https://docs.databricks.com/api/account/accountusers/list.
+		User: {
+			uniqueIDAttrExternalID: "id",
+			scim:                   true,
+			path:                   "/scim/v2/Users",
+		},
+		// https://docs.databricks.com/api/account/accountgroups/list.
+		Group: {
+			uniqueIDAttrExternalID: "id",
+			scim:                   true,
+			path:                   "/scim/v2/Groups",
+		},
+		// https://docs.databricks.com/api/account/accountserviceprincipals/list.
+		ServicePrincipal: {
+			uniqueIDAttrExternalID: "id",
+			scim:                   true,
+			path:                   "/scim/v2/ServicePrincipals",
+		},
+		// https://docs.databricks.com/api/account/workspaces/list.
+		Workspace: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/workspaces",
+		},
+		// https://docs.databricks.com/api/account/workspaceassignment/list.
+		// Connection entity for Workspaces <-> Users, Groups and Service Principals, with the permissions of the
+		// principal in the workspace.
+		WorkspacePermissionAssignment: {
+			uniqueIDAttrExternalID: "id",
+			memberOf: func() *string {
+				s := Workspace
+
+				return &s
+			}(),
+			collectionIDAttrExternalID: "id",
+			setMemberAttributes: func(workspaceID string, assignment map[string]any) {
+				principalID, _ := assignment["principalId"].(string)
+
+				assignment["id"] = workspaceID + "-" + principalID
+				assignment["workspaceId"] = workspaceID
+			},
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	// [MemberEntities] The permission assignments are listed one workspace at a time, so set the `CollectionID` to
+	// the ID of the current workspace, and the `CollectionCursor` to the offset of the next one.
+	if entity.memberOf != nil {
+		collectionReq := &Request{
+			BaseURL:               request.BaseURL,
+			AuthorizationHeader:   request.AuthorizationHeader,
+			AccountID:             request.AccountID,
+			PageSize:              1,
+			EntityExternalID:      *entity.memberOf,
+			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		}
+
+		if request.Cursor != nil && request.Cursor.CollectionCursor != nil {
+			collectionReq.Cursor = &pagination.CompositeCursor[int64]{
+				Cursor: request.Cursor.CollectionCursor,
+			}
+		}
+
+		if request.Cursor == nil {
+			request.Cursor = &pagination.CompositeCursor[int64]{}
+		}
+
+		isEmptyLastPage, cursorErr := pagination.UpdateNextCursorFromCollectionAPI(
+			ctx,
+			request.Cursor,
+			func(ctx context.Context, collectionReq *Request) (
+				int, string, []map[string]any, *pagination.CompositeCursor[int64], *framework.Error,
+			) {
+				resp, err := d.GetPage(ctx, collectionReq)
+				if err != nil {
+					return 0, "", nil, nil, err
+				}
+
+				return resp.StatusCode, resp.RetryAfterHeader, resp.Objects, resp.NextCursor, nil
+			},
+			collectionReq,
+			entity.collectionIDAttrExternalID,
+		)
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		if isEmptyLastPage {
+			return &Response{
+				StatusCode: http.StatusOK,
+			}, nil
+		}
+	}
+
+	validationErr := pagination.ValidateCompositeCursor(
+		request.Cursor,
+		request.EntityExternalID,
+		// Send a bool indicating if the entity is a member of a collection.
+		entity.memberOf != nil,
+	)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects      []map[string]any
+		totalResults *int64
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpoint,
+		Header: http.Header{
+			"Authorization": {request.AuthorizationHeader},
+			"Accept":        {"application/json"},
+		},
+		DatasourceName:        "Databricks",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Databricks")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, totalResults, parseErr = ParseResponse(bodyBytes, request.EntityExternalID)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	var nextCursor *int64
+
+	if entity.scim {
+		nextCursor = nextStartIndex(request.Cursor, len(objects), totalResults)
+	} else {
+		// [Workspaces, WorkspacePermissionAssignments] The endpoints aren't paginated, so the page is
+		// taken from all the objects of the response.
+		var paginateErr *framework.Error
+
+		objects, nextCursor, paginateErr = pagination.PaginateObjects(objects, request.PageSize, request.Cursor)
+		if paginateErr != nil {
+			return nil, paginateErr
+		}
+	}
+
+	if nextCursor != nil {
+		response.NextCursor = &pagination.CompositeCursor[int64]{
+			Cursor: nextCursor,
+		}
+	}
+
+	// [MemberEntities] Set `id` and the attribute referencing the collection, and the cursor of the next page of
+	// members, or of the next collection.
+	if entity.memberOf != nil {
+		collectionID := *request.Cursor.CollectionID
+
+		for _, member := range objects {
+			entity.setMemberAttributes(collectionID, member)
+		}
+
+		request.Cursor.Cursor = nextCursor
+		response.NextCursor = nil
+
+		if request.Cursor.Cursor != nil || request.Cursor.CollectionCursor != nil {
+			response.NextCursor = request.Cursor
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// nextStartIndex returns the 1-based start index of the next page of a SCIM entity, or nil if the page is the
+// last one.
+func nextStartIndex(cursor *pagination.CompositeCursor[int64], objectsInPage int, totalResults *int64) *int64 {
+	startIndex := int64(1)
+	if cursor != nil && cursor.Cursor != nil {
+		startIndex = *cursor.Cursor
+	}
+
+	next := startIndex + int64(objectsInPage)
+
+	if objectsInPage == 0 || totalResults == nil || next > *totalResults {
+		return nil
+	}
+
+	return &next
+}
+
+// ParseResponse parses the objects of the entity from a Databricks Account API response, and the total number
+// of objects of the SCIM entities.
+//
+// The numeric IDs of the workspaces and of the principals of the permission assignments are set as strings in
+// the 'id' attribute of the workspaces and the 'principalId' attribute of the permission assignments, so that
+// they can be used as unique IDs and joined with the SCIM IDs of the principals.
+func ParseResponse(body []byte, entityExternalID string) (
+	objects []map[string]any,
+	totalResults *int64,
+	err *framework.Error,
+) {
+	switch entityExternalID {
+	case Workspace:
+		var ids []workspaceID
+
+		if unmarshalErr := httpds.UnmarshalJSON(body, &objects); unmarshalErr != nil {
+			return nil, nil, unmarshalErr
+		}
+
+		if unmarshalErr := httpds.UnmarshalJSON(body, &ids); unmarshalErr != nil {
+			return nil, nil, unmarshalErr
+		}
+
+		for i, object := range objects {
+			if object != nil {
+				object["id"] = ids[i].WorkspaceID.String()
+			}
+		}
+
+		return objects, nil, nil
+	case WorkspacePermissionAssignment:
+		var (
+			data PermissionAssignmentsResponse
+			ids  principalIDs
+		)
+
+		if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+			return nil, nil, unmarshalErr
+		}
+
+		if unmarshalErr := httpds.UnmarshalJSON(body, &ids); unmarshalErr != nil {
+			return nil, nil, unmarshalErr
+		}
+
+		for i, object := range data.PermissionAssignments {
+			if object != nil {
+				object["principalId"] = ids.PermissionAssignments[i].Principal.PrincipalID.String()
+			}
+		}
+
+		return data.PermissionAssignments, nil, nil
+	default:
+		var data SCIMResponse
+
+		if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+			return nil, nil, unmarshalErr
+		}
+
+		return data.Resources, &data.TotalResults, nil
+	}
+}
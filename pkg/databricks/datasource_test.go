@@ -0,0 +1,385 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package databricks_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/databricks"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock Databricks Account API server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	// Token of the sp-client service principal.
+	if r.URL.Path == "/oidc/accounts/acct-1/v1/token" {
+		clientID, clientSecret, _ := r.BasicAuth()
+
+		if r.Method != http.MethodPost || r.PostFormValue("grant_type") != "client_credentials" ||
+			r.PostFormValue("scope") != "all-apis" || clientID != "sp-client" || clientSecret != "sp-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error": "invalid_client", "error_description": "Client authentication failed"}`))
+
+			return
+		}
+
+		w.Write([]byte(`{"access_token": "dbx-test", "token_type": "Bearer", "scope": "all-apis", "expires_in": 3600}`))
+
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer dbx-test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error_code": "UNAUTHENTICATED", "message": "Invalid Token"}`))
+
+		return
+	}
+
+	switch r.URL.RequestURI() {
+	// Users Page 1
+	case "/api/2.0/accounts/acct-1/scim/v2/Users?count=2&startIndex=1":
+		w.Write([]byte(`{"totalResults": 3, "startIndex": 1, "itemsPerPage": 2, "schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"], "Resources": [
+			{"id": "7535194597985784", "userName": "alice@acme.com", "displayName": "Alice", "active": true, "emails": [{"value": "alice@acme.com", "primary": true}]},
+			{"id": "7535194597985785", "userName": "bob@acme.com", "displayName": "Bob", "active": false, "emails": [{"value": "bob@acme.com", "primary": true}]}
+		]}`))
+
+	// Users Page 2
+	case "/api/2.0/accounts/acct-1/scim/v2/Users?count=2&startIndex=3":
+		w.Write([]byte(`{"totalResults": 3, "startIndex": 3, "itemsPerPage": 1, "Resources": [
+			{"id": "7535194597985786", "userName": "carol@acme.com", "displayName": "Carol", "active": true}
+		]}`))
+
+	// Groups
+	case "/api/2.0/accounts/acct-1/scim/v2/Groups?count=2&startIndex=1":
+		w.Write([]byte(`{"totalResults": 1, "startIndex": 1, "itemsPerPage": 1, "Resources": [
+			{"id": "1010", "displayName": "data-engineers", "members": [{"value": "7535194597985784", "display": "Alice"}]}
+		]}`))
+
+	// Service Principals
+	case "/api/2.0/accounts/acct-1/scim/v2/ServicePrincipals?count=2&startIndex=1":
+		w.Write([]byte(`{"totalResults": 0, "startIndex": 1, "itemsPerPage": 0}`))
+
+	// Workspaces
+	case "/api/2.0/accounts/acct-1/workspaces":
+		w.Write([]byte(`[
+			{"workspace_id": 9007199254740993, "workspace_name": "prod", "deployment_name": "acme-prod", "workspace_status": "RUNNING", "creation_time": 1700000000000},
+			{"workspace_id": 1002, "workspace_name": "dev", "deployment_name": "acme-dev", "workspace_status": "RUNNING", "creation_time": 1700000001000}
+		]`))
+
+	// Permission Assignments of 9007199254740993
+	case "/api/2.0/accounts/acct-1/workspaces/9007199254740993/permissionassignments":
+		w.Write([]byte(`{"permission_assignments": [
+			{"permissions": ["ADMIN"], "principal": {"principal_id": 7535194597985784, "display_name": "Alice", "user_name": "alice@acme.com"}},
+			{"permissions": ["USER"], "principal": {"principal_id": 1010, "display_name": "data-engineers", "group_name": "data-engineers"}},
+			{"permissions": ["USER"], "principal": {"principal_id": 7535194597985786, "display_name": "Carol", "user_name": "carol@acme.com"}}
+		]}`))
+
+	// Permission Assignments of 1002
+	case "/api/2.0/accounts/acct-1/workspaces/1002/permissionassignments":
+		w.Write([]byte(`{"permission_assignments": [
+			{"permissions": ["USER"], "principal": {"principal_id": 7535194597985785, "display_name": "Bob", "user_name": "bob@acme.com"}}
+		]}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body             []byte
+		entityExternalID string
+		wantObjects      []map[string]any
+		wantTotalResults *int64
+		wantErr          *framework.Error
+	}{
+		"scim": {
+			body:             []byte(`{"totalResults": 3, "startIndex": 1, "itemsPerPage": 1, "Resources": [{"id": "101"}]}`),
+			entityExternalID: databricks.User,
+			wantObjects:      []map[string]any{{"id": "101"}},
+			wantTotalResults: testutil.GenPtr[int64](3),
+		},
+		"workspaces": {
+			body:             []byte(`[{"workspace_id": 9007199254740993, "workspace_name": "prod"}]`),
+			entityExternalID: databricks.Workspace,
+			wantObjects:      []map[string]any{{"id": "9007199254740993", "workspace_id": float64(9007199254740993), "workspace_name": "prod"}},
+		},
+		"permission_assignments": {
+			body:             []byte(`{"permission_assignments": [{"permissions": ["USER"], "principal": {"principal_id": 7535194597985784}}]}`),
+			entityExternalID: databricks.WorkspacePermissionAssignment,
+			wantObjects:      []map[string]any{{"principalId": "7535194597985784", "permissions": []any{"USER"}, "principal": map[string]any{"principal_id": float64(7535194597985784)}}},
+		},
+		"invalid_response": {
+			body:             []byte(`[]`),
+			entityExternalID: databricks.Group,
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type databricks.SCIMResponse.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotTotalResults, gotErr := databricks.ParseResponse(tt.body, tt.entityExternalID)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotTotalResults, tt.wantTotalResults) {
+				t.Errorf("gotTotalResults: %v, wantTotalResults: %v", gotTotalResults, tt.wantTotalResults)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := databricks.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *databricks.Request
+		wantResponse *databricks.Response
+		wantErr      *framework.Error
+	}{
+		"users_first_page": {
+			request: &databricks.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer dbx-test",
+				AccountID:           "acct-1",
+				PageSize:            2,
+				EntityExternalID:    databricks.User,
+			},
+			wantResponse: &databricks.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "7535194597985784", "userName": "alice@acme.com", "displayName": "Alice", "active": true, "emails": []any{map[string]any{"value": "alice@acme.com", "primary": true}}},
+					{"id": "7535194597985785", "userName": "bob@acme.com", "displayName": "Bob", "active": false, "emails": []any{map[string]any{"value": "bob@acme.com", "primary": true}}},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](3),
+				},
+			},
+		},
+		"users_last_page": {
+			request: &databricks.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer dbx-test",
+				AccountID:           "acct-1",
+				PageSize:            2,
+				EntityExternalID:    databricks.User,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](3),
+				},
+			},
+			wantResponse: &databricks.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "7535194597985786", "userName": "carol@acme.com", "displayName": "Carol", "active": true},
+				},
+			},
+		},
+		"service_principals_empty": {
+			request: &databricks.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer dbx-test",
+				AccountID:           "acct-1",
+				PageSize:            2,
+				EntityExternalID:    databricks.ServicePrincipal,
+			},
+			wantResponse: &databricks.Response{
+				StatusCode: http.StatusOK,
+			},
+		},
+		"workspaces_first_page": {
+			request: &databricks.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer dbx-test",
+				AccountID:           "acct-1",
+				PageSize:            1,
+				EntityExternalID:    databricks.Workspace,
+			},
+			wantResponse: &databricks.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "9007199254740993", "workspace_id": float64(9007199254740993), "workspace_name": "prod", "deployment_name": "acme-prod", "workspace_status": "RUNNING", "creation_time": float64(1700000000000)},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](1),
+				},
+			},
+		},
+		"permission_assignments_first_page": {
+			request: &databricks.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer dbx-test",
+				AccountID:           "acct-1",
+				PageSize:            2,
+				EntityExternalID:    databricks.WorkspacePermissionAssignment,
+			},
+			wantResponse: &databricks.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "9007199254740993-7535194597985784", "workspaceId": "9007199254740993", "principalId": "7535194597985784", "permissions": []any{"ADMIN"}, "principal": map[string]any{"principal_id": float64(7535194597985784), "display_name": "Alice", "user_name": "alice@acme.com"}},
+					{"id": "9007199254740993-1010", "workspaceId": "9007199254740993", "principalId": "1010", "permissions": []any{"USER"}, "principal": map[string]any{"principal_id": float64(1010), "display_name": "data-engineers", "group_name": "data-engineers"}},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor:           testutil.GenPtr[int64](2),
+					CollectionID:     testutil.GenPtr("9007199254740993"),
+					CollectionCursor: testutil.GenPtr[int64](1),
+				},
+			},
+		},
+		"permission_assignments_last_page_of_workspace": {
+			request: &databricks.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer dbx-test",
+				AccountID:           "acct-1",
+				PageSize:            2,
+				EntityExternalID:    databricks.WorkspacePermissionAssignment,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor:           testutil.GenPtr[int64](2),
+					CollectionID:     testutil.GenPtr("9007199254740993"),
+					CollectionCursor: testutil.GenPtr[int64](1),
+				},
+			},
+			wantResponse: &databricks.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "9007199254740993-7535194597985786", "workspaceId": "9007199254740993", "principalId": "7535194597985786", "permissions": []any{"USER"}, "principal": map[string]any{"principal_id": float64(7535194597985786), "display_name": "Carol", "user_name": "carol@acme.com"}},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					CollectionID:     testutil.GenPtr("9007199254740993"),
+					CollectionCursor: testutil.GenPtr[int64](1),
+				},
+			},
+		},
+		"permission_assignments_last_workspace": {
+			request: &databricks.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer dbx-test",
+				AccountID:           "acct-1",
+				PageSize:            2,
+				EntityExternalID:    databricks.WorkspacePermissionAssignment,
+				Cursor: &pagination.CompositeCursor[int64]{
+					CollectionID:     testutil.GenPtr("9007199254740993"),
+					CollectionCursor: testutil.GenPtr[int64](1),
+				},
+			},
+			wantResponse: &databricks.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "1002-7535194597985785", "workspaceId": "1002", "principalId": "7535194597985785", "permissions": []any{"USER"}, "principal": map[string]any{"principal_id": float64(7535194597985785), "display_name": "Bob", "user_name": "bob@acme.com"}},
+				},
+			},
+		},
+		"unauthorized": {
+			request: &databricks.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer dbx-invalid",
+				AccountID:           "acct-1",
+				PageSize:            1,
+				EntityExternalID:    databricks.Group,
+			},
+			wantResponse: &databricks.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetToken(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := databricks.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request   *databricks.TokenRequest
+		wantToken string
+		wantErr   *framework.Error
+	}{
+		"valid_client_credentials": {
+			request: &databricks.TokenRequest{
+				BaseURL:      server.URL,
+				AccountID:    "acct-1",
+				ClientID:     "sp-client",
+				ClientSecret: "sp-secret",
+			},
+			wantToken: "Bearer dbx-test",
+		},
+		"invalid_client_credentials": {
+			request: &databricks.TokenRequest{
+				BaseURL:      server.URL,
+				AccountID:    "acct-1",
+				ClientID:     "sp-client",
+				ClientSecret: "invalid",
+			},
+			wantErr: &framework.Error{
+				Message: "Failed to get an access token for Databricks account acct-1: Failed to authenticate with datasource. Check datasource configuration details and try again.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotToken, gotErr := client.GetToken(context.Background(), tt.request)
+
+			if gotToken != tt.wantToken {
+				t.Errorf("gotToken: %v, wantToken: %v", gotToken, tt.wantToken)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("cached_token", func(t *testing.T) {
+		request := &databricks.TokenRequest{
+			BaseURL:      server.URL,
+			AccountID:    "acct-1",
+			ClientID:     "sp-client",
+			ClientSecret: "sp-secret",
+		}
+
+		if _, err := client.GetToken(context.Background(), request); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// The cached token is returned without requesting the token endpoint.
+		server.Close()
+
+		gotToken, gotErr := client.GetToken(context.Background(), request)
+		if gotErr != nil || gotToken != "Bearer dbx-test" {
+			t.Errorf("gotToken: %v, gotErr: %v, wantToken: Bearer dbx-test", gotToken, gotErr)
+		}
+	})
+}
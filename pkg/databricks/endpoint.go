@@ -0,0 +1,62 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package databricks
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// For example, the endpoint of the second page of 100 users is:
+// https://accounts.cloud.databricks.com/api/2.0/accounts/{accountId}/scim/v2/Users?count=100&startIndex=101.
+// The workspaces and their permission assignments aren't paginated, so their endpoint is the same for all the
+// pages, e.g. https://accounts.cloud.databricks.com/api/2.0/accounts/{accountId}/workspaces/123/permissionassignments.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if entity.memberOf != nil && (request.Cursor == nil || request.Cursor.CollectionID == nil) {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Unable to construct the %s endpoint without a collection ID.", request.EntityExternalID),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	accountURL := request.BaseURL + "/api/2.0/accounts/" + url.PathEscape(request.AccountID)
+
+	switch request.EntityExternalID {
+	case WorkspacePermissionAssignment:
+		return accountURL + "/workspaces/" + url.PathEscape(*request.Cursor.CollectionID) + "/permissionassignments", nil
+	case Workspace:
+		return accountURL + entity.path, nil
+	}
+
+	// [SCIM Entities] The SCIM start index is 1-based.
+	startIndex := int64(1)
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		startIndex = *request.Cursor.Cursor
+	}
+
+	params := url.Values{}
+	params.Set("startIndex", strconv.FormatInt(startIndex, 10))
+	params.Set("count", strconv.FormatInt(request.PageSize, 10))
+
+	return accountURL + entity.path + "?" + params.Encode(), nil
+}
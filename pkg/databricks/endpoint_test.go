@@ -0,0 +1,123 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package databricks_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/databricks"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *databricks.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &databricks.Request{
+				BaseURL:          "https://accounts.cloud.databricks.com",
+				AccountID:        "acct-1",
+				PageSize:         100,
+				EntityExternalID: "Cluster",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"users": {
+			request: &databricks.Request{
+				BaseURL:          "https://accounts.cloud.databricks.com",
+				AccountID:        "acct-1",
+				PageSize:         100,
+				EntityExternalID: databricks.User,
+			},
+			wantEndpoint: "https://accounts.cloud.databricks.com/api/2.0/accounts/acct-1/scim/v2/Users?count=100&startIndex=1",
+		},
+		"groups_next_page": {
+			request: &databricks.Request{
+				BaseURL:          "https://accounts.azuredatabricks.net",
+				AccountID:        "acct-1",
+				PageSize:         100,
+				EntityExternalID: databricks.Group,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](101),
+				},
+			},
+			wantEndpoint: "https://accounts.azuredatabricks.net/api/2.0/accounts/acct-1/scim/v2/Groups?count=100&startIndex=101",
+		},
+		"service_principals": {
+			request: &databricks.Request{
+				BaseURL:          "https://accounts.gcp.databricks.com",
+				AccountID:        "acct-1",
+				PageSize:         10,
+				EntityExternalID: databricks.ServicePrincipal,
+			},
+			wantEndpoint: "https://accounts.gcp.databricks.com/api/2.0/accounts/acct-1/scim/v2/ServicePrincipals?count=10&startIndex=1",
+		},
+		"workspaces_next_page": {
+			request: &databricks.Request{
+				BaseURL:          "https://accounts.cloud.databricks.com",
+				AccountID:        "acct-1",
+				PageSize:         10,
+				EntityExternalID: databricks.Workspace,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](10),
+				},
+			},
+			wantEndpoint: "https://accounts.cloud.databricks.com/api/2.0/accounts/acct-1/workspaces",
+		},
+		"permission_assignments": {
+			request: &databricks.Request{
+				BaseURL:          "https://accounts.cloud.databricks.com",
+				AccountID:        "acct-1",
+				PageSize:         10,
+				EntityExternalID: databricks.WorkspacePermissionAssignment,
+				Cursor: &pagination.CompositeCursor[int64]{
+					CollectionID: testutil.GenPtr("1002"),
+				},
+			},
+			wantEndpoint: "https://accounts.cloud.databricks.com/api/2.0/accounts/acct-1/workspaces/1002/permissionassignments",
+		},
+		"permission_assignments_without_collection_id": {
+			request: &databricks.Request{
+				BaseURL:          "https://accounts.cloud.databricks.com",
+				AccountID:        "acct-1",
+				PageSize:         10,
+				EntityExternalID: databricks.WorkspacePermissionAssignment,
+			},
+			wantErr: &framework.Error{
+				Message: "Unable to construct the WorkspacePermissionAssignment endpoint without a collection ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := databricks.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package databricks_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/databricks"
+)
+
+// FuzzParseResponse checks that malformed Databricks responses are rejected with an error instead of a panic, for all
+// the entities.
+func FuzzParseResponse(f *testing.F) {
+	entityExternalIDs := make([]string, 0, len(databricks.ValidEntityExternalIDs))
+	for entityExternalID := range databricks.ValidEntityExternalIDs {
+		entityExternalIDs = append(entityExternalIDs, entityExternalID)
+	}
+
+	slices.Sort(entityExternalIDs)
+
+	for _, seed := range []string{
+		`{"Resources":[{"id":"4562380201318224","userName":"john.doe@example.com"}],"totalResults":2,"startIndex":1,"itemsPerPage":1}`,
+		`[{"workspace_id":1234567890123456,"workspace_name":"production"}]`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), uint8(0))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, entityIndex uint8) {
+		entityExternalID := entityExternalIDs[int(entityIndex)%len(entityExternalIDs)]
+
+		_, _, err := databricks.ParseResponse(body, entityExternalID)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for entity %s and body %q", entityExternalID, body)
+		}
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package databricks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/auth"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+)
+
+// tokenExpiryLeeway is subtracted from the lifetime of the cached access tokens, so that a token doesn't expire
+// while a page is being requested.
+const tokenExpiryLeeway = time.Minute
+
+// TokenRequest is a request for an account-level access token of a service principal, using the OAuth
+// machine-to-machine (client credentials) flow: https://docs.databricks.com/en/dev-tools/auth/oauth-m2m.html.
+type TokenRequest struct {
+	// BaseURL is the base URL of the Databricks account console, e.g. "https://accounts.cloud.databricks.com".
+	BaseURL string
+
+	// AccountID is the ID of the Databricks account.
+	AccountID string
+
+	// ClientID is the client ID of the service principal, i.e. its application ID.
+	ClientID string
+
+	// ClientSecret is an OAuth secret of the service principal.
+	ClientSecret string
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	RequestTimeoutSeconds int
+}
+
+// tokenResponse is the response of the token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// cachedToken is an access token cached by the Datasource until it expires.
+type cachedToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// tokenCache caches the access tokens of the service principals across pages.
+type tokenCache struct {
+	mu     sync.Mutex
+	tokens map[TokenRequest]cachedToken
+}
+
+func (c *tokenCache) get(request TokenRequest) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, found := c.tokens[request]
+	if !found || !time.Now().Before(cached.expiresAt) {
+		return "", false
+	}
+
+	return cached.token, true
+}
+
+func (c *tokenCache) set(request TokenRequest, token string, expiresIn int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tokens == nil {
+		c.tokens = make(map[TokenRequest]cachedToken)
+	}
+
+	c.tokens[request] = cachedToken{
+		token:     token,
+		expiresAt: time.Now().Add(time.Duration(expiresIn)*time.Second - tokenExpiryLeeway),
+	}
+}
+
+// GetToken returns the Authorization header value, i.e. "Bearer <token>", to query the Account API with the
+// client credentials of the request. Tokens are cached until they expire.
+func (d *Datasource) GetToken(ctx context.Context, request *TokenRequest) (string, *framework.Error) {
+	if token, found := d.tokens.get(*request); found {
+		return token, nil
+	}
+
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+		"scope":      {"all-apis"},
+	}
+
+	var token tokenResponse
+
+	httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+		Method: http.MethodPost,
+		URL:    request.BaseURL + "/oidc/accounts/" + url.PathEscape(request.AccountID) + "/v1/token",
+		Body:   []byte(form.Encode()),
+		Header: http.Header{
+			"Authorization": {auth.BasicAuthHeader(request.ClientID, request.ClientSecret)},
+			"Content-Type":  {"application/x-www-form-urlencoded"},
+		},
+		DatasourceName:        "Databricks token",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, err := httpds.ReadAll(body, "Databricks token")
+		if err != nil {
+			return err
+		}
+
+		return httpds.UnmarshalJSON(bodyBytes, &token)
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if adapterErr := web.HTTPError(httpResponse.StatusCode, httpResponse.RetryAfterHeader); adapterErr != nil {
+		adapterErr.Message = fmt.Sprintf(
+			"Failed to get an access token for Databricks account %s: %s", request.AccountID, adapterErr.Message,
+		)
+
+		return "", adapterErr
+	}
+
+	if token.AccessToken == "" {
+		return "", &framework.Error{
+			Message: fmt.Sprintf(
+				"Databricks token response for account %s is missing an access token.", request.AccountID,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	authorization := "Bearer " + token.AccessToken
+
+	d.tokens.set(*request, authorization, token.ExpiresIn)
+
+	return authorization, nil
+}
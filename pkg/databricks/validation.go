@@ -0,0 +1,103 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package databricks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+
+	"github.com/sgnl-ai/adapters/pkg/validation"
+)
+
+const (
+	// MaxPageSize is the maximum page size allowed in a GetPage request.
+	// https://docs.databricks.com/api/account/accountusers/list. See the "count" query parameter.
+	MaxPageSize = 1000
+)
+
+// ValidateGetPageRequest validates the fields of the GetPage Request.
+func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework.Request[Config]) *framework.Error {
+	if err := request.Config.Validate(ctx); err != nil {
+		return &framework.Error{
+			Message: fmt.Sprintf("Databricks config is invalid: %v.", err.Error()),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	trimmedAddress, parsed, err := validation.ParseAndValidateAddress(request.Address, []string{"https"})
+	if err != nil {
+		return err
+	}
+
+	// Normalize address with https:// scheme if not provided
+	if parsed.Scheme == "" {
+		request.Address = "https://" + trimmedAddress
+	} else {
+		request.Address = trimmedAddress
+	}
+
+	// The Databricks Account API is authenticated with OAuth access tokens. Two types of credentials are supported:
+	// 1. The OAuth client credentials of a service principal with the account admin role - should be supplied as
+	//    request.Auth.Basic, with the client ID as the username and an OAuth secret as the password. They are
+	//    exchanged for access tokens with the OAuth machine-to-machine flow.
+	// 2. An OAuth access token - should be supplied as request.Auth.HTTPAuthorization with prefix "Bearer ".
+	// https://docs.databricks.com/en/dev-tools/auth/oauth-m2m.html.
+	if request.Auth == nil || request.Auth.Basic == nil && request.Auth.HTTPAuthorization == "" {
+		return &framework.Error{
+			Message: "Request to Databricks is missing OAuth client credentials or Bearer token credentials.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	if request.Auth.HTTPAuthorization != "" && !strings.HasPrefix(request.Auth.HTTPAuthorization, "Bearer ") {
+		return &framework.Error{
+			Message: `Provided auth token is missing required "Bearer " prefix.`,
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.Entity.ExternalId]
+	if !found {
+		return &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	var uniqueIDAttributeFound bool
+
+	for _, attribute := range request.Entity.Attributes {
+		if attribute.ExternalId == entity.uniqueIDAttrExternalID {
+			uniqueIDAttributeFound = true
+
+			break
+		}
+	}
+
+	if !uniqueIDAttributeFound {
+		return &framework.Error{
+			Message: "Requested entity attributes are missing unique ID attribute.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if request.Ordered {
+		return &framework.Error{
+			Message: "Ordered must be set to false.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if request.PageSize > MaxPageSize {
+		return &framework.Error{
+			Message: fmt.Sprintf("Provided page size (%d) exceeds the maximum allowed (%d).", request.PageSize, MaxPageSize),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	return nil
+}
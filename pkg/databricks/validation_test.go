@@ -0,0 +1,227 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package databricks_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/databricks"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: databricks.User,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "userName",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	validConfig := &databricks.Config{
+		AccountID: "acct-1",
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[databricks.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request": {
+			request: &framework.Request[databricks.Config]{
+				Address: "accounts.cloud.databricks.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantAddress: "https://accounts.cloud.databricks.com",
+		},
+		"invalid_request_nil_config": {
+			request: &framework.Request[databricks.Config]{
+				Address: "https://accounts.cloud.databricks.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Databricks config is invalid: request contains no config.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_account_id": {
+			request: &framework.Request[databricks.Config]{
+				Address: "https://accounts.cloud.databricks.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Entity:   validEntity,
+				Config:   &databricks.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Databricks config is invalid: accountId is not set.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[databricks.Config]{
+				Address: "http://accounts.cloud.databricks.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"valid_request_client_credentials": {
+			request: &framework.Request[databricks.Config]{
+				Address: "https://accounts.azuredatabricks.net",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "sp-client",
+						Password: "sp-secret",
+					},
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantAddress: "https://accounts.azuredatabricks.net",
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[databricks.Config]{
+				Address:  "https://accounts.cloud.databricks.com",
+				Auth:     &framework.DatasourceAuthCredentials{},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Request to Databricks is missing OAuth client credentials or Bearer token credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[databricks.Config]{
+				Address: "https://accounts.cloud.databricks.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "dbx-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[databricks.Config]{
+				Address: "https://accounts.cloud.databricks.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Cluster",
+				},
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[databricks.Config]{
+				Address: "https://accounts.cloud.databricks.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: databricks.WorkspacePermissionAssignment,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "principalId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[databricks.Config]{
+				Address: "https://accounts.cloud.databricks.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[databricks.Config]{
+				Address: "https://accounts.cloud.databricks.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer dbx-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 1001,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1001) exceeds the maximum allowed (1000).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &databricks.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
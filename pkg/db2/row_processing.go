@@ -8,6 +8,7 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
 	"go.uber.org/zap"
 )
 
@@ -155,9 +156,8 @@ func (p *queryResultProcessor) buildObject(
 			continue
 		}
 
+		// Null values are omitted rather than returned as nil.
 		if val == nil {
-			obj[attrName] = nil
-
 			continue
 		}
 
@@ -166,7 +166,7 @@ func (p *queryResultProcessor) buildObject(
 			return nil, err
 		}
 
-		obj[attrName] = castedVal
+		commonutil.SetAttribute(obj, attrName, castedVal)
 	}
 
 	// Build composite ID if needed
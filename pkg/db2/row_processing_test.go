@@ -356,7 +356,7 @@ func TestBuildObject(t *testing.T) {
 		assert.False(t, salaryExists)
 	})
 
-	t.Run("handles_nil_values", func(t *testing.T) {
+	t.Run("omits_nil_values", func(t *testing.T) {
 		processor := newQueryResultProcessor(
 			[]*framework.AttributeConfig{
 				{ExternalId: "name", Type: framework.AttributeTypeString},
@@ -372,9 +372,8 @@ func TestBuildObject(t *testing.T) {
 		obj, err := processor.buildObject(allColumns)
 
 		require.Nil(t, err)
-		val, exists := obj["name"]
-		assert.True(t, exists)
-		assert.Nil(t, val)
+		_, exists := obj["name"]
+		assert.False(t, exists)
 	})
 
 	t.Run("skips_missing_columns", func(t *testing.T) {
@@ -0,0 +1,406 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package dedup suppresses the objects returned more than once for an entity during a sync, before they reach the
+// framework. Duplicates are common with offset pagination when objects are created or deleted between pages, since
+// the objects then shift across the page boundaries.
+//
+// The objects are identified by the hash of the value of the unique ID attribute of their entity. Entities without
+// a unique ID attribute, and objects without a unique ID value, are never suppressed. Only the top-level objects are
+// deduplicated, their child objects are left unchanged.
+//
+// Adapters don't keep any state between pages, so the hashes of the objects returned so far are kept in memory by
+// the adapters wrapped with NewAdapter, for each sync identified by a random ID carried in the cursors along with
+// the cursor of the wrapped adapter. The duplicates are therefore only suppressed among the pages served by the same
+// replica of the adapter, and the pages of a sync served by another replica, or after a restart, are returned
+// unchanged with a warning that the sync is unknown. The hashes of a sync are forgotten after its last page, or after
+// IdleTimeout without any page.
+//
+// The number of duplicates suppressed is logged with each page, in the duplicateObjectCount field, and for the whole
+// sync with its last page, in the syncDuplicateObjectCount field. These logs are the only signal of the suppressed
+// duplicates: the adapter binaries don't configure any OpenTelemetry meter provider or exporter, so a counter would
+// be dropped, and the counts are aggregated from the log entries instead.
+//
+// The deduplication is enabled per datasource in the datasource config, see config.CommonConfig.
+package dedup
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+const (
+	// CursorPrefix is the prefix of the cursors carrying a sync ID, followed by the base64 encoded JSON State.
+	CursorPrefix = "dedup:"
+
+	// IdleTimeout is the duration after which the hashes of a sync without any page are forgotten.
+	IdleTimeout = time.Hour
+
+	// MaxObjectsPerSync is the maximum number of object hashes kept for a sync, i.e. a few tens of MiB of memory.
+	// Once reached, the objects returned for the first time are no longer recorded, but the objects recorded
+	// so far are still suppressed.
+	MaxObjectsPerSync = 1 << 20
+)
+
+// cursorShape is the expected JSON shape of a State, used in cursor errors.
+const cursorShape = `{"cursor":<string>,"syncId":<string>,"suppressed":<int64>}`
+
+// Provider provides whether the deduplication of the objects is enabled. It is implemented by config.CommonConfig,
+// and therefore by the configs of all adapters.
+type Provider interface {
+	// DeduplicationEnabled returns whether the objects returned more than once during a sync are suppressed.
+	DeduplicationEnabled() bool
+}
+
+// State is the state of the deduplication of a sync, carried in the cursors.
+type State struct {
+	// Cursor is the cursor of the wrapped adapter.
+	Cursor string `json:"cursor,omitempty"`
+
+	// SyncID is the random ID of the sync the hashes of the objects are kept for.
+	SyncID string `json:"syncId"`
+
+	// Suppressed is the number of objects suppressed so far.
+	Suppressed int64 `json:"suppressed,omitempty"`
+}
+
+// MarshalCursor returns the cursor carrying the state.
+func (s *State) MarshalCursor() (string, error) {
+	stateJSON, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+
+	return CursorPrefix + base64.StdEncoding.EncodeToString(stateJSON), nil
+}
+
+// UnmarshalCursor returns the state carried by a cursor. The state of the first page of a sync, or of a cursor
+// without the CursorPrefix, i.e. a cursor returned by the wrapped adapter before the deduplication was enabled,
+// has no sync ID.
+func UnmarshalCursor(cursor, entityExternalID string) (*State, *framework.Error) {
+	encoded, found := strings.CutPrefix(cursor, CursorPrefix)
+	if !found {
+		return &State{Cursor: cursor}, nil
+	}
+
+	stateJSON, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, cursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var state State
+
+	if err := json.Unmarshal(stateJSON, &state); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, cursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	if state.SyncID == "" {
+		return nil, pagination.NewCursorError(entityExternalID, cursorShape, "cursor does not have syncId set")
+	}
+
+	return &state, nil
+}
+
+// syncObjects are the hashes of the objects returned during a sync.
+type syncObjects struct {
+	// pages maps the cursor of each page to its index.
+	pages map[string]uint32
+
+	// objects maps the hash of each object to the index of the page it was first returned in.
+	objects map[uint64]uint32
+
+	lastPage time.Time
+}
+
+// Filter suppresses the objects returned more than once during a sync. It is safe for concurrent use.
+type Filter struct {
+	mu    sync.Mutex
+	syncs map[string]*syncObjects
+	now   func() time.Time
+}
+
+// NewFilter returns an empty Filter.
+func NewFilter() *Filter {
+	return newFilter(time.Now)
+}
+
+func newFilter(now func() time.Time) *Filter {
+	return &Filter{
+		syncs: make(map[string]*syncObjects),
+		now:   now,
+	}
+}
+
+// Filter returns the objects of the page of a sync identified by its cursor that weren't returned in another page
+// of the sync, or earlier in the same page, along with the number of objects suppressed. The objects of a page
+// requested again with the same cursor, e.g. when a page is retried, aren't suppressed.
+func (f *Filter) Filter(
+	syncID, cursor, uniqueIDAttribute string, objects []framework.Object,
+) ([]framework.Object, int64) {
+	// The hashes are computed before locking the filter, so that the pages of concurrent syncs don't wait for
+	// each other.
+	hashes := make([]uint64, len(objects))
+	hashed := make([]bool, len(objects))
+
+	for i, object := range objects {
+		hashes[i], hashed[i] = hash(object[uniqueIDAttribute])
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+
+	f.sweep(now)
+
+	s, found := f.syncs[syncID]
+	if !found {
+		s = &syncObjects{
+			pages:   make(map[string]uint32),
+			objects: make(map[uint64]uint32, len(objects)),
+		}
+		f.syncs[syncID] = s
+	}
+
+	s.lastPage = now
+
+	page, found := s.pages[cursor]
+	if !found {
+		page = uint32(len(s.pages))
+		s.pages[cursor] = page
+	}
+
+	var suppressed int64
+
+	filtered := make([]framework.Object, 0, len(objects))
+	inPage := make(map[uint64]struct{}, len(objects))
+
+	for i, object := range objects {
+		if !hashed[i] {
+			filtered = append(filtered, object)
+
+			continue
+		}
+
+		if _, duplicate := inPage[hashes[i]]; duplicate {
+			suppressed++
+
+			continue
+		}
+
+		inPage[hashes[i]] = struct{}{}
+
+		firstPage, returned := s.objects[hashes[i]]
+		if returned && firstPage != page {
+			suppressed++
+
+			continue
+		}
+
+		if !returned && len(s.objects) < MaxObjectsPerSync {
+			s.objects[hashes[i]] = page
+		}
+
+		filtered = append(filtered, object)
+	}
+
+	return filtered, suppressed
+}
+
+// Tracks returns whether the objects returned during a sync are known, i.e. whether a page of the sync was filtered
+// and the sync wasn't forgotten since.
+func (f *Filter) Tracks(syncID string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, found := f.syncs[syncID]
+
+	return found
+}
+
+// Forget forgets the objects returned during a sync, e.g. after its last page.
+func (f *Filter) Forget(syncID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.syncs, syncID)
+}
+
+// sweep forgets the objects of the syncs without any page for IdleTimeout. The filter must be locked.
+func (f *Filter) sweep(now time.Time) {
+	for syncID, s := range f.syncs {
+		if now.Sub(s.lastPage) >= IdleTimeout {
+			delete(f.syncs, syncID)
+		}
+	}
+}
+
+// hash returns the first 8 bytes of the SHA-256 hash of the JSON encoding of a unique ID value, or false if the
+// value is missing or can't be encoded.
+func hash(value any) (uint64, bool) {
+	if value == nil {
+		return 0, false
+	}
+
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return 0, false
+	}
+
+	sum := sha256.Sum256(valueJSON)
+
+	return binary.BigEndian.Uint64(sum[:8]), true
+}
+
+// uniqueIDAttribute returns the external ID of the unique ID attribute of the entity, or an empty string
+// if it has none.
+func uniqueIDAttribute(entity *framework.EntityConfig) string {
+	for _, attribute := range entity.Attributes {
+		if attribute != nil && attribute.UniqueId {
+			return attribute.ExternalId
+		}
+	}
+
+	return ""
+}
+
+func newSyncID() string {
+	b := make([]byte, 16)
+
+	// rand.Read never returns an error, see its documentation.
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// adapter suppresses the duplicate objects returned by the next adapter.
+type adapter[Config any] struct {
+	next   framework.Adapter[Config]
+	filter *Filter
+}
+
+// NewAdapter wraps an adapter to suppress the objects it returns more than once during a sync. The config of the
+// adapter must implement Provider and enable the deduplication, otherwise the requests and responses are left
+// unchanged.
+func NewAdapter[Config any](next framework.Adapter[Config]) framework.Adapter[Config] {
+	return newAdapter(next, NewFilter())
+}
+
+func newAdapter[Config any](next framework.Adapter[Config], filter *Filter) *adapter[Config] {
+	return &adapter[Config]{
+		next:   next,
+		filter: filter,
+	}
+}
+
+// GetPage implements framework.Adapter.
+func (a *adapter[Config]) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	var provider Provider
+
+	if request.Config != nil {
+		provider, _ = any(request.Config).(Provider)
+	}
+
+	attribute := uniqueIDAttribute(&request.Entity)
+
+	if provider == nil || !provider.DeduplicationEnabled() || attribute == "" {
+		// The deduplication may have been disabled during the sync, in which case the cursor of the wrapped
+		// adapter must still be unwrapped.
+		if !strings.HasPrefix(request.Cursor, CursorPrefix) {
+			return a.next.GetPage(ctx, request)
+		}
+
+		state, cursorErr := UnmarshalCursor(request.Cursor, request.Entity.ExternalId)
+		if cursorErr != nil {
+			return framework.NewGetPageResponseError(cursorErr)
+		}
+
+		a.filter.Forget(state.SyncID)
+
+		nextRequest := *request
+		nextRequest.Cursor = state.Cursor
+
+		return a.next.GetPage(ctx, &nextRequest)
+	}
+
+	state, cursorErr := UnmarshalCursor(request.Cursor, request.Entity.ExternalId)
+	if cursorErr != nil {
+		return framework.NewGetPageResponseError(cursorErr)
+	}
+
+	if state.SyncID == "" {
+		state.SyncID = newSyncID()
+	} else if !a.filter.Tracks(state.SyncID) {
+		// The earlier pages of the sync were served by another replica, or before a restart, or too long ago.
+		zaplogger.FromContext(ctx).Warn("Unknown deduplication sync, the objects returned by its earlier pages "+
+			"aren't suppressed",
+			fields.RequestEntityExternalID(request.Entity.ExternalId),
+		)
+	}
+
+	nextRequest := *request
+	nextRequest.Cursor = state.Cursor
+
+	response := a.next.GetPage(ctx, &nextRequest)
+	if response.Success == nil {
+		return response
+	}
+
+	objects, suppressed := a.filter.Filter(state.SyncID, state.Cursor, attribute, response.Success.Objects)
+
+	response.Success.Objects = objects
+	state.Suppressed += suppressed
+
+	if suppressed > 0 {
+		zaplogger.FromContext(ctx).Warn("Suppressed duplicate objects",
+			fields.RequestEntityExternalID(request.Entity.ExternalId),
+			fields.DuplicateObjectCount(suppressed),
+			fields.SyncDuplicateObjectCount(state.Suppressed),
+		)
+	}
+
+	if response.Success.NextCursor == "" {
+		a.filter.Forget(state.SyncID)
+
+		if state.Suppressed > 0 {
+			zaplogger.FromContext(ctx).Info("Suppressed duplicate objects during the sync",
+				fields.RequestEntityExternalID(request.Entity.ExternalId),
+				fields.SyncDuplicateObjectCount(state.Suppressed),
+			)
+		}
+
+		return response
+	}
+
+	state.Cursor = response.Success.NextCursor
+
+	nextCursor, err := state.MarshalCursor()
+	if err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: fmt.Sprintf("Failed to marshal the deduplication cursor: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		})
+	}
+
+	response.Success.NextCursor = nextCursor
+
+	return response
+}
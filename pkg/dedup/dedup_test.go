@@ -0,0 +1,437 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package dedup
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+var testEntity = framework.EntityConfig{
+	ExternalId: "User",
+	Attributes: []*framework.AttributeConfig{
+		{ExternalId: "email", Type: framework.AttributeTypeString},
+		{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+	},
+}
+
+// page is a page of a sync requested with a cursor.
+type page struct {
+	cursor         string
+	objects        []framework.Object
+	wantObjects    []framework.Object
+	wantSuppressed int64
+}
+
+func TestFilter(t *testing.T) {
+	tests := map[string]struct {
+		pages []page
+	}{
+		"no_duplicates": {
+			pages: []page{
+				{
+					objects:     []framework.Object{{"id": "00u1"}, {"id": "00u2"}},
+					wantObjects: []framework.Object{{"id": "00u1"}, {"id": "00u2"}},
+				},
+				{
+					cursor:      "2",
+					objects:     []framework.Object{{"id": "00u3"}},
+					wantObjects: []framework.Object{{"id": "00u3"}},
+				},
+			},
+		},
+		"duplicates_across_pages": {
+			pages: []page{
+				{
+					objects:     []framework.Object{{"id": "00u1"}, {"id": "00u2"}},
+					wantObjects: []framework.Object{{"id": "00u1"}, {"id": "00u2"}},
+				},
+				{
+					// 00u1 was deleted, so 00u2 shifted to the second page.
+					cursor:         "2",
+					objects:        []framework.Object{{"id": "00u2"}, {"id": "00u3"}},
+					wantObjects:    []framework.Object{{"id": "00u3"}},
+					wantSuppressed: 1,
+				},
+				{
+					cursor:         "4",
+					objects:        []framework.Object{{"id": "00u3"}, {"id": "00u1"}},
+					wantObjects:    []framework.Object{},
+					wantSuppressed: 2,
+				},
+			},
+		},
+		"duplicates_in_page": {
+			pages: []page{
+				{
+					objects:        []framework.Object{{"id": "00u1"}, {"id": "00u1", "email": "john@example.com"}},
+					wantObjects:    []framework.Object{{"id": "00u1"}},
+					wantSuppressed: 1,
+				},
+			},
+		},
+		"retried_page": {
+			pages: []page{
+				{
+					objects:     []framework.Object{{"id": "00u1"}},
+					wantObjects: []framework.Object{{"id": "00u1"}},
+				},
+				{
+					cursor:      "1",
+					objects:     []framework.Object{{"id": "00u2"}},
+					wantObjects: []framework.Object{{"id": "00u2"}},
+				},
+				{
+					// The second page is requested again, e.g. after the response failed to be delivered.
+					cursor:      "1",
+					objects:     []framework.Object{{"id": "00u2"}, {"id": "00u3"}},
+					wantObjects: []framework.Object{{"id": "00u2"}, {"id": "00u3"}},
+				},
+			},
+		},
+		"unique_id_types": {
+			pages: []page{
+				{
+					objects:     []framework.Object{{"id": int64(1)}, {"id": "1"}},
+					wantObjects: []framework.Object{{"id": int64(1)}, {"id": "1"}},
+				},
+				{
+					cursor:         "2",
+					objects:        []framework.Object{{"id": int64(1)}},
+					wantObjects:    []framework.Object{},
+					wantSuppressed: 1,
+				},
+			},
+		},
+		"missing_unique_id": {
+			pages: []page{
+				{
+					objects:     []framework.Object{{"email": "john@example.com"}, {"id": nil}},
+					wantObjects: []framework.Object{{"email": "john@example.com"}, {"id": nil}},
+				},
+				{
+					cursor:      "2",
+					objects:     []framework.Object{{"email": "john@example.com"}, {"id": nil}},
+					wantObjects: []framework.Object{{"email": "john@example.com"}, {"id": nil}},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			filter := NewFilter()
+
+			for i, page := range tt.pages {
+				gotObjects, gotSuppressed := filter.Filter("sync", page.cursor, "id", page.objects)
+
+				if !reflect.DeepEqual(gotObjects, page.wantObjects) {
+					t.Errorf("page %d: gotObjects: %v, wantObjects: %v", i, gotObjects, page.wantObjects)
+				}
+
+				if gotSuppressed != page.wantSuppressed {
+					t.Errorf("page %d: gotSuppressed: %d, wantSuppressed: %d", i, gotSuppressed, page.wantSuppressed)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterSyncs(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	filter := newFilter(func() time.Time { return now })
+
+	objects := []framework.Object{{"id": "00u1"}}
+
+	filter.Filter("sync1", "", "id", objects)
+
+	// The objects of another sync aren't suppressed.
+	if _, suppressed := filter.Filter("sync2", "", "id", objects); suppressed != 0 {
+		t.Errorf("sync2: gotSuppressed: %d, wantSuppressed: 0", suppressed)
+	}
+
+	// The objects of a forgotten sync aren't suppressed.
+	filter.Forget("sync2")
+
+	if _, suppressed := filter.Filter("sync2", "2", "id", objects); suppressed != 0 {
+		t.Errorf("forgotten sync2: gotSuppressed: %d, wantSuppressed: 0", suppressed)
+	}
+
+	now = now.Add(IdleTimeout / 2)
+
+	if _, suppressed := filter.Filter("sync1", "2", "id", objects); suppressed != 1 {
+		t.Errorf("sync1: gotSuppressed: %d, wantSuppressed: 1", suppressed)
+	}
+
+	// sync2 is idle and forgotten, but not sync1.
+	now = now.Add(IdleTimeout / 2)
+
+	filter.Filter("sync3", "", "id", objects)
+
+	if _, found := filter.syncs["sync2"]; found {
+		t.Errorf("idle sync2 not forgotten")
+	}
+
+	if _, found := filter.syncs["sync1"]; !found {
+		t.Errorf("sync1 forgotten")
+	}
+}
+
+func TestFilterConcurrent(t *testing.T) {
+	filter := NewFilter()
+
+	var wg sync.WaitGroup
+
+	suppressed := make([]int64, 8)
+
+	// Each worker requests all the pages of its own sync, and the same pages of a shared sync.
+	for worker := range suppressed {
+		wg.Go(func() {
+			for i := range 100 {
+				cursor := fmt.Sprintf("%d", i)
+				objects := []framework.Object{{"id": fmt.Sprintf("%d", i)}, {"id": fmt.Sprintf("%d", i+1)}}
+
+				_, got := filter.Filter(fmt.Sprintf("sync%d", worker), cursor, "id", objects)
+				suppressed[worker] += got
+
+				filter.Filter("shared", cursor, "id", objects)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	// The first object of each page after the first one was the second object of the previous page.
+	for worker, got := range suppressed {
+		if got != 99 {
+			t.Errorf("worker %d: gotSuppressed: %d, wantSuppressed: 99", worker, got)
+		}
+	}
+}
+
+func TestNewAdapter(t *testing.T) {
+	next := &testutil.Adapter{
+		Pages: map[string]*framework.Page{
+			"": {
+				Objects:    []framework.Object{{"id": "00u1"}, {"id": "00u2"}},
+				NextCursor: "2",
+			},
+			"2": {
+				Objects:    []framework.Object{{"id": "00u2"}, {"id": "00u3"}},
+				NextCursor: "4",
+			},
+			"4": {
+				Objects: []framework.Object{{"id": "00u1"}, {"id": "00u4"}},
+			},
+		},
+	}
+
+	enabled := &testutil.Config{CommonConfig: &config.CommonConfig{DeduplicateObjects: true}}
+
+	t.Run("enabled", func(t *testing.T) {
+		adapter := newAdapter[testutil.Config](next, NewFilter())
+
+		ctx, observedLogs := testutil.NewContextWithObservableLogger(context.Background())
+
+		var gotObjects []framework.Object
+
+		cursor := ""
+
+		for range 3 {
+			response := adapter.GetPage(ctx, &framework.Request[testutil.Config]{Config: enabled, Entity: testEntity, Cursor: cursor})
+			if response.Error != nil {
+				t.Fatalf("unexpected error: %v", response.Error)
+			}
+
+			gotObjects = append(gotObjects, response.Success.Objects...)
+			cursor = response.Success.NextCursor
+		}
+
+		if cursor != "" {
+			t.Errorf("gotNextCursor: %q, wantNextCursor: \"\"", cursor)
+		}
+
+		wantObjects := []framework.Object{{"id": "00u1"}, {"id": "00u2"}, {"id": "00u3"}, {"id": "00u4"}}
+
+		if !reflect.DeepEqual(gotObjects, wantObjects) {
+			t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, wantObjects)
+		}
+
+		if len(adapter.filter.syncs) != 0 {
+			t.Errorf("sync not forgotten after the last page: %v", adapter.filter.syncs)
+		}
+
+		testutil.ValidateLogOutput(t, observedLogs, []map[string]any{
+			{
+				"level":                              "warn",
+				"msg":                                "Suppressed duplicate objects",
+				fields.FieldRequestEntityExternalID:  "User",
+				fields.FieldDuplicateObjectCount:     int64(1),
+				fields.FieldSyncDuplicateObjectCount: int64(1),
+			},
+			{
+				"level":                              "warn",
+				"msg":                                "Suppressed duplicate objects",
+				fields.FieldRequestEntityExternalID:  "User",
+				fields.FieldDuplicateObjectCount:     int64(1),
+				fields.FieldSyncDuplicateObjectCount: int64(2),
+			},
+			{
+				"level":                              "info",
+				"msg":                                "Suppressed duplicate objects during the sync",
+				fields.FieldRequestEntityExternalID:  "User",
+				fields.FieldSyncDuplicateObjectCount: int64(2),
+			},
+		})
+	})
+
+	t.Run("enabled_during_sync", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		// The cursor of the wrapped adapter, returned before the deduplication was enabled, starts a new sync ID.
+		response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{Config: enabled, Entity: testEntity, Cursor: "2"})
+		if response.Error != nil {
+			t.Fatalf("unexpected error: %v", response.Error)
+		}
+
+		wantObjects := []framework.Object{{"id": "00u2"}, {"id": "00u3"}}
+
+		if !reflect.DeepEqual(response.Success.Objects, wantObjects) {
+			t.Errorf("gotObjects: %v, wantObjects: %v", response.Success.Objects, wantObjects)
+		}
+
+		state, cursorErr := UnmarshalCursor(response.Success.NextCursor, "User")
+		if cursorErr != nil {
+			t.Fatalf("unexpected error: %v", cursorErr)
+		}
+
+		if state.Cursor != "4" || state.SyncID == "" {
+			t.Errorf("gotState: %+v, want cursor 4 with a sync ID", state)
+		}
+	})
+
+	t.Run("unknown_sync", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		ctx, observedLogs := testutil.NewContextWithObservableLogger(context.Background())
+
+		// The earlier pages of the sync were served by another replica.
+		state := &State{Cursor: "4", SyncID: "sync"}
+
+		cursor, err := state.MarshalCursor()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		response := adapter.GetPage(ctx, &framework.Request[testutil.Config]{Config: enabled, Entity: testEntity, Cursor: cursor})
+		if response.Error != nil {
+			t.Fatalf("unexpected error: %v", response.Error)
+		}
+
+		wantObjects := []framework.Object{{"id": "00u1"}, {"id": "00u4"}}
+
+		if !reflect.DeepEqual(response.Success.Objects, wantObjects) {
+			t.Errorf("gotObjects: %v, wantObjects: %v", response.Success.Objects, wantObjects)
+		}
+
+		testutil.ValidateLogOutput(t, observedLogs, []map[string]any{
+			{
+				"level":                             "warn",
+				"msg":                               "Unknown deduplication sync, the objects returned by its earlier pages aren't suppressed",
+				fields.FieldRequestEntityExternalID: "User",
+			},
+		})
+	})
+
+	t.Run("disabled_during_sync", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		state := &State{Cursor: "4", SyncID: "sync", Suppressed: 1}
+
+		cursor, err := state.MarshalCursor()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{Config: &testutil.Config{}, Entity: testEntity, Cursor: cursor})
+
+		wantResponse := framework.NewGetPageResponseSuccess(&framework.Page{
+			Objects: []framework.Object{{"id": "00u1"}, {"id": "00u4"}},
+		})
+
+		if !reflect.DeepEqual(response, wantResponse) {
+			t.Errorf("gotResponse: %v, wantResponse: %v", response, wantResponse)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{Config: &testutil.Config{}, Entity: testEntity, Cursor: "2"})
+
+		wantResponse := framework.NewGetPageResponseSuccess(&framework.Page{
+			Objects:    []framework.Object{{"id": "00u2"}, {"id": "00u3"}},
+			NextCursor: "4",
+		})
+
+		if !reflect.DeepEqual(response, wantResponse) {
+			t.Errorf("gotResponse: %v, wantResponse: %v", response, wantResponse)
+		}
+	})
+
+	t.Run("no_unique_id_attribute", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		entity := framework.EntityConfig{
+			ExternalId: "User",
+			Attributes: []*framework.AttributeConfig{{ExternalId: "id", Type: framework.AttributeTypeString}},
+		}
+
+		response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{Config: enabled, Entity: entity, Cursor: "2"})
+
+		if response.Success == nil || response.Success.NextCursor != "4" {
+			t.Errorf("gotResponse: %v, want the unchanged response of the wrapped adapter", response)
+		}
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{Config: enabled, Entity: testEntity, Cursor: CursorPrefix + "e30="})
+
+		wantErr := &framework.Error{
+			Message: `Invalid cursor for entity User: cursor does not have syncId set. Expected cursor shape: {"cursor":<string>,"syncId":<string>,"suppressed":<int64>}. Restart the sync for this entity to discard the invalid cursor.`,
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+
+		if !reflect.DeepEqual(response.Error, wantErr) {
+			t.Errorf("gotErr: %v, wantErr: %v", response.Error, wantErr)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{Config: enabled, Entity: testEntity, Cursor: "unknown"})
+
+		wantErr := &framework.Error{
+			Message: "Datasource failed.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+
+		if !reflect.DeepEqual(response.Error, wantErr) {
+			t.Errorf("gotErr: %v, wantErr: %v", response.Error, wantErr)
+		}
+	})
+}
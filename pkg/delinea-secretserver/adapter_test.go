@@ -0,0 +1,265 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package delineasecretserver_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	delineasecretserver "github.com/sgnl-ai/adapters/pkg/delinea-secretserver"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := delineasecretserver.NewAdapter(&delineasecretserver.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[string]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	basicAuth := &framework.DatasourceAuthCredentials{
+		Basic: &framework.BasicAuthCredentials{
+			Username: "svc-sgnl",
+			Password: "secret",
+		},
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[delineasecretserver.Config]
+		wantResponse framework.Response
+	}{
+		"users_first_page": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: server.URL,
+				Auth:    basicAuth,
+				Config:  &delineasecretserver.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: delineasecretserver.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "userName",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "enabled",
+							Type:       framework.AttributeTypeBool,
+						},
+						{
+							ExternalId: "created",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":       int64(2),
+							"userName": "alice",
+							"enabled":  true,
+							"created":  time.Date(2024, 3, 5, 14, 12, 37, 123000000, time.UTC),
+						},
+						{
+							"id":       int64(5),
+							"userName": "bob",
+							"enabled":  false,
+							"created":  time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						Cursor: testutil.GenPtr("2"),
+					}),
+				},
+			},
+		},
+		"role_assignments_domain_user": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: server.URL,
+				Auth:    basicAuth,
+				Config: &delineasecretserver.Config{
+					Domain: "ACME",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: delineasecretserver.RoleAssignment,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "roleId",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "groupId",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":      "1-12",
+							"roleId":  int64(1),
+							"groupId": int64(12),
+						},
+						{
+							"id":      "1-20",
+							"roleId":  int64(1),
+							"groupId": int64(20),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						CollectionID:     testutil.GenPtr("1"),
+						CollectionCursor: testutil.GenPtr("1"),
+					}),
+				},
+			},
+		},
+		"folder_permissions_bearer_token": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer ss-test",
+				},
+				Config: &delineasecretserver.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: delineasecretserver.FolderPermission,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "folderId",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "groupId",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "userId",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "folderAccessRoleName",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                   int64(31),
+							"folderId":             int64(5),
+							"groupId":              int64(12),
+							"folderAccessRoleName": "Owner",
+						},
+						{
+							"id":                   int64(32),
+							"folderId":             int64(5),
+							"groupId":              int64(20),
+							"userId":               int64(2),
+							"folderAccessRoleName": "View",
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						CollectionID:     testutil.GenPtr("5"),
+						CollectionCursor: testutil.GenPtr("1"),
+					}),
+				},
+			},
+		},
+		"invalid_password": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "svc-sgnl",
+						Password: "invalid",
+					},
+				},
+				Config: &delineasecretserver.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: delineasecretserver.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to get an access token for Delinea Secret Server user svc-sgnl: invalid username or password.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer ss-invalid",
+				},
+				Config: &delineasecretserver.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: delineasecretserver.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
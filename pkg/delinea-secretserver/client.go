@@ -0,0 +1,63 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package delineasecretserver
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Client is a client that allows querying the Delinea Secret Server datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+
+	// GetToken returns the Authorization header value to query the REST API with the username and password of a
+	// Secret Server user.
+	GetToken(ctx context.Context, request *TokenRequest) (string, *framework.Error)
+}
+
+// Request is a request to the Secret Server REST API.
+type Request struct {
+	// BaseURL is the base URL of the Secret Server instance, e.g. "https://acme.secretservercloud.com" or
+	// "https://pam.acme.com/SecretServer".
+	BaseURL string
+
+	// AuthorizationHeader is the Authorization header value to authenticate a request: the Bearer token of an
+	// access token of a user with the permissions to view the users, groups, roles and folders. The access tokens
+	// of the usernames and passwords are requested with GetToken.
+	AuthorizationHeader string
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity. The cursor is the number of objects to skip, i.e. the "skip" parameter.
+	// nil in the request for the first page.
+	Cursor *pagination.CompositeCursor[string]
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *pagination.CompositeCursor[string]
+}
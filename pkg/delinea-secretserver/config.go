@@ -0,0 +1,38 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package delineasecretserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Delinea Secret Server Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "domain": "ACME"
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// Domain is the Active Directory domain of the user of the Basic credentials, if it's a domain user rather than
+	// a local Secret Server user. It's sent with the username and password to request the access tokens.
+	Domain string `json:"domain,omitempty"`
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	if c == nil {
+		return errors.New("request contains no config")
+	}
+
+	return c.CommonConfig.ValidateSyncMode()
+}
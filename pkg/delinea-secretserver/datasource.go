@@ -0,0 +1,316 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package delineasecretserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+
+	// tokens caches the access tokens of the usernames and passwords.
+	tokens tokenCache
+}
+
+// ListResponse is the format of the paged list responses of the REST API, e.g. PagingOfUserSummary.
+// https://updates.thycotic.net/secretserver/restapiguide/TokenAuth/.
+type ListResponse struct {
+	Records  []map[string]any `json:"records"`
+	HasNext  bool             `json:"hasNext"`
+	NextSkip int64            `json:"nextSkip"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// path is the path of the endpoint of the entity, relative to the REST API, or to the collection object
+	// for the member entities listed with a path parameter.
+	path string
+	// includeInactive is whether the inactive objects must be requested with the "filter.includeInactive"
+	// parameter, as Secret Server only returns the active users, groups and roles by default.
+	includeInactive bool
+	// memberOf is the external ID of the collection entity the entity is listed for, if any.
+	memberOf *string
+	// collectionParam is the query parameter of the ID of the collection object, for the member entities listed
+	// with a filter rather than a path parameter.
+	collectionParam string
+	// setMemberAttributes sets the unique ID of an object listed for the collection, if it doesn't have one,
+	// and the attribute referencing the collection.
+	setMemberAttributes func(collectionID string, object map[string]any)
+}
+
+const (
+	User             string = "User"
+	Group            string = "Group"
+	Role             string = "Role"
+	RoleAssignment   string = "RoleAssignment"
+	Folder           string = "Folder"
+	FolderPermission string = "FolderPermission"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// GET /api/v1/users.
+		User: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/users",
+			includeInactive:        true,
+		},
+		// GET /api/v1/groups.
+		Group: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/groups",
+			includeInactive:        true,
+		},
+		// GET /api/v1/roles.
+		Role: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/roles",
+			includeInactive:        true,
+		},
+		// GET /api/v1/roles/{roleId}/groups.
+		// Connection entity for Roles <-> Groups, listing the groups assigned to each role. The roles of a user are
+		// assigned to the personal group of the user.
+		RoleAssignment: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/groups",
+			memberOf: func() *string {
+				s := Role
+
+				return &s
+			}(),
+			setMemberAttributes: func(roleID string, assignment map[string]any) {
+				groupID := assignment["id"]
+
+				assignment["id"] = roleID + "-" + commonutil.FormatID(groupID)
+				assignment["groupId"] = groupID
+				assignment["roleId"] = parseID(roleID)
+			},
+		},
+		// GET /api/v1/folders.
+		Folder: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/folders",
+		},
+		// GET /api/v1/folder-permissions?filter.folderId={folderId}.
+		// Connection entity for Folders <-> Users and Groups, with the folder and secret access roles of each user
+		// or group on the folder.
+		FolderPermission: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/folder-permissions",
+			memberOf: func() *string {
+				s := Folder
+
+				return &s
+			}(),
+			collectionParam: "filter.folderId",
+			setMemberAttributes: func(folderID string, permission map[string]any) {
+				permission["folderId"] = parseID(folderID)
+			},
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	// [MemberEntities] The members are listed one collection object at a time, e.g. the groups assigned to a
+	// role, so set the `CollectionID` to the ID of the current collection object, and the `CollectionCursor` to
+	// the cursor of the next one.
+	if entity.memberOf != nil {
+		collectionReq := &Request{
+			BaseURL:               request.BaseURL,
+			AuthorizationHeader:   request.AuthorizationHeader,
+			PageSize:              1,
+			EntityExternalID:      *entity.memberOf,
+			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		}
+
+		if request.Cursor != nil && request.Cursor.CollectionCursor != nil {
+			collectionReq.Cursor = &pagination.CompositeCursor[string]{
+				Cursor: request.Cursor.CollectionCursor,
+			}
+		}
+
+		if request.Cursor == nil {
+			request.Cursor = &pagination.CompositeCursor[string]{}
+		}
+
+		isEmptyLastPage, cursorErr := pagination.UpdateNextCursorFromCollectionAPI(
+			ctx,
+			request.Cursor,
+			func(ctx context.Context, collectionReq *Request) (
+				int, string, []map[string]any, *pagination.CompositeCursor[string], *framework.Error,
+			) {
+				resp, err := d.GetPage(ctx, collectionReq)
+				if err != nil {
+					return 0, "", nil, nil, err
+				}
+
+				// The IDs of the roles and folders are numbers, but the collection ID is a string.
+				collections := make([]map[string]any, 0, len(resp.Objects))
+
+				for _, collection := range resp.Objects {
+					collections = append(collections, map[string]any{"id": commonutil.FormatID(collection["id"])})
+				}
+
+				return resp.StatusCode, resp.RetryAfterHeader, collections, resp.NextCursor, nil
+			},
+			collectionReq,
+			"id",
+		)
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		if isEmptyLastPage {
+			return &Response{
+				StatusCode: http.StatusOK,
+			}, nil
+		}
+	}
+
+	validationErr := pagination.ValidateCompositeCursor(
+		request.Cursor,
+		request.EntityExternalID,
+		// Send a bool indicating if the entity is a member of a collection.
+		entity.memberOf != nil,
+	)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects        []map[string]any
+		nextPageCursor *string
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpoint,
+		Header: http.Header{
+			"Authorization": {request.AuthorizationHeader},
+			"Accept":        {"application/json"},
+		},
+		DatasourceName:        "Delinea Secret Server",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Delinea Secret Server")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, nextPageCursor, parseErr = ParseResponse(bodyBytes)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	if nextPageCursor != nil {
+		response.NextCursor = &pagination.CompositeCursor[string]{
+			Cursor: nextPageCursor,
+		}
+	}
+
+	// [MemberEntities] Set the attribute referencing the collection, and the cursor of the next page of members,
+	// or of the next collection object.
+	if entity.memberOf != nil {
+		collectionID := *request.Cursor.CollectionID
+
+		for _, member := range objects {
+			entity.setMemberAttributes(collectionID, member)
+		}
+
+		request.Cursor.Cursor = nextPageCursor
+		response.NextCursor = nil
+
+		if request.Cursor.Cursor != nil || request.Cursor.CollectionCursor != nil {
+			response.NextCursor = request.Cursor
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the records of a Secret Server REST API paged list response, and the number of objects to
+// skip to request the next page, if any.
+func ParseResponse(body []byte) (objects []map[string]any, nextCursor *string, err *framework.Error) {
+	var data ListResponse
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	if !data.HasNext {
+		return data.Records, nil, nil
+	}
+
+	skip := strconv.FormatInt(data.NextSkip, 10)
+
+	return data.Records, &skip, nil
+}
+
+// parseID parses a numeric ID formatted by commonutil.FormatID, so that the IDs of the collections have the same type
+// as the ID of the collection objects. IDs that aren't numbers are returned as is.
+func parseID(id string) any {
+	if v, err := strconv.ParseInt(id, 10, 64); err == nil {
+		return float64(v)
+	}
+
+	return id
+}
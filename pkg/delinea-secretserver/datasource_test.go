@@ -0,0 +1,368 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package delineasecretserver_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	delineasecretserver "github.com/sgnl-ai/adapters/pkg/delinea-secretserver"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock Secret Server REST API server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	// Token of the svc-sgnl user, either a local user or a user of the ACME domain.
+	if r.URL.Path == "/oauth2/token" {
+		if r.Method != http.MethodPost || r.PostFormValue("grant_type") != "password" ||
+			r.PostFormValue("username") != "svc-sgnl" || r.PostFormValue("password") != "secret" ||
+			(r.PostFormValue("domain") != "" && r.PostFormValue("domain") != "ACME") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "invalid_grant"}`))
+
+			return
+		}
+
+		w.Write([]byte(`{"access_token": "ss-test", "token_type": "bearer", "expires_in": 1199, "refresh_token": "ss-refresh"}`))
+
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer ss-test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message": "Authentication failed."}`))
+
+		return
+	}
+
+	switch r.URL.RequestURI() {
+	// Users Page 1
+	case "/api/v1/users?filter.includeInactive=true&skip=0&take=2":
+		w.Write([]byte(`{"skip": 0, "take": 2, "total": 3, "hasNext": true, "nextSkip": 2, "records": [
+			{"id": 2, "userName": "alice", "displayName": "Alice", "emailAddress": "alice@acme.com", "enabled": true, "created": "2024-03-05T14:12:37.123", "domainId": -1},
+			{"id": 5, "userName": "bob", "displayName": "Bob", "emailAddress": "bob@acme.com", "enabled": false, "created": "2024-03-06T09:00:00", "domainId": 1}
+		], "success": true, "severity": "None"}`))
+
+	// Users Page 2
+	case "/api/v1/users?filter.includeInactive=true&skip=2&take=2":
+		w.Write([]byte(`{"skip": 2, "take": 2, "total": 3, "hasNext": false, "nextSkip": 4, "records": [
+			{"id": 9, "userName": "carol", "displayName": "Carol", "emailAddress": "carol@acme.com", "enabled": true, "created": "2024-03-07T09:00:00", "domainId": -1}
+		], "success": true, "severity": "None"}`))
+
+	// Groups
+	case "/api/v1/groups?filter.includeInactive=true&skip=0&take=2":
+		w.Write([]byte(`{"skip": 0, "take": 2, "total": 2, "hasNext": false, "records": [
+			{"id": 3, "name": "Everyone", "enabled": true, "domainId": null, "domainName": null},
+			{"id": 12, "name": "Vault Admins", "enabled": true, "domainId": 1, "domainName": "ACME"}
+		]}`))
+
+	// Roles, one at a time, listed for the role assignments
+	case "/api/v1/roles?filter.includeInactive=true&skip=0&take=1":
+		w.Write([]byte(`{"skip": 0, "take": 1, "total": 2, "hasNext": true, "nextSkip": 1, "records": [{"id": 1, "name": "Administrator", "enabled": true}]}`))
+	case "/api/v1/roles?filter.includeInactive=true&skip=1&take=1":
+		w.Write([]byte(`{"skip": 1, "take": 1, "total": 2, "hasNext": false, "nextSkip": 2, "records": [{"id": 2, "name": "User", "enabled": true}]}`))
+
+	// Role Assignments of role 1
+	case "/api/v1/roles/1/groups?skip=0&take=2":
+		w.Write([]byte(`{"skip": 0, "take": 2, "total": 2, "hasNext": false, "records": [
+			{"id": 12, "name": "Vault Admins", "enabled": true},
+			{"id": 20, "name": "alice", "enabled": true, "isPersonal": true}
+		]}`))
+
+	// Role Assignments of role 2
+	case "/api/v1/roles/2/groups?skip=0&take=2":
+		w.Write([]byte(`{"skip": 0, "take": 2, "total": 0, "hasNext": false, "records": []}`))
+
+	// Folders, one at a time, listed for the folder permissions
+	case "/api/v1/folders?skip=0&take=1":
+		w.Write([]byte(`{"skip": 0, "take": 1, "total": 2, "hasNext": true, "nextSkip": 1, "records": [{"id": 5, "folderName": "Infrastructure", "folderPath": "\\Infrastructure", "parentFolderId": -1, "inheritPermissions": false}]}`))
+	case "/api/v1/folders?skip=1&take=1":
+		w.Write([]byte(`{"skip": 1, "take": 1, "total": 2, "hasNext": false, "nextSkip": 2, "records": [{"id": 7, "folderName": "Databases", "folderPath": "\\Infrastructure\\Databases", "parentFolderId": 5, "inheritPermissions": true}]}`))
+
+	// Folder Permissions of folder 5
+	case "/api/v1/folder-permissions?filter.folderId=5&skip=0&take=2":
+		w.Write([]byte(`{"skip": 0, "take": 2, "total": 2, "hasNext": false, "records": [
+			{"id": 31, "folderId": 5, "groupId": 12, "groupName": "Vault Admins", "userId": null, "userName": null, "folderAccessRoleName": "Owner", "secretAccessRoleName": "Owner"},
+			{"id": 32, "folderId": 5, "groupId": 20, "groupName": "alice", "userId": 2, "userName": "alice", "folderAccessRoleName": "View", "secretAccessRoleName": "View"}
+		]}`))
+
+	// Folder Permissions of folder 7
+	case "/api/v1/folder-permissions?filter.folderId=7&skip=0&take=2":
+		w.Write([]byte(`{"skip": 0, "take": 2, "total": 0, "hasNext": false, "records": []}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body           []byte
+		wantObjects    []map[string]any
+		wantNextCursor *string
+		wantErr        *framework.Error
+	}{
+		"last_page": {
+			body:        []byte(`{"skip": 0, "take": 10, "total": 1, "hasNext": false, "nextSkip": 10, "records": [{"id": 1}]}`),
+			wantObjects: []map[string]any{{"id": float64(1)}},
+		},
+		"next_page": {
+			body:           []byte(`{"skip": 10, "take": 10, "total": 25, "hasNext": true, "nextSkip": 20, "records": [{"id": 11}]}`),
+			wantObjects:    []map[string]any{{"id": float64(11)}},
+			wantNextCursor: testutil.GenPtr("20"),
+		},
+		"no_objects": {
+			body:        []byte(`{"skip": 0, "take": 10, "total": 0, "hasNext": false, "records": []}`),
+			wantObjects: []map[string]any{},
+		},
+		"invalid_response": {
+			body: []byte(`[]`),
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type delineasecretserver.ListResponse.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextCursor, gotErr := delineasecretserver.ParseResponse(tt.body)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextCursor, tt.wantNextCursor) {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", gotNextCursor, tt.wantNextCursor)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := delineasecretserver.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *delineasecretserver.Request
+		wantResponse *delineasecretserver.Response
+		wantErr      *framework.Error
+	}{
+		"users_first_page": {
+			request: &delineasecretserver.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer ss-test",
+				PageSize:            2,
+				EntityExternalID:    delineasecretserver.User,
+			},
+			wantResponse: &delineasecretserver.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(2), "userName": "alice", "displayName": "Alice", "emailAddress": "alice@acme.com", "enabled": true, "created": "2024-03-05T14:12:37.123", "domainId": float64(-1)},
+					{"id": float64(5), "userName": "bob", "displayName": "Bob", "emailAddress": "bob@acme.com", "enabled": false, "created": "2024-03-06T09:00:00", "domainId": float64(1)},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("2"),
+				},
+			},
+		},
+		"users_last_page": {
+			request: &delineasecretserver.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer ss-test",
+				PageSize:            2,
+				EntityExternalID:    delineasecretserver.User,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("2"),
+				},
+			},
+			wantResponse: &delineasecretserver.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(9), "userName": "carol", "displayName": "Carol", "emailAddress": "carol@acme.com", "enabled": true, "created": "2024-03-07T09:00:00", "domainId": float64(-1)},
+				},
+			},
+		},
+		"role_assignments_first_role": {
+			request: &delineasecretserver.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer ss-test",
+				PageSize:            2,
+				EntityExternalID:    delineasecretserver.RoleAssignment,
+			},
+			wantResponse: &delineasecretserver.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "1-12", "roleId": float64(1), "groupId": float64(12), "name": "Vault Admins", "enabled": true},
+					{"id": "1-20", "roleId": float64(1), "groupId": float64(20), "name": "alice", "enabled": true, "isPersonal": true},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("1"),
+					CollectionCursor: testutil.GenPtr("1"),
+				},
+			},
+		},
+		"role_assignments_last_role": {
+			request: &delineasecretserver.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer ss-test",
+				PageSize:            2,
+				EntityExternalID:    delineasecretserver.RoleAssignment,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("1"),
+					CollectionCursor: testutil.GenPtr("1"),
+				},
+			},
+			wantResponse: &delineasecretserver.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]any{},
+			},
+		},
+		"folder_permissions_first_folder": {
+			request: &delineasecretserver.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer ss-test",
+				PageSize:            2,
+				EntityExternalID:    delineasecretserver.FolderPermission,
+			},
+			wantResponse: &delineasecretserver.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(31), "folderId": float64(5), "groupId": float64(12), "groupName": "Vault Admins", "userId": nil, "userName": nil, "folderAccessRoleName": "Owner", "secretAccessRoleName": "Owner"},
+					{"id": float64(32), "folderId": float64(5), "groupId": float64(20), "groupName": "alice", "userId": float64(2), "userName": "alice", "folderAccessRoleName": "View", "secretAccessRoleName": "View"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("5"),
+					CollectionCursor: testutil.GenPtr("1"),
+				},
+			},
+		},
+		"folder_permissions_last_folder": {
+			request: &delineasecretserver.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer ss-test",
+				PageSize:            2,
+				EntityExternalID:    delineasecretserver.FolderPermission,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("5"),
+					CollectionCursor: testutil.GenPtr("1"),
+				},
+			},
+			wantResponse: &delineasecretserver.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]any{},
+			},
+		},
+		"unauthorized": {
+			request: &delineasecretserver.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer ss-invalid",
+				PageSize:            1,
+				EntityExternalID:    delineasecretserver.Group,
+			},
+			wantResponse: &delineasecretserver.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetToken(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := delineasecretserver.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request   *delineasecretserver.TokenRequest
+		wantToken string
+		wantErr   *framework.Error
+	}{
+		"local_user": {
+			request: &delineasecretserver.TokenRequest{
+				BaseURL:  server.URL,
+				Username: "svc-sgnl",
+				Password: "secret",
+			},
+			wantToken: "Bearer ss-test",
+		},
+		"domain_user": {
+			request: &delineasecretserver.TokenRequest{
+				BaseURL:  server.URL,
+				Username: "svc-sgnl",
+				Password: "secret",
+				Domain:   "ACME",
+			},
+			wantToken: "Bearer ss-test",
+		},
+		"invalid_password": {
+			request: &delineasecretserver.TokenRequest{
+				BaseURL:  server.URL,
+				Username: "svc-sgnl",
+				Password: "invalid",
+			},
+			wantErr: &framework.Error{
+				Message: "Failed to get an access token for Delinea Secret Server user svc-sgnl: invalid username or password.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotToken, gotErr := client.GetToken(context.Background(), tt.request)
+
+			if gotToken != tt.wantToken {
+				t.Errorf("gotToken: %v, wantToken: %v", gotToken, tt.wantToken)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("cached_token", func(t *testing.T) {
+		request := &delineasecretserver.TokenRequest{
+			BaseURL:  server.URL,
+			Username: "svc-sgnl",
+			Password: "secret",
+		}
+
+		if _, err := client.GetToken(context.Background(), request); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// The cached token is returned without requesting the token endpoint.
+		server.Close()
+
+		gotToken, gotErr := client.GetToken(context.Background(), request)
+		if gotErr != nil || gotToken != "Bearer ss-test" {
+			t.Errorf("gotToken: %v, gotErr: %v, wantToken: Bearer ss-test", gotToken, gotErr)
+		}
+	})
+}
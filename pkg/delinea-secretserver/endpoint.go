@@ -0,0 +1,71 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package delineasecretserver
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// For example, the endpoint of the second page of 100 users is:
+// https://acme.secretservercloud.com/api/v1/users?filter.includeInactive=true&skip=100&take=100.
+// The members are listed for each collection object, e.g. the groups assigned to a role with
+// https://acme.secretservercloud.com/api/v1/roles/{roleId}/groups?skip=0&take=100, and the permissions of a folder
+// with https://acme.secretservercloud.com/api/v1/folder-permissions?filter.folderId={folderId}&skip=0&take=100.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if entity.memberOf != nil && (request.Cursor == nil || request.Cursor.CollectionID == nil) {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Unable to construct the %s endpoint without a collection ID.", request.EntityExternalID),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	endpoint := request.BaseURL + "/api/v1"
+	params := url.Values{}
+
+	// [MemberEntities] The members are listed either with a path relative to the collection object,
+	// e.g. /roles/{roleId}/groups, or with a filter on the ID of the collection object.
+	if entity.memberOf != nil {
+		if entity.collectionParam != "" {
+			params.Set(entity.collectionParam, *request.Cursor.CollectionID)
+		} else {
+			endpoint += ValidEntityExternalIDs[*entity.memberOf].path + "/" + url.PathEscape(*request.Cursor.CollectionID)
+		}
+	}
+
+	endpoint += entity.path
+
+	if entity.includeInactive {
+		params.Set("filter.includeInactive", "true")
+	}
+
+	skip := "0"
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		skip = *request.Cursor.Cursor
+	}
+
+	params.Set("skip", skip)
+	params.Set("take", strconv.FormatInt(request.PageSize, 10))
+
+	return endpoint + "?" + params.Encode(), nil
+}
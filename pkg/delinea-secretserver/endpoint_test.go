@@ -0,0 +1,125 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package delineasecretserver_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	delineasecretserver "github.com/sgnl-ai/adapters/pkg/delinea-secretserver"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *delineasecretserver.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &delineasecretserver.Request{
+				BaseURL:          "https://acme.secretservercloud.com",
+				PageSize:         100,
+				EntityExternalID: "Secret",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"users": {
+			request: &delineasecretserver.Request{
+				BaseURL:          "https://acme.secretservercloud.com",
+				PageSize:         100,
+				EntityExternalID: delineasecretserver.User,
+			},
+			wantEndpoint: "https://acme.secretservercloud.com/api/v1/users?filter.includeInactive=true&skip=0&take=100",
+		},
+		"groups_next_page_on_premises": {
+			request: &delineasecretserver.Request{
+				BaseURL:          "https://pam.acme.com/SecretServer",
+				PageSize:         100,
+				EntityExternalID: delineasecretserver.Group,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("200"),
+				},
+			},
+			wantEndpoint: "https://pam.acme.com/SecretServer/api/v1/groups?filter.includeInactive=true&skip=200&take=100",
+		},
+		"roles": {
+			request: &delineasecretserver.Request{
+				BaseURL:          "https://acme.secretservercloud.com",
+				PageSize:         100,
+				EntityExternalID: delineasecretserver.Role,
+			},
+			wantEndpoint: "https://acme.secretservercloud.com/api/v1/roles?filter.includeInactive=true&skip=0&take=100",
+		},
+		"folders": {
+			request: &delineasecretserver.Request{
+				BaseURL:          "https://acme.secretservercloud.com",
+				PageSize:         100,
+				EntityExternalID: delineasecretserver.Folder,
+			},
+			wantEndpoint: "https://acme.secretservercloud.com/api/v1/folders?skip=0&take=100",
+		},
+		"role_assignments": {
+			request: &delineasecretserver.Request{
+				BaseURL:          "https://acme.secretservercloud.com",
+				PageSize:         100,
+				EntityExternalID: delineasecretserver.RoleAssignment,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor:       testutil.GenPtr("100"),
+					CollectionID: testutil.GenPtr("3"),
+				},
+			},
+			wantEndpoint: "https://acme.secretservercloud.com/api/v1/roles/3/groups?skip=100&take=100",
+		},
+		"folder_permissions": {
+			request: &delineasecretserver.Request{
+				BaseURL:          "https://acme.secretservercloud.com",
+				PageSize:         100,
+				EntityExternalID: delineasecretserver.FolderPermission,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("12"),
+				},
+			},
+			wantEndpoint: "https://acme.secretservercloud.com/api/v1/folder-permissions?filter.folderId=12&skip=0&take=100",
+		},
+		"folder_permissions_without_collection_id": {
+			request: &delineasecretserver.Request{
+				BaseURL:          "https://acme.secretservercloud.com",
+				PageSize:         100,
+				EntityExternalID: delineasecretserver.FolderPermission,
+			},
+			wantErr: &framework.Error{
+				Message: "Unable to construct the FolderPermission endpoint without a collection ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := delineasecretserver.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
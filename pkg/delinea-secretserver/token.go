@@ -0,0 +1,167 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package delineasecretserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+)
+
+// tokenExpiryLeeway is subtracted from the lifetime of the cached access tokens, so that a token doesn't expire
+// while a page is being requested.
+const tokenExpiryLeeway = time.Minute
+
+// TokenRequest is a request for an access token of a Secret Server user, using the OAuth password grant:
+// https://updates.thycotic.net/secretserver/restapiguide/TokenAuth/.
+type TokenRequest struct {
+	// BaseURL is the base URL of the Secret Server instance.
+	BaseURL string
+
+	// Username is the username of the Secret Server user.
+	Username string
+
+	// Password is the password of the Secret Server user.
+	Password string
+
+	// Domain is the Active Directory domain of the user, or empty for a local user.
+	Domain string
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	RequestTimeoutSeconds int
+}
+
+// tokenResponse is the response of the token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+	Error       string `json:"error"`
+}
+
+// cachedToken is an access token cached by the Datasource until it expires.
+type cachedToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// tokenCache caches the access tokens of the users across pages.
+type tokenCache struct {
+	mu     sync.Mutex
+	tokens map[TokenRequest]cachedToken
+}
+
+func (c *tokenCache) get(request TokenRequest) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, found := c.tokens[request]
+	if !found || !time.Now().Before(cached.expiresAt) {
+		return "", false
+	}
+
+	return cached.token, true
+}
+
+func (c *tokenCache) set(request TokenRequest, token string, expiresIn int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tokens == nil {
+		c.tokens = make(map[TokenRequest]cachedToken)
+	}
+
+	c.tokens[request] = cachedToken{
+		token:     token,
+		expiresAt: time.Now().Add(time.Duration(expiresIn)*time.Second - tokenExpiryLeeway),
+	}
+}
+
+// GetToken returns the Authorization header value, i.e. "Bearer <token>", to query the REST API with the username
+// and password of the request. Tokens are cached until they expire.
+// The refresh tokens aren't used: a new token is requested with the password once the cached one expires.
+func (d *Datasource) GetToken(ctx context.Context, request *TokenRequest) (string, *framework.Error) {
+	if token, found := d.tokens.get(*request); found {
+		return token, nil
+	}
+
+	form := url.Values{
+		"grant_type": {"password"},
+		"username":   {request.Username},
+		"password":   {request.Password},
+	}
+
+	if request.Domain != "" {
+		form.Set("domain", request.Domain)
+	}
+
+	var token tokenResponse
+
+	httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+		Method: http.MethodPost,
+		URL:    request.BaseURL + "/oauth2/token",
+		Body:   []byte(form.Encode()),
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		DatasourceName:        "Delinea Secret Server token",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, err := httpds.ReadAll(body, "Delinea Secret Server token")
+		if err != nil {
+			return err
+		}
+
+		return httpds.UnmarshalJSON(bodyBytes, &token)
+	}, &httpds.Hooks{
+		// The body of the 400 responses is read to tell an invalid username or password from other errors.
+		IsSuccess: func(statusCode int) bool {
+			return statusCode == http.StatusOK || statusCode == http.StatusBadRequest
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Secret Server rejects an invalid username or password with a 400 invalid_grant error, rather than a 401.
+	if httpResponse.StatusCode == http.StatusBadRequest && token.Error == "invalid_grant" {
+		return "", &framework.Error{
+			Message: fmt.Sprintf(
+				"Failed to get an access token for Delinea Secret Server user %s: invalid username or password.",
+				request.Username,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+		}
+	}
+
+	if adapterErr := web.HTTPError(httpResponse.StatusCode, httpResponse.RetryAfterHeader); adapterErr != nil {
+		adapterErr.Message = fmt.Sprintf(
+			"Failed to get an access token for Delinea Secret Server user %s: %s", request.Username, adapterErr.Message,
+		)
+
+		return "", adapterErr
+	}
+
+	if token.AccessToken == "" {
+		return "", &framework.Error{
+			Message: fmt.Sprintf(
+				"Delinea Secret Server token response for user %s is missing an access token.", request.Username,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	authorization := "Bearer " + token.AccessToken
+
+	d.tokens.set(*request, authorization, token.ExpiresIn)
+
+	return authorization, nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package delineasecretserver_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	delineasecretserver "github.com/sgnl-ai/adapters/pkg/delinea-secretserver"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: delineasecretserver.User,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeInt64,
+			},
+			{
+				ExternalId: "userName",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[delineasecretserver.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: "acme.secretservercloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer ss-test",
+				},
+				Entity:   validEntity,
+				Config:   &delineasecretserver.Config{},
+				PageSize: 100,
+			},
+			wantAddress: "https://acme.secretservercloud.com",
+		},
+		"valid_request_basic_auth": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: "https://pam.acme.com/SecretServer",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "svc-sgnl",
+						Password: "secret",
+					},
+				},
+				Entity: validEntity,
+				Config: &delineasecretserver.Config{
+					Domain: "ACME",
+				},
+				PageSize: 100,
+			},
+			wantAddress: "https://pam.acme.com/SecretServer",
+		},
+		"invalid_request_nil_config": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: "https://acme.secretservercloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer ss-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Delinea Secret Server config is invalid: request contains no config.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: "http://pam.acme.com/SecretServer",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer ss-test",
+				},
+				Entity:   validEntity,
+				Config:   &delineasecretserver.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address:  "https://acme.secretservercloud.com",
+				Auth:     &framework.DatasourceAuthCredentials{},
+				Entity:   validEntity,
+				Config:   &delineasecretserver.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Request to Delinea Secret Server is missing Basic credentials or Bearer token credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: "https://acme.secretservercloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "ss-test",
+				},
+				Entity:   validEntity,
+				Config:   &delineasecretserver.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: "https://acme.secretservercloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer ss-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Secret",
+					Attributes: validEntity.Attributes,
+				},
+				Config:   &delineasecretserver.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: "https://acme.secretservercloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer ss-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: delineasecretserver.User,
+					Attributes: validEntity.Attributes[1:],
+				},
+				Config:   &delineasecretserver.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: "https://acme.secretservercloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer ss-test",
+				},
+				Entity:   validEntity,
+				Config:   &delineasecretserver.Config{},
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[delineasecretserver.Config]{
+				Address: "https://acme.secretservercloud.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer ss-test",
+				},
+				Entity:   validEntity,
+				Config:   &delineasecretserver.Config{},
+				PageSize: 1001,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1001) exceeds the maximum allowed (1000).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &delineasecretserver.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package deprecation detects the deprecation notices sent by the datasources in the headers of their responses,
+// so that the upcoming removals of the APIs used by the adapters are noticed from the production traffic.
+//
+// The notices are read from the standard headers:
+//   - Deprecation, e.g. "@1688169599", see RFC 9745.
+//   - Sunset, e.g. "Sat, 31 Dec 2026 23:59:59 GMT", see RFC 8594.
+//   - Link, with the "deprecation" or "sunset" relation type, e.g. `<https://developer.example.com/v1>; rel="sunset"`.
+//   - Warning, with the 299 (Miscellaneous Persistent Warning) code used to announce deprecations.
+//
+// and from the vendor-specific headers configured with the transport. The same notice is only logged once per
+// LogInterval for each datasource type, with the number of responses containing it since it was last logged.
+package deprecation
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"go.uber.org/zap"
+)
+
+const (
+	// LogInterval is the interval during which the same notice of a datasource type is only logged once.
+	LogInterval = time.Hour
+
+	// maxNotices is the maximum number of distinct notices tracked by a Transport, to bound its memory if a
+	// datasource sends a different notice in each response.
+	maxNotices = 1000
+)
+
+// Notice is a deprecation notice found in the headers of a response.
+type Notice struct {
+	// Header is the canonical name of the header, e.g. "Sunset".
+	Header string
+
+	// Value is the value of the header, or the link element of a Link header.
+	Value string
+}
+
+// Notices returns the deprecation notices of the headers of a response, including the notices of the
+// vendor-specific headers.
+func Notices(header http.Header, vendorHeaders []string) []Notice {
+	var notices []Notice
+
+	add := func(name string, values []string) {
+		for _, value := range values {
+			notices = append(notices, Notice{Header: http.CanonicalHeaderKey(name), Value: value})
+		}
+	}
+
+	add("Deprecation", header.Values("Deprecation"))
+	add("Sunset", header.Values("Sunset"))
+
+	for _, value := range header.Values("Link") {
+		for link := range strings.SplitSeq(value, ",") {
+			if link = strings.TrimSpace(link); hasDeprecationRelation(link) {
+				add("Link", []string{link})
+			}
+		}
+	}
+
+	for _, value := range header.Values("Warning") {
+		if strings.HasPrefix(strings.TrimSpace(value), "299 ") {
+			add("Warning", []string{value})
+		}
+	}
+
+	for _, name := range vendorHeaders {
+		add(name, header.Values(name))
+	}
+
+	return notices
+}
+
+// hasDeprecationRelation returns whether a link element has the "deprecation" or "sunset" relation type,
+// e.g. `<https://developer.example.com/v1>; rel="sunset"`.
+func hasDeprecationRelation(link string) bool {
+	_, params, found := strings.Cut(link, ";")
+	if !found {
+		return false
+	}
+
+	for param := range strings.SplitSeq(params, ";") {
+		name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if !strings.EqualFold(name, "rel") {
+			continue
+		}
+
+		for relation := range strings.FieldsSeq(strings.Trim(value, `"`)) {
+			if strings.EqualFold(relation, "deprecation") || strings.EqualFold(relation, "sunset") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ParseHeaders parses a comma separated list of the names of vendor-specific headers, e.g.
+// "Asana-Change,X-Api-Warn".
+func ParseHeaders(s string) []string {
+	var headers []string
+
+	for header := range strings.SplitSeq(s, ",") {
+		if header = strings.TrimSpace(header); header != "" {
+			headers = append(headers, http.CanonicalHeaderKey(header))
+		}
+	}
+
+	return headers
+}
+
+// noticeState is the number of responses containing a notice since it was last logged.
+type noticeState struct {
+	loggedAt time.Time
+	count    int
+}
+
+// Transport is an http.RoundTripper logging the deprecation notices of the responses of a datasource type.
+type Transport struct {
+	next          http.RoundTripper
+	vendorHeaders []string
+	logger        *zap.Logger
+	now           func() time.Time
+
+	mu      sync.Mutex
+	notices map[Notice]*noticeState
+}
+
+// NewTransport returns a Transport sending the requests with the next RoundTripper, and logging the deprecation
+// notices of the responses with the logger. http.DefaultTransport is used if next is nil.
+func NewTransport(
+	next http.RoundTripper,
+	datasourceType string,
+	vendorHeaders []string,
+	logger *zap.Logger,
+) *Transport {
+	return newTransport(next, datasourceType, vendorHeaders, logger, time.Now)
+}
+
+func newTransport(
+	next http.RoundTripper,
+	datasourceType string,
+	vendorHeaders []string,
+	logger *zap.Logger,
+	now func() time.Time,
+) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	adapterType, adapterVersion, _ := strings.Cut(datasourceType, "-")
+
+	return &Transport{
+		next:          next,
+		vendorHeaders: vendorHeaders,
+		logger:        logger.With(fields.AdapterType(adapterType), fields.AdapterVersion(adapterVersion)),
+		now:           now,
+		notices:       make(map[Notice]*noticeState),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	for _, notice := range Notices(resp.Header, t.vendorHeaders) {
+		if count, ok := t.shouldLog(notice); ok {
+			// The query of the URL is omitted, as it may contain secrets.
+			t.logger.Warn("Datasource response contains a deprecation notice",
+				fields.RequestURL(req.URL.Scheme+"://"+req.URL.Host+req.URL.Path),
+				fields.DeprecationHeader(notice.Header),
+				fields.DeprecationHeaderValue(notice.Value),
+				fields.DeprecationResponseCount(count),
+			)
+		}
+	}
+
+	return resp, nil
+}
+
+// shouldLog counts a response containing the notice, and returns whether the notice must be logged, i.e. whether
+// it was never logged or was logged more than LogInterval ago, with the number of responses containing it since.
+func (t *Transport) shouldLog(notice Notice) (int, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, found := t.notices[notice]
+	if !found {
+		if len(t.notices) >= maxNotices {
+			clear(t.notices)
+		}
+
+		state = &noticeState{}
+		t.notices[notice] = state
+	}
+
+	state.count++
+
+	now := t.now()
+	if found && now.Sub(state.loggedAt) < LogInterval {
+		return 0, false
+	}
+
+	count := state.count
+
+	state.loggedAt = now
+	state.count = 0
+
+	return count, true
+}
+
+// WrapClient logs the deprecation notices of the responses received by the client.
+func WrapClient(client *http.Client, datasourceType string, vendorHeaders []string, logger *zap.Logger) *http.Client {
+	client.Transport = NewTransport(client.Transport, datasourceType, vendorHeaders, logger)
+
+	return client
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package deprecation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestNotices(t *testing.T) {
+	tests := map[string]struct {
+		header        http.Header
+		vendorHeaders []string
+		wantNotices   []Notice
+	}{
+		"no_notices": {
+			header: http.Header{
+				"Content-Type": {"application/json"},
+				"Link":         {`<https://api.example.com/users?page=2>; rel="next"`},
+				"Warning":      {`199 - "Miscellaneous warning"`},
+			},
+			wantNotices: nil,
+		},
+		"deprecation_and_sunset": {
+			header: http.Header{
+				"Deprecation": {"@1688169599"},
+				"Sunset":      {"Sat, 31 Dec 2026 23:59:59 GMT"},
+			},
+			wantNotices: []Notice{
+				{Header: "Deprecation", Value: "@1688169599"},
+				{Header: "Sunset", Value: "Sat, 31 Dec 2026 23:59:59 GMT"},
+			},
+		},
+		"link": {
+			header: http.Header{
+				"Link": {`<https://api.example.com/users?page=2>; rel="next", <https://developer.example.com/deprecations/v1>; rel="deprecation"; type="text/html"`},
+			},
+			wantNotices: []Notice{
+				{Header: "Link", Value: `<https://developer.example.com/deprecations/v1>; rel="deprecation"; type="text/html"`},
+			},
+		},
+		"warning": {
+			header: http.Header{
+				"Warning": {`299 - "The v1 API is deprecated and will be removed on 2026-12-31."`},
+			},
+			wantNotices: []Notice{
+				{Header: "Warning", Value: `299 - "The v1 API is deprecated and will be removed on 2026-12-31."`},
+			},
+		},
+		"vendor_header": {
+			header: http.Header{
+				"Asana-Change": {"name=new_user_task_lists;info=https://asana.com/developers/feed;affected=true"},
+			},
+			vendorHeaders: ParseHeaders(" asana-change, ,X-Api-Warn"),
+			wantNotices: []Notice{
+				{Header: "Asana-Change", Value: "name=new_user_task_lists;info=https://asana.com/developers/feed;affected=true"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotNotices := Notices(tt.header, tt.vendorHeaders)
+
+			if !reflect.DeepEqual(gotNotices, tt.wantNotices) {
+				t.Errorf("gotNotices: %v, wantNotices: %v", gotNotices, tt.wantNotices)
+			}
+		})
+	}
+}
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/users" {
+			w.Header().Set("Sunset", "Sat, 31 Dec 2026 23:59:59 GMT")
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	core, observedLogs := observer.New(zapcore.WarnLevel)
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := &http.Client{
+		Transport: newTransport(nil, "Okta-1.0.1", nil, zap.New(core), func() time.Time { return now }),
+	}
+
+	get := func(path string) {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		resp.Body.Close()
+	}
+
+	get("/v1/users?token=secret")
+
+	// The same notice is only logged once per LogInterval.
+	get("/v1/users")
+	get("/v1/users")
+
+	// No notice.
+	get("/v2/users")
+
+	now = now.Add(LogInterval)
+
+	get("/v1/users")
+
+	wantLog := func(count int) map[string]any {
+		return map[string]any{
+			fields.FieldAdapterType:              "Okta",
+			fields.FieldAdapterVersion:           "1.0.1",
+			fields.FieldRequestURL:               server.URL + "/v1/users",
+			fields.FieldDeprecationHeader:        "Sunset",
+			fields.FieldDeprecationHeaderValue:   "Sat, 31 Dec 2026 23:59:59 GMT",
+			fields.FieldDeprecationResponseCount: int64(count),
+		}
+	}
+
+	wantLogs := []map[string]any{wantLog(1), wantLog(3)}
+
+	gotLogs := observedLogs.AllUntimed()
+	if len(gotLogs) != len(wantLogs) {
+		t.Fatalf("gotLogs: %v, wantLogs: %v", gotLogs, wantLogs)
+	}
+
+	for i, gotLog := range gotLogs {
+		if gotLog.Message != "Datasource response contains a deprecation notice" {
+			t.Errorf("gotMessage: %v", gotLog.Message)
+		}
+
+		if gotFields := gotLog.ContextMap(); !reflect.DeepEqual(gotFields, wantLogs[i]) {
+			t.Errorf("gotFields: %v, wantFields: %v", gotFields, wantLogs[i])
+		}
+	}
+}
@@ -12,21 +12,35 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
 // from datasources.
 type Adapter struct {
 	DuoClient Client
+
+	// Now returns the current time, used as the end of the syncs of the TrustMonitorEvent entity.
+	// Defaults to time.Now if nil.
+	Now func() time.Time
 }
 
 // NewAdapter instantiates a new Adapter.
 func NewAdapter(client Client) framework.Adapter[Config] {
 	return &Adapter{
 		DuoClient: client,
+		Now:       time.Now,
 	}
 }
 
+func (a *Adapter) now() time.Time {
+	if a.Now == nil {
+		return time.Now()
+	}
+
+	return a.Now()
+}
+
 // GetPage is called by SGNL's ingestion service to query a page of objects
 // from a datasource.
 func (a *Adapter) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
@@ -48,20 +62,45 @@ func (a *Adapter) RequestPageFromDatasource(
 
 	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
 
-	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor)
-	if err != nil {
-		return framework.NewGetPageResponseError(err)
+	var (
+		cursor             *pagination.CompositeCursor[int64]
+		trustMonitorCursor *TrustMonitorCursor
+		err                *framework.Error
+	)
+
+	timeWindowed := ValidEntityExternalIDs[request.Entity.ExternalId].timeWindowed
+
+	if timeWindowed {
+		trustMonitorCursor, err = UnmarshalTrustMonitorCursor(request.Cursor, request.Entity.ExternalId)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		if trustMonitorCursor == nil {
+			trustMonitorCursor = firstTrustMonitorCursor(
+				commonConfig.ChangedSince(),
+				a.now(),
+				request.Config.trustMonitorLookbackDays(),
+				request.Config.trustMonitorWindowHours(),
+			)
+		}
+	} else {
+		// Unmarshal the current cursor.
+		cursor, err = pagination.UnmarshalCursor[int64](request.Cursor, request.Entity.ExternalId)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
 	}
 
 	duoReq := &Request{
 		BaseURL:               request.Address,
 		IntegrationKey:        request.Auth.Basic.Username,
 		Secret:                request.Auth.Basic.Password,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		APIVersion:            request.Config.APIVersion,
 		Cursor:                cursor,
+		TrustMonitorCursor:    trustMonitorCursor,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
 	}
 
@@ -76,6 +115,18 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
+	// The only date formats that Duo responses contain are UNIX timestamps and RFC3339.
+	// RFC3339: Phone Entity -> "last_seen": "2019-11-18T15:51:13"
+	// Unix Timestamp: User Entity -> "created": 1574100000
+	unixFormat := web.SGNLUnixSec
+
+	// The Trust Monitor events contain UNIX timestamps in milliseconds, e.g. "surfaced_timestamp": 1675893605269.
+	if timeWindowed {
+		unixFormat = web.SGNLUnixMilli
+	}
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
@@ -84,11 +135,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		web.WithJSONPathAttributeNames(),
 		web.WithDateTimeFormats(
 			[]web.DateTimeFormatWithTimeZone{
-				// The only date formats that Duo responses contain are UNIX timestamps and RFC3339.
-				// RFC3339: Phone Entity -> "last_seen": "2019-11-18T15:51:13"
-				// Unix Timestamp: User Entity -> "created": 1574100000
 				{Format: time.RFC3339, HasTimeZone: true},
-				{Format: web.SGNLUnixSec, HasTimeZone: false},
+				{Format: unixFormat, HasTimeZone: false},
 			}...,
 		),
 		web.WithLocalTimeZoneOffset(commonConfig.LocalTimeZoneOffset),
@@ -102,8 +150,17 @@ func (a *Adapter) RequestPageFromDatasource(
 		)
 	}
 
+	var nextCursor string
+
 	// Marshal the next cursor.
-	nextCursor, err := pagination.MarshalCursor(resp.NextCursor)
+	if timeWindowed {
+		nextCursor, err = MarshalTrustMonitorCursor(
+			nextTrustMonitorCursor(trustMonitorCursor, resp.NextOffset, request.Config.trustMonitorWindowHours()),
+		)
+	} else {
+		nextCursor, err = pagination.MarshalCursor(resp.NextCursor)
+	}
+
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
@@ -289,8 +289,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Failed to unmarshal JSON cursor: json: cannot unmarshal string into Go struct field CompositeCursor[int64].cursor of type int64.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+					Message: "Invalid cursor for entity Group: failed to unmarshal JSON cursor: json: cannot unmarshal string into Go struct field CompositeCursor[int64].cursor of type int64. " +
+						`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
 		},
@@ -330,8 +332,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Cursor must not contain CollectionID or CollectionCursor fields for entity Group.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+					Message: "Invalid cursor for entity Group: cursor must not contain CollectionID or CollectionCursor fields. " +
+						`Expected cursor shape: {"cursor":<int64>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
 		},
@@ -1283,3 +1287,419 @@ func TestAdapterGetEndpointPage(t *testing.T) {
 		})
 	}
 }
+
+func TestAdapterGetTokenPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := duo_adapter.NewAdapter(&duo_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	entity := framework.EntityConfig{
+		ExternalId: "Token",
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "token_id",
+				Type:       framework.AttributeTypeString,
+				List:       false,
+			},
+			{
+				ExternalId: "serial",
+				Type:       framework.AttributeTypeString,
+				List:       false,
+			},
+			{
+				ExternalId: "type",
+				Type:       framework.AttributeTypeString,
+				List:       false,
+			},
+			{
+				ExternalId: "totp_step",
+				Type:       framework.AttributeTypeInt64,
+				List:       false,
+			},
+		},
+		ChildEntities: []*framework.EntityConfig{
+			{
+				ExternalId: "users",
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "user_id",
+						Type:       framework.AttributeTypeString,
+						List:       false,
+					},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		ctx                context.Context
+		request            *framework.Request[duo_adapter.Config]
+		inputRequestCursor *pagination.CompositeCursor[int64]
+		wantResponse       framework.Response
+		wantCursor         *pagination.CompositeCursor[int64]
+	}{
+		"first_page": {
+			ctx: context.Background(),
+			request: &framework.Request[duo_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Config: &duo_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity:   entity,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"serial":   "0",
+							"token_id": "DHIZ34ALBA2445ND4AI2",
+							"type":     "d1",
+							"users": []framework.Object{
+								{"user_id": "DUYC8O4O953VBGGKLHAL"},
+							},
+						},
+						{"serial": "YK0001", "token_id": "DHEKH0JJIYC1LX3AZWO4", "type": "yk"},
+					},
+					NextCursor: "eyJjdXJzb3IiOjJ9",
+				},
+			},
+			wantCursor: &pagination.CompositeCursor[int64]{
+				Cursor: testutil.GenPtr[int64](2),
+			},
+		},
+		"last_page": {
+			ctx: context.Background(),
+			request: &framework.Request[duo_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Config: &duo_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity:   entity,
+				PageSize: 2,
+			},
+			inputRequestCursor: &pagination.CompositeCursor[int64]{
+				Cursor: testutil.GenPtr[int64](2),
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"serial": "HOTP-0002", "token_id": "DHUNT2EFQ0HDE3B8BH1Z", "totp_step": int64(30), "type": "t6"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tt.inputRequestCursor != nil {
+				encodedCursor, err := pagination.MarshalCursor(tt.inputRequestCursor)
+				if err != nil {
+					t.Error(err)
+				}
+
+				tt.request.Cursor = encodedCursor
+			}
+
+			gotResponse := adapter.GetPage(tt.ctx, tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if gotResponse.Success != nil && tt.wantCursor != nil {
+				var gotCursor pagination.CompositeCursor[int64]
+
+				decodedCursor, err := base64.StdEncoding.DecodeString(gotResponse.Success.NextCursor)
+				if err != nil {
+					t.Errorf("error decoding cursor: %v", err)
+				}
+
+				if err := json.Unmarshal(decodedCursor, &gotCursor); err != nil {
+					t.Errorf("error unmarshalling cursor: %v", err)
+				}
+
+				if !reflect.DeepEqual(&gotCursor, tt.wantCursor) {
+					t.Errorf("gotCursor: %v, wantCursor: %v", gotCursor, tt.wantCursor)
+				}
+			}
+		})
+	}
+}
+
+func TestAdapterGetBypassCodePage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := duo_adapter.NewAdapter(&duo_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	entity := framework.EntityConfig{
+		ExternalId: "BypassCode",
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "bypass_code_id",
+				Type:       framework.AttributeTypeString,
+				List:       false,
+			},
+			{
+				ExternalId: "expiration",
+				Type:       framework.AttributeTypeDateTime,
+				List:       false,
+			},
+			{
+				ExternalId: "reuse_count",
+				Type:       framework.AttributeTypeInt64,
+				List:       false,
+			},
+			{
+				ExternalId: "$.user.user_id",
+				Type:       framework.AttributeTypeString,
+				List:       false,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		ctx                context.Context
+		request            *framework.Request[duo_adapter.Config]
+		inputRequestCursor *pagination.CompositeCursor[int64]
+		wantResponse       framework.Response
+		wantCursor         *pagination.CompositeCursor[int64]
+	}{
+		"first_page": {
+			ctx: context.Background(),
+			request: &framework.Request[duo_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Config: &duo_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity:   entity,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"$.user.user_id": "DUYC8O4O953VBGGKLHAL",
+							"bypass_code_id": "DBP4ZIDUGK33GT7RQK0Q",
+							"expiration":     time.Date(2024, 1, 24, 20, 17, 36, 0, time.UTC),
+							"reuse_count":    int64(1),
+						},
+						{
+							"$.user.user_id": "DUG1B8MRABMVKYVCFO8H",
+							"bypass_code_id": "DBPKVYKI5D0R1N4HMWPR",
+							"reuse_count":    int64(0),
+						},
+					},
+					NextCursor: "eyJjdXJzb3IiOjJ9",
+				},
+			},
+			wantCursor: &pagination.CompositeCursor[int64]{
+				Cursor: testutil.GenPtr[int64](2),
+			},
+		},
+		"last_page": {
+			ctx: context.Background(),
+			request: &framework.Request[duo_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Config: &duo_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity:   entity,
+				PageSize: 2,
+			},
+			inputRequestCursor: &pagination.CompositeCursor[int64]{
+				Cursor: testutil.GenPtr[int64](2),
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"$.user.user_id": "DUHUTX7KGB6D15WTD3VY",
+							"bypass_code_id": "DBPF8WWKTQ6Q0HH7M0G8",
+							"reuse_count":    int64(3),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tt.inputRequestCursor != nil {
+				encodedCursor, err := pagination.MarshalCursor(tt.inputRequestCursor)
+				if err != nil {
+					t.Error(err)
+				}
+
+				tt.request.Cursor = encodedCursor
+			}
+
+			gotResponse := adapter.GetPage(tt.ctx, tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if gotResponse.Success != nil && tt.wantCursor != nil {
+				var gotCursor pagination.CompositeCursor[int64]
+
+				decodedCursor, err := base64.StdEncoding.DecodeString(gotResponse.Success.NextCursor)
+				if err != nil {
+					t.Errorf("error decoding cursor: %v", err)
+				}
+
+				if err := json.Unmarshal(decodedCursor, &gotCursor); err != nil {
+					t.Errorf("error unmarshalling cursor: %v", err)
+				}
+
+				if !reflect.DeepEqual(&gotCursor, tt.wantCursor) {
+					t.Errorf("gotCursor: %v, wantCursor: %v", gotCursor, tt.wantCursor)
+				}
+			}
+		})
+	}
+}
+
+func TestAdapterGetIntegrationPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := duo_adapter.NewAdapter(&duo_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	tests := map[string]struct {
+		ctx          context.Context
+		request      *framework.Request[duo_adapter.Config]
+		wantResponse framework.Response
+	}{
+		"integrations_first_page": {
+			ctx: context.Background(),
+			request: &framework.Request[duo_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Config: &duo_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Integration",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "integration_key",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "name",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "type",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "secret_key",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			// The secret keys of the integrations are never returned.
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"integration_key": "DIRWIH0ZZPV4G88B37VQ", "name": "Salesforce SSO", "type": "sso-salesforce"},
+						{"integration_key": "DIKMG8W4ABAUCWCTTUBO", "name": "Microsoft RDP", "type": "rdp"},
+					},
+					NextCursor: "eyJjdXJzb3IiOjJ9",
+				},
+			},
+		},
+		"policy_assignments_first_page": {
+			ctx: context.Background(),
+			request: &framework.Request[duo_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Config: &duo_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "PolicyAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "integration_key",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "policy_key",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":              "DIRWIH0ZZPV4G88B37VQ-POU7B4C0GB5DO1EXYXLI",
+							"integration_key": "DIRWIH0ZZPV4G88B37VQ",
+							"policy_key":      "POU7B4C0GB5DO1EXYXLI",
+						},
+					},
+					NextCursor: "eyJjdXJzb3IiOjJ9",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(tt.ctx, tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+		})
+	}
+}
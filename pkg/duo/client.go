@@ -37,6 +37,10 @@ type Request struct {
 	// nil in the request for the first page.
 	Cursor *pagination.CompositeCursor[int64]
 
+	// TrustMonitorCursor is the time window and the offset within it of the page to return, for the
+	// TrustMonitorEvent entity only. Cursor is ignored if set.
+	TrustMonitorCursor *TrustMonitorCursor
+
 	// APIVersion the API version to use.
 	APIVersion string
 
@@ -60,4 +64,8 @@ type Response struct {
 	// NextCursor is the cursor that identifies the first object of the next page.
 	// nil if this is the last page in this full sync.
 	NextCursor *pagination.CompositeCursor[int64]
+
+	// NextOffset is the offset of the next page within the time window of the TrustMonitorEvent entity.
+	// nil if this is the last page of the time window.
+	NextOffset *string
 }
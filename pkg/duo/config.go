@@ -21,7 +21,9 @@ var supportedAPIVersions = map[string]struct{}{
 {
     "requestTimeoutSeconds": 10,
     "localTimeZoneOffset": 43200,
-    "apiVersion": "v1"
+    "apiVersion": "v1",
+    "trustMonitorLookbackDays": 30,
+    "trustMonitorWindowHours": 24
 }
 */
 type Config struct {
@@ -30,6 +32,33 @@ type Config struct {
 
 	// APIVersion is the version of the Duo API to use.
 	APIVersion string `json:"apiVersion,omitempty"`
+
+	// TrustMonitorLookbackDays is the number of days of events returned by a full sync of the TrustMonitorEvent
+	// entity. Defaults to DefaultTrustMonitorLookbackDays, and can't exceed the 180 days of events returned by
+	// Duo. During an incremental sync, the events surfaced since the last sync are returned instead.
+	TrustMonitorLookbackDays *int `json:"trustMonitorLookbackDays,omitempty"`
+
+	// TrustMonitorWindowHours is the duration of the time windows in which the events of the TrustMonitorEvent
+	// entity are requested. Defaults to DefaultTrustMonitorWindowHours. See TrustMonitorCursor.
+	TrustMonitorWindowHours *int `json:"trustMonitorWindowHours,omitempty"`
+}
+
+// trustMonitorLookbackDays returns the configured TrustMonitorLookbackDays, or the default.
+func (c *Config) trustMonitorLookbackDays() int {
+	if c.TrustMonitorLookbackDays == nil {
+		return DefaultTrustMonitorLookbackDays
+	}
+
+	return *c.TrustMonitorLookbackDays
+}
+
+// trustMonitorWindowHours returns the configured TrustMonitorWindowHours, or the default.
+func (c *Config) trustMonitorWindowHours() int {
+	if c.TrustMonitorWindowHours == nil {
+		return DefaultTrustMonitorWindowHours
+	}
+
+	return *c.TrustMonitorWindowHours
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
@@ -44,6 +73,15 @@ func (c *Config) Validate(_ context.Context) error {
 			return fmt.Errorf("apiVersion is not supported: %v", c.APIVersion)
 		}
 
-		return nil
+		if c.TrustMonitorLookbackDays != nil &&
+			(*c.TrustMonitorLookbackDays < 1 || *c.TrustMonitorLookbackDays > maxTrustMonitorLookbackDays) {
+			return fmt.Errorf("trustMonitorLookbackDays must be between 1 and %d", maxTrustMonitorLookbackDays)
+		}
+
+		if c.TrustMonitorWindowHours != nil && *c.TrustMonitorWindowHours < 1 {
+			return errors.New("trustMonitorWindowHours must be greater than 0")
+		}
+
+		return c.CommonConfig.ValidateSyncMode()
 	}
 }
@@ -4,19 +4,14 @@ package duo
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
-	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
-	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
-	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
-	"go.uber.org/zap"
 )
 
 // Datasource directly implements a Client interface to allow querying an external datasource.
@@ -43,14 +38,27 @@ type Entity struct {
 	path string
 	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
 	uniqueIDAttrExternalID string
+	// maxPageSize is the maximum value of the limit parameter accepted by the endpoint.
+	maxPageSize int64
+	// transform converts the objects returned by the endpoint into the objects of the entity, if set.
+	transform func(objects []map[string]any) []map[string]any
+	// timeWindowed indicates whether the objects are events requested one time window at a time, with the
+	// mintime and maxtime parameters. See TrustMonitorCursor.
+	timeWindowed bool
 }
 
 const (
-	User     = "User"
-	Group    = "Group"
-	Phone    = "Phone"
-	Endpoint = "Endpoint"
-	RFC2822  = "Mon, 02 Jan 2006 15:04:05 -0700"
+	User       = "User"
+	Group      = "Group"
+	Phone      = "Phone"
+	Endpoint   = "Endpoint"
+	Token      = "Token"
+	BypassCode = "BypassCode"
+	RFC2822    = "Mon, 02 Jan 2006 15:04:05 -0700"
+
+	Integration       = "Integration"
+	PolicyAssignment  = "PolicyAssignment"
+	TrustMonitorEvent = "TrustMonitorEvent"
 )
 
 var (
@@ -60,18 +68,57 @@ var (
 		User: {
 			path:                   "users",
 			uniqueIDAttrExternalID: "user_id",
+			maxPageSize:            300,
 		},
 		Endpoint: {
 			path:                   "endpoints",
 			uniqueIDAttrExternalID: "epkey",
+			maxPageSize:            500,
 		},
 		Group: {
 			path:                   "groups",
 			uniqueIDAttrExternalID: "group_id",
+			maxPageSize:            100,
 		},
 		Phone: {
 			path:                   "phones",
 			uniqueIDAttrExternalID: "phone_id",
+			maxPageSize:            500,
+		},
+		// Token is a hardware token, e.g. a YubiKey or an OTP token.
+		Token: {
+			path:                   "tokens",
+			uniqueIDAttrExternalID: "token_id",
+			maxPageSize:            500,
+		},
+		BypassCode: {
+			path:                   "bypass_codes",
+			uniqueIDAttrExternalID: "bypass_code_id",
+			maxPageSize:            500,
+		},
+		// Integration is an application protected by Duo, e.g. an SSO application or Microsoft RDP.
+		// https://duo.com/docs/adminapi#retrieve-integrations.
+		Integration: {
+			path:                   "integrations",
+			uniqueIDAttrExternalID: "integration_key",
+			maxPageSize:            300,
+			transform:              removeSecretKeys,
+		},
+		// Connection entity for Integrations <-> Policies. The policy assignments are listed from the
+		// integrations, which contain the key of the custom policy applied to them, if any.
+		PolicyAssignment: {
+			path:                   "integrations",
+			uniqueIDAttrExternalID: "id",
+			maxPageSize:            300,
+			transform:              toPolicyAssignments,
+		},
+		// TrustMonitorEvent is an anomalous authentication or device event surfaced by Duo Trust Monitor.
+		// https://duo.com/docs/adminapi#retrieve-events.
+		TrustMonitorEvent: {
+			path:                   "trust_monitor/events",
+			uniqueIDAttrExternalID: "sekey",
+			maxPageSize:            200,
+			timeWindowed:           true,
 		},
 	}
 )
@@ -105,75 +152,59 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		return nil, endpointErr
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpointInfo.URL, nil)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
 
-	// Timeout API calls that take longer than the configured timeout.
-	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
-	defer cancel()
-
-	req = req.WithContext(apiCtx)
+	var (
+		objects    []map[string]any
+		nextCursor *pagination.CompositeCursor[int64]
+		nextOffset *string
+	)
 
-	req.Header.Add("Authorization", endpointInfo.Auth)
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Add("Date", endpointInfo.Date)
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpointInfo.URL,
+		Header: http.Header{
+			"Authorization": {endpointInfo.Auth},
+			"Content-Type":  {"application/x-www-form-urlencoded"},
+			"Date":          {endpointInfo.Date},
+		},
+		DatasourceName:        "Duo",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Duo")
+		if readErr != nil {
+			return readErr
+		}
 
-	logger.Info("Sending request to datasource", fields.RequestURL(endpointInfo.URL))
+		var parseErr *framework.Error
 
-	res, err := d.Client.Do(req)
-	if err != nil {
-		logger.Error("Request to datasource failed",
-			fields.RequestURL(endpointInfo.URL),
-			fields.SGNLEventTypeError(),
-			zap.Error(err),
-		)
+		if entity.timeWindowed {
+			objects, nextOffset, parseErr = ParseTrustMonitorResponse(bodyBytes)
+		} else {
+			objects, nextCursor, parseErr = ParseResponse(bodyBytes)
+		}
 
-		return nil, customerror.UpdateError(&framework.Error{
-			Message: fmt.Sprintf("Failed to execute Duo request: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		},
-			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
-		)
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
 	}
 
-	defer res.Body.Close()
-
 	response := &Response{
-		StatusCode:       res.StatusCode,
-		RetryAfterHeader: res.Header.Get("Retry-After"),
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
 	}
 
-	if res.StatusCode != http.StatusOK {
-		logger.Error("Datasource responded with an error",
-			fields.RequestURL(endpointInfo.URL),
-			fields.ResponseStatusCode(response.StatusCode),
-			fields.ResponseRetryAfterHeader(response.RetryAfterHeader),
-			fields.ResponseBody(res.Body),
-			fields.SGNLEventTypeError(),
-		)
-
+	if response.StatusCode != http.StatusOK {
 		return response, nil
 	}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to read Duo response body: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
-	objects, nextCursor, frameworkErr := ParseResponse(body)
-	if frameworkErr != nil {
-		return nil, frameworkErr
+	if entity.transform != nil {
+		objects = entity.transform(objects)
 	}
 
 	response.NextCursor = nextCursor
+	response.NextOffset = nextOffset
 	response.Objects = objects
 
 	logger.Info("Datasource request completed successfully",
@@ -190,13 +221,10 @@ func ParseResponse(body []byte) (
 	nextCursor *pagination.CompositeCursor[int64],
 	err *framework.Error,
 ) {
-	var data *DatasourceResponse
+	var data DatasourceResponse
 
-	if unmarshalErr := json.Unmarshal(body, &data); unmarshalErr != nil || data == nil {
-		return nil, nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", unmarshalErr),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
 	}
 
 	if data.Metadata != nil && data.Metadata.NextOffset != nil {
@@ -207,3 +235,38 @@ func ParseResponse(body []byte) (
 
 	return data.Objects, nextCursor, nil
 }
+
+// removeSecretKeys removes the secret keys of the integrations, which are returned by Duo with the integrations
+// but must not be synced.
+func removeSecretKeys(integrations []map[string]any) []map[string]any {
+	for _, integration := range integrations {
+		delete(integration, "secret_key")
+	}
+
+	return integrations
+}
+
+// toPolicyAssignments returns the policy assignments of the integrations with a custom policy applied, with the
+// 'id' attribute set to "{integration_key}-{policy_key}". The integrations without a custom policy are only
+// subject to the global policy, and have no policy assignment.
+func toPolicyAssignments(integrations []map[string]any) []map[string]any {
+	assignments := make([]map[string]any, 0, len(integrations))
+
+	for _, integration := range integrations {
+		integrationKey, _ := integration["integration_key"].(string)
+		policyKey, _ := integration["policy_key"].(string)
+
+		if integrationKey == "" || policyKey == "" {
+			continue
+		}
+
+		assignments = append(assignments, map[string]any{
+			"id":               integrationKey + "-" + policyKey,
+			"integration_key":  integrationKey,
+			"integration_name": integration["name"],
+			"policy_key":       policyKey,
+		})
+	}
+
+	return assignments
+}
@@ -1049,6 +1049,193 @@ var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Req
 			]
 		  }`))
 
+	// Tokens Page 1:
+	case "/admin/v1/tokens?limit=2&offset=0":
+		w.Write([]byte(`{
+			"metadata": {
+			  "next_offset": 2,
+			  "total_objects": 3
+			},
+			"response": [
+			  {
+				"admins": [],
+				"serial": "0",
+				"token_id": "DHIZ34ALBA2445ND4AI2",
+				"totp_step": null,
+				"type": "d1",
+				"users": [
+				  {
+					"email": "user1@example.com",
+					"realname": "Test User 1",
+					"status": "active",
+					"user_id": "DUYC8O4O953VBGGKLHAL",
+					"username": "user1"
+				  }
+				]
+			  },
+			  {
+				"admins": [],
+				"serial": "YK0001",
+				"token_id": "DHEKH0JJIYC1LX3AZWO4",
+				"totp_step": null,
+				"type": "yk",
+				"users": []
+			  }
+			],
+			"stat": "OK"
+		  }`))
+
+	// Tokens Page 2:
+	case "/admin/v1/tokens?limit=2&offset=2":
+		w.Write([]byte(`{
+			"metadata": {
+			  "prev_offset": 0,
+			  "total_objects": 3
+			},
+			"response": [
+			  {
+				"admins": [],
+				"serial": "HOTP-0002",
+				"token_id": "DHUNT2EFQ0HDE3B8BH1Z",
+				"totp_step": 30,
+				"type": "t6",
+				"users": []
+			  }
+			],
+			"stat": "OK"
+		  }`))
+
+	// Bypass Codes Page 1:
+	case "/admin/v1/bypass_codes?limit=2&offset=0":
+		w.Write([]byte(`{
+			"metadata": {
+			  "next_offset": 2,
+			  "total_objects": 3
+			},
+			"response": [
+			  {
+				"admin_email": "admin@example.com",
+				"bypass_code_id": "DBP4ZIDUGK33GT7RQK0Q",
+				"created": 1706041056,
+				"expiration": 1706127456,
+				"reuse_count": 1,
+				"user": {
+				  "created": 1706041056,
+				  "email": "user1@example.com",
+				  "last_login": null,
+				  "realname": "Test User 1",
+				  "status": "active",
+				  "user_id": "DUYC8O4O953VBGGKLHAL",
+				  "username": "user1"
+				}
+			  },
+			  {
+				"admin_email": "admin@example.com",
+				"bypass_code_id": "DBPKVYKI5D0R1N4HMWPR",
+				"created": 1706041057,
+				"expiration": null,
+				"reuse_count": 0,
+				"user": {
+				  "created": 1706041057,
+				  "email": "user10@example.com",
+				  "last_login": null,
+				  "realname": "Test User 10",
+				  "status": "active",
+				  "user_id": "DUG1B8MRABMVKYVCFO8H",
+				  "username": "user10"
+				}
+			  }
+			],
+			"stat": "OK"
+		  }`))
+
+	// Bypass Codes Page 2:
+	case "/admin/v1/bypass_codes?limit=2&offset=2":
+		w.Write([]byte(`{
+			"metadata": {
+			  "prev_offset": 0,
+			  "total_objects": 3
+			},
+			"response": [
+			  {
+				"admin_email": "",
+				"bypass_code_id": "DBPF8WWKTQ6Q0HH7M0G8",
+				"created": 1706041058,
+				"expiration": null,
+				"reuse_count": 3,
+				"user": {
+				  "created": 1706041056,
+				  "email": "user2@example.com",
+				  "last_login": null,
+				  "realname": "Test User 2",
+				  "status": "active",
+				  "user_id": "DUHUTX7KGB6D15WTD3VY",
+				  "username": "user2"
+				}
+			  }
+			],
+			"stat": "OK"
+		  }`))
+
+	// Integrations Page 1:
+	case "/admin/v1/integrations?limit=2&offset=0":
+		w.Write([]byte(`{
+			"metadata": {
+			  "next_offset": 2,
+			  "total_objects": 3
+			},
+			"response": [
+			  {
+				"adminapi_admins": 0,
+				"enroll_policy": "enroll",
+				"groups_allowed": [],
+				"integration_key": "DIRWIH0ZZPV4G88B37VQ",
+				"name": "Salesforce SSO",
+				"policy_key": "POU7B4C0GB5DO1EXYXLI",
+				"secret_key": "QO4ZLqQVRIOZYkHfdPDORfcNf8LeXIbCWwHazY7o",
+				"self_service_allowed": true,
+				"type": "sso-salesforce",
+				"username_normalization_policy": "None"
+			  },
+			  {
+				"adminapi_admins": 0,
+				"enroll_policy": "enroll",
+				"groups_allowed": [],
+				"integration_key": "DIKMG8W4ABAUCWCTTUBO",
+				"name": "Microsoft RDP",
+				"secret_key": "7CdRyYdvnFWfOJQlZlvtcPtXJcJwSCV0cX5OdJyu",
+				"self_service_allowed": false,
+				"type": "rdp",
+				"username_normalization_policy": "Simple"
+			  }
+			],
+			"stat": "OK"
+		  }`))
+
+	// Integrations Page 2:
+	case "/admin/v1/integrations?limit=2&offset=2":
+		w.Write([]byte(`{
+			"metadata": {
+			  "prev_offset": 0,
+			  "total_objects": 3
+			},
+			"response": [
+			  {
+				"adminapi_admins": 0,
+				"enroll_policy": "deny",
+				"groups_allowed": ["DGXQ2A4O1P2YFD6IK3N9"],
+				"integration_key": "DI3FTJ1CXCZ7BSB4B5UH",
+				"name": "Workday SSO",
+				"policy_key": "POSEW4B6IRUKSZN1Q7LL",
+				"secret_key": "aKuDPB1X3IZLL2XrVYbL8VGdQDyRoGGEbbSOHyx7",
+				"self_service_allowed": false,
+				"type": "sso-workday",
+				"username_normalization_policy": "None"
+			  }
+			],
+			"stat": "OK"
+		  }`))
+
 	default:
 		w.WriteHeader(http.StatusNotFound)
 		w.Write([]byte(``))
@@ -2343,3 +2530,126 @@ func TestGetEndpointPage(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIntegrationPage(t *testing.T) {
+	client := &http.Client{
+		Timeout: time.Duration(60) * time.Second,
+	}
+
+	duoClient := duo.NewClient(client)
+	server := httptest.NewServer(TestServerHandler)
+	tests := map[string]struct {
+		context context.Context
+		request *duo.Request
+		wantRes *duo.Response
+		wantErr *framework.Error
+	}{
+		"integrations_first_page": {
+			context: context.Background(),
+			request: &duo.Request{
+				BaseURL:               server.URL,
+				IntegrationKey:        "test key",
+				Secret:                "test secret",
+				PageSize:              2,
+				EntityExternalID:      "Integration",
+				APIVersion:            "v1",
+				RequestTimeoutSeconds: 5,
+			},
+			wantRes: &duo.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"adminapi_admins":               float64(0),
+						"enroll_policy":                 "enroll",
+						"groups_allowed":                []any{},
+						"integration_key":               "DIRWIH0ZZPV4G88B37VQ",
+						"name":                          "Salesforce SSO",
+						"policy_key":                    "POU7B4C0GB5DO1EXYXLI",
+						"self_service_allowed":          true,
+						"type":                          "sso-salesforce",
+						"username_normalization_policy": "None",
+					},
+					{
+						"adminapi_admins":               float64(0),
+						"enroll_policy":                 "enroll",
+						"groups_allowed":                []any{},
+						"integration_key":               "DIKMG8W4ABAUCWCTTUBO",
+						"name":                          "Microsoft RDP",
+						"self_service_allowed":          false,
+						"type":                          "rdp",
+						"username_normalization_policy": "Simple",
+					},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+		},
+		"policy_assignments_first_page": {
+			context: context.Background(),
+			request: &duo.Request{
+				BaseURL:               server.URL,
+				IntegrationKey:        "test key",
+				Secret:                "test secret",
+				PageSize:              2,
+				EntityExternalID:      "PolicyAssignment",
+				APIVersion:            "v1",
+				RequestTimeoutSeconds: 5,
+			},
+			wantRes: &duo.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"id":               "DIRWIH0ZZPV4G88B37VQ-POU7B4C0GB5DO1EXYXLI",
+						"integration_key":  "DIRWIH0ZZPV4G88B37VQ",
+						"integration_name": "Salesforce SSO",
+						"policy_key":       "POU7B4C0GB5DO1EXYXLI",
+					},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+		},
+		"policy_assignments_last_page": {
+			context: context.Background(),
+			request: &duo.Request{
+				BaseURL:          server.URL,
+				IntegrationKey:   "test key",
+				Secret:           "test secret",
+				PageSize:         2,
+				EntityExternalID: "PolicyAssignment",
+				APIVersion:       "v1",
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+				RequestTimeoutSeconds: 5,
+			},
+			wantRes: &duo.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"id":               "DI3FTJ1CXCZ7BSB4B5UH-POSEW4B6IRUKSZN1Q7LL",
+						"integration_key":  "DI3FTJ1CXCZ7BSB4B5UH",
+						"integration_name": "Workday SSO",
+						"policy_key":       "POSEW4B6IRUKSZN1Q7LL",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := duoClient.GetPage(tt.context, tt.request)
+
+			if !reflect.DeepEqual(gotRes, tt.wantRes) {
+				t.Errorf("gotRes: %v, wantRes: %v", gotRes, tt.wantRes)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -43,8 +44,34 @@ func ConstructEndpoint(request *Request) (*EndpointInfo, *framework.Error) {
 		offset = *request.Cursor.Cursor
 	}
 
-	path := fmt.Sprintf("/admin/%s/%s", request.APIVersion, ValidEntityExternalIDs[request.EntityExternalID].path)
-	params := fmt.Sprintf("limit=%d&offset=%d", request.PageSize, offset)
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	// Each endpoint accepts a limit up to its own maximum, so cap the page size. The next_offset
+	// returned by Duo accounts for the number of objects actually returned.
+	pageSize := request.PageSize
+	if entity.maxPageSize > 0 && pageSize > entity.maxPageSize {
+		pageSize = entity.maxPageSize
+	}
+
+	path := fmt.Sprintf("/admin/%s/%s", request.APIVersion, entity.path)
+	params := fmt.Sprintf("limit=%d&offset=%d", pageSize, offset)
+
+	// The parameters must be sorted by name to be signed, see ConfigureAuth.
+	if entity.timeWindowed {
+		window := request.TrustMonitorCursor
+		if window == nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Time window is not set for the %s entity.", request.EntityExternalID),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		params = fmt.Sprintf("limit=%d&maxtime=%d&mintime=%d", pageSize, window.MaxTime, window.MinTime)
+
+		if window.Offset != nil {
+			params += "&offset=" + url.QueryEscape(*window.Offset)
+		}
+	}
 	auth, date := ConfigureAuth(request, path, params)
 	baseURL := request.BaseURL
 	endpoint := fmt.Sprintf("%s%s?%s", baseURL, path, params)
@@ -96,6 +96,99 @@ func TestConstructEndpoint(t *testing.T) {
 				URL: "https://api-xxxxxxxx.duosecurity.com/admin/v1/users?limit=100&offset=30",
 			},
 		},
+		"page_size_capped_to_endpoint_maximum": {
+			request: &duo.Request{
+				BaseURL:          "https://api-xxxxxxxx.duosecurity.com",
+				APIVersion:       "v1",
+				EntityExternalID: "Group",
+				PageSize:         500,
+				IntegrationKey:   "testkey",
+				Secret:           "testsecret",
+			},
+			wantEndpointInfo: &duo.EndpointInfo{
+				URL: "https://api-xxxxxxxx.duosecurity.com/admin/v1/groups?limit=100&offset=0",
+			},
+		},
+		"valid_token_endpoint": {
+			request: &duo.Request{
+				BaseURL:          "https://api-xxxxxxxx.duosecurity.com",
+				APIVersion:       "v1",
+				EntityExternalID: "Token",
+				PageSize:         1000,
+				IntegrationKey:   "testkey",
+				Secret:           "testsecret",
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](500),
+				},
+			},
+			wantEndpointInfo: &duo.EndpointInfo{
+				URL: "https://api-xxxxxxxx.duosecurity.com/admin/v1/tokens?limit=500&offset=500",
+			},
+		},
+		"valid_bypass_code_endpoint": {
+			request: &duo.Request{
+				BaseURL:          "https://api-xxxxxxxx.duosecurity.com",
+				APIVersion:       "v1",
+				EntityExternalID: "BypassCode",
+				PageSize:         100,
+				IntegrationKey:   "testkey",
+				Secret:           "testsecret",
+			},
+			wantEndpointInfo: &duo.EndpointInfo{
+				URL: "https://api-xxxxxxxx.duosecurity.com/admin/v1/bypass_codes?limit=100&offset=0",
+			},
+		},
+		"valid_trust_monitor_event_endpoint": {
+			request: &duo.Request{
+				BaseURL:          "https://api-xxxxxxxx.duosecurity.com",
+				APIVersion:       "v1",
+				EntityExternalID: "TrustMonitorEvent",
+				PageSize:         500,
+				IntegrationKey:   "testkey",
+				Secret:           "testsecret",
+				TrustMonitorCursor: &duo.TrustMonitorCursor{
+					MinTime: 1767225600000,
+					MaxTime: 1767311999999,
+					SyncEnd: 1767830400000,
+				},
+			},
+			wantEndpointInfo: &duo.EndpointInfo{
+				URL: "https://api-xxxxxxxx.duosecurity.com/admin/v1/trust_monitor/events?limit=200&maxtime=1767311999999&mintime=1767225600000",
+			},
+		},
+		"valid_trust_monitor_event_endpoint_with_offset": {
+			request: &duo.Request{
+				BaseURL:          "https://api-xxxxxxxx.duosecurity.com",
+				APIVersion:       "v1",
+				EntityExternalID: "TrustMonitorEvent",
+				PageSize:         100,
+				IntegrationKey:   "testkey",
+				Secret:           "testsecret",
+				TrustMonitorCursor: &duo.TrustMonitorCursor{
+					MinTime: 1767225600000,
+					MaxTime: 1767311999999,
+					SyncEnd: 1767830400000,
+					Offset:  testutil.GenPtr("1767230000000,SEDOR9BP00L23C6YUH5"),
+				},
+			},
+			wantEndpointInfo: &duo.EndpointInfo{
+				URL: "https://api-xxxxxxxx.duosecurity.com/admin/v1/trust_monitor/events?limit=100&maxtime=1767311999999&mintime=1767225600000&offset=1767230000000%2CSEDOR9BP00L23C6YUH5",
+			},
+		},
+		"trust_monitor_event_endpoint_without_time_window": {
+			request: &duo.Request{
+				BaseURL:          "https://api-xxxxxxxx.duosecurity.com",
+				APIVersion:       "v1",
+				EntityExternalID: "TrustMonitorEvent",
+				PageSize:         100,
+				IntegrationKey:   "testkey",
+				Secret:           "testsecret",
+			},
+			wantError: &framework.Error{
+				Message: "Time window is not set for the TrustMonitorEvent entity.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
 	}
 
 	for name, tt := range tests {
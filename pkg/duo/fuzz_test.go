@@ -0,0 +1,31 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package duo_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/duo"
+)
+
+// FuzzParseResponse checks that malformed Duo responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"stat":"OK","response":[{"user_id":"DU3RP9I2WOC59VZX672N"}],"metadata":{"next_offset":1,"total_objects":2}}`,
+		`{"stat":"FAIL","code":40002,"message":"Invalid request parameters"}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, _, err := duo.ParseResponse(body)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
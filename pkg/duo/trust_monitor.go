@@ -0,0 +1,188 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package duo
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+const (
+	// DefaultTrustMonitorLookbackDays is the number of days of Trust Monitor events returned by a full sync,
+	// if not configured.
+	DefaultTrustMonitorLookbackDays = 7
+
+	// DefaultTrustMonitorWindowHours is the duration of the time windows of Trust Monitor events, if not configured.
+	DefaultTrustMonitorWindowHours = 24
+
+	// maxTrustMonitorLookbackDays is the maximum age of the events returned by the Trust Monitor endpoint.
+	// https://duo.com/docs/adminapi#trust-monitor
+	maxTrustMonitorLookbackDays = 180
+
+	// trustMonitorCursorShape is the expected JSON shape of a TrustMonitorCursor, used in cursor errors.
+	trustMonitorCursorShape = `{"mintime":<int64>,"maxtime":<int64>,"syncEnd":<int64>,"offset":<string>}`
+)
+
+/*
+TrustMonitorCursor is the cursor of the TrustMonitorEvent entity. The times are Unix timestamps in
+milliseconds, the unit of the mintime and maxtime parameters of the Trust Monitor endpoint.
+
+The events of a sync are requested one time window at a time, from the start of the sync, i.e. the time of
+the last sync during an incremental sync, or the configured lookback otherwise, to the end of the sync, i.e.
+the time of the first page. Events surfaced after the end of the sync are left to the next sync. Duo returns
+the events surfaced between mintime and maxtime, both inclusive, so each window starts one millisecond after
+the end of the previous one. Offset is the opaque next_offset returned by Duo within the current window.
+*/
+type TrustMonitorCursor struct {
+	MinTime int64   `json:"mintime"`
+	MaxTime int64   `json:"maxtime"`
+	SyncEnd int64   `json:"syncEnd"`
+	Offset  *string `json:"offset,omitempty"`
+}
+
+// TrustMonitorResponse is the response of the Trust Monitor endpoint, which nests the events and the
+// pagination metadata within the response object, unlike the other endpoints.
+// https://duo.com/docs/adminapi#retrieve-events
+type TrustMonitorResponse struct {
+	Stat     *string `json:"stat"`
+	Response struct {
+		Events   []map[string]any `json:"events"`
+		Metadata *struct {
+			NextOffset *string `json:"next_offset,omitempty"`
+		} `json:"metadata,omitempty"`
+	} `json:"response"`
+}
+
+// MarshalTrustMonitorCursor marshals the struct and b64 encodes it.
+func MarshalTrustMonitorCursor(cursor *TrustMonitorCursor) (string, *framework.Error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	nextCursorBytes, marshalErr := json.Marshal(cursor)
+	if marshalErr != nil {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal Trust Monitor cursor into JSON: %v.", marshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(nextCursorBytes), nil
+}
+
+// UnmarshalTrustMonitorCursor decodes the b64 encoded string and unmarshals it.
+// nil is returned for the first page.
+func UnmarshalTrustMonitorCursor(cursor string, entityExternalID string) (*TrustMonitorCursor, *framework.Error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	trustMonitorCursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, trustMonitorCursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var trustMonitorCursor TrustMonitorCursor
+
+	if err := json.Unmarshal(trustMonitorCursorBytes, &trustMonitorCursor); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, trustMonitorCursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	if trustMonitorCursor.MinTime >= trustMonitorCursor.MaxTime ||
+		trustMonitorCursor.MaxTime > trustMonitorCursor.SyncEnd {
+		return nil, pagination.NewCursorError(
+			entityExternalID, trustMonitorCursorShape, "mintime must be less than maxtime, and maxtime not after syncEnd",
+		)
+	}
+
+	return &trustMonitorCursor, nil
+}
+
+// firstTrustMonitorCursor returns the cursor of the first page of a sync of the TrustMonitorEvent entity,
+// ending at now.
+func firstTrustMonitorCursor(syncStart *time.Time, now time.Time, lookbackDays, windowHours int) *TrustMonitorCursor {
+	syncEnd := now.UnixMilli()
+
+	start := now.AddDate(0, 0, -lookbackDays).UnixMilli()
+	if syncStart != nil {
+		start = syncStart.UnixMilli()
+	}
+
+	// An incremental sync started in the future returns no events.
+	if start >= syncEnd {
+		start = syncEnd - 1
+	}
+
+	return &TrustMonitorCursor{
+		MinTime: start,
+		MaxTime: trustMonitorWindowEnd(start, syncEnd, windowHours),
+		SyncEnd: syncEnd,
+	}
+}
+
+// nextTrustMonitorCursor returns the cursor of the page following the current page of the TrustMonitorEvent
+// entity, given the offset of the next page within the time window. nil is returned after the last time window.
+func nextTrustMonitorCursor(current *TrustMonitorCursor, nextOffset *string, windowHours int) *TrustMonitorCursor {
+	if nextOffset != nil {
+		return &TrustMonitorCursor{
+			MinTime: current.MinTime,
+			MaxTime: current.MaxTime,
+			SyncEnd: current.SyncEnd,
+			Offset:  nextOffset,
+		}
+	}
+
+	if current.MaxTime >= current.SyncEnd {
+		return nil
+	}
+
+	return &TrustMonitorCursor{
+		MinTime: current.MaxTime + 1,
+		MaxTime: trustMonitorWindowEnd(current.MaxTime+1, current.SyncEnd, windowHours),
+		SyncEnd: current.SyncEnd,
+	}
+}
+
+// trustMonitorWindowEnd returns the inclusive end of the time window starting at minTime. Duo requires mintime
+// to be less than maxtime, so the window is extended to the end of the sync rather than leave a last window of a
+// single millisecond.
+func trustMonitorWindowEnd(minTime, syncEnd int64, windowHours int) int64 {
+	maxTime := minTime + (time.Duration(windowHours) * time.Hour).Milliseconds() - 1
+	if maxTime >= syncEnd-1 {
+		return syncEnd
+	}
+
+	return maxTime
+}
+
+// ParseTrustMonitorResponse parses the events and the offset of the next page within the time window, if any,
+// from a response of the Trust Monitor endpoint.
+func ParseTrustMonitorResponse(body []byte) (
+	objects []map[string]any,
+	nextOffset *string,
+	err *framework.Error,
+) {
+	var data TrustMonitorResponse
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	if data.Response.Metadata != nil && data.Response.Metadata.NextOffset != nil &&
+		*data.Response.Metadata.NextOffset != "" {
+		nextOffset = data.Response.Metadata.NextOffset
+	}
+
+	return data.Response.Events, nextOffset, nil
+}
@@ -0,0 +1,383 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package duo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/duo"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+var trustMonitorHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.RequestURI() {
+	case "/admin/v1/trust_monitor/events?limit=2&maxtime=1767355199999&mintime=1767268800000":
+		w.Write([]byte(`{
+			"stat": "OK",
+			"response": {
+				"events": [
+					{
+						"sekey": "SEDOR9BP00L23C6YUH5",
+						"type": "auth",
+						"state": "new",
+						"priority_event": true,
+						"priority_reasons": [{"label": "New country", "type": "location"}],
+						"surfaced_timestamp": 1767270000000,
+						"triaged_as_interesting": false
+					},
+					{
+						"sekey": "SEWIGPF0M4KX2LRZ7T9",
+						"type": "bypass_status_enabled",
+						"state": "new",
+						"priority_event": false,
+						"priority_reasons": [],
+						"surfaced_timestamp": 1767280000000,
+						"triaged_as_interesting": false
+					}
+				],
+				"metadata": {
+					"next_offset": "1767280000000,SEWIGPF0M4KX2LRZ7T9"
+				}
+			}
+		}`))
+	case "/admin/v1/trust_monitor/events?limit=2&maxtime=1767355199999&mintime=1767268800000&offset=1767280000000%2CSEWIGPF0M4KX2LRZ7T9":
+		w.Write([]byte(`{
+			"stat": "OK",
+			"response": {
+				"events": [
+					{
+						"sekey": "SEX2J7PA3C8QNU5YBD1",
+						"type": "device_registration",
+						"state": "processed",
+						"priority_event": false,
+						"priority_reasons": [],
+						"surfaced_timestamp": 1767290000000,
+						"triaged_as_interesting": true
+					}
+				],
+				"metadata": {}
+			}
+		}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestGetTrustMonitorEventPage(t *testing.T) {
+	duoClient := duo.NewClient(&http.Client{
+		Timeout: time.Duration(60) * time.Second,
+	})
+	server := httptest.NewServer(trustMonitorHandler)
+
+	tests := map[string]struct {
+		request *duo.Request
+		wantRes *duo.Response
+		wantErr *framework.Error
+	}{
+		"first_page": {
+			request: &duo.Request{
+				BaseURL:          server.URL,
+				IntegrationKey:   "test key",
+				Secret:           "test secret",
+				PageSize:         2,
+				EntityExternalID: duo.TrustMonitorEvent,
+				APIVersion:       "v1",
+				TrustMonitorCursor: &duo.TrustMonitorCursor{
+					MinTime: 1767268800000,
+					MaxTime: 1767355199999,
+					SyncEnd: 1767873600000,
+				},
+				RequestTimeoutSeconds: 5,
+			},
+			wantRes: &duo.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"sekey":                  "SEDOR9BP00L23C6YUH5",
+						"type":                   "auth",
+						"state":                  "new",
+						"priority_event":         true,
+						"priority_reasons":       []any{map[string]any{"label": "New country", "type": "location"}},
+						"surfaced_timestamp":     float64(1767270000000),
+						"triaged_as_interesting": false,
+					},
+					{
+						"sekey":                  "SEWIGPF0M4KX2LRZ7T9",
+						"type":                   "bypass_status_enabled",
+						"state":                  "new",
+						"priority_event":         false,
+						"priority_reasons":       []any{},
+						"surfaced_timestamp":     float64(1767280000000),
+						"triaged_as_interesting": false,
+					},
+				},
+				NextOffset: testutil.GenPtr("1767280000000,SEWIGPF0M4KX2LRZ7T9"),
+			},
+		},
+		"last_page_of_window": {
+			request: &duo.Request{
+				BaseURL:          server.URL,
+				IntegrationKey:   "test key",
+				Secret:           "test secret",
+				PageSize:         2,
+				EntityExternalID: duo.TrustMonitorEvent,
+				APIVersion:       "v1",
+				TrustMonitorCursor: &duo.TrustMonitorCursor{
+					MinTime: 1767268800000,
+					MaxTime: 1767355199999,
+					SyncEnd: 1767873600000,
+					Offset:  testutil.GenPtr("1767280000000,SEWIGPF0M4KX2LRZ7T9"),
+				},
+				RequestTimeoutSeconds: 5,
+			},
+			wantRes: &duo.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"sekey":                  "SEX2J7PA3C8QNU5YBD1",
+						"type":                   "device_registration",
+						"state":                  "processed",
+						"priority_event":         false,
+						"priority_reasons":       []any{},
+						"surfaced_timestamp":     float64(1767290000000),
+						"triaged_as_interesting": true,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := duoClient.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotRes, tt.wantRes) {
+				t.Errorf("gotRes: %v, wantRes: %v", gotRes, tt.wantRes)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+// trustMonitorClient returns a page with the next offset, and records the requests.
+type trustMonitorClient struct {
+	nextOffset  *string
+	gotRequests []*duo.Request
+}
+
+func (c *trustMonitorClient) GetPage(_ context.Context, request *duo.Request) (*duo.Response, *framework.Error) {
+	c.gotRequests = append(c.gotRequests, request)
+
+	return &duo.Response{
+		StatusCode: http.StatusOK,
+		Objects:    []map[string]any{{"sekey": "SEDOR9BP00L23C6YUH5", "surfaced_timestamp": float64(1767870000001)}},
+		NextOffset: c.nextOffset,
+	}, nil
+}
+
+func mustMarshalTrustMonitorCursor(t *testing.T, cursor *duo.TrustMonitorCursor) string {
+	t.Helper()
+
+	cursorStr, err := duo.MarshalTrustMonitorCursor(cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return cursorStr
+}
+
+func TestAdapterGetTrustMonitorEventPage(t *testing.T) {
+	now := time.Date(2026, 1, 8, 12, 0, 0, 500, time.UTC)
+	syncEnd := int64(1767873600000)
+
+	tests := map[string]struct {
+		config                 *duo.Config
+		cursor                 func(t *testing.T) string
+		respNextOffset         *string
+		wantTrustMonitorCursor *duo.TrustMonitorCursor
+		wantNextCursor         func(t *testing.T) string
+		wantErr                *framework.Error
+	}{
+		"first_page_full_sync": {
+			config: &duo.Config{APIVersion: "v1"},
+			wantTrustMonitorCursor: &duo.TrustMonitorCursor{
+				MinTime: 1767268800000,
+				MaxTime: 1767355199999,
+				SyncEnd: syncEnd,
+			},
+			wantNextCursor: func(t *testing.T) string {
+				return mustMarshalTrustMonitorCursor(t, &duo.TrustMonitorCursor{
+					MinTime: 1767355200000,
+					MaxTime: 1767441599999,
+					SyncEnd: syncEnd,
+				})
+			},
+		},
+		"first_page_incremental_sync": {
+			config: &duo.Config{
+				CommonConfig: &config.CommonConfig{
+					SyncMode:             config.SyncModeIncremental,
+					IncrementalSyncSince: testutil.GenPtr(time.Date(2026, 1, 8, 9, 0, 0, 0, time.UTC)),
+				},
+				APIVersion:              "v1",
+				TrustMonitorWindowHours: testutil.GenPtr(1),
+			},
+			wantTrustMonitorCursor: &duo.TrustMonitorCursor{
+				MinTime: 1767862800000,
+				MaxTime: 1767866399999,
+				SyncEnd: syncEnd,
+			},
+			wantNextCursor: func(t *testing.T) string {
+				return mustMarshalTrustMonitorCursor(t, &duo.TrustMonitorCursor{
+					MinTime: 1767866400000,
+					MaxTime: 1767869999999,
+					SyncEnd: syncEnd,
+				})
+			},
+		},
+		"offset_within_window": {
+			config: &duo.Config{APIVersion: "v1"},
+			cursor: func(t *testing.T) string {
+				return mustMarshalTrustMonitorCursor(t, &duo.TrustMonitorCursor{
+					MinTime: 1767355200000,
+					MaxTime: 1767441599999,
+					SyncEnd: syncEnd,
+				})
+			},
+			respNextOffset: testutil.GenPtr("1767360000000,SEDOR9BP00L23C6YUH5"),
+			wantTrustMonitorCursor: &duo.TrustMonitorCursor{
+				MinTime: 1767355200000,
+				MaxTime: 1767441599999,
+				SyncEnd: syncEnd,
+			},
+			wantNextCursor: func(t *testing.T) string {
+				return mustMarshalTrustMonitorCursor(t, &duo.TrustMonitorCursor{
+					MinTime: 1767355200000,
+					MaxTime: 1767441599999,
+					SyncEnd: syncEnd,
+					Offset:  testutil.GenPtr("1767360000000,SEDOR9BP00L23C6YUH5"),
+				})
+			},
+		},
+		"last_window_extended_to_sync_end": {
+			config: &duo.Config{
+				CommonConfig: &config.CommonConfig{
+					SyncMode:             config.SyncModeIncremental,
+					IncrementalSyncSince: testutil.GenPtr(time.Date(2026, 1, 8, 11, 0, 0, 0, time.UTC)),
+				},
+				APIVersion:              "v1",
+				TrustMonitorWindowHours: testutil.GenPtr(1),
+			},
+			wantTrustMonitorCursor: &duo.TrustMonitorCursor{
+				MinTime: 1767870000000,
+				MaxTime: syncEnd,
+				SyncEnd: syncEnd,
+			},
+			wantNextCursor: func(_ *testing.T) string {
+				return ""
+			},
+		},
+		"invalid_cursor": {
+			config: &duo.Config{APIVersion: "v1"},
+			cursor: func(t *testing.T) string {
+				return mustMarshalTrustMonitorCursor(t, &duo.TrustMonitorCursor{
+					MinTime: 1767441599999,
+					MaxTime: 1767355200000,
+					SyncEnd: syncEnd,
+				})
+			},
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity TrustMonitorEvent: mintime must be less than maxtime, and maxtime not after syncEnd. " +
+					`Expected cursor shape: {"mintime":<int64>,"maxtime":<int64>,"syncEnd":<int64>,"offset":<string>}. ` +
+					"Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &trustMonitorClient{nextOffset: tt.respNextOffset}
+
+			adapter := &duo.Adapter{
+				DuoClient: client,
+				Now:       func() time.Time { return now },
+			}
+
+			request := &framework.Request[duo.Config]{
+				Address: "https://api-xxxxxxxx.duosecurity.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "testkey",
+						Password: "testsecret",
+					},
+				},
+				Config: tt.config,
+				Entity: framework.EntityConfig{
+					ExternalId: duo.TrustMonitorEvent,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "sekey",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "surfaced_timestamp",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 100,
+			}
+
+			if tt.cursor != nil {
+				request.Cursor = tt.cursor(t)
+			}
+
+			response := adapter.GetPage(context.Background(), request)
+
+			if !reflect.DeepEqual(response.Error, tt.wantErr) {
+				t.Fatalf("gotErr: %v, wantErr: %v", response.Error, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if len(client.gotRequests) != 1 {
+				t.Fatalf("got %d requests, want 1", len(client.gotRequests))
+			}
+
+			if !reflect.DeepEqual(client.gotRequests[0].TrustMonitorCursor, tt.wantTrustMonitorCursor) {
+				t.Errorf("gotTrustMonitorCursor: %v, wantTrustMonitorCursor: %v",
+					client.gotRequests[0].TrustMonitorCursor, tt.wantTrustMonitorCursor)
+			}
+
+			wantObjects := []framework.Object{
+				{
+					"sekey":              "SEDOR9BP00L23C6YUH5",
+					"surfaced_timestamp": time.Date(2026, 1, 8, 11, 0, 0, 1000000, time.UTC),
+				},
+			}
+
+			if !reflect.DeepEqual(response.Success.Objects, wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", response.Success.Objects, wantObjects)
+			}
+
+			if wantNextCursor := tt.wantNextCursor(t); response.Success.NextCursor != wantNextCursor {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", response.Success.NextCursor, wantNextCursor)
+			}
+		})
+	}
+}
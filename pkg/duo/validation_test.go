@@ -10,7 +10,9 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
 	duo_adapter "github.com/sgnl-ai/adapters/pkg/duo"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
 )
 
 func TestValidateGetPageRequest(t *testing.T) {
@@ -470,6 +472,106 @@ func TestValidateGetPageRequest(t *testing.T) {
 				PageSize: 250,
 			},
 		},
+		"valid_trust_monitor_event": {
+			request: &framework.Request[duo_adapter.Config]{
+				Config:  &duo_adapter.Config{APIVersion: "v1", TrustMonitorLookbackDays: testutil.GenPtr(180), TrustMonitorWindowHours: testutil.GenPtr(6)},
+				Address: "api-xxxxxxxx.duosecurity.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "TrustMonitorEvent",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "sekey",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 200,
+			},
+		},
+		"invalid_trust_monitor_lookback_days": {
+			request: &framework.Request[duo_adapter.Config]{
+				Config:  &duo_adapter.Config{APIVersion: "v1", TrustMonitorLookbackDays: testutil.GenPtr(181)},
+				Address: "api-xxxxxxxx.duosecurity.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "TrustMonitorEvent",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "sekey",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: "Duo config is invalid: trustMonitorLookbackDays must be between 1 and 180.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_trust_monitor_window_hours": {
+			request: &framework.Request[duo_adapter.Config]{
+				Config:  &duo_adapter.Config{APIVersion: "v1", TrustMonitorWindowHours: testutil.GenPtr(0)},
+				Address: "api-xxxxxxxx.duosecurity.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "TrustMonitorEvent",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "sekey",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: "Duo config is invalid: trustMonitorWindowHours must be greater than 0.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_incremental_sync_without_since": {
+			request: &framework.Request[duo_adapter.Config]{
+				Config:  &duo_adapter.Config{APIVersion: "v1", CommonConfig: &config.CommonConfig{SyncMode: config.SyncModeIncremental}},
+				Address: "api-xxxxxxxx.duosecurity.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "Test Integration Key",
+						Password: "Test Secret",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "TrustMonitorEvent",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "sekey",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: "Duo config is invalid: incrementalSyncSince is required when syncMode is INCREMENTAL.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 	}
 
 	adapter := duo_adapter.Adapter{}
@@ -0,0 +1,233 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package egress restricts the hosts the adapters can send HTTP requests to.
+//
+// The address of a datasource is provided by each request, and requests may be sent through the
+// connector proxy from within the network of a customer. An allowlist prevents a misconfigured
+// datasource address from making an adapter reach arbitrary endpoints, e.g. internal services.
+package egress
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"strings"
+)
+
+// DefaultAllowlistKey is the key of the allowlist applied to the datasource types without an allowlist
+// of their own, in the file read by LoadAllowlists.
+const DefaultAllowlistKey = "*"
+
+// ErrHostNotAllowed is returned when a request is sent to a host which is not allowed.
+var ErrHostNotAllowed = errors.New("host is not in the egress allowlist")
+
+// Allowlist is a list of the hosts requests can be sent to.
+type Allowlist struct {
+	// domains are the allowed domain names, lowercase. A domain prefixed with "*." allows its subdomains.
+	domains []string
+
+	// prefixes are the allowed IP address ranges.
+	prefixes []netip.Prefix
+}
+
+// NewAllowlist returns an Allowlist from a list of entries. Each entry is either:
+//   - a domain name, e.g. "example.okta.com", which only allows this exact domain.
+//   - a wildcard domain name, e.g. "*.okta.com", which allows all the subdomains of the domain.
+//   - an IP address or a CIDR range, e.g. "10.0.0.0/8", which allows the IP addresses in the range.
+//
+// Hosts are matched as written in the request URL: domain names are not resolved, as the requests sent
+// through the connector proxy are resolved from the network of the connector. Requests to an IP address
+// must therefore match an IP address range, and requests to a domain name must match a domain name.
+func NewAllowlist(entries []string) (*Allowlist, error) {
+	allowlist := &Allowlist{}
+
+	for _, entry := range entries {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+
+		switch {
+		case entry == "":
+			return nil, errors.New("allowlist entry is empty")
+		case strings.Contains(entry, "/"):
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("allowlist entry %s is not a valid CIDR range: %w", entry, err)
+			}
+
+			allowlist.prefixes = append(allowlist.prefixes, prefix.Masked())
+		default:
+			if addr, err := netip.ParseAddr(entry); err == nil {
+				allowlist.prefixes = append(allowlist.prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+
+				continue
+			}
+
+			if strings.Contains(strings.TrimPrefix(entry, "*."), "*") {
+				return nil, fmt.Errorf("allowlist entry %s may only contain a leading wildcard", entry)
+			}
+
+			allowlist.domains = append(allowlist.domains, entry)
+		}
+	}
+
+	return allowlist, nil
+}
+
+// Allows returns true if requests can be sent to the host, with or without a port.
+// A nil Allowlist allows all hosts.
+func (a *Allowlist) Allows(host string) bool {
+	if a == nil {
+		return true
+	}
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	host = strings.ToLower(strings.TrimSuffix(strings.Trim(host, "[]"), "."))
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addr = addr.Unmap()
+
+		for _, prefix := range a.prefixes {
+			if prefix.Contains(addr) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, domain := range a.domains {
+		if suffix, ok := strings.CutPrefix(domain, "*"); ok {
+			if strings.HasSuffix(host, suffix) && len(host) > len(suffix) {
+				return true
+			}
+
+			continue
+		}
+
+		if host == domain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Allowlists holds the allowlist of each datasource type.
+type Allowlists map[string]*Allowlist
+
+// LoadAllowlists reads the allowlists from the JSON file at the given path. The file contains an object
+// mapping each datasource type (e.g. "Okta-1.0.1") to a list of allowlist entries, as described in
+// NewAllowlist. The entries under DefaultAllowlistKey apply to the datasource types not listed.
+//
+// For example:
+//
+//	{"Okta-1.0.1": ["*.okta.com", "*.oktapreview.com"], "*": ["*.example.com"]}
+func LoadAllowlists(path string) (Allowlists, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read egress allowlists: %w", err)
+	}
+
+	var entries map[string][]string
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse egress allowlists: %w", err)
+	}
+
+	allowlists := make(Allowlists, len(entries))
+
+	for datasourceType, typeEntries := range entries {
+		allowlist, err := NewAllowlist(typeEntries)
+		if err != nil {
+			return nil, fmt.Errorf("egress allowlist of %s is invalid: %w", datasourceType, err)
+		}
+
+		allowlists[datasourceType] = allowlist
+	}
+
+	return allowlists, nil
+}
+
+// For returns the allowlist of the datasource type, or nil if requests to any host are allowed.
+func (a Allowlists) For(datasourceType string) *Allowlist {
+	if allowlist, ok := a[datasourceType]; ok {
+		return allowlist
+	}
+
+	return a[DefaultAllowlistKey]
+}
+
+// Transport is an http.RoundTripper rejecting the requests to hosts which are not allowed,
+// including the requests following redirects.
+type Transport struct {
+	next      http.RoundTripper
+	allowlist *Allowlist
+}
+
+// NewTransport returns a Transport sending the allowed requests with the next RoundTripper.
+// http.DefaultTransport is used if next is nil.
+func NewTransport(next http.RoundTripper, allowlist *Allowlist) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Transport{
+		next:      next,
+		allowlist: allowlist,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.allowlist.Allows(req.URL.Host) {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+
+		return nil, fmt.Errorf("%w: %s", ErrHostNotAllowed, req.URL.Hostname())
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// WrapClient restricts the requests sent by the client to the hosts allowed by the allowlist.
+// The client is returned unchanged if the allowlist is nil.
+func WrapClient(client *http.Client, allowlist *Allowlist) *http.Client {
+	if allowlist == nil {
+		return client
+	}
+
+	client.Transport = NewTransport(client.Transport, allowlist)
+
+	return client
+}
+
+// DirectDatasourceTypes is the set of datasource types whose adapters send requests directly to the datasource,
+// bypassing the connector proxy. This avoids the latency of the proxy hop for the SaaS datasources reachable from
+// the network of the adapters.
+type DirectDatasourceTypes map[string]struct{}
+
+// ParseDirectDatasourceTypes parses a comma separated list of datasource types, e.g. "Okta-1.0.1,Slack-1.0.0".
+func ParseDirectDatasourceTypes(s string) DirectDatasourceTypes {
+	datasourceTypes := make(DirectDatasourceTypes)
+
+	for datasourceType := range strings.SplitSeq(s, ",") {
+		if datasourceType = strings.TrimSpace(datasourceType); datasourceType != "" {
+			datasourceTypes[datasourceType] = struct{}{}
+		}
+	}
+
+	return datasourceTypes
+}
+
+// Has returns true if the adapter of the datasource type sends requests directly to the datasource.
+func (d DirectDatasourceTypes) Has(datasourceType string) bool {
+	_, ok := d[datasourceType]
+
+	return ok
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package egress_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/egress"
+)
+
+func TestNewAllowlist(t *testing.T) {
+	tests := map[string]struct {
+		entries []string
+		wantErr bool
+	}{
+		"valid": {
+			entries: []string{"example.okta.com", "*.okta.com", "10.0.0.0/8", "192.168.1.10", "fd00::/8"},
+		},
+		"empty_entry": {
+			entries: []string{""},
+			wantErr: true,
+		},
+		"invalid_cidr": {
+			entries: []string{"10.0.0.0/33"},
+			wantErr: true,
+		},
+		"inner_wildcard": {
+			entries: []string{"api.*.okta.com"},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := egress.NewAllowlist(tt.entries)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAllowlistAllows(t *testing.T) {
+	allowlist, err := egress.NewAllowlist([]string{"Example.okta.com", "*.oktapreview.com", "10.0.0.0/8", "fd00::1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		allowlist *egress.Allowlist
+		host      string
+		want      bool
+	}{
+		"exact_domain": {
+			allowlist: allowlist,
+			host:      "example.okta.com",
+			want:      true,
+		},
+		"exact_domain_with_port_and_case": {
+			allowlist: allowlist,
+			host:      "EXAMPLE.okta.com:443",
+			want:      true,
+		},
+		"exact_domain_with_trailing_dot": {
+			allowlist: allowlist,
+			host:      "example.okta.com.",
+			want:      true,
+		},
+		"subdomain_of_exact_domain": {
+			allowlist: allowlist,
+			host:      "api.example.okta.com",
+			want:      false,
+		},
+		"wildcard_subdomain": {
+			allowlist: allowlist,
+			host:      "dev-123.oktapreview.com",
+			want:      true,
+		},
+		"wildcard_apex": {
+			allowlist: allowlist,
+			host:      "oktapreview.com",
+			want:      false,
+		},
+		"wildcard_suffix_without_dot": {
+			allowlist: allowlist,
+			host:      "evil-oktapreview.com",
+			want:      false,
+		},
+		"ip_in_range": {
+			allowlist: allowlist,
+			host:      "10.1.2.3:8080",
+			want:      true,
+		},
+		"ip_out_of_range": {
+			allowlist: allowlist,
+			host:      "169.254.169.254",
+			want:      false,
+		},
+		"ipv6": {
+			allowlist: allowlist,
+			host:      "[fd00::1]:443",
+			want:      true,
+		},
+		"ipv4_mapped_ipv6": {
+			allowlist: allowlist,
+			host:      "[::ffff:10.0.0.1]",
+			want:      true,
+		},
+		"other_domain": {
+			allowlist: allowlist,
+			host:      "metadata.google.internal",
+			want:      false,
+		},
+		"nil_allowlist": {
+			allowlist: nil,
+			host:      "metadata.google.internal",
+			want:      true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.allowlist.Allows(tt.host); got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadAllowlists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "allowlists.json")
+
+	err := os.WriteFile(path, []byte(`{"Okta-1.0.1": ["*.okta.com"], "*": ["*.example.com"]}`), 0o600)
+	if err != nil {
+		t.Fatalf("failed to write allowlists: %v", err)
+	}
+
+	allowlists, err := egress.LoadAllowlists(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !allowlists.For("Okta-1.0.1").Allows("example.okta.com") || allowlists.For("Okta-1.0.1").Allows("api.example.com") {
+		t.Errorf("expected the Okta allowlist to only allow okta.com subdomains")
+	}
+
+	if !allowlists.For("Jira-1.0.0").Allows("api.example.com") {
+		t.Errorf("expected the default allowlist to apply to unlisted datasource types")
+	}
+
+	if egress.Allowlists(nil).For("Jira-1.0.0") != nil {
+		t.Errorf("expected no allowlist without allowlists")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"Okta-1.0.1": ["10.0.0.0/33"]}`), 0o600); err != nil {
+		t.Fatalf("failed to write allowlists: %v", err)
+	}
+
+	if _, err := egress.LoadAllowlists(path); err == nil {
+		t.Errorf("expected error for invalid allowlist")
+	}
+}
+
+func TestParseDirectDatasourceTypes(t *testing.T) {
+	datasourceTypes := egress.ParseDirectDatasourceTypes(" Okta-1.0.1, ,Slack-1.0.0,")
+
+	for _, datasourceType := range []string{"Okta-1.0.1", "Slack-1.0.0"} {
+		if !datasourceTypes.Has(datasourceType) {
+			t.Errorf("expected %s to send requests directly", datasourceType)
+		}
+	}
+
+	if datasourceTypes.Has("Jira-1.0.0") || len(datasourceTypes) != 2 {
+		t.Errorf("got datasource types: %v, want: [Okta-1.0.1 Slack-1.0.0]", datasourceTypes)
+	}
+
+	if egress.ParseDirectDatasourceTypes("").Has("Okta-1.0.1") {
+		t.Errorf("expected no datasource type to send requests directly by default")
+	}
+}
+
+func TestWrapClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	allowlist, err := egress.NewAllowlist([]string{"127.0.0.1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client := egress.WrapClient(&http.Client{}, allowlist)
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status: %v, want: %v", resp.StatusCode, http.StatusOK)
+	}
+
+	// Requests following redirects are checked too.
+	if _, err := client.Get(server.URL + "/redirect"); !errors.Is(err, egress.ErrHostNotAllowed) {
+		t.Errorf("got error: %v, want: %v", err, egress.ErrHostNotAllowed)
+	}
+
+	if _, err := client.Get("http://localhost:1/"); !errors.Is(err, egress.ErrHostNotAllowed) {
+		t.Errorf("got error: %v, want: %v", err, egress.ErrHostNotAllowed)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package customerror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HTTPStatusError is returned by the clients that don't return a framework.Error, e.g. the file stores, when the
+// datasource responds with an unsuccessful HTTP status, so that the error can be classified from the status.
+type HTTPStatusError struct {
+	// Service is the name of the service which responded, e.g. "the blob service".
+	Service string
+
+	StatusCode       int
+	RetryAfterHeader string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("%s responded with HTTP status %d", e.Service, e.StatusCode)
+}
+
+// IsRetryable returns whether the request which failed with the error may succeed if retried as is, e.g. after
+// the datasource rate limited the request or was temporarily unavailable. Other errors are terminal, e.g. an
+// invalid token, and the sync should stop until the datasource config or the datasource itself is fixed.
+func IsRetryable(err *framework.Error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch err.Code {
+	case api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+		api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE:
+		return true
+	default:
+		return err.RetryAfter != nil
+	}
+}
+
+// WithRetryClassification replaces the ERROR_CODE_INTERNAL code of the error of a failed request with the code
+// of the cause of the failure, so that callers can tell retryable errors from terminal ones, see IsRetryable:
+//   - an HTTPStatusError is classified like the HTTP status, see web.HTTPError.
+//   - a gRPC status returned by the connector service is classified from its code, e.g. RESOURCE_EXHAUSTED
+//     as ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS.
+//   - timeouts, refused and reset connections are classified as ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE.
+//
+// Errors with a more specific code and errors of unknown causes are left unchanged.
+func WithRetryClassification(reqErr error) ErrorModifier {
+	return func(frameworkErr *framework.Error) {
+		if frameworkErr == nil || reqErr == nil || frameworkErr.Code != api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL {
+			return
+		}
+
+		var statusErr *HTTPStatusError
+		if errors.As(reqErr, &statusErr) {
+			if httpErr := web.HTTPError(statusErr.StatusCode, statusErr.RetryAfterHeader); httpErr != nil {
+				frameworkErr.Code = httpErr.Code
+				frameworkErr.RetryAfter = httpErr.RetryAfter
+			}
+
+			return
+		}
+
+		if st, ok := status.FromError(reqErr); ok {
+			switch st.Code() {
+			case codes.ResourceExhausted:
+				frameworkErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS
+			case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted:
+				frameworkErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE
+			}
+
+			return
+		}
+
+		if isTransient(reqErr) {
+			frameworkErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE
+		}
+	}
+}
+
+// isTransient returns whether the error is caused by a failure of the connection to the datasource which
+// is likely to be temporary.
+func isTransient(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll
+package customerror_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"syscall"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithRetryClassification(t *testing.T) {
+	retryAfter := 30 * time.Second
+
+	tests := map[string]struct {
+		inputError *framework.Error
+		reqErr     error
+		wantError  *framework.Error
+	}{
+		"http_status_too_many_requests": {
+			inputError: &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+			reqErr: fmt.Errorf("failed to read: %w", &customerror.HTTPStatusError{
+				Service:          "the blob service",
+				StatusCode:       http.StatusTooManyRequests,
+				RetryAfterHeader: "30",
+			}),
+			wantError: &framework.Error{
+				Message:    "Failed.",
+				Code:       api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+				RetryAfter: &retryAfter,
+			},
+		},
+		"http_status_unauthorized": {
+			inputError: &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+			reqErr:     &customerror.HTTPStatusError{Service: "the blob service", StatusCode: http.StatusUnauthorized},
+			wantError: &framework.Error{
+				Message: "Failed.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+		},
+		"grpc_resource_exhausted": {
+			inputError: &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+			reqErr:     fmt.Errorf("proxy request failed: %w", status.Error(codes.ResourceExhausted, "too many requests")),
+			wantError: &framework.Error{
+				Message: "Failed.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+			},
+		},
+		"grpc_unavailable": {
+			inputError: &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+			reqErr:     status.Error(codes.Unavailable, "connector unavailable"),
+			wantError: &framework.Error{
+				Message: "Failed.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+			},
+		},
+		"grpc_invalid_argument": {
+			inputError: &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+			reqErr:     status.Error(codes.InvalidArgument, "invalid request"),
+			wantError:  &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+		},
+		"timeout": {
+			inputError: &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+			reqErr:     fmt.Errorf("timed out: %w", context.DeadlineExceeded),
+			wantError: &framework.Error{
+				Message: "Failed.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+			},
+		},
+		"connection_reset": {
+			inputError: &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+			reqErr:     fmt.Errorf("read: %w", syscall.ECONNRESET),
+			wantError: &framework.Error{
+				Message: "Failed.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+			},
+		},
+		"unknown_cause": {
+			inputError: &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+			reqErr:     errors.New("unsupported protocol scheme"),
+			wantError:  &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+		},
+		"specific_code_unchanged": {
+			inputError: &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED},
+			reqErr:     context.DeadlineExceeded,
+			wantError:  &framework.Error{Message: "Failed.", Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED},
+		},
+		"nil_input": {
+			inputError: nil,
+			reqErr:     context.DeadlineExceeded,
+			wantError:  nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := customerror.UpdateError(tt.inputError, customerror.WithRetryClassification(tt.reqErr))
+
+			if !reflect.DeepEqual(got, tt.wantError) {
+				t.Errorf("UpdateError() = %v, want %v", got, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	retryAfter := time.Minute
+
+	tests := map[string]struct {
+		err  *framework.Error
+		want bool
+	}{
+		"too_many_requests": {
+			err:  &framework.Error{Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS},
+			want: true,
+		},
+		"temporarily_unavailable": {
+			err:  &framework.Error{Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE},
+			want: true,
+		},
+		"retry_after": {
+			err:  &framework.Error{Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED, RetryAfter: &retryAfter},
+			want: true,
+		},
+		"authentication_failed": {
+			err:  &framework.Error{Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED},
+			want: false,
+		},
+		"internal": {
+			err:  &framework.Error{Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL},
+			want: false,
+		},
+		"nil": {
+			err:  nil,
+			want: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := customerror.IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
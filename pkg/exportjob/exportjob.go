@@ -0,0 +1,360 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package exportjob implements the state machine of the datasources which export objects asynchronously, e.g.
+// the Tenable exports, the Qualys reports, the Salesforce Bulk API queries or the Workday reports: a job is
+// created, polled until it completes, then the objects of each chunk of its result are downloaded, a page at a
+// time.
+//
+// The state of the job is stored in the cursor returned with each page, see State, so that a sync resumes
+// where it stopped, and the adapters only implement the requests to the datasource, see Exporter.
+package exportjob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+const (
+	// DefaultPollInterval is the interval between two polls of a job within a page, if not set.
+	DefaultPollInterval = 5 * time.Second
+
+	// DefaultMaxPollDuration is the maximum duration a job is polled within a page, if not set.
+	DefaultMaxPollDuration = 30 * time.Second
+
+	// DefaultJobTimeout is the maximum duration of a job, from its creation to its completion, if not set.
+	DefaultJobTimeout = 6 * time.Hour
+
+	// stateShape is the expected JSON shape of a State, used in cursor errors.
+	stateShape = `{"phase":<string>,"jobId":<string>,"createdAt":<int64>,"chunks":[<string>],"chunk":<int>,` +
+		`"chunkCursor":<string>}`
+)
+
+// Phase is the phase of an export job.
+type Phase string
+
+const (
+	// PhasePoll is the phase of a job which has been created, and is polled until it completes.
+	PhasePoll Phase = "poll"
+
+	// PhaseDownload is the phase of a completed job, whose chunks are downloaded.
+	PhaseDownload Phase = "download"
+)
+
+// JobStatus is the status of an export job, as returned by the datasource.
+type JobStatus int
+
+const (
+	// JobPending is the status of a job which is queued or running.
+	JobPending JobStatus = iota
+
+	// JobComplete is the status of a job whose result can be downloaded.
+	JobComplete
+
+	// JobFailed is the status of a job which failed, was canceled, or expired.
+	JobFailed
+)
+
+// Status is the status of an export job.
+type Status struct {
+	Status JobStatus
+
+	// Chunks are the IDs of the chunks of the result of a completed job, e.g. the chunk IDs of a Tenable export,
+	// or a single empty ID if the result isn't split. Empty if the job didn't export any object.
+	Chunks []string
+
+	// Message is the reason of the failure of a failed job, if any.
+	Message string
+}
+
+// Exporter sends the requests of an export job to the datasource. An Exporter is created for each GetPage
+// request, so that it can capture the request, e.g. its page size or its credentials.
+type Exporter interface {
+	// Create creates an export job, and returns its ID.
+	Create(ctx context.Context) (jobID string, err *framework.Error)
+
+	// Status returns the status of the job.
+	Status(ctx context.Context, jobID string) (*Status, *framework.Error)
+
+	// Download returns a page of objects of the chunk of the result of the job, from the position of the cursor
+	// (nil for the first page of the chunk), and the cursor of the next page of the chunk (nil for the last page).
+	Download(ctx context.Context, jobID, chunk string, cursor *string) (
+		objects []map[string]any, nextCursor *string, err *framework.Error,
+	)
+}
+
+/*
+State is the state of an export job, stored in the cursor returned with each page, e.g.:
+
+	{
+	    "phase": "download",
+	    "jobId": "7b1e4f0c-7f3d-4c55-a1c4-0c8f1d1e2a44",
+	    "createdAt": 1767225600,
+	    "chunks": ["1", "2", "3"],
+	    "chunk": 1,
+	    "chunkCursor": "1000"
+	}
+
+A nil State is the state of the first page, before the job is created.
+*/
+type State struct {
+	Phase Phase `json:"phase"`
+
+	// JobID is the ID of the job, as returned by Exporter.Create.
+	JobID string `json:"jobId"`
+
+	// CreatedAt is the Unix time of the creation of the job, used to enforce Runner.JobTimeout.
+	CreatedAt int64 `json:"createdAt"`
+
+	// Chunks are the IDs of the chunks of the result, set once the job completes.
+	Chunks []string `json:"chunks,omitempty"`
+
+	// Chunk is the index of the chunk being downloaded in Chunks.
+	Chunk int `json:"chunk,omitempty"`
+
+	// ChunkCursor is the cursor of the next page of the chunk being downloaded, nil for its first page.
+	ChunkCursor *string `json:"chunkCursor,omitempty"`
+}
+
+// MarshalState marshals the state into a JSON string, to be stored in the Cursor of a CompositeCursor.
+// nil is returned for a nil state, i.e. at the end of the export.
+func MarshalState(state *State) (*string, *framework.Error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal export job state into JSON: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	s := string(stateBytes)
+
+	return &s, nil
+}
+
+// UnmarshalState unmarshals the state stored in the Cursor of a CompositeCursor by MarshalState.
+// nil is returned for the first page.
+func UnmarshalState(cursor *string, entityExternalID string) (*State, *framework.Error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	var state State
+
+	if err := json.Unmarshal([]byte(*cursor), &state); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, stateShape, fmt.Sprintf("failed to unmarshal export job state: %v", err),
+		)
+	}
+
+	switch {
+	case state.JobID == "":
+		return nil, pagination.NewCursorError(entityExternalID, stateShape, "export job state has no job ID")
+	case state.Phase != PhasePoll && state.Phase != PhaseDownload:
+		return nil, pagination.NewCursorError(
+			entityExternalID, stateShape, fmt.Sprintf("export job phase %q is invalid", state.Phase),
+		)
+	case state.Phase == PhaseDownload && (state.Chunk < 0 || state.Chunk >= len(state.Chunks)):
+		return nil, pagination.NewCursorError(
+			entityExternalID, stateShape, fmt.Sprintf("export job chunk %d is out of range", state.Chunk),
+		)
+	}
+
+	return &state, nil
+}
+
+// Runner runs the export jobs of an Exporter, a page at a time.
+type Runner struct {
+	Exporter Exporter
+
+	// PollInterval is the interval between two polls of a job within a page. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+
+	// MaxPollDuration is the maximum duration a job is polled within a page. A page without objects is returned
+	// once exceeded, with the state of the job, so that the job is polled again with the next page.
+	// Defaults to DefaultMaxPollDuration.
+	MaxPollDuration time.Duration
+
+	// JobTimeout is the maximum duration of a job, from its creation to its completion. Defaults to
+	// DefaultJobTimeout.
+	JobTimeout time.Duration
+
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	// Sleep waits for the duration, or until the context is done. Defaults to a timer.
+	Sleep func(ctx context.Context, d time.Duration) error
+}
+
+// GetPage returns the objects of the next page of the export whose state is stored in the Cursor of the composite
+// cursor, and the composite cursor of the page after. The next cursor is nil after the last page.
+func (r *Runner) GetPage(
+	ctx context.Context, cursor *pagination.CompositeCursor[string], entityExternalID string,
+) ([]map[string]any, *pagination.CompositeCursor[string], *framework.Error) {
+	var stateCursor *string
+	if cursor != nil {
+		stateCursor = cursor.Cursor
+	}
+
+	state, err := UnmarshalState(stateCursor, entityExternalID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objects, nextState, err := r.NextPage(ctx, state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextStateCursor, err := MarshalState(nextState)
+	if err != nil || nextStateCursor == nil {
+		return objects, nil, err
+	}
+
+	return objects, &pagination.CompositeCursor[string]{Cursor: nextStateCursor}, nil
+}
+
+// NextPage returns the objects of the next page of the export, and the state of the job after the page.
+// The next state is nil after the last page.
+//
+// The job is created with the first page, i.e. a nil state, then polled until it completes. A page is only
+// returned without objects while the job is pending, or if the job didn't export any object.
+func (r *Runner) NextPage(ctx context.Context, state *State) ([]map[string]any, *State, *framework.Error) {
+	now := r.now()
+
+	if state == nil {
+		jobID, err := r.Exporter.Create(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		state = &State{
+			Phase:     PhasePoll,
+			JobID:     jobID,
+			CreatedAt: now.Unix(),
+		}
+	}
+
+	if state.Phase == PhasePoll {
+		completed, err := r.poll(ctx, state, now)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !completed {
+			return nil, state, nil
+		}
+
+		// The job completed without exporting any object.
+		if len(state.Chunks) == 0 {
+			return nil, nil, nil
+		}
+	}
+
+	objects, chunkCursor, err := r.Exporter.Download(ctx, state.JobID, state.Chunks[state.Chunk], state.ChunkCursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next := *state
+	next.ChunkCursor = chunkCursor
+
+	if chunkCursor == nil {
+		next.Chunk++
+
+		if next.Chunk == len(next.Chunks) {
+			return objects, nil, nil
+		}
+	}
+
+	return objects, &next, nil
+}
+
+// poll polls the job until it completes, or MaxPollDuration is exceeded. The state is updated to the
+// PhaseDownload phase once the job completes.
+func (r *Runner) poll(ctx context.Context, state *State, start time.Time) (bool, *framework.Error) {
+	pollInterval := valueOrDefault(r.PollInterval, DefaultPollInterval)
+	maxPollDuration := valueOrDefault(r.MaxPollDuration, DefaultMaxPollDuration)
+	jobTimeout := valueOrDefault(r.JobTimeout, DefaultJobTimeout)
+
+	for {
+		status, err := r.Exporter.Status(ctx, state.JobID)
+		if err != nil {
+			return false, err
+		}
+
+		switch status.Status {
+		case JobComplete:
+			state.Phase = PhaseDownload
+			state.Chunks = status.Chunks
+			state.Chunk = 0
+			state.ChunkCursor = nil
+
+			return true, nil
+		case JobFailed:
+			return false, &framework.Error{
+				Message: fmt.Sprintf("Export job %s failed: %s.", state.JobID, status.Message),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			}
+		}
+
+		now := r.now()
+
+		if now.Sub(time.Unix(state.CreatedAt, 0)) > jobTimeout {
+			return false, &framework.Error{
+				Message: fmt.Sprintf("Export job %s did not complete within %s.", state.JobID, jobTimeout),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			}
+		}
+
+		// Return a page without objects rather than exceeding the timeout of the page.
+		if now.Add(pollInterval).Sub(start) > maxPollDuration {
+			return false, nil
+		}
+
+		if err := r.sleep(ctx, pollInterval); err != nil {
+			return false, nil
+		}
+	}
+}
+
+func (r *Runner) now() time.Time {
+	if r.Now == nil {
+		return time.Now()
+	}
+
+	return r.Now()
+}
+
+func (r *Runner) sleep(ctx context.Context, d time.Duration) error {
+	if r.Sleep != nil {
+		return r.Sleep(ctx, d)
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func valueOrDefault(d, defaultValue time.Duration) time.Duration {
+	if d <= 0 {
+		return defaultValue
+	}
+
+	return d
+}
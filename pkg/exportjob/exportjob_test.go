@@ -0,0 +1,296 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package exportjob_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/exportjob"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// fakeExporter is an Exporter whose job completes after a number of polls, with chunks of two pages.
+type fakeExporter struct {
+	pendingPolls int
+	failure      string
+	chunks       []string
+
+	created int
+	polls   int
+}
+
+func (e *fakeExporter) Create(_ context.Context) (string, *framework.Error) {
+	e.created++
+
+	return "job-1", nil
+}
+
+func (e *fakeExporter) Status(_ context.Context, jobID string) (*exportjob.Status, *framework.Error) {
+	e.polls++
+
+	switch {
+	case e.failure != "":
+		return &exportjob.Status{Status: exportjob.JobFailed, Message: e.failure}, nil
+	case e.polls <= e.pendingPolls:
+		return &exportjob.Status{Status: exportjob.JobPending}, nil
+	default:
+		return &exportjob.Status{Status: exportjob.JobComplete, Chunks: e.chunks}, nil
+	}
+}
+
+func (e *fakeExporter) Download(_ context.Context, jobID, chunk string, cursor *string) (
+	[]map[string]any, *string, *framework.Error,
+) {
+	if cursor == nil {
+		return []map[string]any{{"id": chunk + "-1"}}, testutil.GenPtr("2"), nil
+	}
+
+	return []map[string]any{{"id": chunk + "-" + *cursor}}, nil, nil
+}
+
+// fakeClock is a clock advanced by the sleeps of the runner.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	c.now = c.now.Add(d)
+
+	return nil
+}
+
+func TestRunnerNextPage(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		exporter  *fakeExporter
+		state     *exportjob.State
+		wantPages [][]map[string]any
+		wantErr   *framework.Error
+	}{
+		"job_completes_within_the_first_page": {
+			exporter: &fakeExporter{pendingPolls: 2, chunks: []string{"a", "b"}},
+			wantPages: [][]map[string]any{
+				{{"id": "a-1"}},
+				{{"id": "a-2"}},
+				{{"id": "b-1"}},
+				{{"id": "b-2"}},
+			},
+		},
+		"job_pending_beyond_the_max_poll_duration": {
+			exporter: &fakeExporter{pendingPolls: 10, chunks: []string{"a"}},
+			wantPages: [][]map[string]any{
+				nil,
+				nil,
+				{{"id": "a-1"}},
+				{{"id": "a-2"}},
+			},
+		},
+		"job_without_objects": {
+			exporter:  &fakeExporter{},
+			wantPages: [][]map[string]any{nil},
+		},
+		"job_failed": {
+			exporter: &fakeExporter{failure: "report quota exceeded"},
+			wantErr: &framework.Error{
+				Message: "Export job job-1 failed: report quota exceeded.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+		"job_timed_out": {
+			exporter: &fakeExporter{pendingPolls: 10},
+			state: &exportjob.State{
+				Phase:     exportjob.PhasePoll,
+				JobID:     "job-1",
+				CreatedAt: start.Add(-6 * time.Hour).Unix(),
+			},
+			wantErr: &framework.Error{
+				Message: "Export job job-1 did not complete within 6h0m0s.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+		"resume_download": {
+			exporter: &fakeExporter{},
+			state: &exportjob.State{
+				Phase:       exportjob.PhaseDownload,
+				JobID:       "job-1",
+				CreatedAt:   start.Unix(),
+				Chunks:      []string{"a", "b"},
+				Chunk:       1,
+				ChunkCursor: testutil.GenPtr("2"),
+			},
+			wantPages: [][]map[string]any{
+				{{"id": "b-2"}},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			clock := &fakeClock{now: start}
+			runner := &exportjob.Runner{
+				Exporter:        tt.exporter,
+				PollInterval:    10 * time.Second,
+				MaxPollDuration: 30 * time.Second,
+				Now:             clock.Now,
+				Sleep:           clock.Sleep,
+			}
+
+			var gotPages [][]map[string]any
+
+			state := tt.state
+
+			for {
+				objects, nextState, err := runner.NextPage(context.Background(), state)
+				if !reflect.DeepEqual(err, tt.wantErr) {
+					t.Fatalf("gotErr: %v, wantErr: %v", err, tt.wantErr)
+				}
+
+				if err != nil {
+					break
+				}
+
+				gotPages = append(gotPages, objects)
+
+				if nextState == nil {
+					break
+				}
+
+				if len(gotPages) > 10 {
+					t.Fatal("export did not end")
+				}
+
+				state = nextState
+			}
+
+			if !reflect.DeepEqual(gotPages, tt.wantPages) {
+				t.Errorf("gotPages: %v, wantPages: %v", gotPages, tt.wantPages)
+			}
+
+			if tt.state == nil && tt.exporter.created != 1 {
+				t.Errorf("got %d jobs created, want 1", tt.exporter.created)
+			}
+		})
+	}
+}
+
+func TestRunnerGetPage(t *testing.T) {
+	runner := &exportjob.Runner{
+		Exporter: &fakeExporter{chunks: []string{"a"}},
+	}
+
+	objects, nextCursor, err := runner.GetPage(context.Background(), nil, "Vulnerability")
+	if err != nil {
+		t.Fatalf("gotErr: %v", err)
+	}
+
+	if want := []map[string]any{{"id": "a-1"}}; !reflect.DeepEqual(objects, want) {
+		t.Errorf("gotObjects: %v, wantObjects: %v", objects, want)
+	}
+
+	// The state is stored in the Cursor of the composite cursor, which is marshaled with the page.
+	encodedCursor, err := pagination.MarshalCursor(nextCursor)
+	if err != nil {
+		t.Fatalf("gotErr: %v", err)
+	}
+
+	cursor, err := pagination.UnmarshalCursor[string](encodedCursor, "Vulnerability")
+	if err != nil {
+		t.Fatalf("gotErr: %v", err)
+	}
+
+	objects, nextCursor, err = runner.GetPage(context.Background(), cursor, "Vulnerability")
+	if err != nil {
+		t.Fatalf("gotErr: %v", err)
+	}
+
+	if want := []map[string]any{{"id": "a-2"}}; !reflect.DeepEqual(objects, want) {
+		t.Errorf("gotObjects: %v, wantObjects: %v", objects, want)
+	}
+
+	if nextCursor != nil {
+		t.Errorf("gotNextCursor: %v, wantNextCursor: nil", nextCursor)
+	}
+}
+
+func TestUnmarshalState(t *testing.T) {
+	tests := map[string]struct {
+		cursor    *string
+		wantState *exportjob.State
+		wantErr   *framework.Error
+	}{
+		"first_page": {},
+		"poll": {
+			cursor: testutil.GenPtr(`{"phase":"poll","jobId":"job-1","createdAt":1767225600}`),
+			wantState: &exportjob.State{
+				Phase:     exportjob.PhasePoll,
+				JobID:     "job-1",
+				CreatedAt: 1767225600,
+			},
+		},
+		"download": {
+			cursor: testutil.GenPtr(`{"phase":"download","jobId":"job-1","createdAt":1767225600,"chunks":["1","2"],"chunk":1,"chunkCursor":"1000"}`),
+			wantState: &exportjob.State{
+				Phase:       exportjob.PhaseDownload,
+				JobID:       "job-1",
+				CreatedAt:   1767225600,
+				Chunks:      []string{"1", "2"},
+				Chunk:       1,
+				ChunkCursor: testutil.GenPtr("1000"),
+			},
+		},
+		"invalid_json": {
+			cursor: testutil.GenPtr(`{"phase":`),
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity Vulnerability: failed to unmarshal export job state: unexpected end of JSON input. Expected cursor shape: {"phase":<string>,"jobId":<string>,"createdAt":<int64>,"chunks":[<string>],"chunk":<int>,"chunkCursor":<string>}. ` + pagination.RestartSyncHint,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"missing_job_id": {
+			cursor: testutil.GenPtr(`{"phase":"poll"}`),
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity Vulnerability: export job state has no job ID. Expected cursor shape: {"phase":<string>,"jobId":<string>,"createdAt":<int64>,"chunks":[<string>],"chunk":<int>,"chunkCursor":<string>}. ` + pagination.RestartSyncHint,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"invalid_phase": {
+			cursor: testutil.GenPtr(`{"phase":"create","jobId":"job-1"}`),
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity Vulnerability: export job phase "create" is invalid. Expected cursor shape: {"phase":<string>,"jobId":<string>,"createdAt":<int64>,"chunks":[<string>],"chunk":<int>,"chunkCursor":<string>}. ` + pagination.RestartSyncHint,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"chunk_out_of_range": {
+			cursor: testutil.GenPtr(`{"phase":"download","jobId":"job-1","chunks":["1"],"chunk":1}`),
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity Vulnerability: export job chunk 1 is out of range. Expected cursor shape: {"phase":<string>,"jobId":<string>,"createdAt":<int64>,"chunks":[<string>],"chunk":<int>,"chunkCursor":<string>}. ` + pagination.RestartSyncHint,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotState, gotErr := exportjob.UnmarshalState(tt.cursor, "Vulnerability")
+
+			if !reflect.DeepEqual(gotState, tt.wantState) {
+				t.Errorf("gotState: %v, wantState: %v", gotState, tt.wantState)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
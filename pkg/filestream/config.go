@@ -0,0 +1,123 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package filestream
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	DefaultFileType    = FileTypeCSV
+	SupportedFileTypes = map[string]struct{}{FileTypeCSV: {}, FileTypeJSONL: {}}
+)
+
+// FileConfig is the configuration of the format of the files containing the entity data, shared by the
+// adapters reading files from a storage service.
+type FileConfig struct {
+	// FileType is the extension of the files containing the entity data: "csv", or "jsonl" for JSON Lines files
+	// containing a JSON object per line.
+	// This defaults to "csv".
+	FileType *string `json:"fileType,omitempty"`
+
+	// SchemaFiles enables reading the schema of each entity file from a sidecar file stored next to it,
+	// named "<entity>.schema.json". The schema defines the columns of the file and their types, which allows
+	// parsing files without a header row. The requested attributes are validated against the schema.
+	// This defaults to false.
+	SchemaFiles bool `json:"schemaFiles,omitempty"`
+
+	// Delimiter is the character separating the fields of the rows of the CSV files, e.g. ";" or "\t".
+	// This defaults to ",".
+	Delimiter *string `json:"delimiter,omitempty"`
+
+	// QuoteChar is the character enclosing the fields of the CSV files that contain delimiters, quotes
+	// or line breaks, e.g. "'".
+	// This defaults to '"'.
+	QuoteChar *string `json:"quoteChar,omitempty"`
+
+	// EscapeChar is the character escaping a quote within a quoted field of the CSV files, e.g. "\\".
+	// This defaults to the quote character, i.e. a quote is escaped by doubling it.
+	EscapeChar *string `json:"escapeChar,omitempty"`
+
+	// Charset is the character encoding of the CSV files: "utf-8", "utf-16le", "utf-16be",
+	// "windows-1252" or "iso-8859-1".
+	// This defaults to "utf-8".
+	Charset *string `json:"charset,omitempty"`
+}
+
+// Validate validates the file type and the CSV dialect set in the configuration.
+func (c *FileConfig) Validate() error {
+	if c.SchemaFiles && c.FileTypeOrDefault() == FileTypeJSONL {
+		return errors.New("schema files are not supported for JSON Lines files")
+	}
+
+	dialect, err := c.CSVDialect()
+	if err != nil {
+		return err
+	}
+
+	if dialect.Delimiter == dialect.Quote {
+		return errors.New("the delimiter and the quote character in the configuration must be different")
+	}
+
+	if dialect.Delimiter == dialect.Escape {
+		return errors.New("the delimiter and the escape character in the configuration must be different")
+	}
+
+	return nil
+}
+
+// FileTypeOrDefault returns the file type set in the configuration, or DefaultFileType if unset.
+func (c *FileConfig) FileTypeOrDefault() string {
+	if c.FileType == nil {
+		return DefaultFileType
+	}
+
+	return *c.FileType
+}
+
+// CSVDialect returns the dialect of the CSV files set in the configuration.
+func (c *FileConfig) CSVDialect() (CSVDialect, error) {
+	var (
+		dialect CSVDialect
+		err     error
+	)
+
+	if c.Delimiter != nil {
+		if dialect.Delimiter, err = ParseCSVDialectChar(*c.Delimiter); err != nil {
+			return dialect, fmt.Errorf("the delimiter in the configuration is invalid: %w", err)
+		}
+	}
+
+	if c.QuoteChar != nil {
+		if dialect.Quote, err = ParseCSVDialectChar(*c.QuoteChar); err != nil {
+			return dialect, fmt.Errorf("the quote character in the configuration is invalid: %w", err)
+		}
+	}
+
+	if c.EscapeChar != nil {
+		if dialect.Escape, err = ParseCSVDialectChar(*c.EscapeChar); err != nil {
+			return dialect, fmt.Errorf("the escape character in the configuration is invalid: %w", err)
+		}
+	}
+
+	if c.Charset != nil {
+		dialect.Charset = strings.ToLower(*c.Charset)
+
+		if _, found := SupportedCharsets[dialect.Charset]; !found {
+			return dialect, fmt.Errorf("the charset %s in the configuration is not supported", *c.Charset)
+		}
+	}
+
+	return dialect.withDefaults(), nil
+}
+
+// ObjectKey returns the key of the file of an entity, stored under the prefix.
+func ObjectKey(prefix, entityExternalID, fileType string) string {
+	return filepath.Join(
+		filepath.Clean(prefix),
+		filepath.Clean(fmt.Sprintf("%s.%s", entityExternalID, fileType)),
+	)
+}
@@ -1,26 +1,27 @@
 // Copyright 2026 SGNL.ai, Inc.
 
-package awss3
+package filestream
 
 import (
 	"bufio"
 	"bytes"
-	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"strconv"
 	"strings"
 
 	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
 )
 
 const FileTypeCSV = "csv"
 
 var ErrEmptyOrMissing = errors.New("empty or missing")
 
-func handleQuoteChar(reader *bufio.Reader, lineBuffer *bytes.Buffer, bytesRead *int64, inQuotes *bool) error {
+func handleQuoteChar(
+	reader *charReader, dialect CSVDialect, lineBuffer *bytes.Buffer, bytesRead *int64, inQuotes *bool,
+) error {
 	if !*inQuotes {
 		// This is an opening quote
 		*inQuotes = true
@@ -29,7 +30,7 @@ func handleQuoteChar(reader *bufio.Reader, lineBuffer *bytes.Buffer, bytesRead *
 	}
 
 	// We're inside quotes - need to check if this is an escaped quote or closing quote
-	nextBytes, peekErr := reader.Peek(1)
+	nextChar, _, peekErr := reader.peekChar()
 	if peekErr != nil {
 		if peekErr == io.EOF {
 			// End of file - this quote closes the field
@@ -41,19 +42,16 @@ func handleQuoteChar(reader *bufio.Reader, lineBuffer *bytes.Buffer, bytesRead *
 		return fmt.Errorf("failed to peek next byte after quote: %w", peekErr)
 	}
 
-	if len(nextBytes) > 0 && nextBytes[0] == '"' {
+	if dialect.Escape == dialect.Quote && nextChar == dialect.Quote {
 		// This is "", an escaped quote - consume the second quote
-		nextByte, readErr := reader.ReadByte()
+		// Stay in quotes, this was just an escaped quote
+		// Note: The first quote was already written to buffer in readCSVLine
+		_, size, readErr := reader.readChar(lineBuffer)
 		if readErr != nil {
 			return fmt.Errorf("failed to read escaped quote: %w", readErr)
 		}
 
-		*bytesRead++
-
-		// Write the second quote to buffer
-		// Stay in quotes, this was just an escaped quote
-		// Note: The first quote was already written to buffer in readCSVLine
-		lineBuffer.WriteByte(nextByte)
+		*bytesRead += int64(size)
 	} else {
 		// This is the closing quote
 		*inQuotes = false
@@ -62,8 +60,31 @@ func handleQuoteChar(reader *bufio.Reader, lineBuffer *bytes.Buffer, bytesRead *
 	return nil
 }
 
-func handleLineEnding(reader *bufio.Reader, lineBuffer *bytes.Buffer, bytesRead *int64) error {
-	nextBytes, peekErr := reader.Peek(1)
+func handleEscapeChar(reader *charReader, dialect CSVDialect, lineBuffer *bytes.Buffer, bytesRead *int64) error {
+	nextChar, _, peekErr := reader.peekChar()
+	if peekErr != nil {
+		if peekErr == io.EOF {
+			return nil
+		}
+
+		return fmt.Errorf("failed to peek next byte after escape: %w", peekErr)
+	}
+
+	if nextChar == dialect.Quote || nextChar == dialect.Escape {
+		// Consume the escaped character, so that an escaped quote doesn't close the field.
+		_, size, readErr := reader.readChar(lineBuffer)
+		if readErr != nil {
+			return fmt.Errorf("failed to read escaped character: %w", readErr)
+		}
+
+		*bytesRead += int64(size)
+	}
+
+	return nil
+}
+
+func handleLineEnding(reader *charReader, lineBuffer *bytes.Buffer, bytesRead *int64) error {
+	nextChar, _, peekErr := reader.peekChar()
 	if peekErr != nil {
 		if peekErr == io.EOF {
 			// CR at EOF is a valid line ending
@@ -73,23 +94,23 @@ func handleLineEnding(reader *bufio.Reader, lineBuffer *bytes.Buffer, bytesRead
 		return fmt.Errorf("failed to peek after CR: %w", peekErr)
 	}
 
-	if len(nextBytes) > 0 && nextBytes[0] == '\n' {
+	if nextChar == '\n' {
 		// This is CRLF - consume the LF
-		nextByte, readErr := reader.ReadByte()
+		_, size, readErr := reader.readChar(lineBuffer)
 		if readErr != nil {
 			// This shouldn't happen - we just peeked successfully
 			return fmt.Errorf("failed to read LF after CR: %w", readErr)
 		}
 
-		*bytesRead++
-
-		lineBuffer.WriteByte(nextByte)
+		*bytesRead += int64(size)
 	}
 
 	return nil
 }
 
-func readCSVLine(reader *bufio.Reader, maxRowSizeBytes int64) (
+// readCSVLine reads a line of a CSV file in the given dialect, including the line breaks within quoted fields.
+// The line is returned encoded in UTF-8, along with the number of bytes read from the file.
+func readCSVLine(reader *charReader, dialect CSVDialect, maxRowSizeBytes int64) (
 	lineBytes []byte, bytesRead int64, err error) {
 	var lineBuffer bytes.Buffer
 
@@ -100,7 +121,7 @@ func readCSVLine(reader *bufio.Reader, maxRowSizeBytes int64) (
 			return nil, 0, fmt.Errorf("size limit of %d MiB exceeded", maxRowSizeBytes/(1024*1024))
 		}
 
-		b, readErr := reader.ReadByte()
+		c, size, readErr := reader.readChar(&lineBuffer)
 		if readErr != nil {
 			if readErr == io.EOF {
 				if lineBuffer.Len() == 0 && bytesRead == 0 {
@@ -113,43 +134,51 @@ func readCSVLine(reader *bufio.Reader, maxRowSizeBytes int64) (
 			return nil, 0, fmt.Errorf("failed to read byte: %w", readErr)
 		}
 
-		bytesRead++
-
-		lineBuffer.WriteByte(b)
+		bytesRead += int64(size)
 
-		if b == '"' {
-			if err := handleQuoteChar(reader, &lineBuffer, &bytesRead, &inQuotes); err != nil {
+		switch {
+		case inQuotes && c == dialect.Escape && dialect.Escape != dialect.Quote:
+			if err := handleEscapeChar(reader, dialect, &lineBuffer, &bytesRead); err != nil {
+				return nil, 0, err
+			}
+		case c == dialect.Quote:
+			if err := handleQuoteChar(reader, dialect, &lineBuffer, &bytesRead, &inQuotes); err != nil {
 				return nil, 0, err
 			}
-		} else if (b == '\n' || b == '\r') && !inQuotes {
-			if b == '\r' {
+		case (c == '\n' || c == '\r') && !inQuotes:
+			if c == '\r' {
 				if err := handleLineEnding(reader, &lineBuffer, &bytesRead); err != nil {
 					return nil, 0, err
 				}
 			}
 
-			break
+			return normalizeLineEnding(lineBuffer.Bytes()), bytesRead, nil
 		}
 	}
 
-	lineBytes = lineBuffer.Bytes()
+	return normalizeLineEnding(lineBuffer.Bytes()), bytesRead, nil
+}
 
+func normalizeLineEnding(lineBytes []byte) []byte {
 	if len(lineBytes) > 0 && lineBytes[len(lineBytes)-1] == '\r' {
 		lineBytes[len(lineBytes)-1] = '\n'
 	}
 
-	return lineBytes, bytesRead, nil
+	return lineBytes
 }
 
-func CSVHeaders(reader *bufio.Reader, maxRowSizeBytes int64) (headers []string, bytesReadForHeader int64, err error) {
-	headerLineBytes, bytesRead, err := readCSVLine(reader, maxRowSizeBytes)
+func CSVHeaders(reader *bufio.Reader, dialect CSVDialect, maxRowSizeBytes int64) (
+	headers []string, bytesReadForHeader int64, err error) {
+	dialect = dialect.withDefaults()
+
+	headerLineBytes, bytesRead, err := readCSVLine(
+		&charReader{reader: reader, charset: dialect.Charset}, dialect, maxRowSizeBytes,
+	)
 	if err != nil {
 		return nil, 0, fmt.Errorf("CSV header error: %w", err)
 	}
 
-	csvReader := csv.NewReader(bytes.NewReader(headerLineBytes))
-
-	parsedHeaders, parseErr := csvReader.Read()
+	parsedHeaders, parseErr := dialect.parseRecord(headerLineBytes)
 	if parseErr != nil {
 		return nil, 0, fmt.Errorf("CSV file format is invalid or corrupted: %v", parseErr)
 	}
@@ -164,6 +193,7 @@ func CSVHeaders(reader *bufio.Reader, maxRowSizeBytes int64) (headers []string,
 func StreamingCSVToPage(
 	streamReader *bufio.Reader,
 	headers []string,
+	dialect CSVDialect,
 	pageSize int64,
 	attrConfig []*framework.AttributeConfig,
 	maxProcessingBytesTotal int64,
@@ -172,12 +202,15 @@ func StreamingCSVToPage(
 	objects = make([]map[string]any, 0, pageSize)
 	headerToAttributeConfig := headerToAttributeConfig(headers, attrConfig)
 
+	dialect = dialect.withDefaults()
+	reader := &charReader{reader: streamReader, charset: dialect.Charset}
+
 	var totalBytesRead int64
 
 	hasNext = true
 
 	for int64(len(objects)) < pageSize {
-		rowBytes, bytesRead, rowReadErr := readCSVLine(streamReader, maxRowSizeBytes)
+		rowBytes, bytesRead, rowReadErr := readCSVLine(reader, dialect, maxRowSizeBytes)
 
 		if bytesRead > 0 {
 			if (totalBytesRead + bytesRead) > maxProcessingBytesTotal {
@@ -205,8 +238,7 @@ func StreamingCSVToPage(
 			hasNext = false
 		}
 
-		csvRowReader := csv.NewReader(bytes.NewReader(rowBytes))
-		record, recordParseErr := csvRowReader.Read()
+		record, recordParseErr := dialect.parseRecord(rowBytes)
 
 		if recordParseErr != nil && recordParseErr != io.EOF {
 			return nil, 0, false, fmt.Errorf("CSV file format is invalid or corrupted: %w", recordParseErr)
@@ -227,6 +259,11 @@ func StreamingCSVToPage(
 				continue
 			}
 
+			// CSV can't represent a null value: an empty field is null, and is omitted from the row.
+			if value == "" {
+				continue
+			}
+
 			headerName := headers[i]
 			attrConfig, found := headerToAttributeConfig[headerName]
 
@@ -255,7 +292,7 @@ func StreamingCSVToPage(
 
 			switch attrConfig.Type {
 			case framework.AttributeTypeInt64, framework.AttributeTypeDouble:
-				floatValue, convErr := strconv.ParseFloat(value, 64)
+				floatValue, convErr := commonutil.ParseFloat(value)
 				if convErr != nil {
 					return nil, 0, false, fmt.Errorf(
 						`CSV contains invalid numeric value "%s" in column "%s"`,
@@ -264,6 +301,16 @@ func StreamingCSVToPage(
 				}
 
 				row[headerName] = floatValue
+			case framework.AttributeTypeBool:
+				boolValue, convErr := commonutil.ParseBool(value)
+				if convErr != nil {
+					return nil, 0, false, fmt.Errorf(
+						`CSV contains invalid boolean value "%s" in column "%s"`,
+						value, headerName,
+					)
+				}
+
+				row[headerName] = boolValue
 			default:
 				row[headerName] = value
 			}
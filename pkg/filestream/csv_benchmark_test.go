@@ -0,0 +1,72 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+
+package filestream_test
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+)
+
+// BenchmarkParseCSVPage benchmarks parsing a multi-MB page of a CSV file, as read from S3 and the other file
+// stores, to catch performance regressions in the parsing of the files.
+//
+//	go test -run '^$' -bench BenchmarkParse -benchmem ./pkg/filestream/
+func BenchmarkParseCSVPage(b *testing.B) {
+	headers := []string{"id", "name", "email", "age", "active", "department", "created", "groups"}
+	attrConfig := []*framework.AttributeConfig{
+		{ExternalId: "id", Type: framework.AttributeTypeString},
+		{ExternalId: "name", Type: framework.AttributeTypeString},
+		{ExternalId: "email", Type: framework.AttributeTypeString},
+		{ExternalId: "age", Type: framework.AttributeTypeInt64},
+		{ExternalId: "active", Type: framework.AttributeTypeBool},
+		{ExternalId: "department", Type: framework.AttributeTypeString},
+		{ExternalId: "created", Type: framework.AttributeTypeDateTime},
+		// groups is parsed as JSON.
+	}
+
+	body := generateCSVRows(20000)
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		objects, _, _, err := filestream.StreamingCSVToPage(
+			bufio.NewReader(bytes.NewReader(body)),
+			headers,
+			filestream.CSVDialect{},
+			20000,
+			attrConfig,
+			int64(len(body)),
+			MaxCSVRowSizeBytes,
+		)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if len(objects) != 20000 {
+			b.Fatalf("got %d objects, want 20000", len(objects))
+		}
+	}
+}
+
+// generateCSVRows returns rowCount CSV rows, with quoted fields and JSON fields.
+func generateCSVRows(rowCount int) []byte {
+	var buf bytes.Buffer
+
+	for i := range rowCount {
+		fmt.Fprintf(&buf,
+			`%d,"Last%d, First%d",user%d@example.com,%d,true,Engineering,2026-01-02T03:04:05Z,`+
+				`"[{""id"":""group%d"",""name"":""Group %d""},{""id"":""everyone"",""name"":""Everyone""}]"`+"\n",
+			i, i, i, i, 20+i%50, i%100, i%100,
+		)
+	}
+
+	return buf.Bytes()
+}
@@ -2,22 +2,37 @@
 
 // nolint: goconst
 
-package awss3_test
+package filestream_test
 
 import (
 	"bufio"
+	"encoding/binary"
 	"fmt"
 	"strings"
 	"testing"
+	"unicode/utf16"
 
 	"github.com/google/go-cmp/cmp"
 	framework "github.com/sgnl-ai/adapter-framework"
-	s3_adapter "github.com/sgnl-ai/adapters/pkg/aws-s3"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
 )
 
+// encodeUTF16 encodes a string in UTF-16 with the given byte order.
+func encodeUTF16(byteOrder binary.ByteOrder, value string) string {
+	units := utf16.Encode([]rune(value))
+	encoded := make([]byte, 2*len(units))
+
+	for i, unit := range units {
+		byteOrder.PutUint16(encoded[2*i:], unit)
+	}
+
+	return string(encoded)
+}
+
 func TestCSVHeaders(t *testing.T) {
 	tests := map[string]struct {
 		inputReaderFn     func() *bufio.Reader
+		dialect           filestream.CSVDialect
 		expectedHeaders   []string
 		expectedBytesRead int64
 		expectedError     bool
@@ -94,12 +109,52 @@ func TestCSVHeaders(t *testing.T) {
 			expectedHeaders:   []string{"name", "multi\nline\nheader", "status"},
 			expectedBytesRead: int64(len("name,\"multi\nline\nheader\",status\n")),
 		},
+		"semicolon_delimiter": {
+			inputReaderFn: func() *bufio.Reader {
+				return bufio.NewReader(strings.NewReader("name;\"age;years\";city\nJohn;25;NYC"))
+			},
+			dialect:           filestream.CSVDialect{Delimiter: ';'},
+			expectedHeaders:   []string{"name", "age;years", "city"},
+			expectedBytesRead: int64(len("name;\"age;years\";city\n")),
+		},
+		"single_quote_with_quoted_newline": {
+			inputReaderFn: func() *bufio.Reader {
+				return bufio.NewReader(strings.NewReader("name,'multi\n''line''',status\nvalue1,value2,value3"))
+			},
+			dialect:           filestream.CSVDialect{Quote: '\''},
+			expectedHeaders:   []string{"name", "multi\n'line'", "status"},
+			expectedBytesRead: int64(len("name,'multi\n''line''',status\n")),
+		},
+		"invalid_csv_format_custom_quote_unclosed": {
+			inputReaderFn: func() *bufio.Reader {
+				return bufio.NewReader(strings.NewReader("name,'unclosed"))
+			},
+			dialect:       filestream.CSVDialect{Quote: '\''},
+			expectedError: true,
+			errorContains: "CSV file format is invalid or corrupted: missing closing quote in field 2",
+		},
+		"utf16le_headers": {
+			inputReaderFn: func() *bufio.Reader {
+				return bufio.NewReader(strings.NewReader(encodeUTF16(binary.LittleEndian, "név,città\r\nJohn,NYC")))
+			},
+			dialect:           filestream.CSVDialect{Charset: filestream.CharsetUTF16LE},
+			expectedHeaders:   []string{"név", "città"},
+			expectedBytesRead: int64(len(encodeUTF16(binary.LittleEndian, "név,città\r\n"))),
+		},
+		"windows1252_headers": {
+			inputReaderFn: func() *bufio.Reader {
+				return bufio.NewReader(strings.NewReader("caf\xe9,\x80\nJohn,NYC"))
+			},
+			dialect:           filestream.CSVDialect{Charset: filestream.CharsetWindows1252},
+			expectedHeaders:   []string{"café", "€"},
+			expectedBytesRead: int64(len("caf\xe9,\x80\n")),
+		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			reader := tt.inputReaderFn()
-			headers, bytesRead, err := s3_adapter.CSVHeaders(reader, MaxCSVRowSizeBytes)
+			headers, bytesRead, err := filestream.CSVHeaders(reader, tt.dialect, MaxCSVRowSizeBytes)
 
 			if tt.expectedError {
 				if err == nil {
@@ -163,6 +218,7 @@ Bob,35,SF,"[{""alias"":""Bobby""}]"`
 	tests := map[string]struct {
 		csvData                 string
 		headers                 []string
+		dialect                 filestream.CSVDialect
 		pageSize                int64
 		attrConfig              []*framework.AttributeConfig
 		maxProcessingBytesTotal int64
@@ -201,7 +257,7 @@ Bob,35,SF,"[{""alias"":""Bobby""}]"`
 			attrConfig:              attrConfigDefault,
 			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
 			expectedObjects: []map[string]any{
-				{"name": "Alice", "age": float64(40), "city": "BOS", "aliases": ""},
+				{"name": "Alice", "age": float64(40), "city": "BOS"},
 			},
 			expectedHasNext: false,
 		},
@@ -212,7 +268,7 @@ Bob,35,SF,"[{""alias"":""Bobby""}]"`
 			attrConfig:              attrConfigDefault,
 			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
 			expectedObjects: []map[string]any{
-				{"name": "Alice", "age": float64(40), "city": "BOS", "aliases": ""},
+				{"name": "Alice", "age": float64(40), "city": "BOS"},
 			},
 			expectedHasNext: false,
 		},
@@ -244,6 +300,35 @@ Bob,35,SF,"[{""alias"":""Bobby""}]"`
 			},
 			expectedHasNext: false,
 		},
+		"success_locale_formatted_values": {
+			csvData:  "John;\"1.234,5\";1 000;TRUE\nJane;\"1,234.5\";2'000;0",
+			headers:  []string{"name", "score", "rating", "active"},
+			dialect:  filestream.CSVDialect{Delimiter: ';'},
+			pageSize: 2,
+			attrConfig: []*framework.AttributeConfig{
+				{ExternalId: "name", Type: framework.AttributeTypeString},
+				{ExternalId: "score", Type: framework.AttributeTypeDouble},
+				{ExternalId: "rating", Type: framework.AttributeTypeInt64},
+				{ExternalId: "active", Type: framework.AttributeTypeBool},
+			},
+			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
+			expectedObjects: []map[string]any{
+				{"name": "John", "score": 1234.5, "rating": float64(1000), "active": true},
+				{"name": "Jane", "score": 1234.5, "rating": float64(2000), "active": false},
+			},
+			expectedHasNext: false,
+		},
+		"success_empty_fields_are_omitted": {
+			csvData:                 `John,,"",`,
+			headers:                 sampleHeaders,
+			pageSize:                1,
+			attrConfig:              attrConfigDefault,
+			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
+			expectedObjects: []map[string]any{
+				{"name": "John"},
+			},
+			expectedHasNext: false,
+		},
 		"success_empty_csv_data_after_headers": {
 			csvData:                 "",
 			headers:                 sampleHeaders,
@@ -260,8 +345,8 @@ Bob,35,SF,"[{""alias"":""Bobby""}]"`
 			attrConfig:              attrConfigDefault,
 			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
 			expectedObjects: []map[string]any{
-				{"name": "John", "age": float64(25), "city": "NYC", "aliases": ""},
-				{"name": "Jane", "age": float64(30), "city": "LA", "aliases": ""},
+				{"name": "John", "age": float64(25), "city": "NYC"},
+				{"name": "Jane", "age": float64(30), "city": "LA"},
 			},
 			expectedHasNext: false,
 		},
@@ -378,14 +463,77 @@ Bob,35,SF,"[{""alias"":""Bobby""}]"`
 			},
 			expectedHasNext: false,
 		},
+		"success_semicolon_delimiter": {
+			csvData:                 "John;25;\"New York; NY\";\nJane;30;LA;",
+			headers:                 sampleHeaders,
+			dialect:                 filestream.CSVDialect{Delimiter: ';'},
+			pageSize:                2,
+			attrConfig:              attrConfigDefault,
+			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
+			expectedObjects: []map[string]any{
+				{"name": "John", "age": float64(25), "city": "New York; NY"},
+				{"name": "Jane", "age": float64(30), "city": "LA"},
+			},
+			expectedHasNext: false,
+		},
+		"success_single_quote_with_backslash_escape": {
+			csvData:                 "'O\\'Brien',25,'Dublin\\\\Cork',\nJane,30,'LA, CA',",
+			headers:                 sampleHeaders,
+			dialect:                 filestream.CSVDialect{Quote: '\'', Escape: '\\'},
+			pageSize:                2,
+			attrConfig:              attrConfigDefault,
+			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
+			expectedObjects: []map[string]any{
+				{"name": "O'Brien", "age": float64(25), "city": "Dublin\\Cork"},
+				{"name": "Jane", "age": float64(30), "city": "LA, CA"},
+			},
+			expectedHasNext: false,
+		},
+		"error_extraneous_character_after_custom_quote": {
+			csvData:                 "'John'x,25,NYC,",
+			headers:                 sampleHeaders,
+			dialect:                 filestream.CSVDialect{Quote: '\''},
+			pageSize:                1,
+			attrConfig:              attrConfigDefault,
+			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
+			expectedError:           true,
+			errorContains: "CSV file format is invalid or corrupted: " +
+				"extraneous character after the closing quote in field 1",
+		},
+		"success_utf16be": {
+			csvData:                 encodeUTF16(binary.BigEndian, "Zoë,25,\"Zürich, CH\",\r\nJürgen,30,Köln,\r\n"),
+			headers:                 sampleHeaders,
+			dialect:                 filestream.CSVDialect{Charset: filestream.CharsetUTF16BE},
+			pageSize:                2,
+			attrConfig:              attrConfigDefault,
+			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
+			expectedObjects: []map[string]any{
+				{"name": "Zoë", "age": float64(25), "city": "Zürich, CH"},
+				{"name": "Jürgen", "age": float64(30), "city": "Köln"},
+			},
+			expectedHasNext: false,
+		},
+		"success_windows1252": {
+			csvData:                 "Ren\xe9e,25,M\xfcnchen,\n",
+			headers:                 sampleHeaders,
+			dialect:                 filestream.CSVDialect{Charset: filestream.CharsetWindows1252},
+			pageSize:                1,
+			attrConfig:              attrConfigDefault,
+			maxProcessingBytesTotal: MaxBytesToProcessPerPage,
+			expectedObjects: []map[string]any{
+				{"name": "Renée", "age": float64(25), "city": "München"},
+			},
+			expectedHasNext: false,
+		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			streamReader := bufio.NewReader(strings.NewReader(tt.csvData))
-			objects, _, hasNext, err := s3_adapter.StreamingCSVToPage(
+			objects, _, hasNext, err := filestream.StreamingCSVToPage(
 				streamReader,
 				tt.headers,
+				tt.dialect,
 				tt.pageSize,
 				tt.attrConfig,
 				tt.maxProcessingBytesTotal,
@@ -0,0 +1,90 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package filestream
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"go.uber.org/zap/zapcore"
+)
+
+// Cursor contains pagination state for CSV files.
+// Headers are cached to avoid re-fetching on subsequent pages.
+type Cursor struct {
+	// Cursor is the byte position offset in the file where the next fetch should start.
+	Cursor *int64 `json:"cursor,omitempty"`
+
+	// Headers contains the parsed CSV headers from the first page.
+	// Cached to avoid re-fetching headers on subsequent pages.
+	Headers []string `json:"headers,omitempty"`
+
+	// Remainder contains unprocessed bytes from the previous fetch.
+	// These bytes are prepended to the next fetch to avoid data loss.
+	Remainder []byte `json:"remainder,omitempty"`
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler to control cursor logging.
+// Logs metadata (byte position, headers count, remainder length) without exposing actual data.
+func (c *Cursor) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Cursor != nil {
+		enc.AddInt64("cursor", *c.Cursor)
+	}
+
+	enc.AddInt("headersCount", len(c.Headers))
+	enc.AddInt("remainderLength", len(c.Remainder))
+
+	return nil
+}
+
+// UnmarshalCursor unmarshals the cursor from a base64 encoded JSON string.
+// Returns nil cursor if the input is empty.
+func UnmarshalCursor(cursor string) (*Cursor, *framework.Error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	cursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to decode base64 cursor: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	unmarshaledCursor := &Cursor{}
+
+	unmarshalErr := json.Unmarshal(cursorBytes, unmarshaledCursor)
+	if unmarshalErr != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal JSON cursor: %v.", unmarshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	return unmarshaledCursor, nil
+}
+
+// MarshalCursor marshals the cursor into a base64 encoded JSON string.
+func MarshalCursor(cursor *Cursor) (string, *framework.Error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	nextCursorBytes, marshalErr := json.Marshal(cursor)
+	if marshalErr != nil {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal cursor into JSON: %v.", marshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(nextCursorBytes), nil
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package filestream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Supported charsets of CSV files.
+const (
+	CharsetUTF8        = "utf-8"
+	CharsetUTF16LE     = "utf-16le"
+	CharsetUTF16BE     = "utf-16be"
+	CharsetWindows1252 = "windows-1252"
+	CharsetISO88591    = "iso-8859-1"
+)
+
+var SupportedCharsets = map[string]struct{}{
+	CharsetUTF8:        {},
+	CharsetUTF16LE:     {},
+	CharsetUTF16BE:     {},
+	CharsetWindows1252: {},
+	CharsetISO88591:    {},
+}
+
+// DefaultCSVDialect is the dialect of CSV files defined in RFC 4180, encoded in UTF-8.
+var DefaultCSVDialect = CSVDialect{
+	Delimiter: ',',
+	Quote:     '"',
+	Escape:    '"',
+	Charset:   CharsetUTF8,
+}
+
+// CSVDialect is the dialect of a CSV file. The zero value of each field is replaced by its value
+// in DefaultCSVDialect.
+type CSVDialect struct {
+	// Delimiter is the character separating the fields of a row.
+	Delimiter rune
+
+	// Quote is the character enclosing the fields that contain delimiters, quotes or line breaks.
+	Quote rune
+
+	// Escape is the character escaping a quote within a quoted field.
+	// If it's the same as Quote, a quote is escaped by doubling it.
+	Escape rune
+
+	// Charset is the character encoding of the file.
+	Charset string
+}
+
+// withDefaults returns the dialect, with the unset fields set to their default value.
+func (d CSVDialect) withDefaults() CSVDialect {
+	if d.Delimiter == 0 {
+		d.Delimiter = DefaultCSVDialect.Delimiter
+	}
+
+	if d.Quote == 0 {
+		d.Quote = DefaultCSVDialect.Quote
+	}
+
+	if d.Escape == 0 {
+		d.Escape = d.Quote
+	}
+
+	if d.Charset == "" {
+		d.Charset = DefaultCSVDialect.Charset
+	}
+
+	return d
+}
+
+// ParseCSVDialectChar parses a dialect character set in the config, which must be a single ASCII
+// character other than a line break.
+func ParseCSVDialectChar(value string) (rune, error) {
+	if len(value) != 1 || value[0] >= utf8.RuneSelf {
+		return 0, fmt.Errorf("%q is not a single ASCII character", value)
+	}
+
+	if value[0] == '\r' || value[0] == '\n' {
+		return 0, errors.New("a line break is not allowed")
+	}
+
+	return rune(value[0]), nil
+}
+
+// charReader reads the characters of a CSV file, decoding them from the charset of the file.
+// The characters of UTF-8 files are read byte by byte, and written as is.
+type charReader struct {
+	reader  *bufio.Reader
+	charset string
+}
+
+// peekChar returns the next character without consuming it, and its size in bytes in the file.
+// An incomplete character at the end of the file is returned as utf8.RuneError.
+func (c *charReader) peekChar() (char rune, size int, err error) {
+	switch c.charset {
+	case CharsetUTF16LE, CharsetUTF16BE:
+		var byteOrder binary.ByteOrder = binary.LittleEndian
+		if c.charset == CharsetUTF16BE {
+			byteOrder = binary.BigEndian
+		}
+
+		unitBytes, peekErr := c.reader.Peek(4)
+		if len(unitBytes) < 2 {
+			return c.incompleteChar(len(unitBytes), peekErr)
+		}
+
+		unit := rune(byteOrder.Uint16(unitBytes))
+		if !utf16.IsSurrogate(unit) {
+			return unit, 2, nil
+		}
+
+		if len(unitBytes) < 4 {
+			return c.incompleteChar(len(unitBytes), peekErr)
+		}
+
+		// An invalid surrogate pair is decoded as utf8.RuneError, and only its first unit is consumed.
+		if char = utf16.DecodeRune(unit, rune(byteOrder.Uint16(unitBytes[2:]))); char == utf8.RuneError {
+			return char, 2, nil
+		}
+
+		return char, 4, nil
+	default:
+		charBytes, peekErr := c.reader.Peek(1)
+		if len(charBytes) == 0 {
+			return 0, 0, peekErr
+		}
+
+		switch c.charset {
+		case CharsetWindows1252:
+			return charmap.Windows1252.DecodeByte(charBytes[0]), 1, nil
+		default:
+			// The bytes of ISO-8859-1 map to the first 256 Unicode code points. For UTF-8, only
+			// ASCII characters are compared, so multi-byte characters don't need to be decoded.
+			return rune(charBytes[0]), 1, nil
+		}
+	}
+}
+
+// incompleteChar returns the result of peekChar when less bytes than the size of a character
+// are left in the file.
+func (c *charReader) incompleteChar(size int, peekErr error) (rune, int, error) {
+	if size == 0 {
+		return 0, 0, peekErr
+	}
+
+	if peekErr != nil && peekErr != io.EOF {
+		return 0, 0, peekErr
+	}
+
+	return utf8.RuneError, size, nil
+}
+
+// readChar consumes the next character and writes it to buf, encoded in UTF-8.
+func (c *charReader) readChar(buf *bytes.Buffer) (char rune, size int, err error) {
+	char, size, err = c.peekChar()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if _, err := c.reader.Discard(size); err != nil {
+		return 0, 0, err
+	}
+
+	if c.charset == CharsetUTF8 {
+		buf.WriteByte(byte(char))
+	} else {
+		buf.WriteRune(char)
+	}
+
+	return char, size, nil
+}
+
+// parseRecord parses the fields of a CSV line, read by readCSVLine. It returns io.EOF for an empty line.
+func (d CSVDialect) parseRecord(line []byte) ([]string, error) {
+	// The standard CSV reader supports custom delimiters, but only '"' quotes escaped by doubling them.
+	if d.Quote == '"' && d.Escape == '"' {
+		csvReader := csv.NewReader(bytes.NewReader(line))
+		csvReader.Comma = d.Delimiter
+
+		return csvReader.Read()
+	}
+
+	text := strings.TrimSuffix(strings.TrimSuffix(string(line), "\n"), "\r")
+	if text == "" {
+		return nil, io.EOF
+	}
+
+	var (
+		fields   []string
+		field    strings.Builder
+		inQuotes bool
+		quoted   bool
+	)
+
+	chars := []rune(text)
+
+	for i := 0; i < len(chars); i++ {
+		char := chars[i]
+		column := len(fields) + 1
+
+		switch {
+		case inQuotes && char == d.Escape && d.Escape != d.Quote:
+			if i+1 < len(chars) && (chars[i+1] == d.Quote || chars[i+1] == d.Escape) {
+				i++
+				char = chars[i]
+			}
+
+			field.WriteRune(char)
+		case inQuotes && char == d.Quote:
+			if d.Escape == d.Quote && i+1 < len(chars) && chars[i+1] == d.Quote {
+				i++
+
+				field.WriteRune(char)
+
+				continue
+			}
+
+			inQuotes = false
+
+			if i+1 < len(chars) && chars[i+1] != d.Delimiter {
+				return nil, fmt.Errorf("extraneous character after the closing quote in field %d", column)
+			}
+		case inQuotes:
+			field.WriteRune(char)
+		case char == d.Delimiter:
+			fields = append(fields, field.String())
+			field.Reset()
+
+			quoted = false
+		case char == d.Quote:
+			if quoted || field.Len() > 0 {
+				return nil, fmt.Errorf("bare quote in the non-quoted field %d", column)
+			}
+
+			inQuotes = true
+			quoted = true
+		default:
+			field.WriteRune(char)
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("missing closing quote in field %d", len(fields)+1)
+	}
+
+	return append(fields, field.String()), nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package filestream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// FileTypeJSONL is the file type of the JSON Lines files, containing a JSON object per line.
+const FileTypeJSONL = "jsonl"
+
+// StreamingJSONLToPage reads up to pageSize objects from the JSON Lines of the stream, without reading more than
+// maxProcessingBytesTotal bytes. A line is only read if it ends with a line break or, if final, i.e. the stream
+// ends at the end of the file, at the end of the stream, so that the incomplete last line of a chunk is read with
+// the next page. Empty lines are skipped.
+func StreamingJSONLToPage(
+	streamReader *bufio.Reader,
+	pageSize int64,
+	maxProcessingBytesTotal int64,
+	maxRowSizeBytes int64,
+	final bool,
+) (objects []map[string]any, bytesReadFromDataStream int64, err error) {
+	objects = make([]map[string]any, 0, pageSize)
+
+	var totalBytesRead int64
+
+	for int64(len(objects)) < pageSize {
+		line, readErr := streamReader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return nil, 0, fmt.Errorf("JSON Lines row error: %w", readErr)
+		}
+
+		if int64(len(line)) > maxRowSizeBytes {
+			return nil, 0, fmt.Errorf(
+				"JSON Lines row error: size limit of %d MiB exceeded", maxRowSizeBytes/(1024*1024),
+			)
+		}
+
+		// The last line of a chunk may continue in the next chunk.
+		if readErr == io.EOF && !final {
+			if len(objects) == 0 && totalBytesRead == 0 && int64(len(line)) >= maxProcessingBytesTotal {
+				return nil, 0, fmt.Errorf(
+					"JSON Lines row error: the row exceeds the limit of %d bytes to process per page",
+					maxProcessingBytesTotal,
+				)
+			}
+
+			break
+		}
+
+		if totalBytesRead+int64(len(line)) > maxProcessingBytesTotal {
+			break
+		}
+
+		totalBytesRead += int64(len(line))
+
+		// The first line may start with a UTF-8 BOM.
+		if trimmed := bytes.TrimSpace(bytes.TrimPrefix(line, UTF8BOM)); len(trimmed) > 0 {
+			var object map[string]any
+
+			if unmarshalErr := json.Unmarshal(trimmed, &object); unmarshalErr != nil {
+				return nil, 0, fmt.Errorf("JSON Lines file format is invalid or corrupted: %w", unmarshalErr)
+			}
+
+			if object == nil {
+				return nil, 0, errors.New("JSON Lines file format is invalid or corrupted: a line is null")
+			}
+
+			objects = append(objects, object)
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+	}
+
+	return objects, totalBytesRead, nil
+}
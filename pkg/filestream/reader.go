@@ -0,0 +1,529 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package filestream
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+)
+
+// BOM (Byte Order Mark) patterns for different encodings.
+var (
+	UTF8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	UTF16LEBOM = []byte{0xFF, 0xFE}
+	UTF16BEBOM = []byte{0xFE, 0xFF}
+	UTF32LEBOM = []byte{0xFF, 0xFE, 0x00, 0x00}
+	UTF32BEBOM = []byte{0x00, 0x00, 0xFE, 0xFF}
+)
+
+// Store is a storage service containing the entity files, e.g. AWS S3.
+type Store interface {
+	// Size returns the size of the file with the given key, in bytes.
+	Size(ctx context.Context, key string) (int64, error)
+
+	// Read returns the content of the file with the given key in the inclusive byte range [start, end].
+	// If end is negative, the content is read until the end of the file.
+	Read(ctx context.Context, key string, start, end int64) (io.ReadCloser, error)
+}
+
+// Reader reads pages of objects from the entity files of a Store.
+type Reader struct {
+	// StoreName is the name of the storage service, used in error messages, e.g. "AWS S3".
+	StoreName string
+
+	MaxCSVRowSizeBytes       int64
+	MaxBytesToProcessPerPage int64
+
+	// MaxConcurrentRangeReads is the maximum number of ranged reads issued in parallel
+	// to fetch the data of a single page. Values less than or equal to 1 disable parallel reads
+	// and the page data is fetched over a single connection.
+	MaxConcurrentRangeReads int
+}
+
+// Request is a request for a page of objects from an entity file.
+type Request struct {
+	// Key is the key of the entity file in the store.
+	Key string
+
+	// SchemaKey is the key of the schema sidecar file of the entity in the store.
+	// Empty if schema files are disabled.
+	SchemaKey string
+
+	// FileType is the type of the entity file, FileTypeCSV or FileTypeJSONL.
+	// Empty for a CSV file.
+	FileType string
+
+	// Dialect is the dialect of the CSV file.
+	Dialect CSVDialect
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// PageSize is the maximum number of objects to return.
+	PageSize int64
+
+	// Cursor identifies the first object of the page to return.
+	// nil in the request for the first page.
+	Cursor *Cursor
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to the store, used in error messages.
+	RequestTimeoutSeconds int
+
+	// AttributeConfig is the list of attributes requested.
+	AttributeConfig []*framework.AttributeConfig
+}
+
+// Page is a page of objects read from an entity file.
+type Page struct {
+	// Objects is the list of objects of the page.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page of the file.
+	NextCursor *Cursor
+}
+
+// stripBOM discards the BOM at the start of the reader, if any, and returns its length.
+func stripBOM(reader *bufio.Reader) (bomLength int, err error) {
+	peekedBytes, peekErr := reader.Peek(len(UTF32LEBOM))
+	if peekErr != nil && peekErr != io.EOF && peekErr != bufio.ErrBufferFull {
+		return 0, fmt.Errorf("error peeking for BOM: %w", peekErr)
+	}
+
+	identifiedBomLength := 0
+	if bytes.HasPrefix(peekedBytes, UTF32LEBOM) {
+		identifiedBomLength = len(UTF32LEBOM)
+	} else if bytes.HasPrefix(peekedBytes, UTF32BEBOM) {
+		identifiedBomLength = len(UTF32BEBOM)
+	} else if bytes.HasPrefix(peekedBytes, UTF8BOM) {
+		identifiedBomLength = len(UTF8BOM)
+	} else if bytes.HasPrefix(peekedBytes, UTF16LEBOM) {
+		identifiedBomLength = len(UTF16LEBOM)
+	} else if bytes.HasPrefix(peekedBytes, UTF16BEBOM) {
+		identifiedBomLength = len(UTF16BEBOM)
+	}
+
+	if identifiedBomLength > 0 {
+		_, discardErr := reader.Discard(identifiedBomLength)
+		if discardErr != nil {
+			return 0, fmt.Errorf("error discarding BOM (length %d): %w", identifiedBomLength, discardErr)
+		}
+
+		return identifiedBomLength, nil
+	}
+
+	return 0, nil
+}
+
+// GetPage reads a page of objects from the entity file of the request.
+func (r *Reader) GetPage(ctx context.Context, store Store, request *Request) (*Page, *framework.Error) {
+	entityName := request.EntityExternalID
+
+	fileSize, err := store.Size(ctx, request.Key)
+	if err != nil {
+		return nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to fetch entity from %s: %s, error: %v.", r.StoreName, entityName, err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
+		)
+	}
+
+	if fileSize == 0 {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("The file for entity %s is empty.", entityName),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+	}
+
+	var (
+		parsedHeaders []string
+		startBytePos  int64
+	)
+
+	// For the first page or old cursor format without headers, two requests are made:
+	// 1. Fetch the headers (the first line of the file) to determine the CSV column names.
+	// 2. Fetch the actual data starting from the byte position after the headers.
+	// For subsequent pages, only one request is made to fetch the data starting from
+	// the byte position indicated by the cursor.
+
+	// Step 1: Get headers - either from cursor cache or fetch from the store.
+	switch {
+	case request.FileType == FileTypeJSONL:
+		// JSON Lines files have no header row, each line is an object with its own attribute names.
+	case request.Cursor != nil && len(request.Cursor.Headers) > 0:
+		// Use cached headers from cursor - skip header fetch.
+		parsedHeaders = request.Cursor.Headers
+	default:
+		// If schema files are enabled, the schema is read from the sidecar file of the entity and the
+		// requested attributes are validated against it. If the file has no header row, the columns
+		// of the schema are used as the headers.
+		var schema *Schema
+
+		if request.SchemaKey != "" {
+			var schemaErr *framework.Error
+
+			schema, schemaErr = r.getSchema(ctx, store, request)
+			if schemaErr != nil {
+				return nil, schemaErr
+			}
+		}
+
+		// Fetch headers from the store (first page or old cursor format without headers).
+		// Use a bounded range for the header fetch to avoid the store streaming the entire file.
+		// Without a range, the store starts streaming the full file, and even though we only
+		// read the header line before closing, TCP buffering causes significant data transfer.
+		// We need enough bytes to read the BOM (up to 4 bytes) and the header row, so we use
+		// 2x MaxCSVRowSizeBytes as a safe buffer that's consistent with the data fetch approach.
+		// For a file without a header row, only the BOM is read.
+		headerEndBytePos := (2 * r.MaxCSVRowSizeBytes) - 1
+		if schema != nil && !schema.HasHeader {
+			headerEndBytePos = int64(len(UTF32LEBOM)) - 1
+		}
+
+		headerStream, err := store.Read(ctx, request.Key, 0, headerEndBytePos)
+		if err != nil {
+			return nil, customerror.UpdateError(&framework.Error{
+				Message: fmt.Sprintf("Failed to fetch entity from %s: %s, error: %v.", r.StoreName, entityName, err),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+				customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+				customerror.WithRetryClassification(err),
+			)
+		}
+		defer headerStream.Close()
+
+		headerBufReader := bufio.NewReader(headerStream)
+
+		bomLength, bomErr := stripBOM(headerBufReader)
+		if bomErr != nil {
+			return nil, customerror.UpdateError(&framework.Error{
+				Message: fmt.Sprintf(
+					"Failed to fetch entity from %s: %s, error processing BOM: %v", r.StoreName, entityName, bomErr,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+				customerror.WithRequestTimeoutMessage(bomErr, request.RequestTimeoutSeconds),
+				customerror.WithRetryClassification(bomErr),
+			)
+		}
+
+		var bytesReadForHeaderLine int64
+
+		if schema != nil && !schema.HasHeader {
+			parsedHeaders = schema.ColumnNames()
+		} else {
+			parsedHeaders, bytesReadForHeaderLine, err = CSVHeaders(
+				headerBufReader, request.Dialect, r.MaxCSVRowSizeBytes,
+			)
+			if err != nil {
+				return nil, customerror.UpdateError(&framework.Error{
+					Message: fmt.Sprintf("Unable to parse CSV file headers: %v", err),
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				},
+					customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+					customerror.WithRetryClassification(err),
+				)
+			}
+
+			if schema != nil {
+				if err := schema.ValidateHeaders(parsedHeaders); err != nil {
+					return nil, &framework.Error{
+						Message: fmt.Sprintf(
+							"The CSV file headers of entity %s don't match its schema file %s: %v.",
+							entityName, request.SchemaKey, err,
+						),
+						Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+					}
+				}
+			}
+		}
+
+		headerStream.Close()
+
+		// Default start position is after headers (for first page).
+		startBytePos = int64(bomLength) + bytesReadForHeaderLine
+	}
+
+	// Step 2: Override start position if cursor has one (for pagination).
+	// This handles both new cursor format (with headers) and old cursor format (without headers).
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		startBytePos = *request.Cursor.Cursor
+	}
+
+	// Step 3: Get remainder from cursor (unprocessed bytes from previous fetch).
+	var remainder []byte
+	if request.Cursor != nil && len(request.Cursor.Remainder) > 0 {
+		remainder = request.Cursor.Remainder
+	}
+
+	// Step 4: Fetch data from the store if needed.
+	var fetchedData []byte
+
+	if startBytePos < fileSize {
+		// Calculate how much to fetch to bring total buffer to MaxBytesToProcessPerPage.
+		remainderSize := int64(len(remainder))
+		fetchSize := r.MaxBytesToProcessPerPage - remainderSize
+
+		if fetchSize > 0 {
+			endBytePos := startBytePos + fetchSize - 1
+			if endBytePos >= fileSize {
+				endBytePos = fileSize - 1
+			}
+
+			var fetchErr error
+
+			fetchedData, fetchErr = r.fetchRange(ctx, store, request.Key, startBytePos, endBytePos)
+			if fetchErr != nil {
+				return nil, customerror.UpdateError(&framework.Error{
+					Message: fmt.Sprintf("Failed to fetch entity from %s: %v", r.StoreName, fetchErr),
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				},
+					customerror.WithRequestTimeoutMessage(fetchErr, request.RequestTimeoutSeconds),
+					customerror.WithRetryClassification(fetchErr),
+				)
+			}
+		}
+	}
+
+	// Step 5: Combine remainder + newly fetched data into a single buffer.
+	combinedData := append(remainder, fetchedData...)
+
+	// If there's no data to process, return an empty page.
+	if len(combinedData) == 0 {
+		return &Page{
+			Objects: []map[string]any{},
+		}, nil
+	}
+
+	// Create a reader from the combined buffer for CSV processing.
+	dataBufReader := bufio.NewReader(bytes.NewReader(combinedData))
+
+	var (
+		objects       []map[string]any
+		bytesConsumed int64
+		processErr    error
+	)
+
+	if request.FileType == FileTypeJSONL {
+		// The last line of the buffer is incomplete, unless the buffer ends at the end of the file.
+		final := startBytePos+int64(len(fetchedData)) >= fileSize
+
+		objects, bytesConsumed, processErr = StreamingJSONLToPage(
+			dataBufReader,
+			request.PageSize,
+			r.MaxBytesToProcessPerPage,
+			r.MaxCSVRowSizeBytes,
+			final,
+		)
+	} else {
+		objects, bytesConsumed, _, processErr = StreamingCSVToPage(
+			dataBufReader,
+			parsedHeaders,
+			request.Dialect,
+			request.PageSize,
+			request.AttributeConfig,
+			r.MaxBytesToProcessPerPage,
+			r.MaxCSVRowSizeBytes,
+		)
+	}
+
+	if processErr != nil {
+		return nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to fetch entity from %s: %s, error: %v.", r.StoreName, entityName, processErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(processErr, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(processErr),
+		)
+	}
+
+	page := &Page{
+		Objects: objects,
+	}
+
+	// Step 6: Calculate new remainder (unprocessed bytes from combined buffer).
+	var newRemainder []byte
+	if bytesConsumed < int64(len(combinedData)) {
+		newRemainder = combinedData[bytesConsumed:]
+	}
+
+	// Step 7: Build next cursor if there's more data to process.
+	// More data exists if: there's remainder OR we haven't reached EOF in the file.
+	nextBytePos := startBytePos + int64(len(fetchedData))
+
+	if len(newRemainder) > 0 || nextBytePos < fileSize {
+		page.NextCursor = &Cursor{
+			Cursor:    &nextBytePos,
+			Headers:   parsedHeaders,
+			Remainder: newRemainder,
+		}
+	}
+
+	return page, nil
+}
+
+// getSchema fetches and parses the schema sidecar file of the requested entity, and validates the requested
+// attributes against it.
+func (r *Reader) getSchema(ctx context.Context, store Store, request *Request) (*Schema, *framework.Error) {
+	schemaKey := request.SchemaKey
+
+	stream, err := store.Read(ctx, schemaKey, 0, -1)
+	if err != nil {
+		return nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to fetch schema file %s from %s, error: %v.", schemaKey, r.StoreName, err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
+		)
+	}
+	defer stream.Close()
+
+	// The schema file is bounded by the maximum row size, as it describes a single row.
+	data, err := io.ReadAll(io.LimitReader(stream, r.MaxCSVRowSizeBytes+1))
+	if err != nil {
+		return nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to read schema file %s from %s, error: %v.", schemaKey, r.StoreName, err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
+		)
+	}
+
+	if int64(len(data)) > r.MaxCSVRowSizeBytes {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"The schema file %s exceeds the size limit of %d bytes.", schemaKey, r.MaxCSVRowSizeBytes,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+	}
+
+	schema, err := ParseSchema(data)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("The schema file %s is invalid: %v.", schemaKey, err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+	}
+
+	if err := schema.ValidateAttributes(request.AttributeConfig); err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"The requested attributes of entity %s don't match its schema file %s: %v.",
+				request.EntityExternalID, schemaKey, err,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	return schema, nil
+}
+
+// fetchRange fetches the inclusive byte range [startBytePos, endBytePos] of a file.
+// If MaxConcurrentRangeReads is greater than 1, the range is split into contiguous parts which
+// are fetched in parallel and reassembled in order. This reduces the time spent waiting on
+// high-latency links when a page spans a large number of bytes.
+func (r *Reader) fetchRange(
+	ctx context.Context,
+	store Store,
+	key string,
+	startBytePos, endBytePos int64,
+) ([]byte, error) {
+	totalSize := endBytePos - startBytePos + 1
+
+	parts := int64(r.MaxConcurrentRangeReads)
+	if parts > totalSize {
+		parts = totalSize
+	}
+
+	if parts <= 1 {
+		stream, err := store.Read(ctx, key, startBytePos, endBytePos)
+		if err != nil {
+			return nil, err
+		}
+		defer stream.Close()
+
+		data, err := io.ReadAll(stream)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read data: %w", err)
+		}
+
+		return data, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	data := make([]byte, totalSize)
+	partSize := (totalSize + parts - 1) / parts
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	for partStart := int64(0); partStart < totalSize; partStart += partSize {
+		partEnd := min(partStart+partSize, totalSize)
+
+		wg.Add(1)
+
+		go func(partStart, partEnd int64) {
+			defer wg.Done()
+
+			if err := readRangeInto(
+				ctx, store, key, startBytePos+partStart, data[partStart:partEnd],
+			); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+
+					cancel()
+				})
+			}
+		}(partStart, partEnd)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return data, nil
+}
+
+// readRangeInto fills buf with the bytes of a file starting at startBytePos.
+func readRangeInto(
+	ctx context.Context,
+	store Store,
+	key string,
+	startBytePos int64,
+	buf []byte,
+) error {
+	endBytePos := startBytePos + int64(len(buf)) - 1
+
+	stream, err := store.Read(ctx, key, startBytePos, endBytePos)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if _, err := io.ReadFull(stream, buf); err != nil {
+		return fmt.Errorf("failed to read data for range bytes=%d-%d: %w", startBytePos, endBytePos, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+
+package filestream_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+const (
+	MaxCSVRowSizeBytes       = 1 * 1024 * 1024 // 1MiB
+	MaxBytesToProcessPerPage = 1 * 1024 * 1024 // 1MiB
+
+	usersCSVData = "id,name\n1,Alice\n2,Bob\n3,Carol\n"
+)
+
+// memoryStore is a filestream.Store serving files from memory.
+type memoryStore struct {
+	files map[string]string
+}
+
+func (s *memoryStore) Size(_ context.Context, key string) (int64, error) {
+	data, found := s.files[key]
+	if !found {
+		return 0, errors.New("file not found")
+	}
+
+	return int64(len(data)), nil
+}
+
+func (s *memoryStore) Read(_ context.Context, key string, start, end int64) (io.ReadCloser, error) {
+	data, found := s.files[key]
+	if !found {
+		return nil, errors.New("file not found")
+	}
+
+	if end < 0 || end >= int64(len(data)) {
+		end = int64(len(data)) - 1
+	}
+
+	return io.NopCloser(strings.NewReader(data[start : end+1])), nil
+}
+
+func TestReaderGetPage(t *testing.T) {
+	attributes := []*framework.AttributeConfig{
+		{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+		{ExternalId: "name", Type: framework.AttributeTypeString},
+	}
+
+	tests := map[string]struct {
+		files                   map[string]string
+		schemaKey               string
+		cursor                  *filestream.Cursor
+		maxConcurrentRangeReads int
+		wantPage                *filestream.Page
+		wantErr                 *framework.Error
+	}{
+		"first_page": {
+			files: map[string]string{"data/users.csv": usersCSVData},
+			wantPage: &filestream.Page{
+				Objects: []map[string]any{
+					{"id": "1", "name": "Alice"},
+					{"id": "2", "name": "Bob"},
+				},
+				NextCursor: &filestream.Cursor{
+					Cursor:    testutil.GenPtr(int64(len(usersCSVData))),
+					Headers:   []string{"id", "name"},
+					Remainder: []byte("3,Carol\n"),
+				},
+			},
+		},
+		"first_page_concurrent_range_reads": {
+			files:                   map[string]string{"data/users.csv": usersCSVData},
+			maxConcurrentRangeReads: 4,
+			wantPage: &filestream.Page{
+				Objects: []map[string]any{
+					{"id": "1", "name": "Alice"},
+					{"id": "2", "name": "Bob"},
+				},
+				NextCursor: &filestream.Cursor{
+					Cursor:    testutil.GenPtr(int64(len(usersCSVData))),
+					Headers:   []string{"id", "name"},
+					Remainder: []byte("3,Carol\n"),
+				},
+			},
+		},
+		"last_page": {
+			files: map[string]string{"data/users.csv": usersCSVData},
+			cursor: &filestream.Cursor{
+				Cursor:    testutil.GenPtr(int64(len(usersCSVData))),
+				Headers:   []string{"id", "name"},
+				Remainder: []byte("3,Carol\n"),
+			},
+			wantPage: &filestream.Page{
+				Objects: []map[string]any{
+					{"id": "3", "name": "Carol"},
+				},
+			},
+		},
+		"headerless_file_with_schema": {
+			files: map[string]string{
+				"data/users.csv":         "1,Alice\n2,Bob\n",
+				"data/users.schema.json": `{"columns": [{"name": "id"}, {"name": "name"}]}`,
+			},
+			schemaKey: "data/users.schema.json",
+			wantPage: &filestream.Page{
+				Objects: []map[string]any{
+					{"id": "1", "name": "Alice"},
+					{"id": "2", "name": "Bob"},
+				},
+			},
+		},
+		"missing_schema_file": {
+			files:     map[string]string{"data/users.csv": usersCSVData},
+			schemaKey: "data/users.schema.json",
+			wantErr: &framework.Error{
+				Message: "Failed to fetch schema file data/users.schema.json from Test Store, error: file not found.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"empty_file": {
+			files: map[string]string{"data/users.csv": ""},
+			wantErr: &framework.Error{
+				Message: "The file for entity users is empty.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+		"missing_file": {
+			files: map[string]string{},
+			wantErr: &framework.Error{
+				Message: "Failed to fetch entity from Test Store: users, error: file not found.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reader := &filestream.Reader{
+				StoreName:                "Test Store",
+				MaxCSVRowSizeBytes:       MaxCSVRowSizeBytes,
+				MaxBytesToProcessPerPage: MaxBytesToProcessPerPage,
+				MaxConcurrentRangeReads:  tt.maxConcurrentRangeReads,
+			}
+
+			gotPage, gotErr := reader.GetPage(context.Background(), &memoryStore{files: tt.files}, &filestream.Request{
+				Key:                   "data/users.csv",
+				SchemaKey:             tt.schemaKey,
+				EntityExternalID:      "users",
+				PageSize:              2,
+				Cursor:                tt.cursor,
+				RequestTimeoutSeconds: 30,
+				AttributeConfig:       attributes,
+			})
+
+			if diff := cmp.Diff(tt.wantPage, gotPage); diff != "" {
+				t.Errorf("GetPage() page mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantErr, gotErr); diff != "" {
+				t.Errorf("GetPage() error mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestReaderGetPageJSONL verifies that all the objects of a JSON Lines file are read exactly once when paginating,
+// whatever the number of bytes processed per page, i.e. when a line spans the boundary of two chunks.
+func TestReaderGetPageJSONL(t *testing.T) {
+	usersJSONLData := "\xef\xbb\xbf" + `{"id": "1", "name": "Alice", "age": 30}` + "\n" +
+		`{"id": "2", "name": "Bob", "groups": [{"id": "g1"}]}` + "\r\n" +
+		"\n" +
+		`{"id": "3", "name": "Carol", "active": true}`
+
+	wantObjects := []map[string]any{
+		{"id": "1", "name": "Alice", "age": float64(30)},
+		{"id": "2", "name": "Bob", "groups": []any{map[string]any{"id": "g1"}}},
+		{"id": "3", "name": "Carol", "active": true},
+	}
+
+	tests := map[string]struct {
+		data                     string
+		maxBytesToProcessPerPage int64
+		wantObjects              []map[string]any
+		wantErr                  *framework.Error
+	}{
+		"single_chunk": {
+			data:                     usersJSONLData,
+			maxBytesToProcessPerPage: MaxBytesToProcessPerPage,
+			wantObjects:              wantObjects,
+		},
+		"lines_spanning_chunks": {
+			data:                     usersJSONLData,
+			maxBytesToProcessPerPage: 60,
+			wantObjects:              wantObjects,
+		},
+		"trailing_line_break": {
+			data:                     usersJSONLData + "\n",
+			maxBytesToProcessPerPage: 70,
+			wantObjects:              wantObjects,
+		},
+		"line_exceeding_bytes_per_page": {
+			data:                     usersJSONLData,
+			maxBytesToProcessPerPage: 30,
+			wantErr: &framework.Error{
+				Message: "Failed to fetch entity from Test Store: users, error: JSON Lines row error: " +
+					"the row exceeds the limit of 30 bytes to process per page.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"invalid_line": {
+			data:                     `{"id": "1"}` + "\n" + `not json` + "\n",
+			maxBytesToProcessPerPage: MaxBytesToProcessPerPage,
+			wantErr: &framework.Error{
+				Message: "Failed to fetch entity from Test Store: users, error: JSON Lines file format is invalid " +
+					"or corrupted: invalid character 'o' in literal null (expecting 'u').",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"line_not_an_object": {
+			data:                     `["1", "Alice"]` + "\n",
+			maxBytesToProcessPerPage: MaxBytesToProcessPerPage,
+			wantErr: &framework.Error{
+				Message: "Failed to fetch entity from Test Store: users, error: JSON Lines file format is invalid " +
+					"or corrupted: json: cannot unmarshal array into Go value of type map[string]interface {}.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reader := &filestream.Reader{
+				StoreName:                "Test Store",
+				MaxCSVRowSizeBytes:       MaxCSVRowSizeBytes,
+				MaxBytesToProcessPerPage: tt.maxBytesToProcessPerPage,
+			}
+
+			store := &memoryStore{files: map[string]string{"data/users.jsonl": tt.data}}
+
+			var (
+				gotObjects []map[string]any
+				gotErr     *framework.Error
+				cursor     *filestream.Cursor
+			)
+
+			for range 10 {
+				page, err := reader.GetPage(context.Background(), store, &filestream.Request{
+					Key:                   "data/users.jsonl",
+					FileType:              filestream.FileTypeJSONL,
+					EntityExternalID:      "users",
+					PageSize:              2,
+					Cursor:                cursor,
+					RequestTimeoutSeconds: 30,
+				})
+				if err != nil {
+					gotErr = err
+
+					break
+				}
+
+				gotObjects = append(gotObjects, page.Objects...)
+
+				if cursor = page.NextCursor; cursor == nil {
+					break
+				}
+			}
+
+			if diff := cmp.Diff(tt.wantObjects, gotObjects); diff != "" {
+				t.Errorf("GetPage() objects mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantErr, gotErr); diff != "" {
+				t.Errorf("GetPage() error mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package filestream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+)
+
+// SchemaFileSuffix is the suffix of the schema sidecar file of an entity, stored next to the entity file.
+// For example, the schema of "data/users.csv" is read from "data/users.schema.json".
+const SchemaFileSuffix = ".schema.json"
+
+// schemaColumnTypes maps the column types supported in schema files to their attribute types.
+var schemaColumnTypes = map[string]framework.AttributeType{
+	"bool":     framework.AttributeTypeBool,
+	"datetime": framework.AttributeTypeDateTime,
+	"double":   framework.AttributeTypeDouble,
+	"duration": framework.AttributeTypeDuration,
+	"int64":    framework.AttributeTypeInt64,
+	"string":   framework.AttributeTypeString,
+}
+
+// Schema is the schema of an entity file, read from its schema sidecar file.
+type Schema struct {
+	// HasHeader is whether the first row of the entity file is a header row.
+	// If true, the header must match the columns of the schema.
+	// This defaults to false.
+	HasHeader bool `json:"hasHeader,omitempty"`
+
+	// Columns are the columns of the entity file, in order.
+	Columns []SchemaColumn `json:"columns"`
+}
+
+// SchemaColumn is a column of an entity file.
+type SchemaColumn struct {
+	// Name is the name of the column, matching the external ID of its attribute.
+	Name string `json:"name"`
+
+	// Type is the type of the column values: "bool", "datetime", "double", "duration", "int64" or "string".
+	// This defaults to "string".
+	Type string `json:"type,omitempty"`
+}
+
+// ParseSchema parses and validates the content of a schema file.
+func ParseSchema(data []byte) (*Schema, error) {
+	schema := &Schema{}
+
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if len(schema.Columns) == 0 {
+		return nil, errors.New("no columns are defined")
+	}
+
+	names := make(map[string]struct{}, len(schema.Columns))
+
+	for i, column := range schema.Columns {
+		if column.Name == "" {
+			return nil, fmt.Errorf("column %d has an empty name", i+1)
+		}
+
+		if _, found := names[column.Name]; found {
+			return nil, fmt.Errorf("column %d has the duplicate name %q", i+1, column.Name)
+		}
+
+		names[column.Name] = struct{}{}
+
+		if column.Type == "" {
+			continue
+		}
+
+		if _, found := schemaColumnTypes[strings.ToLower(column.Type)]; !found {
+			return nil, fmt.Errorf("column %d (%q) has the unsupported type %q", i+1, column.Name, column.Type)
+		}
+	}
+
+	return schema, nil
+}
+
+// ColumnNames returns the names of the columns of the schema, in order.
+func (s *Schema) ColumnNames() []string {
+	names := make([]string, 0, len(s.Columns))
+
+	for _, column := range s.Columns {
+		names = append(names, column.Name)
+	}
+
+	return names
+}
+
+// ValidateHeaders validates that the header row of an entity file matches the columns of the schema.
+func (s *Schema) ValidateHeaders(headers []string) error {
+	if len(headers) != len(s.Columns) {
+		return fmt.Errorf("the header has %d columns, but the schema defines %d", len(headers), len(s.Columns))
+	}
+
+	for i, column := range s.Columns {
+		if headers[i] != column.Name {
+			return fmt.Errorf("column %d of the header is %q, but the schema defines %q", i+1, headers[i], column.Name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAttributes validates that each requested attribute is a column of the schema, with the same type.
+func (s *Schema) ValidateAttributes(attrConfig []*framework.AttributeConfig) error {
+	columns := make(map[string]int, len(s.Columns))
+
+	for i, column := range s.Columns {
+		columns[column.Name] = i
+	}
+
+	for _, attr := range attrConfig {
+		if attr == nil {
+			continue
+		}
+
+		i, found := columns[attr.ExternalId]
+		if !found {
+			return fmt.Errorf("attribute %q is not a column of the schema", attr.ExternalId)
+		}
+
+		column := s.Columns[i]
+
+		columnType := strings.ToLower(column.Type)
+		if columnType == "" {
+			columnType = "string"
+		}
+
+		if schemaColumnTypes[columnType] != attr.Type {
+			return fmt.Errorf(
+				"attribute %q has type %s, but column %d (%q) of the schema has type %s",
+				attr.ExternalId, attributeTypeName(attr.Type), i+1, column.Name, columnType,
+			)
+		}
+	}
+
+	return nil
+}
+
+// attributeTypeName returns the schema column type name of an attribute type.
+func attributeTypeName(attrType framework.AttributeType) string {
+	for name, columnType := range schemaColumnTypes {
+		if columnType == attrType {
+			return name
+		}
+	}
+
+	return fmt.Sprintf("unknown (%d)", attrType)
+}
+
+// SchemaKey returns the key of the schema sidecar file of an entity, stored under the prefix.
+func SchemaKey(prefix, entityExternalID string) string {
+	return filepath.Join(filepath.Clean(prefix), filepath.Clean(entityExternalID+SchemaFileSuffix))
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package filestream_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+)
+
+const (
+	validSchema = `{"columns": [{"name": "id"}, {"name": "name", "type": "string"}, {"name": "age", "type": "int64"}]}`
+)
+
+func TestParseSchema(t *testing.T) {
+	tests := map[string]struct {
+		data       string
+		wantSchema *filestream.Schema
+		wantErr    string
+	}{
+		"valid": {
+			data: validSchema,
+			wantSchema: &filestream.Schema{
+				Columns: []filestream.SchemaColumn{
+					{Name: "id"},
+					{Name: "name", Type: "string"},
+					{Name: "age", Type: "int64"},
+				},
+			},
+		},
+		"valid_with_header": {
+			data: `{"hasHeader": true, "columns": [{"name": "id", "type": "String"}]}`,
+			wantSchema: &filestream.Schema{
+				HasHeader: true,
+				Columns:   []filestream.SchemaColumn{{Name: "id", Type: "String"}},
+			},
+		},
+		"invalid_json": {
+			data:    `{"columns": [`,
+			wantErr: "invalid JSON: unexpected end of JSON input",
+		},
+		"no_columns": {
+			data:    `{"columns": []}`,
+			wantErr: "no columns are defined",
+		},
+		"empty_column_name": {
+			data:    `{"columns": [{"name": "id"}, {"name": ""}]}`,
+			wantErr: "column 2 has an empty name",
+		},
+		"duplicate_column_name": {
+			data:    `{"columns": [{"name": "id"}, {"name": "id"}]}`,
+			wantErr: `column 2 has the duplicate name "id"`,
+		},
+		"unsupported_column_type": {
+			data:    `{"columns": [{"name": "id", "type": "uuid"}]}`,
+			wantErr: `column 1 ("id") has the unsupported type "uuid"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotSchema, gotErr := filestream.ParseSchema([]byte(tt.data))
+
+			if diff := cmp.Diff(tt.wantSchema, gotSchema); diff != "" {
+				t.Errorf("ParseSchema() mismatch (-want +got):\n%s", diff)
+			}
+
+			if gotErr == nil && tt.wantErr != "" || gotErr != nil && gotErr.Error() != tt.wantErr {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateAttributes(t *testing.T) {
+	schema, err := filestream.ParseSchema([]byte(validSchema))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		attributes []*framework.AttributeConfig
+		wantErr    string
+	}{
+		"valid": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+				{ExternalId: "age", Type: framework.AttributeTypeInt64},
+			},
+		},
+		"unknown_attribute": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+				{ExternalId: "email", Type: framework.AttributeTypeString},
+			},
+			wantErr: `attribute "email" is not a column of the schema`,
+		},
+		"mismatched_type": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+				{ExternalId: "age", Type: framework.AttributeTypeDouble},
+			},
+			wantErr: `attribute "age" has type double, but column 3 ("age") of the schema has type int64`,
+		},
+		"mismatched_default_type": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeInt64, UniqueId: true},
+			},
+			wantErr: `attribute "id" has type int64, but column 1 ("id") of the schema has type string`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := schema.ValidateAttributes(tt.attributes)
+
+			if gotErr == nil && tt.wantErr != "" || gotErr != nil && gotErr.Error() != tt.wantErr {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package gcs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+	"github.com/sgnl-ai/adapters/pkg/gcs"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+const (
+	MaxCSVRowSizeBytes       = 1 * 1024 * 1024 // 1MiB
+	MaxBytesToProcessPerPage = 1 * 1024 * 1024 // 1MiB
+
+	usersCSVData = "id,name,age\n1,Alice,30\n2,Bob,40\n3,Carol,50\n"
+)
+
+// testObjects are the objects served by the mock Cloud Storage XML API, by path.
+var testObjects = map[string]string{
+	"/sgnl/data/users.csv": usersCSVData,
+}
+
+// TestServerHandler mocks the Cloud Storage XML API, serving testObjects to requests authorized with
+// a bearer token.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer testtoken" {
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	data, found := testObjects[r.URL.EscapedPath()]
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	// ServeContent handles HEAD requests and the Range header.
+	http.ServeContent(w, r, "", time.Time{}, strings.NewReader(data))
+})
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	defer server.Close()
+
+	adapter := gcs.NewAdapter(gcs.NewClient(
+		server.Client(), MaxCSVRowSizeBytes, MaxBytesToProcessPerPage, 1,
+	))
+
+	attributes := []*framework.AttributeConfig{
+		{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+		{ExternalId: "name", Type: framework.AttributeTypeString},
+		{ExternalId: "age", Type: framework.AttributeTypeInt64},
+	}
+
+	tokenAuth := &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"}
+
+	firstPageCursor, _ := filestream.MarshalCursor(&filestream.Cursor{
+		Cursor:    testutil.GenPtr(int64(len(usersCSVData))),
+		Headers:   []string{"id", "name", "age"},
+		Remainder: []byte("3,Carol,50\n"),
+	})
+
+	tests := map[string]struct {
+		request      *framework.Request[gcs.Config]
+		wantResponse framework.Response
+	}{
+		"first_page": {
+			request: &framework.Request[gcs.Config]{
+				Address: server.URL,
+				Auth:    tokenAuth,
+				Config:  &gcs.Config{Bucket: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "1", "name": "Alice", "age": int64(30)},
+						{"id": "2", "name": "Bob", "age": int64(40)},
+					},
+					NextCursor: firstPageCursor,
+				},
+			},
+		},
+		"first_page_no_https_prefix": {
+			request: &framework.Request[gcs.Config]{
+				Address: strings.TrimPrefix(server.URL, "https://"),
+				Auth:    tokenAuth,
+				Config:  &gcs.Config{Bucket: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "1", "name": "Alice", "age": int64(30)},
+						{"id": "2", "name": "Bob", "age": int64(40)},
+					},
+					NextCursor: firstPageCursor,
+				},
+			},
+		},
+		"last_page": {
+			request: &framework.Request[gcs.Config]{
+				Address: server.URL,
+				Auth:    tokenAuth,
+				Config:  &gcs.Config{Bucket: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+				Cursor:   firstPageCursor,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "3", "name": "Carol", "age": int64(50)},
+					},
+				},
+			},
+		},
+		"missing_object": {
+			request: &framework.Request[gcs.Config]{
+				Address: server.URL,
+				Auth:    tokenAuth,
+				Config:  &gcs.Config{Bucket: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "groups",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to fetch entity from Google Cloud Storage: groups, error: " +
+						"the storage service responded with HTTP status 404.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				},
+			},
+		},
+		"invalid_token": {
+			request: &framework.Request[gcs.Config]{
+				Address: server.URL,
+				Auth:    &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer invalid"},
+				Config:  &gcs.Config{Bucket: "sgnl", Prefix: "data"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: attributes,
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to fetch entity from Google Cloud Storage: users, error: " +
+						"the storage service responded with HTTP status 401.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package gcs
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+)
+
+// Client is a client that allows querying the datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+}
+
+// Request is a request to the datasource.
+type Request struct {
+	// Token is the OAuth access token, including the "Bearer " prefix.
+	Token string
+
+	// BaseURL is the Base URL of the Cloud Storage XML API, e.g. https://storage.googleapis.com.
+	BaseURL string
+
+	// Bucket is the Google Cloud Storage bucket containing the files with entity data.
+	Bucket string
+
+	// PathPrefix is the prefix of the path containing the files with entity data.
+	PathPrefix string
+
+	// FileType is the extension of the files containing the entity data.
+	FileType string
+
+	// SchemaFiles is whether the schema of the entity file is read from its schema sidecar file.
+	SchemaFiles bool
+
+	// Dialect is the dialect of the CSV files.
+	Dialect filestream.CSVDialect
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	// The external ID should match the file name.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity.
+	// nil in the request for the first page.
+	Cursor *filestream.Cursor
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+
+	// AttributeConfig is the list of attributes requested by the datasource.
+	AttributeConfig []*framework.AttributeConfig
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *filestream.Cursor
+}
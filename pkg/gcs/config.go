@@ -0,0 +1,50 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package gcs
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+)
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Google Cloud Storage Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "bucket": "sgnl-data",
+    "prefix": "exports/identities",
+    "fileType": "csv",
+    "delimiter": ";"
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// File format configuration
+	filestream.FileConfig
+
+	// Bucket is the Google Cloud Storage bucket containing the files with entity data.
+	Bucket string `json:"bucket" validate:"required"`
+
+	// Prefix is the prefix of the path containing the files with entity data.
+	Prefix string `json:"prefix"`
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	switch {
+	case c == nil:
+		return errors.New("the request contains an empty configuration")
+	case c.Bucket == "":
+		return errors.New("the request contains an empty Google Cloud Storage bucket name in the configuration")
+	}
+
+	return c.FileConfig.Validate()
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package gcs
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client                   *http.Client
+	MaxCSVRowSizeBytes       int64
+	MaxBytesToProcessPerPage int64
+
+	// MaxConcurrentRangeReads is the maximum number of ranged GET requests issued in parallel
+	// to fetch the data of a single page. Values less than or equal to 1 disable parallel reads
+	// and the page data is fetched over a single connection.
+	MaxConcurrentRangeReads int
+}
+
+// NewClient returns a Client to query the datasource.
+func NewClient(
+	client *http.Client,
+	maxRowSizeBytes, maxPageSizeBytes int64,
+	maxConcurrentRangeReads int,
+) Client {
+	return &Datasource{
+		Client:                   client,
+		MaxCSVRowSizeBytes:       maxRowSizeBytes,
+		MaxBytesToProcessPerPage: maxPageSizeBytes,
+		MaxConcurrentRangeReads:  maxConcurrentRangeReads,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	// Timeout API calls that take longer than the configured timeout.
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	store := &objectStore{
+		client:  d.Client,
+		token:   request.Token,
+		baseURL: request.BaseURL,
+		bucket:  request.Bucket,
+	}
+
+	reader := &filestream.Reader{
+		StoreName:                "Google Cloud Storage",
+		MaxCSVRowSizeBytes:       d.MaxCSVRowSizeBytes,
+		MaxBytesToProcessPerPage: d.MaxBytesToProcessPerPage,
+		MaxConcurrentRangeReads:  d.MaxConcurrentRangeReads,
+	}
+
+	streamRequest := &filestream.Request{
+		Key:                   filestream.ObjectKey(request.PathPrefix, request.EntityExternalID, request.FileType),
+		FileType:              request.FileType,
+		Dialect:               request.Dialect,
+		EntityExternalID:      request.EntityExternalID,
+		PageSize:              request.PageSize,
+		Cursor:                request.Cursor,
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		AttributeConfig:       request.AttributeConfig,
+	}
+
+	if request.SchemaFiles {
+		streamRequest.SchemaKey = filestream.SchemaKey(request.PathPrefix, request.EntityExternalID)
+	}
+
+	page, err := reader.GetPage(ctx, store, streamRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{
+		StatusCode: http.StatusOK,
+		Objects:    page.Objects,
+		NextCursor: page.NextCursor,
+	}
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package gcs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+	"github.com/sgnl-ai/adapters/pkg/gcs"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// newRangeTrackingServer returns a mock Cloud Storage XML API serving data for any object, and recording the
+// Range headers of the GET requests.
+func newRangeTrackingServer(t *testing.T, data string) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		ranges []string
+	)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			mu.Lock()
+			ranges = append(ranges, r.Header.Get("Range"))
+			mu.Unlock()
+		}
+
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(data))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]string(nil), ranges...)
+	}
+}
+
+// TestDatasourceGetPageLimits verifies the row size and bytes per page limits bound the ranges of the object
+// read for a page, as for the S3 adapter.
+func TestDatasourceGetPageLimits(t *testing.T) {
+	csvData := "id,name\n" + strings.Repeat("1234567890,abcdefghijklmnopqrstuvwxyz\n", 20)
+
+	tests := map[string]struct {
+		maxRowSize      int64
+		maxBytesPerPage int64
+		cursor          *filestream.Cursor
+		wantRanges      []string
+		wantErr         bool
+	}{
+		"first_page": {
+			maxRowSize:      100,
+			maxBytesPerPage: 150,
+			// The headers are read from the first 2 * maxRowSize bytes, then the rows from the end of the headers.
+			wantRanges: []string{"bytes=0-199", "bytes=8-157"},
+		},
+		"cursor_with_headers": {
+			maxRowSize:      100,
+			maxBytesPerPage: 150,
+			cursor: &filestream.Cursor{
+				Cursor:  testutil.GenPtr(int64(200)),
+				Headers: []string{"id", "name"},
+			},
+			wantRanges: []string{"bytes=200-349"},
+		},
+		"row_exceeding_row_size": {
+			maxRowSize:      20,
+			maxBytesPerPage: 150,
+			cursor: &filestream.Cursor{
+				Cursor:  testutil.GenPtr(int64(8)),
+				Headers: []string{"id", "name"},
+			},
+			wantRanges: []string{"bytes=8-157"},
+			wantErr:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, gotRanges := newRangeTrackingServer(t, csvData)
+
+			client := gcs.NewClient(server.Client(), tt.maxRowSize, tt.maxBytesPerPage, 1)
+
+			ctx, _ := testutil.NewContextWithObservableLogger(context.Background())
+
+			_, err := client.GetPage(ctx, &gcs.Request{
+				Token:                 "Bearer testtoken",
+				BaseURL:               server.URL,
+				Bucket:                "sgnl",
+				PathPrefix:            "data",
+				FileType:              "csv",
+				EntityExternalID:      "users",
+				PageSize:              2,
+				Cursor:                tt.cursor,
+				RequestTimeoutSeconds: 10,
+				AttributeConfig: []*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+					{ExternalId: "name", Type: framework.AttributeTypeString},
+				},
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", err, tt.wantErr)
+			}
+
+			if got := gotRanges(); strings.Join(got, ";") != strings.Join(tt.wantRanges, ";") {
+				t.Errorf("gotRanges: %v, wantRanges: %v", got, tt.wantRanges)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package gcs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+)
+
+// DefaultBaseURL is the Base URL of the Cloud Storage XML API, used if the request has no address.
+const DefaultBaseURL = "https://storage.googleapis.com"
+
+// objectStore implements filestream.Store for the objects of a Google Cloud Storage bucket,
+// using the XML API.
+type objectStore struct {
+	client  *http.Client
+	token   string
+	baseURL string
+	bucket  string
+}
+
+func (s *objectStore) Size(ctx context.Context, key string) (int64, error) {
+	res, err := s.do(ctx, http.MethodHead, key, "")
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	size, err := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to determine file size")
+	}
+
+	return size, nil
+}
+
+func (s *objectStore) Read(ctx context.Context, key string, start, end int64) (io.ReadCloser, error) {
+	var rangeHeader string
+
+	if end >= 0 {
+		rangeHeader = fmt.Sprintf("bytes=%d-%d", start, end)
+	} else if start > 0 {
+		rangeHeader = fmt.Sprintf("bytes=%d-", start)
+	}
+
+	res, err := s.do(ctx, http.MethodGet, key, rangeHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+// do sends an authenticated request for the object with the given key, and returns the response if successful.
+func (s *objectStore) do(ctx context.Context, method, key, rangeHeader string) (*http.Response, error) {
+	objectURL := fmt.Sprintf("%s/%s/%s", s.baseURL, url.PathEscape(s.bucket), escapeKey(key))
+
+	req, err := http.NewRequestWithContext(ctx, method, objectURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", s.token)
+
+	if rangeHeader != "" {
+		req.Header.Set("Range", rangeHeader)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusPartialContent {
+		res.Body.Close()
+
+		return nil, &customerror.HTTPStatusError{
+			Service:          "the storage service",
+			StatusCode:       res.StatusCode,
+			RetryAfterHeader: res.Header.Get("Retry-After"),
+		}
+	}
+
+	return res, nil
+}
+
+// escapeKey escapes each segment of the path of an object key.
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
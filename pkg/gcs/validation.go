@@ -0,0 +1,101 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package gcs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+	"github.com/sgnl-ai/adapters/pkg/validation"
+)
+
+const (
+	// Limit the maximum allowed page size to 1000.
+	maxPageSize = 1000
+)
+
+// ValidateGetPageRequest validates the fields of the GetPage Request.
+func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework.Request[Config]) *framework.Error {
+	if err := request.Config.Validate(ctx); err != nil {
+		return &framework.Error{
+			Message: fmt.Sprintf("Google Cloud Storage config is invalid: %v.", err.Error()),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	// The address is optional, and defaults to the global endpoint of the XML API.
+	if request.Address == "" {
+		request.Address = DefaultBaseURL
+	} else {
+		trimmedAddress, parsed, err := validation.ParseAndValidateAddress(request.Address, []string{"https"})
+		if err != nil {
+			return err
+		}
+
+		// Normalize address with https:// scheme if not provided
+		if parsed.Scheme == "" {
+			request.Address = "https://" + trimmedAddress
+		} else {
+			request.Address = trimmedAddress
+		}
+	}
+
+	if request.Auth == nil || request.Auth.HTTPAuthorization == "" {
+		return &framework.Error{
+			Message: "Provided datasource auth is missing required http authorization credentials.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	if !strings.HasPrefix(request.Auth.HTTPAuthorization, "Bearer ") {
+		return &framework.Error{
+			Message: `Provided auth token is missing required "Bearer " prefix.`,
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	// Validate that at least the unique ID attribute for the requested entity
+	// is requested.
+	var uniqueIDAttributeFound bool
+
+	for _, config := range request.Entity.Attributes {
+		if config.UniqueId {
+			uniqueIDAttributeFound = true
+
+			break
+		}
+	}
+
+	if !uniqueIDAttributeFound {
+		return &framework.Error{
+			Message: "Requested entity attributes are missing unique ID attribute.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if request.PageSize > maxPageSize {
+		return &framework.Error{
+			Message: fmt.Sprintf(
+				"Provided page size (%d) exceeds the maximum allowed (%d).", request.PageSize, maxPageSize,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	if request.Config.FileType != nil {
+		if _, found := filestream.SupportedFileTypes[*request.Config.FileType]; !found {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"The filetype %s in config.fileType is not supported.", *request.Config.FileType,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+	}
+
+	return nil
+}
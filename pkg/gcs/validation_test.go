@@ -0,0 +1,139 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package gcs_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/filestream"
+	"github.com/sgnl-ai/adapters/pkg/gcs"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: "users",
+		Attributes: []*framework.AttributeConfig{
+			{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+		},
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[gcs.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request_default_address": {
+			request: &framework.Request[gcs.Config]{
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config:   &gcs.Config{Bucket: "sgnl"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantAddress: gcs.DefaultBaseURL,
+		},
+		"valid_request_address_without_https_prefix": {
+			request: &framework.Request[gcs.Config]{
+				Address:  "storage.example.com",
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config:   &gcs.Config{Bucket: "sgnl", Prefix: "data"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantAddress: "https://storage.example.com",
+		},
+		"invalid_request_missing_bucket": {
+			request: &framework.Request[gcs.Config]{
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config:   &gcs.Config{},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Google Cloud Storage config is invalid: " +
+					"the request contains an empty Google Cloud Storage bucket name in the configuration.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_token": {
+			request: &framework.Request[gcs.Config]{
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{Username: "user", Password: "password"},
+				},
+				Config:   &gcs.Config{Bucket: "sgnl"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided datasource auth is missing required http authorization credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_token_missing_bearer_prefix": {
+			request: &framework.Request[gcs.Config]{
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "testtoken"},
+				Config:   &gcs.Config{Bucket: "sgnl"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_unsupported_charset": {
+			request: &framework.Request[gcs.Config]{
+				Auth: &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config: &gcs.Config{
+					Bucket:     "sgnl",
+					FileConfig: filestream.FileConfig{Charset: testutil.GenPtr("utf-32")},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Google Cloud Storage config is invalid: " +
+					"the charset utf-32 in the configuration is not supported.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id_attribute": {
+			request: &framework.Request[gcs.Config]{
+				Auth:   &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config: &gcs.Config{Bucket: "sgnl"},
+				Entity: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: []*framework.AttributeConfig{
+						{ExternalId: "name", Type: framework.AttributeTypeString},
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+	}
+
+	adapter := &gcs.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
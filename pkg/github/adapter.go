@@ -12,6 +12,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -49,7 +50,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
 
 	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[string](request.Cursor)
+	cursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
@@ -57,13 +58,14 @@ func (a *Adapter) RequestPageFromDatasource(
 	githubReq := &Request{
 		BaseURL:               request.Address,
 		Token:                 request.Auth.HTTPAuthorization,
-		EnterpriseSlug:        request.Config.EnterpriseSlug,
+		EnterpriseSlug:        request.Config.enterpriseSlug(),
 		APIVersion:            request.Config.APIVersion,
 		IsEnterpriseCloud:     request.Config.IsEnterpriseCloud,
 		EntityConfig:          &request.Entity,
+		AdditionalFields:      request.Config.AdditionalFields[request.Entity.ExternalId],
 		EntityExternalID:      request.Entity.ExternalId,
 		Cursor:                cursor,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		Organizations:         request.Config.Organizations,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
 	}
@@ -79,6 +81,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// GitHub GraphQL API dates are represented using ISO 8601.
 	// https://docs.github.com/en/enterprise-cloud@latest/graphql/reference/scalars#datetime.
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
@@ -1337,6 +1337,72 @@ func TestAdapterGetRepositoryPageWithOrganizations(t *testing.T) {
 				nil,
 			),
 		},
+		"first_page_with_empty_enterprise_slug": {
+			ctx: context.Background(),
+			request: &framework.Request[github_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer Testtoken",
+				},
+				Config: &github_adapter.Config{
+					IsEnterpriseCloud: false,
+					APIVersion:        testutil.GenPtr("v3"),
+					EnterpriseSlug:    testutil.GenPtr(""),
+					Organizations:     []string{"arvindorg1", "arvindorg2"},
+				},
+				Entity:   *PopulateDefaultRepositoryEntityConfig(),
+				Ordered:  false,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                "MDEwOlJlcG9zaXRvcnk1",
+							"name":              "arvindrepo1",
+							"databaseId":        int64(5),
+							"allowUpdateBranch": false,
+							"orgId":             "O_kgDOCPwuWw",
+							"pushedAt":          time.Date(2024, 2, 2, 23, 22, 20, 0, time.UTC),
+							"createdAt":         time.Date(2024, 2, 2, 23, 22, 20, 0, time.UTC),
+							"$.collaborators.edges": []framework.Object{
+								{
+									"$.node.id":  "MDQ6VXNlcjQ=",
+									"permission": "ADMIN",
+								},
+								{
+									"$.node.id":  "MDQ6VXNlcjY=",
+									"permission": "MAINTAIN",
+								},
+							},
+						},
+						{
+							"id":                "MDEwOlJlcG9zaXRvcnk2",
+							"name":              "arvindrepo2",
+							"databaseId":        int64(6),
+							"allowUpdateBranch": false,
+							// "pushedAt":          "2024-02-02T23:22:33Z",
+							// "createdAt":         "2024-02-02T23:22:32Z",
+							"orgId":     "O_kgDOCPwuWw",
+							"pushedAt":  time.Date(2024, 2, 2, 23, 22, 33, 0, time.UTC),
+							"createdAt": time.Date(2024, 2, 2, 23, 22, 32, 0, time.UTC),
+							"$.collaborators.edges": []framework.Object{
+								{
+									"$.node.id":  "MDQ6VXNlcjQ=",
+									"permission": "ADMIN",
+								},
+							},
+						},
+					},
+					NextCursor: "eyJjdXJzb3IiOiJleUpvWVhOT1pYaDBVR0ZuWlNJNlptRnNjMlVzSW1WdVpFTjFjbk52Y2lJNklsa3pWbmxqTWpsNVQyNVplVTl3UlVjaUxDSnZjbWRoYm1sNllYUnBiMjVQWm1aelpYUWlPakFzSWtsdWJtVnlVR0ZuWlVsdVptOGlPbTUxYkd4OSJ9",
+				},
+			},
+			wantCursor: CreateGraphQLCompositeCursor(
+				[]*string{testutil.GenPtr("Y3Vyc29yOnYyOpEG")},
+				nil,
+				nil,
+			),
+		},
 	}
 
 	for name, tt := range tests {
@@ -0,0 +1,147 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package github
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AllowedAdditionalFields is the set of GraphQL fields of each entity which can be requested through
+// Config.AdditionalFields. Only fields of the entity's own GraphQL type are allowed, i.e. fields which do not
+// change how the entity is paginated or parsed.
+var AllowedAdditionalFields = map[string]map[string]struct{}{
+	Organization: {
+		"domains":                         {},
+		"ipAllowListEntries":              {},
+		"requiresTwoFactorAuthentication": {},
+		"samlIdentityProvider":            {},
+	},
+	User: {
+		"socialAccounts": {},
+		"status":         {},
+	},
+	Team: {
+		"ancestors":  {},
+		"childTeams": {},
+		"parentTeam": {},
+	},
+	Repository: {
+		"branchProtectionRules": {},
+		"defaultBranchRef":      {},
+		"languages":             {},
+		"licenseInfo":           {},
+		"primaryLanguage":       {},
+		"repositoryTopics":      {},
+	},
+	Issue: {
+		"milestone":      {},
+		"reactionGroups": {},
+	},
+	PullRequest: {
+		"latestReviews":  {},
+		"mergedBy":       {},
+		"milestone":      {},
+		"reviewRequests": {},
+	},
+}
+
+// ValidateAdditionalField validates a GraphQL field selection configured in Config.AdditionalFields for an entity.
+// The selection must be a single field, optionally with arguments and a selection set, and the field must be
+// allowed for the entity. For example:
+//
+//	repositoryTopics(first: 20) { nodes { topic { name } } }
+func ValidateAdditionalField(entityExternalID, field string) error {
+	name, rest := additionalFieldName(field)
+	if name == "" {
+		return fmt.Errorf("additional field of entity %s must start with a field name: %s", entityExternalID, field)
+	}
+
+	if _, allowed := AllowedAdditionalFields[entityExternalID][name]; !allowed {
+		return fmt.Errorf("additional field %s is not allowed for entity %s", name, entityExternalID)
+	}
+
+	// Strings and comments are not needed to select the allowed fields, and would make it possible to
+	// terminate the selection early.
+	if strings.ContainsAny(rest, "\"#") {
+		return fmt.Errorf("additional field %s of entity %s must not contain strings or comments", name, entityExternalID)
+	}
+
+	var err error
+
+	if strings.HasPrefix(rest, "(") {
+		if rest, err = skipBlock(rest, '(', ')'); err != nil {
+			return fmt.Errorf("additional field %s of entity %s has invalid arguments: %w", name, entityExternalID, err)
+		}
+	}
+
+	if strings.HasPrefix(rest, "{") {
+		if rest, err = skipBlock(rest, '{', '}'); err != nil {
+			return fmt.Errorf(
+				"additional field %s of entity %s has an invalid selection set: %w", name, entityExternalID, err,
+			)
+		}
+	}
+
+	if rest != "" {
+		return fmt.Errorf("additional field %s of entity %s must be a single field", name, entityExternalID)
+	}
+
+	return nil
+}
+
+// additionalFieldName splits a GraphQL field selection into the name of the field and the rest of the
+// selection, i.e. its arguments and selection set.
+func additionalFieldName(field string) (string, string) {
+	field = strings.TrimSpace(field)
+
+	end := strings.IndexFunc(field, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '_'
+	})
+	if end == -1 {
+		end = len(field)
+	}
+
+	return field[:end], strings.TrimSpace(field[end:])
+}
+
+// skipBlock skips the block starting at the beginning of s, delimited by the open and closing characters,
+// and returns the rest of s. Nested blocks of any kind must be balanced.
+func skipBlock(s string, open, closing byte) (string, error) {
+	var stack []byte
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(', '{', '[':
+			stack = append(stack, s[i])
+		case ')', '}', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != matchingOpen(s[i]) {
+				return "", errors.New("unbalanced brackets")
+			}
+
+			stack = stack[:len(stack)-1]
+		}
+
+		if len(stack) == 0 {
+			if s[0] != open || s[i] != closing {
+				return "", errors.New("unbalanced brackets")
+			}
+
+			return strings.TrimSpace(s[i+1:]), nil
+		}
+	}
+
+	return "", errors.New("unbalanced brackets")
+}
+
+func matchingOpen(c byte) byte {
+	switch c {
+	case ')':
+		return '('
+	case '}':
+		return '{'
+	default:
+		return '['
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package github_test
+
+import (
+	"testing"
+
+	github "github.com/sgnl-ai/adapters/pkg/github"
+)
+
+func TestValidateAdditionalField(t *testing.T) {
+	tests := map[string]struct {
+		entityExternalID string
+		field            string
+		wantErr          string
+	}{
+		"scalar_field": {
+			entityExternalID: "Organization",
+			field:            "requiresTwoFactorAuthentication",
+		},
+		"field_with_arguments_and_selection_set": {
+			entityExternalID: "Repository",
+			field:            "repositoryTopics(first: 20) { nodes { topic { name } } }",
+		},
+		"field_with_nested_arguments": {
+			entityExternalID: "Organization",
+			field: "samlIdentityProvider { externalIdentities(first: 100) { nodes { samlIdentity { nameId } " +
+				"user { login } } } }",
+		},
+		"field_with_list_argument": {
+			entityExternalID: "Repository",
+			field:            " languages(first: 10, orderBy: {field: SIZE, direction: DESC}) { nodes { name } } ",
+		},
+		"field_not_allowed": {
+			entityExternalID: "Repository",
+			field:            "viewerPermission",
+			wantErr:          "additional field viewerPermission is not allowed for entity Repository",
+		},
+		"entity_not_allowed": {
+			entityExternalID: "SecretScanningAlert",
+			field:            "repositoryTopics",
+			wantErr:          "additional field repositoryTopics is not allowed for entity SecretScanningAlert",
+		},
+		"missing_field_name": {
+			entityExternalID: "Repository",
+			field:            "{ nodes { name } }",
+			wantErr:          "additional field of entity Repository must start with a field name: { nodes { name } }",
+		},
+		"multiple_fields": {
+			entityExternalID: "Repository",
+			field:            "licenseInfo { name } viewerPermission",
+			wantErr:          "additional field licenseInfo of entity Repository must be a single field",
+		},
+		"selection_set_closes_query": {
+			entityExternalID: "Repository",
+			field:            "licenseInfo { name } } } viewer { login",
+			wantErr:          "additional field licenseInfo of entity Repository must be a single field",
+		},
+		"unbalanced_selection_set": {
+			entityExternalID: "Repository",
+			field:            "licenseInfo { name",
+			wantErr:          "additional field licenseInfo of entity Repository has an invalid selection set: unbalanced brackets",
+		},
+		"unbalanced_arguments": {
+			entityExternalID: "Repository",
+			field:            "languages(first: 10 { nodes { name } }",
+			wantErr:          "additional field languages of entity Repository has invalid arguments: unbalanced brackets",
+		},
+		"string_argument": {
+			entityExternalID: "Repository",
+			field:            `languages(after: "abc") { nodes { name } }`,
+			wantErr:          "additional field languages of entity Repository must not contain strings or comments",
+		},
+		"comment": {
+			entityExternalID: "Repository",
+			field:            "licenseInfo # { name }",
+			wantErr:          "additional field licenseInfo of entity Repository must not contain strings or comments",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := github.ValidateAdditionalField(tt.entityExternalID, tt.field)
+
+			if tt.wantErr == "" {
+				if gotErr != nil {
+					t.Errorf("unexpected error: %v", gotErr)
+				}
+
+				return
+			}
+
+			if gotErr == nil || gotErr.Error() != tt.wantErr {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
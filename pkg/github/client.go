@@ -50,6 +50,10 @@ type Request struct {
 	// Attributes contains the list of attributes to request along with the current request.
 	EntityConfig *framework.EntityConfig
 
+	// AdditionalFields contains the GraphQL field selections to request in addition to the attributes
+	// of the entity. See Config.AdditionalFields.
+	AdditionalFields []string
+
 	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
 	// This should be set to the number of seconds to wait before timing out.
 	RequestTimeoutSeconds int
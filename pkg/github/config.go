@@ -25,7 +25,12 @@ var supportedAPIVersions = map[string]bool{
 		"wholesalechips"
 	],
 	"isEnterpriseCloud": true,
-	"apiVersion": "v3"
+	"apiVersion": "v3",
+	"additionalFields": {
+		"Repository": [
+			"repositoryTopics(first: 20) { nodes { topic { name } } }"
+		]
+	}
 }
 */
 type Config struct {
@@ -33,9 +38,12 @@ type Config struct {
 	*config.CommonConfig
 
 	// EnterpriseSlug is the enterprise slug to query. This is the top level entity for every Github query.
+	// An empty slug is treated as unset, so that organizations without an enterprise account, e.g. on github.com,
+	// can be synced with only the Organizations list.
 	EnterpriseSlug *string `json:"enterpriseSlug,omitempty"`
 
 	// Organizations is the list of organizations to query. Either this field or EnterpriseSlug must be set (but not both).
+	// If set, enterprise-scoped attributes, e.g. enterpriseId, are left unset.
 	Organizations []string `json:"organizations,omitempty"`
 
 	// isEnterpriseCloud is a boolean that indicates whether the deployment is GitHub Enterprise Cloud.
@@ -46,6 +54,11 @@ type Config struct {
 	// APIVersion is the version of the GitHub API to use.
 	// This is only used when constructing REST endpoints.
 	APIVersion *string `json:"apiVersion"`
+
+	// AdditionalFields maps the external ID of an entity to GraphQL field selections to add to the query of the
+	// entity, for fields which can't be requested through the entity attributes, e.g. fields with arguments.
+	// Only the fields in AllowedAdditionalFields can be requested.
+	AdditionalFields map[string][]string `json:"additionalFields,omitempty"`
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
@@ -57,7 +70,7 @@ func (c *Config) Validate(_ context.Context, isRestAPI bool) error {
 		return errors.New("enterpriseSlug must be specified")
 	case c.EnterpriseSlug == nil && len(c.Organizations) == 0:
 		return errors.New("either enterpriseSlug or organizations must be specified")
-	case c.EnterpriseSlug != nil && len(c.Organizations) > 0:
+	case c.enterpriseSlug() != nil && len(c.Organizations) > 0:
 		return errors.New("only one of enterpriseSlug or organizations must be specified, not both")
 	case c.APIVersion == nil && isRestAPI:
 		return errors.New("apiVersion is not set for an entity that is retrieve through the GitHub REST API")
@@ -65,5 +78,23 @@ func (c *Config) Validate(_ context.Context, isRestAPI bool) error {
 		return fmt.Errorf("apiVersion is not supported: %s", *c.APIVersion)
 	}
 
+	for entityExternalID, fields := range c.AdditionalFields {
+		for _, field := range fields {
+			if err := ValidateAdditionalField(entityExternalID, field); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
+
+// enterpriseSlug returns the enterprise slug to query, or nil if the organizations are queried without an
+// enterprise, i.e. if the slug is unset or empty.
+func (c *Config) enterpriseSlug() *string {
+	if c.EnterpriseSlug == nil || *c.EnterpriseSlug == "" {
+		return nil
+	}
+
+	return c.EnterpriseSlug
+}
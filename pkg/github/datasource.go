@@ -16,6 +16,7 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
 	customerror "github.com/sgnl-ai/adapters/pkg/errors"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
@@ -47,6 +48,12 @@ type Entity struct {
 	CollectionAttribute string
 	// isRestAPI is a boolean that indicates whether the entity is retrieved using the GitHub REST APIs.
 	isRestAPI bool
+	// requiresOrganizations is a boolean that indicates whether the entity can only be retrieved per organization,
+	// i.e. the GitHub API has no enterprise endpoint for it and Config.Organizations must be set.
+	requiresOrganizations bool
+	// usesRestAPI is a boolean that indicates whether some attributes of a GraphQL entity are retrieved using the
+	// GitHub REST APIs, which requires Config.APIVersion to be set.
+	usesRestAPI bool
 }
 
 type ContainerLayers struct {
@@ -56,6 +63,8 @@ type ContainerLayers struct {
 	Label        *LabelInfo
 	Issue        *IssueInfo
 	PullRequest  *PullRequestInfo
+	User         *UserInfo
+	Package      *PackageInfo
 }
 
 // This entity represents the pagination metadata for each layer of the GraphQL response.
@@ -112,6 +121,7 @@ type OrganizationInfo struct {
 	Users        *EntitiesInfo `json:"membersWithRole"`
 	Repositories *EntitiesInfo `json:"repositories"`
 	Teams        *EntitiesInfo `json:"teams"`
+	Packages     *EntitiesInfo `json:"packages"`
 }
 
 type RepositoryInfo struct {
@@ -135,6 +145,16 @@ type LabelInfo struct {
 	PullRequests *EntitiesInfo `json:"pullRequests"`
 }
 
+type UserInfo struct {
+	ID    *string       `json:"id"`
+	Gists *EntitiesInfo `json:"gists"`
+}
+
+type PackageInfo struct {
+	ID       *string       `json:"id"`
+	Versions *EntitiesInfo `json:"versions"`
+}
+
 type PullRequestInfo struct {
 	ID           *string       `json:"id"`
 	ChangedFiles *EntitiesInfo `json:"files"`
@@ -154,6 +174,7 @@ const (
 	TeamRepository         string = "$.repositories.edges"
 	Repository             string = "Repository"
 	RepositoryCollaborator string = "$.collaborators.edges"
+	RepositoryTopic        string = "$.repositoryTopics.nodes"
 	Collaborator           string = "Collaborator"
 	Label                  string = "Label"
 	IssueLabel             string = "IssueLabel"
@@ -168,6 +189,14 @@ const (
 	PullRequestAssignee    string = "PullRequestAssignee"
 	PullRequestParticipant string = "PullRequestParticipant"
 	SecretScanningAlert    string = "SecretScanningAlert"
+	Gist                   string = "Gist"
+	Package                string = "Package"
+	PackageVersion         string = "PackageVersion"
+
+	RepositoryCustomProperty string = "RepositoryCustomProperty"
+
+	OrganizationSecuritySettings string = "OrganizationSecuritySettings"
+	IPAllowListEntry             string = "$.ipAllowListEntries.nodes"
 )
 
 var (
@@ -214,6 +243,10 @@ var (
 		RepositoryCollaborator: {
 			UniqueExternalIDAttribute: "id",
 		},
+		// RepositoryTopic is a child entity of Repository.
+		RepositoryTopic: {
+			UniqueExternalIDAttribute: "$.topic.id",
+		},
 		Collaborator: {
 			UniqueExternalIDAttribute: "id",
 			ParsePath: []string{"Enterprise", "Organizations", "Nodes", "Organization",
@@ -290,6 +323,40 @@ var (
 			UniqueExternalIDAttribute: "number",
 			isRestAPI:                 true,
 		},
+		// Gist is a gist owned by a member of an organization.
+		Gist: {
+			UniqueExternalIDAttribute: "id",
+			ParsePath: []string{"Enterprise", "Organizations", "Nodes", "Organization",
+				"Users", "Nodes", "User", "Gists", "Nodes"},
+		},
+		Package: {
+			UniqueExternalIDAttribute: "id",
+			ParsePath:                 []string{"Enterprise", "Organizations", "Nodes", "Organization", "Packages", "Nodes"},
+		},
+		PackageVersion: {
+			UniqueExternalIDAttribute: "id",
+			ParsePath: []string{"Enterprise", "Organizations", "Nodes", "Organization",
+				"Packages", "Nodes", "Package", "Versions", "Nodes"},
+		},
+		// RepositoryCustomProperty is a repository of an organization with the values of the custom properties
+		// of the repository, in the `properties` child entity.
+		RepositoryCustomProperty: {
+			UniqueExternalIDAttribute: "repository_id",
+			isRestAPI:                 true,
+			requiresOrganizations:     true,
+		},
+		// OrganizationSecuritySettings is an organization with its security settings, e.g. whether two-factor
+		// authentication is required, and the entries of its IP allow list in the `$.ipAllowListEntries.nodes`
+		// child entity. The default repository permission of the organization is retrieved from the REST API.
+		OrganizationSecuritySettings: {
+			UniqueExternalIDAttribute: "id",
+			ParsePath:                 []string{"Enterprise", "Organizations", "Nodes"},
+			usesRestAPI:               true,
+		},
+		// IPAllowListEntry is a child entity of OrganizationSecuritySettings.
+		IPAllowListEntry: {
+			UniqueExternalIDAttribute: "id",
+		},
 	}
 )
 
@@ -417,6 +484,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -468,6 +536,18 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		return nil, frameworkErr
 	}
 
+	if request.EntityExternalID == OrganizationSecuritySettings &&
+		requestsAttribute(request.EntityConfig, DefaultRepositoryPermission) {
+		errResponse, err := d.injectDefaultRepositoryPermissions(ctx, request, response.Objects)
+		if err != nil {
+			return nil, err
+		}
+
+		if errResponse != nil {
+			return errResponse, nil
+		}
+	}
+
 	logger.Info("Datasource request completed successfully",
 		fields.ResponseStatusCode(response.StatusCode),
 		fields.ResponseObjectCount(len(response.Objects)),
@@ -580,6 +660,13 @@ func ParseGraphQLResponseForOrganization(
 		}
 	}
 
+	if response.Data.Organization == nil {
+		return nil, nil, &framework.Error{
+			Message: "Failed to unmarshal the datasource response: Organization not found.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
 	// nolint: prealloc
 	objects = make([]map[string]any, 0)
 
@@ -589,7 +676,7 @@ func ParseGraphQLResponseForOrganization(
 	if currentCursor != nil && currentCursor.Cursor != nil {
 		var err *framework.Error
 
-		currentPageInfo, err = DecodePageInfo(currentCursor.Cursor)
+		currentPageInfo, err = DecodePageInfo(currentCursor.Cursor, Organization)
 
 		if err != nil {
 			return nil, nil, err
@@ -642,7 +729,7 @@ func ParseGraphQLResponse(
 	nextCursor *pagination.CompositeCursor[string],
 	err *framework.Error,
 ) {
-	if externalID == Organization && orgCount > 0 {
+	if (externalID == Organization || externalID == OrganizationSecuritySettings) && orgCount > 0 {
 		return ParseGraphQLResponseForOrganization(body, currentCursor, orgCount)
 	}
 
@@ -679,7 +766,7 @@ func ParseGraphQLResponse(
 	if currentCursor != nil && currentCursor.Cursor != nil {
 		var err *framework.Error
 
-		currentPageInfo, err = DecodePageInfo(currentCursor.Cursor)
+		currentPageInfo, err = DecodePageInfo(currentCursor.Cursor, externalID)
 
 		if err != nil {
 			return nil, nil, err
@@ -1057,6 +1144,10 @@ func GetCollectionEntityInterface(
 		return ParseAndAssignCollection[IssueInfo](entities, entityName, &collections.Issue)
 	case PullRequest:
 		return ParseAndAssignCollection[PullRequestInfo](entities, entityName, &collections.PullRequest)
+	case User:
+		return ParseAndAssignCollection[UserInfo](entities, entityName, &collections.User)
+	case Package:
+		return ParseAndAssignCollection[PackageInfo](entities, entityName, &collections.Package)
 	default:
 		return nil, &framework.Error{
 			Message: fmt.Sprintf("Failed to get the collection entity interface: %s not found.", entityName),
@@ -1276,7 +1367,7 @@ func InjectCommonFields(
 		switch externalID {
 		// Teams and Repositories can use the OrgId field to build relationships with the Organization.
 		// OrganizationUser needs OrgId to build relationships between Organizations and Users.
-		case Team, Repository, OrganizationUser:
+		case Team, Repository, OrganizationUser, Package:
 			if container.Organization == nil || container.Organization.ID == nil {
 				return &framework.Error{
 					Message: fmt.Sprintf("Organization is nil or orgID is missing for the %s entity.", externalID),
@@ -1321,6 +1412,24 @@ func InjectCommonFields(
 			}
 
 			(*objects)[i]["pullRequestId"] = *container.PullRequest.ID
+		case Gist:
+			if container.User == nil || container.User.ID == nil {
+				return &framework.Error{
+					Message: fmt.Sprintf("User is nil or userID is missing for the %s entity.", externalID),
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				}
+			}
+
+			(*objects)[i]["userId"] = *container.User.ID
+		case PackageVersion:
+			if container.Package == nil || container.Package.ID == nil {
+				return &framework.Error{
+					Message: fmt.Sprintf("Package is nil or packageID is missing for the %s entity.", externalID),
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				}
+			}
+
+			(*objects)[i]["packageId"] = *container.Package.ID
 		}
 
 		// This switch statement handles post-processing tasks like creating the uniqueId field.
@@ -1391,10 +1500,11 @@ func GetValueFromPath(object map[string]any, path []string) (string, error) {
 		}
 	}
 
-	strValue, ok := value.(string)
-	if !ok {
+	// Numeric IDs, e.g. the databaseId of the objects, are unmarshalled as float64.
+	switch value.(type) {
+	case string, float64:
+		return commonutil.FormatID(value), nil
+	default:
 		return "", fmt.Errorf("expected string, got %T", value)
 	}
-
-	return strValue, nil
 }
@@ -0,0 +1,66 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package github_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/github"
+)
+
+// BenchmarkParseGraphQLResponse benchmarks parsing a multi-MB GraphQL page of repositories, to catch performance
+// regressions in the parsing of the responses.
+//
+//	go test -run '^$' -bench BenchmarkParse -benchmem ./pkg/github/
+func BenchmarkParseGraphQLResponse(b *testing.B) {
+	body := generateRepositoriesPage(5000)
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		objects, _, err := github.ParseGraphQLResponse(body, github.Repository, nil, 0)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if len(objects) != 5000 {
+			b.Fatalf("got %d objects, want 5000", len(objects))
+		}
+	}
+}
+
+// generateRepositoriesPage returns a GraphQL response of the repositories of an organization of an enterprise
+// with objectCount repositories.
+func generateRepositoriesPage(objectCount int) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(`{"data":{"enterprise":{"id":"MDEwOkVudGVycHJpc2Ux","organizations":{` +
+		`"pageInfo":{"endCursor":"Y3Vyc29yOnYyOpKqQXJ2aW5kT3JnMQU=","hasNextPage":true},` +
+		`"nodes":[{"id":"MDEyOk9yZ2FuaXphdGlvbjU=","repositories":{` +
+		`"pageInfo":{"endCursor":"Y3Vyc29yOnYyOpEB","hasNextPage":true},"nodes":[`)
+
+	for i := range objectCount {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		fmt.Fprintf(&buf,
+			`{"id":"MDEwOlJlcG9zaXRvcnk%08d","name":"repository-%d","databaseId":%d,`+
+				`"url":"https://github.com/example-org/repository-%d","allowUpdateBranch":false,`+
+				`"description":"Repository %d of the example organization, used to benchmark the parsing of the responses.",`+
+				`"isPrivate":true,"isArchived":false,"visibility":"PRIVATE",`+
+				`"pushedAt":"2026-03-04T05:06:07Z","createdAt":"2026-01-02T03:04:05Z","updatedAt":"2026-03-04T05:06:07Z",`+
+				`"owner":{"login":"example-org","id":"MDEyOk9yZ2FuaXphdGlvbjU="},`+
+				`"primaryLanguage":{"name":"Go"},"defaultBranchRef":{"name":"main"}}`,
+			i, i, 100000+i, i, i,
+		)
+	}
+
+	buf.WriteString(`]}}]}}}}`)
+
+	return buf.Bytes()
+}
@@ -71,6 +71,65 @@ func TestParseGraphQLResponse(t *testing.T) {
 				nil,
 			),
 		},
+		"package_versions": {
+			body: []byte(`{
+				"data": {
+					"enterprise": {
+						"id": "MDEwOkVudGVycHJpc2Ux",
+						"organizations": {
+							"pageInfo": {
+								"hasNextPage": false,
+								"endCursor": "Y3Vyc29yOnYyOpKqQXJ2aW5kT3JnMQU="
+							},
+							"nodes": [
+								{
+									"id": "MDEyOk9yZ2FuaXphdGlvbjk=",
+									"packages": {
+										"pageInfo": {
+											"hasNextPage": true,
+											"endCursor": "Y3Vyc29yOnYyOpHOAAhUkQ=="
+										},
+										"nodes": [
+											{
+												"id": "MDc6UGFja2FnZTE=",
+												"versions": {
+													"pageInfo": {
+														"hasNextPage": false,
+														"endCursor": "Y3Vyc29yOnYyOpHOAAhUkg=="
+													},
+													"nodes": [
+														{
+															"id": "MDE0OlBhY2thZ2VWZXJzaW9uMQ==",
+															"version": "1.0.0",
+															"preRelease": false
+														}
+													]
+												}
+											}
+										]
+									}
+								}
+							]
+						}
+					}
+				}
+			}`),
+			entityExternalID: "PackageVersion",
+			wantObjects: []map[string]any{
+				{
+					"id":           "MDE0OlBhY2thZ2VWZXJzaW9uMQ==",
+					"version":      "1.0.0",
+					"preRelease":   false,
+					"enterpriseId": "MDEwOkVudGVycHJpc2Ux",
+					"packageId":    "MDc6UGFja2FnZTE=",
+				},
+			},
+			wantNextCursor: CreateGraphQLCompositeCursor(
+				[]*string{nil, testutil.GenPtr("Y3Vyc29yOnYyOpHOAAhUkQ==")},
+				nil,
+				nil,
+			),
+		},
 		"invalid_object_structure": {
 			body: []byte(`{
 				"enterprise": {
@@ -6113,3 +6172,52 @@ func TestGetOrganizationUserPage_WithOrganizations(t *testing.T) {
 		})
 	}
 }
+
+func TestGetValueFromPath(t *testing.T) {
+	tests := map[string]struct {
+		object    map[string]any
+		path      []string
+		wantValue string
+		wantErr   bool
+	}{
+		"string": {
+			object:    map[string]any{"node": map[string]any{"id": "MDQ6VXNlcjQ="}},
+			path:      []string{"node", "id"},
+			wantValue: "MDQ6VXNlcjQ=",
+		},
+		"numeric_id": {
+			object:    map[string]any{"databaseId": float64(123456789)},
+			path:      []string{"databaseId"},
+			wantValue: "123456789",
+		},
+		"missing_key": {
+			object:  map[string]any{"node": map[string]any{}},
+			path:    []string{"node", "id"},
+			wantErr: true,
+		},
+		"not_an_object": {
+			object:  map[string]any{"node": "MDQ6VXNlcjQ="},
+			path:    []string{"node", "id"},
+			wantErr: true,
+		},
+		"invalid_type": {
+			object:  map[string]any{"id": true},
+			path:    []string{"id"},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotValue, gotErr := github.GetValueFromPath(tt.object, tt.path)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if gotValue != tt.wantValue {
+				t.Errorf("gotValue: %v, wantValue: %v", gotValue, tt.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package github_test
+
+import (
+	"encoding/base64"
+	"slices"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/github"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// FuzzDecodePageInfo checks that the nested cursors of the GraphQL entities are decoded without panicking,
+// however deeply they are nested.
+func FuzzDecodePageInfo(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		base64.StdEncoding.EncodeToString([]byte(`{"hasNextPage":true,"endCursor":"Y3Vyc29yOnYyOpEB","organizationOffset":1}`)),
+		base64.StdEncoding.EncodeToString([]byte(`{"hasNextPage":true,"endCursor":"a","InnerPageInfo":{"hasNextPage":true,"endCursor":"b","InnerPageInfo":{"endCursor":null}}}`)),
+		base64.StdEncoding.EncodeToString([]byte(`{"InnerPageInfo":null}`)),
+		base64.StdEncoding.EncodeToString([]byte(`{"organizationOffset":-1}`)),
+		base64.StdEncoding.EncodeToString([]byte(`null`)),
+		"not base64",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, cursor string) {
+		pageInfo, err := github.DecodePageInfo(&cursor, github.User)
+		if err != nil {
+			if err.Message == "" {
+				t.Errorf("got an error without a message for cursor %q", cursor)
+			}
+
+			return
+		}
+
+		for n := -1; n < 5; n++ {
+			github.GetPageInfoAfter(pageInfo, n, nil)
+		}
+
+		github.UpdatePageInfo(pageInfo, pageInfo)
+	})
+}
+
+// FuzzParseGraphQLResponse checks that malformed GraphQL responses, and malformed cursors of the current page,
+// are rejected with an error instead of a panic.
+func FuzzParseGraphQLResponse(f *testing.F) {
+	entityExternalIDs := make([]string, 0, len(github.ValidEntityExternalIDs))
+	for entityExternalID := range github.ValidEntityExternalIDs {
+		entityExternalIDs = append(entityExternalIDs, entityExternalID)
+	}
+
+	slices.Sort(entityExternalIDs)
+
+	for _, seed := range []string{
+		`{"data":{"enterprise":{"id":"MDEwOkVudGVycHJpc2Ux","organizations":{"pageInfo":{"hasNextPage":true,"endCursor":"Y3Vyc29yOnYyOpKqQXJ2aW5kT3JnMgo="},"nodes":[{"id":"MDEyOk9yZ2FuaXphdGlvbjk=","login":"ArvindOrg2"}]}}}}`,
+		`{"data":{"enterprise":{"id":"MDEwOkVudGVycHJpc2Ux","organizations":{"pageInfo":{"endCursor":"Y3Vyc29yOnYyOpKqQXJ2aW5kT3JnMQU=","hasNextPage":true},"nodes":[{"id":"MDEyOk9yZ2FuaXphdGlvbjU=","repositories":{"pageInfo":{"endCursor":"Y3Vyc29yOnYyOpEB","hasNextPage":true},"nodes":[{"id":"MDEwOlJlcG9zaXRvcnkx"}]}}]}}}}`,
+		`{"data":{"organization":{"id":"MDEyOk9yZ2FuaXphdGlvbjU=","repositories":{"pageInfo":{"endCursor":null,"hasNextPage":false},"nodes":[]}}}}`,
+		`{"errors":[{"type":"NOT_FOUND","message":"Could not resolve to an Enterprise with the slug of 'SGNL'."}]}`,
+		`{"data":null}`,
+		`{"data":{"enterprise":null}}`,
+		`{"data":{"enterprise":{"organizations":{"nodes":[null]}}}}`,
+		`{"data":`,
+	} {
+		f.Add([]byte(seed), uint8(0), uint8(0), "")
+	}
+
+	f.Add([]byte(`{"data":{"enterprise":{"organizations":{"nodes":[]}}}}`), uint8(1), uint8(2),
+		base64.StdEncoding.EncodeToString([]byte(`{"hasNextPage":true,"endCursor":"a","organizationOffset":1,"InnerPageInfo":{"endCursor":"b"}}`)))
+
+	f.Fuzz(func(t *testing.T, body []byte, entityIndex uint8, orgCount uint8, cursor string) {
+		entityExternalID := entityExternalIDs[int(entityIndex)%len(entityExternalIDs)]
+
+		var currentCursor *pagination.CompositeCursor[string]
+		if cursor != "" {
+			currentCursor = &pagination.CompositeCursor[string]{Cursor: &cursor}
+		}
+
+		_, _, err := github.ParseGraphQLResponse(body, entityExternalID, currentCursor, int(orgCount))
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for entity %s and body %q", entityExternalID, body)
+		}
+	})
+}
+
+// FuzzParseRESTResponse checks that malformed REST responses, and malformed link headers, are rejected with an
+// error instead of a panic.
+func FuzzParseRESTResponse(f *testing.F) {
+	for _, seed := range []string{
+		`[{"number":1,"state":"open","url":"https://api.github.com/repos/octocat/hello-world/secret-scanning/alerts/1"}]`,
+		`{"message":"Not Found","documentation_url":"https://docs.github.com/rest"}`,
+		`[]`,
+		`null`,
+		`[{"`,
+	} {
+		f.Add([]byte(seed), `<https://api.github.com/organizations/1/secret-scanning/alerts?per_page=1&page=2>; rel="next"`, uint8(0), uint8(0))
+	}
+
+	f.Add([]byte(`[]`), `<>; rel="next", <https://api.github.com/x?page=1>; rel="first"`, uint8(1), uint8(2))
+
+	f.Fuzz(func(t *testing.T, body []byte, link string, orgOffset uint8, orgCount uint8) {
+		_, _, err := github.ParseRESTResponse(body, []string{link}, int(orgOffset), int(orgCount))
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q and link %q", body, link)
+		}
+	})
+}
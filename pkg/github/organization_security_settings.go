@@ -0,0 +1,174 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"go.uber.org/zap"
+)
+
+// DefaultRepositoryPermission is the attribute of the OrganizationSecuritySettings entity set to the base
+// permission of the members of an organization on its repositories, i.e. "read", "write", "admin" or "none".
+// The GraphQL API doesn't expose it, so it is retrieved from the REST endpoint of each organization.
+const DefaultRepositoryPermission = "defaultRepositoryPermission"
+
+// organizationResponse is the subset of the REST representation of an organization used by the adapter.
+// https://docs.github.com/en/rest/orgs/orgs#get-an-organization
+type organizationResponse struct {
+	// DefaultRepositoryPermission is only returned to the owners of the organization.
+	DefaultRepositoryPermission *string `json:"default_repository_permission"`
+}
+
+// requestsAttribute returns whether an attribute of the entity is requested.
+func requestsAttribute(entityConfig *framework.EntityConfig, externalID string) bool {
+	if entityConfig == nil {
+		return false
+	}
+
+	for _, attribute := range entityConfig.Attributes {
+		if attribute.ExternalId == externalID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// injectDefaultRepositoryPermissions sets the DefaultRepositoryPermission attribute of each organization of a page
+// of the OrganizationSecuritySettings entity, retrieved with one REST request per organization. The attribute is
+// left unset if the token isn't authorized to read the permission, i.e. if it isn't an organization owner's.
+// If a request is not successful, the response to return for the page is returned instead.
+func (d *Datasource) injectDefaultRepositoryPermissions(
+	ctx context.Context,
+	request *Request,
+	objects []map[string]any,
+) (*Response, *framework.Error) {
+	deploymentInfo := EndpointMappings[EnterpriseServer]
+	if request.IsEnterpriseCloud {
+		deploymentInfo = EndpointMappings[EnterpriseCloud]
+	}
+
+	baseURI := deploymentInfo.RESTBasePath
+	if !request.IsEnterpriseCloud {
+		if request.APIVersion == nil {
+			return nil, &framework.Error{
+				Message: "APIVersion is not set for an entity that is retrieved through the GitHub REST API.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+
+		baseURI = fmt.Sprintf(baseURI, *request.APIVersion)
+	}
+
+	for _, object := range objects {
+		login, ok := object["login"].(string)
+		if !ok || login == "" {
+			return nil, &framework.Error{
+				Message: "Failed to retrieve the default repository permission of an organization: login is missing.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		endpoint := request.BaseURL + baseURI + fmt.Sprintf(
+			deploymentInfo.RESTEndpoints[OrganizationSecuritySettings]["organization"], url.PathEscape(login),
+		)
+
+		organization, errResponse, err := d.getOrganization(ctx, request, endpoint)
+		if err != nil || errResponse != nil {
+			return errResponse, err
+		}
+
+		if organization.DefaultRepositoryPermission != nil {
+			object[DefaultRepositoryPermission] = *organization.DefaultRepositoryPermission
+		}
+	}
+
+	return nil, nil
+}
+
+// getOrganization requests the REST representation of an organization. If the request is not successful,
+// the response to return for the page is returned instead.
+func (d *Datasource) getOrganization(
+	ctx context.Context,
+	request *Request,
+	endpoint string,
+) (*organizationResponse, *Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	// Timeout API calls that take longer than the configured timeout.
+	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(apiCtx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	req.Header.Add("Authorization", request.Token)
+
+	logger.Info("Sending request to datasource", fields.RequestURL(endpoint))
+
+	res, err := d.Client.Do(req)
+	if err != nil {
+		logger.Error("Request to datasource failed",
+			fields.RequestURL(endpoint),
+			fields.SGNLEventTypeError(),
+			zap.Error(err),
+		)
+
+		return nil, nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to execute GitHub request: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
+		)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		response := &Response{
+			StatusCode:       res.StatusCode,
+			RetryAfterHeader: res.Header.Get("Retry-After"),
+		}
+
+		logger.Error("Datasource responded with an error",
+			fields.RequestURL(endpoint),
+			fields.ResponseStatusCode(res.StatusCode),
+			fields.ResponseRetryAfterHeader(response.RetryAfterHeader),
+			fields.ResponseBody(res.Body),
+			fields.SGNLEventTypeError(),
+		)
+
+		return nil, response, nil
+	}
+
+	var organization organizationResponse
+
+	if err := json.NewDecoder(res.Body).Decode(&organization); err != nil {
+		return nil, nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return &organization, nil, nil
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package github_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/github"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+var organizationSecuritySettingsHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	query := string(body)
+
+	switch {
+	case r.URL.Path == "/api/graphql" && strings.Contains(query, `enterprise (slug: \"SGNL\")`):
+		w.Write([]byte(`{
+			"data": {
+				"enterprise": {
+					"id": "E_1",
+					"organizations": {
+						"pageInfo": {
+							"hasNextPage": false,
+							"endCursor": "Y3Vyc29yOnYyOpHOAAAAAg=="
+						},
+						"nodes": [
+							{
+								"id": "O_1",
+								"login": "org1",
+								"requiresTwoFactorAuthentication": true,
+								"ipAllowListEnabledSetting": "ENABLED",
+								"ipAllowListEntries": {
+									"nodes": [
+										{
+											"id": "IALE_1",
+											"allowListValue": "192.0.2.0/24",
+											"isActive": true
+										}
+									]
+								}
+							},
+							{
+								"id": "O_2",
+								"login": "org2",
+								"requiresTwoFactorAuthentication": false,
+								"ipAllowListEnabledSetting": "DISABLED",
+								"ipAllowListEntries": {
+									"nodes": []
+								}
+							}
+						]
+					}
+				}
+			}
+		}`))
+	case r.URL.Path == "/graphql" && strings.Contains(query, `organization (login: \"org1\")`):
+		w.Write([]byte(`{
+			"data": {
+				"organization": {
+					"id": "O_1",
+					"login": "org1",
+					"requiresTwoFactorAuthentication": true
+				}
+			}
+		}`))
+	case r.URL.Path == "/api/graphql" && strings.Contains(query, `organization (login: \"forbidden\")`):
+		w.Write([]byte(`{
+			"data": {
+				"organization": {
+					"id": "O_3",
+					"login": "forbidden",
+					"requiresTwoFactorAuthentication": false
+				}
+			}
+		}`))
+	case r.URL.Path == "/api/v3/orgs/org1":
+		w.Write([]byte(`{"id": 1, "login": "org1", "two_factor_requirement_enabled": true, "default_repository_permission": "read"}`))
+	case r.URL.Path == "/api/v3/orgs/org2":
+		// The default repository permission is only returned to the owners of the organization.
+		w.Write([]byte(`{"id": 2, "login": "org2"}`))
+	case r.URL.Path == "/orgs/org1":
+		w.Write([]byte(`{"id": 1, "login": "org1", "default_repository_permission": "write"}`))
+	case r.URL.Path == "/api/v3/orgs/forbidden":
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestGetOrganizationSecuritySettingsPage(t *testing.T) {
+	githubClient := github.NewClient(&http.Client{
+		Timeout: time.Duration(10) * time.Second,
+	})
+	server := httptest.NewServer(organizationSecuritySettingsHandler)
+
+	entityConfig := func(attributes ...string) *framework.EntityConfig {
+		config := &framework.EntityConfig{
+			ExternalId: github.OrganizationSecuritySettings,
+		}
+
+		for _, attribute := range attributes {
+			config.Attributes = append(config.Attributes, &framework.AttributeConfig{
+				ExternalId: attribute,
+				Type:       framework.AttributeTypeString,
+			})
+		}
+
+		return config
+	}
+
+	tests := map[string]struct {
+		request *github.Request
+		wantRes *github.Response
+		wantErr *framework.Error
+	}{
+		"enterprise": {
+			request: &github.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer Testtoken",
+				PageSize:              2,
+				EntityExternalID:      github.OrganizationSecuritySettings,
+				EnterpriseSlug:        testutil.GenPtr("SGNL"),
+				APIVersion:            testutil.GenPtr("v3"),
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          entityConfig("id", "requiresTwoFactorAuthentication", "defaultRepositoryPermission"),
+			},
+			wantRes: &github.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"id":                              "O_1",
+						"enterpriseId":                    "E_1",
+						"login":                           "org1",
+						"requiresTwoFactorAuthentication": true,
+						"ipAllowListEnabledSetting":       "ENABLED",
+						"ipAllowListEntries": map[string]any{
+							"nodes": []any{
+								map[string]any{
+									"id":             "IALE_1",
+									"allowListValue": "192.0.2.0/24",
+									"isActive":       true,
+								},
+							},
+						},
+						"defaultRepositoryPermission": "read",
+					},
+					{
+						"id":                              "O_2",
+						"enterpriseId":                    "E_1",
+						"login":                           "org2",
+						"requiresTwoFactorAuthentication": false,
+						"ipAllowListEnabledSetting":       "DISABLED",
+						"ipAllowListEntries": map[string]any{
+							"nodes": []any{},
+						},
+					},
+				},
+			},
+		},
+		"organizations_enterprise_cloud": {
+			request: &github.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer Testtoken",
+				PageSize:              2,
+				EntityExternalID:      github.OrganizationSecuritySettings,
+				Organizations:         []string{"org1"},
+				IsEnterpriseCloud:     true,
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          entityConfig("id", "requiresTwoFactorAuthentication", "defaultRepositoryPermission"),
+			},
+			wantRes: &github.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"id":                              "O_1",
+						"login":                           "org1",
+						"requiresTwoFactorAuthentication": true,
+						"defaultRepositoryPermission":     "write",
+					},
+				},
+			},
+		},
+		"default_repository_permission_forbidden": {
+			request: &github.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer Testtoken",
+				PageSize:              2,
+				EntityExternalID:      github.OrganizationSecuritySettings,
+				Organizations:         []string{"forbidden"},
+				APIVersion:            testutil.GenPtr("v3"),
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          entityConfig("id", "defaultRepositoryPermission"),
+			},
+			wantRes: &github.Response{
+				StatusCode: http.StatusForbidden,
+			},
+		},
+		"default_repository_permission_not_requested": {
+			request: &github.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer Testtoken",
+				PageSize:              2,
+				EntityExternalID:      github.OrganizationSecuritySettings,
+				Organizations:         []string{"forbidden"},
+				APIVersion:            testutil.GenPtr("v3"),
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          entityConfig("id", "requiresTwoFactorAuthentication"),
+			},
+			wantRes: &github.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"id":                              "O_3",
+						"login":                           "forbidden",
+						"requiresTwoFactorAuthentication": false,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := githubClient.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(tt.wantErr, gotErr); diff != "" {
+				t.Errorf("Error mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantRes, gotRes); diff != "" {
+				t.Errorf("Response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -11,23 +11,40 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
 )
 
 const (
 	CollectionPageSize = 1
 )
 
+// pageInfoShape is the expected JSON shape of the PageInfo encoded in a cursor, nested once per layer of the query.
+const pageInfoShape = `{"hasNextPage":<bool>,"endCursor":<string>,"organizationOffset":<int>,` +
+	`"InnerPageInfo":<PageInfo|null>}`
+
 // These are a list of attributes that will be added when post-processing the SoR response
 // ex. InjectCommonFields() in datasource.go.
 // These should be ignored when building the query.
 var attributesToIgnore = map[string]struct{}{
-	"enterpriseId":  {},
-	"issueId":       {},
-	"labelId":       {},
-	"orgId":         {},
-	"pullRequestId": {},
-	"repositoryId":  {},
-	"uniqueId":      {},
+	"defaultRepositoryPermission": {},
+	"enterpriseId":                {},
+	"issueId":                     {},
+	"labelId":                     {},
+	"orgId":                       {},
+	"packageId":                   {},
+	"pullRequestId":               {},
+	"repositoryId":                {},
+	"uniqueId":                    {},
+	"userId":                      {},
+}
+
+// childEntityArguments are the arguments of the connections of child entities which GitHub requires, e.g. the
+// number of nodes to return. Child entities are not paginated, so the maximum number of nodes is requested.
+var childEntityArguments = map[string]string{
+	// A repository can have at most 20 topics.
+	RepositoryTopic: "first: 20",
+	// Only the first 100 entries of the IP allow list of an organization are returned.
+	IPAllowListEntry: "first: 100",
 }
 
 // GraphQLPayload is used as a wrapper to construct the query.
@@ -72,6 +89,11 @@ type UserQueryBuilder struct {
 	UserAfter *string
 }
 
+type GistQueryBuilder struct {
+	UserQueryBuilder
+	GistAfter *string
+}
+
 type TeamQueryBuilder struct {
 	OrganizationQueryBuilder
 	TeamAfter *string
@@ -82,6 +104,16 @@ type RepositoryQueryBuilder struct {
 	RepoAfter *string
 }
 
+type PackageQueryBuilder struct {
+	OrganizationQueryBuilder
+	PackageAfter *string
+}
+
+type PackageVersionQueryBuilder struct {
+	PackageQueryBuilder
+	VersionAfter *string
+}
+
 type CollaboratorQueryBuilder struct {
 	RepositoryQueryBuilder
 	CollabAfter *string
@@ -159,7 +191,7 @@ func (b *OrganizationQueryBuilder) Build(request *Request) (string, *framework.E
 	if request.EnterpriseSlug != nil {
 		orgAfterQuery := SetAfterParameter(b.OrgAfter)
 
-		innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+		innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 		if err != nil {
 			return "", err
 		}
@@ -179,7 +211,7 @@ func (b *OrganizationQueryBuilder) Build(request *Request) (string, *framework.E
 	}
 
 	// Return just the attributes of the organization.
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "")
 	if err != nil {
 		return "", err
 	}
@@ -220,7 +252,7 @@ func (b *OrganizationQueryBuilder) Build(request *Request) (string, *framework.E
 func (b *OrganizationUserQueryBuilder) Build(request *Request) (string, *framework.Error) {
 	userAfterQuery := SetAfterParameter(b.UserAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, &b.OrgLogin, "edges")
+	innerNode, err := EntityAttributeQueryBuilder(request, &b.OrgLogin, "edges")
 	if err != nil {
 		return "", err
 	}
@@ -245,7 +277,7 @@ func (b *UserQueryBuilder) Build(request *Request) (string, *framework.Error) {
 	orgAfterQuery := SetAfterParameter(b.OrgAfter)
 	userAfterQuery := SetAfterParameter(b.UserAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -292,11 +324,88 @@ func (b *UserQueryBuilder) Build(request *Request) (string, *framework.Error) {
 	), nil
 }
 
+// Build returns the query of the gists of the members of the organizations. The gists of the other users are only
+// returned if they are public, regardless of the privacy argument.
+func (b *GistQueryBuilder) Build(request *Request) (string, *framework.Error) {
+	orgAfterQuery := SetAfterParameter(b.OrgAfter)
+	userAfterQuery := SetAfterParameter(b.UserAfter)
+	gistAfterQuery := SetAfterParameter(b.GistAfter)
+
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
+	if err != nil {
+		return "", err
+	}
+
+	if request.EnterpriseSlug != nil {
+		return fmt.Sprintf(`query {
+			enterprise (slug: "%s") {
+				id
+				organizations (first: %d%s) {
+					pageInfo {
+						endCursor
+						hasNextPage
+					}
+					nodes {
+						id
+						membersWithRole (first: %d%s) {
+							pageInfo {
+								endCursor
+								hasNextPage
+							}
+							nodes {
+								id
+								gists (first: %d, privacy: ALL%s) {
+									pageInfo {
+										endCursor
+										hasNextPage
+									}
+									%s
+								}
+							}
+						}
+					}
+				}
+			}
+		}`, b.EnterpriseQueryInfo.EnterpriseSlug, CollectionPageSize, orgAfterQuery, CollectionPageSize,
+			userAfterQuery, b.EnterpriseQueryInfo.PageSize, gistAfterQuery, innerNode.BuildQuery()), nil
+	}
+
+	OrganizationName := request.Organizations[b.OrganizationOffset]
+
+	query := fmt.Sprintf(`query {
+		organization (login: "%s") {
+			id
+			membersWithRole (first: %d%s) {
+				pageInfo {
+					endCursor
+					hasNextPage
+				}
+				nodes {
+					id
+					gists (first: %d, privacy: ALL%s) {
+						pageInfo {
+							endCursor
+							hasNextPage
+						}
+						%s
+					}
+				}
+			}
+		}
+    }`,
+		OrganizationName,
+		CollectionPageSize, userAfterQuery,
+		b.EnterpriseQueryInfo.PageSize, gistAfterQuery,
+		innerNode.BuildQuery())
+
+	return query, nil
+}
+
 func (b *TeamQueryBuilder) Build(request *Request) (string, *framework.Error) {
 	orgAfterQuery := SetAfterParameter(b.OrgAfter)
 	teamAfterQuery := SetAfterParameter(b.TeamAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -343,11 +452,137 @@ func (b *TeamQueryBuilder) Build(request *Request) (string, *framework.Error) {
 	), nil
 }
 
+func (b *PackageQueryBuilder) Build(request *Request) (string, *framework.Error) {
+	orgAfterQuery := SetAfterParameter(b.OrgAfter)
+	packageAfterQuery := SetAfterParameter(b.PackageAfter)
+
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
+	if err != nil {
+		return "", err
+	}
+
+	if request.EnterpriseSlug != nil {
+		return fmt.Sprintf(`query {
+			enterprise (slug: "%s") {
+				id
+				organizations (first: %d%s) {
+					pageInfo {
+						endCursor
+						hasNextPage
+					}
+					nodes {
+						id
+						packages (first: %d%s) {
+							pageInfo {
+								endCursor
+								hasNextPage
+							}
+							%s
+						}
+					}
+				}
+			}
+		}`, b.EnterpriseQueryInfo.EnterpriseSlug, CollectionPageSize, orgAfterQuery, b.EnterpriseQueryInfo.PageSize,
+			packageAfterQuery, innerNode.BuildQuery()), nil
+	}
+
+	OrganizationName := request.Organizations[b.OrganizationOffset]
+
+	return fmt.Sprintf(`query {
+		organization (login: "%s") {
+				id
+				packages (first: %d%s) {
+					pageInfo {
+						endCursor
+						hasNextPage
+					}
+					%s
+				}
+			}
+		}`, OrganizationName, b.EnterpriseQueryInfo.PageSize, packageAfterQuery, innerNode.BuildQuery(),
+	), nil
+}
+
+func (b *PackageVersionQueryBuilder) Build(request *Request) (string, *framework.Error) {
+	orgAfterQuery := SetAfterParameter(b.OrgAfter)
+	packageAfterQuery := SetAfterParameter(b.PackageAfter)
+	versionAfterQuery := SetAfterParameter(b.VersionAfter)
+
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
+	if err != nil {
+		return "", err
+	}
+
+	if request.EnterpriseSlug != nil {
+		return fmt.Sprintf(`query {
+			enterprise (slug: "%s") {
+				id
+				organizations (first: %d%s) {
+					pageInfo {
+						endCursor
+						hasNextPage
+					}
+					nodes {
+						id
+						packages (first: %d%s) {
+							pageInfo {
+								endCursor
+								hasNextPage
+							}
+							nodes {
+								id
+								versions (first: %d%s) {
+									pageInfo {
+										endCursor
+										hasNextPage
+									}
+									%s
+								}
+							}
+						}
+					}
+				}
+			}
+		}`, b.EnterpriseQueryInfo.EnterpriseSlug, CollectionPageSize, orgAfterQuery, CollectionPageSize,
+			packageAfterQuery, b.EnterpriseQueryInfo.PageSize, versionAfterQuery, innerNode.BuildQuery()), nil
+	}
+
+	OrganizationName := request.Organizations[b.OrganizationOffset]
+
+	query := fmt.Sprintf(`query {
+		organization (login: "%s") {
+			id
+			packages (first: %d%s) {
+				pageInfo {
+					endCursor
+					hasNextPage
+				}
+				nodes {
+					id
+					versions (first: %d%s) {
+						pageInfo {
+							endCursor
+							hasNextPage
+						}
+						%s
+					}
+				}
+			}
+		}
+    }`,
+		OrganizationName,
+		CollectionPageSize, packageAfterQuery,
+		b.EnterpriseQueryInfo.PageSize, versionAfterQuery,
+		innerNode.BuildQuery())
+
+	return query, nil
+}
+
 func (b *RepositoryQueryBuilder) Build(request *Request) (string, *framework.Error) {
 	orgAfterQuery := SetAfterParameter(b.OrgAfter)
 	repoAfterQuery := SetAfterParameter(b.RepoAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -402,7 +637,7 @@ func (b *CollaboratorQueryBuilder) Build(request *Request) (string, *framework.E
 	repoAfterQuery := SetAfterParameter(b.RepoAfter)
 	collabAfterQuery := SetAfterParameter(b.CollabAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -477,7 +712,7 @@ func (b *LabelQueryBuilder) Build(request *Request) (string, *framework.Error) {
 	repoAfterQuery := SetAfterParameter(b.RepoAfter)
 	labelAfterQuery := SetAfterParameter(b.LabelAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -552,7 +787,7 @@ func (b *IssueQueryBuilder) Build(request *Request) (string, *framework.Error) {
 	repoAfterQuery := SetAfterParameter(b.RepoAfter)
 	issueAfterQuery := SetAfterParameter(b.IssueAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -628,7 +863,7 @@ func (b *IssueLabelQueryBuilder) Build(request *Request) (string, *framework.Err
 	labelAfterQuery := SetAfterParameter(b.LabelAfter)
 	issueAfterQuery := SetAfterParameter(b.IssueAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -724,7 +959,7 @@ func (b *PullRequestLabelQueryBuilder) Build(request *Request) (string, *framewo
 	labelAfterQuery := SetAfterParameter(b.LabelAfter)
 	pullRequestAfterQuery := SetAfterParameter(b.PullRequestAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -816,7 +1051,7 @@ func (b *IssueAssigneeQueryBuilder) Build(request *Request) (string, *framework.
 	issueAfterQuery := SetAfterParameter(b.IssueAfter)
 	assigneeAfterQuery := SetAfterParameter(b.AssigneeAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -912,7 +1147,7 @@ func (b *IssueParticipantQueryBuilder) Build(request *Request) (string, *framewo
 	issueAfterQuery := SetAfterParameter(b.IssueAfter)
 	participantAfterQuery := SetAfterParameter(b.ParticipantAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -1007,7 +1242,7 @@ func (b *PullRequestQueryBuilder) Build(request *Request) (string, *framework.Er
 	repoAfterQuery := SetAfterParameter(b.RepoAfter)
 	pullRequestAfterQuery := SetAfterParameter(b.PullRequestAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -1083,7 +1318,7 @@ func (b *PullRequestChangedFileQueryBuilder) Build(request *Request) (string, *f
 	pullRequestAfterQuery := SetAfterParameter(b.PullRequestAfter)
 	changedFileAfterQuery := SetAfterParameter(b.ChangedFileAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -1179,7 +1414,7 @@ func (b *PullRequestAssigneeQueryBuilder) Build(request *Request) (string, *fram
 	pullRequestAfterQuery := SetAfterParameter(b.PullRequestAfter)
 	assigneeAfterQuery := SetAfterParameter(b.AssigneeAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -1275,7 +1510,7 @@ func (b *PullRequestParticipantQueryBuilder) Build(request *Request) (string, *f
 	pullRequestAfterQuery := SetAfterParameter(b.PullRequestAfter)
 	participantAfterQuery := SetAfterParameter(b.ParticipantAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -1371,7 +1606,7 @@ func (b *PullRequestCommitQueryBuilder) Build(request *Request) (string, *framew
 	pullRequestAfterQuery := SetAfterParameter(b.PullRequestAfter)
 	commitAfterQuery := SetAfterParameter(b.CommitAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -1467,7 +1702,7 @@ func (b *PullRequestReviewQueryBuilder) Build(request *Request) (string, *framew
 	pullRequestAfterQuery := SetAfterParameter(b.PullRequestAfter)
 	reviewAfterQuery := SetAfterParameter(b.ReviewAfter)
 
-	innerNode, err := AttributeQueryBuilder(request.EntityConfig, nil, "nodes")
+	innerNode, err := EntityAttributeQueryBuilder(request, nil, "nodes")
 	if err != nil {
 		return "", err
 	}
@@ -1635,12 +1870,46 @@ func AttributeQueryBuilder(
 			return nil, err
 		}
 
+		if args, found := childEntityArguments[child.ExternalId]; found {
+			childNode.Name = fmt.Sprintf("%s (%s)", childNode.Name, args)
+		}
+
 		baseNode.Children[childParts[0]] = childNode
 	}
 
 	return rootNode, nil
 }
 
+// EntityAttributeQueryBuilder builds the attribute node of the requested entity, including the additional
+// fields of the request.
+func EntityAttributeQueryBuilder(
+	request *Request,
+	login *string,
+	rootName string,
+) (*AttributeNode, *framework.Error) {
+	node, err := AttributeQueryBuilder(request.EntityConfig, login, rootName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range request.AdditionalFields {
+		name, _ := additionalFieldName(field)
+
+		// The selection replaces the node built from the attributes for the same field, if any,
+		// as it may contain arguments required by the field, e.g. `first` for connections.
+		node.Children[name] = &AttributeNode{
+			Name: strings.TrimSpace(field),
+		}
+	}
+
+	// The login of the organizations is required to retrieve their default repository permission.
+	if request.EntityExternalID == OrganizationSecuritySettings {
+		node.AddChild([]string{"login"})
+	}
+
+	return node, nil
+}
+
 func (node *AttributeNode) BuildQuery() string {
 	if len(node.Children) == 0 {
 		return node.Name
@@ -1700,7 +1969,7 @@ func ConstructQuery(request *Request) (string, *framework.Error) {
 	if request.Cursor != nil && request.Cursor.Cursor != nil {
 		var err *framework.Error
 
-		pageInfo, err = DecodePageInfo(request.Cursor.Cursor)
+		pageInfo, err = DecodePageInfo(request.Cursor.Cursor, request.EntityExternalID)
 
 		if err != nil {
 			return "", err
@@ -1807,7 +2076,7 @@ func ConstructQueryBuilder(request *Request, pageInfo *PageInfo) (QueryBuilder,
 		}
 
 		switch request.EntityExternalID {
-		case Organization:
+		case Organization, OrganizationSecuritySettings:
 			builder = &orgQueryBuilder
 		case Team:
 			builder = &TeamQueryBuilder{
@@ -1819,11 +2088,36 @@ func ConstructQueryBuilder(request *Request, pageInfo *PageInfo) (QueryBuilder,
 				OrganizationQueryBuilder: orgQueryBuilder,
 				RepoAfter:                GetPageInfoAfter(pageInfo, 1, &orgListProvided),
 			}
-		case User:
-			builder = &UserQueryBuilder{
+		case User, Gist:
+			userQueryBuilder := UserQueryBuilder{
 				OrganizationQueryBuilder: orgQueryBuilder,
 				UserAfter:                GetPageInfoAfter(pageInfo, 1, &orgListProvided),
 			}
+
+			switch request.EntityExternalID {
+			case User:
+				builder = &userQueryBuilder
+			case Gist:
+				builder = &GistQueryBuilder{
+					UserQueryBuilder: userQueryBuilder,
+					GistAfter:        GetPageInfoAfter(pageInfo, 2, &orgListProvided),
+				}
+			}
+		case Package, PackageVersion:
+			packageQueryBuilder := PackageQueryBuilder{
+				OrganizationQueryBuilder: orgQueryBuilder,
+				PackageAfter:             GetPageInfoAfter(pageInfo, 1, &orgListProvided),
+			}
+
+			switch request.EntityExternalID {
+			case Package:
+				builder = &packageQueryBuilder
+			case PackageVersion:
+				builder = &PackageVersionQueryBuilder{
+					PackageQueryBuilder: packageQueryBuilder,
+					VersionAfter:        GetPageInfoAfter(pageInfo, 2, &orgListProvided),
+				}
+			}
 		default:
 			repoQueryBuilder := RepositoryQueryBuilder{
 				OrganizationQueryBuilder: orgQueryBuilder,
@@ -1919,23 +2213,22 @@ func ConstructQueryBuilder(request *Request, pageInfo *PageInfo) (QueryBuilder,
 	return builder, nil
 }
 
-func DecodePageInfo(cursor *string) (*PageInfo, *framework.Error) {
+// DecodePageInfo decodes the b64 encoded PageInfo of the cursor of a request for the entity.
+func DecodePageInfo(cursor *string, entityExternalID string) (*PageInfo, *framework.Error) {
 	b, err := base64.StdEncoding.DecodeString(*cursor)
 	if err != nil {
-		return nil, &framework.Error{
-			Message: "Cursor.Cursor base64 decoding failed.",
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
+		return nil, pagination.NewCursorError(
+			entityExternalID, pageInfoShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
 	}
 
 	var pageInfo PageInfo
 
 	err = json.Unmarshal(b, &pageInfo)
 	if err != nil {
-		return nil, &framework.Error{
-			Message: "PageInfo unmarshalling failed.",
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
+		return nil, pagination.NewCursorError(
+			entityExternalID, pageInfoShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
 	}
 
 	return &pageInfo, nil
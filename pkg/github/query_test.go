@@ -185,6 +185,46 @@ func TestConstructQuery(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
+		"cursor_not_base64": {
+			request: &github.Request{
+				BaseURL:           "https://ghe-test-server",
+				EnterpriseSlug:    testutil.GenPtr("testID"),
+				IsEnterpriseCloud: false,
+				APIVersion:        testutil.GenPtr("v3"),
+				EntityExternalID:  "User",
+				PageSize:          100,
+				Token:             "Bearer Testtoken",
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("BROKEN!"),
+				},
+			},
+			wantError: &framework.Error{
+				Message: "Invalid cursor for entity User: failed to decode base64 cursor: illegal base64 data at input byte 6. " +
+					`Expected cursor shape: {"hasNextPage":<bool>,"endCursor":<string>,"organizationOffset":<int>,"InnerPageInfo":<PageInfo|null>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"cursor_not_page_info": {
+			request: &github.Request{
+				BaseURL:           "https://ghe-test-server",
+				EnterpriseSlug:    testutil.GenPtr("testID"),
+				IsEnterpriseCloud: false,
+				APIVersion:        testutil.GenPtr("v3"),
+				EntityExternalID:  "User",
+				PageSize:          100,
+				Token:             "Bearer Testtoken",
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(base64.StdEncoding.EncodeToString([]byte(`{"hasNextPage":"yes"}`))),
+				},
+			},
+			wantError: &framework.Error{
+				Message: "Invalid cursor for entity User: failed to unmarshal JSON cursor: json: cannot unmarshal string into Go struct field PageInfo.hasNextPage of type bool. " +
+					`Expected cursor shape: {"hasNextPage":<bool>,"endCursor":<string>,"organizationOffset":<int>,"InnerPageInfo":<PageInfo|null>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
 		"user_entity_with_cursor": {
 			request: &github.Request{
 				BaseURL:           "https://ghe-test-server",
@@ -586,6 +626,358 @@ func TestConstructQuery(t *testing.T) {
 				}
 			}`,
 		},
+		"repository_with_additional_fields": {
+			request: &github.Request{
+				BaseURL:           "https://ghe-test-server",
+				IsEnterpriseCloud: false,
+				APIVersion:        testutil.GenPtr("v3"),
+				EntityExternalID:  "Repository",
+				PageSize:          100,
+				Token:             "Bearer Testtoken",
+				Organizations:     []string{"testOrg"},
+				EntityConfig: &framework.EntityConfig{
+					ExternalId: "Repository",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+						{
+							ExternalId: "$.repositoryTopics.nodes.topic.name",
+							Type:       framework.AttributeTypeString,
+							List:       true,
+						},
+					},
+				},
+				AdditionalFields: []string{
+					"repositoryTopics(first: 20) { nodes { topic { name } } }",
+					" licenseInfo { spdxId } ",
+				},
+			},
+			wantQuery: `query {
+				organization (login: "testOrg") {
+					id
+					repositories (first: 100) {
+						pageInfo {
+							endCursor
+							hasNextPage
+						}
+						nodes {
+							id
+							licenseInfo { spdxId }
+							repositoryTopics(first: 20) { nodes { topic { name } } }
+						}
+					}
+				}
+			}`,
+		},
+		"repository_with_topics": {
+			request: &github.Request{
+				BaseURL:           "https://ghe-test-server",
+				IsEnterpriseCloud: false,
+				APIVersion:        testutil.GenPtr("v3"),
+				EntityExternalID:  "Repository",
+				PageSize:          100,
+				Token:             "Bearer Testtoken",
+				Organizations:     []string{"testOrg"},
+				EntityConfig: &framework.EntityConfig{
+					ExternalId: "Repository",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+						{
+							ExternalId: "visibility",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+					},
+					ChildEntities: []*framework.EntityConfig{
+						{
+							ExternalId: "$.repositoryTopics.nodes",
+							Attributes: []*framework.AttributeConfig{
+								{
+									ExternalId: "$.topic.id",
+									Type:       framework.AttributeTypeString,
+									List:       false,
+								},
+								{
+									ExternalId: "$.topic.name",
+									Type:       framework.AttributeTypeString,
+									List:       false,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantQuery: `query {
+				organization (login: "testOrg") {
+					id
+					repositories (first: 100) {
+						pageInfo {
+							endCursor
+							hasNextPage
+						}
+						nodes {
+							id
+							repositoryTopics (first: 20) {
+								nodes {
+									topic {
+										id
+										name
+									}
+								}
+							}
+							visibility
+						}
+					}
+				}
+			}`,
+		},
+		"organization_security_settings": {
+			request: &github.Request{
+				BaseURL:           "https://ghe-test-server",
+				EnterpriseSlug:    testutil.GenPtr("testID"),
+				IsEnterpriseCloud: false,
+				APIVersion:        testutil.GenPtr("v3"),
+				EntityExternalID:  "OrganizationSecuritySettings",
+				PageSize:          100,
+				Token:             "Bearer Testtoken",
+				EntityConfig: &framework.EntityConfig{
+					ExternalId: "OrganizationSecuritySettings",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+						{
+							ExternalId: "requiresTwoFactorAuthentication",
+							Type:       framework.AttributeTypeBool,
+							List:       false,
+						},
+						{
+							ExternalId: "ipAllowListEnabledSetting",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+						{
+							ExternalId: "defaultRepositoryPermission",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+					},
+					ChildEntities: []*framework.EntityConfig{
+						{
+							ExternalId: "$.ipAllowListEntries.nodes",
+							Attributes: []*framework.AttributeConfig{
+								{
+									ExternalId: "id",
+									Type:       framework.AttributeTypeString,
+									List:       false,
+								},
+								{
+									ExternalId: "allowListValue",
+									Type:       framework.AttributeTypeString,
+									List:       false,
+								},
+								{
+									ExternalId: "isActive",
+									Type:       framework.AttributeTypeBool,
+									List:       false,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantQuery: `query {
+				enterprise (slug: "testID") {
+					id
+					organizations (first: 100) {
+						pageInfo {
+							endCursor
+							hasNextPage
+						}
+						nodes {
+							id
+							ipAllowListEnabledSetting
+							ipAllowListEntries (first: 100) {
+								nodes {
+									allowListValue
+									id
+									isActive
+								}
+							}
+							login
+							requiresTwoFactorAuthentication
+						}
+					}
+				}
+			}`,
+		},
+		"organization_security_settings_with_organizations": {
+			request: &github.Request{
+				BaseURL:           "https://ghe-test-server",
+				IsEnterpriseCloud: false,
+				APIVersion:        testutil.GenPtr("v3"),
+				EntityExternalID:  "OrganizationSecuritySettings",
+				PageSize:          100,
+				Token:             "Bearer Testtoken",
+				Organizations:     []string{"testOrg"},
+				EntityConfig: &framework.EntityConfig{
+					ExternalId: "OrganizationSecuritySettings",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+						{
+							ExternalId: "requiresTwoFactorAuthentication",
+							Type:       framework.AttributeTypeBool,
+							List:       false,
+						},
+					},
+				},
+			},
+			wantQuery: `query {
+				organization (login: "testOrg") {
+					id
+					login
+					requiresTwoFactorAuthentication
+				}
+			}`,
+		},
+		"gist_with_organizations": {
+			request: &github.Request{
+				BaseURL:           "https://ghe-test-server",
+				IsEnterpriseCloud: false,
+				APIVersion:        testutil.GenPtr("v3"),
+				EntityExternalID:  "Gist",
+				PageSize:          100,
+				Token:             "Bearer Testtoken",
+				Organizations:     []string{"testOrg"},
+				EntityConfig: &framework.EntityConfig{
+					ExternalId: "Gist",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+						{
+							ExternalId: "userId",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+						{
+							ExternalId: "isPublic",
+							Type:       framework.AttributeTypeBool,
+							List:       false,
+						},
+						{
+							ExternalId: "url",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+					},
+				},
+			},
+			wantQuery: `query {
+				organization (login: "testOrg") {
+					id
+					membersWithRole (first: 1) {
+						pageInfo {
+							endCursor
+							hasNextPage
+						}
+						nodes {
+							id
+							gists (first: 100, privacy: ALL) {
+								pageInfo {
+									endCursor
+									hasNextPage
+								}
+								nodes {
+									id
+									isPublic
+									url
+								}
+							}
+						}
+					}
+				}
+			}`,
+		},
+		"package_version_with_enterprise": {
+			request: &github.Request{
+				BaseURL:           "https://ghe-test-server",
+				IsEnterpriseCloud: false,
+				APIVersion:        testutil.GenPtr("v3"),
+				EntityExternalID:  "PackageVersion",
+				PageSize:          100,
+				Token:             "Bearer Testtoken",
+				EnterpriseSlug:    testutil.GenPtr("SGNL"),
+				EntityConfig: &framework.EntityConfig{
+					ExternalId: "PackageVersion",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+						{
+							ExternalId: "packageId",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+						{
+							ExternalId: "version",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+					},
+				},
+			},
+			wantQuery: `query {
+				enterprise (slug: "SGNL") {
+					id
+					organizations (first: 1) {
+						pageInfo {
+							endCursor
+							hasNextPage
+						}
+						nodes {
+							id
+							packages (first: 1) {
+								pageInfo {
+									endCursor
+									hasNextPage
+								}
+								nodes {
+									id
+									versions (first: 100) {
+										pageInfo {
+											endCursor
+											hasNextPage
+										}
+										nodes {
+											id
+											version
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}`,
+		},
 		"default_user_builder_attributes": {
 			request: &github.Request{
 				BaseURL:           "https://ghe-test-server",
@@ -1600,7 +1992,7 @@ func ValidateGraphQLCompositeCursor(
 	var gotCursorPageInfo *github.PageInfo
 
 	if gotCompositeCursor.Cursor != nil {
-		gotCursorPageInfo, err = github.DecodePageInfo(gotCompositeCursor.Cursor)
+		gotCursorPageInfo, err = github.DecodePageInfo(gotCompositeCursor.Cursor, "")
 		if err != nil {
 			return false
 		}
@@ -1608,7 +2000,7 @@ func ValidateGraphQLCompositeCursor(
 
 	var wantCursorPageInfo *github.PageInfo
 	if wantCompositeCursor.Cursor != nil {
-		wantCursorPageInfo, err = github.DecodePageInfo(wantCompositeCursor.Cursor)
+		wantCursorPageInfo, err = github.DecodePageInfo(wantCompositeCursor.Cursor, "")
 		if err != nil {
 			return false
 		}
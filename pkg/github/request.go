@@ -39,6 +39,12 @@ var (
 					"enterprise":   "/enterprises/%s/secret-scanning/alerts",
 					"organization": "/orgs/%s/secret-scanning/alerts",
 				},
+				RepositoryCustomProperty: {
+					"organization": "/orgs/%s/properties/values",
+				},
+				OrganizationSecuritySettings: {
+					"organization": "/orgs/%s",
+				},
 			},
 		},
 		EnterpriseServer: {
@@ -49,6 +55,12 @@ var (
 					"enterprise":   "/enterprises/%s/secret-scanning/alerts",
 					"organization": "/orgs/%s/secret-scanning/alerts",
 				},
+				RepositoryCustomProperty: {
+					"organization": "/orgs/%s/properties/values",
+				},
+				OrganizationSecuritySettings: {
+					"organization": "/orgs/%s",
+				},
 			},
 		},
 	}
@@ -124,7 +136,7 @@ func PopulateRequestInfo(request *Request) (*RequestInfo, *framework.Error) {
 		URI := ""
 		// Certain endpoints require additional parameters in the URI.
 		switch request.EntityExternalID {
-		case SecretScanningAlert:
+		case SecretScanningAlert, RepositoryCustomProperty:
 			if request.EnterpriseSlug != nil {
 				URI = fmt.Sprintf(deploymentInfo.RESTEndpoints[request.EntityExternalID]["enterprise"], *request.EnterpriseSlug)
 			} else if len(request.Organizations) > 0 {
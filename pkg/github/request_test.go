@@ -323,6 +323,26 @@ func TestPopulateRequestInfo(t *testing.T) {
 				Query:      "",
 			},
 		},
+		"enterprise_cloud_rest_repository_custom_property_entity": {
+			request: &github.Request{
+				BaseURL:           "https://api.github.com",
+				Organizations:     []string{"testOrg1", "testOrg2"},
+				IsEnterpriseCloud: true,
+				APIVersion:        testutil.GenPtr("v3"),
+				EntityExternalID:  "RepositoryCustomProperty",
+				PageSize:          100,
+				Token:             "Bearer Testtoken",
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("1"),
+				},
+			},
+			wantRequestInfo: &github.RequestInfo{
+				Endpoint:           "https://api.github.com/orgs/testOrg2/properties/values?per_page=100",
+				HTTPMethod:         "GET",
+				Query:              "",
+				OrganizationOffset: 1,
+			},
+		},
 	}
 
 	for name, tt := range tests {
@@ -28,13 +28,25 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
-	if err := request.Config.Validate(ctx, ValidEntityExternalIDs[request.Entity.ExternalId].isRestAPI); err != nil {
+	entity := ValidEntityExternalIDs[request.Entity.ExternalId]
+
+	if err := request.Config.Validate(ctx, entity.isRestAPI || entity.usesRestAPI); err != nil {
 		return &framework.Error{
 			Message: fmt.Sprintf("GitHub config is invalid: %v.", err.Error()),
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 		}
 	}
 
+	if ValidEntityExternalIDs[request.Entity.ExternalId].requiresOrganizations && len(request.Config.Organizations) == 0 {
+		return &framework.Error{
+			Message: fmt.Sprintf(
+				"GitHub config is invalid: organizations must be specified to retrieve %s entities.",
+				request.Entity.ExternalId,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
 	trimmedAddress, parsed, err := validation.ParseAndValidateAddress(request.Address, []string{"https"})
 	if err != nil {
 		return err
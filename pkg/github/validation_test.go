@@ -43,6 +43,35 @@ func TestValidateGetPageRequest(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"invalid_request_additional_field_not_allowed": {
+			request: &framework.Request[github.Config]{
+				Address: "ghe-test-server/api/graphql",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Organization",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &github.Config{
+					EnterpriseSlug: testutil.GenPtr("testenterpriseslug"),
+					AdditionalFields: map[string][]string{
+						"Organization": {"samlIdentityProvider { id }", "viewerIsAMember"},
+					},
+				},
+				Ordered:  false,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "GitHub config is invalid: additional field viewerIsAMember is not allowed for entity Organization.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_request_empty_config": {
 			request: &framework.Request[github.Config]{
 				Address: "ghe-test-server/api/graphql",
@@ -142,6 +171,34 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"invalid_config_empty_api_version_for_organization_security_settings": {
+			request: &framework.Request[github.Config]{
+				Address: "ghe-test-server/api/graphql",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "OrganizationSecuritySettings",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &github.Config{
+					EnterpriseSlug:    testutil.GenPtr("SGNL"),
+					IsEnterpriseCloud: true,
+					APIVersion:        nil,
+				},
+				Ordered:  false,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "GitHub config is invalid: apiVersion is not set for an entity that is retrieve through the GitHub REST API.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_config_invalid_api_version_for_REST": {
 			request: &framework.Request[github.Config]{
 				Address: "ghe-test-server/api/graphql",
@@ -533,6 +590,34 @@ func TestValidateGetPageRequest(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"invalid_repository_custom_property_with_enterpriseSlug": {
+			request: &framework.Request[github.Config]{
+				Address: "ghe-test-server/api/graphql",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "RepositoryCustomProperty",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "repository_id",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				Config: &github.Config{
+					EnterpriseSlug:    testutil.GenPtr("testenterpriseslug"),
+					IsEnterpriseCloud: false,
+					APIVersion:        testutil.GenPtr("v3"),
+				},
+				Ordered:  false,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "GitHub config is invalid: organizations must be specified to retrieve RepositoryCustomProperty entities.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_both_organizations_and_enterpriseSlug": {
 			request: &framework.Request[github.Config]{
 				Address: "ghe-test-server/api/graphql",
@@ -616,7 +701,7 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
-		"invalid_organizations_and_empty_enterpriseSlug_are_present": {
+		"valid_organizations_and_empty_enterpriseSlug_are_present": {
 			request: &framework.Request[github.Config]{
 				Address: "ghe-test-server/api/graphql",
 				Auth: &framework.DatasourceAuthCredentials{
@@ -640,10 +725,7 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Ordered:  false,
 				PageSize: 100,
 			},
-			wantErr: &framework.Error{
-				Message: "GitHub config is invalid: only one of enterpriseSlug or organizations must be specified, not both.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
-			},
+			wantErr: nil,
 		},
 		"invalid_organizations_has_empty_value": {
 			request: &framework.Request[github.Config]{
@@ -0,0 +1,243 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package gitlab_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/gitlab"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := gitlab.NewAdapter(&gitlab.Datasource{
+		Client: server.Client(),
+	})
+
+	// marshalCursor returns the cursor of a page, which contains the URL of the test server.
+	marshalCursor := func(cursor *pagination.CompositeCursor[string]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	usersNextCursor := marshalCursor(&pagination.CompositeCursor[string]{
+		Cursor: testutil.GenPtr(server.URL + "/api/v4/users?id_after=2&order_by=id&pagination=keyset&per_page=2&sort=asc"),
+	})
+
+	tests := map[string]struct {
+		request      *framework.Request[gitlab.Config]
+		wantResponse framework.Response
+	}{
+		"users_first_page": {
+			request: &framework.Request[gitlab.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: gitlab.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "username",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "created_at",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":         int64(1),
+							"username":   "alice",
+							"created_at": time.Date(2012, 5, 23, 8, 0, 58, 0, time.UTC),
+						},
+						{
+							"id":         int64(2),
+							"username":   "bob",
+							"created_at": time.Date(2013, 5, 23, 8, 0, 58, 0, time.UTC),
+						},
+					},
+					NextCursor: usersNextCursor,
+				},
+			},
+		},
+		"users_last_page": {
+			request: &framework.Request[gitlab.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Config: &gitlab.Config{
+					APIVersion: "v4",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: gitlab.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "state",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+				Cursor:   usersNextCursor,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":    int64(3),
+							"state": "active",
+						},
+					},
+				},
+			},
+		},
+		"group_members_first_page": {
+			request: &framework.Request[gitlab.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: gitlab.GroupMember,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "groupId",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "userId",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "access_level",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "expires_at",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":           "10-1",
+							"groupId":      int64(10),
+							"userId":       int64(1),
+							"access_level": int64(50),
+						},
+						{
+							"id":           "10-2",
+							"groupId":      int64(10),
+							"userId":       int64(2),
+							"access_level": int64(30),
+							"expires_at":   time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						Cursor:           testutil.GenPtr(server.URL + "/api/v4/groups/10/members?page=2&per_page=2"),
+						CollectionID:     testutil.GenPtr("10"),
+						CollectionCursor: testutil.GenPtr(server.URL + "/api/v4/groups?cursor=eyJuYW1lIjoiYSJ9&order_by=name&pagination=keyset&per_page=1&sort=asc"),
+					}),
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[gitlab.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-invalid",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: gitlab.Project,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+		"invalid_config": {
+			request: &framework.Request[gitlab.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Config: &gitlab.Config{
+					APIVersion: "v3",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: gitlab.Project,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "GitLab config is invalid: apiVersion is not supported: v3.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
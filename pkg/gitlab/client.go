@@ -0,0 +1,65 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package gitlab
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Client is a client that allows querying the GitLab datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+}
+
+// Request is a request to the GitLab REST API.
+type Request struct {
+	// BaseURL is the Base URL of the GitLab instance to query, e.g. "https://gitlab.com" or the URL of a
+	// self-managed instance.
+	BaseURL string
+
+	// Token is the Bearer token to authenticate a request. GitLab accepts both personal, group or project access
+	// tokens and OAuth2 access tokens as Bearer tokens, e.g. "Bearer glpat-XXXX".
+	Token string
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity.
+	// nil in the request for the first page.
+	Cursor *pagination.CompositeCursor[string]
+
+	// APIVersion is the version of the REST API to query, e.g. "v4".
+	APIVersion string
+
+	// GroupID is the ID or the full path of the group the sync is scoped to, e.g. "acme".
+	// nil to sync the whole instance.
+	GroupID *string
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *pagination.CompositeCursor[string]
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// DefaultAPIVersion is the version of the REST API queried if not configured.
+const DefaultAPIVersion = "v4"
+
+var supportedAPIVersions = map[string]struct{}{
+	"v4": {},
+}
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// GitLab Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "apiVersion": "v4",
+    "groupId": "acme"
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// APIVersion is the version of the REST API to query. Defaults to DefaultAPIVersion.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// GroupID is the ID or the full path of the group to sync, e.g. "acme" or "acme/engineering".
+	// Required on GitLab.com, where the instance-wide endpoints list every public user, group and project.
+	// If set, the group and its descendant groups, their projects, and the members of the group are synced.
+	// If not set, every user, group and project visible to the token is synced, e.g. the whole instance with
+	// the token of an administrator of a self-managed instance.
+	GroupID *string `json:"groupId,omitempty"`
+}
+
+// apiVersion returns the configured APIVersion, or the default.
+func (c *Config) apiVersion() string {
+	if c == nil || c.APIVersion == "" {
+		return DefaultAPIVersion
+	}
+
+	return c.APIVersion
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	if _, found := supportedAPIVersions[c.apiVersion()]; !found {
+		return fmt.Errorf("apiVersion is not supported: %v", c.APIVersion)
+	}
+
+	if c.GroupID != nil && *c.GroupID == "" {
+		return errors.New("groupId cannot be an empty string")
+	}
+
+	return c.CommonConfig.ValidateSyncMode()
+}
@@ -0,0 +1,284 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package gitlab
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// memberOf is the external ID of the collection entity the entity is listed for, if any.
+	memberOf *string
+	// collectionIDAttribute is the attribute set on the members with the ID of their collection.
+	collectionIDAttribute string
+}
+
+const (
+	User          string = "User"
+	Group         string = "Group"
+	Project       string = "Project"
+	GroupMember   string = "GroupMember"
+	ProjectMember string = "ProjectMember"
+
+	// memberUserIDAttribute is the attribute set on the members with the ID of the user.
+	memberUserIDAttribute = "userId"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// https://docs.gitlab.com/ee/api/users.html#list-users.
+		User: {
+			uniqueIDAttrExternalID: "id",
+		},
+		// https://docs.gitlab.com/ee/api/groups.html#list-groups.
+		Group: {
+			uniqueIDAttrExternalID: "id",
+		},
+		// https://docs.gitlab.com/ee/api/projects.html#list-all-projects.
+		Project: {
+			uniqueIDAttrExternalID: "id",
+		},
+		// https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project.
+		// Connection entity for Groups <-> Users, with the access level of the user in the group.
+		GroupMember: {
+			uniqueIDAttrExternalID: "id",
+			memberOf: func() *string {
+				s := Group
+
+				return &s
+			}(),
+			collectionIDAttribute: "groupId",
+		},
+		// Connection entity for Projects <-> Users, with the access level of the user in the project.
+		ProjectMember: {
+			uniqueIDAttrExternalID: "id",
+			memberOf: func() *string {
+				s := Project
+
+				return &s
+			}(),
+			collectionIDAttribute: "projectId",
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	// [MemberEntities] The members are listed one group or project at a time, so set the `CollectionID` to
+	// the ID of the current group or project, and the `CollectionCursor` to the link to the next one.
+	if entity.memberOf != nil {
+		collectionReq := &Request{
+			BaseURL:               request.BaseURL,
+			Token:                 request.Token,
+			PageSize:              1,
+			EntityExternalID:      *entity.memberOf,
+			APIVersion:            request.APIVersion,
+			GroupID:               request.GroupID,
+			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		}
+
+		if request.Cursor != nil && request.Cursor.CollectionCursor != nil {
+			collectionReq.Cursor = &pagination.CompositeCursor[string]{
+				Cursor: request.Cursor.CollectionCursor,
+			}
+		}
+
+		if request.Cursor == nil {
+			request.Cursor = &pagination.CompositeCursor[string]{}
+		}
+
+		isEmptyLastPage, cursorErr := pagination.UpdateNextCursorFromCollectionAPI(
+			ctx,
+			request.Cursor,
+			func(ctx context.Context, collectionReq *Request) (
+				int, string, []map[string]any, *pagination.CompositeCursor[string], *framework.Error,
+			) {
+				resp, err := d.GetPage(ctx, collectionReq)
+				if err != nil {
+					return 0, "", nil, nil, err
+				}
+
+				// The IDs of the groups and projects are numbers, but the collection ID is a string.
+				collections := make([]map[string]any, 0, len(resp.Objects))
+
+				for _, collection := range resp.Objects {
+					collections = append(collections, map[string]any{"id": commonutil.FormatID(collection["id"])})
+				}
+
+				return resp.StatusCode, resp.RetryAfterHeader, collections, resp.NextCursor, nil
+			},
+			collectionReq,
+			"id",
+		)
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		if isEmptyLastPage {
+			return &Response{
+				StatusCode: http.StatusOK,
+			}, nil
+		}
+	}
+
+	validationErr := pagination.ValidateCompositeCursor(
+		request.Cursor,
+		request.EntityExternalID,
+		// Send a bool indicating if the entity is a member of a collection.
+		entity.memberOf != nil,
+	)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	// [Groups] The first page of the groups of a group scoped sync is the group itself.
+	isScopeGroupPage := request.EntityExternalID == Group && request.GroupID != nil &&
+		(request.Cursor == nil || request.Cursor.Cursor == nil)
+
+	var objects []map[string]any
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL:                   endpoint,
+		Header:                http.Header{"Authorization": {request.Token}},
+		DatasourceName:        "GitLab",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "GitLab")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, parseErr = ParseResponse(bodyBytes, isScopeGroupPage)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	if isScopeGroupPage {
+		nextEndpoint := descendantGroupsEndpoint(request)
+
+		response.NextCursor = &pagination.CompositeCursor[string]{
+			Cursor: &nextEndpoint,
+		}
+	} else {
+		response.NextCursor = pagination.GetNextCursorFromLinkHeader(httpResponse.Header.Values("Link"))
+	}
+
+	// [MemberEntities] Set `id`, `userId` and the ID of the collection, and the cursor of the next page of
+	// members, or of the next collection.
+	if entity.memberOf != nil {
+		collectionID := *request.Cursor.CollectionID
+
+		for _, member := range objects {
+			userID := member["id"]
+
+			member["id"] = collectionID + "-" + commonutil.FormatID(userID)
+			member[memberUserIDAttribute] = userID
+			member[entity.collectionIDAttribute] = parseID(collectionID)
+		}
+
+		request.Cursor.Cursor = nil
+		if response.NextCursor != nil {
+			request.Cursor.Cursor = response.NextCursor.Cursor
+		}
+
+		response.NextCursor = nil
+
+		if request.Cursor.Cursor != nil || request.Cursor.CollectionCursor != nil {
+			response.NextCursor = request.Cursor
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the objects of a GitLab REST API response, which is a list of objects, or a single object.
+func ParseResponse(body []byte, isSingleObject bool) (objects []map[string]any, err *framework.Error) {
+	if isSingleObject {
+		var object map[string]any
+
+		if unmarshalErr := httpds.UnmarshalJSON(body, &object); unmarshalErr != nil {
+			return nil, unmarshalErr
+		}
+
+		return []map[string]any{object}, nil
+	}
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &objects); unmarshalErr != nil {
+		return nil, unmarshalErr
+	}
+
+	return objects, nil
+}
+
+// parseID parses a numeric ID formatted by commonutil.FormatID, so that the IDs of the collections have the same type
+// as the ID of the collection objects. IDs that aren't numbers are returned as is.
+func parseID(id string) any {
+	if v, err := strconv.ParseInt(id, 10, 64); err == nil {
+		return float64(v)
+	}
+
+	return id
+}
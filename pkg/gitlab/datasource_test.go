@@ -0,0 +1,362 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package gitlab_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/gitlab"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock GitLab server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer glpat-test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message": "401 Unauthorized"}`))
+
+		return
+	}
+
+	// nextLink sets the Link header to the next page, on the test server.
+	nextLink := func(path string) {
+		w.Header().Set("Link", `<https://`+r.Host+path+`>; rel="next"`)
+	}
+
+	switch r.URL.RequestURI() {
+	// Users Page 1
+	case "/api/v4/users?order_by=id&pagination=keyset&per_page=2&sort=asc":
+		nextLink("/api/v4/users?id_after=2&order_by=id&pagination=keyset&per_page=2&sort=asc")
+		w.Write([]byte(`[
+			{"id": 1, "username": "alice", "name": "Alice Smith", "state": "active", "created_at": "2012-05-23T08:00:58.000Z"},
+			{"id": 2, "username": "bob", "name": "Bob Jones", "state": "blocked", "created_at": "2013-05-23T08:00:58.000Z"}
+		]`))
+
+	// Users Page 2
+	case "/api/v4/users?id_after=2&order_by=id&pagination=keyset&per_page=2&sort=asc":
+		w.Write([]byte(`[
+			{"id": 3, "username": "carol", "name": "Carol White", "state": "active", "created_at": "2014-05-23T08:00:58.000Z"}
+		]`))
+
+	// Groups Page 1
+	case "/api/v4/groups?order_by=name&pagination=keyset&per_page=1&sort=asc":
+		nextLink("/api/v4/groups?cursor=eyJuYW1lIjoiYSJ9&order_by=name&pagination=keyset&per_page=1&sort=asc")
+		w.Write([]byte(`[
+			{"id": 10, "name": "Acme", "full_path": "acme", "parent_id": null, "visibility": "private"}
+		]`))
+
+	// Groups Page 2
+	case "/api/v4/groups?cursor=eyJuYW1lIjoiYSJ9&order_by=name&pagination=keyset&per_page=1&sort=asc":
+		w.Write([]byte(`[
+			{"id": 11, "name": "Engineering", "full_path": "acme/engineering", "parent_id": 10, "visibility": "private"}
+		]`))
+
+	// Group Members of Group 10 Page 1
+	case "/api/v4/groups/10/members?per_page=2":
+		nextLink("/api/v4/groups/10/members?page=2&per_page=2")
+		w.Write([]byte(`[
+			{"id": 1, "username": "alice", "access_level": 50, "expires_at": null},
+			{"id": 2, "username": "bob", "access_level": 30, "expires_at": "2026-12-31"}
+		]`))
+
+	// Group Members of Group 10 Page 2
+	case "/api/v4/groups/10/members?page=2&per_page=2":
+		w.Write([]byte(`[
+			{"id": 3, "username": "carol", "access_level": 10, "expires_at": null}
+		]`))
+
+	// Group Members of Group 11
+	case "/api/v4/groups/11/members?per_page=2":
+		w.Write([]byte(`[
+			{"id": 1, "username": "alice", "access_level": 40, "expires_at": null}
+		]`))
+
+	// Scoped Group
+	case "/api/v4/groups/acme%2Fengineering":
+		w.Write([]byte(`{"id": 11, "name": "Engineering", "full_path": "acme/engineering", "parent_id": 10, "visibility": "private"}`))
+
+	// Scoped Group Descendant Groups
+	case "/api/v4/groups/acme%2Fengineering/descendant_groups?per_page=2":
+		w.Write([]byte(`[
+			{"id": 12, "name": "Platform", "full_path": "acme/engineering/platform", "parent_id": 11, "visibility": "internal"}
+		]`))
+
+	// Scoped Group Projects
+	case "/api/v4/groups/acme%2Fengineering/projects?include_subgroups=true&per_page=1":
+		w.Write([]byte(`[
+			{"id": 30, "name": "api", "path_with_namespace": "acme/engineering/platform/api", "visibility": "private"}
+		]`))
+
+	// Project Members of Project 30
+	case "/api/v4/projects/30/members?per_page=2":
+		w.Write([]byte(`[
+			{"id": 2, "username": "bob", "access_level": 40, "expires_at": null}
+		]`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body           []byte
+		isSingleObject bool
+		wantObjects    []map[string]any
+		wantErr        *framework.Error
+	}{
+		"list": {
+			body:        []byte(`[{"id": 1}, {"id": 2}]`),
+			wantObjects: []map[string]any{{"id": float64(1)}, {"id": float64(2)}},
+		},
+		"empty_list": {
+			body:        []byte(`[]`),
+			wantObjects: []map[string]any{},
+		},
+		"single_object": {
+			body:           []byte(`{"id": 1}`),
+			isSingleObject: true,
+			wantObjects:    []map[string]any{{"id": float64(1)}},
+		},
+		"invalid_list": {
+			body: []byte(`{"message": "404 Not found"}`),
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal object into Go value of type []map[string]interface {}.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotErr := gitlab.ParseResponse(tt.body, tt.isSingleObject)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := gitlab.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *gitlab.Request
+		wantResponse *gitlab.Response
+		wantErr      *framework.Error
+	}{
+		"users_first_page": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-test",
+				PageSize:         2,
+				EntityExternalID: gitlab.User,
+				APIVersion:       "v4",
+			},
+			wantResponse: &gitlab.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(1), "username": "alice", "name": "Alice Smith", "state": "active", "created_at": "2012-05-23T08:00:58.000Z"},
+					{"id": float64(2), "username": "bob", "name": "Bob Jones", "state": "blocked", "created_at": "2013-05-23T08:00:58.000Z"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(server.URL + "/api/v4/users?id_after=2&order_by=id&pagination=keyset&per_page=2&sort=asc"),
+				},
+			},
+		},
+		"users_last_page": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-test",
+				PageSize:         2,
+				EntityExternalID: gitlab.User,
+				APIVersion:       "v4",
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(server.URL + "/api/v4/users?id_after=2&order_by=id&pagination=keyset&per_page=2&sort=asc"),
+				},
+			},
+			wantResponse: &gitlab.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(3), "username": "carol", "name": "Carol White", "state": "active", "created_at": "2014-05-23T08:00:58.000Z"},
+				},
+			},
+		},
+		"users_cursor_on_another_host": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-test",
+				PageSize:         2,
+				EntityExternalID: gitlab.User,
+				APIVersion:       "v4",
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://attacker.example.com/api/v4/users?page=2"),
+				},
+			},
+			wantErr: pagination.NewCursorError(
+				gitlab.User,
+				pagination.CompositeCursorShape[string](false),
+				"cursor link is not an endpoint of "+server.URL+"/api/v4",
+			),
+		},
+		"group_members_first_page": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-test",
+				PageSize:         2,
+				EntityExternalID: gitlab.GroupMember,
+				APIVersion:       "v4",
+			},
+			wantResponse: &gitlab.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "10-1", "userId": float64(1), "groupId": float64(10), "username": "alice", "access_level": float64(50), "expires_at": nil},
+					{"id": "10-2", "userId": float64(2), "groupId": float64(10), "username": "bob", "access_level": float64(30), "expires_at": "2026-12-31"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr(server.URL + "/api/v4/groups/10/members?page=2&per_page=2"),
+					CollectionID:     testutil.GenPtr("10"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/api/v4/groups?cursor=eyJuYW1lIjoiYSJ9&order_by=name&pagination=keyset&per_page=1&sort=asc"),
+				},
+			},
+		},
+		"group_members_last_page_of_group": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-test",
+				PageSize:         2,
+				EntityExternalID: gitlab.GroupMember,
+				APIVersion:       "v4",
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr(server.URL + "/api/v4/groups/10/members?page=2&per_page=2"),
+					CollectionID:     testutil.GenPtr("10"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/api/v4/groups?cursor=eyJuYW1lIjoiYSJ9&order_by=name&pagination=keyset&per_page=1&sort=asc"),
+				},
+			},
+			wantResponse: &gitlab.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "10-3", "userId": float64(3), "groupId": float64(10), "username": "carol", "access_level": float64(10), "expires_at": nil},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("10"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/api/v4/groups?cursor=eyJuYW1lIjoiYSJ9&order_by=name&pagination=keyset&per_page=1&sort=asc"),
+				},
+			},
+		},
+		"group_members_last_group": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-test",
+				PageSize:         2,
+				EntityExternalID: gitlab.GroupMember,
+				APIVersion:       "v4",
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("10"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/api/v4/groups?cursor=eyJuYW1lIjoiYSJ9&order_by=name&pagination=keyset&per_page=1&sort=asc"),
+				},
+			},
+			wantResponse: &gitlab.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "11-1", "userId": float64(1), "groupId": float64(11), "username": "alice", "access_level": float64(40), "expires_at": nil},
+				},
+			},
+		},
+		"scoped_groups_first_page": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-test",
+				PageSize:         2,
+				EntityExternalID: gitlab.Group,
+				APIVersion:       "v4",
+				GroupID:          testutil.GenPtr("acme/engineering"),
+			},
+			wantResponse: &gitlab.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(11), "name": "Engineering", "full_path": "acme/engineering", "parent_id": float64(10), "visibility": "private"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(server.URL + "/api/v4/groups/acme%2Fengineering/descendant_groups?per_page=2"),
+				},
+			},
+		},
+		"scoped_groups_descendant_groups": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-test",
+				PageSize:         2,
+				EntityExternalID: gitlab.Group,
+				APIVersion:       "v4",
+				GroupID:          testutil.GenPtr("acme/engineering"),
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(server.URL + "/api/v4/groups/acme%2Fengineering/descendant_groups?per_page=2"),
+				},
+			},
+			wantResponse: &gitlab.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(12), "name": "Platform", "full_path": "acme/engineering/platform", "parent_id": float64(11), "visibility": "internal"},
+				},
+			},
+		},
+		"scoped_project_members": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-test",
+				PageSize:         2,
+				EntityExternalID: gitlab.ProjectMember,
+				APIVersion:       "v4",
+				GroupID:          testutil.GenPtr("acme/engineering"),
+			},
+			wantResponse: &gitlab.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "30-2", "userId": float64(2), "projectId": float64(30), "username": "bob", "access_level": float64(40), "expires_at": nil},
+				},
+			},
+		},
+		"unauthorized": {
+			request: &gitlab.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer glpat-invalid",
+				PageSize:         2,
+				EntityExternalID: gitlab.Project,
+				APIVersion:       "v4",
+			},
+			wantResponse: &gitlab.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// The endpoint of the next pages is the "next" link returned by GitLab, which is stored in the cursor.
+// For example, the endpoint of the first page of projects of an instance is:
+// https://gitlab.example.com/api/v4/projects?order_by=id&pagination=keyset&per_page=100&sort=asc.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	baseEndpoint := request.BaseURL + "/api/" + request.APIVersion
+
+	// [All Entities] This is the link to the next page of objects.
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		// The link is only followed on the queried instance, so that the token is never sent to another host.
+		if !strings.HasPrefix(*request.Cursor.Cursor, baseEndpoint+"/") {
+			return "", pagination.NewCursorError(
+				request.EntityExternalID,
+				pagination.CompositeCursorShape[string](request.Cursor.CollectionID != nil),
+				fmt.Sprintf("cursor link is not an endpoint of %s", baseEndpoint),
+			)
+		}
+
+		return *request.Cursor.Cursor, nil
+	}
+
+	var (
+		path   string
+		params = url.Values{}
+	)
+
+	params.Set("per_page", strconv.FormatInt(request.PageSize, 10))
+
+	switch request.EntityExternalID {
+	case User:
+		if request.GroupID != nil {
+			// The members of the group, including the members inherited from its ancestors.
+			path = "/groups/" + url.PathEscape(*request.GroupID) + "/members/all"
+		} else {
+			path = "/users"
+
+			setKeysetPagination(params, "id")
+		}
+	case Group:
+		if request.GroupID != nil {
+			// The first page only contains the group itself, and the next pages its descendant groups,
+			// see descendantGroupsEndpoint.
+			return baseEndpoint + "/groups/" + url.PathEscape(*request.GroupID), nil
+		}
+
+		path = "/groups"
+
+		// GitLab only supports keyset pagination of the groups ordered by name.
+		setKeysetPagination(params, "name")
+	case Project:
+		if request.GroupID != nil {
+			path = "/groups/" + url.PathEscape(*request.GroupID) + "/projects"
+
+			params.Set("include_subgroups", "true")
+		} else {
+			path = "/projects"
+
+			setKeysetPagination(params, "id")
+		}
+	case GroupMember, ProjectMember:
+		if request.Cursor == nil || request.Cursor.CollectionID == nil {
+			return "", &framework.Error{
+				Message: fmt.Sprintf("Unable to construct the %s endpoint without a collection ID.", request.EntityExternalID),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			}
+		}
+
+		collectionPath := "/groups/"
+		if request.EntityExternalID == ProjectMember {
+			collectionPath = "/projects/"
+		}
+
+		// Only the direct members, as the inherited members are synced with the ancestor groups.
+		path = collectionPath + url.PathEscape(*request.Cursor.CollectionID) + "/members"
+	default:
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	return baseEndpoint + path + "?" + params.Encode(), nil
+}
+
+// descendantGroupsEndpoint returns the endpoint of the first page of the descendant groups of the group the sync
+// is scoped to.
+func descendantGroupsEndpoint(request *Request) string {
+	params := url.Values{}
+	params.Set("per_page", strconv.FormatInt(request.PageSize, 10))
+
+	return request.BaseURL + "/api/" + request.APIVersion + "/groups/" + url.PathEscape(*request.GroupID) +
+		"/descendant_groups?" + params.Encode()
+}
+
+// setKeysetPagination sets the parameters of keyset pagination, which is more efficient than offset pagination
+// for large collections, on the endpoints supporting it.
+// https://docs.gitlab.com/ee/api/rest/#keyset-based-pagination.
+func setKeysetPagination(params url.Values, orderBy string) {
+	params.Set("pagination", "keyset")
+	params.Set("order_by", orderBy)
+	params.Set("sort", "asc")
+}
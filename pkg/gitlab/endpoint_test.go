@@ -0,0 +1,178 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package gitlab_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/gitlab"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *gitlab.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.example.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: "Issue",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"users": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.example.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.User,
+			},
+			wantEndpoint: "https://gitlab.example.com/api/v4/users?order_by=id&pagination=keyset&per_page=100&sort=asc",
+		},
+		"users_next_page": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.example.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.User,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://gitlab.example.com/api/v4/users?id_after=100&order_by=id&pagination=keyset&per_page=100&sort=asc"),
+				},
+			},
+			wantEndpoint: "https://gitlab.example.com/api/v4/users?id_after=100&order_by=id&pagination=keyset&per_page=100&sort=asc",
+		},
+		"users_next_page_on_another_host": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.example.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.User,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://gitlab.example.com.attacker.com/api/v4/users?page=2"),
+				},
+			},
+			wantErr: pagination.NewCursorError(
+				gitlab.User,
+				pagination.CompositeCursorShape[string](false),
+				"cursor link is not an endpoint of https://gitlab.example.com/api/v4",
+			),
+		},
+		"scoped_users": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.User,
+				GroupID:          testutil.GenPtr("acme"),
+			},
+			wantEndpoint: "https://gitlab.com/api/v4/groups/acme/members/all?per_page=100",
+		},
+		"groups": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.example.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.Group,
+			},
+			wantEndpoint: "https://gitlab.example.com/api/v4/groups?order_by=name&pagination=keyset&per_page=100&sort=asc",
+		},
+		"scoped_groups": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.Group,
+				GroupID:          testutil.GenPtr("acme/engineering"),
+			},
+			wantEndpoint: "https://gitlab.com/api/v4/groups/acme%2Fengineering",
+		},
+		"projects": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.example.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.Project,
+			},
+			wantEndpoint: "https://gitlab.example.com/api/v4/projects?order_by=id&pagination=keyset&per_page=100&sort=asc",
+		},
+		"scoped_projects": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.Project,
+				GroupID:          testutil.GenPtr("1234"),
+			},
+			wantEndpoint: "https://gitlab.com/api/v4/groups/1234/projects?include_subgroups=true&per_page=100",
+		},
+		"group_members": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.example.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.GroupMember,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("10"),
+				},
+			},
+			wantEndpoint: "https://gitlab.example.com/api/v4/groups/10/members?per_page=100",
+		},
+		"project_members": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.example.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.ProjectMember,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("30"),
+				},
+			},
+			wantEndpoint: "https://gitlab.example.com/api/v4/projects/30/members?per_page=100",
+		},
+		"project_members_missing_collection_id": {
+			request: &gitlab.Request{
+				BaseURL:          "https://gitlab.example.com",
+				APIVersion:       "v4",
+				PageSize:         100,
+				EntityExternalID: gitlab.ProjectMember,
+			},
+			wantErr: &framework.Error{
+				Message: "Unable to construct the ProjectMember endpoint without a collection ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := gitlab.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
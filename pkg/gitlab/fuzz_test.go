@@ -0,0 +1,33 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package gitlab_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/gitlab"
+)
+
+// FuzzParseResponse checks that malformed GitLab responses are rejected with an error instead of a panic, for both
+// the list and the single object endpoints.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`[{"id":1,"username":"john_smith","state":"active"}]`,
+		`{"id":1,"name":"Twitter","path":"twitter"}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), false)
+		f.Add([]byte(seed), true)
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, isSingleObject bool) {
+		_, err := gitlab.ParseResponse(body, isSingleObject)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
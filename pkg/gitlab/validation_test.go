@@ -0,0 +1,216 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package gitlab_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/gitlab"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: gitlab.User,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "name",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[gitlab.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity: validEntity,
+				Config: &gitlab.Config{
+					APIVersion: "v4",
+					GroupID:    testutil.GenPtr("acme"),
+				},
+				PageSize: 100,
+			},
+			wantAddress: "https://gitlab.com",
+		},
+		"valid_request_nil_config": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "https://gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantAddress: "https://gitlab.com",
+		},
+		"invalid_request_empty_group_id": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "https://gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity: validEntity,
+				Config: &gitlab.Config{
+					GroupID: testutil.GenPtr(""),
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "GitLab config is invalid: groupId cannot be an empty string.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_unsupported_api_version": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "https://gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity: validEntity,
+				Config: &gitlab.Config{
+					APIVersion: "v3",
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "GitLab config is invalid: apiVersion is not supported: v3.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "http://gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[gitlab.Config]{
+				Address:  "https://gitlab.com",
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided datasource auth is missing required http authorization credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "https://gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "glpat-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "https://gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Issue",
+					Attributes: validEntity.Attributes,
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "https://gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: gitlab.User,
+					Attributes: validEntity.Attributes[1:],
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "https://gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity:   validEntity,
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[gitlab.Config]{
+				Address: "https://gitlab.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer glpat-test",
+				},
+				Entity:   validEntity,
+				PageSize: 101,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (101) exceeds the maximum allowed (100).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &gitlab.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -48,20 +49,51 @@ func (a *Adapter) RequestPageFromDatasource(
 
 	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
 
-	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[string](request.Cursor)
-	if err != nil {
-		return framework.NewGetPageResponseError(err)
+	var (
+		cursor       *pagination.CompositeCursor[string]
+		domain       = request.Config.Domain
+		domainCursor *DomainCursor
+	)
+
+	if len(request.Config.Domains) > 0 {
+		parsedDomainCursor, err := UnmarshalDomainCursor(
+			request.Cursor, request.Entity.ExternalId, len(request.Config.Domains),
+		)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		domainCursor = parsedDomainCursor
+		cursor = domainCursor.Cursor
+		domain = &request.Config.Domains[domainCursor.DomainIndex]
+	} else {
+		// Unmarshal the current cursor.
+		parsedCursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		cursor = parsedCursor
+	}
+
+	baseURL := request.Address
+
+	// The LicenseAssignment entity is queried with the Enterprise License Manager API, which has its own address.
+	if request.Entity.ExternalId == LicenseAssignment {
+		baseURL = DefaultLicensingAddress
+		if request.Config.LicensingAddress != nil {
+			baseURL = *request.Config.LicensingAddress
+		}
 	}
 
 	req := &Request{
-		BaseURL:               request.Address,
+		BaseURL:               baseURL,
 		Token:                 request.Auth.HTTPAuthorization,
 		APIVersion:            request.Config.APIVersion,
-		Domain:                request.Config.Domain,
+		Domain:                domain,
 		Customer:              request.Config.Customer,
 		Filters:               request.Config.Filters,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		Ordered:               request.Ordered,
 		EntityExternalID:      request.Entity.ExternalId,
 		Cursor:                cursor,
@@ -79,6 +111,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
@@ -103,8 +137,15 @@ func (a *Adapter) RequestPageFromDatasource(
 		)
 	}
 
-	// Marshal the next cursor.
-	nextCursor, err := pagination.MarshalCursor(resp.NextCursor)
+	// Marshal the next cursor. Wrap the cursor with a DomainCursor if multiple domains are configured.
+	var nextCursor string
+
+	if domainCursor != nil {
+		nextCursor, err = MarshalDomainCursor(nextDomainCursor(domainCursor, resp.NextCursor, len(request.Config.Domains)))
+	} else {
+		nextCursor, err = pagination.MarshalCursor(resp.NextCursor)
+	}
+
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
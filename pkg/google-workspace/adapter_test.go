@@ -220,6 +220,67 @@ func TestAdapterGetPage(t *testing.T) {
 				Cursor: testutil.GenPtr("Q0FFUzhRSUJrUHpWQUdiNG04Q1IxMklwSjFJemdWcFhvODg4ZFRjV1RSWTBWK2JoeDNXWUQyQUpLeFkzN1NuQWI4ZDFHUWszMmpESGpxR1I3Um5EcXd4V2REbi9Xc0NJTUYyVXVYR2xZcEgwdUVNRk5ZWCtlVlhzYTRXeXA3MFJ2NUxqT25vM1hCeUMzZ0wvdDRwUXZHa3pnd21QcnVZSm9udFEzMk9zMlcyaEhZOUJ5OGd0UzZmU3BZdHBpeE1uUUtOUWJ6ZlYrTUI0WjVnNFBYVVB4ZjRDZTJVc0pXQ05GSG1FZnYzQkMreU9BRWNYZWRkWCt2U3REMFR0ZjI0SElMY1Z3VHB3SHh3WURzbk84d0N5eTFsNDAwUFNVVGJrNW9BUkFwajJEL3dYcFo4bmRIa3FRdmRGK3Z3b0EwWSt6ZTB6Y3ZkcUlMd3pwVjIzL25GL0tIN2JPcTVqMWFSVVYrRDN0NE4zZzNxaU44clJ5c1dxMWhadkxyT1R2TGJjdWNVdVEwMVgwcHp5cTZlSG5vTUVWeWttMHJUZEFBNGdVOVFtVjUvZStBMWlhVlVrOEQyTVlpMmJJUUtaRnRoUk5jK3lhM1dScyt0ZDFSZWFHd09MVlNnQVNpQWZrQlZYaDJqbFVqblRvL3Y3OUZYWlp1TT0="),
 			},
 		},
+		"valid_request_license_assignment": {
+			ctx: context.Background(),
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer Testtoken",
+				},
+				Config: &googleworkspace.Config{
+					APIVersion:       "v1",
+					Customer:         testutil.GenPtr("C01234567"),
+					LicensingAddress: testutil.GenPtr(server.URL),
+					Filters: googleworkspace.Filters{
+						LicenseAssignmentFilters: &googleworkspace.LicenseAssignmentFilters{
+							Products: []googleworkspace.LicenseProduct{
+								{ProductID: "Google-Apps", SKUIDs: []string{"1010020027"}},
+								{ProductID: "101034"},
+							},
+						},
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LicenseAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uniqueId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "userId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "skuName",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"uniqueId": "Google-Apps/1010020027/user1@sgnldemos.com",
+							"userId":   "user1@sgnldemos.com",
+							"skuName":  "Google Workspace Business Starter",
+						},
+						{
+							"uniqueId": "Google-Apps/1010020027/user2@sgnldemos.com",
+							"userId":   "user2@sgnldemos.com",
+							"skuName":  "Google Workspace Business Starter",
+						},
+					},
+					NextCursor: "eyJjdXJzb3IiOiJUR2xqWlc1elpWQmhaMlV5IiwiY29sbGVjdGlvbklkIjoiR29vZ2xlLUFwcHMvMTAxMDAyMDAyNyJ9",
+				},
+			},
+			wantCursor: &pagination.CompositeCursor[string]{
+				Cursor:       testutil.GenPtr("TGljZW5zZVBhZ2Uy"),
+				CollectionID: testutil.GenPtr("Google-Apps/1010020027"),
+			},
+		},
 		"invalid_request_invalid_url": {
 			ctx: context.Background(),
 			request: &framework.Request[googleworkspace.Config]{
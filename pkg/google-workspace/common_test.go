@@ -332,6 +332,70 @@ var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Req
 			]
 		}`))
 
+	// LicenseAssignments: Total of 3 for the Google-Apps/1010020027 SKU, 2 per request, and 1 for the 101034 product.
+	case "/apps/licensing/v1/product/Google-Apps/sku/1010020027/users?customerId=C01234567&maxResults=2":
+		w.Write([]byte(`{
+			"kind": "licensing#licenseAssignmentList",
+			"etag": "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/Jm7Bq3V8e0zVb9aE1k3y0Vn1Qzk\"",
+			"items": [
+				{
+					"kind": "licensing#licenseAssignment",
+					"etags": "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/KzTvJsxm3aB1qN5u8nSgrvA0Kgc\"",
+					"selfLink": "https://licensing.googleapis.com/apps/licensing/v1/product/Google-Apps/sku/1010020027/user/user1@sgnldemos.com",
+					"userId": "user1@sgnldemos.com",
+					"productId": "Google-Apps",
+					"productName": "Google Workspace",
+					"skuId": "1010020027",
+					"skuName": "Google Workspace Business Starter"
+				},
+				{
+					"kind": "licensing#licenseAssignment",
+					"etags": "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/KzTvJsxm3aB1qN5u8nSgrvA0Kgc\"",
+					"selfLink": "https://licensing.googleapis.com/apps/licensing/v1/product/Google-Apps/sku/1010020027/user/user2@sgnldemos.com",
+					"userId": "user2@sgnldemos.com",
+					"productId": "Google-Apps",
+					"productName": "Google Workspace",
+					"skuId": "1010020027",
+					"skuName": "Google Workspace Business Starter"
+				}
+			],
+			"nextPageToken": "TGljZW5zZVBhZ2Uy"
+		}`))
+	case "/apps/licensing/v1/product/Google-Apps/sku/1010020027/users?customerId=C01234567&maxResults=2&pageToken=TGljZW5zZVBhZ2Uy":
+		w.Write([]byte(`{
+			"kind": "licensing#licenseAssignmentList",
+			"etag": "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/3sQ2mS0o9bLxZ8yN1kF0r4hHn7g\"",
+			"items": [
+				{
+					"kind": "licensing#licenseAssignment",
+					"etags": "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/KzTvJsxm3aB1qN5u8nSgrvA0Kgc\"",
+					"selfLink": "https://licensing.googleapis.com/apps/licensing/v1/product/Google-Apps/sku/1010020027/user/sor-dev@sgnldemos.com",
+					"userId": "sor-dev@sgnldemos.com",
+					"productId": "Google-Apps",
+					"productName": "Google Workspace",
+					"skuId": "1010020027",
+					"skuName": "Google Workspace Business Starter"
+				}
+			]
+		}`))
+	case "/apps/licensing/v1/product/101034/users?customerId=C01234567&maxResults=2":
+		w.Write([]byte(`{
+			"kind": "licensing#licenseAssignmentList",
+			"etag": "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/a9QfTQ0y6ZrW3bKp2yVvN8mYl1s\"",
+			"items": [
+				{
+					"kind": "licensing#licenseAssignment",
+					"etags": "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/KzTvJsxm3aB1qN5u8nSgrvA0Kgc\"",
+					"selfLink": "https://licensing.googleapis.com/apps/licensing/v1/product/101034/sku/1010340001/user/user1@sgnldemos.com",
+					"userId": "user1@sgnldemos.com",
+					"productId": "101034",
+					"productName": "Google Vault",
+					"skuId": "1010340001",
+					"skuName": "Google Vault"
+				}
+			]
+		}`))
+
 	default:
 		w.WriteHeader(http.StatusNotFound)
 		w.Write([]byte(``))
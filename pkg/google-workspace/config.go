@@ -28,7 +28,7 @@ var supportedRoles = map[string]struct{}{
     "requestTimeoutSeconds": 10,
     "localTimeZoneOffset": 43200,
     "apiVersion": "v1",
-	"domain": "sgnldemos.com",
+	"domains": ["sgnldemos.com", "sgnldemos.net"],
 	"filters": {
 		"user": {
 			"showDeleted": true,
@@ -40,6 +40,12 @@ var supportedRoles = map[string]struct{}{
 		"member": {
 			"includeDerivedMembership": false,
 			"roles": "MEMBER"
+		},
+		"licenseAssignment": {
+			"products": [
+				{"productId": "Google-Apps", "skuIds": ["1010020027", "1010020028"]},
+				{"productId": "101034"}
+			]
 		}
 	}
 }
@@ -51,22 +57,34 @@ type Config struct {
 	// APIVersion is the version of the Google Workspace API to use.
 	APIVersion string `json:"apiVersion"`
 
-	// Note: Only one of Customer or Domain should be set.
-	// The unique ID for the customer's Google Workspace account. In case of a multi-domain account,
-	// to fetch all entities for a customer, use this field instead of domain.
+	// Note: Only one of Customer, Domain or Domains should be set.
+	// The unique ID for the customer's Google Workspace account, or "my_customer" for the account of the
+	// authenticated administrator. In case of a multi-domain account, to fetch all entities for a customer,
+	// use this field instead of domain.
 	Customer *string `json:"customer"`
 
-	// Note: Only one of Customer or Domain should be set.
+	// Note: Only one of Customer, Domain or Domains should be set.
 	// Use this field to get entities from only one domain.
 	Domain *string `json:"domain"`
 
+	// Note: Only one of Customer, Domain or Domains should be set.
+	// Use this field to get entities from some of the domains of a multi-domain account. The entities
+	// of each domain are synced one domain at a time, see DomainCursor.
+	Domains []string `json:"domains"`
+
 	Filters Filters `json:"filters"`
+
+	// LicensingAddress is the address of the Enterprise License Manager API, queried by the LicenseAssignment
+	// entity instead of the address of the datasource. Default: https://licensing.googleapis.com.
+	LicensingAddress *string `json:"licensingAddress"`
 }
 
 type Filters struct {
 	UserFilters   *UserFilters   `json:"user"`
 	GroupFilters  *GroupFilters  `json:"group"`
 	MemberFilters *MemberFilters `json:"member"`
+
+	LicenseAssignmentFilters *LicenseAssignmentFilters `json:"licenseAssignment"`
 }
 
 type UserFilters struct {
@@ -92,6 +110,23 @@ type MemberFilters struct {
 	Roles *string `json:"roles"`
 }
 
+type LicenseAssignmentFilters struct {
+	// Products are the products, and optionally the SKUs, whose license assignments are synced by the
+	// LicenseAssignment entity. The license assignments of each product or SKU are synced one at a time,
+	// in the order of the config, see LicenseCollectionIDs.
+	// Products and SKUs: https://developers.google.com/admin-sdk/licensing/v1/how-tos/products
+	Products []LicenseProduct `json:"products"`
+}
+
+type LicenseProduct struct {
+	// ProductID is the ID of the product, e.g. "Google-Apps" for Google Workspace.
+	ProductID string `json:"productId"`
+
+	// SKUIDs are the IDs of the SKUs of the product, e.g. "1010020027" for Google Workspace Business Starter.
+	// The license assignments of all the SKUs of the product are synced if not set.
+	SKUIDs []string `json:"skuIds"`
+}
+
 // ValidateConfig validates that a Config received in a GetPage call is valid.
 func (c *Config) Validate(_ context.Context) error {
 	switch {
@@ -99,21 +134,62 @@ func (c *Config) Validate(_ context.Context) error {
 		return errors.New("request contains no config")
 	case c.APIVersion == "":
 		return errors.New("apiVersion is not set")
-	case c.Customer == nil && c.Domain == nil:
-		return errors.New("customer or domain must be set")
-	case c.Customer != nil && c.Domain != nil:
-		return errors.New("One of customer or domain must be set")
+	case c.Customer == nil && c.Domain == nil && c.Domains == nil:
+		return errors.New("customer, domain or domains must be set")
+	case c.Customer != nil && c.Domain != nil,
+		c.Domains != nil && (c.Customer != nil || c.Domain != nil):
+		return errors.New("only one of customer, domain or domains must be set")
 	default:
 		if _, found := supportedAPIVersions[c.APIVersion]; !found {
 			return fmt.Errorf("apiVersion is not supported: %v", c.APIVersion)
 		}
 
+		if c.Domains != nil && len(c.Domains) == 0 {
+			return errors.New("domains must not be empty")
+		}
+
+		for _, domain := range c.Domains {
+			if domain == "" {
+				return errors.New("domains must not contain an empty domain")
+			}
+		}
+
 		if c.Filters.MemberFilters != nil && c.Filters.MemberFilters.Roles != nil {
 			if _, found := supportedRoles[*c.Filters.MemberFilters.Roles]; !found {
 				return fmt.Errorf("filters.member.roles is set to an unsupported value: %v", *c.Filters.MemberFilters.Roles)
 			}
 		}
 
+		if c.LicensingAddress != nil && *c.LicensingAddress == "" {
+			return errors.New("licensingAddress must not be empty")
+		}
+
+		if c.Filters.LicenseAssignmentFilters != nil {
+			return c.Filters.LicenseAssignmentFilters.Validate()
+		}
+
 		return nil
 	}
 }
+
+// Validate validates the products and SKUs of the LicenseAssignment filters.
+func (f *LicenseAssignmentFilters) Validate() error {
+	if len(f.Products) == 0 {
+		return errors.New("filters.licenseAssignment.products must not be empty")
+	}
+
+	for _, product := range f.Products {
+		if product.ProductID == "" {
+			return errors.New("filters.licenseAssignment.products must not contain an empty productId")
+		}
+
+		for _, skuID := range product.SKUIDs {
+			if skuID == "" {
+				return fmt.Errorf("filters.licenseAssignment.products.skuIds of %s must not contain an empty SKU ID",
+					product.ProductID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -4,19 +4,16 @@ package googleworkspace
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
-	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
-	"go.uber.org/zap"
 )
 
 // Datasource directly implements a Client interface to allow querying an external datasource.
@@ -29,6 +26,8 @@ type DatasourceResponse struct {
 	Users   []map[string]interface{} `json:"users"`
 	Groups  []map[string]interface{} `json:"groups"`
 	Members []map[string]interface{} `json:"members"`
+	// Items are the license assignments of the LicenseAssignment entity.
+	Items []map[string]interface{} `json:"items"`
 }
 
 // Google Workspace API response template.
@@ -77,9 +76,10 @@ type Entity struct {
 }
 
 const (
-	User   = "User"
-	Group  = "Group"
-	Member = "Member"
+	User              = "User"
+	Group             = "Group"
+	Member            = "Member"
+	LicenseAssignment = "LicenseAssignment"
 )
 
 var (
@@ -108,6 +108,15 @@ var (
 			UniqueIDAttribute:  "uniqueId",
 			RequiredAttributes: []string{"id", "groupId"},
 		},
+		// The license assignments of a product or SKU, queried with the Enterprise License Manager API.
+		// A license assignment has no ID, so its uniqueId is "{productId}/{skuId}/{userId}".
+		LicenseAssignment: {
+			// Example URI: /apps/licensing/{{APIVersion}}/product/{{productId}}/sku/{{skuId}}/users
+			// or /apps/licensing/{{APIVersion}}/product/{{productId}}/users
+			Path:              "/apps/licensing/%s/%s/users",
+			MaxPageSize:       1000,
+			UniqueIDAttribute: "uniqueId",
+		},
 	}
 )
 
@@ -181,10 +190,16 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		}
 	}
 
+	if request.EntityExternalID == LicenseAssignment {
+		if licenseErr := setLicenseCursor(request); licenseErr != nil {
+			return nil, licenseErr
+		}
+	}
+
 	if validationErr := pagination.ValidateCompositeCursor(
 		request.Cursor,
 		request.EntityExternalID,
-		request.EntityExternalID == Member,
+		request.EntityExternalID == Member || request.EntityExternalID == LicenseAssignment,
 	); validationErr != nil {
 		return nil, validationErr
 	}
@@ -194,70 +209,42 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		return nil, endpointErr
 	}
 
-	// Timeout API calls that take longer than the configured timeout.
-	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
-	defer cancel()
+	var (
+		objects    []map[string]any
+		nextCursor *pagination.CompositeCursor[string]
+	)
 
-	req, err := http.NewRequestWithContext(apiCtx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL:                   endpoint,
+		Header:                http.Header{"Authorization": {request.Token}},
+		DatasourceName:        "Google Workspace",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Google Workspace")
+		if readErr != nil {
+			return readErr
 		}
-	}
 
-	req.Header.Add("Authorization", request.Token)
+		var parseErr *framework.Error
 
-	logger.Info("Sending request to datasource", fields.RequestURL(endpoint))
-
-	res, err := d.Client.Do(req)
-	if err != nil {
-		logger.Error("Request to datasource failed",
-			fields.RequestURL(endpoint),
-			fields.SGNLEventTypeError(),
-			zap.Error(err),
-		)
+		objects, nextCursor, parseErr = ParseResponse(bodyBytes, request)
 
-		return nil, customerror.UpdateError(&framework.Error{
-			Message: fmt.Sprintf("Failed to execute Google Workspace request: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		},
-			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
-		)
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
 	}
 
-	defer res.Body.Close()
-
 	response := &Response{
-		StatusCode:       res.StatusCode,
-		RetryAfterHeader: res.Header.Get("Retry-After"),
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
 	}
 
-	if res.StatusCode != http.StatusOK {
-		logger.Error("Datasource responded with an error",
-			fields.RequestURL(endpoint),
-			fields.ResponseStatusCode(res.StatusCode),
-			fields.ResponseRetryAfterHeader(res.Header.Get("Retry-After")),
-			fields.ResponseBody(res.Body),
-			fields.SGNLEventTypeError(),
-		)
-
+	if response.StatusCode != http.StatusOK {
 		return response, nil
 	}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to read Google Workspace response body: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
-	objects, nextCursor, frameworkErr := ParseResponse(body, request)
-	if frameworkErr != nil {
-		return nil, frameworkErr
-	}
-
 	response.NextCursor = nextCursor
 	response.Objects = objects
 
@@ -275,13 +262,10 @@ func ParseResponse(body []byte, request *Request) (
 	nextCursor *pagination.CompositeCursor[string],
 	err *framework.Error,
 ) {
-	var response *DatasourceResponse
+	var response DatasourceResponse
 
-	if unmarshalErr := json.Unmarshal(body, &response); unmarshalErr != nil || response == nil {
-		return nil, nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", unmarshalErr),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
+	if unmarshalErr := httpds.UnmarshalJSON(body, &response); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
 	}
 
 	if response.Error != nil {
@@ -315,6 +299,30 @@ func ParseResponse(body []byte, request *Request) (
 			objects[idx]["uniqueId"] = fmt.Sprintf("%s-%s", *request.Cursor.CollectionID, memberID)
 			objects[idx]["groupId"] = *request.Cursor.CollectionID
 		}
+	case LicenseAssignment:
+		objects = response.Items
+
+		// Post-processing for the LicenseAssignment entity.
+		for idx, assignment := range objects {
+			productID, productOK := assignment["productId"].(string)
+			skuID, skuOK := assignment["skuId"].(string)
+			userID, userOK := assignment["userId"].(string)
+
+			if !productOK || !skuOK || !userOK {
+				return nil, nil, &framework.Error{
+					Message: fmt.Sprintf(
+						"Failed to parse 'productId', 'skuId' and 'userId' fields in LicenseAssignment response as "+
+							"strings, actual values: %v, %v, %v.",
+						assignment["productId"], assignment["skuId"], assignment["userId"],
+					),
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				}
+			}
+
+			objects[idx]["uniqueId"] = fmt.Sprintf("%s/%s/%s", productID, skuID, userID)
+		}
+
+		return objects, nextLicenseCursor(response.NextPageToken, request.Cursor, request.LicenseAssignmentFilters), nil
 	default:
 		return nil, nil, &framework.Error{
 			Message: fmt.Sprintf("Entity ID %v is not supported.", request.EntityExternalID),
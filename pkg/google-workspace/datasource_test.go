@@ -724,3 +724,193 @@ func TestGetMembersPage(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLicenseAssignmentsPage(t *testing.T) {
+	client := &http.Client{
+		Timeout: time.Duration(60) * time.Second,
+	}
+
+	googleworkspaceClient := googleworkspace.NewClient(client)
+	server := httptest.NewServer(TestServerHandler)
+
+	filters := googleworkspace.Filters{
+		LicenseAssignmentFilters: &googleworkspace.LicenseAssignmentFilters{
+			Products: []googleworkspace.LicenseProduct{
+				{ProductID: "Google-Apps", SKUIDs: []string{"1010020027"}},
+				{ProductID: "101034"},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		context context.Context
+		request *googleworkspace.Request
+		wantRes *googleworkspace.Response
+		wantErr *framework.Error
+	}{
+		"first_page": {
+			context: context.Background(),
+			request: &googleworkspace.Request{
+				Token:                 "Bearer Testtoken",
+				BaseURL:               server.URL,
+				EntityExternalID:      "LicenseAssignment",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				Customer:              testutil.GenPtr("C01234567"),
+				APIVersion:            "v1",
+				Filters:               filters,
+			},
+			wantRes: &googleworkspace.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"kind":        "licensing#licenseAssignment",
+						"etags":       "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/KzTvJsxm3aB1qN5u8nSgrvA0Kgc\"",
+						"selfLink":    "https://licensing.googleapis.com/apps/licensing/v1/product/Google-Apps/sku/1010020027/user/user1@sgnldemos.com",
+						"userId":      "user1@sgnldemos.com",
+						"productId":   "Google-Apps",
+						"productName": "Google Workspace",
+						"skuId":       "1010020027",
+						"skuName":     "Google Workspace Business Starter",
+						"uniqueId":    "Google-Apps/1010020027/user1@sgnldemos.com",
+					},
+					{
+						"kind":        "licensing#licenseAssignment",
+						"etags":       "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/KzTvJsxm3aB1qN5u8nSgrvA0Kgc\"",
+						"selfLink":    "https://licensing.googleapis.com/apps/licensing/v1/product/Google-Apps/sku/1010020027/user/user2@sgnldemos.com",
+						"userId":      "user2@sgnldemos.com",
+						"productId":   "Google-Apps",
+						"productName": "Google Workspace",
+						"skuId":       "1010020027",
+						"skuName":     "Google Workspace Business Starter",
+						"uniqueId":    "Google-Apps/1010020027/user2@sgnldemos.com",
+					},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor:       testutil.GenPtr("TGljZW5zZVBhZ2Uy"),
+					CollectionID: testutil.GenPtr("Google-Apps/1010020027"),
+				},
+			},
+		},
+		"last_page_of_sku": {
+			context: context.Background(),
+			request: &googleworkspace.Request{
+				Token:                 "Bearer Testtoken",
+				BaseURL:               server.URL,
+				EntityExternalID:      "LicenseAssignment",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				Customer:              testutil.GenPtr("C01234567"),
+				APIVersion:            "v1",
+				Filters:               filters,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor:       testutil.GenPtr("TGljZW5zZVBhZ2Uy"),
+					CollectionID: testutil.GenPtr("Google-Apps/1010020027"),
+				},
+			},
+			wantRes: &googleworkspace.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"kind":        "licensing#licenseAssignment",
+						"etags":       "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/KzTvJsxm3aB1qN5u8nSgrvA0Kgc\"",
+						"selfLink":    "https://licensing.googleapis.com/apps/licensing/v1/product/Google-Apps/sku/1010020027/user/sor-dev@sgnldemos.com",
+						"userId":      "sor-dev@sgnldemos.com",
+						"productId":   "Google-Apps",
+						"productName": "Google Workspace",
+						"skuId":       "1010020027",
+						"skuName":     "Google Workspace Business Starter",
+						"uniqueId":    "Google-Apps/1010020027/sor-dev@sgnldemos.com",
+					},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("101034"),
+				},
+			},
+		},
+		"last_page_of_product": {
+			context: context.Background(),
+			request: &googleworkspace.Request{
+				Token:                 "Bearer Testtoken",
+				BaseURL:               server.URL,
+				EntityExternalID:      "LicenseAssignment",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				Customer:              testutil.GenPtr("C01234567"),
+				APIVersion:            "v1",
+				Filters:               filters,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("101034"),
+				},
+			},
+			wantRes: &googleworkspace.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"kind":        "licensing#licenseAssignment",
+						"etags":       "\"nZcnZvJxXmN5aU6HBqgqYl8i5Ws/KzTvJsxm3aB1qN5u8nSgrvA0Kgc\"",
+						"selfLink":    "https://licensing.googleapis.com/apps/licensing/v1/product/101034/sku/1010340001/user/user1@sgnldemos.com",
+						"userId":      "user1@sgnldemos.com",
+						"productId":   "101034",
+						"productName": "Google Vault",
+						"skuId":       "1010340001",
+						"skuName":     "Google Vault",
+						"uniqueId":    "101034/1010340001/user1@sgnldemos.com",
+					},
+				},
+				NextCursor: nil,
+			},
+		},
+		"invalid_collection_id": {
+			context: context.Background(),
+			request: &googleworkspace.Request{
+				Token:                 "Bearer Testtoken",
+				BaseURL:               server.URL,
+				EntityExternalID:      "LicenseAssignment",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				Customer:              testutil.GenPtr("C01234567"),
+				APIVersion:            "v1",
+				Filters:               filters,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("Google-Apps/1010020028"),
+				},
+			},
+			wantErr: pagination.NewCursorError(
+				"LicenseAssignment",
+				pagination.CompositeCursorShape[string](true),
+				"collectionId Google-Apps/1010020028 is not a configured product or SKU",
+			),
+		},
+		"missing_filters": {
+			context: context.Background(),
+			request: &googleworkspace.Request{
+				Token:                 "Bearer Testtoken",
+				BaseURL:               server.URL,
+				EntityExternalID:      "LicenseAssignment",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				Customer:              testutil.GenPtr("C01234567"),
+				APIVersion:            "v1",
+			},
+			wantErr: &framework.Error{
+				Message: "filters.licenseAssignment.products must be set to query the LicenseAssignment entity.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := googleworkspaceClient.GetPage(tt.context, tt.request)
+
+			if diff := cmp.Diff(tt.wantRes, gotRes); diff != "" {
+				t.Errorf("Mismatch (-want +got):\n%s", diff)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package googleworkspace
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// domainCursorShape is the expected JSON shape of a DomainCursor, used in cursor errors.
+const domainCursorShape = `{"domainIndex":<int>,"cursor":{"cursor":<string>,"collectionId":<string>,` +
+	`"collectionCursor":<string>}}`
+
+// DomainCursor is the cursor of the requests of a config with multiple domains. The entities of each domain
+// are synced one domain at a time, in the order of the domains in the config.
+type DomainCursor struct {
+	// DomainIndex is the index in Config.Domains of the domain of the next page.
+	DomainIndex int `json:"domainIndex"`
+
+	// Cursor is the cursor of the next page within the domain. nil for the first page of the domain.
+	Cursor *pagination.CompositeCursor[string] `json:"cursor,omitempty"`
+}
+
+// MarshalDomainCursor marshals the struct and b64 encodes it.
+func MarshalDomainCursor(cursor *DomainCursor) (string, *framework.Error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	nextCursorBytes, marshalErr := json.Marshal(cursor)
+	if marshalErr != nil {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal domain cursor into JSON: %v.", marshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(nextCursorBytes), nil
+}
+
+// UnmarshalDomainCursor decodes the b64 encoded string and unmarshals it, and validates the domain index
+// against the number of domains in the config.
+func UnmarshalDomainCursor(cursor string, entityExternalID string, domainCount int) (*DomainCursor, *framework.Error) {
+	domainCursor := &DomainCursor{}
+	if cursor == "" {
+		return domainCursor, nil
+	}
+
+	domainCursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, domainCursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	if err := json.Unmarshal(domainCursorBytes, domainCursor); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, domainCursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	if domainCursor.DomainIndex < 0 || domainCursor.DomainIndex >= domainCount {
+		return nil, pagination.NewCursorError(
+			entityExternalID,
+			domainCursorShape,
+			fmt.Sprintf("domainIndex %d is out of range for %d configured domains", domainCursor.DomainIndex, domainCount),
+		)
+	}
+
+	return domainCursor, nil
+}
+
+// nextDomainCursor returns the cursor of the page following the current page, given the cursor of the next
+// page within the current domain. nil is returned after the last page of the last domain.
+func nextDomainCursor(
+	current *DomainCursor, nextCursor *pagination.CompositeCursor[string], domainCount int,
+) *DomainCursor {
+	if nextCursor != nil {
+		return &DomainCursor{DomainIndex: current.DomainIndex, Cursor: nextCursor}
+	}
+
+	if current.DomainIndex+1 >= domainCount {
+		return nil
+	}
+
+	return &DomainCursor{DomainIndex: current.DomainIndex + 1}
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package googleworkspace_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	googleworkspace "github.com/sgnl-ai/adapters/pkg/google-workspace"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// domainClient returns a page with the next cursor, and records the requests.
+type domainClient struct {
+	nextCursor  *pagination.CompositeCursor[string]
+	gotRequests []*googleworkspace.Request
+}
+
+func (c *domainClient) GetPage(_ context.Context, request *googleworkspace.Request) (*googleworkspace.Response, *framework.Error) {
+	c.gotRequests = append(c.gotRequests, request)
+
+	return &googleworkspace.Response{
+		StatusCode: http.StatusOK,
+		Objects:    []map[string]any{{"id": "USER987654321"}},
+		NextCursor: c.nextCursor,
+	}, nil
+}
+
+func TestAdapterGetPageWithDomains(t *testing.T) {
+	tests := map[string]struct {
+		cursor         *googleworkspace.DomainCursor
+		respNextCursor *pagination.CompositeCursor[string]
+		wantDomain     string
+		wantCursor     *pagination.CompositeCursor[string]
+		wantNextCursor *googleworkspace.DomainCursor
+		wantErr        *framework.Error
+	}{
+		"first_page": {
+			respNextCursor: &pagination.CompositeCursor[string]{Cursor: testutil.GenPtr("page2")},
+			wantDomain:     "sgnldemos.com",
+			wantNextCursor: &googleworkspace.DomainCursor{
+				Cursor: &pagination.CompositeCursor[string]{Cursor: testutil.GenPtr("page2")},
+			},
+		},
+		"last_page_of_first_domain": {
+			cursor: &googleworkspace.DomainCursor{
+				Cursor: &pagination.CompositeCursor[string]{Cursor: testutil.GenPtr("page2")},
+			},
+			wantDomain:     "sgnldemos.com",
+			wantCursor:     &pagination.CompositeCursor[string]{Cursor: testutil.GenPtr("page2")},
+			wantNextCursor: &googleworkspace.DomainCursor{DomainIndex: 1},
+		},
+		"last_page_of_last_domain": {
+			cursor:     &googleworkspace.DomainCursor{DomainIndex: 1},
+			wantDomain: "sgnldemos.net",
+		},
+		"domain_index_out_of_range": {
+			cursor: &googleworkspace.DomainCursor{DomainIndex: 2},
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity User: domainIndex 2 is out of range for 2 configured domains. " +
+					`Expected cursor shape: {"domainIndex":<int>,"cursor":{"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}}. ` +
+					"Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &domainClient{nextCursor: tt.respNextCursor}
+			adapter := googleworkspace.NewAdapter(client)
+
+			cursor, err := googleworkspace.MarshalDomainCursor(tt.cursor)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response := adapter.GetPage(context.Background(), &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer Testtoken",
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Domains:    []string{"sgnldemos.com", "sgnldemos.net"},
+				},
+				Entity:   *PopulateDefaultUserEntityConfig(),
+				PageSize: 1,
+				Cursor:   cursor,
+			})
+
+			if !reflect.DeepEqual(response.Error, tt.wantErr) {
+				t.Fatalf("gotErr: %v, wantErr: %v", response.Error, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if gotDomain := *client.gotRequests[0].Domain; gotDomain != tt.wantDomain {
+				t.Errorf("gotDomain: %v, wantDomain: %v", gotDomain, tt.wantDomain)
+			}
+
+			if !reflect.DeepEqual(client.gotRequests[0].Cursor, tt.wantCursor) {
+				t.Errorf("gotCursor: %v, wantCursor: %v", client.gotRequests[0].Cursor, tt.wantCursor)
+			}
+
+			wantNextCursor, err := googleworkspace.MarshalDomainCursor(tt.wantNextCursor)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if response.Success.NextCursor != wantNextCursor {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", response.Success.NextCursor, wantNextCursor)
+			}
+		})
+	}
+}
@@ -29,23 +29,32 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 	// [User]: https://admin.googleapis.com/admin/directory/v1/users?domain=sgnldemos.com&maxResults=500
 	// [Group]: https://admin.googleapis.com/admin/directory/v1/groups?domain=sgnldemos.com&maxResults=500
 	// [Member]: https://admin.googleapis.com/admin/directory/v1/groups/0300/members?domain=sgnldemos.com&maxResults=2
+	// [LicenseAssignment]:
+	// https://licensing.googleapis.com/apps/licensing/v1/product/Google-Apps/sku/1010020027/users?customerId=C01
 
 	var sb strings.Builder
 
 	sb.Grow(len(request.BaseURL) + len(ValidEntityExternalIDs[request.EntityExternalID].Path) + 5)
 	sb.WriteString(request.BaseURL)
 
-	if request.EntityExternalID == Member {
+	switch request.EntityExternalID {
+	case Member, LicenseAssignment:
 		if request.Cursor == nil || request.Cursor.CollectionID == nil {
 			return "", &framework.Error{
-				Message: "Collection ID is nil for Member entity, unable to form request URI.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				Message: fmt.Sprintf("Collection ID is nil for %s entity, unable to form request URI.",
+					request.EntityExternalID),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 			}
 		}
 
+		collectionPath := *request.Cursor.CollectionID
+		if request.EntityExternalID == LicenseAssignment {
+			collectionPath = licenseCollectionPath(collectionPath)
+		}
+
 		sb.WriteString(fmt.Sprintf(ValidEntityExternalIDs[request.EntityExternalID].Path,
-			request.APIVersion, *request.Cursor.CollectionID))
-	} else {
+			request.APIVersion, collectionPath))
+	default:
 		sb.WriteString(fmt.Sprintf(ValidEntityExternalIDs[request.EntityExternalID].Path, request.APIVersion))
 	}
 
@@ -56,12 +65,15 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 		params.Add("pageToken", *request.Cursor.Cursor)
 	}
 
-	if request.Customer != nil {
-		params.Add("customer", *request.Customer)
-	}
+	// The Enterprise License Manager API is scoped with the customerId parameter instead.
+	if request.EntityExternalID != LicenseAssignment {
+		if request.Customer != nil {
+			params.Add("customer", *request.Customer)
+		}
 
-	if request.Domain != nil {
-		params.Add("domain", *request.Domain)
+		if request.Domain != nil {
+			params.Add("domain", *request.Domain)
+		}
 	}
 
 	switch request.EntityExternalID {
@@ -71,6 +83,8 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 		AddGroupParams(&params, request)
 	case Member:
 		AddMemberParams(&params, request)
+	case LicenseAssignment:
+		AddLicenseAssignmentParams(&params, request)
 	default:
 		return "", &framework.Error{
 			Message: fmt.Sprintf("Entity ID %v is not supported.", request.EntityExternalID),
@@ -122,3 +136,12 @@ func AddMemberParams(params *url.Values, request *Request) {
 		params.Add("includeDerivedMembership", strconv.FormatBool(request.MemberFilters.IncludeDerivedMembership))
 	}
 }
+
+func AddLicenseAssignmentParams(params *url.Values, request *Request) {
+	// The customer ID, or the primary domain of the customer if only a domain is configured.
+	if request.Customer != nil {
+		params.Add("customerId", *request.Customer)
+	} else if request.Domain != nil {
+		params.Add("customerId", *request.Domain)
+	}
+}
@@ -287,6 +287,47 @@ func TestConstructEndpoint(t *testing.T) {
 			},
 			wantEndpoint: "https://admin.googleapis.com/admin/directory/v1/groups/collectionId/members?domain=sgnldemos.com&includeDerivedMembership=true&maxResults=100&pageToken=nextPage&roles=ADMIN",
 		},
+		"nil_collection_id_license_assignment_entity": {
+			request: &googleworkspace.Request{
+				BaseURL:          "https://licensing.googleapis.com",
+				APIVersion:       "v1",
+				PageSize:         100,
+				EntityExternalID: "LicenseAssignment",
+				Customer:         testutil.GenPtr("C01234567"),
+				Cursor:           &pagination.CompositeCursor[string]{},
+			},
+			wantError: &framework.Error{
+				Message: "Collection ID is nil for LicenseAssignment entity, unable to form request URI.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"license_assignment_entity_sku": {
+			request: &googleworkspace.Request{
+				BaseURL:          "https://licensing.googleapis.com",
+				APIVersion:       "v1",
+				PageSize:         100,
+				EntityExternalID: "LicenseAssignment",
+				Customer:         testutil.GenPtr("C01234567"),
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("Google-Apps/1010020027"),
+					Cursor:       testutil.GenPtr("nextPage"),
+				},
+			},
+			wantEndpoint: "https://licensing.googleapis.com/apps/licensing/v1/product/Google-Apps/sku/1010020027/users?customerId=C01234567&maxResults=100&pageToken=nextPage",
+		},
+		"license_assignment_entity_product_with_domain": {
+			request: &googleworkspace.Request{
+				BaseURL:          "https://licensing.googleapis.com",
+				APIVersion:       "v1",
+				PageSize:         100,
+				EntityExternalID: "LicenseAssignment",
+				Domain:           testutil.GenPtr("sgnldemos.com"),
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("101034"),
+				},
+			},
+			wantEndpoint: "https://licensing.googleapis.com/apps/licensing/v1/product/101034/users?customerId=sgnldemos.com&maxResults=100",
+		},
 	}
 
 	for name, tt := range tests {
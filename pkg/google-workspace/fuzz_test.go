@@ -0,0 +1,48 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package googleworkspace_test
+
+import (
+	"testing"
+
+	googleworkspace "github.com/sgnl-ai/adapters/pkg/google-workspace"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// FuzzParseResponse checks that malformed Google Workspace responses are rejected with an error instead of a
+// panic, for all the entities.
+func FuzzParseResponse(f *testing.F) {
+	entityExternalIDs := []string{
+		googleworkspace.User,
+		googleworkspace.Group,
+		googleworkspace.Member,
+	}
+
+	for _, seed := range []string{
+		`{"kind":"admin#directory#users","users":[{"id":"100000000000000000001","primaryEmail":"john@sgnldemos.com"}],"nextPageToken":"Q0FFUzJ3R0"}`,
+		`{"kind":"admin#directory#members","members":[{"id":"100000000000000000001","role":"OWNER"}]}`,
+		`{"error":{"code":403,"message":"Not Authorized to access this resource/api"}}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), uint8(0))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, entityIndex uint8) {
+		request := &googleworkspace.Request{
+			EntityExternalID: entityExternalIDs[int(entityIndex)%len(entityExternalIDs)],
+			Cursor: &pagination.CompositeCursor[string]{
+				CollectionID: testutil.GenPtr("01234567"),
+			},
+		}
+
+		_, _, err := googleworkspace.ParseResponse(body, request)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for entity %s and body %q", request.EntityExternalID, body)
+		}
+	})
+}
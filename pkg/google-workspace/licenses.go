@@ -0,0 +1,110 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package googleworkspace
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// DefaultLicensingAddress is the address of the Enterprise License Manager API, queried by the LicenseAssignment
+// entity if Config.LicensingAddress is not set.
+const DefaultLicensingAddress = "https://licensing.googleapis.com"
+
+// LicenseCollectionIDs returns the IDs of the products and SKUs of the filters, in the order their license
+// assignments are synced. The ID of a SKU is "{productId}/{skuId}", and the ID of a product without SKUs is
+// "{productId}". The CollectionID of the cursor of the LicenseAssignment entity is the ID of the current
+// product or SKU.
+func LicenseCollectionIDs(filters *LicenseAssignmentFilters) []string {
+	if filters == nil {
+		return nil
+	}
+
+	collectionIDs := make([]string, 0, len(filters.Products))
+
+	for _, product := range filters.Products {
+		if len(product.SKUIDs) == 0 {
+			collectionIDs = append(collectionIDs, product.ProductID)
+
+			continue
+		}
+
+		for _, skuID := range product.SKUIDs {
+			collectionIDs = append(collectionIDs, product.ProductID+"/"+skuID)
+		}
+	}
+
+	return collectionIDs
+}
+
+// licenseCollectionPath returns the path of a product or SKU in the Enterprise License Manager API, e.g.
+// "product/Google-Apps/sku/1010020027", given its collection ID.
+func licenseCollectionPath(collectionID string) string {
+	productID, skuID, hasSKU := strings.Cut(collectionID, "/")
+	if !hasSKU {
+		return "product/" + url.PathEscape(productID)
+	}
+
+	return "product/" + url.PathEscape(productID) + "/sku/" + url.PathEscape(skuID)
+}
+
+// setLicenseCursor sets the cursor of a LicenseAssignment request to the first product or SKU of the filters
+// for the first page, and validates the collection ID of the cursor of the other pages.
+func setLicenseCursor(request *Request) *framework.Error {
+	collectionIDs := LicenseCollectionIDs(request.LicenseAssignmentFilters)
+	if len(collectionIDs) == 0 {
+		return &framework.Error{
+			Message: "filters.licenseAssignment.products must be set to query the LicenseAssignment entity.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	if request.Cursor == nil {
+		request.Cursor = &pagination.CompositeCursor[string]{
+			CollectionID: &collectionIDs[0],
+		}
+
+		return nil
+	}
+
+	if request.Cursor.CollectionID != nil && !slices.Contains(collectionIDs, *request.Cursor.CollectionID) {
+		return pagination.NewCursorError(
+			request.EntityExternalID,
+			pagination.CompositeCursorShape[string](true),
+			fmt.Sprintf("collectionId %s is not a configured product or SKU", *request.Cursor.CollectionID),
+		)
+	}
+
+	return nil
+}
+
+// nextLicenseCursor returns the cursor of the page following the current page of license assignments.
+// The next page is the next page of the current product or SKU if nextPageToken is set, or else the first page
+// of the next product or SKU. nil is returned after the last page of the last product or SKU.
+func nextLicenseCursor(
+	nextPageToken *string, currentCursor *pagination.CompositeCursor[string], filters *LicenseAssignmentFilters,
+) *pagination.CompositeCursor[string] {
+	if nextPageToken != nil {
+		return &pagination.CompositeCursor[string]{
+			Cursor:       nextPageToken,
+			CollectionID: currentCursor.CollectionID,
+		}
+	}
+
+	collectionIDs := LicenseCollectionIDs(filters)
+
+	idx := slices.Index(collectionIDs, *currentCursor.CollectionID)
+	if idx < 0 || idx+1 >= len(collectionIDs) {
+		return nil
+	}
+
+	return &pagination.CompositeCursor[string]{
+		CollectionID: &collectionIDs[idx+1],
+	}
+}
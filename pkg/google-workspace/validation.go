@@ -34,6 +34,21 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		request.Address = trimmedAddress
 	}
 
+	if request.Config.LicensingAddress != nil {
+		trimmedLicensingAddress, parsedLicensing, err := validation.ParseAndValidateAddress(
+			*request.Config.LicensingAddress, []string{"https"},
+		)
+		if err != nil {
+			return err
+		}
+
+		if parsedLicensing.Scheme == "" {
+			trimmedLicensingAddress = "https://" + trimmedLicensingAddress
+		}
+
+		request.Config.LicensingAddress = &trimmedLicensingAddress
+	}
+
 	if request.Auth == nil || request.Auth.HTTPAuthorization == "" {
 		return &framework.Error{
 			Message: "Provided datasource auth is missing required http authorization credentials.",
@@ -55,6 +70,23 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
+	if request.Entity.ExternalId == LicenseAssignment {
+		if request.Config.Filters.LicenseAssignmentFilters == nil {
+			return &framework.Error{
+				Message: "filters.licenseAssignment.products must be set to query the LicenseAssignment entity.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+
+		// The Enterprise License Manager API lists the license assignments of a whole customer account.
+		if request.Config.Domains != nil {
+			return &framework.Error{
+				Message: "The LicenseAssignment entity requires customer or domain to be set instead of domains.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+	}
+
 	var uniqueIDAttributeFound bool
 
 	for _, attribute := range request.Entity.Attributes {
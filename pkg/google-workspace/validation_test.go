@@ -188,7 +188,86 @@ func TestValidateGetPageRequest(t *testing.T) {
 				PageSize: 250,
 			},
 			wantErr: &framework.Error{
-				Message: "Google Workspace adapter config is invalid: customer or domain must be set.",
+				Message: "Google Workspace adapter config is invalid: customer, domain or domains must be set.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"valid_only_domains_set": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Domains:    []string{"sgnldemos.com", "sgnldemos.net"},
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: nil,
+		},
+		"invalid_domains_and_customer_set": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Customer:   testutil.GenPtr("my_customer"),
+					Domains:    []string{"sgnldemos.com"},
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Google Workspace adapter config is invalid: only one of customer, domain or domains must be set.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_empty_domains": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Domains:    []string{},
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Google Workspace adapter config is invalid: domains must not be empty.",
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
@@ -642,6 +721,260 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
+		"valid_license_assignment_entity": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LicenseAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uniqueId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Customer:   testutil.GenPtr("C01234567"),
+					Filters: googleworkspace.Filters{
+						LicenseAssignmentFilters: &googleworkspace.LicenseAssignmentFilters{
+							Products: []googleworkspace.LicenseProduct{
+								{ProductID: "Google-Apps", SKUIDs: []string{"1010020027"}},
+								{ProductID: "101034"},
+							},
+						},
+					},
+				},
+				PageSize: 1000,
+			},
+			wantErr: nil,
+		},
+		"valid_license_assignment_entity_licensing_address": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LicenseAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uniqueId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion:       "v1",
+					Customer:         testutil.GenPtr("C01234567"),
+					LicensingAddress: testutil.GenPtr("https://licensing.googleapis.com"),
+					Filters: googleworkspace.Filters{
+						LicenseAssignmentFilters: &googleworkspace.LicenseAssignmentFilters{
+							Products: []googleworkspace.LicenseProduct{
+								{ProductID: "Google-Apps", SKUIDs: []string{"1010020027"}},
+								{ProductID: "101034"},
+							},
+						},
+					},
+				},
+				PageSize: 1000,
+			},
+			wantErr: nil,
+		},
+		"invalid_license_assignment_entity_http_licensing_address": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LicenseAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uniqueId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion:       "v1",
+					Customer:         testutil.GenPtr("C01234567"),
+					LicensingAddress: testutil.GenPtr("http://licensing.googleapis.com"),
+					Filters: googleworkspace.Filters{
+						LicenseAssignmentFilters: &googleworkspace.LicenseAssignmentFilters{
+							Products: []googleworkspace.LicenseProduct{
+								{ProductID: "Google-Apps", SKUIDs: []string{"1010020027"}},
+								{ProductID: "101034"},
+							},
+						},
+					},
+				},
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_license_assignment_entity_missing_filters": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LicenseAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uniqueId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Customer:   testutil.GenPtr("C01234567"),
+				},
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "filters.licenseAssignment.products must be set to query the LicenseAssignment entity.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_license_assignment_entity_domains_set": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LicenseAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uniqueId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Domains:    []string{"sgnldemos.com", "sgnldemos.net"},
+					Filters: googleworkspace.Filters{
+						LicenseAssignmentFilters: &googleworkspace.LicenseAssignmentFilters{
+							Products: []googleworkspace.LicenseProduct{
+								{ProductID: "Google-Apps", SKUIDs: []string{"1010020027"}},
+								{ProductID: "101034"},
+							},
+						},
+					},
+				},
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "The LicenseAssignment entity requires customer or domain to be set instead of domains.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_license_assignment_empty_products": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LicenseAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uniqueId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Customer:   testutil.GenPtr("C01234567"),
+					Filters: googleworkspace.Filters{
+						LicenseAssignmentFilters: &googleworkspace.LicenseAssignmentFilters{},
+					},
+				},
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "Google Workspace adapter config is invalid: filters.licenseAssignment.products must not be empty.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_license_assignment_empty_sku_id": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LicenseAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uniqueId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Customer:   testutil.GenPtr("C01234567"),
+					Filters: googleworkspace.Filters{
+						LicenseAssignmentFilters: &googleworkspace.LicenseAssignmentFilters{
+							Products: []googleworkspace.LicenseProduct{
+								{ProductID: "Google-Apps", SKUIDs: []string{""}},
+							},
+						},
+					},
+				},
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "Google Workspace adapter config is invalid: filters.licenseAssignment.products.skuIds of Google-Apps must not contain an empty SKU ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_license_assignment_page_size_too_big": {
+			request: &framework.Request[googleworkspace.Config]{
+				Address: "admin.googleapis.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LicenseAssignment",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "uniqueId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &googleworkspace.Config{
+					APIVersion: "v1",
+					Customer:   testutil.GenPtr("C01234567"),
+					Filters: googleworkspace.Filters{
+						LicenseAssignmentFilters: &googleworkspace.LicenseAssignmentFilters{
+							Products: []googleworkspace.LicenseProduct{
+								{ProductID: "Google-Apps", SKUIDs: []string{"1010020027"}},
+								{ProductID: "101034"},
+							},
+						},
+					},
+				},
+				PageSize: 1001,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested page size, 1001, exceeds the maximum allowed value of 1000 for entity: LicenseAssignment.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
 	}
 
 	adapter := &googleworkspace.Adapter{}
@@ -11,7 +11,7 @@ import (
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
-	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 	"github.com/sgnl-ai/adapters/pkg/validation"
 )
 
@@ -57,11 +57,16 @@ func (a *Adapter) RequestPageFromDatasource(
 
 	request.Address = strings.TrimSuffix(request.Address, "/")
 
-	cursor, err := pagination.UnmarshalCursor[string](request.Cursor)
+	cursor, err := UnmarshalScopeCursor(request.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
 
+	rootScopeID := GlobalScopeID
+	if entityConfig, ok := request.Config.EntityConfig[request.Entity.ExternalId]; ok && entityConfig.ScopeID != "" {
+		rootScopeID = entityConfig.ScopeID
+	}
+
 	hashicorpReq := &Request{
 		BaseURL: request.Address,
 		Auth: Auth{
@@ -69,24 +74,19 @@ func (a *Adapter) RequestPageFromDatasource(
 			Password:     request.Auth.Basic.Password,
 			AuthMethodID: request.Config.AuthMethodID,
 		},
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		Attributes:            request.Entity.Attributes,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
-		Cursor:                cursor,
 		EntityConfig:          request.Config.EntityConfig,
 	}
 
-	resp, err := a.HashicorpClient.GetPage(ctx, hashicorpReq)
+	resp, nextCursor, err := a.requestScopePage(ctx, hashicorpReq, cursor, rootScopeID)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
 
-	// An adapter error message is generated if the response status code is not
-	// successful (i.e. if not statusCode >= 200 && statusCode < 300).
-	if adapterErr := web.HTTPError(resp.StatusCode, resp.RetryAfterHeader); adapterErr != nil {
-		return framework.NewGetPageResponseError(adapterErr)
-	}
+	schemadrift.Observe(ctx, resp.Objects)
 
 	// Convert the JSON response into the expected SGNL adapter object format.
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
@@ -107,8 +107,9 @@ func (a *Adapter) RequestPageFromDatasource(
 		Objects: parsedObjects,
 	}
 
-	if resp.NextCursor != nil {
-		page.NextCursor = *resp.NextCursor
+	page.NextCursor, err = MarshalScopeCursor(nextCursor)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
 	}
 
 	return framework.NewGetPageResponseSuccess(page)
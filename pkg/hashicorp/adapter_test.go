@@ -77,6 +77,8 @@ func (h *testHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.handleAccountsEndpoint(w)
 	case strings.Contains(r.URL.Path, "/v1/users"):
 		h.handleUsersEndpoint(w, r)
+	case strings.Contains(r.URL.Path, "/v1/scopes"):
+		h.handleScopesEndpoint(w, r)
 	default:
 		w.WriteHeader(http.StatusNotFound)
 	}
@@ -99,6 +101,30 @@ func (h *testHandler) handleAuthMethodsEndpoint(w http.ResponseWriter) {
 	}`))
 }
 
+// handleScopesEndpoint returns a single org in the global scope, and a single project in the org.
+func (h *testHandler) handleScopesEndpoint(w http.ResponseWriter, r *http.Request) {
+	var items string
+
+	switch r.URL.Query().Get("scope_id") {
+	case "global":
+		items = `[{"id": "o_123", "type": "org"}]`
+	case "o_123":
+		items = `[{"id": "p_123", "type": "project"}]`
+	default:
+		items = `[]`
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{
+		"items": ` + items + `,
+		"response_type": "complete",
+		"list_token": "next_page_token",
+		"sort_by": "created_time",
+		"sort_dir": "desc",
+		"est_item_count": 1
+	}`))
+}
+
 func (h *testHandler) handleHostsEndpoint(w http.ResponseWriter, r *http.Request) {
 	filter := r.URL.Query().Get("filter")
 	if filter != "" && filter != "name eq \"test-host\"" {
@@ -317,7 +343,8 @@ func TestAdapterGetPage(t *testing.T) {
 							"login_name":   "test-account",
 						},
 					},
-					NextCursor: "",
+					// {"orgId":"o_123"}
+					NextCursor: "eyJvcmdJZCI6Im9fMTIzIn0=",
 				},
 			},
 		},
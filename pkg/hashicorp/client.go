@@ -53,6 +53,10 @@ type Request struct {
 	// This should be set to the number of seconds to wait before timing out.
 	RequestTimeoutSeconds int
 
+	// ScopeID is the ID of the scope to list the objects of the entity in. The objects of the child scopes
+	// are not listed. Defaults to the global scope.
+	ScopeID string
+
 	// EntityConfig is the configuration for the each entity.
 	EntityConfig map[string]EntityConfig
 
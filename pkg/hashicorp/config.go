@@ -25,6 +25,8 @@ import (
 }
 */
 type EntityConfig struct {
+	// ScopeID is the ID of the root scope of the entity. The objects of the entity are listed in the root scope,
+	// and in the orgs and projects discovered under it. Defaults to the global scope.
 	ScopeID string `json:"scopeId,omitempty"`
 
 	Filter string `json:"filter,omitempty"`
@@ -29,6 +29,9 @@ const (
 	EntityTypeHostCatalogs        = "host-catalogs"
 	EntityTypeCredentialStores    = "credential-stores"
 	EntityTypeAuthMethods         = "auth-methods"
+	EntityTypeScopes              = "scopes"
+	EntityTypeUsers               = "users"
+	EntityTypeTargets             = "targets"
 
 	ParamHostCatalogID     = "host_catalog_id"
 	ParamCredentialStoreID = "credential_store_id"
@@ -240,6 +243,7 @@ func (d *Datasource) getResourcePage(
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -339,6 +343,7 @@ func (d *Datasource) getCollectionResourcePage(
 		Filter:                parentFilter,
 		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
 		EntityConfig:          request.EntityConfig,
+		ScopeID:               request.ScopeID,
 	}
 
 	if request.Cursor != nil {
@@ -14,8 +14,9 @@ func ConstructEndpoint(request *Request) string {
 	var sb strings.Builder
 
 	// URL Format:
-	// baseURL + "/v1/" + resourceType + "?page_size=" + pageSize + ["&filter=" + filter] + ["&list_token=" + cursor]
-	// Example: https://boundary.example.com/v1/roles?page_size=100&filter=name%20eq%20"admin"&list_token=abc123
+	// baseURL + "/v1/" + resourceType + "?page_size=" + pageSize + "&scope_id=" + scopeID + ["&filter=" + filter] +
+	// ["&list_token=" + cursor]
+	// Example: https://boundary.example.com/v1/roles?page_size=100&scope_id=o_123&list_token=abc123
 	sb.Grow(len(request.BaseURL) + len(request.EntityExternalID) + 52)
 
 	sb.WriteString(request.BaseURL)
@@ -24,7 +25,6 @@ func ConstructEndpoint(request *Request) string {
 
 	params := url.Values{}
 	params.Add("page_size", strconv.FormatInt(request.PageSize, 10))
-	params.Add("recursive", "true")
 
 	if config, ok := request.EntityConfig[request.EntityExternalID]; ok && config.Filter != "" {
 		params.Add("filter", config.Filter)
@@ -34,10 +34,10 @@ func ConstructEndpoint(request *Request) string {
 		params.Add("list_token", *request.Cursor.Cursor)
 	}
 
-	if config, ok := request.EntityConfig[request.EntityExternalID]; ok && config.ScopeID != "" {
-		params.Add("scope_id", config.ScopeID)
+	if request.ScopeID != "" {
+		params.Add("scope_id", request.ScopeID)
 	} else {
-		params.Add("scope_id", "global")
+		params.Add("scope_id", GlobalScopeID)
 	}
 
 	for key, value := range request.AdditionalParams {
@@ -24,13 +24,9 @@ func TestConstructEndpoint(t *testing.T) {
 				BaseURL:          "https://boundary.example.com",
 				EntityExternalID: "roles",
 				PageSize:         100,
-				EntityConfig: map[string]hashicorp_adapter.EntityConfig{
-					"roles": {
-						ScopeID: "global",
-					},
-				},
+				ScopeID:          "global",
 			},
-			expectedURL: "https://boundary.example.com/v1/roles?page_size=100&recursive=true&scope_id=global",
+			expectedURL: "https://boundary.example.com/v1/roles?page_size=100&scope_id=global",
 		},
 		{
 			name: "endpoint_with_filter",
@@ -38,15 +34,15 @@ func TestConstructEndpoint(t *testing.T) {
 				BaseURL:          "https://boundary.example.com",
 				EntityExternalID: "hosts",
 				PageSize:         50,
+				ScopeID:          "p_123",
 				EntityConfig: map[string]hashicorp_adapter.EntityConfig{
 					"hosts": {
-						ScopeID: "org_123",
-						Filter:  "name eq \"test\"",
+						Filter: "name eq \"test\"",
 					},
 				},
 			},
 			expectedURL: "https://boundary.example.com/v1/hosts?" +
-				"filter=name+eq+%22test%22&page_size=50&recursive=true&scope_id=org_123",
+				"filter=name+eq+%22test%22&page_size=50&scope_id=p_123",
 		},
 		{
 			name: "endpoint_with_cursor",
@@ -57,14 +53,10 @@ func TestConstructEndpoint(t *testing.T) {
 				Cursor: &pagination.CompositeCursor[string]{
 					Cursor: testutil.GenPtr("next_page_token"),
 				},
-				EntityConfig: map[string]hashicorp_adapter.EntityConfig{
-					"users": {
-						ScopeID: "proj_456",
-					},
-				},
+				ScopeID: "o_456",
 			},
 			expectedURL: "https://boundary.example.com/v1/users?" +
-				"list_token=next_page_token&page_size=25&recursive=true&scope_id=proj_456",
+				"list_token=next_page_token&page_size=25&scope_id=o_456",
 		},
 		{
 			name: "endpoint_with_additional_params",
@@ -72,18 +64,13 @@ func TestConstructEndpoint(t *testing.T) {
 				BaseURL:          "https://boundary.example.com",
 				EntityExternalID: "groups",
 				PageSize:         75,
-				EntityConfig: map[string]hashicorp_adapter.EntityConfig{
-					"groups": {
-						ScopeID: "global",
-					},
-				},
 				AdditionalParams: map[string]string{
 					"include": "members",
 					"sort":    "name",
 				},
 			},
 			expectedURL: "https://boundary.example.com/v1/groups?" +
-				"include=members&page_size=75&recursive=true&scope_id=global&sort=name",
+				"include=members&page_size=75&scope_id=global&sort=name",
 		},
 	}
 
@@ -0,0 +1,30 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package hashicorp_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/hashicorp"
+)
+
+// FuzzParseResponse checks that malformed HCP Terraform responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"data":[{"id":"user-V3R563qtJNcExAkN","type":"users"}],"meta":{"pagination":{"next-page":2}}}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, _, err := hashicorp.ParseResponse(body)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
@@ -0,0 +1,346 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package hashicorp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Types of the Boundary scopes.
+const (
+	ScopeTypeGlobal  = "global"
+	ScopeTypeOrg     = "org"
+	ScopeTypeProject = "project"
+
+	// GlobalScopeID is the ID of the global scope, the default root scope of the entities.
+	GlobalScopeID = "global"
+
+	orgScopeIDPrefix     = "o_"
+	projectScopeIDPrefix = "p_"
+
+	// maxScopesPerPage is the maximum number of scopes visited in a single page request, so that walking
+	// through many scopes without objects of the entity doesn't exceed the request timeout.
+	maxScopesPerPage = 10
+)
+
+// scopeCursorShape is the expected JSON shape of a ScopeCursor, used in cursor errors.
+const scopeCursorShape = `{"orgId":<string>,"orgsCursor":<string>,"projectId":<string>,` +
+	`"projectsCursor":<string>,"cursor":<string>}`
+
+// entityScopeTypes maps the entities to the types of the scopes they can be created in.
+// Entities not listed here can be created in scopes of any type.
+var entityScopeTypes = map[string][]string{
+	EntityTypeScopes:              {ScopeTypeGlobal, ScopeTypeOrg},
+	EntityTypeUsers:               {ScopeTypeGlobal, ScopeTypeOrg},
+	EntityTypeAuthMethods:         {ScopeTypeGlobal, ScopeTypeOrg},
+	EntityTypeAccounts:            {ScopeTypeGlobal, ScopeTypeOrg},
+	EntityTypeHostCatalogs:        {ScopeTypeProject},
+	EntityTypeHosts:               {ScopeTypeProject},
+	EntityTypeHostSets:            {ScopeTypeProject},
+	EntityTypeCredentialStores:    {ScopeTypeProject},
+	EntityTypeCredentials:         {ScopeTypeProject},
+	EntityTypeCredentialLibraries: {ScopeTypeProject},
+	EntityTypeTargets:             {ScopeTypeProject},
+}
+
+/*
+ScopeCursor is the cursor of the requests of all entities. Boundary scopes form a tree: the global scope
+contains orgs, which contain projects. Instead of listing the objects of the entity recursively from the root
+scope, the scopes under the root scope are discovered one at a time, and the objects of the entity are listed
+in each scope in turn, depth first: the root scope, then the first org, the projects of the first org, the
+second org, and so on.
+
+The orgs and projects are listed with a page size of 1, and the cursors to the following orgs and projects are
+kept in the cursor, so that the number of scopes doesn't affect the size of the cursor or of the requests.
+*/
+type ScopeCursor struct {
+	// OrgID is the ID of the current org. Empty while in the root scope, or if the root scope is not the
+	// global scope.
+	OrgID string `json:"orgId,omitempty"`
+
+	// OrgsCursor is the cursor of the orgs following the current org. Empty after the last org.
+	OrgsCursor string `json:"orgsCursor,omitempty"`
+
+	// ProjectID is the ID of the current project. Empty while not in a project under the root scope.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// ProjectsCursor is the cursor of the projects of the current org following the current project.
+	// Empty after the last project.
+	ProjectsCursor string `json:"projectsCursor,omitempty"`
+
+	// Cursor is the cursor of the next page of the entity within the current scope.
+	// Empty for the first page of the scope.
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// MarshalScopeCursor marshals the struct and b64 encodes it.
+func MarshalScopeCursor(cursor *ScopeCursor) (string, *framework.Error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	nextCursorBytes, marshalErr := json.Marshal(cursor)
+	if marshalErr != nil {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal scope cursor into JSON: %v.", marshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(nextCursorBytes), nil
+}
+
+// UnmarshalScopeCursor decodes the b64 encoded string and unmarshals it.
+// An empty cursor returns the cursor of the first page of the root scope.
+func UnmarshalScopeCursor(cursor string, entityExternalID string) (*ScopeCursor, *framework.Error) {
+	scopeCursor := &ScopeCursor{}
+	if cursor == "" {
+		return scopeCursor, nil
+	}
+
+	scopeCursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, scopeCursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	if err := json.Unmarshal(scopeCursorBytes, scopeCursor); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, scopeCursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	return scopeCursor, nil
+}
+
+// scopeType returns the type of the scope from its ID. An empty string is returned for unknown IDs.
+func scopeType(scopeID string) string {
+	switch {
+	case scopeID == GlobalScopeID:
+		return ScopeTypeGlobal
+	case strings.HasPrefix(scopeID, orgScopeIDPrefix):
+		return ScopeTypeOrg
+	case strings.HasPrefix(scopeID, projectScopeIDPrefix):
+		return ScopeTypeProject
+	default:
+		return ""
+	}
+}
+
+// currentScope returns the ID of the scope of the cursor, given the root scope.
+func (c *ScopeCursor) currentScope(rootScopeID string) string {
+	switch {
+	case c.ProjectID != "":
+		return c.ProjectID
+	case c.OrgID != "":
+		return c.OrgID
+	default:
+		return rootScopeID
+	}
+}
+
+// isListedInScope returns whether the objects of the entity can be created in the scope, and should be listed.
+// The objects of all the entities are listed in scopes of unknown type.
+func isListedInScope(entityExternalID string, scopeID string) bool {
+	scopeTypes, found := entityScopeTypes[entityExternalID]
+	if !found {
+		return true
+	}
+
+	currentType := scopeType(scopeID)
+
+	return currentType == "" || slices.Contains(scopeTypes, currentType)
+}
+
+// nextScope returns the cursor of the first page of the scope following the current scope, depth first.
+// nil is returned after the last scope.
+func (a *Adapter) nextScope(
+	ctx context.Context, request *Request, cursor *ScopeCursor, rootScopeID string,
+) (*ScopeCursor, *framework.Error) {
+	currentScope := cursor.currentScope(rootScopeID)
+
+	switch scopeType(currentScope) {
+	case ScopeTypeGlobal:
+		orgID, orgsCursor, err := a.listChildScope(ctx, request, currentScope, "")
+		if err != nil || orgID == "" {
+			return nil, err
+		}
+
+		return &ScopeCursor{OrgID: orgID, OrgsCursor: orgsCursor}, nil
+	case ScopeTypeOrg:
+		projectID, projectsCursor, err := a.listChildScope(ctx, request, currentScope, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if projectID != "" {
+			return &ScopeCursor{
+				OrgID:          cursor.OrgID,
+				OrgsCursor:     cursor.OrgsCursor,
+				ProjectID:      projectID,
+				ProjectsCursor: projectsCursor,
+			}, nil
+		}
+	case ScopeTypeProject:
+		if cursor.ProjectsCursor != "" {
+			// The projects are either in the current org, or in the root scope if it's an org.
+			orgID := rootScopeID
+			if cursor.OrgID != "" {
+				orgID = cursor.OrgID
+			}
+
+			projectID, projectsCursor, err := a.listChildScope(ctx, request, orgID, cursor.ProjectsCursor)
+			if err != nil {
+				return nil, err
+			}
+
+			if projectID != "" {
+				return &ScopeCursor{
+					OrgID:          cursor.OrgID,
+					OrgsCursor:     cursor.OrgsCursor,
+					ProjectID:      projectID,
+					ProjectsCursor: projectsCursor,
+				}, nil
+			}
+		}
+	default:
+		return nil, nil
+	}
+
+	// All the projects of the current org have been listed, so move on to the next org.
+	if cursor.OrgsCursor == "" {
+		return nil, nil
+	}
+
+	orgID, orgsCursor, err := a.listChildScope(ctx, request, rootScopeID, cursor.OrgsCursor)
+	if err != nil || orgID == "" {
+		return nil, err
+	}
+
+	return &ScopeCursor{OrgID: orgID, OrgsCursor: orgsCursor}, nil
+}
+
+// listChildScope requests a page of a single child scope of the parent scope. The ID of the child scope and
+// the cursor of the following child scopes are returned. An empty ID is returned if there are no more child scopes.
+func (a *Adapter) listChildScope(
+	ctx context.Context, request *Request, parentScopeID string, cursor string,
+) (string, string, *framework.Error) {
+	scopesCursor, err := pagination.UnmarshalCursor[string](cursor, EntityTypeScopes)
+	if err != nil {
+		return "", "", err
+	}
+
+	resp, err := a.HashicorpClient.GetPage(ctx, &Request{
+		BaseURL:               request.BaseURL,
+		Auth:                  request.Auth,
+		PageSize:              1,
+		EntityExternalID:      EntityTypeScopes,
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Cursor:                scopesCursor,
+		ScopeID:               parentScopeID,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	if adapterErr := web.HTTPError(resp.StatusCode, resp.RetryAfterHeader); adapterErr != nil {
+		return "", "", adapterErr
+	}
+
+	if len(resp.Objects) == 0 {
+		return "", "", nil
+	}
+
+	scopeID, ok := resp.Objects[0][uniqueIDAttribute].(string)
+	if !ok {
+		return "", "", &framework.Error{
+			Message: fmt.Sprintf("Scope object 'id' field is not a string for scope %s", parentScopeID),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	var nextCursor string
+	if resp.NextCursor != nil {
+		nextCursor = *resp.NextCursor
+	}
+
+	return scopeID, nextCursor, nil
+}
+
+// requestScopePage requests the next page of objects of the entity, starting from the scope of the cursor and
+// skipping the scopes the entity can't be created in. The returned cursor is nil after the last page of the
+// last scope.
+func (a *Adapter) requestScopePage(
+	ctx context.Context, request *Request, cursor *ScopeCursor, rootScopeID string,
+) (*Response, *ScopeCursor, *framework.Error) {
+	for visited := 1; ; visited++ {
+		if isListedInScope(request.EntityExternalID, cursor.currentScope(rootScopeID)) {
+			return a.requestPageInScope(ctx, request, cursor, rootScopeID)
+		}
+
+		nextCursor, err := a.nextScope(ctx, request, cursor, rootScopeID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Return an empty page once the last scope or the maximum number of scopes per page is reached.
+		if nextCursor == nil || visited >= maxScopesPerPage {
+			return &Response{StatusCode: http.StatusOK}, nextCursor, nil
+		}
+
+		cursor = nextCursor
+	}
+}
+
+// requestPageInScope requests the page of objects of the entity in the scope of the cursor.
+func (a *Adapter) requestPageInScope(
+	ctx context.Context, request *Request, cursor *ScopeCursor, rootScopeID string,
+) (*Response, *ScopeCursor, *framework.Error) {
+	pageCursor, err := pagination.UnmarshalCursor[string](cursor.Cursor, request.EntityExternalID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request.Cursor = pageCursor
+	request.ScopeID = cursor.currentScope(rootScopeID)
+
+	resp, err := a.HashicorpClient.GetPage(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// An adapter error message is generated if the response status code is not
+	// successful (i.e. if not statusCode >= 200 && statusCode < 300).
+	if adapterErr := web.HTTPError(resp.StatusCode, resp.RetryAfterHeader); adapterErr != nil {
+		return nil, nil, adapterErr
+	}
+
+	if resp.NextCursor != nil {
+		return resp, &ScopeCursor{
+			OrgID:          cursor.OrgID,
+			OrgsCursor:     cursor.OrgsCursor,
+			ProjectID:      cursor.ProjectID,
+			ProjectsCursor: cursor.ProjectsCursor,
+			Cursor:         *resp.NextCursor,
+		}, nil
+	}
+
+	nextCursor, err := a.nextScope(ctx, request, cursor, rootScopeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, nextCursor, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package hashicorp_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	hashicorp_adapter "github.com/sgnl-ai/adapters/pkg/hashicorp"
+	"github.com/sgnl-ai/adapters/pkg/mock"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// scopeClient serves two orgs in the global scope, listed one at a time, and a single project in the first org.
+// The requests of the other entities return a single object, and their scopes are recorded.
+type scopeClient struct {
+	orgsCursor  string
+	gotScopeIDs []string
+}
+
+func (c *scopeClient) GetPage(_ context.Context, request *hashicorp_adapter.Request) (*hashicorp_adapter.Response, *framework.Error) {
+	response := &hashicorp_adapter.Response{
+		StatusCode: http.StatusOK,
+		Objects:    []map[string]any{},
+	}
+
+	if request.EntityExternalID != hashicorp_adapter.EntityTypeScopes {
+		c.gotScopeIDs = append(c.gotScopeIDs, request.ScopeID)
+		response.Objects = []map[string]any{{"id": "r_123"}}
+
+		return response, nil
+	}
+
+	switch {
+	case request.ScopeID == "global" && request.Cursor == nil:
+		response.Objects = []map[string]any{{"id": "o_1"}}
+		response.NextCursor = &c.orgsCursor
+	case request.ScopeID == "global":
+		response.Objects = []map[string]any{{"id": "o_2"}}
+	case request.ScopeID == "o_1":
+		response.Objects = []map[string]any{{"id": "p_1"}}
+	}
+
+	return response, nil
+}
+
+func TestAdapterGetPageWithScopes(t *testing.T) {
+	orgsCursor, err := pagination.MarshalCursor(&pagination.CompositeCursor[string]{
+		Cursor: testutil.GenPtr("orgs_page_2"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		entityExternalID string
+		rootScopeID      string
+		cursor           *hashicorp_adapter.ScopeCursor
+		wantScopeIDs     []string
+		wantNextCursor   *hashicorp_adapter.ScopeCursor
+	}{
+		"global_scope": {
+			entityExternalID: "roles",
+			wantScopeIDs:     []string{"global"},
+			wantNextCursor:   &hashicorp_adapter.ScopeCursor{OrgID: "o_1", OrgsCursor: orgsCursor},
+		},
+		"org_with_projects": {
+			entityExternalID: "roles",
+			cursor:           &hashicorp_adapter.ScopeCursor{OrgID: "o_1", OrgsCursor: orgsCursor},
+			wantScopeIDs:     []string{"o_1"},
+			wantNextCursor:   &hashicorp_adapter.ScopeCursor{OrgID: "o_1", OrgsCursor: orgsCursor, ProjectID: "p_1"},
+		},
+		"last_project_of_org": {
+			entityExternalID: "roles",
+			cursor:           &hashicorp_adapter.ScopeCursor{OrgID: "o_1", OrgsCursor: orgsCursor, ProjectID: "p_1"},
+			wantScopeIDs:     []string{"p_1"},
+			wantNextCursor:   &hashicorp_adapter.ScopeCursor{OrgID: "o_2"},
+		},
+		"last_org_without_projects": {
+			entityExternalID: "roles",
+			cursor:           &hashicorp_adapter.ScopeCursor{OrgID: "o_2"},
+			wantScopeIDs:     []string{"o_2"},
+		},
+		"project_entity_skips_global_scope_and_orgs": {
+			entityExternalID: "targets",
+			wantScopeIDs:     []string{"p_1"},
+			wantNextCursor:   &hashicorp_adapter.ScopeCursor{OrgID: "o_2"},
+		},
+		"project_entity_in_last_org_without_projects": {
+			entityExternalID: "targets",
+			cursor:           &hashicorp_adapter.ScopeCursor{OrgID: "o_2"},
+		},
+		"org_root_scope": {
+			entityExternalID: "roles",
+			rootScopeID:      "o_1",
+			wantScopeIDs:     []string{"o_1"},
+			wantNextCursor:   &hashicorp_adapter.ScopeCursor{ProjectID: "p_1"},
+		},
+		"project_root_scope": {
+			entityExternalID: "targets",
+			rootScopeID:      "p_1",
+			wantScopeIDs:     []string{"p_1"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &scopeClient{orgsCursor: orgsCursor}
+			adapter := &hashicorp_adapter.Adapter{
+				HashicorpClient: client,
+				SSRFValidator:   mock.NewNoOpSSRFValidator(),
+			}
+
+			cursor, err := hashicorp_adapter.MarshalScopeCursor(tt.cursor)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response := adapter.GetPage(context.Background(), &framework.Request[hashicorp_adapter.Config]{
+				Address: "boundary.example.com",
+				Auth:    mockAuth,
+				Config: &hashicorp_adapter.Config{
+					AuthMethodID: "ampw_123",
+					EntityConfig: map[string]hashicorp_adapter.EntityConfig{
+						tt.entityExternalID: {
+							ScopeID: tt.rootScopeID,
+						},
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: tt.entityExternalID,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 100,
+				Cursor:   cursor,
+			})
+
+			if response.Error != nil {
+				t.Fatalf("unexpected error: %v", response.Error)
+			}
+
+			if !reflect.DeepEqual(client.gotScopeIDs, tt.wantScopeIDs) {
+				t.Errorf("gotScopeIDs: %v, wantScopeIDs: %v", client.gotScopeIDs, tt.wantScopeIDs)
+			}
+
+			wantNextCursor, err := hashicorp_adapter.MarshalScopeCursor(tt.wantNextCursor)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if response.Success.NextCursor != wantNextCursor {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", response.Success.NextCursor, wantNextCursor)
+			}
+		})
+	}
+}
@@ -123,7 +123,12 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
-	cursor, err := pagination.UnmarshalCursor[string](request.Cursor)
+	scopeCursor, err := UnmarshalScopeCursor(request.Cursor, request.Entity.ExternalId)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := pagination.UnmarshalCursor[string](scopeCursor.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return err
 	}
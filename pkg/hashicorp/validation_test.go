@@ -205,8 +205,41 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Cursor:   "invalid-base64",
 			},
 			wantErr: &framework.Error{
-				Message: "Failed to decode base64 cursor: illegal base64 data at input byte 7.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity hosts: failed to decode base64 cursor: illegal base64 data at input byte 7. " +
+					`Expected cursor shape: {"orgId":<string>,"orgsCursor":<string>,"projectId":<string>,"projectsCursor":<string>,"cursor":<string>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"invalid_cursor_in_scope": {
+			request: &framework.Request[hashicorp_adapter.Config]{
+				Config: &hashicorp_adapter.Config{
+					AuthMethodID: "test-auth-method-id",
+				},
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "test",
+						Password: "test",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "hosts",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 100,
+				// {"projectId":"p_123","cursor":"<{"cursor":"next_page_token"}>"}
+				Cursor: "eyJwcm9qZWN0SWQiOiJwXzEyMyIsImN1cnNvciI6ImV5SmpkWEp6YjNJaU9pSnVaWGgwWDNCaFoyVmZkRzlyWlc0aWZRPT0ifQ==",
+			},
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity hosts: cursor does not have CollectionID set. " +
+					`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 		"invalid_localhost_address": {
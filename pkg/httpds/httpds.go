@@ -0,0 +1,220 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package httpds contains the scaffolding shared by the datasources of HTTP adapters: building and
+// sending requests, timing them out, logging them, and reading and unmarshaling the responses.
+package httpds
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"go.uber.org/zap"
+)
+
+// Request is a request to an HTTP datasource.
+type Request struct {
+	// Method is the HTTP method of the request. Defaults to GET.
+	Method string
+
+	// URL is the URL of the request.
+	URL string
+
+	// Body is the body of the request. May be nil.
+	Body []byte
+
+	// Header contains the headers of the request, e.g. the Authorization header.
+	Header http.Header
+
+	// DatasourceName is the name of the datasource in error messages, e.g. "Okta".
+	DatasourceName string
+
+	// RequestTimeoutSeconds is the timeout duration for the request, including reading the response body.
+	// The request doesn't time out if 0.
+	RequestTimeoutSeconds int
+
+	// Logger is the logger of the request, usually with the entity fields attached.
+	// Defaults to the logger from the context.
+	Logger *zap.Logger
+}
+
+// Response is the response of an HTTP datasource to a Request.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Header contains the headers of the response.
+	Header http.Header
+}
+
+// Hooks customize how a request is sent and how its response is handled.
+// All hooks are optional.
+type Hooks struct {
+	// PrepareRequest is called with the HTTP request before it is sent, e.g. to sign it.
+	PrepareRequest func(req *http.Request) *framework.Error
+
+	// InspectResponse is called with every HTTP response before its body is handled, including
+	// unsuccessful responses, e.g. to track rate limits.
+	InspectResponse func(res *http.Response)
+
+	// IsSuccess reports whether a response status code is successful. Defaults to 200 OK only.
+	IsSuccess func(statusCode int) bool
+}
+
+// BodyHandler handles the body of a successful response.
+type BodyHandler func(body io.Reader) *framework.Error
+
+// Do sends the request to the datasource, and calls handleBody with the body of the response if successful.
+//
+// If the datasource responds with an unsuccessful status code, the response body is logged and the Response is
+// returned without calling handleBody, so that the caller can map the status code to an adapter error with
+// web.HTTPError.
+func Do(
+	ctx context.Context, client *http.Client, request *Request, handleBody BodyHandler, hooks *Hooks,
+) (*Response, *framework.Error) {
+	if hooks == nil {
+		hooks = &Hooks{}
+	}
+
+	logger := request.Logger
+	if logger == nil {
+		logger = zaplogger.FromContext(ctx)
+	}
+
+	method := request.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	// Timeout API calls that take longer than the configured timeout.
+	if request.RequestTimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+
+	var body io.Reader
+	if request.Body != nil {
+		body = bytes.NewReader(request.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, request.URL, body)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	for name, values := range request.Header {
+		req.Header[name] = values
+	}
+
+	if hooks.PrepareRequest != nil {
+		if prepareErr := hooks.PrepareRequest(req); prepareErr != nil {
+			return nil, prepareErr
+		}
+	}
+
+	logger.Info("Sending request to datasource", fields.RequestURL(request.URL))
+
+	res, err := client.Do(req)
+	if err != nil {
+		logger.Error("Request to datasource failed",
+			fields.RequestURL(request.URL),
+			fields.SGNLEventTypeError(),
+			zap.Error(err),
+		)
+
+		return nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to execute %s request: %v.", request.DatasourceName, err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
+		)
+	}
+
+	defer res.Body.Close()
+
+	if hooks.InspectResponse != nil {
+		hooks.InspectResponse(res)
+	}
+
+	response := &Response{
+		StatusCode:       res.StatusCode,
+		RetryAfterHeader: res.Header.Get("Retry-After"),
+		Header:           res.Header,
+	}
+
+	isSuccess := hooks.IsSuccess
+	if isSuccess == nil {
+		isSuccess = func(statusCode int) bool { return statusCode == http.StatusOK }
+	}
+
+	if !isSuccess(res.StatusCode) {
+		logger.Error("Datasource responded with an error",
+			fields.RequestURL(request.URL),
+			fields.ResponseStatusCode(response.StatusCode),
+			fields.ResponseRetryAfterHeader(response.RetryAfterHeader),
+			fields.ResponseBody(res.Body),
+			fields.SGNLEventTypeError(),
+		)
+
+		return response, nil
+	}
+
+	if handleBody != nil {
+		if handleErr := handleBody(res.Body); handleErr != nil {
+			return nil, handleErr
+		}
+	}
+
+	return response, nil
+}
+
+// ReadAll reads the whole body of a response from the datasource.
+func ReadAll(body io.Reader, datasourceName string) ([]byte, *framework.Error) {
+	bodyBytes, err := io.ReadAll(body)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to read %s response body: %v.", datasourceName, err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return bodyBytes, nil
+}
+
+// UnmarshalJSON unmarshals the JSON body of a response from the datasource into v.
+// A response body containing JSON null is rejected, as it can't be unmarshaled into a usable value.
+func UnmarshalJSON(body []byte, v any) *framework.Error {
+	if bytes.Equal(bytes.TrimSpace(body), []byte("null")) {
+		return &framework.Error{
+			Message: "Failed to unmarshal the datasource response: the response is null.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return nil
+}
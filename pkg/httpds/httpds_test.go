@@ -0,0 +1,248 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package httpds_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+)
+
+func TestDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/echo":
+			if r.Header.Get("Authorization") != "Bearer testtoken" {
+				w.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+
+			body, _ := io.ReadAll(r.Body)
+
+			w.Header().Set("X-Method", r.Method)
+			w.Write(append([]byte(r.Header.Get("X-Signature")), body...))
+		case "/created":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte("created"))
+		case "/throttled":
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/slow":
+			time.Sleep(1500 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	tests := map[string]struct {
+		request      *httpds.Request
+		hooks        *httpds.Hooks
+		wantResponse *httpds.Response
+		wantBody     string
+		wantErr      *framework.Error
+	}{
+		"get": {
+			request: &httpds.Request{
+				URL:    server.URL + "/echo",
+				Header: http.Header{"Authorization": {"Bearer testtoken"}},
+			},
+			wantResponse: &httpds.Response{StatusCode: http.StatusOK},
+			wantBody:     "",
+		},
+		"post_with_body": {
+			request: &httpds.Request{
+				Method: http.MethodPost,
+				URL:    server.URL + "/echo",
+				Body:   []byte(`{"ids": ["1"]}`),
+				Header: http.Header{"Authorization": {"Bearer testtoken"}},
+			},
+			wantResponse: &httpds.Response{StatusCode: http.StatusOK},
+			wantBody:     `{"ids": ["1"]}`,
+		},
+		"prepare_request_hook": {
+			request: &httpds.Request{
+				URL:    server.URL + "/echo",
+				Header: http.Header{"Authorization": {"Bearer testtoken"}},
+			},
+			hooks: &httpds.Hooks{
+				PrepareRequest: func(req *http.Request) *framework.Error {
+					req.Header.Set("X-Signature", "signed")
+
+					return nil
+				},
+			},
+			wantResponse: &httpds.Response{StatusCode: http.StatusOK},
+			wantBody:     "signed",
+		},
+		"prepare_request_hook_error": {
+			request: &httpds.Request{
+				URL: server.URL + "/echo",
+			},
+			hooks: &httpds.Hooks{
+				PrepareRequest: func(_ *http.Request) *framework.Error {
+					return &framework.Error{
+						Message: "Failed to sign request.",
+						Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					}
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Failed to sign request.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"unauthorized": {
+			request: &httpds.Request{
+				URL: server.URL + "/echo",
+			},
+			wantResponse: &httpds.Response{StatusCode: http.StatusUnauthorized},
+		},
+		"too_many_requests": {
+			request: &httpds.Request{
+				URL: server.URL + "/throttled",
+			},
+			wantResponse: &httpds.Response{StatusCode: http.StatusTooManyRequests, RetryAfterHeader: "30"},
+		},
+		"created_is_unsuccessful_by_default": {
+			request: &httpds.Request{
+				URL: server.URL + "/created",
+			},
+			wantResponse: &httpds.Response{StatusCode: http.StatusCreated},
+		},
+		"is_success_hook": {
+			request: &httpds.Request{
+				URL: server.URL + "/created",
+			},
+			hooks: &httpds.Hooks{
+				IsSuccess: func(statusCode int) bool { return statusCode == http.StatusCreated },
+			},
+			wantResponse: &httpds.Response{StatusCode: http.StatusCreated},
+			wantBody:     "created",
+		},
+		"request_timeout": {
+			request: &httpds.Request{
+				URL:                   server.URL + "/slow",
+				DatasourceName:        "Test",
+				RequestTimeoutSeconds: 1,
+			},
+			wantErr: &framework.Error{
+				Message: `Failed to execute Test request: Get "` + server.URL + `/slow": context deadline exceeded. ` +
+					"Request exceeded configured timeout of 1 seconds. Please increase the request timeout.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var (
+				gotBody           string
+				gotInspectedCodes []int
+			)
+
+			hooks := tt.hooks
+			if hooks == nil {
+				hooks = &httpds.Hooks{}
+			}
+
+			hooks.InspectResponse = func(res *http.Response) {
+				gotInspectedCodes = append(gotInspectedCodes, res.StatusCode)
+			}
+
+			gotResponse, gotErr := httpds.Do(context.Background(), server.Client(), tt.request,
+				func(body io.Reader) *framework.Error {
+					bodyBytes, err := httpds.ReadAll(body, "Test")
+					gotBody = string(bodyBytes)
+
+					return err
+				},
+				hooks,
+			)
+
+			if gotResponse != nil {
+				gotResponse.Header = nil
+			}
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if gotBody != tt.wantBody {
+				t.Errorf("gotBody: %q, wantBody: %q", gotBody, tt.wantBody)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantResponse != nil && !reflect.DeepEqual(gotInspectedCodes, []int{tt.wantResponse.StatusCode}) {
+				t.Errorf("InspectResponse called with status codes %v, want [%d]",
+					gotInspectedCodes, tt.wantResponse.StatusCode)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	type object struct {
+		ID string `json:"id"`
+	}
+
+	tests := map[string]struct {
+		body       string
+		wantObject object
+		wantErr    *framework.Error
+	}{
+		"valid": {
+			body:       `{"id": "1"}`,
+			wantObject: object{ID: "1"},
+		},
+		"null": {
+			body: "null",
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: the response is null.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"invalid_type": {
+			body: `[{"id": "1"}]`,
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: " +
+					"json: cannot unmarshal array into Go value of type httpds_test.object.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"invalid_json": {
+			body: `{"id": "1"`,
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: unexpected end of JSON input.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotObject object
+
+			gotErr := httpds.UnmarshalJSON([]byte(tt.body), &gotObject)
+
+			if !reflect.DeepEqual(gotObject, tt.wantObject) {
+				t.Errorf("gotObject: %v, wantObject: %v", gotObject, tt.wantObject)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package identity maps the users and groups of the directory adapters (Okta, Azure AD and Google Workspace) to
+// a normalized shape, so that the identities of different IdPs can be joined without mapping the attributes of
+// each datasource.
+//
+// The normalized attributes are requested like any other attribute of the User and Group entities, with the
+// external IDs prefixed with Prefix, e.g. "identity.email". They are read from the attributes of the datasource
+// they are mapped to, which are still returned if they are requested too. The mapping is optional: the requests
+// without normalized attributes are left unchanged.
+package identity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// Prefix is the prefix of the external IDs of the normalized attributes.
+const Prefix = "identity."
+
+// The normalized attributes of the users and groups.
+const (
+	// AttributeID is the ID of the user or group in the datasource.
+	AttributeID = Prefix + "id"
+
+	// AttributeEmail is the primary email address of the user or group.
+	AttributeEmail = Prefix + "email"
+
+	// AttributeUsername is the name the user signs in with, e.g. the Okta login or the Azure AD user principal
+	// name.
+	AttributeUsername = Prefix + "username"
+
+	// AttributeDisplayName is the full name of the user.
+	AttributeDisplayName = Prefix + "displayName"
+
+	// AttributeGivenName is the first name of the user.
+	AttributeGivenName = Prefix + "givenName"
+
+	// AttributeFamilyName is the last name of the user.
+	AttributeFamilyName = Prefix + "familyName"
+
+	// AttributeActive is whether the user is allowed to sign in.
+	AttributeActive = Prefix + "active"
+
+	// AttributeName is the name of the group.
+	AttributeName = Prefix + "name"
+
+	// AttributeDescription is the description of the group.
+	AttributeDescription = Prefix + "description"
+)
+
+// attributeTypes are the types the normalized attributes must be requested with.
+var attributeTypes = map[string]framework.AttributeType{
+	AttributeID:          framework.AttributeTypeString,
+	AttributeEmail:       framework.AttributeTypeString,
+	AttributeUsername:    framework.AttributeTypeString,
+	AttributeDisplayName: framework.AttributeTypeString,
+	AttributeGivenName:   framework.AttributeTypeString,
+	AttributeFamilyName:  framework.AttributeTypeString,
+	AttributeActive:      framework.AttributeTypeBool,
+	AttributeName:        framework.AttributeTypeString,
+	AttributeDescription: framework.AttributeTypeString,
+}
+
+// attributeTypeNames are the names of the types of the normalized attributes, for the error messages.
+var attributeTypeNames = map[framework.AttributeType]string{
+	framework.AttributeTypeString: "string",
+	framework.AttributeTypeBool:   "bool",
+}
+
+// Mapping maps a normalized attribute to the attribute of the datasource it is read from.
+type Mapping struct {
+	// Source is the external ID of the attribute of the datasource, e.g. "$.profile.email".
+	Source string
+
+	// SourceType is the type of the attribute of the datasource.
+	SourceType framework.AttributeType
+
+	// Transform converts the values of the attribute of the datasource, if set, e.g. a status into whether
+	// the user is active.
+	Transform func(value any) any
+}
+
+// EntityMappings maps the external ID of the normalized attributes of an entity to their Mapping.
+type EntityMappings map[string]Mapping
+
+// Mappings maps the name of each supported datasource type, i.e. without its version, to the mappings of its
+// entities, by entity external ID.
+var Mappings = map[string]map[string]EntityMappings{
+	"Okta": {
+		"User": {
+			AttributeID:          {Source: "id", SourceType: framework.AttributeTypeString},
+			AttributeEmail:       {Source: "$.profile.email", SourceType: framework.AttributeTypeString},
+			AttributeUsername:    {Source: "$.profile.login", SourceType: framework.AttributeTypeString},
+			AttributeDisplayName: {Source: "$.profile.displayName", SourceType: framework.AttributeTypeString},
+			AttributeGivenName:   {Source: "$.profile.firstName", SourceType: framework.AttributeTypeString},
+			AttributeFamilyName:  {Source: "$.profile.lastName", SourceType: framework.AttributeTypeString},
+			// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/User/#tag/User/operation/listUsers.
+			// The users locked out, or whose password expired or is being recovered, are still allowed to sign in
+			// once they complete the flow.
+			AttributeActive: {
+				Source:     "status",
+				SourceType: framework.AttributeTypeString,
+				Transform:  oneOf("ACTIVE", "LOCKED_OUT", "PASSWORD_EXPIRED", "RECOVERY"),
+			},
+		},
+		"Group": {
+			AttributeID:          {Source: "id", SourceType: framework.AttributeTypeString},
+			AttributeName:        {Source: "$.profile.name", SourceType: framework.AttributeTypeString},
+			AttributeDescription: {Source: "$.profile.description", SourceType: framework.AttributeTypeString},
+		},
+	},
+	"AzureAD": {
+		"User": {
+			AttributeID:          {Source: "id", SourceType: framework.AttributeTypeString},
+			AttributeEmail:       {Source: "mail", SourceType: framework.AttributeTypeString},
+			AttributeUsername:    {Source: "userPrincipalName", SourceType: framework.AttributeTypeString},
+			AttributeDisplayName: {Source: "displayName", SourceType: framework.AttributeTypeString},
+			AttributeGivenName:   {Source: "givenName", SourceType: framework.AttributeTypeString},
+			AttributeFamilyName:  {Source: "surname", SourceType: framework.AttributeTypeString},
+			AttributeActive:      {Source: "accountEnabled", SourceType: framework.AttributeTypeBool},
+		},
+		"Group": {
+			AttributeID:          {Source: "id", SourceType: framework.AttributeTypeString},
+			AttributeEmail:       {Source: "mail", SourceType: framework.AttributeTypeString},
+			AttributeName:        {Source: "displayName", SourceType: framework.AttributeTypeString},
+			AttributeDescription: {Source: "description", SourceType: framework.AttributeTypeString},
+		},
+	},
+	"GoogleWorkspace": {
+		"User": {
+			AttributeID:          {Source: "id", SourceType: framework.AttributeTypeString},
+			AttributeEmail:       {Source: "primaryEmail", SourceType: framework.AttributeTypeString},
+			AttributeUsername:    {Source: "primaryEmail", SourceType: framework.AttributeTypeString},
+			AttributeDisplayName: {Source: "$.name.fullName", SourceType: framework.AttributeTypeString},
+			AttributeGivenName:   {Source: "$.name.givenName", SourceType: framework.AttributeTypeString},
+			AttributeFamilyName:  {Source: "$.name.familyName", SourceType: framework.AttributeTypeString},
+			AttributeActive:      {Source: "suspended", SourceType: framework.AttributeTypeBool, Transform: negate},
+		},
+		"Group": {
+			AttributeID:          {Source: "id", SourceType: framework.AttributeTypeString},
+			AttributeEmail:       {Source: "email", SourceType: framework.AttributeTypeString},
+			AttributeName:        {Source: "name", SourceType: framework.AttributeTypeString},
+			AttributeDescription: {Source: "description", SourceType: framework.AttributeTypeString},
+		},
+	},
+}
+
+// oneOf returns a Transform converting a string value into whether it is one of the values.
+func oneOf(values ...string) func(value any) any {
+	return func(value any) any {
+		s, ok := value.(string)
+		if !ok {
+			return nil
+		}
+
+		return slices.Contains(values, s)
+	}
+}
+
+// negate is a Transform negating a bool value.
+func negate(value any) any {
+	b, ok := value.(bool)
+	if !ok {
+		return nil
+	}
+
+	return !b
+}
+
+// IsNormalized returns whether the attribute is a normalized attribute.
+func IsNormalized(attributeExternalID string) bool {
+	return strings.HasPrefix(attributeExternalID, Prefix)
+}
+
+// Rewrite returns the attributes of the entity with the normalized attributes replaced by the attributes of the
+// datasource they are mapped to, for the adapter of the datasource, and the external IDs of the attributes of
+// the datasource that were added, i.e. that weren't requested.
+func Rewrite(
+	mappings EntityMappings,
+	entity *framework.EntityConfig,
+) (attributes []*framework.AttributeConfig, added map[string]struct{}, err *framework.Error) {
+	requested := make(map[string]struct{}, len(entity.Attributes))
+
+	for _, attribute := range entity.Attributes {
+		if !IsNormalized(attribute.ExternalId) {
+			requested[attribute.ExternalId] = struct{}{}
+		}
+	}
+
+	attributes = make([]*framework.AttributeConfig, 0, len(entity.Attributes))
+	added = make(map[string]struct{})
+
+	for _, attribute := range entity.Attributes {
+		if !IsNormalized(attribute.ExternalId) {
+			attributes = append(attributes, attribute)
+
+			continue
+		}
+
+		mapping, found := mappings[attribute.ExternalId]
+		if !found {
+			return nil, nil, &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s of entity %s is not a supported identity attribute of the datasource.",
+					attribute.ExternalId, entity.ExternalId,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+
+		if attribute.Type != attributeTypes[attribute.ExternalId] || attribute.List {
+			return nil, nil, &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s of entity %s must be a single %s value.",
+					attribute.ExternalId, entity.ExternalId, attributeTypeNames[attributeTypes[attribute.ExternalId]],
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+
+		if _, found := requested[mapping.Source]; found {
+			continue
+		}
+
+		requested[mapping.Source] = struct{}{}
+		added[mapping.Source] = struct{}{}
+
+		attributes = append(attributes, &framework.AttributeConfig{
+			ExternalId: mapping.Source,
+			Type:       mapping.SourceType,
+			UniqueId:   attribute.UniqueId,
+		})
+	}
+
+	return attributes, added, nil
+}
+
+// Apply sets the normalized attributes of the entity in the objects returned by the adapter of the datasource,
+// in place, and removes the attributes of the datasource that were added by Rewrite.
+func Apply(
+	mappings EntityMappings,
+	entity *framework.EntityConfig,
+	added map[string]struct{},
+	objects []framework.Object,
+) {
+	for _, object := range objects {
+		for _, attribute := range entity.Attributes {
+			mapping, found := mappings[attribute.ExternalId]
+			if !found {
+				continue
+			}
+
+			value, found := object[mapping.Source]
+			if !found {
+				continue
+			}
+
+			if mapping.Transform != nil {
+				value = mapping.Transform(value)
+			}
+
+			object[attribute.ExternalId] = value
+		}
+
+		for source := range added {
+			delete(object, source)
+		}
+	}
+}
+
+// adapter maps the users and groups returned by the next adapter to the normalized attributes.
+type adapter[Config any] struct {
+	next     framework.Adapter[Config]
+	mappings map[string]EntityMappings
+}
+
+// NewAdapter wraps an adapter to return the normalized attributes requested for its entities, if the datasource
+// type is one of the Mappings. The requests without normalized attributes are left unchanged.
+func NewAdapter[Config any](next framework.Adapter[Config], datasourceType string) framework.Adapter[Config] {
+	name, _, _ := strings.Cut(datasourceType, "-")
+
+	return &adapter[Config]{
+		next:     next,
+		mappings: Mappings[name],
+	}
+}
+
+// GetPage implements framework.Adapter.
+func (a *adapter[Config]) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	var normalized bool
+
+	for _, attribute := range request.Entity.Attributes {
+		if IsNormalized(attribute.ExternalId) {
+			normalized = true
+
+			break
+		}
+	}
+
+	if !normalized {
+		return a.next.GetPage(ctx, request)
+	}
+
+	mappings := a.mappings[request.Entity.ExternalId]
+
+	attributes, added, err := Rewrite(mappings, &request.Entity)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	// The request is passed on with the attributes of the datasource, and the attributes restored afterwards,
+	// so that the changes of the other fields of the request by the next adapter are kept, e.g. the address.
+	requestedAttributes := request.Entity.Attributes
+	request.Entity.Attributes = attributes
+
+	response := a.next.GetPage(ctx, request)
+
+	request.Entity.Attributes = requestedAttributes
+
+	if response.Success != nil {
+		Apply(mappings, &request.Entity, added, response.Success.Objects)
+	}
+
+	return response
+}
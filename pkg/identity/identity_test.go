@@ -0,0 +1,214 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package identity_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/identity"
+)
+
+type testConfig struct{}
+
+// testAdapter returns the requested attributes of its objects, like the adapters, and records the external IDs
+// of the requested attributes.
+type testAdapter struct {
+	objects             []framework.Object
+	requestedAttributes []string
+}
+
+func (a *testAdapter) GetPage(_ context.Context, request *framework.Request[testConfig]) framework.Response {
+	a.requestedAttributes = nil
+
+	for _, attribute := range request.Entity.Attributes {
+		a.requestedAttributes = append(a.requestedAttributes, attribute.ExternalId)
+	}
+
+	objects := make([]framework.Object, 0, len(a.objects))
+
+	for _, object := range a.objects {
+		converted := framework.Object{}
+
+		for _, attribute := range request.Entity.Attributes {
+			if value, found := object[attribute.ExternalId]; found {
+				converted[attribute.ExternalId] = value
+			}
+		}
+
+		objects = append(objects, converted)
+	}
+
+	return framework.NewGetPageResponseSuccess(&framework.Page{Objects: objects})
+}
+
+func TestNewAdapter(t *testing.T) {
+	oktaUsers := []framework.Object{
+		{"id": "00u1", "$.profile.email": "john@example.com", "$.profile.login": "john", "status": "ACTIVE"},
+		{"id": "00u2", "$.profile.email": "jane@example.com", "$.profile.login": "jane", "status": "SUSPENDED"},
+	}
+
+	tests := map[string]struct {
+		datasourceType          string
+		objects                 []framework.Object
+		entity                  framework.EntityConfig
+		wantRequestedAttributes []string
+		wantResponse            framework.Response
+	}{
+		"no_normalized_attributes": {
+			datasourceType: "Okta-1.0.1",
+			objects:        oktaUsers,
+			entity: framework.EntityConfig{
+				ExternalId: "User",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+					{ExternalId: "status", Type: framework.AttributeTypeString},
+				},
+			},
+			wantRequestedAttributes: []string{"id", "status"},
+			wantResponse: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "00u1", "status": "ACTIVE"},
+					{"id": "00u2", "status": "SUSPENDED"},
+				},
+			}),
+		},
+		"okta_users": {
+			datasourceType: "Okta-1.0.1",
+			objects:        oktaUsers,
+			entity: framework.EntityConfig{
+				ExternalId: "User",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "identity.id", Type: framework.AttributeTypeString, UniqueId: true},
+					{ExternalId: "$.profile.login", Type: framework.AttributeTypeString},
+					{ExternalId: "identity.email", Type: framework.AttributeTypeString},
+					{ExternalId: "identity.username", Type: framework.AttributeTypeString},
+					{ExternalId: "identity.active", Type: framework.AttributeTypeBool},
+				},
+			},
+			// The attributes of the datasource are only returned if they are requested.
+			wantRequestedAttributes: []string{"id", "$.profile.login", "$.profile.email", "status"},
+			wantResponse: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"identity.id": "00u1", "$.profile.login": "john", "identity.email": "john@example.com", "identity.username": "john", "identity.active": true},
+					{"identity.id": "00u2", "$.profile.login": "jane", "identity.email": "jane@example.com", "identity.username": "jane", "identity.active": false},
+				},
+			}),
+		},
+		"google_workspace_users": {
+			datasourceType: "GoogleWorkspace-1.0.0",
+			objects: []framework.Object{
+				{"id": "101", "primaryEmail": "john@example.com", "suspended": false},
+				{"id": "102", "primaryEmail": "jane@example.com"},
+			},
+			entity: framework.EntityConfig{
+				ExternalId: "User",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+					{ExternalId: "identity.email", Type: framework.AttributeTypeString},
+					{ExternalId: "identity.username", Type: framework.AttributeTypeString},
+					{ExternalId: "identity.active", Type: framework.AttributeTypeBool},
+				},
+			},
+			wantRequestedAttributes: []string{"id", "primaryEmail", "suspended"},
+			wantResponse: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "101", "identity.email": "john@example.com", "identity.username": "john@example.com", "identity.active": true},
+					{"id": "102", "identity.email": "jane@example.com", "identity.username": "jane@example.com"},
+				},
+			}),
+		},
+		"azure_ad_groups": {
+			datasourceType: "AzureAD-1.0.1",
+			objects: []framework.Object{
+				{"id": "g1", "displayName": "Engineering", "mail": "eng@example.com"},
+			},
+			entity: framework.EntityConfig{
+				ExternalId: "Group",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+					{ExternalId: "identity.name", Type: framework.AttributeTypeString},
+					{ExternalId: "identity.email", Type: framework.AttributeTypeString},
+				},
+			},
+			wantRequestedAttributes: []string{"id", "displayName", "mail"},
+			wantResponse: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "g1", "identity.name": "Engineering", "identity.email": "eng@example.com"},
+				},
+			}),
+		},
+		"unsupported_attribute": {
+			datasourceType: "Okta-1.0.1",
+			objects:        oktaUsers,
+			entity: framework.EntityConfig{
+				ExternalId: "Group",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+					{ExternalId: "identity.email", Type: framework.AttributeTypeString},
+				},
+			},
+			wantResponse: framework.NewGetPageResponseError(&framework.Error{
+				Message: "Attribute identity.email of entity Group is not a supported identity attribute of the datasource.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}),
+		},
+		"unsupported_datasource_type": {
+			datasourceType: "Slack-1.0.0",
+			objects:        oktaUsers,
+			entity: framework.EntityConfig{
+				ExternalId: "User",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "identity.id", Type: framework.AttributeTypeString, UniqueId: true},
+				},
+			},
+			wantResponse: framework.NewGetPageResponseError(&framework.Error{
+				Message: "Attribute identity.id of entity User is not a supported identity attribute of the datasource.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}),
+		},
+		"invalid_attribute_type": {
+			datasourceType: "Okta-1.0.1",
+			objects:        oktaUsers,
+			entity: framework.EntityConfig{
+				ExternalId: "User",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+					{ExternalId: "identity.active", Type: framework.AttributeTypeString},
+				},
+			},
+			wantResponse: framework.NewGetPageResponseError(&framework.Error{
+				Message: "Attribute identity.active of entity User must be a single bool value.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := &testAdapter{objects: tt.objects}
+			adapter := identity.NewAdapter[testConfig](next, tt.datasourceType)
+
+			request := &framework.Request[testConfig]{Entity: tt.entity}
+
+			gotResponse := adapter.GetPage(context.Background(), request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(next.requestedAttributes, tt.wantRequestedAttributes) {
+				t.Errorf("gotRequestedAttributes: %v, wantRequestedAttributes: %v", next.requestedAttributes, tt.wantRequestedAttributes)
+			}
+
+			// The requested attributes are restored.
+			if !reflect.DeepEqual(request.Entity, tt.entity) {
+				t.Errorf("gotEntity: %v, wantEntity: %v", request.Entity, tt.entity)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -60,7 +61,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	}
 
 	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor)
+	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
@@ -68,12 +69,22 @@ func (a *Adapter) RequestPageFromDatasource(
 	identityNowReq := &Request{
 		BaseURL:               request.Address,
 		Token:                 request.Auth.HTTPAuthorization,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		Cursor:                cursor,
 		APIVersion:            *entityAPIVersion,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
 		Filter:                entityConfig.Filter,
+		SourceIDs:             request.Config.SourceIDs,
+		ExcludedSourceIDs:     request.Config.ExcludedSourceIDs,
+	}
+
+	if request.Config.AccountCollectionPageSize != nil {
+		identityNowReq.AccountCollectionPageSize = *request.Config.AccountCollectionPageSize
+	}
+
+	if request.Config.AccountCollectionConcurrency != nil {
+		identityNowReq.AccountCollectionConcurrency = *request.Config.AccountCollectionConcurrency
 	}
 
 	resp, err := a.IdentityNowClient.GetPage(ctx, identityNowReq)
@@ -87,6 +98,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone, if applicable.
@@ -435,8 +435,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Failed to decode base64 cursor: illegal base64 data at input byte 3.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+					Message: "Invalid cursor for entity accounts: failed to decode base64 cursor: illegal base64 data at input byte 3. " +
+						`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
 		},
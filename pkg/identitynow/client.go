@@ -53,6 +53,22 @@ type Request struct {
 	// Example: sorters=type,-modified
 	// Results are sorted primarily by type in ascending order, and secondarily by modified date in descending order.
 	Sorters *string
+
+	// AccountCollectionPageSize is the number of accounts to request at once when collecting the entitlements
+	// of the accounts. If 0, the AccountCollectionPageSize of the Datasource is used.
+	AccountCollectionPageSize int
+
+	// AccountCollectionConcurrency is the maximum number of accounts whose entitlements are requested
+	// concurrently. If 0 or 1, the entitlements of the accounts are requested serially.
+	AccountCollectionConcurrency int
+
+	// SourceIDs contains the optional allowlist of the IDs of the sources of the accounts to return.
+	// It's applied to the accounts requests using the `filters` query parameter.
+	SourceIDs []string
+
+	// ExcludedSourceIDs contains the optional denylist of the IDs of the sources of the accounts to not return.
+	// The accounts of these sources are removed from the responses of the accounts requests.
+	ExcludedSourceIDs []string
 }
 
 // Response is a response returned by the datasource.
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/sgnl-ai/adapters/pkg/config"
 )
@@ -23,6 +24,9 @@ var supportedAPIVersions = map[string]struct{}{
 	"requestTimeoutSeconds": 10,
 	"localTimeZoneOffset": 43200,
 	"apiVersion": "v3",
+	"accountCollectionPageSize": 250,
+	"accountCollectionConcurrency": 4,
+	"excludedSourceIds": ["2c9180835d2e5168015d32f890ca1581"],
 	"entityConfig": {
 		"accounts": {
 			"uniqueIDAttribute": "id",
@@ -41,6 +45,22 @@ type Config struct {
 	// EntityConfig is a map of configs for each entity associated with this datasource.
 	// The key is the entity's external ID, and the value is a map of config values.
 	EntityConfig map[string]EntityConfig `json:"entityConfig,omitempty"`
+
+	// AccountCollectionPageSize is the number of accounts requested at once when collecting the entitlements
+	// of the accounts. Optional. Defaults to the page size of the adapter, 100.
+	AccountCollectionPageSize *int `json:"accountCollectionPageSize,omitempty"`
+
+	// AccountCollectionConcurrency is the maximum number of accounts whose entitlements are requested
+	// concurrently. Optional. Defaults to 1, i.e. the entitlements of the accounts are requested serially.
+	AccountCollectionConcurrency *int `json:"accountCollectionConcurrency,omitempty"`
+
+	// SourceIDs is the allowlist of the IDs of the sources to collect the accounts and account entitlements of.
+	// Optional. If not set, the accounts of all the sources are collected.
+	SourceIDs []string `json:"sourceIds,omitempty"`
+
+	// ExcludedSourceIDs is the denylist of the IDs of the sources to not collect the accounts and account
+	// entitlements of. Optional.
+	ExcludedSourceIDs []string `json:"excludedSourceIds,omitempty"`
 }
 
 type EntityConfig struct {
@@ -68,6 +88,24 @@ func (c *Config) Validate(_ context.Context) error {
 			return fmt.Errorf("apiVersion %s is not supported", c.APIVersion)
 		}
 
+		if c.AccountCollectionPageSize != nil &&
+			(*c.AccountCollectionPageSize < 1 || *c.AccountCollectionPageSize > MaxPageSize) {
+			return fmt.Errorf("accountCollectionPageSize must be between 1 and %d", MaxPageSize)
+		}
+
+		if c.AccountCollectionConcurrency != nil &&
+			(*c.AccountCollectionConcurrency < 1 || *c.AccountCollectionConcurrency > MaxAccountCollectionConcurrency) {
+			return fmt.Errorf("accountCollectionConcurrency must be between 1 and %d", MaxAccountCollectionConcurrency)
+		}
+
+		if slices.Contains(c.SourceIDs, "") {
+			return errors.New("sourceIds cannot contain an empty source ID")
+		}
+
+		if slices.Contains(c.ExcludedSourceIDs, "") {
+			return errors.New("excludedSourceIds cannot contain an empty source ID")
+		}
+
 		// Loop through each key in the entity config and validate:
 		// 1) The entity config is not empty.
 		// 2) The uniqueIDAttribute is not empty.
@@ -4,23 +4,22 @@ package identitynow
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
-	"time"
+	"sync"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
-	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mitchellh/mapstructure"
-	"go.uber.org/zap"
 )
 
 const (
@@ -42,6 +41,14 @@ var (
 type AccountObject struct {
 	AccountID       string `mapstructure:"id" validate:"required"`
 	HasEntitlements bool   `mapstructure:"hasEntitlements" validate:"omitempty"`
+	SourceID        string `mapstructure:"sourceId" validate:"omitempty"`
+}
+
+// entitlementsPage is a page of entitlements of an account, requested ahead of its processing.
+type entitlementsPage struct {
+	response *Response
+	objects  []map[string]any
+	err      *framework.Error
 }
 
 // Datasource directly implements a Client interface to allow querying an external datasource.
@@ -144,6 +151,16 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		}
 	}
 
+	// The accounts of the excluded sources are removed after the next cursor is calculated, since the offset
+	// counts every account returned by the datasource.
+	if request.EntityExternalID == Accounts && len(request.ExcludedSourceIDs) > 0 {
+		objects = slices.DeleteFunc(objects, func(object map[string]any) bool {
+			sourceID, _ := object["sourceId"].(string)
+
+			return slices.Contains(request.ExcludedSourceIDs, sourceID)
+		})
+	}
+
 	return &Response{
 		StatusCode:       response.StatusCode,
 		RetryAfterHeader: response.RetryAfterHeader,
@@ -152,15 +169,12 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	}, nil
 }
 
-// TODO: This is identical to the ParseResponse in the Okta package. Refactor into a shared "parser" package.
+// ParseResponse unmarshals the JSON array of objects returned by IdentityNow list endpoints.
 func ParseResponse(body []byte) (objects []map[string]any, err *framework.Error) {
 	var data DatasourceResponse
 
-	if unmarshalErr := json.Unmarshal(body, &data); unmarshalErr != nil || data == nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", unmarshalErr),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, unmarshalErr
 	}
 
 	return data, nil
@@ -231,10 +245,11 @@ func (d *Datasource) handleAccountEntitlements(ctx context.Context, request *Req
 
 	accountEntitlementObjects := make([]map[string]any, 0, request.PageSize)
 
-	// Loop through the account objects and call `account/{accountId/entitlements` endpoint if the account has
-	// entitlements.
-	for i, account := range accountResponse.Objects {
-		// Get the account `id` and `hasEntitlements` fields from the account object to construct the request.
+	accountObjects := make([]*AccountObject, 0, len(accountResponse.Objects))
+
+	for _, account := range accountResponse.Objects {
+		// Get the account `id`, `hasEntitlements` and `sourceId` fields from the account object to construct
+		// the request.
 		accountObject := new(AccountObject)
 
 		if err := mapstructure.Decode(account, &accountObject); err != nil {
@@ -252,8 +267,18 @@ func (d *Datasource) handleAccountEntitlements(ctx context.Context, request *Req
 			}
 		}
 
-		// If the account has no entitlements, skip to the next account.
-		if !accountObject.HasEntitlements {
+		accountObjects = append(accountObjects, accountObject)
+	}
+
+	// The entitlements of the accounts are requested ahead of their processing, in batches of up to
+	// `AccountCollectionConcurrency` accounts.
+	entitlementsPages := make([]*entitlementsPage, len(accountObjects))
+
+	// Loop through the account objects and call `account/{accountId/entitlements` endpoint if the account has
+	// entitlements.
+	for i, accountObject := range accountObjects {
+		// If the account has no entitlements or its source is excluded, skip to the next account.
+		if !hasEntitlementsToCollect(accountObject, request) {
 			continue
 		}
 
@@ -271,10 +296,14 @@ func (d *Datasource) handleAccountEntitlements(ctx context.Context, request *Req
 			CollectionCursor: nextCollectionCursor,
 		}
 
-		// Get the entitlements for the account.
-		response, entitlementObjects, frameworkErr := d.ConstructEndpointAndGetResponse(ctx, request)
-		if frameworkErr != nil {
-			return nil, frameworkErr
+		// Get the entitlements for the account, along with the next batch of accounts if they weren't requested yet.
+		if entitlementsPages[i] == nil {
+			d.getEntitlementsPages(ctx, request, accountObjects[i:], entitlementsPages[i:])
+		}
+
+		response, entitlementObjects := entitlementsPages[i].response, entitlementsPages[i].objects
+		if entitlementsPages[i].err != nil {
+			return nil, entitlementsPages[i].err
 		}
 
 		if response.StatusCode != http.StatusOK {
@@ -400,8 +429,59 @@ func (d *Datasource) handleAccountEntitlements(ctx context.Context, request *Req
 	return response, nil
 }
 
+// hasEntitlementsToCollect returns whether the account has entitlements and its source isn't excluded.
+func hasEntitlementsToCollect(account *AccountObject, request *Request) bool {
+	return account.HasEntitlements && !slices.Contains(request.ExcludedSourceIDs, account.SourceID)
+}
+
+// getEntitlementsPages concurrently requests the pages of entitlements of the next accounts with entitlements to
+// collect, up to `AccountCollectionConcurrency` accounts. The first account is requested from the offset of the
+// request cursor, and the following accounts from offset 0, and the pages are stored at the index of their account.
+func (d *Datasource) getEntitlementsPages(
+	ctx context.Context,
+	request *Request,
+	accounts []*AccountObject,
+	pages []*entitlementsPage,
+) {
+	concurrency := max(request.AccountCollectionConcurrency, 1)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < len(accounts) && concurrency > 0; i++ {
+		if !hasEntitlementsToCollect(accounts[i], request) {
+			continue
+		}
+
+		var offset int64
+		if i == 0 {
+			offset = *request.Cursor.Cursor
+		}
+
+		entitlementsReq := *request
+		entitlementsReq.Cursor = &pagination.CompositeCursor[int64]{
+			Cursor:           &offset,
+			CollectionID:     &accounts[i].AccountID,
+			CollectionCursor: request.Cursor.CollectionCursor,
+		}
+
+		pages[i] = &entitlementsPage{}
+		concurrency--
+
+		wg.Add(1)
+
+		go func(page *entitlementsPage) {
+			defer wg.Done()
+
+			page.response, page.objects, page.err = d.ConstructEndpointAndGetResponse(ctx, &entitlementsReq)
+		}(pages[i])
+	}
+
+	wg.Wait()
+}
+
 // getAccountsForEntitlements retrieves the accounts for fetching the entitlements.
-// The number of accounts returned is determined by the value in AccountCollectionPageSize.
+// The number of accounts returned is determined by the AccountCollectionPageSize of the request, if set,
+// or else of the Datasource.
 func (d *Datasource) getAccountsForEntitlements(ctx context.Context, request *Request) (*Response, *framework.Error) {
 	var (
 		accountCursor *pagination.CompositeCursor[int64]
@@ -418,11 +498,17 @@ func (d *Datasource) getAccountsForEntitlements(ctx context.Context, request *Re
 		}
 	}
 
+	accountCollectionPageSize := d.AccountCollectionPageSize
+	if request.AccountCollectionPageSize > 0 {
+		accountCollectionPageSize = request.AccountCollectionPageSize
+	}
+
 	// Setup an Account request to retrieve account IDs.
+	// The accounts of the excluded sources aren't removed here, since the collection cursor counts every account.
 	accountReq := &Request{
 		BaseURL:               request.BaseURL,
 		Token:                 request.Token,
-		PageSize:              int64(d.AccountCollectionPageSize),
+		PageSize:              int64(accountCollectionPageSize),
 		Cursor:                accountCursor,
 		EntityExternalID:      Accounts,
 		APIVersion:            request.APIVersion,
@@ -430,7 +516,8 @@ func (d *Datasource) getAccountsForEntitlements(ctx context.Context, request *Re
 		// Use the filter on the AccountEntitlements config to filter
 		// the accounts to retrieve entitlements from.
 		// TODO [sc-19213]: Remove this hack once POC complete to use a proper filter config.
-		Filter: request.Filter,
+		Filter:    request.Filter,
+		SourceIDs: request.SourceIDs,
 	}
 
 	accountReq.Sorters = &DefaultAccountSorter
@@ -463,74 +550,40 @@ func (d *Datasource) ConstructEndpointAndGetResponse(
 		return nil, nil, errFramework
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
-	// Timeout API calls that take longer than the configured timeout.
-	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
-	defer cancel()
-
-	req = req.WithContext(apiCtx)
-
-	req.Header.Add("Authorization", request.Token)
-	req.Header.Add("Content-Type", "application/json")
+	var objects []map[string]any
 
-	logger.Info("Sending request to datasource", fields.RequestURL(endpoint))
-
-	res, err := d.Client.Do(req)
-	if err != nil {
-		logger.Error("Request to datasource failed",
-			fields.RequestURL(endpoint),
-			fields.SGNLEventTypeError(),
-			zap.Error(err),
-		)
-
-		return nil, nil, customerror.UpdateError(&framework.Error{
-			Message: fmt.Sprintf("Failed to execute IdentityNow request: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+	httpResponse, errFramework := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpoint,
+		Header: http.Header{
+			"Authorization": {request.Token},
+			"Content-Type":  {"application/json"},
 		},
-			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
-		)
-	}
-
-	defer res.Body.Close()
+		DatasourceName:        "IdentityNow",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "IdentityNow")
+		if readErr != nil {
+			return readErr
+		}
 
-	if res.StatusCode != http.StatusOK {
-		logger.Error("Datasource responded with an error",
-			fields.RequestURL(endpoint),
-			fields.ResponseStatusCode(res.StatusCode),
-			fields.ResponseRetryAfterHeader(res.Header.Get("Retry-After")),
-			fields.ResponseBody(res.Body),
-			fields.SGNLEventTypeError(),
-		)
+		var parseErr *framework.Error
 
-		return &Response{
-			StatusCode:       res.StatusCode,
-			RetryAfterHeader: res.Header.Get("Retry-After"),
-		}, nil, nil
-	}
+		objects, parseErr = ParseResponse(bodyBytes)
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to read IdentityNow response: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
-	objects, errFramework := ParseResponse(body)
+		return parseErr
+	}, nil)
 	if errFramework != nil {
 		return nil, nil, errFramework
 	}
 
 	response := &Response{
-		StatusCode:       res.StatusCode,
-		RetryAfterHeader: res.Header.Get("Retry-After"),
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil, nil
 	}
 
 	logger.Info("Datasource request completed successfully",
@@ -1200,6 +1200,26 @@ func TestGetAccountsPage(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"last_page_with_excluded_source": {
+			context: context.Background(),
+			request: &identitynow.Request{
+				Token:                 "Bearer token",
+				BaseURL:               server.URL,
+				EntityExternalID:      "accounts",
+				PageSize:              2,
+				APIVersion:            "v3",
+				RequestTimeoutSeconds: 5,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+				ExcludedSourceIDs: []string{"602dbeacc6eb429c9038a4bb2d776e28"},
+			},
+			wantRes: &identitynow.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]any{},
+			},
+			wantErr: nil,
+		},
 		"not_found_response": {
 			context: context.Background(),
 			request: &identitynow.Request{
@@ -1325,6 +1345,79 @@ func TestGetAccountEntitlements(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"fetch_account_entitlements_for_first_5_accounts_concurrently_by_4th_account_page_is_full": {
+			context: context.Background(),
+			request: &identitynow.Request{
+				Token:                 "Bearer token",
+				BaseURL:               server.URL,
+				EntityExternalID:      "accountEntitlements",
+				PageSize:              10,
+				APIVersion:            "beta",
+				RequestTimeoutSeconds: 5,
+				// The entitlements of testaccountId5 are not requested, since it has no entitlements.
+				AccountCollectionConcurrency: 3,
+			},
+			wantRes: &identitynow.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"id":            "testaccountId1-entitlementId1",
+						"accountId":     "testaccountId1",
+						"entitlementId": "entitlementId1",
+					},
+					{
+						"id":            "testaccountId1-entitlementId2",
+						"accountId":     "testaccountId1",
+						"entitlementId": "entitlementId2",
+					},
+					{
+						"id":            "testaccountId2-entitlementId3",
+						"accountId":     "testaccountId2",
+						"entitlementId": "entitlementId3",
+					},
+					{
+						"id":            "testaccountId2-entitlementId4",
+						"accountId":     "testaccountId2",
+						"entitlementId": "entitlementId4",
+					},
+					{
+						"id":            "testaccountId2-entitlementId5",
+						"accountId":     "testaccountId2",
+						"entitlementId": "entitlementId5",
+					},
+					{
+						"id":            "testaccountId3-entitlementId6",
+						"accountId":     "testaccountId3",
+						"entitlementId": "entitlementId6",
+					},
+					{
+						"id":            "testaccountId3-entitlementId7",
+						"accountId":     "testaccountId3",
+						"entitlementId": "entitlementId7",
+					},
+					{
+						"id":            "testaccountId3-entitlementId8",
+						"accountId":     "testaccountId3",
+						"entitlementId": "entitlementId8",
+					},
+					{
+						"id":            "testaccountId4-entitlementId9",
+						"accountId":     "testaccountId4",
+						"entitlementId": "entitlementId9",
+					},
+					{
+						"id":            "testaccountId4-entitlementId10",
+						"accountId":     "testaccountId4",
+						"entitlementId": "entitlementId10",
+					},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					CollectionID:     testutil.GenPtr[string]("testaccountId4"),
+					CollectionCursor: testutil.GenPtr[int64](4),
+				},
+			},
+			wantErr: nil,
+		},
 		"fetch_account_entitlements_5_accounts_by_2nd_account_page_is_full_and_excess_entitlements_for_account": {
 			context: context.Background(),
 			request: &identitynow.Request{
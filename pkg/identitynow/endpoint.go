@@ -74,11 +74,11 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 	// TODO [sc-19213]: We don't apply any filters when querying AccountEntitlements. This is because
 	// the filter that is passed in must be used to filter the accounts that we're retrieving entitlements for
 	// and NOT the entitlements themselves. This is a limitation that must be addressed in the future.
-	if request.Filter != nil && request.EntityExternalID != AccountEntitlements {
+	if filter := constructFilter(request); filter != "" {
 		// IdentityNow requires spaces to be encoded as %20 instead of +.
 		// https://developer.sailpoint.com/idn/api/standard-collection-parameters/#known-limitations.
 		// Golang's url.QueryEscape() encodes spaces as +, so we need to replace them with %20.
-		escapedFilter := strings.Replace(url.QueryEscape(*request.Filter), "+", "%20", -1)
+		escapedFilter := strings.Replace(url.QueryEscape(filter), "+", "%20", -1)
 		endpoint.Grow(len(escapedFilter) + 9)
 
 		endpoint.WriteString("&filters=")
@@ -87,3 +87,30 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 
 	return endpoint.String(), nil
 }
+
+// constructFilter returns the filter of the request, if any, combined with the filter on the allowed source IDs
+// of the accounts, e.g. `(type eq "ENTITLEMENT") and sourceId in ("2c91808a","2c91808b")`.
+func constructFilter(request *Request) string {
+	var filter string
+
+	if request.Filter != nil && request.EntityExternalID != AccountEntitlements {
+		filter = *request.Filter
+	}
+
+	if request.EntityExternalID != Accounts || len(request.SourceIDs) == 0 {
+		return filter
+	}
+
+	quotedSourceIDs := make([]string, 0, len(request.SourceIDs))
+	for _, sourceID := range request.SourceIDs {
+		quotedSourceIDs = append(quotedSourceIDs, strconv.Quote(sourceID))
+	}
+
+	sourceFilter := "sourceId in (" + strings.Join(quotedSourceIDs, ",") + ")"
+
+	if filter == "" {
+		return sourceFilter
+	}
+
+	return "(" + filter + ") and " + sourceFilter
+}
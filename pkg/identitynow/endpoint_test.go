@@ -62,6 +62,35 @@ func TestConstructEndpoint(t *testing.T) {
 			},
 			wantEndpoint: "https://sgnl-dev.api.identitynow-demo.com/v3/accounts?limit=100&offset=0&filters=name%20eq%20%22John%2B%20Doe%22",
 		},
+		"accounts_with_source_ids": {
+			request: &identitynow.Request{
+				BaseURL:          "https://sgnl-dev.api.identitynow-demo.com",
+				APIVersion:       "v3",
+				EntityExternalID: "accounts",
+				PageSize:         100,
+				Token:            "Bearer token",
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](0),
+				},
+				SourceIDs: []string{"2c91808a", "2c91808b"},
+			},
+			wantEndpoint: "https://sgnl-dev.api.identitynow-demo.com/v3/accounts?limit=100&offset=0&filters=sourceId%20in%20%28%222c91808a%22%2C%222c91808b%22%29",
+		},
+		"accounts_with_filter_and_source_ids": {
+			request: &identitynow.Request{
+				BaseURL:          "https://sgnl-dev.api.identitynow-demo.com",
+				APIVersion:       "v3",
+				EntityExternalID: "accounts",
+				PageSize:         100,
+				Token:            "Bearer token",
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](0),
+				},
+				Filter:    testutil.GenPtr[string](`name eq "John Doe"`),
+				SourceIDs: []string{"2c91808a"},
+			},
+			wantEndpoint: "https://sgnl-dev.api.identitynow-demo.com/v3/accounts?limit=100&offset=0&filters=%28name%20eq%20%22John%20Doe%22%29%20and%20sourceId%20in%20%28%222c91808a%22%29",
+		},
 		"accounts_nil_composite_cursor": {
 			request: &identitynow.Request{
 				BaseURL:          "https://sgnl-dev.api.identitynow-demo.com",
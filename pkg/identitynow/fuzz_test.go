@@ -0,0 +1,30 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package identitynow_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/identitynow"
+)
+
+// FuzzParseResponse checks that malformed IdentityNow responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`[{"id":"2c9180835d2e5168015d32f890ca1581","name":"john.doe"}]`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := identitynow.ParseResponse(body)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
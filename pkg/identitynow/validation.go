@@ -18,6 +18,9 @@ const (
 	// The IdentityNow documentation specifies a page size limit of 250.
 	// https://developer.sailpoint.com/idn/api/standard-collection-parameters/#paginating-results.
 	MaxPageSize = 250
+
+	// MaxAccountCollectionConcurrency is the maximum number of concurrent requests for the entitlements of accounts.
+	MaxAccountCollectionConcurrency = 10
 )
 
 // ValidateGetPageRequest validates the fields of the GetPage Request.
@@ -251,6 +251,70 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"invalid_request_account_collection_concurrency_too_high": {
+			request: &framework.Request[identitynow_adapter.Config]{
+				Address: "sgnl-dev-tenant.api.identitynow.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer token",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "accounts",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &identitynow_adapter.Config{
+					APIVersion: "v3",
+					EntityConfig: map[string]identitynow_adapter.EntityConfig{
+						"accounts": {
+							UniqueIDAttribute: "id",
+						},
+					},
+					AccountCollectionConcurrency: testutil.GenPtr(11),
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "IdentityNow config is invalid: accountCollectionConcurrency must be between 1 and 10.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_empty_excluded_source_id": {
+			request: &framework.Request[identitynow_adapter.Config]{
+				Address: "sgnl-dev-tenant.api.identitynow.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer token",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "accounts",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &identitynow_adapter.Config{
+					APIVersion: "v3",
+					EntityConfig: map[string]identitynow_adapter.EntityConfig{
+						"accounts": {
+							UniqueIDAttribute: "id",
+						},
+					},
+					ExcludedSourceIDs: []string{"2c91808a", ""},
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "IdentityNow config is invalid: excludedSourceIds cannot contain an empty source ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_request_ordered_true": {
 			request: &framework.Request[identitynow_adapter.Config]{
 				Address: "sgnl-dev-tenant.api.identitynow.com",
@@ -0,0 +1,145 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package intune
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
+)
+
+// Adapter implements the framework.Adapter interface to query pages of objects
+// from datasources.
+type Adapter struct {
+	IntuneClient Client
+}
+
+// NewAdapter instantiates a new Adapter.
+func NewAdapter(client Client) framework.Adapter[Config] {
+	return &Adapter{
+		IntuneClient: client,
+	}
+}
+
+// GetPage is called by SGNL's ingestion service to query a page of objects
+// from a datasource.
+func (a *Adapter) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	if err := a.ValidateGetPageRequest(ctx, request); err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return a.RequestPageFromDatasource(ctx, request)
+}
+
+// RequestPageFromDatasource requests a page of objects from a datasource.
+func (a *Adapter) RequestPageFromDatasource(
+	ctx context.Context, request *framework.Request[Config],
+) framework.Response {
+	var commonConfig *config.CommonConfig
+	if request.Config != nil {
+		commonConfig = request.Config.CommonConfig
+	}
+
+	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
+
+	// Unmarshal the current cursor.
+	cursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	intuneReq := &Request{
+		BaseURL:               request.Address,
+		Token:                 request.Auth.HTTPAuthorization,
+		APIVersion:            request.Config.APIVersion,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
+		EntityExternalID:      request.Entity.ExternalId,
+		Cursor:                cursor,
+		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
+	}
+
+	// The filter of a member entity applies to the objects its members are listed from.
+	if memberOf := ValidEntityExternalIDs[request.Entity.ExternalId].memberOf; memberOf != "" {
+		intuneReq.ParentFilter = entityFilter(request.Config, memberOf, commonConfig.ChangedSince())
+	} else {
+		intuneReq.Filter = entityFilter(request.Config, request.Entity.ExternalId, commonConfig.ChangedSince())
+	}
+
+	resp, err := a.IntuneClient.GetPage(ctx, intuneReq)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	// An adapter error message is generated if the response status code is not
+	// successful (i.e. if not statusCode >= 200 && statusCode < 300).
+	if adapterErr := web.HTTPError(resp.StatusCode, resp.RetryAfterHeader); adapterErr != nil {
+		return framework.NewGetPageResponseError(adapterErr)
+	}
+
+	schemadrift.Observe(ctx, resp.Objects)
+
+	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
+	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
+	parsedObjects, parserErr := web.ConvertJSONObjectList(
+		&request.Entity,
+		resp.Objects,
+		web.WithJSONPathAttributeNames(),
+		web.WithDateTimeFormats(
+			[]web.DateTimeFormatWithTimeZone{
+				// The times are returned in RFC 3339 format, e.g. "lastSyncDateTime": "2026-01-02T03:04:05.1234567Z".
+				{Format: time.RFC3339, HasTimeZone: true},
+			}...,
+		),
+		web.WithLocalTimeZoneOffset(commonConfig.LocalTimeZoneOffset),
+	)
+	if parserErr != nil {
+		return framework.NewGetPageResponseError(
+			&framework.Error{
+				Message: fmt.Sprintf("Failed to convert datasource response objects: %v.", parserErr),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		)
+	}
+
+	// Marshal the next cursor.
+	nextCursor, err := pagination.MarshalCursor(resp.NextCursor)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return framework.NewGetPageResponseSuccess(&framework.Page{
+		Objects:    parsedObjects,
+		NextCursor: nextCursor,
+	})
+}
+
+// entityFilter returns the $filter to list the objects of an entity: the filter configured for the entity, and
+// during an incremental sync, the objects changed since the last sync if the entity supports it. nil if the objects
+// aren't filtered.
+func entityFilter(cfg *Config, entityExternalID string, changedSince *time.Time) *string {
+	filter := cfg.Filters[entityExternalID]
+
+	attribute := ValidEntityExternalIDs[entityExternalID].changedSinceAttribute
+	if changedSince != nil && attribute != "" {
+		changedSinceFilter := fmt.Sprintf("%s ge %s", attribute, changedSince.UTC().Format(time.RFC3339))
+
+		if filter == "" {
+			filter = changedSinceFilter
+		} else {
+			filter = fmt.Sprintf("(%s) and %s", filter, changedSinceFilter)
+		}
+	}
+
+	if filter == "" {
+		return nil
+	}
+
+	return &filter
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package intune_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/intune"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := intune.NewAdapter(&intune.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[string]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	bearerAuth := &framework.DatasourceAuthCredentials{
+		HTTPAuthorization: "Bearer testtoken",
+	}
+
+	managedDeviceEntity := framework.EntityConfig{
+		ExternalId: intune.ManagedDevice,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+				UniqueId:   true,
+			},
+			{
+				ExternalId: "deviceName",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "complianceState",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "lastSyncDateTime",
+				Type:       framework.AttributeTypeDateTime,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[intune.Config]
+		wantResponse framework.Response
+	}{
+		"managed_devices_first_page": {
+			request: &framework.Request[intune.Config]{
+				Address:  server.URL,
+				Auth:     bearerAuth,
+				Config:   &intune.Config{APIVersion: "v1.0"},
+				Entity:   managedDeviceEntity,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":               "d1",
+							"deviceName":       "DESKTOP-01",
+							"complianceState":  "compliant",
+							"lastSyncDateTime": time.Date(2026, 1, 2, 3, 4, 5, 123456700, time.UTC),
+						},
+						{
+							"id":               "d2",
+							"deviceName":       "iPhone-02",
+							"complianceState":  "noncompliant",
+							"lastSyncDateTime": time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						Cursor: testutil.GenPtr(server.URL + "/v1.0/deviceManagement/managedDevices?$top=2&$skiptoken=d3"),
+					}),
+				},
+			},
+		},
+		"managed_devices_incremental_sync_with_filter": {
+			request: &framework.Request[intune.Config]{
+				Address: server.URL,
+				Auth:    bearerAuth,
+				Config: &intune.Config{
+					CommonConfig: &config.CommonConfig{
+						SyncMode:             config.SyncModeIncremental,
+						IncrementalSyncSince: testutil.GenPtr(time.Date(2026, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))),
+					},
+					APIVersion: "v1.0",
+					Filters: map[string]string{
+						intune.ManagedDevice: "operatingSystem eq 'Windows'",
+					},
+				},
+				Entity:   managedDeviceEntity,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":               "d1",
+							"deviceName":       "DESKTOP-01",
+							"complianceState":  "compliant",
+							"lastSyncDateTime": time.Date(2026, 1, 2, 3, 4, 5, 123456700, time.UTC),
+						},
+					},
+				},
+			},
+		},
+		"compliance_policies": {
+			request: &framework.Request[intune.Config]{
+				Address: server.URL,
+				Auth:    bearerAuth,
+				Config:  &intune.Config{APIVersion: "v1.0"},
+				Entity: framework.EntityConfig{
+					ExternalId: intune.CompliancePolicy,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "@odata.type",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "version",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "lastModifiedDateTime",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                   "p1",
+							"@odata.type":          "#microsoft.graph.windows10CompliancePolicy",
+							"version":              int64(3),
+							"lastModifiedDateTime": time.Date(2025, 11, 20, 8, 30, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+		},
+		"device_compliance_states": {
+			request: &framework.Request[intune.Config]{
+				Address: server.URL,
+				Auth:    bearerAuth,
+				Config:  &intune.Config{APIVersion: "v1.0"},
+				Entity: framework.EntityConfig{
+					ExternalId: intune.DeviceComplianceState,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "deviceId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "policyStateId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "state",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 3,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "p1-d1", "deviceId": "d1", "policyStateId": "p1", "state": "compliant"},
+						{"id": "p2-d1", "deviceId": "d1", "policyStateId": "p2", "state": "compliant"},
+						{"id": "p3-d2", "deviceId": "d2", "policyStateId": "p3", "state": "nonCompliant"},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						CollectionID:     testutil.GenPtr("d2"),
+						CollectionCursor: testutil.GenPtr(server.URL + "/v1.0/deviceManagement/managedDevices?$top=1&$skiptoken=d3"),
+					}),
+				},
+			},
+		},
+		"device_configuration_assignments": {
+			request: &framework.Request[intune.Config]{
+				Address: server.URL,
+				Auth:    bearerAuth,
+				Config:  &intune.Config{APIVersion: "v1.0"},
+				Entity: framework.EntityConfig{
+					ExternalId: intune.DeviceConfigurationAssignment,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "deviceConfigurationId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.target.groupId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "c1_g1-c1", "deviceConfigurationId": "c1", "$.target.groupId": "g1"},
+					},
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[intune.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer invalid",
+				},
+				Config:   &intune.Config{APIVersion: "v1.0"},
+				Entity:   managedDeviceEntity,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package intune
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Client is a client that allows querying the Intune resources of the Microsoft Graph API.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+}
+
+// Request is a request to the datasource.
+type Request struct {
+	// BaseURL is the Base URL of the Graph API, e.g. "https://graph.microsoft.com".
+	BaseURL string
+
+	// Token is the Bearer API token to authenticate a request.
+	Token string
+
+	// APIVersion is the version of the Graph API to use.
+	APIVersion string
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity. The cursor is the @odata.nextLink of the last response.
+	// For member entities, CollectionID is the ID of the object the members are listed from, and
+	// CollectionCursor is the @odata.nextLink of the next of these objects.
+	// nil in the request for the first page.
+	Cursor *pagination.CompositeCursor[string]
+
+	// Filter contains the optional $filter to apply to the current request.
+	Filter *string
+
+	// ParentFilter contains the optional $filter to apply when listing the objects the members of a member
+	// entity are listed from.
+	ParentFilter *string
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *pagination.CompositeCursor[string]
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package intune
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+var supportedAPIVersions = map[string]struct{}{
+	"v1.0": {},
+	"beta": {},
+}
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Microsoft Intune Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "apiVersion": "v1.0",
+    "filters": {
+        "ManagedDevice": "operatingSystem eq 'Windows'"
+    },
+    "syncMode": "INCREMENTAL",
+    "incrementalSyncSince": "2026-01-01T00:00:00Z"
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// APIVersion is the version of the Microsoft Graph API to use, "v1.0" or "beta".
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Filters contains a map of $filter query parameters for the entities listed directly from the
+	// deviceManagement resources, i.e. ManagedDevice, CompliancePolicy and DeviceConfiguration. The filter
+	// of an entity also applies to the objects its member entities are listed from, e.g. the filter of
+	// ManagedDevice restricts the devices whose compliance states are returned for DeviceComplianceState.
+	Filters map[string]string `json:"filters,omitempty"`
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	switch {
+	case c == nil:
+		return errors.New("request contains no config")
+	case c.APIVersion == "":
+		return errors.New("apiVersion is not set")
+	default:
+		if _, found := supportedAPIVersions[c.APIVersion]; !found {
+			return fmt.Errorf("apiVersion is not supported: %v", c.APIVersion)
+		}
+
+		for entityExternalID := range c.Filters {
+			entity, found := ValidEntityExternalIDs[entityExternalID]
+			if !found || entity.memberOf != "" {
+				return fmt.Errorf("filters are not supported for entity: %v", entityExternalID)
+			}
+		}
+
+		return c.CommonConfig.ValidateSyncMode()
+	}
+}
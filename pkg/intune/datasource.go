@@ -0,0 +1,369 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package intune
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/jsonstream"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+}
+
+// Entity contains entity specific information, such as the endpoint the objects of the entity are listed from.
+type Entity struct {
+	// path is the path of the endpoint of the entity, relative to the API version. The path of a member entity
+	// contains a %s verb, replaced with the ID of the object the members are listed from.
+	path string
+
+	// memberOf is the entity whose objects the objects of a member entity are listed from, or empty if the entity
+	// isn't a member entity.
+	memberOf string
+
+	// memberIDAttribute is the attribute of the objects of a member entity set to their ID in the Graph API.
+	// Their unique ID attribute is set to the ID of the member followed by the ID of the object it is listed
+	// from, as the ID of a member isn't unique across these objects.
+	memberIDAttribute string
+
+	// parentIDAttribute is the attribute of the objects of a member entity set to the ID of the object
+	// they are listed from.
+	parentIDAttribute string
+
+	// changedSinceAttribute is the DateTime property filtered on to only list the objects changed since the last
+	// sync during an incremental sync, or empty if the objects are all listed during an incremental sync.
+	changedSinceAttribute string
+}
+
+const (
+	ManagedDevice       string = "ManagedDevice"
+	CompliancePolicy    string = "CompliancePolicy"
+	DeviceConfiguration string = "DeviceConfiguration"
+
+	// DeviceComplianceState are the compliance states of the managed devices with each policy assigned to them,
+	// listed from each managed device.
+	DeviceComplianceState string = "DeviceComplianceState"
+
+	// DeviceConfigurationAssignment are the groups each device configuration is assigned to, listed from each
+	// device configuration.
+	DeviceConfigurationAssignment string = "DeviceConfigurationAssignment"
+
+	// odataNextLink is the Graph API response member containing the URL of the next page.
+	odataNextLink = "@odata.nextLink"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The Graph API doesn't support delta queries for the deviceManagement resources, so an incremental sync
+	// filters the objects on their changedSinceAttribute instead, where the API supports it.
+	ValidEntityExternalIDs = map[string]Entity{
+		// GET /deviceManagement/managedDevices.
+		// https://learn.microsoft.com/en-us/graph/api/intune-devices-manageddevice-list?view=graph-rest-1.0
+		ManagedDevice: {
+			path:                  "/deviceManagement/managedDevices",
+			changedSinceAttribute: "lastSyncDateTime",
+		},
+		// GET /deviceManagement/deviceCompliancePolicies.
+		// nolint:lll
+		// https://learn.microsoft.com/en-us/graph/api/intune-deviceconfig-devicecompliancepolicy-list?view=graph-rest-1.0
+		CompliancePolicy: {
+			path: "/deviceManagement/deviceCompliancePolicies",
+		},
+		// GET /deviceManagement/deviceConfigurations.
+		// nolint:lll
+		// https://learn.microsoft.com/en-us/graph/api/intune-deviceconfig-deviceconfiguration-list?view=graph-rest-1.0
+		DeviceConfiguration: {
+			path: "/deviceManagement/deviceConfigurations",
+		},
+		// GET /deviceManagement/managedDevices/{managedDeviceId}/deviceCompliancePolicyStates.
+		DeviceComplianceState: {
+			path:              "/deviceManagement/managedDevices/%s/deviceCompliancePolicyStates",
+			memberOf:          ManagedDevice,
+			memberIDAttribute: "policyStateId",
+			parentIDAttribute: "deviceId",
+		},
+		// GET /deviceManagement/deviceConfigurations/{deviceConfigurationId}/assignments.
+		// nolint:lll
+		// https://learn.microsoft.com/en-us/graph/api/intune-deviceconfig-deviceconfigurationassignment-list?view=graph-rest-1.0
+		DeviceConfigurationAssignment: {
+			path:              "/deviceManagement/deviceConfigurations/%s/assignments",
+			memberOf:          DeviceConfiguration,
+			memberIDAttribute: "assignmentId",
+			parentIDAttribute: "deviceConfigurationId",
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+// copyCursor returns a deep copy of a cursor.
+func copyCursor(cursor *pagination.CompositeCursor[string]) *pagination.CompositeCursor[string] {
+	if cursor == nil {
+		return nil
+	}
+
+	result := &pagination.CompositeCursor[string]{}
+
+	if cursor.Cursor != nil {
+		value := *cursor.Cursor
+		result.Cursor = &value
+	}
+
+	if cursor.CollectionID != nil {
+		value := *cursor.CollectionID
+		result.CollectionID = &value
+	}
+
+	if cursor.CollectionCursor != nil {
+		value := *cursor.CollectionCursor
+		result.CollectionCursor = &value
+	}
+
+	return result
+}
+
+// GetPage fetches a page of objects. The objects of a member entity are listed from one object at a time, e.g. the
+// few compliance states of a managed device, so the members of multiple objects are accumulated to fill the page.
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	if ValidEntityExternalIDs[request.EntityExternalID].memberOf == "" {
+		return d.getPageBase(ctx, request)
+	}
+
+	accumulatedObjects := make([]map[string]any, 0)
+	currentRequest := *request
+
+	var nextCursor *pagination.CompositeCursor[string]
+
+	// Return a partial page with a cursor to resume from rather than letting the whole page miss its deadline, as
+	// each request to the datasource only has its own timeout.
+	deadlineGuard := pagination.NewDeadlineGuard(ctx)
+
+	for int64(len(accumulatedObjects)) < request.PageSize {
+		if len(accumulatedObjects) > 0 && nextCursor != nil && deadlineGuard.Expiring() {
+			zaplogger.FromContext(ctx).Info("Returning a partial page as the request deadline is about to expire",
+				fields.RequestEntityExternalID(request.EntityExternalID),
+			)
+
+			break
+		}
+
+		doneTracking := deadlineGuard.Track()
+		response, err := d.getPageBase(ctx, &currentRequest)
+
+		doneTracking()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode != http.StatusOK {
+			return response, nil
+		}
+
+		// The members of this object are returned with the next page instead, from the cursor of this request.
+		if len(accumulatedObjects) > 0 && int64(len(accumulatedObjects)+len(response.Objects)) > request.PageSize {
+			break
+		}
+
+		accumulatedObjects = append(accumulatedObjects, response.Objects...)
+		nextCursor = response.NextCursor
+
+		if nextCursor == nil {
+			break
+		}
+
+		currentRequest.Cursor = nextCursor
+	}
+
+	return &Response{
+		StatusCode: http.StatusOK,
+		Objects:    accumulatedObjects,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (d *Datasource) getPageBase(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+	isMemberEntity := entity.memberOf != ""
+
+	// The cursor of the request is updated with the object the members are listed from, so it is copied to leave
+	// the request unchanged.
+	pageRequest := *request
+	pageRequest.Cursor = copyCursor(request.Cursor)
+
+	// [MemberEntities] Set the `CollectionID` and `CollectionCursor` to the next object to list members from.
+	if isMemberEntity {
+		if pageRequest.Cursor == nil {
+			pageRequest.Cursor = &pagination.CompositeCursor[string]{}
+		}
+
+		parentRequest := &Request{
+			BaseURL:               request.BaseURL,
+			Token:                 request.Token,
+			APIVersion:            request.APIVersion,
+			PageSize:              1,
+			EntityExternalID:      entity.memberOf,
+			Filter:                request.ParentFilter,
+			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		}
+
+		if pageRequest.Cursor.CollectionCursor != nil {
+			parentRequest.Cursor = &pagination.CompositeCursor[string]{
+				Cursor: pageRequest.Cursor.CollectionCursor,
+			}
+		}
+
+		isEmptyLastPage, cursorErr := pagination.UpdateNextCursorFromCollectionAPI(
+			ctx,
+			pageRequest.Cursor,
+			func(ctx context.Context, parentRequest *Request) (
+				int, string, []map[string]any, *pagination.CompositeCursor[string], *framework.Error,
+			) {
+				resp, err := d.getPageBase(ctx, parentRequest)
+				if err != nil {
+					return 0, "", nil, nil, err
+				}
+
+				return resp.StatusCode, resp.RetryAfterHeader, resp.Objects, resp.NextCursor, nil
+			},
+			parentRequest,
+			uniqueIDAttribute,
+		)
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		// There are no more objects to list members from, the sync is complete.
+		if isEmptyLastPage {
+			return &Response{
+				StatusCode: http.StatusOK,
+			}, nil
+		}
+	}
+
+	validationErr := pagination.ValidateCompositeCursor(pageRequest.Cursor, request.EntityExternalID, isMemberEntity)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(&pageRequest)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects  []map[string]any
+		nextLink *string
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL:                   endpoint,
+		Header:                http.Header{"Authorization": {request.Token}},
+		DatasourceName:        "Microsoft Intune",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		var parseErr *framework.Error
+
+		objects, nextLink, parseErr = ParseResponse(body)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	if isMemberEntity {
+		// [MemberEntities] Set the member ID and the ID of the object the members are listed from.
+		collectionID := *pageRequest.Cursor.CollectionID
+
+		for _, object := range objects {
+			memberID, ok := object[uniqueIDAttribute].(string)
+			if !ok {
+				return nil, &framework.Error{
+					Message: fmt.Sprintf(
+						"Failed to parse %s field in Microsoft Intune %s response as string.",
+						uniqueIDAttribute, request.EntityExternalID,
+					),
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				}
+			}
+
+			object[uniqueIDAttribute] = memberID + "-" + collectionID
+			object[entity.memberIDAttribute] = memberID
+			object[entity.parentIDAttribute] = collectionID
+		}
+
+		// Continue with the next page of members of the same object if any, or with the next object.
+		pageRequest.Cursor.Cursor = nextLink
+
+		if pageRequest.Cursor.Cursor != nil || pageRequest.Cursor.CollectionCursor != nil {
+			response.NextCursor = pageRequest.Cursor
+		}
+	} else if nextLink != nil {
+		response.NextCursor = &pagination.CompositeCursor[string]{
+			Cursor: nextLink,
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse decodes the objects in the `value` member and the `@odata.nextLink` member
+// of a Graph API response.
+// Paging: https://learn.microsoft.com/en-us/graph/paging?tabs=http
+func ParseResponse(body io.Reader) (objects []map[string]any, nextLink *string, err *framework.Error) {
+	objects, values, decodeErr := jsonstream.DecodeList(body, "value", odataNextLink)
+	if decodeErr != nil {
+		return nil, nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", decodeErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	if link, found := values[odataNextLink]; found {
+		nextLink = &link
+	}
+
+	return objects, nextLink, nil
+}
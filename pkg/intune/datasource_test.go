@@ -0,0 +1,295 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package intune_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/intune"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock Graph API server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer testtoken" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error": {"code": "InvalidAuthenticationToken", "message": "Access token is empty."}}`))
+
+		return
+	}
+
+	// The next links are absolute URLs of the server.
+	nextLink := func(requestURI string) string {
+		return `"@odata.nextLink": "https://` + r.Host + requestURI + `"`
+	}
+
+	switch r.URL.RequestURI() {
+	// Managed Devices Page 1
+	case "/v1.0/deviceManagement/managedDevices?$top=2":
+		w.Write([]byte(`{"@odata.context": "https://graph.microsoft.com/v1.0/$metadata#deviceManagement/managedDevices", "value": [
+			{"id": "d1", "deviceName": "DESKTOP-01", "operatingSystem": "Windows", "complianceState": "compliant", "lastSyncDateTime": "2026-01-02T03:04:05.1234567Z", "userPrincipalName": "john@acme.com"},
+			{"id": "d2", "deviceName": "iPhone-02", "operatingSystem": "iOS", "complianceState": "noncompliant", "lastSyncDateTime": "2025-12-01T00:00:00Z", "userPrincipalName": "jane@acme.com"}
+		], ` + nextLink("/v1.0/deviceManagement/managedDevices?$top=2&$skiptoken=d3") + `}`))
+
+	// Managed Devices Page 2
+	case "/v1.0/deviceManagement/managedDevices?$top=2&$skiptoken=d3":
+		w.Write([]byte(`{"value": [
+			{"id": "d3", "deviceName": "MacBook-03", "operatingSystem": "macOS", "complianceState": "inGracePeriod", "lastSyncDateTime": "2026-01-05T00:00:00Z", "userPrincipalName": "jim@acme.com"}
+		]}`))
+
+	// Managed Devices synced since 2026-01-01, during an incremental sync.
+	case "/v1.0/deviceManagement/managedDevices?$top=2&$filter=%28operatingSystem+eq+%27Windows%27%29+and+lastSyncDateTime+ge+2026-01-01T00%3A00%3A00Z":
+		w.Write([]byte(`{"value": [
+			{"id": "d1", "deviceName": "DESKTOP-01", "operatingSystem": "Windows", "complianceState": "compliant", "lastSyncDateTime": "2026-01-02T03:04:05.1234567Z", "userPrincipalName": "john@acme.com"}
+		]}`))
+
+	// Managed Devices, one at a time, to list their compliance states.
+	case "/v1.0/deviceManagement/managedDevices?$top=1":
+		w.Write([]byte(`{"value": [{"id": "d1", "deviceName": "DESKTOP-01"}], ` + nextLink("/v1.0/deviceManagement/managedDevices?$top=1&$skiptoken=d2") + `}`))
+	case "/v1.0/deviceManagement/managedDevices?$top=1&$skiptoken=d2":
+		w.Write([]byte(`{"value": [{"id": "d2", "deviceName": "iPhone-02"}], ` + nextLink("/v1.0/deviceManagement/managedDevices?$top=1&$skiptoken=d3") + `}`))
+	case "/v1.0/deviceManagement/managedDevices?$top=1&$skiptoken=d3":
+		w.Write([]byte(`{"value": [{"id": "d3", "deviceName": "MacBook-03"}]}`))
+
+	// Device Compliance States
+	case "/v1.0/deviceManagement/managedDevices/d1/deviceCompliancePolicyStates?$top=3":
+		w.Write([]byte(`{"value": [
+			{"id": "p1", "displayName": "Windows Baseline", "platformType": "windows10AndLater", "state": "compliant", "settingCount": 4},
+			{"id": "p2", "displayName": "BitLocker", "platformType": "windows10AndLater", "state": "compliant", "settingCount": 1}
+		]}`))
+	case "/v1.0/deviceManagement/managedDevices/d2/deviceCompliancePolicyStates?$top=3":
+		w.Write([]byte(`{"value": [
+			{"id": "p3", "displayName": "iOS Baseline", "platformType": "iOS", "state": "nonCompliant", "settingCount": 2}
+		]}`))
+	case "/v1.0/deviceManagement/managedDevices/d3/deviceCompliancePolicyStates?$top=3":
+		w.Write([]byte(`{"value": []}`))
+
+	// Compliance Policies
+	case "/v1.0/deviceManagement/deviceCompliancePolicies?$top=2":
+		w.Write([]byte(`{"value": [
+			{"@odata.type": "#microsoft.graph.windows10CompliancePolicy", "id": "p1", "displayName": "Windows Baseline", "version": 3, "createdDateTime": "2025-06-01T10:00:00Z", "lastModifiedDateTime": "2025-11-20T08:30:00Z", "bitLockerEnabled": true}
+		]}`))
+
+	// Device Configurations, one at a time, to list their assignments.
+	case "/v1.0/deviceManagement/deviceConfigurations?$top=1":
+		w.Write([]byte(`{"value": [{"id": "c1", "displayName": "Wi-Fi"}]}`))
+
+	// Device Configuration Assignments
+	case "/v1.0/deviceManagement/deviceConfigurations/c1/assignments?$top=2":
+		w.Write([]byte(`{"value": [
+			{"id": "c1_g1", "target": {"@odata.type": "#microsoft.graph.groupAssignmentTarget", "groupId": "g1"}}
+		]}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body         string
+		wantObjects  []map[string]any
+		wantNextLink *string
+		wantErr      *framework.Error
+	}{
+		"last_page": {
+			body:        `{"@odata.context": "https://graph.microsoft.com/v1.0/$metadata#deviceManagement/managedDevices", "value": [{"id": "d1"}]}`,
+			wantObjects: []map[string]any{{"id": "d1"}},
+		},
+		"next_page": {
+			body:         `{"value": [{"id": "d1"}], "@odata.nextLink": "https://graph.microsoft.com/v1.0/deviceManagement/managedDevices?$top=1&$skiptoken=d2"}`,
+			wantObjects:  []map[string]any{{"id": "d1"}},
+			wantNextLink: testutil.GenPtr("https://graph.microsoft.com/v1.0/deviceManagement/managedDevices?$top=1&$skiptoken=d2"),
+		},
+		"no_objects": {
+			body:        `{"value": []}`,
+			wantObjects: []map[string]any{},
+		},
+		"invalid_response": {
+			body: `{"value": {}}`,
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: expected start of JSON array but found {.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextLink, gotErr := intune.ParseResponse(strings.NewReader(tt.body))
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextLink, tt.wantNextLink) {
+				t.Errorf("gotNextLink: %v, wantNextLink: %v", gotNextLink, tt.wantNextLink)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := intune.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *intune.Request
+		wantResponse *intune.Response
+		wantErr      *framework.Error
+	}{
+		"managed_devices_first_page": {
+			request: &intune.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer testtoken",
+				APIVersion:            "v1.0",
+				PageSize:              2,
+				RequestTimeoutSeconds: 10,
+				EntityExternalID:      intune.ManagedDevice,
+			},
+			wantResponse: &intune.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "d1", "deviceName": "DESKTOP-01", "operatingSystem": "Windows", "complianceState": "compliant", "lastSyncDateTime": "2026-01-02T03:04:05.1234567Z", "userPrincipalName": "john@acme.com"},
+					{"id": "d2", "deviceName": "iPhone-02", "operatingSystem": "iOS", "complianceState": "noncompliant", "lastSyncDateTime": "2025-12-01T00:00:00Z", "userPrincipalName": "jane@acme.com"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(server.URL + "/v1.0/deviceManagement/managedDevices?$top=2&$skiptoken=d3"),
+				},
+			},
+		},
+		"managed_devices_last_page": {
+			request: &intune.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer testtoken",
+				APIVersion:            "v1.0",
+				PageSize:              2,
+				RequestTimeoutSeconds: 10,
+				EntityExternalID:      intune.ManagedDevice,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr(server.URL + "/v1.0/deviceManagement/managedDevices?$top=2&$skiptoken=d3"),
+				},
+			},
+			wantResponse: &intune.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "d3", "deviceName": "MacBook-03", "operatingSystem": "macOS", "complianceState": "inGracePeriod", "lastSyncDateTime": "2026-01-05T00:00:00Z", "userPrincipalName": "jim@acme.com"},
+				},
+			},
+		},
+		"device_compliance_states_of_multiple_devices": {
+			request: &intune.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer testtoken",
+				APIVersion:            "v1.0",
+				PageSize:              3,
+				RequestTimeoutSeconds: 10,
+				EntityExternalID:      intune.DeviceComplianceState,
+			},
+			wantResponse: &intune.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "p1-d1", "policyStateId": "p1", "deviceId": "d1", "displayName": "Windows Baseline", "platformType": "windows10AndLater", "state": "compliant", "settingCount": float64(4)},
+					{"id": "p2-d1", "policyStateId": "p2", "deviceId": "d1", "displayName": "BitLocker", "platformType": "windows10AndLater", "state": "compliant", "settingCount": float64(1)},
+					{"id": "p3-d2", "policyStateId": "p3", "deviceId": "d2", "displayName": "iOS Baseline", "platformType": "iOS", "state": "nonCompliant", "settingCount": float64(2)},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("d2"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/v1.0/deviceManagement/managedDevices?$top=1&$skiptoken=d3"),
+				},
+			},
+		},
+		"device_compliance_states_of_last_device": {
+			request: &intune.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer testtoken",
+				APIVersion:            "v1.0",
+				PageSize:              3,
+				RequestTimeoutSeconds: 10,
+				EntityExternalID:      intune.DeviceComplianceState,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("d2"),
+					CollectionCursor: testutil.GenPtr(server.URL + "/v1.0/deviceManagement/managedDevices?$top=1&$skiptoken=d3"),
+				},
+			},
+			wantResponse: &intune.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]any{},
+			},
+		},
+		"device_configuration_assignments": {
+			request: &intune.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer testtoken",
+				APIVersion:            "v1.0",
+				PageSize:              2,
+				RequestTimeoutSeconds: 10,
+				EntityExternalID:      intune.DeviceConfigurationAssignment,
+			},
+			wantResponse: &intune.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "c1_g1-c1", "assignmentId": "c1_g1", "deviceConfigurationId": "c1", "target": map[string]any{"@odata.type": "#microsoft.graph.groupAssignmentTarget", "groupId": "g1"}},
+				},
+			},
+		},
+		"cursor_of_another_host": {
+			request: &intune.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer testtoken",
+				APIVersion:            "v1.0",
+				PageSize:              2,
+				RequestTimeoutSeconds: 10,
+				EntityExternalID:      intune.ManagedDevice,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://attacker.example.com/v1.0/deviceManagement/managedDevices"),
+				},
+			},
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity ManagedDevice: cursor is not a URL of ` + server.URL + `. Expected cursor shape: {"cursor":<string>}. Restart the sync for this entity to discard the invalid cursor.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"unauthorized": {
+			request: &intune.Request{
+				BaseURL:               server.URL,
+				Token:                 "Bearer invalid",
+				APIVersion:            "v1.0",
+				PageSize:              2,
+				RequestTimeoutSeconds: 10,
+				EntityExternalID:      intune.CompliancePolicy,
+			},
+			wantResponse: &intune.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
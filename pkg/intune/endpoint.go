@@ -0,0 +1,79 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package intune
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// For example, the endpoint of the first page of 100 managed devices is:
+// https://graph.microsoft.com/v1.0/deviceManagement/managedDevices?$top=100.
+// The endpoint of the next pages is the @odata.nextLink of the last response, stored in the cursor.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		// The next link must be on the Graph API, so that the token is never sent to another host.
+		if !strings.HasPrefix(*request.Cursor.Cursor, request.BaseURL+"/") {
+			return "", pagination.NewCursorError(
+				request.EntityExternalID,
+				pagination.CompositeCursorShape[string](entity.memberOf != ""),
+				fmt.Sprintf("cursor is not a URL of %s", request.BaseURL),
+			)
+		}
+
+		return *request.Cursor.Cursor, nil
+	}
+
+	path := entity.path
+
+	// [MemberEntities] The members are listed from the object of the cursor.
+	if entity.memberOf != "" {
+		if request.Cursor == nil || request.Cursor.CollectionID == nil {
+			return "", &framework.Error{
+				Message: fmt.Sprintf("Unable to list the %s objects without the ID of a %s object.",
+					request.EntityExternalID, entity.memberOf),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		path = fmt.Sprintf(entity.path, url.PathEscape(*request.Cursor.CollectionID))
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(request.BaseURL)
+	sb.WriteString("/")
+	sb.WriteString(request.APIVersion)
+	sb.WriteString(path)
+	sb.WriteString("?$top=")
+	sb.WriteString(strconv.FormatInt(request.PageSize, 10))
+
+	if request.Filter != nil {
+		sb.WriteString("&$filter=")
+		sb.WriteString(url.QueryEscape(*request.Filter))
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package intune_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/intune"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *intune.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				PageSize:         100,
+				EntityExternalID: "DetectedApp",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"managed_devices": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				PageSize:         100,
+				EntityExternalID: intune.ManagedDevice,
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/deviceManagement/managedDevices?$top=100",
+		},
+		"managed_devices_with_filter": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "beta",
+				PageSize:         100,
+				EntityExternalID: intune.ManagedDevice,
+				Filter:           testutil.GenPtr("lastSyncDateTime ge 2026-01-01T00:00:00Z"),
+			},
+			wantEndpoint: "https://graph.microsoft.com/beta/deviceManagement/managedDevices?$top=100&$filter=lastSyncDateTime+ge+2026-01-01T00%3A00%3A00Z",
+		},
+		"managed_devices_next_page": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				PageSize:         100,
+				EntityExternalID: intune.ManagedDevice,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://graph.microsoft.com/v1.0/deviceManagement/managedDevices?$top=100&$skiptoken=abc"),
+				},
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/deviceManagement/managedDevices?$top=100&$skiptoken=abc",
+		},
+		"next_page_of_another_host": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				PageSize:         100,
+				EntityExternalID: intune.ManagedDevice,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("https://graph.microsoft.com.attacker.example.com/v1.0/deviceManagement/managedDevices"),
+				},
+			},
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity ManagedDevice: cursor is not a URL of https://graph.microsoft.com. Expected cursor shape: {"cursor":<string>}. Restart the sync for this entity to discard the invalid cursor.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"compliance_policies": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				PageSize:         100,
+				EntityExternalID: intune.CompliancePolicy,
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/deviceManagement/deviceCompliancePolicies?$top=100",
+		},
+		"device_configurations": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				PageSize:         100,
+				EntityExternalID: intune.DeviceConfiguration,
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/deviceManagement/deviceConfigurations?$top=100",
+		},
+		"device_compliance_states": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				PageSize:         100,
+				EntityExternalID: intune.DeviceComplianceState,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("0a1b2c3d-0000-0000-0000-000000000000"),
+				},
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/deviceManagement/managedDevices/0a1b2c3d-0000-0000-0000-000000000000/deviceCompliancePolicyStates?$top=100",
+		},
+		"device_configuration_assignments": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				PageSize:         100,
+				EntityExternalID: intune.DeviceConfigurationAssignment,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("c1"),
+				},
+			},
+			wantEndpoint: "https://graph.microsoft.com/v1.0/deviceManagement/deviceConfigurations/c1/assignments?$top=100",
+		},
+		"device_configuration_assignments_without_collection_id": {
+			request: &intune.Request{
+				BaseURL:          "https://graph.microsoft.com",
+				APIVersion:       "v1.0",
+				PageSize:         100,
+				EntityExternalID: intune.DeviceConfigurationAssignment,
+			},
+			wantErr: &framework.Error{
+				Message: "Unable to list the DeviceConfigurationAssignment objects without the ID of a DeviceConfiguration object.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := intune.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
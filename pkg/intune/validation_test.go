@@ -0,0 +1,242 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package intune_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/intune"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: intune.ManagedDevice,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "deviceName",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	bearerAuth := &framework.DatasourceAuthCredentials{
+		HTTPAuthorization: "Bearer testtoken",
+	}
+
+	validConfig := &intune.Config{
+		APIVersion: "v1.0",
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[intune.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request": {
+			request: &framework.Request[intune.Config]{
+				Address:  "graph.microsoft.com",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 999,
+			},
+			wantAddress: "https://graph.microsoft.com",
+		},
+		"valid_request_with_filters": {
+			request: &framework.Request[intune.Config]{
+				Address: "https://graph.microsoft.com",
+				Auth:    bearerAuth,
+				Entity:  validEntity,
+				Config: &intune.Config{
+					APIVersion: "beta",
+					Filters: map[string]string{
+						intune.ManagedDevice:       "operatingSystem eq 'Windows'",
+						intune.DeviceConfiguration: "displayName eq 'Wi-Fi'",
+					},
+				},
+				PageSize: 100,
+			},
+			wantAddress: "https://graph.microsoft.com",
+		},
+		"invalid_request_nil_config": {
+			request: &framework.Request[intune.Config]{
+				Address:  "https://graph.microsoft.com",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Microsoft Intune config is invalid: request contains no config.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_api_version": {
+			request: &framework.Request[intune.Config]{
+				Address:  "https://graph.microsoft.com",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   &intune.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Microsoft Intune config is invalid: apiVersion is not set.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_unsupported_api_version": {
+			request: &framework.Request[intune.Config]{
+				Address:  "https://graph.microsoft.com",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   &intune.Config{APIVersion: "v2.0"},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Microsoft Intune config is invalid: apiVersion is not supported: v2.0.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_filter_of_member_entity": {
+			request: &framework.Request[intune.Config]{
+				Address: "https://graph.microsoft.com",
+				Auth:    bearerAuth,
+				Entity:  validEntity,
+				Config: &intune.Config{
+					APIVersion: "v1.0",
+					Filters: map[string]string{
+						intune.DeviceComplianceState: "state eq 'nonCompliant'",
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Microsoft Intune config is invalid: filters are not supported for entity: DeviceComplianceState.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[intune.Config]{
+				Address:  "http://graph.microsoft.com",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[intune.Config]{
+				Address:  "https://graph.microsoft.com",
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided datasource auth is missing required http authorization credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[intune.Config]{
+				Address: "https://graph.microsoft.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "testtoken",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[intune.Config]{
+				Address: "https://graph.microsoft.com",
+				Auth:    bearerAuth,
+				Entity: framework.EntityConfig{
+					ExternalId: "DetectedApp",
+					Attributes: validEntity.Attributes,
+				},
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[intune.Config]{
+				Address: "https://graph.microsoft.com",
+				Auth:    bearerAuth,
+				Entity: framework.EntityConfig{
+					ExternalId: intune.ManagedDevice,
+					Attributes: validEntity.Attributes[1:],
+				},
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[intune.Config]{
+				Address:  "https://graph.microsoft.com",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   validConfig,
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[intune.Config]{
+				Address:  "https://graph.microsoft.com",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1000) exceeds the maximum allowed (999).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &intune.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/sgnl-ai/adapters/pkg/auth"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -65,7 +66,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	jiraReq := &Request{
 		BaseURL:               request.Address,
 		AuthorizationHeader:   authorizationHeader,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
 		Entity:                &request.Entity,
@@ -88,7 +89,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	jiraReq.Attributes = request.Entity.Attributes
 
 	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor)
+	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
@@ -107,6 +108,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, res.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
@@ -191,8 +191,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Failed to decode base64 cursor: illegal base64 data at input byte 7.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+					Message: "Invalid cursor for entity Group: failed to decode base64 cursor: illegal base64 data at input byte 7. " +
+						`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
 		},
@@ -222,8 +224,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Failed to unmarshal JSON cursor: json: cannot unmarshal array into Go struct field " +
-						"CompositeCursor[int64].cursor of type int64.",
+					Message: "Invalid cursor for entity Group: failed to unmarshal JSON cursor: json: cannot unmarshal array " +
+						"into Go struct field CompositeCursor[int64].cursor of type int64. " +
+						`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
 					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
@@ -325,8 +329,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Cursor does not have CollectionID set for entity GroupMember.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+					Message: "Invalid cursor for entity GroupMember: cursor does not have CollectionID set. " +
+						`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
 		},
@@ -359,7 +365,7 @@ func TestAdapterGetPage(t *testing.T) {
 				Error: &framework.Error{
 					Message: `Failed to execute Jira request: Get "https://localhost:1/rest/api/latest/groups/picker": ` +
 						`dial tcp [::1]:1: connect: connection refused.`,
-					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
 				},
 			},
 		},
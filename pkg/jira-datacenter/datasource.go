@@ -16,6 +16,7 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
 	customerror "github.com/sgnl-ai/adapters/pkg/errors"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
@@ -200,6 +201,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -342,6 +344,12 @@ func (d *Datasource) constructCursor(
 		return nil, false, err
 	}
 
+	// An adapter error message is generated if the response status code from the
+	// collection API is not successful (i.e. if not statusCode >= 200 && statusCode < 300).
+	if adapterErr := web.HTTPError(nextCollectionRes.StatusCode, nextCollectionRes.RetryAfterHeader); adapterErr != nil {
+		return nil, false, adapterErr
+	}
+
 	// There are no more collections. Return a bool indicating this was the last page.
 	if len(nextCollectionRes.Objects) == 0 {
 		return nil, true, nil
@@ -485,6 +493,14 @@ func (e Entity) parseGroupsResponse(
 		return nil, nil, frameworkErr
 	}
 
+	// The groups are paginated by the adapter, so the cursor is used as an index in the list of groups.
+	if *request.Cursor.Cursor < 0 {
+		return nil, nil, &framework.Error{
+			Message: fmt.Sprintf("The cursor value: %v, is out of range.", *request.Cursor.Cursor),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
 	objects, nextCursor = filterAndPaginateGroups(objects, request.Groups, request.PageSize, *request.Cursor.Cursor)
 
 	return objects, nextCursor, nil
@@ -227,6 +227,10 @@ var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Req
 	case "/rest/api/failing-version-two/groups/picker":
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`{"groups": [{"name":10}]}`))
+	// Rate limit the Group request.
+	case "/rest/api/rate-limited-version/groups/picker":
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
 
 	// Create a group member uniqueId that is not parsable into a string.
 	case "/rest/api/latest/group/member?groupname=group1&startAt=99&maxResults=1":
@@ -521,6 +525,17 @@ func TestParseGroupsResponse(t *testing.T) {
 				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 			},
 		},
+		"negative_cursor": {
+			body:           []byte(`{"groups": [{"name": "group1"}, {"name": "group2"}]}`),
+			cursor:         -1,
+			pageSize:       10,
+			wantObjects:    nil,
+			wantNextCursor: nil,
+			wantErr: &framework.Error{
+				Message: "The cursor value: -1, is out of range.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
 	}
 
 	for name, tt := range tests {
@@ -1237,6 +1252,25 @@ func (ts *TestSuite) TestGetPageUsers(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
 			},
 		},
+		// If the Group request is rate limited, the error must be returned instead of ending the sync.
+		"group_get_page_rate_limited": {
+			ctx: context.Background(),
+			request: &jiradatacenter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				AuthorizationHeader:   mockAuthorizationHeader,
+				PageSize:              int64(1),
+				Cursor:                &pagination.CompositeCursor[int64]{},
+				EntityExternalID:      externalEntityID,
+				APIVersion:            "rate-limited-version",
+			},
+			wantResponse: nil,
+			wantErr: &framework.Error{
+				Message:    "Datasource received too many requests. Adjust datasource sync frequency and try again.",
+				Code:       api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+				RetryAfter: testutil.GenPtr(30 * time.Second),
+			},
+		},
 		// If we're syncing group members, we must have a group id to sync.
 		"composite_cursor_missing_group_id": {
 			ctx: context.Background(),
@@ -1255,8 +1289,10 @@ func (ts *TestSuite) TestGetPageUsers(t *testing.T) {
 			},
 			wantResponse: nil,
 			wantErr: &framework.Error{
-				Message: "Cursor does not have CollectionID set for entity User.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity User: cursor does not have CollectionID set. " +
+					`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 		// On the first page sync of a GroupMember, we make a request for the first group. If that request fails,
@@ -1275,7 +1311,7 @@ func (ts *TestSuite) TestGetPageUsers(t *testing.T) {
 			wantErr: &framework.Error{
 				Message: `Failed to execute Jira request: Get "http://localhost:1234/rest/api/latest/groups/picker": ` +
 					`dial tcp [::1]:1234: connect: connection refused.`,
-				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
 			},
 		},
 		// If the GroupMember request is successful, but the response structure is not what we expect
@@ -2027,8 +2063,10 @@ func (ts *TestSuite) TestGetPageGroupMembers(t *testing.T) {
 			},
 			wantResponse: nil,
 			wantErr: &framework.Error{
-				Message: "Cursor does not have CollectionID set for entity GroupMember.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity GroupMember: cursor does not have CollectionID set. " +
+					`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 		// On the first page sync of a GroupMember, we make a request for the first group. If that request fails,
@@ -2047,7 +2085,7 @@ func (ts *TestSuite) TestGetPageGroupMembers(t *testing.T) {
 			wantErr: &framework.Error{
 				Message: `Failed to execute Jira request: Get "http://localhost:1234/rest/api/latest/groups/picker": ` +
 					`dial tcp [::1]:1234: connect: connection refused.`,
-				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
 			},
 		},
 		// If the GroupMember request is successful, but the response structure is not what we expect
@@ -0,0 +1,59 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package jiradatacenter_test
+
+import (
+	"slices"
+	"testing"
+
+	jiradatacenter "github.com/sgnl-ai/adapters/pkg/jira-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// FuzzParse checks that malformed Jira Data Center responses are rejected with an error instead of a panic, for
+// all the entities.
+func FuzzParse(f *testing.F) {
+	entityExternalIDs := make([]string, 0, len(jiradatacenter.ValidEntityExternalIDs))
+	for entityExternalID := range jiradatacenter.ValidEntityExternalIDs {
+		entityExternalIDs = append(entityExternalIDs, entityExternalID)
+	}
+
+	slices.Sort(entityExternalIDs)
+
+	for _, seed := range []string{
+		`{"values":[{"key":"user1"},{"key":"user2"}],"isLast":true}`,
+		`{"startAt":0,"maxResults":1,"total":2,"issues":[{"id":"10002","key":"ED-1"}]}`,
+		`{"header":"Showing 2 of 2 matching groups","total":2,"groups":[{"name":"jira-administrators"},{"name":"jira-software-users"}]}`,
+		`{"values":[null],"isLast":"no"}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), uint8(0), int64(1), int64(0))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, entityIndex uint8, pageSize int64, cursor int64) {
+		// The page size is validated before the request is sent.
+		if pageSize <= 0 {
+			t.Skip()
+		}
+
+		entityExternalID := entityExternalIDs[int(entityIndex)%len(entityExternalIDs)]
+
+		request := jiradatacenter.Request{
+			PageSize:         pageSize,
+			EntityExternalID: entityExternalID,
+			Cursor: &pagination.CompositeCursor[int64]{
+				Cursor: &cursor,
+			},
+			Groups: []string{"jira-administrators"},
+		}
+
+		_, _, err := jiradatacenter.ValidEntityExternalIDs[entityExternalID].Parse(body, request)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for entity %s and body %q", entityExternalID, body)
+		}
+	})
+}
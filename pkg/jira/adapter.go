@@ -12,6 +12,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 var DefaultAssetBaseURL = "https://api.atlassian.com/jsm/assets"
@@ -58,7 +59,7 @@ func (a *Adapter) RequestPageFromDatasource(
 		BaseURL:               request.Address,
 		Username:              request.Auth.Basic.Username,
 		Password:              request.Auth.Basic.Password,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
 	}
@@ -73,6 +74,15 @@ func (a *Adapter) RequestPageFromDatasource(
 		switch request.Entity.ExternalId {
 		case Issue, EnhancedIssue:
 			jiraReq.IssuesJQLFilter = request.Config.IssuesJQLFilter
+			jiraReq.IssuesUpdatedSince = commonConfig.ChangedSince()
+			jiraReq.IssueExpansions = request.Config.IssueExpansions
+			jiraReq.ExpansionConcurrency = request.Config.ExpansionConcurrency
+		case Changelog:
+			jiraReq.IssuesJQLFilter = request.Config.IssuesJQLFilter
+			jiraReq.IssuesUpdatedSince = commonConfig.ChangedSince()
+			jiraReq.EnhancedIssueSearch = request.Config.EnhancedIssueSearch
+		case Worklog:
+			jiraReq.WorklogsUpdatedSince = commonConfig.ChangedSince()
 		case Object:
 			jiraReq.ObjectsQLQuery = request.Config.ObjectsQLQuery
 
@@ -84,13 +94,37 @@ func (a *Adapter) RequestPageFromDatasource(
 		}
 	}
 
+	// The sites to sync, in order. The site of the datasource address is always synced first.
+	sites := []string{request.Address}
+	if request.Config != nil {
+		sites = append(sites, request.Config.Sites...)
+	}
+
+	var currentSiteIndex int
+
 	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[string](request.Cursor)
-	if err != nil {
-		return framework.NewGetPageResponseError(err)
+	if len(sites) > 1 {
+		cursor, err := UnmarshalSiteCursor(request.Cursor, request.Entity.ExternalId)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		currentSiteIndex, err = siteIndex(cursor, sites, request.Entity.ExternalId)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		jiraReq.Cursor = cursor.pageCursor()
+	} else {
+		cursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		jiraReq.Cursor = cursor
 	}
 
-	jiraReq.Cursor = cursor
+	jiraReq.BaseURL = sites[currentSiteIndex]
 
 	res, err := a.JiraClient.GetPage(ctx, jiraReq)
 	if err != nil {
@@ -104,6 +138,14 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	if len(sites) > 1 {
+		for _, object := range res.Objects {
+			object[SiteIDAttribute] = sites[currentSiteIndex]
+		}
+	}
+
+	schemadrift.Observe(ctx, res.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
@@ -134,7 +176,14 @@ func (a *Adapter) RequestPageFromDatasource(
 	}
 
 	// Marshal the next cursor.
-	nextCursor, err := pagination.MarshalCursor(res.NextCursor)
+	var nextCursor string
+
+	if len(sites) > 1 {
+		nextCursor, err = MarshalSiteCursor(nextSiteCursor(res.NextCursor, sites, currentSiteIndex))
+	} else {
+		nextCursor, err = pagination.MarshalCursor(res.NextCursor)
+	}
+
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
@@ -346,8 +346,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Failed to decode base64 cursor: illegal base64 data at input byte 7.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+					Message: "Invalid cursor for entity Group: failed to decode base64 cursor: illegal base64 data at input byte 7. " +
+						`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
 		},
@@ -376,8 +378,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Failed to unmarshal JSON cursor: json: cannot unmarshal array into Go struct field " +
-						"CompositeCursor[string].cursor of type string.",
+					Message: "Invalid cursor for entity Group: failed to unmarshal JSON cursor: json: cannot unmarshal array " +
+						"into Go struct field CompositeCursor[string].cursor of type string. " +
+						`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
 					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
@@ -474,8 +478,10 @@ func TestAdapterGetPage(t *testing.T) {
 			},
 			wantResponse: framework.Response{
 				Error: &framework.Error{
-					Message: "Cursor does not have CollectionID set for entity GroupMember.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+					Message: "Invalid cursor for entity GroupMember: cursor does not have CollectionID set. " +
+						`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}.` +
+						" Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 				},
 			},
 		},
@@ -577,3 +583,124 @@ func TestAdapterGetPage(t *testing.T) {
 		})
 	}
 }
+
+func TestAdapterGetPageWithSites(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	defer server.Close()
+
+	// siteServer uses the same certificate as server, so it is trusted by server.Client().
+	siteServer := httptest.NewTLSServer(TestServerHandler)
+	defer siteServer.Close()
+
+	adapter := jira_adapter.NewAdapter(&jira_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	encodeCursor := func(cursor string) string {
+		return base64.StdEncoding.EncodeToString([]byte(cursor))
+	}
+
+	tests := map[string]struct {
+		cursor       string
+		pageSize     int64
+		wantResponse framework.Response
+	}{
+		"first_page_of_first_site": {
+			pageSize: 1,
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"groupId": "group1",
+							"siteId":  server.URL,
+						},
+					},
+					NextCursor: encodeCursor(`{"cursor":"1","siteId":"` + server.URL + `"}`),
+				},
+			},
+		},
+		"last_page_of_first_site": {
+			cursor:   encodeCursor(`{"cursor":"2","siteId":"` + server.URL + `"}`),
+			pageSize: 1,
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"groupId": "group3",
+							"siteId":  server.URL,
+						},
+					},
+					NextCursor: encodeCursor(`{"siteId":"` + siteServer.URL + `"}`),
+				},
+			},
+		},
+		"last_page_of_last_site": {
+			cursor:   encodeCursor(`{"siteId":"` + siteServer.URL + `"}`),
+			pageSize: 10,
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"groupId": "group1",
+							"siteId":  siteServer.URL,
+						},
+						{
+							"groupId": "group2",
+							"siteId":  siteServer.URL,
+						},
+					},
+				},
+			},
+		},
+		"unknown_site": {
+			cursor:   encodeCursor(`{"siteId":"https://unknown.atlassian.net"}`),
+			pageSize: 1,
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Invalid cursor for entity Group: site https://unknown.atlassian.net is not configured. " +
+						`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>,` +
+						`"siteId":<string>}. ` + pagination.RestartSyncHint,
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := &framework.Request[jira_adapter.Config]{
+				Address: server.URL,
+				Config: &jira_adapter.Config{
+					Sites: []string{siteServer.URL},
+				},
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: mockUsername,
+						Password: mockPassword,
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: jira_adapter.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "groupId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: jira_adapter.SiteIDAttribute,
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: tt.pageSize,
+				Cursor:   tt.cursor,
+			}
+
+			gotResponse := adapter.GetPage(context.Background(), request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+		})
+	}
+}
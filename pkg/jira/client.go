@@ -4,6 +4,7 @@ package jira
 
 import (
 	"context"
+	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
@@ -39,9 +40,35 @@ type Request struct {
 	Cursor *pagination.CompositeCursor[string]
 
 	// IssuesJQLFilter is a JQL filter to apply to the request.
-	// This is only used when EntityExternalID = "Issue".
+	// This is only used when EntityExternalID = "Issue" or "Changelog". The changelogs are
+	// requested for the issues matching the filter.
 	IssuesJQLFilter *string
 
+	// IssuesUpdatedSince restricts the returned issues to those updated since this time during an
+	// incremental sync. nil during a full backfill.
+	// This is only used when EntityExternalID = "Issue" or "Changelog".
+	IssuesUpdatedSince *time.Time
+
+	// EnhancedIssueSearch determines whether the issues of the changelogs are requested from the enhanced
+	// issue search endpoint. See Config.EnhancedIssueSearch.
+	// This is only used when EntityExternalID = "Changelog".
+	// TODO: Remove this after fully deprecating the legacy Issue endpoint.
+	EnhancedIssueSearch bool
+
+	// IssueExpansions are the expansions to set on each issue, e.g. "changelog". See Config.IssueExpansions.
+	// This is only used when EntityExternalID = "Issue".
+	IssueExpansions []string
+
+	// ExpansionConcurrency is the maximum number of concurrent requests to expand the issues of a page.
+	// DefaultExpansionConcurrency if 0.
+	// This is only used when EntityExternalID = "Issue".
+	ExpansionConcurrency int
+
+	// WorklogsUpdatedSince restricts the returned worklogs to those updated since this time during an
+	// incremental sync. nil during a full backfill.
+	// This is only used when EntityExternalID = "Worklog".
+	WorklogsUpdatedSince *time.Time
+
 	// ObjectsQLQuery is a AQL query to apply to the request.
 	// This is only used when EntityExternalID = "Object".
 	ObjectsQLQuery *string
@@ -22,7 +22,12 @@ import (
     "issuesJqlFilter": "project=SGNL OR project=MVP",
     "objectsQlQuery": "objectType = Customer",
     "assetBaseUrl": "https://api.atlassian.com/jsm/assets",
-	"enhancedIssueSearch": true
+	"enhancedIssueSearch": true,
+    "sites": ["https://acme-eu.atlassian.net", "https://acme-apac.atlassian.net"],
+    "issueExpansions": ["changelog", "comments"],
+    "expansionConcurrency": 10,
+    "syncMode": "INCREMENTAL",
+    "incrementalSyncSince": "2026-01-01T00:00:00Z"
 }
 */
 type Config struct {
@@ -59,10 +64,29 @@ type Config struct {
 	// This will eventually be removed and all requests will default to the new enhanced endpoint. This is being added
 	// so we can apply this on a per instance basis during this rolling deprecation.
 	EnhancedIssueSearch bool `json:"enhancedIssueSearch,omitempty"`
+
+	// Sites are the addresses of additional Jira Cloud sites to sync, e.g. "https://acme-eu.atlassian.net".
+	// The sites are synced in order after the site of the datasource address, with the same credentials.
+	// If set, the address of the site of each object is set in the SiteIDAttribute attribute, since IDs
+	// are only unique within a site.
+	Sites []string `json:"sites,omitempty"`
+
+	// IssueExpansions are the expansions to set on each issue of the Issue entity, requested from the endpoints
+	// of each issue: "changelog" sets all the changelogs of the issue in its "changelog" attribute, and
+	// "comments" sets all the comments of the issue in its "comments" attribute.
+	IssueExpansions []string `json:"issueExpansions,omitempty"`
+
+	// ExpansionConcurrency is the maximum number of concurrent requests to expand the issues of a page with
+	// IssueExpansions. Defaults to DefaultExpansionConcurrency, and must not exceed MaxExpansionConcurrency.
+	ExpansionConcurrency int `json:"expansionConcurrency,omitempty"`
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
 func (c *Config) Validate(_ context.Context) error {
+	if err := c.CommonConfig.ValidateSyncMode(); err != nil {
+		return err
+	}
+
 	// If EnhancedIssueSearch is specified, IssuesJQLFilter is required.
 	if c.EnhancedIssueSearch && c.IssuesJQLFilter == nil {
 		return errors.New("issuesJqlFilter is required for enhanced issue search")
@@ -90,6 +114,24 @@ func (c *Config) Validate(_ context.Context) error {
 		}
 	}
 
+	expansions := make(map[string]struct{}, len(c.IssueExpansions))
+
+	for _, expansion := range c.IssueExpansions {
+		if _, found := issueExpansions[expansion]; !found {
+			return fmt.Errorf("issueExpansions contains an unsupported expansion: %v", expansion)
+		}
+
+		if _, found := expansions[expansion]; found {
+			return fmt.Errorf("issueExpansions must not contain duplicate values: %v", expansion)
+		}
+
+		expansions[expansion] = struct{}{}
+	}
+
+	if c.ExpansionConcurrency < 0 || c.ExpansionConcurrency > MaxExpansionConcurrency {
+		return fmt.Errorf("expansionConcurrency must be between 0 and %d", MaxExpansionConcurrency)
+	}
+
 	if c.AssetBaseURL != nil {
 		if _, err := url.ParseRequestURI(*c.AssetBaseURL); err != nil {
 			return fmt.Errorf("assetBaseUrl is not a valid URL: %w", err)
@@ -33,6 +33,8 @@ const (
 	GroupMember   string = "GroupMember"
 	Workspace     string = "Workspace"
 	Object        string = "Object"
+	Worklog       string = "Worklog"
+	Changelog     string = "Changelog"
 
 	isLastFieldName     = "isLast"
 	nextCursorFieldName = "nextPageToken"
@@ -41,6 +43,7 @@ const (
 var EntityIDToParentCollectionID = map[string]string{
 	GroupMember: Group,
 	Object:      Workspace,
+	Changelog:   Issue,
 }
 
 var (
@@ -60,6 +63,11 @@ var (
 	//   https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-assets/#api-rest-servicedeskapi-assets-workspace-get.
 	// Objects doc:
 	// 	 https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-aql-post.
+	// Worklogs doc:
+	//   https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-worklog-updated-get.
+	// Changelogs doc:
+	// nolint:lll
+	//   https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-changelog-get.
 	ValidEntityExternalIDs = map[string]Entity{
 		User: {
 			uniqueIDAttrExternalID: "accountId",
@@ -107,6 +115,19 @@ var (
 			endpoint:               "object", // Not used.
 			parseResponse:          ParseObjectsResponse,
 		},
+		Worklog: {
+			uniqueIDAttrExternalID: "id",
+			// The IDs of the updated worklogs are requested from this endpoint, then the worklogs are
+			// requested from the "worklog/list" endpoint. See getWorklogPage.
+			endpoint:      "worklog/updated",
+			parseResponse: ParseWorklogsResponse,
+		},
+		Changelog: {
+			// Changelog IDs are unique across issues.
+			uniqueIDAttrExternalID: "id",
+			endpoint:               "issue", // The issue ID and "changelog" are appended by ConstructURL.
+			parseResponse:          ParseChangelogsResponse,
+		},
 	}
 )
 
@@ -154,6 +175,11 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 
 	logger.Info("Starting datasource request")
 
+	// Worklogs are not paginated like the other entities.
+	if request.EntityExternalID == Worklog {
+		return d.getWorklogPage(ctx, request, logger)
+	}
+
 	// ValidateGetPageRequest already checks if the entity exists in the valid entities map.
 	entity := ValidEntityExternalIDs[request.EntityExternalID]
 
@@ -168,11 +194,13 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		}, nil
 	}
 
+	_, isMemberEntity := EntityIDToParentCollectionID[request.EntityExternalID]
+
 	validationErr := pagination.ValidateCompositeCursor(
 		cursor,
 		request.EntityExternalID,
 		// Send a bool indicating if the entity is a member of a collection.
-		request.EntityExternalID == GroupMember || request.EntityExternalID == Object,
+		isMemberEntity,
 	)
 	if validationErr != nil {
 		return nil, validationErr
@@ -238,6 +266,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 	defer res.Body.Close()
@@ -308,6 +337,25 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		// So the globalId can be used as the unique ID. e.g. globalId: f1668d0c-828c-470c-b7d1-8c4f48cd345a:88.
 		response.NextCursor.CollectionID = cursor.CollectionID
 		response.NextCursor.CollectionCursor = cursor.CollectionCursor
+	case Changelog:
+		// The changelog response doesn't include the ID of the issue.
+		for _, object := range objects {
+			object["issueId"] = *cursor.CollectionID
+		}
+
+		response.NextCursor.CollectionID = cursor.CollectionID
+		response.NextCursor.CollectionCursor = cursor.CollectionCursor
+	case Issue, EnhancedIssue:
+		if len(request.IssueExpansions) > 0 {
+			expansionErrResponse, expansionErr := d.expandIssues(ctx, request, objects, logger)
+			if expansionErr != nil {
+				return nil, expansionErr
+			}
+
+			if expansionErrResponse != nil {
+				return expansionErrResponse, nil
+			}
+		}
 	}
 
 	response.Objects = objects
@@ -357,6 +405,13 @@ func (d *Datasource) constructCursor(
 				collectionCursor = &zero
 			}
 
+			// The changelogs are requested for the issues matching the issues filter, using the same
+			// issue search endpoint as the Issue entity.
+			// TODO: Remove this after fully deprecating the legacy Issue endpoint.
+			if parentCollectionEntityID == Issue && request.EnhancedIssueSearch {
+				parentCollectionEntityID = EnhancedIssue
+			}
+
 			// We have no more members to query for the last requested collection,
 			// or this is a request for the first page.
 			// Get the ID of the next collection.
@@ -367,6 +422,8 @@ func (d *Datasource) constructCursor(
 				PageSize:              1,
 				Cursor:                &pagination.CompositeCursor[string]{Cursor: collectionCursor},
 				EntityExternalID:      parentCollectionEntityID,
+				IssuesJQLFilter:       request.IssuesJQLFilter,
+				IssuesUpdatedSince:    request.IssuesUpdatedSince,
 				RequestTimeoutSeconds: request.RequestTimeoutSeconds,
 			}
 
@@ -631,6 +688,12 @@ func ParseObjectsResponse(
 	return parseResponse(body, pageSize, cursor, Object, "isLast")
 }
 
+func ParseChangelogsResponse(
+	body []byte, pageSize int64, cursor string,
+) (objects []map[string]any, nextCursor *string, err *framework.Error) {
+	return parseResponse(body, pageSize, cursor, Changelog, "isLast")
+}
+
 // parseResponse parses Jira responses that have the format {"values": []Entity}.
 // If the lastPageFieldName field exists, it is used to determine if the current page is the last page.
 // If parsing fails, a framework.Error is returned.
@@ -878,6 +941,22 @@ func ConstructURL(request *Request, entity Entity, cursor *pagination.CompositeC
 		sb.WriteString("/workspace/")
 		sb.WriteString(*cursor.CollectionID)
 		sb.WriteString("/v1/object/aql?includeAttributes=true&")
+	case Changelog:
+		if cursor.CollectionID == nil {
+			return "", fmt.Errorf("cursor.CollectionID must not be nil for Changelog entity")
+		}
+
+		issueID := net_url.PathEscape(*cursor.CollectionID)
+
+		// request.BaseURL + "/rest/api/3/" + entity.endpoint + "/" + issueID + "/changelog?"
+		// len("/rest/api/3/") + len("/") + len("/changelog?") == 24.
+		sb.Grow(len(request.BaseURL) + len(entity.endpoint) + len(issueID) + 24)
+		sb.WriteString(request.BaseURL)
+		sb.WriteString("/rest/api/3/")
+		sb.WriteString(entity.endpoint)
+		sb.WriteRune('/')
+		sb.WriteString(issueID)
+		sb.WriteString("/changelog?")
 	default:
 		// request.BaseURL + "/rest/api/3/" + entity.endpoint
 		// len("/rest/api/3/") + len("?") == 13.
@@ -898,8 +977,8 @@ func ConstructURL(request *Request, entity Entity, cursor *pagination.CompositeC
 			sb.WriteString(*cursor.CollectionID)
 			sb.WriteRune('&')
 		case Issue, EnhancedIssue:
-			if request.IssuesJQLFilter != nil {
-				escapedFilter := net_url.QueryEscape(*request.IssuesJQLFilter)
+			if jql := IssuesJQL(request.IssuesJQLFilter, request.IssuesUpdatedSince); jql != "" {
+				escapedFilter := net_url.QueryEscape(jql)
 				sb.Grow(len(escapedFilter) + 5)
 				sb.WriteString("jql=")
 				sb.WriteString(escapedFilter)
@@ -941,6 +1020,29 @@ func ConstructURL(request *Request, entity Entity, cursor *pagination.CompositeC
 	return sb.String(), nil
 }
 
+// IssuesJQL returns the JQL query to use when requesting issues.
+// During an incremental sync, the filter is narrowed down to the issues updated since the given time using
+// the indexed "updated" field, so Jira doesn't have to scan every issue matched by the filter.
+// The time is compared as milliseconds since the epoch, which Jira doesn't interpret in the timezone of the
+// authenticated user, unlike formatted dates.
+func IssuesJQL(filter *string, updatedSince *time.Time) string {
+	if updatedSince == nil {
+		if filter == nil {
+			return ""
+		}
+
+		return *filter
+	}
+
+	updatedClause := fmt.Sprintf("updated >= %d", updatedSince.UnixMilli())
+
+	if filter == nil {
+		return updatedClause
+	}
+
+	return "(" + *filter + ") AND " + updatedClause
+}
+
 // basicAuth returns the basic auth header value for the given username and password base64 encoded.
 // Cf. https://developer.atlassian.com/cloud/jira/platform/basic-auth-for-rest-apis/#supply-basic-auth-headers.
 func basicAuth(username, password string) string {
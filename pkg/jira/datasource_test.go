@@ -140,6 +140,72 @@ var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Req
 			w.Write([]byte(`{"values": []}`))
 		}
 
+	// Worklog endpoints
+	// The updated worklogs endpoint ignores the page size.
+	case "/rest/api/3/worklog/updated?since=0":
+		w.WriteHeader(http.StatusOK)
+		// nolint: lll
+		w.Write([]byte(`{"values": [{"worklogId": 10, "updatedTime": 1000}, {"worklogId": 11, "updatedTime": 2000}, {"worklogId": 12, "updatedTime": 2000}], "since": 0, "until": 2000, "lastPage": false}`))
+	case "/rest/api/3/worklog/updated?since=2000":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"values": [{"worklogId": 13, "updatedTime": 3000}], "since": 2000, "until": 3000, "lastPage": true}`))
+	// Incremental sync since 2026-01-01T00:00:00Z.
+	case "/rest/api/3/worklog/updated?since=1767225600000":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"values": [], "since": 1767225600000, "until": 1767225600000, "lastPage": true}`))
+	case "/rest/api/3/worklog/list":
+		body, _ := io.ReadAll(r.Body)
+		switch string(body) {
+		case `{"ids":[10,11,12]}`:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[{"id": "10", "issueId": "1"}, {"id": "11", "issueId": "1"}, {"id": "12", "issueId": "2"}]`))
+		case `{"ids":[10]}`:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[{"id": "10", "issueId": "1"}]`))
+		case `{"ids":[13]}`:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[{"id": "13", "issueId": "2"}]`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errorMessages": ["Invalid request."]}`))
+		}
+
+	// Changelog endpoints
+	// Issue1 has 2 changelogs.
+	case "/rest/api/3/issue/1/changelog?startAt=0&maxResults=10":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"values": [{"id": "100"}, {"id": "101"}], "isLast": true}`))
+	case "/rest/api/3/issue/1/changelog?startAt=0&maxResults=1":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"values": [{"id": "100"}], "isLast": false}`))
+	// Issue2 has 1 changelog.
+	case "/rest/api/3/issue/2/changelog?startAt=0&maxResults=1":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"values": [{"id": "200"}], "isLast": true}`))
+
+	// Issue expansion endpoints
+	// Issue1 has 2 changelogs and 3 comments, over 2 pages.
+	case "/rest/api/3/issue/1/changelog?startAt=0&maxResults=100":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"values": [{"id": "100"}, {"id": "101"}], "isLast": true}`))
+	case "/rest/api/3/issue/1/comment?startAt=0&maxResults=100":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"comments": [{"id": "1000"}, {"id": "1001"}], "startAt": 0, "maxResults": 2, "total": 3}`))
+	case "/rest/api/3/issue/1/comment?startAt=2&maxResults=100":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"comments": [{"id": "1002"}], "startAt": 2, "maxResults": 2, "total": 3}`))
+	// Issue2 has 1 changelog and no comments.
+	case "/rest/api/3/issue/2/changelog?startAt=0&maxResults=100":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"values": [{"id": "200"}], "isLast": true}`))
+	case "/rest/api/3/issue/2/comment?startAt=0&maxResults=100":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"comments": [], "startAt": 0, "maxResults": 100, "total": 0}`))
+	// Issue99 is rate limited.
+	case "/rest/api/3/issue/99/comment?startAt=0&maxResults=100":
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+
 	// These endpoints define cases where tests should fail, e.g. missing fields, empty, etc.
 	// Hence, they start from page 99 to avoid colliding with the above endpoints.
 	// Return an empty list of groups.
@@ -918,6 +984,39 @@ func TestConstructURL(t *testing.T) {
 			},
 			wantURL: "https://jira.com/rest/api/3/search?jql=project%3DTEST&startAt=10&maxResults=10",
 		},
+		"issues_incremental": {
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               "https://jira.com",
+				PageSize:              10,
+				EntityExternalID:      jira_adapter.Issue,
+				IssuesUpdatedSince:    testutil.GenPtr(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			entity: jira.ValidEntityExternalIDs[jira_adapter.Issue],
+			cursor: &pagination.CompositeCursor[string]{
+				Cursor: testutil.GenPtr("10"),
+			},
+			wantURL: "https://jira.com/rest/api/3/search?jql=updated+%3E%3D+1767323045000" +
+				"&startAt=10&maxResults=10",
+		},
+		"enhanced_issues_incremental_with_filter": {
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               "https://jira.com",
+				PageSize:              10,
+				EntityExternalID:      jira_adapter.EnhancedIssue,
+				IssuesJQLFilter:       testutil.GenPtr("project=TEST"),
+				IssuesUpdatedSince: testutil.GenPtr(
+					time.Date(2026, 1, 2, 4, 4, 5, 0, time.FixedZone("UTC+1", 3600)),
+				),
+			},
+			entity: jira.ValidEntityExternalIDs[jira_adapter.EnhancedIssue],
+			cursor: &pagination.CompositeCursor[string]{
+				Cursor: testutil.GenPtr("10"),
+			},
+			wantURL: "https://jira.com/rest/api/3/search/jql?jql=%28project%3DTEST%29+AND+" +
+				"updated+%3E%3D+1767323045000&nextPageToken=10&maxResults=10&fields=*navigable",
+		},
 		"group_members": {
 			request: &jira_adapter.Request{
 				RequestTimeoutSeconds: 5,
@@ -947,6 +1046,34 @@ func TestConstructURL(t *testing.T) {
 			},
 			wantErr: errors.New("cursor.CollectionID must not be nil for GroupMember entity"),
 		},
+		"changelogs": {
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               "https://jira.com",
+				PageSize:              10,
+				EntityExternalID:      jira_adapter.Changelog,
+			},
+			entity: jira.ValidEntityExternalIDs[jira_adapter.Changelog],
+			cursor: &pagination.CompositeCursor[string]{
+				Cursor:           testutil.GenPtr("10"),
+				CollectionID:     testutil.GenPtr("10001"),
+				CollectionCursor: testutil.GenPtr("1"),
+			},
+			wantURL: "https://jira.com/rest/api/3/issue/10001/changelog?startAt=10&maxResults=10",
+		},
+		"changelogs_missing_issue_id": {
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               "https://jira.com",
+				PageSize:              10,
+				EntityExternalID:      jira_adapter.Changelog,
+			},
+			entity: jira.ValidEntityExternalIDs[jira_adapter.Changelog],
+			cursor: &pagination.CompositeCursor[string]{
+				Cursor: testutil.GenPtr("10"),
+			},
+			wantErr: errors.New("cursor.CollectionID must not be nil for Changelog entity"),
+		},
 		"workspaces": {
 			request: &jira_adapter.Request{
 				RequestTimeoutSeconds: 5,
@@ -1040,6 +1167,9 @@ func TestGetPage(t *testing.T) {
 	t.Run("TestGetPageGroupMembers", ts.TestGetPageGroupMembers)
 	t.Run("TestGetPageWorkspaces", ts.TestGetPageWorkspaces)
 	t.Run("TestGetPageObjects", ts.TestGetPageObjects)
+	t.Run("TestGetPageWorklogs", ts.TestGetPageWorklogs)
+	t.Run("TestGetPageChangelogs", ts.TestGetPageChangelogs)
+	t.Run("TestGetPageIssuesWithExpansions", ts.TestGetPageIssuesWithExpansions)
 }
 
 func (ts *TestSuite) TestGetPageErrors(t *testing.T) {
@@ -1204,8 +1334,10 @@ func (ts *TestSuite) TestGetPageUsers(t *testing.T) {
 			},
 			wantResponse: nil,
 			wantErr: &framework.Error{
-				Message: "Cursor must not contain CollectionID or CollectionCursor fields for entity User.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity User: cursor must not contain CollectionID or CollectionCursor fields. " +
+					`Expected cursor shape: {"cursor":<string>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 	}
@@ -1784,8 +1916,10 @@ func (ts *TestSuite) TestGetPageGroupMembers(t *testing.T) {
 			},
 			wantResponse: nil,
 			wantErr: &framework.Error{
-				Message: "Cursor does not have CollectionID set for entity GroupMember.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity GroupMember: cursor does not have CollectionID set. " +
+					`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 		// On the first page sync of a GroupMember, we make a request for the first group. If that request fails,
@@ -1805,7 +1939,7 @@ func (ts *TestSuite) TestGetPageGroupMembers(t *testing.T) {
 			wantErr: &framework.Error{
 				Message: `Failed to execute Jira request: Get "http://localhost:1234/rest/api/3/group/bulk` +
 					`?startAt=0&maxResults=1": dial tcp [::1]:1234: connect: connection refused.`,
-				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
 			},
 		},
 		// If the GroupMember request is successful, but the response structure is not what we expect
@@ -2018,3 +2152,327 @@ func (ts *TestSuite) TestGetPageObjects(t *testing.T) {
 		})
 	}
 }
+
+func (ts *TestSuite) TestGetPageWorklogs(t *testing.T) {
+	externalEntityID := jira_adapter.Worklog
+
+	tests := map[string]struct {
+		ctx          context.Context
+		request      *jira_adapter.Request
+		wantResponse *jira_adapter.Response
+		wantErr      *framework.Error
+	}{
+		"first_page": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(10),
+				EntityExternalID:      externalEntityID,
+			},
+			wantResponse: &jira_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{"id": "10", "issueId": "1"},
+					{"id": "11", "issueId": "1"},
+					{"id": "12", "issueId": "2"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("2000"),
+				},
+			},
+		},
+		"first_page_truncated_to_page_size": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(2),
+				EntityExternalID:      externalEntityID,
+			},
+			// Worklogs 11 and 12 were updated at the same time, so they are both left to the next page.
+			wantResponse: &jira_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{"id": "10", "issueId": "1"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("1000"),
+				},
+			},
+		},
+		"last_page": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(10),
+				EntityExternalID:      externalEntityID,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("2000"),
+				},
+			},
+			wantResponse: &jira_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{"id": "13", "issueId": "2"},
+				},
+			},
+		},
+		"incremental_sync_no_updated_worklogs": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(10),
+				EntityExternalID:      externalEntityID,
+				WorklogsUpdatedSince:  testutil.GenPtr(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			wantResponse: &jira_adapter.Response{
+				StatusCode: 200,
+				Objects:    []map[string]any{},
+			},
+		},
+		"invalid_cursor": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(10),
+				EntityExternalID:      externalEntityID,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("yesterday"),
+				},
+			},
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity Worklog: "yesterday" is not a time in milliseconds since the epoch. ` +
+					`Expected cursor shape: {"cursor":<string>}. Restart the sync for this entity to discard the invalid cursor.`,
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"not_found": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(10),
+				EntityExternalID:      externalEntityID,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("5000"),
+				},
+			},
+			wantResponse: &jira_adapter.Response{
+				StatusCode: 404,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := ts.client.GetPage(tt.ctx, tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func (ts *TestSuite) TestGetPageChangelogs(t *testing.T) {
+	externalEntityID := jira_adapter.Changelog
+
+	tests := map[string]struct {
+		ctx          context.Context
+		request      *jira_adapter.Request
+		wantResponse *jira_adapter.Response
+		wantErr      *framework.Error
+	}{
+		// The majority of this logic has already been tested in TestGetPageGroupMembers, so
+		// most duplicate test cases are omitted here.
+		"first_page_first_issue": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(10),
+				EntityExternalID:      externalEntityID,
+			},
+			wantResponse: &jira_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{"id": "100", "issueId": "1"},
+					{"id": "101", "issueId": "1"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("1"),
+					CollectionCursor: testutil.GenPtr("1"),
+				},
+			},
+		},
+		"first_page_first_issue_page_size_1": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(1),
+				EntityExternalID:      externalEntityID,
+			},
+			wantResponse: &jira_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{"id": "100", "issueId": "1"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr("1"),
+					CollectionID:     testutil.GenPtr("1"),
+					CollectionCursor: testutil.GenPtr("1"),
+				},
+			},
+		},
+		"first_page_last_issue": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(1),
+				EntityExternalID:      externalEntityID,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("1"),
+					CollectionCursor: testutil.GenPtr("1"),
+				},
+			},
+			wantResponse: &jira_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{"id": "200", "issueId": "2"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("2"),
+					CollectionCursor: testutil.GenPtr("2"),
+				},
+			},
+		},
+		"missing_issue_id": {
+			ctx: context.Background(),
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(1),
+				EntityExternalID:      externalEntityID,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("1"),
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity Changelog: cursor does not have CollectionID set. " +
+					`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}. ` +
+					"Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := ts.client.GetPage(tt.ctx, tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func (ts *TestSuite) TestGetPageIssuesWithExpansions(t *testing.T) {
+	tests := map[string]struct {
+		request      *jira_adapter.Request
+		wantResponse *jira_adapter.Response
+		wantErr      *framework.Error
+	}{
+		"changelog_and_comments": {
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(10),
+				EntityExternalID:      jira_adapter.Issue,
+				IssueExpansions:       []string{jira_adapter.IssueExpansionChangelog, jira_adapter.IssueExpansionComments},
+				ExpansionConcurrency:  2,
+			},
+			wantResponse: &jira_adapter.Response{
+				StatusCode: 200,
+				Objects: []map[string]any{
+					{
+						"id":        "1",
+						"changelog": []any{map[string]any{"id": "100"}, map[string]any{"id": "101"}},
+						"comments":  []any{map[string]any{"id": "1000"}, map[string]any{"id": "1001"}, map[string]any{"id": "1002"}},
+					},
+					{
+						"id":        "2",
+						"changelog": []any{map[string]any{"id": "200"}},
+						"comments":  []any{},
+					},
+				},
+			},
+		},
+		"rate_limited_expansion": {
+			request: &jira_adapter.Request{
+				RequestTimeoutSeconds: 5,
+				BaseURL:               ts.server.URL,
+				Username:              mockUsername,
+				Password:              mockPassword,
+				PageSize:              int64(10),
+				EntityExternalID:      jira_adapter.Issue,
+				IssuesJQLFilter:       testutil.GenPtr("project='SGNL'"),
+				IssueExpansions:       []string{jira_adapter.IssueExpansionComments},
+			},
+			wantResponse: &jira_adapter.Response{
+				StatusCode:       429,
+				RetryAfterHeader: "30",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := ts.client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
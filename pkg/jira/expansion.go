@@ -0,0 +1,216 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	net_url "net/url"
+	"strconv"
+	"sync"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"go.uber.org/zap"
+)
+
+const (
+	// IssueExpansionChangelog expands each issue with all its changelogs, in the "changelog" attribute.
+	// nolint:lll
+	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-changelog-get.
+	IssueExpansionChangelog = "changelog"
+
+	// IssueExpansionComments expands each issue with all its comments, in the "comments" attribute.
+	// nolint:lll
+	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-comments/#api-rest-api-3-issue-issueidorkey-comment-get.
+	IssueExpansionComments = "comments"
+
+	// DefaultExpansionConcurrency is the default maximum number of concurrent expansion requests.
+	DefaultExpansionConcurrency = 5
+
+	// MaxExpansionConcurrency is the maximum value of Config.ExpansionConcurrency, to stay well within the
+	// Jira Cloud rate limits.
+	MaxExpansionConcurrency = 20
+
+	// expansionPageSize is the maximum page size of the changelog and comment endpoints.
+	expansionPageSize = 100
+)
+
+// issueExpansion describes how to request all the values of an issue expansion.
+type issueExpansion struct {
+	// path is appended to "/rest/api/3/issue/{issueId}" to request the values.
+	path string
+
+	// valuesField is the field of the response containing the values of the page.
+	valuesField string
+}
+
+// issueExpansions are the supported expansions of the Issue entity, by name.
+var issueExpansions = map[string]issueExpansion{
+	IssueExpansionChangelog: {path: "/changelog", valuesField: "values"},
+	IssueExpansionComments:  {path: "/comment", valuesField: "comments"},
+}
+
+// expansionJob is the expansion of a single issue of a page.
+type expansionJob struct {
+	issueIndex int
+	issueID    string
+	expansion  string
+}
+
+// expansionResult is the result of an expansionJob.
+type expansionResult struct {
+	values           []any
+	statusCode       int
+	retryAfterHeader string
+	err              *framework.Error
+}
+
+// expandIssues sets the expansions of the request on each issue of a page, e.g. all the changelogs of each issue
+// in its "changelog" attribute. Expanded issue syncs are dominated by the round-trip latency of the requests of
+// each issue, so the issues are expanded concurrently by a pool of request.ExpansionConcurrency workers.
+//
+// If any expansion request fails, the first error is returned. If any expansion request responds with a status
+// code other than 200, a Response with that status code is returned and the issues must be discarded.
+func (d *Datasource) expandIssues(
+	ctx context.Context, request *Request, issues []map[string]any, logger *zap.Logger,
+) (*Response, *framework.Error) {
+	jobs := make([]expansionJob, 0, len(issues)*len(request.IssueExpansions))
+
+	for i, issue := range issues {
+		issueID, ok := issue[ValidEntityExternalIDs[Issue].uniqueIDAttrExternalID].(string)
+		if !ok {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Failed to parse id field in Jira %s response as string.", Issue),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		for _, expansion := range request.IssueExpansions {
+			jobs = append(jobs, expansionJob{issueIndex: i, issueID: issueID, expansion: expansion})
+		}
+	}
+
+	concurrency := request.ExpansionConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultExpansionConcurrency
+	}
+
+	// The remaining jobs are canceled as soon as one fails.
+	expansionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobsCh := make(chan int)
+	results := make([]expansionResult, len(jobs))
+
+	var (
+		wg           sync.WaitGroup
+		mu           sync.Mutex
+		firstFailure *expansionResult
+	)
+
+	for range min(concurrency, len(jobs)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := range jobsCh {
+				results[j] = d.expandIssue(expansionCtx, request, jobs[j], logger)
+
+				if results[j].err == nil && results[j].statusCode == http.StatusOK {
+					continue
+				}
+
+				// Only the first failure is returned, not the cancellation errors of the jobs canceled after it.
+				mu.Lock()
+				if firstFailure == nil {
+					firstFailure = &results[j]
+				}
+				mu.Unlock()
+
+				cancel()
+			}
+		}()
+	}
+
+	for j := range jobs {
+		if expansionCtx.Err() != nil {
+			break
+		}
+
+		jobsCh <- j
+	}
+
+	close(jobsCh)
+	wg.Wait()
+
+	if firstFailure != nil {
+		if firstFailure.err != nil {
+			return nil, firstFailure.err
+		}
+
+		return &Response{StatusCode: firstFailure.statusCode, RetryAfterHeader: firstFailure.retryAfterHeader}, nil
+	}
+
+	// The results are set once all the workers are done, since the jobs of an issue share its map.
+	for j, job := range jobs {
+		issues[job.issueIndex][job.expansion] = results[j].values
+	}
+
+	return nil, nil
+}
+
+// expandIssue requests all the pages of an expansion of an issue.
+func (d *Datasource) expandIssue(
+	ctx context.Context, request *Request, job expansionJob, logger *zap.Logger,
+) expansionResult {
+	expansion := issueExpansions[job.expansion]
+	values := make([]any, 0)
+
+	var startAt int64
+
+	for {
+		url := request.BaseURL + "/rest/api/3/issue/" + net_url.PathEscape(job.issueID) + expansion.path +
+			"?startAt=" + strconv.FormatInt(startAt, 10) + "&maxResults=" + strconv.Itoa(expansionPageSize)
+
+		statusCode, retryAfterHeader, body, err := d.send(ctx, request, http.MethodGet, url, nil, logger)
+		if err != nil {
+			return expansionResult{err: err}
+		}
+
+		if statusCode != http.StatusOK {
+			return expansionResult{statusCode: statusCode, retryAfterHeader: retryAfterHeader}
+		}
+
+		var data map[string]any
+
+		if unmarshalErr := json.Unmarshal(body, &data); unmarshalErr != nil {
+			return expansionResult{err: &framework.Error{
+				Message: fmt.Sprintf("Failed to unmarshal Jira issue %s response: %v.", job.expansion, unmarshalErr),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}}
+		}
+
+		objects, ok := data[expansion.valuesField].([]any)
+		if !ok {
+			return expansionResult{err: &framework.Error{
+				Message: fmt.Sprintf("Field missing in Jira issue %s response: %s.", job.expansion, expansion.valuesField),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}}
+		}
+
+		values = append(values, objects...)
+		startAt += int64(len(objects))
+
+		// The changelog endpoint sets isLast, the comment endpoint only sets total.
+		isLast, hasIsLast := data[isLastFieldName].(bool)
+		total, _ := data["total"].(float64)
+
+		if len(objects) == 0 || isLast || (!hasIsLast && startAt >= int64(total)) {
+			return expansionResult{values: values, statusCode: http.StatusOK}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package jira_test
+
+import (
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/jira"
+)
+
+// FuzzParseResponse checks that malformed Jira responses are rejected with an error instead of a panic, for all
+// the entities, and for malformed cursors.
+func FuzzParseResponse(f *testing.F) {
+	parsers := []func(body []byte, pageSize int64, cursor string) ([]map[string]any, *string, *framework.Error){
+		jira.ParseUsersResponse,
+		jira.ParseIssuesResponse,
+		jira.ParseEnhancedIssuesResponse,
+		jira.ParseGroupsResponse,
+		jira.ParseGroupMembersResponse,
+		jira.ParseWorkspacesResponse,
+		jira.ParseObjectsResponse,
+		jira.ParseChangelogsResponse,
+		jira.ParseWorklogsResponse,
+	}
+
+	for _, seed := range []string{
+		`[{"accountId":"5b10a2844c20165700ede21g","accountType":"atlassian","active":true}]`,
+		`{"startAt":0,"maxResults":1,"total":2,"issues":[{"id":"10002","key":"ED-1"}]}`,
+		`{"issues":[{"id":"10002","key":"ED-1"}],"nextPageToken":"CAEaAggD","isLast":false}`,
+		`{"isLast":false,"maxResults":1,"startAt":0,"total":2,"values":[{"groupId":"276f955c-63d7-42c8-9520-92d01dca0625","name":"jdog-developers"}]}`,
+		`{"isLastPage":"yes","values":[{"workspaceId":"g2778e1b-939d-581d-c8e2-9d5g59de456b"}]}`,
+		`[{"id":"100028","issueId":"10002","timeSpentSeconds":12000}]`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), uint8(0), int64(1), "0")
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, parserIndex uint8, pageSize int64, cursor string) {
+		_, _, err := parsers[int(parserIndex)%len(parsers)](body, pageSize, cursor)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for parser %d and body %q", int(parserIndex)%len(parsers), body)
+		}
+	})
+}
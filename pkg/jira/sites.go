@@ -0,0 +1,134 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package jira
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// SiteIDAttribute is the attribute set on each object synced from a datasource with multiple sites, with the ID
+// of the site the object was retrieved from, i.e. the address of the site. Some IDs, e.g. the IDs of issues, are
+// only unique within a site.
+const SiteIDAttribute = "siteId"
+
+// siteCursorShape is the expected JSON shape of a SiteCursor.
+const siteCursorShape = `{"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>,"siteId":<string>}`
+
+// SiteCursor is the cursor of an entity synced from multiple sites, see Config.Sites. It embeds the composite
+// cursor of the page within the site, so that it is marshaled into the same JSON object, e.g.:
+//
+//	{
+//	    "cursor": "100",
+//	    "siteId": "https://acme-eu.atlassian.net"
+//	}
+//
+// An empty composite cursor identifies the first page of the site.
+type SiteCursor struct {
+	pagination.CompositeCursor[string]
+
+	// SiteID is the ID of the site of the page, i.e. its address.
+	// nil for the site of the datasource address.
+	SiteID *string `json:"siteId,omitempty"`
+}
+
+// UnmarshalSiteCursor unmarshals the cursor provided for the given entity from a base64 encoded JSON string.
+// It also accepts the cursors of datasources with a single site, which have no site ID.
+func UnmarshalSiteCursor(cursor string, entityExternalID string) (*SiteCursor, *framework.Error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	siteCursor := &SiteCursor{}
+
+	cursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID,
+			siteCursorShape,
+			fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	if err := json.Unmarshal(cursorBytes, siteCursor); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID,
+			siteCursorShape,
+			fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	return siteCursor, nil
+}
+
+// pageCursor returns the composite cursor of the page within the site, or nil for the first page of the site.
+func (c *SiteCursor) pageCursor() *pagination.CompositeCursor[string] {
+	if c == nil || (c.Cursor == nil && c.CollectionID == nil && c.CollectionCursor == nil) {
+		return nil
+	}
+
+	return &c.CompositeCursor
+}
+
+// MarshalSiteCursor marshals the cursor into a base64 encoded JSON string.
+func MarshalSiteCursor(cursor *SiteCursor) (string, *framework.Error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	cursorBytes, err := json.Marshal(cursor)
+	if err != nil {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal cursor into JSON: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(cursorBytes), nil
+}
+
+// siteIndex returns the index of the site of the cursor in sites, the first site being the site of the datasource
+// address.
+func siteIndex(cursor *SiteCursor, sites []string, entityExternalID string) (int, *framework.Error) {
+	if cursor == nil || cursor.SiteID == nil {
+		return 0, nil
+	}
+
+	for i, site := range sites {
+		if site == *cursor.SiteID {
+			return i, nil
+		}
+	}
+
+	return 0, pagination.NewCursorError(
+		entityExternalID,
+		siteCursorShape,
+		fmt.Sprintf("site %s is not configured", *cursor.SiteID),
+	)
+}
+
+// nextSiteCursor returns the cursor of the page following the page of the site at index siteIndex, whose
+// next cursor within the site is nextCursor, or nil if the page is the last page of the last site.
+func nextSiteCursor(
+	nextCursor *pagination.CompositeCursor[string], sites []string, siteIndex int,
+) *SiteCursor {
+	if nextCursor != nil {
+		return &SiteCursor{
+			CompositeCursor: *nextCursor,
+			SiteID:          &sites[siteIndex],
+		}
+	}
+
+	if siteIndex+1 < len(sites) {
+		return &SiteCursor{
+			SiteID: &sites[siteIndex+1],
+		}
+	}
+
+	return nil
+}
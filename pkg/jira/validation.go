@@ -43,6 +43,32 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		request.Address = trimmedAddress
 	}
 
+	if request.Config != nil && len(request.Config.Sites) > 0 {
+		sites := make(map[string]struct{}, len(request.Config.Sites)+1)
+		sites[request.Address] = struct{}{}
+
+		for i, site := range request.Config.Sites {
+			trimmedSite, parsedSite, err := validation.ParseAndValidateAddress(site, []string{"https"})
+			if err != nil {
+				return err
+			}
+
+			if parsedSite.Scheme == "" {
+				trimmedSite = "https://" + trimmedSite
+			}
+
+			if _, found := sites[trimmedSite]; found {
+				return &framework.Error{
+					Message: fmt.Sprintf("Jira config is invalid: site %s is specified more than once.", trimmedSite),
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+				}
+			}
+
+			sites[trimmedSite] = struct{}{}
+			request.Config.Sites[i] = trimmedSite
+		}
+	}
+
 	// Jira uses Basic Auth for REST API clients:
 	//   https://developer.atlassian.com/cloud/jira/platform/security-overview/#scripts-and-other-rest-api-clients.
 	// The username is the email address and the password is an API token:
@@ -10,6 +10,7 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
 	jira_adapter "github.com/sgnl-ai/adapters/pkg/jira"
 	"github.com/sgnl-ai/adapters/pkg/testutil"
 )
@@ -63,6 +64,85 @@ func TestValidateGetPageRequest(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"invalid_config_unsupported_issue_expansion": {
+			request: &framework.Request[jira_adapter.Config]{
+				Address: "https://example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "username",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: jira_adapter.Issue,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+						},
+					},
+				},
+				Config: &jira_adapter.Config{
+					IssueExpansions: []string{"changelog", "worklogs"},
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Jira config is invalid: issueExpansions contains an unsupported expansion: worklogs.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_config_duplicate_issue_expansion": {
+			request: &framework.Request[jira_adapter.Config]{
+				Address: "https://example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "username",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: jira_adapter.Issue,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+						},
+					},
+				},
+				Config: &jira_adapter.Config{
+					IssueExpansions: []string{"comments", "comments"},
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Jira config is invalid: issueExpansions must not contain duplicate values: comments.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_config_expansion_concurrency_too_high": {
+			request: &framework.Request[jira_adapter.Config]{
+				Address: "https://example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "username",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: jira_adapter.Issue,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+						},
+					},
+				},
+				Config: &jira_adapter.Config{
+					IssueExpansions:      []string{"comments"},
+					ExpansionConcurrency: 50,
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Jira config is invalid: expansionConcurrency must be between 0 and 20.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_config_filter_empty": {
 			request: &framework.Request[jira_adapter.Config]{
 				Address: "https://example.com",
@@ -115,6 +195,34 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"invalid_config_incremental_sync_missing_since": {
+			request: &framework.Request[jira_adapter.Config]{
+				Address: "https://example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "username",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: jira_adapter.Issue,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+						},
+					},
+				},
+				Config: &jira_adapter.Config{
+					CommonConfig: &config.CommonConfig{
+						SyncMode: config.SyncModeIncremental,
+					},
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Jira config is invalid: incrementalSyncSince is required when syncMode is INCREMENTAL.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"valid_ql_query": {
 			request: &framework.Request[jira_adapter.Config]{
 				Address: "https://example.com",
@@ -247,6 +355,55 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"valid_sites": {
+			request: &framework.Request[jira_adapter.Config]{
+				Address: "https://example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "username",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: jira_adapter.Issue,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+						},
+					},
+				},
+				Config: &jira_adapter.Config{
+					Sites: []string{"https://example-eu.com", "example-apac.com"},
+				},
+			},
+			wantErr: nil,
+		},
+		"duplicate_sites": {
+			request: &framework.Request[jira_adapter.Config]{
+				Address: "https://example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "username",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: jira_adapter.Issue,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+						},
+					},
+				},
+				Config: &jira_adapter.Config{
+					Sites: []string{"https://example-eu.com", "example.com"},
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Jira config is invalid: site https://example.com is specified more than once.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"missing_auth": {
 			request: &framework.Request[jira_adapter.Config]{
 				Address: "https://example.com",
@@ -0,0 +1,286 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"go.uber.org/zap"
+)
+
+// worklogListEndpoint is the endpoint returning the worklogs of a list of worklog IDs.
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-worklog-list-post.
+const worklogListEndpoint = "worklog/list"
+
+// UpdatedWorklogsResponse is the response of the updated worklogs endpoint.
+type UpdatedWorklogsResponse struct {
+	// Values are the IDs of the worklogs updated since the requested time, ordered by update time.
+	Values []UpdatedWorklog `json:"values"`
+
+	// Until is the update time of the last worklog of the page, in milliseconds since the epoch.
+	Until int64 `json:"until"`
+
+	// LastPage is true if there are no more worklogs updated since the requested time.
+	LastPage bool `json:"lastPage"`
+}
+
+// UpdatedWorklog is a worklog returned by the updated worklogs endpoint.
+type UpdatedWorklog struct {
+	WorklogID int64 `json:"worklogId"`
+
+	// UpdatedTime is the update time of the worklog, in milliseconds since the epoch.
+	UpdatedTime int64 `json:"updatedTime"`
+}
+
+// getWorklogPage returns a page of the worklogs updated since the time in the cursor.
+//
+// The cursor is the update time of the last worklog of the previous page, in milliseconds since the epoch.
+// The IDs of the worklogs updated after that time are requested first, then the worklogs of the page are
+// requested by ID. The first page starts at request.WorklogsUpdatedSince during an incremental sync, and
+// at the epoch otherwise.
+func (d *Datasource) getWorklogPage(
+	ctx context.Context, request *Request, logger *zap.Logger,
+) (*Response, *framework.Error) {
+	since, cursorErr := worklogsSince(request)
+	if cursorErr != nil {
+		return nil, cursorErr
+	}
+
+	url := request.BaseURL + "/rest/api/3/" + ValidEntityExternalIDs[Worklog].endpoint +
+		"?since=" + strconv.FormatInt(since, 10)
+
+	statusCode, retryAfterHeader, body, err := d.send(ctx, request, http.MethodGet, url, nil, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return &Response{StatusCode: statusCode, RetryAfterHeader: retryAfterHeader}, nil
+	}
+
+	var updated UpdatedWorklogsResponse
+
+	if unmarshalErr := json.Unmarshal(body, &updated); unmarshalErr != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal Jira updated worklogs response: %v.", unmarshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	values, nextSince := pageUpdatedWorklogs(updated, request.PageSize)
+
+	response := &Response{
+		StatusCode: http.StatusOK,
+		Objects:    []map[string]any{},
+	}
+
+	if nextSince != nil {
+		nextCursor := strconv.FormatInt(*nextSince, 10)
+		response.NextCursor = &pagination.CompositeCursor[string]{Cursor: &nextCursor}
+	}
+
+	if len(values) == 0 {
+		return response, nil
+	}
+
+	ids := make([]int64, 0, len(values))
+	for _, value := range values {
+		ids = append(ids, value.WorklogID)
+	}
+
+	listBody, marshalErr := json.Marshal(map[string]any{"ids": ids})
+	if marshalErr != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal Jira worklog list request body: %v.", marshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	statusCode, retryAfterHeader, body, err = d.send(
+		ctx, request, http.MethodPost, request.BaseURL+"/rest/api/3/"+worklogListEndpoint, listBody, logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		return &Response{StatusCode: statusCode, RetryAfterHeader: retryAfterHeader}, nil
+	}
+
+	objects, _, parseErr := ValidEntityExternalIDs[Worklog].parseResponse(body, request.PageSize, "")
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// worklogsSince returns the time after which the worklogs of the page were updated, in milliseconds since the epoch.
+func worklogsSince(request *Request) (int64, *framework.Error) {
+	if request.Cursor == nil || request.Cursor.Cursor == nil {
+		if request.WorklogsUpdatedSince == nil {
+			return 0, nil
+		}
+
+		return request.WorklogsUpdatedSince.UnixMilli(), nil
+	}
+
+	if err := pagination.ValidateCompositeCursor(request.Cursor, Worklog, false); err != nil {
+		return 0, err
+	}
+
+	since, err := strconv.ParseInt(*request.Cursor.Cursor, 10, 64)
+	if err != nil || since < 0 {
+		return 0, pagination.NewCursorError(
+			Worklog,
+			pagination.CompositeCursorShape[string](false),
+			fmt.Sprintf("%q is not a time in milliseconds since the epoch", *request.Cursor.Cursor),
+		)
+	}
+
+	return since, nil
+}
+
+// pageUpdatedWorklogs returns the updated worklogs of the page and the time after which the worklogs of the next
+// page were updated, or nil if this is the last page.
+//
+// The updated worklogs endpoint returns up to 1000 worklogs regardless of the page size, so the worklogs
+// are truncated to the page size. Worklogs updated at the same time are kept on the same page, since the next
+// page starts after the update time of the last worklog of the page.
+func pageUpdatedWorklogs(updated UpdatedWorklogsResponse, pageSize int64) ([]UpdatedWorklog, *int64) {
+	values := updated.Values
+
+	if int64(len(values)) > pageSize {
+		end := pageSize
+
+		for end > 0 && values[end-1].UpdatedTime == values[end].UpdatedTime {
+			end--
+		}
+
+		// All the worklogs of the page were updated at the same time, so they can't be split.
+		if end > 0 {
+			nextSince := values[end-1].UpdatedTime
+
+			return values[:end], &nextSince
+		}
+	}
+
+	if updated.LastPage {
+		return values, nil
+	}
+
+	return values, &updated.Until
+}
+
+// ParseWorklogsResponse parses the response of the worklog list endpoint, a list of worklogs.
+// The worklogs are paginated by getWorklogPage, so no next cursor is returned.
+func ParseWorklogsResponse(
+	body []byte, _ int64, _ string,
+) (objects []map[string]any, nextCursor *string, err *framework.Error) {
+	var worklogs []any
+
+	if unmarshalErr := json.Unmarshal(body, &worklogs); unmarshalErr != nil {
+		return nil, nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal Jira %s response: %v.", Worklog, unmarshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	objects, parserErr := parseObjects(Worklog, worklogs)
+	if parserErr != nil {
+		return nil, nil, parserErr
+	}
+
+	return objects, nil, nil
+}
+
+// send sends a request to Jira and returns the status code, the Retry-After header and the body of the
+// response. The body is only returned if the status code is 200.
+func (d *Datasource) send(
+	ctx context.Context, request *Request, method, url string, body []byte, logger *zap.Logger,
+) (int, string, []byte, *framework.Error) {
+	// Timeout API calls that take longer than the configured timeout.
+	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(apiCtx, method, url, bodyReader)
+	if err != nil {
+		return 0, "", nil, &framework.Error{
+			Message: fmt.Sprintf("Address in datasource config is an invalid URL: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	req.Header.Add("Authorization", basicAuth(request.Username, request.Password))
+
+	logger.Info("Sending request to datasource", fields.RequestURL(url))
+
+	res, err := d.Client.Do(req)
+	if err != nil {
+		logger.Error("Request to datasource failed",
+			fields.RequestURL(url),
+			fields.SGNLEventTypeError(),
+			zap.Error(err),
+		)
+
+		return 0, "", nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to execute Jira request: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
+		)
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return 0, "", nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to read Jira response: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	if res.StatusCode != http.StatusOK {
+		logger.Error("Datasource responded with an error",
+			fields.RequestURL(url),
+			fields.ResponseStatusCode(res.StatusCode),
+			fields.ResponseRetryAfterHeader(res.Header.Get("Retry-After")),
+			fields.ResponseBody(resBody),
+			fields.SGNLEventTypeError(),
+		)
+
+		return res.StatusCode, res.Header.Get("Retry-After"), nil, nil
+	}
+
+	return res.StatusCode, "", resBody, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package jsonstream decodes pages of JSON objects directly from a response body.
+//
+// Unmarshaling a full response into an intermediate struct requires buffering the entire body and
+// allocating a second copy of every object. For high-volume entities, this garbage dominates sync
+// time. The decoders in this package walk the JSON tokens of the body and only materialize the
+// objects of the requested list.
+package jsonstream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Decoder decodes a list of JSON objects from a reader.
+// Implementations backed by alternative JSON libraries (e.g. sonic or jsoniter) can be swapped in
+// by assigning Default.
+type Decoder interface {
+	// DecodeList decodes a list of JSON objects from r.
+	//
+	// If listKey is empty, the body must be a JSON array of objects.
+	// Otherwise, the body must be a JSON object and the list is read from its listKey member. The
+	// values of the string members named in stringKeys are returned in the second return value,
+	// keyed by member name. Members with a null value are omitted. All other members are skipped.
+	DecodeList(r io.Reader, listKey string, stringKeys ...string) ([]map[string]any, map[string]string, error)
+}
+
+// Default is the Decoder used by adapters.
+var Default Decoder = &TokenDecoder{}
+
+// DecodeList decodes a list of JSON objects from r using the Default decoder.
+func DecodeList(r io.Reader, listKey string, stringKeys ...string) ([]map[string]any, map[string]string, error) {
+	return Default.DecodeList(r, listKey, stringKeys...)
+}
+
+// TokenDecoder is a Decoder implemented by walking the tokens of an encoding/json Decoder.
+// Numbers are decoded as float64, matching the behavior of json.Unmarshal.
+type TokenDecoder struct{}
+
+// DecodeList implements Decoder.
+func (*TokenDecoder) DecodeList(
+	r io.Reader, listKey string, stringKeys ...string,
+) ([]map[string]any, map[string]string, error) {
+	dec := json.NewDecoder(r)
+
+	if listKey == "" {
+		objects, err := decodeObjectArray(dec, false)
+
+		return objects, nil, err
+	}
+
+	if err := expectDelim(dec, '{', "JSON object"); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		objects []map[string]any
+		values  map[string]string
+	)
+
+	for dec.More() {
+		keyToken, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key, ok := keyToken.(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("expected object key but found %v", keyToken)
+		}
+
+		switch {
+		case key == listKey:
+			if objects, err = decodeObjectArray(dec, true); err != nil {
+				return nil, nil, err
+			}
+		case slices.Contains(stringKeys, key):
+			value, err := dec.Token()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			switch v := value.(type) {
+			case nil:
+			case string:
+				if values == nil {
+					values = make(map[string]string, len(stringKeys))
+				}
+
+				values[key] = v
+			default:
+				return nil, nil, fmt.Errorf("member %q: expected string but found %v", key, value)
+			}
+		default:
+			if err := skipValue(dec); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	// Consume the closing delimiter of the top-level object.
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, err
+	}
+
+	return objects, values, nil
+}
+
+// decodeObjectArray decodes a JSON array of objects one element at a time.
+// If allowNull is true, a null value is accepted and decoded as a nil list.
+func decodeObjectArray(dec *json.Decoder, allowNull bool) ([]map[string]any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok == nil && allowNull {
+		return nil, nil
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("expected start of JSON array but found %v", tok)
+	}
+
+	objects := make([]map[string]any, 0)
+
+	for dec.More() {
+		var object map[string]any
+
+		if err := dec.Decode(&object); err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, object)
+	}
+
+	// Consume the closing delimiter of the array.
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// expectDelim reads the next token and verifies it is the expected delimiter.
+func expectDelim(dec *json.Decoder, want json.Delim, description string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("expected start of %s but found end of input", description)
+		}
+
+		return err
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("expected start of %s but found %v", description, tok)
+	}
+
+	return nil
+}
+
+// skipValue discards the next JSON value without allocating it.
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if delim, ok := tok.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+			}
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
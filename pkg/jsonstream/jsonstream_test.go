@@ -0,0 +1,176 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package jsonstream_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/jsonstream"
+)
+
+func TestDecodeList(t *testing.T) {
+	tests := map[string]struct {
+		body        string
+		listKey     string
+		stringKeys  []string
+		wantObjects []map[string]any
+		wantValues  map[string]string
+		wantErr     string
+	}{
+		"top_level_array": {
+			body: `[{"id": "1", "count": 2}, {"id": "2", "nested": {"a": [1, 2]}}]`,
+			wantObjects: []map[string]any{
+				{"id": "1", "count": float64(2)},
+				{"id": "2", "nested": map[string]any{"a": []any{float64(1), float64(2)}}},
+			},
+		},
+		"top_level_empty_array": {
+			body:        `[]`,
+			wantObjects: []map[string]any{},
+		},
+		"top_level_not_an_array": {
+			body:    `{"result": []}`,
+			wantErr: "expected start of JSON array but found {",
+		},
+		"top_level_null": {
+			body:    `null`,
+			wantErr: "expected start of JSON array but found <nil>",
+		},
+		"member_list_with_string_values": {
+			body:       `{"@odata.context": {"skip": [1, {"a": "b"}]}, "value": [{"id": "1"}], "@odata.nextLink": "https://next"}`,
+			listKey:    "value",
+			stringKeys: []string{"@odata.nextLink"},
+			wantObjects: []map[string]any{
+				{"id": "1"},
+			},
+			wantValues: map[string]string{"@odata.nextLink": "https://next"},
+		},
+		"member_list_null_string_value": {
+			body:        `{"value": [], "@odata.nextLink": null}`,
+			listKey:     "value",
+			stringKeys:  []string{"@odata.nextLink"},
+			wantObjects: []map[string]any{},
+		},
+		"member_list_missing": {
+			body:    `{"other": [{"id": "1"}]}`,
+			listKey: "result",
+		},
+		"member_list_null": {
+			body:    `{"result": null}`,
+			listKey: "result",
+		},
+		"member_list_not_an_array": {
+			body:    `{"result": {"id": "1"}}`,
+			listKey: "result",
+			wantErr: "expected start of JSON array but found {",
+		},
+		"member_list_invalid_element": {
+			body:    `{"result": ["1", "2"]}`,
+			listKey: "result",
+			wantErr: "json: cannot unmarshal string into Go value of type map[string]interface {}",
+		},
+		"member_string_value_invalid_type": {
+			body:       `{"value": [], "@odata.nextLink": 1}`,
+			listKey:    "value",
+			stringKeys: []string{"@odata.nextLink"},
+			wantErr:    `member "@odata.nextLink": expected string but found 1`,
+		},
+		"body_not_an_object": {
+			body:    `[{"id": "1"}]`,
+			listKey: "value",
+			wantErr: "expected start of JSON object but found [",
+		},
+		"empty_body": {
+			body:    ``,
+			listKey: "value",
+			wantErr: "expected start of JSON object but found end of input",
+		},
+		"syntax_error": {
+			body:    `{"value": [{"1"}]}`,
+			listKey: "value",
+			wantErr: "invalid character '}' after object key",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotValues, gotErr := jsonstream.DecodeList(strings.NewReader(tt.body), tt.listKey, tt.stringKeys...)
+
+			if tt.wantErr != "" {
+				if gotErr == nil || gotErr.Error() != tt.wantErr {
+					t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+				}
+
+				return
+			}
+
+			if gotErr != nil {
+				t.Fatalf("unexpected error: %v", gotErr)
+			}
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotValues, tt.wantValues) {
+				t.Errorf("gotValues: %v, wantValues: %v", gotValues, tt.wantValues)
+			}
+		})
+	}
+}
+
+func generatePage(objectCount int) []byte {
+	var builder strings.Builder
+
+	builder.WriteString(`{"@odata.context": "https://graph.microsoft.com/v1.0/$metadata#users", "value": [`)
+
+	for i := range objectCount {
+		if i > 0 {
+			builder.WriteString(",")
+		}
+
+		fmt.Fprintf(&builder,
+			`{"id": "%d", "displayName": "User %d", "mail": "user%d@example.com", "accountEnabled": true, `+
+				`"businessPhones": ["+1 555 0100"], "employeeOrgData": {"division": "Engineering", "costCenter": "%d"}}`,
+			i, i, i, i,
+		)
+	}
+
+	builder.WriteString(`], "@odata.nextLink": "https://graph.microsoft.com/v1.0/users?$skiptoken=abc"}`)
+
+	return []byte(builder.String())
+}
+
+func BenchmarkDecodeList(b *testing.B) {
+	body := generatePage(999)
+
+	b.Run("jsonstream", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for b.Loop() {
+			if _, _, err := jsonstream.DecodeList(bytes.NewReader(body), "value", "@odata.nextLink"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for b.Loop() {
+			var data struct {
+				Values   []map[string]any `json:"value"`
+				NextLink *string          `json:"@odata.nextLink"`
+			}
+
+			if err := json.Unmarshal(body, &data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
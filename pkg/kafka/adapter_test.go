@@ -0,0 +1,252 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package kafka_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/kafka"
+)
+
+// testPartitions are the records of the partitions of the "identity.users" topic served by the mock
+// Kafka HTTP proxy, by path.
+var testPartitions = map[string][]kafka.Record{
+	"/topics/identity.users/partitions/0/records": {
+		{Value: json.RawMessage(`{"id": "1", "name": "Alice"}`), Partition: 0, Offset: 0},
+		{Value: json.RawMessage(`{"id": "2", "name": "Bob"}`), Partition: 0, Offset: 1},
+		{Value: json.RawMessage(`{"id": "3", "name": "Carol"}`), Partition: 0, Offset: 2},
+	},
+	"/topics/identity.users/partitions/1/records": {
+		{Value: json.RawMessage(`null`), Partition: 1, Offset: 5},
+		{Value: json.RawMessage(`{"id": "4", "name": "Dave"}`), Partition: 1, Offset: 6},
+	},
+	"/topics/identity.invalid/partitions/0/records": {
+		{Value: json.RawMessage(`"not an object"`), Partition: 0, Offset: 0},
+	},
+}
+
+// TestServerHandler mocks a Kafka HTTP proxy, serving the records of testPartitions from the requested offset.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer testtoken" {
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	if r.Header.Get("Accept") != kafka.JSONRecordsContentType {
+		w.WriteHeader(http.StatusNotAcceptable)
+
+		return
+	}
+
+	records, found := testPartitions[r.URL.Path]
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	offset, err := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	fetched := []kafka.Record{}
+
+	for _, record := range records {
+		if record.Offset >= offset {
+			fetched = append(fetched, record)
+		}
+	}
+
+	w.Header().Set("Content-Type", kafka.JSONRecordsContentType)
+	json.NewEncoder(w).Encode(fetched)
+})
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	defer server.Close()
+
+	adapter := kafka.NewAdapter(kafka.NewClient(server.Client()))
+
+	entity := framework.EntityConfig{
+		ExternalId: "User",
+		Attributes: []*framework.AttributeConfig{
+			{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+			{ExternalId: "name", Type: framework.AttributeTypeString},
+		},
+	}
+
+	config := &kafka.Config{
+		Topics: map[string]kafka.Topic{
+			"User": {Name: "identity.users", Partitions: 2},
+		},
+	}
+
+	auth := &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"}
+
+	cursor := func(partition int32, offset int64) string {
+		marshaledCursor, _ := kafka.MarshalCursor(&kafka.Cursor{Partition: partition, Offset: offset})
+
+		return marshaledCursor
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[kafka.Config]
+		wantResponse framework.Response
+	}{
+		"first_page": {
+			request: &framework.Request[kafka.Config]{
+				Address:  server.URL,
+				Auth:     auth,
+				Config:   config,
+				Entity:   entity,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "1", "name": "Alice"},
+						{"id": "2", "name": "Bob"},
+					},
+					NextCursor: cursor(0, 2),
+				},
+			},
+		},
+		"end_of_partition": {
+			request: &framework.Request[kafka.Config]{
+				Address:  server.URL,
+				Auth:     auth,
+				Config:   config,
+				Entity:   entity,
+				PageSize: 2,
+				Cursor:   cursor(0, 2),
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "3", "name": "Carol"},
+					},
+					NextCursor: cursor(0, 3),
+				},
+			},
+		},
+		"next_partition_skips_tombstones": {
+			request: &framework.Request[kafka.Config]{
+				Address:  server.URL,
+				Auth:     auth,
+				Config:   config,
+				Entity:   entity,
+				PageSize: 2,
+				Cursor:   cursor(0, 3),
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "4", "name": "Dave"},
+					},
+					NextCursor: cursor(1, 7),
+				},
+			},
+		},
+		"last_page": {
+			request: &framework.Request[kafka.Config]{
+				Address:  server.URL,
+				Auth:     auth,
+				Config:   config,
+				Entity:   entity,
+				PageSize: 2,
+				Cursor:   cursor(1, 7),
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{},
+			},
+		},
+		"invalid_cursor_partition": {
+			request: &framework.Request[kafka.Config]{
+				Address:  server.URL,
+				Auth:     auth,
+				Config:   config,
+				Entity:   entity,
+				PageSize: 2,
+				Cursor:   cursor(2, 0),
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Cursor partition 2 and offset 0 are invalid for topic identity.users with 2 partitions.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				},
+			},
+		},
+		"message_not_json_object": {
+			request: &framework.Request[kafka.Config]{
+				Address: server.URL,
+				Auth:    auth,
+				Config: &kafka.Config{
+					Topics: map[string]kafka.Topic{
+						"User": {Name: "identity.invalid"},
+					},
+				},
+				Entity:   entity,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "The message at partition 0 and offset 0 is not a JSON object: " +
+						"json: cannot unmarshal string into Go value of type map[string]interface {}.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+				},
+			},
+		},
+		"missing_topic": {
+			request: &framework.Request[kafka.Config]{
+				Address:  server.URL,
+				Auth:     auth,
+				Entity:   entity,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Datasource rejected request, returned status code: 404.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				},
+			},
+		},
+		"invalid_token": {
+			request: &framework.Request[kafka.Config]{
+				Address:  server.URL,
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer invalid"},
+				Config:   config,
+				Entity:   entity,
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+		})
+	}
+}
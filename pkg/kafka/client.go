@@ -0,0 +1,61 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package kafka
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+)
+
+// Client is a client that allows querying the datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+}
+
+// Request is a request to the datasource.
+type Request struct {
+	// BaseURL is the Base URL of the Kafka HTTP proxy to query.
+	BaseURL string
+
+	// AuthorizationHeader is the value of the Authorization header sent to the Kafka HTTP proxy.
+	AuthorizationHeader string
+
+	// Topic is the topic to consume.
+	Topic string
+
+	// Partitions is the number of partitions of the topic.
+	Partitions int32
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the partition and offset of the first message of the page to return, as returned by
+	// the last request for the entity.
+	// nil in the request for the first page.
+	Cursor *Cursor
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of messages returned by the datasource, decoded from JSON.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first message of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *Cursor
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// Topic is the configuration of the Kafka topic consumed for an entity.
+type Topic struct {
+	// Name is the name of the topic. Defaults to the entity's external ID.
+	Name string `json:"name,omitempty"`
+
+	// Partitions is the number of partitions of the topic. Defaults to 1.
+	Partitions int32 `json:"partitions,omitempty" validate:"omitempty,gte=0"`
+}
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Kafka Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "topics": {
+        "User": {
+            "name": "identity.users",
+            "partitions": 3
+        }
+    }
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// Topics is a map containing the topic consumed for each entity associated with this datasource.
+	// The key is the entity's external ID. Entities missing from this map consume the topic named after
+	// the entity's external ID, with a single partition.
+	Topics map[string]Topic `json:"topics,omitempty"`
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	for entityExternalID, topic := range c.Topics {
+		if topic.Partitions < 0 {
+			return fmt.Errorf("the number of partitions of the topic for entity %s must not be negative", entityExternalID)
+		}
+	}
+
+	return nil
+}
+
+// TopicForEntity returns the topic consumed for the entity, with the defaults applied.
+func (c *Config) TopicForEntity(entityExternalID string) Topic {
+	topic := Topic{Name: entityExternalID, Partitions: 1}
+
+	if c == nil {
+		return topic
+	}
+
+	if configured, found := c.Topics[entityExternalID]; found {
+		if configured.Name != "" {
+			topic.Name = configured.Name
+		}
+
+		if configured.Partitions > 0 {
+			topic.Partitions = configured.Partitions
+		}
+	}
+
+	return topic
+}
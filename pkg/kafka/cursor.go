@@ -0,0 +1,66 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package kafka
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// Cursor contains the position of the next message to consume from a topic.
+// Partitions are consumed in order, from partition 0 to the last partition of the topic.
+type Cursor struct {
+	// Partition is the partition the next message is consumed from.
+	Partition int32 `json:"partition"`
+
+	// Offset is the offset of the next message to consume from the partition.
+	Offset int64 `json:"offset"`
+}
+
+// UnmarshalCursor unmarshals the cursor from a base64 encoded JSON string.
+// Returns nil cursor if the input is empty.
+func UnmarshalCursor(cursor string) (*Cursor, *framework.Error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	cursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to decode base64 cursor: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	unmarshaledCursor := &Cursor{}
+
+	if unmarshalErr := json.Unmarshal(cursorBytes, unmarshaledCursor); unmarshalErr != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal JSON cursor: %v.", unmarshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	return unmarshaledCursor, nil
+}
+
+// MarshalCursor marshals the cursor into a base64 encoded JSON string.
+func MarshalCursor(cursor *Cursor) (string, *framework.Error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	nextCursorBytes, marshalErr := json.Marshal(cursor)
+	if marshalErr != nil {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal cursor into JSON: %v.", marshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(nextCursorBytes), nil
+}
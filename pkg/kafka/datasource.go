@@ -0,0 +1,201 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"go.uber.org/zap"
+)
+
+const (
+	// JSONRecordsContentType is the media type of the records returned by the Kafka HTTP proxy
+	// for topics containing JSON messages.
+	JSONRecordsContentType = "application/vnd.kafka.json.v2+json"
+
+	// FetchTimeoutMilliseconds is the time the Kafka HTTP proxy waits for records to be available
+	// in a partition before responding.
+	FetchTimeoutMilliseconds = 1000
+
+	// FetchMaxBytes is the maximum number of bytes of records returned by the Kafka HTTP proxy
+	// for a single fetch.
+	FetchMaxBytes = 1 * 1024 * 1024 // 1MiB
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+}
+
+// Record is a record returned by the Kafka HTTP proxy.
+type Record struct {
+	Topic     string          `json:"topic"`
+	Key       json.RawMessage `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	Partition int32           `json:"partition"`
+	Offset    int64           `json:"offset"`
+}
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+// GetPage consumes the messages of the topic from the partition and offset in the cursor.
+// Partitions are consumed in order: once no message is left in a partition, consumption moves on to
+// the next one. The last page is returned once no message is left in the last partition.
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	cursor := Cursor{}
+	if request.Cursor != nil {
+		cursor = *request.Cursor
+	}
+
+	if cursor.Partition < 0 || cursor.Partition >= request.Partitions || cursor.Offset < 0 {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Cursor partition %d and offset %d are invalid for topic %s with %d partitions.",
+				cursor.Partition, cursor.Offset, request.Topic, request.Partitions,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	// Timeout API calls that take longer than the configured timeout.
+	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	for ; cursor.Partition < request.Partitions; cursor = (Cursor{Partition: cursor.Partition + 1}) {
+		response, records, err := d.fetchRecords(apiCtx, logger, request, cursor)
+		if err != nil || response.StatusCode != http.StatusOK {
+			return response, err
+		}
+
+		// No message is left in this partition.
+		if len(records) == 0 {
+			continue
+		}
+
+		if int64(len(records)) > request.PageSize {
+			records = records[:request.PageSize]
+		}
+
+		objects, err := parseRecords(records)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Objects = objects
+		response.NextCursor = &Cursor{
+			Partition: cursor.Partition,
+			Offset:    records[len(records)-1].Offset + 1,
+		}
+
+		logger.Info("Datasource request completed successfully",
+			fields.ResponseStatusCode(response.StatusCode),
+			fields.ResponseObjectCount(len(response.Objects)),
+			fields.ResponseNextCursor(response.NextCursor),
+		)
+
+		return response, nil
+	}
+
+	logger.Info("Datasource request completed successfully, no message left in the topic")
+
+	return &Response{StatusCode: http.StatusOK}, nil
+}
+
+// fetchRecords fetches the records of a partition of the topic, starting at the cursor's offset.
+// A Response is returned without records if the Kafka HTTP proxy responds with an error status.
+func (d *Datasource) fetchRecords(
+	ctx context.Context, logger *zap.Logger, request *Request, cursor Cursor,
+) (*Response, []Record, *framework.Error) {
+	query := url.Values{}
+	query.Set("offset", fmt.Sprint(cursor.Offset))
+	query.Set("timeout", fmt.Sprint(FetchTimeoutMilliseconds))
+	query.Set("max_bytes", fmt.Sprint(FetchMaxBytes))
+
+	requestURL := fmt.Sprintf("%s/topics/%s/partitions/%d/records?%s",
+		request.BaseURL, url.PathEscape(request.Topic), cursor.Partition, query.Encode())
+
+	header := http.Header{"Accept": {JSONRecordsContentType}}
+
+	if request.AuthorizationHeader != "" {
+		header.Set("Authorization", request.AuthorizationHeader)
+	}
+
+	var records []Record
+
+	httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL:                   requestURL,
+		Header:                header,
+		DatasourceName:        "Kafka",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Kafka")
+		if readErr != nil {
+			return readErr
+		}
+
+		return httpds.UnmarshalJSON(bodyBytes, &records)
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	return response, records, nil
+}
+
+// parseRecords decodes the JSON object in the value of each record.
+// Records without a value, e.g. tombstones in compacted topics, are skipped.
+func parseRecords(records []Record) ([]map[string]any, *framework.Error) {
+	objects := make([]map[string]any, 0, len(records))
+
+	for _, record := range records {
+		if len(record.Value) == 0 || bytes.Equal(record.Value, []byte("null")) {
+			continue
+		}
+
+		var object map[string]any
+
+		if err := json.Unmarshal(record.Value, &object); err != nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf(
+					"The message at partition %d and offset %d is not a JSON object: %v.",
+					record.Partition, record.Offset, err,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			}
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package kafka_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/kafka"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: "User",
+		Attributes: []*framework.AttributeConfig{
+			{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+		},
+	}
+
+	tests := map[string]struct {
+		request *framework.Request[kafka.Config]
+		wantErr *framework.Error
+	}{
+		"valid_request_token_auth": {
+			request: &framework.Request[kafka.Config]{
+				Address:  "kafka-proxy.example.com",
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+		},
+		"valid_request_basic_auth": {
+			request: &framework.Request[kafka.Config]{
+				Address: "https://kafka-proxy.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{Username: "sgnl", Password: "password"},
+				},
+				Config: &kafka.Config{
+					Topics: map[string]kafka.Topic{"User": {Name: "identity.users", Partitions: 3}},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+		},
+		"invalid_request_negative_partitions": {
+			request: &framework.Request[kafka.Config]{
+				Address: "kafka-proxy.example.com",
+				Auth:    &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Config: &kafka.Config{
+					Topics: map[string]kafka.Topic{"User": {Partitions: -1}},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Kafka config is invalid: the number of partitions of the topic for entity User must not be negative.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[kafka.Config]{
+				Address:  "http://kafka-proxy.example.com",
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[kafka.Config]{
+				Address:  "kafka-proxy.example.com",
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Kafka auth is missing required credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_password": {
+			request: &framework.Request[kafka.Config]{
+				Address: "kafka-proxy.example.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{Username: "sgnl"},
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "One of username or password required for basic auth is empty.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[kafka.Config]{
+				Address: "kafka-proxy.example.com",
+				Auth:    &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{ExternalId: "name", Type: framework.AttributeTypeString},
+					},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[kafka.Config]{
+				Address:  "kafka-proxy.example.com",
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Entity:   validEntity,
+				PageSize: 100,
+				Ordered:  true,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[kafka.Config]{
+				Address:  "kafka-proxy.example.com",
+				Auth:     &framework.DatasourceAuthCredentials{HTTPAuthorization: "Bearer testtoken"},
+				Entity:   validEntity,
+				PageSize: 1001,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1001) exceeds the maximum allowed (1000).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &kafka.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
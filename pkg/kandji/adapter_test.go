@@ -0,0 +1,234 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package kandji_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/kandji"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := kandji.NewAdapter(&kandji.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[int64]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	bearerAuth := &framework.DatasourceAuthCredentials{
+		HTTPAuthorization: "Bearer testtoken",
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[kandji.Config]
+		wantResponse framework.Response
+	}{
+		"devices_first_page": {
+			request: &framework.Request[kandji.Config]{
+				Address: server.URL,
+				Auth:    bearerAuth,
+				Config:  &kandji.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: kandji.Device,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "device_id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "serial_number",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "os_version",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "agent_installed",
+							Type:       framework.AttributeTypeBool,
+						},
+						{
+							ExternalId: "last_check_in",
+							Type:       framework.AttributeTypeDateTime,
+						},
+						{
+							ExternalId: "first_enrollment",
+							Type:       framework.AttributeTypeDateTime,
+						},
+						{
+							ExternalId: "$.user.email",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"device_id":        "9e9a6a1b-3b6a-4a41-8c4f-6a4a0b4e3f01",
+							"serial_number":    "C02XK1ABJG5H",
+							"os_version":       "15.2",
+							"agent_installed":  true,
+							"last_check_in":    time.Date(2026, 1, 5, 21, 50, 45, 612000000, time.UTC),
+							"first_enrollment": time.Date(2026, 1, 5, 19, 41, 9, 437000000, time.UTC),
+							"$.user.email":     "jane@acme.com",
+						},
+						{
+							"device_id":        "5b0f4a8e-12c3-4d6e-9f7a-8b9c0d1e2f02",
+							"serial_number":    "DMPXK2ABCD12",
+							"os_version":       "18.2",
+							"agent_installed":  false,
+							"last_check_in":    time.Date(2026, 1, 4, 8, 0, 0, 0, time.UTC),
+							"first_enrollment": time.Date(2025, 11, 20, 10, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[int64]{
+						Cursor: testutil.GenPtr[int64](2),
+					}),
+				},
+			},
+		},
+		"blueprints_last_page": {
+			request: &framework.Request[kandji.Config]{
+				Address: server.URL,
+				Auth:    bearerAuth,
+				Config:  &kandji.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: kandji.Blueprint,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "name",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "computers_count",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				Cursor: marshalCursor(&pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				}),
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":              "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a13",
+							"name":            "Sales",
+							"computers_count": int64(0),
+						},
+					},
+				},
+			},
+		},
+		"users": {
+			request: &framework.Request[kandji.Config]{
+				Address: server.URL,
+				Auth:    bearerAuth,
+				Config:  &kandji.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: kandji.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "email",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "is_archived",
+							Type:       framework.AttributeTypeBool,
+						},
+						{
+							ExternalId: "created_at",
+							Type:       framework.AttributeTypeDateTime,
+						},
+						{
+							ExternalId: "$.integration.type",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                 "4cf2cbd8-6d0e-4b4b-a4a4-a4ff1b19c401",
+							"email":              "jane@acme.com",
+							"is_archived":        false,
+							"created_at":         time.Date(2025, 9, 14, 18, 49, 31, 519389000, time.UTC),
+							"$.integration.type": "okta",
+						},
+					},
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[kandji.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer invalid",
+				},
+				Config: &kandji.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: kandji.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
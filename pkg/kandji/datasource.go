@@ -0,0 +1,212 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package kandji
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+}
+
+// ListResponse is the format of the paginated list responses of the Kandji API, e.g. of the blueprints.
+type ListResponse struct {
+	Count    int64            `json:"count"`
+	Next     *string          `json:"next"`
+	Previous *string          `json:"previous"`
+	Results  []map[string]any `json:"results"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// path is the path of the endpoint of the entity, relative to the API.
+	path string
+	// isList indicates whether the objects are returned in a ListResponse. Otherwise, the response is a JSON array
+	// of the objects, without a count or a link to the next page.
+	isList bool
+	// transform converts the objects returned by the endpoint into the objects of the entity, if set.
+	transform func(objects []map[string]any) []map[string]any
+}
+
+const (
+	Device    string = "Device"
+	Blueprint string = "Blueprint"
+	User      string = "User"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// GET /api/v1/devices.
+		Device: {
+			uniqueIDAttrExternalID: "device_id",
+			path:                   "/devices",
+			transform:              removeEmptyUsers,
+		},
+		// GET /api/v1/blueprints.
+		Blueprint: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/blueprints",
+			isList:                 true,
+		},
+		// GET /api/v1/users.
+		User: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/users",
+			isList:                 true,
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	validationErr := pagination.ValidateCompositeCursor(request.Cursor, request.EntityExternalID, false)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var offset int64
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		offset = *request.Cursor.Cursor
+	}
+
+	var (
+		objects          []map[string]any
+		nextOffsetCursor *int64
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpoint,
+		Header: http.Header{
+			"Authorization": {request.Token},
+			"Accept":        {"application/json"},
+		},
+		DatasourceName:        "Kandji",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Kandji")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, nextOffsetCursor, parseErr = ParseResponse(bodyBytes, request.EntityExternalID, offset, request.PageSize)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	if entity := ValidEntityExternalIDs[request.EntityExternalID]; entity.transform != nil {
+		objects = entity.transform(objects)
+	}
+
+	if nextOffsetCursor != nil {
+		response.NextCursor = &pagination.CompositeCursor[int64]{
+			Cursor: nextOffsetCursor,
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the objects of a Kandji API response of the entity, and the offset of the next page,
+// if any, given the offset and the page size of the request. The pages of a ListResponse end when it has no
+// next link. The devices are returned as a JSON array, so their pages end with the first page that isn't full.
+func ParseResponse(body []byte, entityExternalID string, offset, pageSize int64) (
+	objects []map[string]any,
+	nextCursor *int64,
+	err *framework.Error,
+) {
+	var hasNext bool
+
+	if ValidEntityExternalIDs[entityExternalID].isList {
+		var data ListResponse
+
+		if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+			return nil, nil, unmarshalErr
+		}
+
+		objects = data.Results
+		hasNext = data.Next != nil && *data.Next != ""
+	} else {
+		if unmarshalErr := httpds.UnmarshalJSON(body, &objects); unmarshalErr != nil {
+			return nil, nil, unmarshalErr
+		}
+
+		hasNext = int64(len(objects)) >= pageSize
+	}
+
+	// An empty page ends the sync even if a next link is returned, e.g. if objects were deleted during the sync.
+	if !hasNext || len(objects) == 0 {
+		return objects, nil, nil
+	}
+
+	nextOffset := offset + int64(len(objects))
+
+	return objects, &nextOffset, nil
+}
+
+// removeEmptyUsers removes the user of the devices that aren't assigned to a user, which Kandji returns as an
+// empty string instead of an object, e.g. "user": "", so that the JSONPath attributes of the user, e.g.
+// $.user.email, are left unset.
+func removeEmptyUsers(devices []map[string]any) []map[string]any {
+	for _, device := range devices {
+		if _, isObject := device["user"].(map[string]any); !isObject {
+			delete(device, "user")
+		}
+	}
+
+	return devices
+}
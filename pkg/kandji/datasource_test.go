@@ -0,0 +1,278 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package kandji_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/kandji"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock Kandji API server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer testtoken" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"detail": "Invalid token."}`))
+
+		return
+	}
+
+	switch r.URL.RequestURI() {
+	// Devices Page 1
+	case "/api/v1/devices?limit=2&offset=0":
+		w.Write([]byte(`[
+			{"device_id": "9e9a6a1b-3b6a-4a41-8c4f-6a4a0b4e3f01", "device_name": "Jane's MacBook Pro", "model": "MacBook Pro (14-inch, 2023)", "serial_number": "C02XK1ABJG5H", "platform": "Mac", "os_version": "15.2", "last_check_in": "2026-01-05T21:50:45.612Z", "first_enrollment": "2026-01-05 19:41:09.437000+00:00", "blueprint_id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a11", "blueprint_name": "Engineering", "mdm_enabled": true, "agent_installed": true, "is_missing": false, "is_removed": false, "user": {"email": "jane@acme.com", "name": "Jane Doe", "id": "4cf2cbd8-6d0e-4b4b-a4a4-a4ff1b19c401", "is_archived": false}},
+			{"device_id": "5b0f4a8e-12c3-4d6e-9f7a-8b9c0d1e2f02", "device_name": "Lobby iPad", "model": "iPad (10th generation)", "serial_number": "DMPXK2ABCD12", "platform": "iPad", "os_version": "18.2", "last_check_in": "2026-01-04T08:00:00.000Z", "first_enrollment": "2025-11-20 10:00:00.000000+00:00", "blueprint_id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a12", "blueprint_name": "Shared Devices", "mdm_enabled": true, "agent_installed": false, "is_missing": false, "is_removed": false, "user": ""}
+		]`))
+
+	// Devices Page 2
+	case "/api/v1/devices?limit=2&offset=2":
+		w.Write([]byte(`[
+			{"device_id": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c03", "device_name": "John's MacBook Air", "model": "MacBook Air (M2, 2022)", "serial_number": "C02YL3EFGH67", "platform": "Mac", "os_version": "14.7", "last_check_in": "2025-12-30T12:00:00.000Z", "first_enrollment": "2025-06-01 09:30:00.000000+00:00", "blueprint_id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a11", "blueprint_name": "Engineering", "mdm_enabled": true, "agent_installed": true, "is_missing": true, "is_removed": false, "user": {"email": "john@acme.com", "name": "John Doe", "id": "4cf2cbd8-6d0e-4b4b-a4a4-a4ff1b19c402", "is_archived": false}}
+		]`))
+
+	// Blueprints
+	case "/api/v1/blueprints?limit=2&offset=0":
+		w.Write([]byte(`{"count": 3, "next": "https://acme.api.kandji.io/api/v1/blueprints?limit=2&offset=2", "previous": null, "results": [
+			{"id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a11", "name": "Engineering", "description": "Macs of the engineers", "type": "classic", "computers_count": 2, "enrollment_code": {"code": "123456", "is_active": true}},
+			{"id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a12", "name": "Shared Devices", "description": "", "type": "classic", "computers_count": 1, "enrollment_code": {"code": "654321", "is_active": false}}
+		]}`))
+
+	case "/api/v1/blueprints?limit=2&offset=2":
+		w.Write([]byte(`{"count": 3, "next": null, "previous": "https://acme.api.kandji.io/api/v1/blueprints?limit=2", "results": [
+			{"id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a13", "name": "Sales", "description": "", "type": "map", "computers_count": 0, "enrollment_code": {"code": "112233", "is_active": true}}
+		]}`))
+
+	// Users
+	case "/api/v1/users?limit=2&offset=0":
+		w.Write([]byte(`{"count": 1, "next": null, "previous": null, "results": [
+			{"id": "4cf2cbd8-6d0e-4b4b-a4a4-a4ff1b19c401", "email": "jane@acme.com", "name": "Jane Doe", "is_archived": false, "department": "Engineering", "job_title": "Engineer", "device_count": 1, "created_at": "2025-09-14T18:49:31.519389Z", "integration": {"id": 7, "name": "Okta", "type": "okta"}}
+		]}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body             []byte
+		entityExternalID string
+		offset           int64
+		pageSize         int64
+		wantObjects      []map[string]any
+		wantNextCursor   *int64
+		wantErr          *framework.Error
+	}{
+		"devices_full_page": {
+			body:             []byte(`[{"device_id": "1"}, {"device_id": "2"}]`),
+			entityExternalID: kandji.Device,
+			offset:           4,
+			pageSize:         2,
+			wantObjects:      []map[string]any{{"device_id": "1"}, {"device_id": "2"}},
+			wantNextCursor:   testutil.GenPtr[int64](6),
+		},
+		"devices_last_page": {
+			body:             []byte(`[{"device_id": "1"}]`),
+			entityExternalID: kandji.Device,
+			offset:           4,
+			pageSize:         2,
+			wantObjects:      []map[string]any{{"device_id": "1"}},
+		},
+		"devices_no_objects": {
+			body:             []byte(`[]`),
+			entityExternalID: kandji.Device,
+			pageSize:         2,
+			wantObjects:      []map[string]any{},
+		},
+		"users_next_page": {
+			body:             []byte(`{"count": 5, "next": "https://acme.api.kandji.io/api/v1/users?limit=2&offset=4", "previous": null, "results": [{"id": "1"}, {"id": "2"}]}`),
+			entityExternalID: kandji.User,
+			offset:           2,
+			pageSize:         2,
+			wantObjects:      []map[string]any{{"id": "1"}, {"id": "2"}},
+			wantNextCursor:   testutil.GenPtr[int64](4),
+		},
+		"users_last_page": {
+			body:             []byte(`{"count": 2, "next": null, "previous": null, "results": [{"id": "1"}, {"id": "2"}]}`),
+			entityExternalID: kandji.User,
+			pageSize:         2,
+			wantObjects:      []map[string]any{{"id": "1"}, {"id": "2"}},
+		},
+		"users_no_objects_with_next_link": {
+			body:             []byte(`{"count": 5, "next": "https://acme.api.kandji.io/api/v1/users?limit=2&offset=6", "previous": null, "results": []}`),
+			entityExternalID: kandji.User,
+			offset:           4,
+			pageSize:         2,
+			wantObjects:      []map[string]any{},
+		},
+		"invalid_devices_response": {
+			body:             []byte(`{"detail": "Not found."}`),
+			entityExternalID: kandji.Device,
+			pageSize:         2,
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal object into Go value of type []map[string]interface {}.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"invalid_users_response": {
+			body:             []byte(`[]`),
+			entityExternalID: kandji.User,
+			pageSize:         2,
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type kandji.ListResponse.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextCursor, gotErr := kandji.ParseResponse(tt.body, tt.entityExternalID, tt.offset, tt.pageSize)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextCursor, tt.wantNextCursor) {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", gotNextCursor, tt.wantNextCursor)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := kandji.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *kandji.Request
+		wantResponse *kandji.Response
+		wantErr      *framework.Error
+	}{
+		"devices_first_page": {
+			request: &kandji.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer testtoken",
+				PageSize:         2,
+				EntityExternalID: kandji.Device,
+			},
+			wantResponse: &kandji.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"device_id": "9e9a6a1b-3b6a-4a41-8c4f-6a4a0b4e3f01", "device_name": "Jane's MacBook Pro", "model": "MacBook Pro (14-inch, 2023)", "serial_number": "C02XK1ABJG5H", "platform": "Mac", "os_version": "15.2", "last_check_in": "2026-01-05T21:50:45.612Z", "first_enrollment": "2026-01-05 19:41:09.437000+00:00", "blueprint_id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a11", "blueprint_name": "Engineering", "mdm_enabled": true, "agent_installed": true, "is_missing": false, "is_removed": false, "user": map[string]any{"email": "jane@acme.com", "name": "Jane Doe", "id": "4cf2cbd8-6d0e-4b4b-a4a4-a4ff1b19c401", "is_archived": false}},
+					{"device_id": "5b0f4a8e-12c3-4d6e-9f7a-8b9c0d1e2f02", "device_name": "Lobby iPad", "model": "iPad (10th generation)", "serial_number": "DMPXK2ABCD12", "platform": "iPad", "os_version": "18.2", "last_check_in": "2026-01-04T08:00:00.000Z", "first_enrollment": "2025-11-20 10:00:00.000000+00:00", "blueprint_id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a12", "blueprint_name": "Shared Devices", "mdm_enabled": true, "agent_installed": false, "is_missing": false, "is_removed": false},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+		},
+		"devices_last_page": {
+			request: &kandji.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer testtoken",
+				PageSize:         2,
+				EntityExternalID: kandji.Device,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+			wantResponse: &kandji.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"device_id": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c03", "device_name": "John's MacBook Air", "model": "MacBook Air (M2, 2022)", "serial_number": "C02YL3EFGH67", "platform": "Mac", "os_version": "14.7", "last_check_in": "2025-12-30T12:00:00.000Z", "first_enrollment": "2025-06-01 09:30:00.000000+00:00", "blueprint_id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a11", "blueprint_name": "Engineering", "mdm_enabled": true, "agent_installed": true, "is_missing": true, "is_removed": false, "user": map[string]any{"email": "john@acme.com", "name": "John Doe", "id": "4cf2cbd8-6d0e-4b4b-a4a4-a4ff1b19c402", "is_archived": false}},
+				},
+			},
+		},
+		"blueprints_first_page": {
+			request: &kandji.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer testtoken",
+				PageSize:         2,
+				EntityExternalID: kandji.Blueprint,
+			},
+			wantResponse: &kandji.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a11", "name": "Engineering", "description": "Macs of the engineers", "type": "classic", "computers_count": float64(2), "enrollment_code": map[string]any{"code": "123456", "is_active": true}},
+					{"id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a12", "name": "Shared Devices", "description": "", "type": "classic", "computers_count": float64(1), "enrollment_code": map[string]any{"code": "654321", "is_active": false}},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+		},
+		"blueprints_last_page": {
+			request: &kandji.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer testtoken",
+				PageSize:         2,
+				EntityExternalID: kandji.Blueprint,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](2),
+				},
+			},
+			wantResponse: &kandji.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "6391a5b1-4a38-4ef9-bb0b-4f2d5e3b6a13", "name": "Sales", "description": "", "type": "map", "computers_count": float64(0), "enrollment_code": map[string]any{"code": "112233", "is_active": true}},
+				},
+			},
+		},
+		"invalid_cursor": {
+			request: &kandji.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer testtoken",
+				PageSize:         2,
+				EntityExternalID: kandji.User,
+				Cursor: &pagination.CompositeCursor[int64]{
+					CollectionID: testutil.GenPtr("1"),
+				},
+			},
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity User: cursor must not contain CollectionID or CollectionCursor fields. Expected cursor shape: {"cursor":<int64>}. Restart the sync for this entity to discard the invalid cursor.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"unauthorized": {
+			request: &kandji.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer invalid",
+				PageSize:         2,
+				EntityExternalID: kandji.User,
+			},
+			wantResponse: &kandji.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
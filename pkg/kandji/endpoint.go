@@ -0,0 +1,42 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package kandji
+
+import (
+	"net/url"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// For example, the endpoint of the second page of 100 devices is:
+// https://acme.api.kandji.io/api/v1/devices?limit=100&offset=100.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	var offset int64
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		offset = *request.Cursor.Cursor
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.FormatInt(request.PageSize, 10))
+	params.Set("offset", strconv.FormatInt(offset, 10))
+
+	return request.BaseURL + "/api/v1" + entity.path + "?" + params.Encode(), nil
+}
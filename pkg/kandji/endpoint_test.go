@@ -0,0 +1,91 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package kandji_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/kandji"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *kandji.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &kandji.Request{
+				BaseURL:          "https://acme.api.kandji.io",
+				PageSize:         100,
+				EntityExternalID: "App",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"devices": {
+			request: &kandji.Request{
+				BaseURL:          "https://acme.api.kandji.io",
+				PageSize:         300,
+				EntityExternalID: kandji.Device,
+			},
+			wantEndpoint: "https://acme.api.kandji.io/api/v1/devices?limit=300&offset=0",
+		},
+		"devices_next_page": {
+			request: &kandji.Request{
+				BaseURL:          "https://acme.api.kandji.io",
+				PageSize:         100,
+				EntityExternalID: kandji.Device,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](200),
+				},
+			},
+			wantEndpoint: "https://acme.api.kandji.io/api/v1/devices?limit=100&offset=200",
+		},
+		"blueprints": {
+			request: &kandji.Request{
+				BaseURL:          "https://acme.api.eu.kandji.io",
+				PageSize:         100,
+				EntityExternalID: kandji.Blueprint,
+			},
+			wantEndpoint: "https://acme.api.eu.kandji.io/api/v1/blueprints?limit=100&offset=0",
+		},
+		"users": {
+			request: &kandji.Request{
+				BaseURL:          "https://acme.api.kandji.io",
+				PageSize:         100,
+				EntityExternalID: kandji.User,
+			},
+			wantEndpoint: "https://acme.api.kandji.io/api/v1/users?limit=100&offset=0",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := kandji.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
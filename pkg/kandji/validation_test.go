@@ -0,0 +1,196 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package kandji_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/kandji"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: kandji.Device,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "device_id",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "serial_number",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	bearerAuth := &framework.DatasourceAuthCredentials{
+		HTTPAuthorization: "Bearer testtoken",
+	}
+
+	validConfig := &kandji.Config{}
+
+	tests := map[string]struct {
+		request     *framework.Request[kandji.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request": {
+			request: &framework.Request[kandji.Config]{
+				Address:  "acme.api.kandji.io",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 300,
+			},
+			wantAddress: "https://acme.api.kandji.io",
+		},
+		"invalid_request_nil_config": {
+			request: &framework.Request[kandji.Config]{
+				Address:  "https://acme.api.kandji.io",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Kandji config is invalid: request contains no config.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_incremental_sync_without_since": {
+			request: &framework.Request[kandji.Config]{
+				Address: "https://acme.api.kandji.io",
+				Auth:    bearerAuth,
+				Entity:  validEntity,
+				Config: &kandji.Config{
+					CommonConfig: &config.CommonConfig{SyncMode: config.SyncModeIncremental},
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Kandji config is invalid: incrementalSyncSince is required when syncMode is INCREMENTAL.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[kandji.Config]{
+				Address:  "http://acme.api.kandji.io",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[kandji.Config]{
+				Address:  "https://acme.api.kandji.io",
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided datasource auth is missing required http authorization credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[kandji.Config]{
+				Address: "https://acme.api.kandji.io",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "testtoken",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[kandji.Config]{
+				Address: "https://acme.api.kandji.io",
+				Auth:    bearerAuth,
+				Entity: framework.EntityConfig{
+					ExternalId: "App",
+					Attributes: validEntity.Attributes,
+				},
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[kandji.Config]{
+				Address: "https://acme.api.kandji.io",
+				Auth:    bearerAuth,
+				Entity: framework.EntityConfig{
+					ExternalId: kandji.Device,
+					Attributes: validEntity.Attributes[1:],
+				},
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[kandji.Config]{
+				Address:  "https://acme.api.kandji.io",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   validConfig,
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[kandji.Config]{
+				Address:  "https://acme.api.kandji.io",
+				Auth:     bearerAuth,
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 301,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (301) exceeds the maximum allowed (300).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &kandji.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
@@ -145,6 +145,7 @@ func (c *ldapClient) ProxyRequest(
 			customerror.WithRequestTimeoutMessage(
 				err, request.RequestTimeoutSeconds,
 			),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -312,6 +313,7 @@ func (c *ldapClient) Request(ctx context.Context, request *Request) (*Response,
 			customerror.WithRequestTimeoutMessage(
 				err, request.RequestTimeoutSeconds,
 			),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
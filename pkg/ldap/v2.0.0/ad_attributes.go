@@ -0,0 +1,196 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package ldap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	framework "github.com/sgnl-ai/adapter-framework"
+)
+
+const (
+	// Syntax: Interval
+	// A 64-bit integer holding a Windows FILETIME, i.e. the number of 100-nanosecond intervals since
+	// January 1, 1601 (UTC). The values 0 and 0x7FFFFFFFFFFFFFFF mean that the time is not set.
+	//
+	// See: https://learn.microsoft.com/en-us/windows/win32/adschema/s-interval
+	lastLogonTimestamp = "lastLogonTimestamp"
+	lastLogon          = "lastLogon"
+	pwdLastSet         = "pwdLastSet"
+	accountExpires     = "accountExpires"
+	badPasswordTime    = "badPasswordTime"
+	lockoutTime        = "lockoutTime"
+
+	// This attribute contains flags that control the behavior of the user account.
+	//
+	// See: https://learn.microsoft.com/en-us/troubleshoot/windows-server/active-directory/useraccountcontrol-manipulate-account-properties
+	userAccountControl = "userAccountControl"
+
+	// UserAccountControlFlagPrefix is the prefix of the attributes holding the value of a single
+	// userAccountControl flag as a boolean, e.g. "userAccountControl.ACCOUNTDISABLE".
+	UserAccountControlFlagPrefix = userAccountControl + "."
+
+	// fileTimeEpochOffset is the number of 100-nanosecond intervals between the FILETIME epoch
+	// (January 1, 1601) and the Unix epoch (January 1, 1970).
+	fileTimeEpochOffset = 116444736000000000
+
+	// fileTimeNever is the largest FILETIME value, used by AD for times that never occur.
+	fileTimeNever = 0x7FFFFFFFFFFFFFFF
+)
+
+var fileTimeAttributes = map[string]struct{}{
+	lastLogonTimestamp: {},
+	lastLogon:          {},
+	pwdLastSet:         {},
+	accountExpires:     {},
+	badPasswordTime:    {},
+	lockoutTime:        {},
+}
+
+// UserAccountControlFlags maps the names of the userAccountControl flags to their values.
+var UserAccountControlFlags = map[string]int64{
+	"SCRIPT":                         0x0001,
+	"ACCOUNTDISABLE":                 0x0002,
+	"HOMEDIR_REQUIRED":               0x0008,
+	"LOCKOUT":                        0x0010,
+	"PASSWD_NOTREQD":                 0x0020,
+	"PASSWD_CANT_CHANGE":             0x0040,
+	"ENCRYPTED_TEXT_PWD_ALLOWED":     0x0080,
+	"TEMP_DUPLICATE_ACCOUNT":         0x0100,
+	"NORMAL_ACCOUNT":                 0x0200,
+	"INTERDOMAIN_TRUST_ACCOUNT":      0x0800,
+	"WORKSTATION_TRUST_ACCOUNT":      0x1000,
+	"SERVER_TRUST_ACCOUNT":           0x2000,
+	"DONT_EXPIRE_PASSWORD":           0x10000,
+	"MNS_LOGON_ACCOUNT":              0x20000,
+	"SMARTCARD_REQUIRED":             0x40000,
+	"TRUSTED_FOR_DELEGATION":         0x80000,
+	"NOT_DELEGATED":                  0x100000,
+	"USE_DES_KEY_ONLY":               0x200000,
+	"DONT_REQ_PREAUTH":               0x400000,
+	"PASSWORD_EXPIRED":               0x800000,
+	"TRUSTED_TO_AUTH_FOR_DELEGATION": 0x1000000,
+	"PARTIAL_SECRETS_ACCOUNT":        0x4000000,
+}
+
+// isFileTimeAttribute returns true if the attribute holds a FILETIME.
+func isFileTimeAttribute(name string) bool {
+	_, ok := fileTimeAttributes[name]
+
+	return ok
+}
+
+// FileTimeToRFC3339 converts a FILETIME value to an RFC3339 timestamp in UTC.
+// Returns false if the value means that the time is not set.
+func FileTimeToRFC3339(value string) (string, bool, error) {
+	fileTime, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return "", false, err
+	}
+
+	if fileTime <= 0 || fileTime == fileTimeNever {
+		return "", false, nil
+	}
+
+	intervals := fileTime - fileTimeEpochOffset
+
+	t := time.Unix(intervals/1e7, (intervals%1e7)*100).UTC()
+
+	return t.Format(time.RFC3339), true, nil
+}
+
+// userAccountControlFlag returns the value of the userAccountControl flag held by the attribute, if any.
+func userAccountControlFlag(attributeID string) (int64, bool) {
+	name, ok := strings.CutPrefix(attributeID, UserAccountControlFlagPrefix)
+	if !ok {
+		return 0, false
+	}
+
+	flag, ok := UserAccountControlFlags[name]
+
+	return flag, ok
+}
+
+// validateUserAccountControlFlagAttributes validates that the attributes holding userAccountControl flags
+// refer to known flags and are booleans.
+func validateUserAccountControlFlagAttributes(attributes []*framework.AttributeConfig) error {
+	for _, attribute := range attributes {
+		if !strings.HasPrefix(attribute.ExternalId, UserAccountControlFlagPrefix) {
+			continue
+		}
+
+		if _, ok := userAccountControlFlag(attribute.ExternalId); !ok {
+			return fmt.Errorf("attribute %s refers to an unknown userAccountControl flag", attribute.ExternalId)
+		}
+
+		if attribute.Type != framework.AttributeTypeBool || attribute.List {
+			return fmt.Errorf("attribute %s must be a single-valued boolean", attribute.ExternalId)
+		}
+	}
+
+	return nil
+}
+
+// searchAttributes returns the LDAP attributes to request for the configured attributes. The attributes
+// holding userAccountControl flags are all read from the userAccountControl attribute.
+func searchAttributes(attributes []*framework.AttributeConfig) []string {
+	searchAttributes := make([]string, 0, len(attributes))
+	requestsUserAccountControl := false
+
+	for _, attr := range attributes {
+		if attr.ExternalId == userAccountControl || strings.HasPrefix(attr.ExternalId, UserAccountControlFlagPrefix) {
+			if requestsUserAccountControl {
+				continue
+			}
+
+			requestsUserAccountControl = true
+
+			searchAttributes = append(searchAttributes, userAccountControl)
+
+			continue
+		}
+
+		searchAttributes = append(searchAttributes, attr.ExternalId)
+	}
+
+	return searchAttributes
+}
+
+// canonicalObjectGUID converts an objectGUID formatted from its bytes in storage order into the
+// canonical GUID string format, in which the first three fields are little-endian.
+func canonicalObjectGUID(value string) (string, error) {
+	guid, err := uuid.Parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	guid[0], guid[1], guid[2], guid[3] = guid[3], guid[2], guid[1], guid[0]
+	guid[4], guid[5] = guid[5], guid[4]
+	guid[6], guid[7] = guid[7], guid[6]
+
+	return guid.String(), nil
+}
+
+// canonicalizeObjectGUIDs converts the objectGUID of each object into the canonical GUID string format.
+func canonicalizeObjectGUIDs(objects []map[string]any) error {
+	for _, object := range objects {
+		value, ok := object[objectGUID].(string)
+		if !ok {
+			continue
+		}
+
+		guid, err := canonicalObjectGUID(value)
+		if err != nil {
+			return errors.New("failed to convert objectGUID to the canonical GUID format")
+		}
+
+		object[objectGUID] = guid
+	}
+
+	return nil
+}
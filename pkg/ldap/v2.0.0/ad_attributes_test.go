@@ -0,0 +1,237 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll
+package ldap_test
+
+import (
+	"reflect"
+	"testing"
+
+	ldap_v3 "github.com/go-ldap/ldap/v3"
+	framework "github.com/sgnl-ai/adapter-framework"
+	ldap "github.com/sgnl-ai/adapters/pkg/ldap/v2.0.0"
+)
+
+func TestFileTimeToRFC3339(t *testing.T) {
+	tests := map[string]struct {
+		value     string
+		wantValue string
+		wantIsSet bool
+		wantErr   bool
+	}{
+		"timestamp": {
+			value:     "132514560000000000",
+			wantValue: "2020-12-03T08:00:00Z",
+			wantIsSet: true,
+		},
+		"timestamp_with_sub_second_intervals": {
+			value:     "132514560001234567",
+			wantValue: "2020-12-03T08:00:00Z",
+			wantIsSet: true,
+		},
+		"not_set": {
+			value:     "0",
+			wantIsSet: false,
+		},
+		"never": {
+			value:     "9223372036854775807",
+			wantIsSet: false,
+		},
+		"invalid": {
+			value:   "yesterday",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotValue, gotIsSet, gotErr := ldap.FileTimeToRFC3339(tt.value)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if gotValue != tt.wantValue || gotIsSet != tt.wantIsSet {
+				t.Errorf("got: (%v, %v), want: (%v, %v)", gotValue, gotIsSet, tt.wantValue, tt.wantIsSet)
+			}
+		})
+	}
+}
+
+func TestEntryToObjectADAttributes(t *testing.T) {
+	// S-1-5-21-1-2-3-500
+	sid := []byte{
+		0x01, 0x05, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05,
+		0x15, 0x00, 0x00, 0x00,
+		0x01, 0x00, 0x00, 0x00,
+		0x02, 0x00, 0x00, 0x00,
+		0x03, 0x00, 0x00, 0x00,
+		0xf4, 0x01, 0x00, 0x00,
+	}
+
+	tests := map[string]struct {
+		entry      *ldap_v3.Entry
+		attrConfig map[string]*framework.AttributeConfig
+		wantObject map[string]any
+		wantErr    bool
+	}{
+		"filetime_attributes": {
+			entry: &ldap_v3.Entry{
+				DN: "CN=user1,DC=example,DC=com",
+				Attributes: []*ldap_v3.EntryAttribute{
+					{Name: "pwdLastSet", Values: []string{"132514560000000000"}},
+					{Name: "accountExpires", Values: []string{"9223372036854775807"}},
+					{Name: "lastLogonTimestamp", Values: []string{"0"}},
+				},
+			},
+			attrConfig: map[string]*framework.AttributeConfig{
+				"pwdLastSet":         {Type: framework.AttributeTypeDateTime},
+				"accountExpires":     {Type: framework.AttributeTypeDateTime},
+				"lastLogonTimestamp": {Type: framework.AttributeTypeDateTime},
+			},
+			wantObject: map[string]any{
+				"dn":         "CN=user1,DC=example,DC=com",
+				"pwdLastSet": "2020-12-03T08:00:00Z",
+			},
+		},
+		"filetime_attribute_requested_as_string_is_not_converted": {
+			entry: &ldap_v3.Entry{
+				DN: "CN=user1,DC=example,DC=com",
+				Attributes: []*ldap_v3.EntryAttribute{
+					{Name: "pwdLastSet", Values: []string{"132514560000000000"}},
+				},
+			},
+			attrConfig: map[string]*framework.AttributeConfig{
+				"pwdLastSet": {Type: framework.AttributeTypeString},
+			},
+			wantObject: map[string]any{
+				"dn":         "CN=user1,DC=example,DC=com",
+				"pwdLastSet": "132514560000000000",
+			},
+		},
+		"invalid_filetime_attribute": {
+			entry: &ldap_v3.Entry{
+				DN: "CN=user1,DC=example,DC=com",
+				Attributes: []*ldap_v3.EntryAttribute{
+					{Name: "pwdLastSet", Values: []string{"yesterday"}},
+				},
+			},
+			attrConfig: map[string]*framework.AttributeConfig{
+				"pwdLastSet": {Type: framework.AttributeTypeDateTime},
+			},
+			wantErr: true,
+		},
+		"user_account_control_flags": {
+			entry: &ldap_v3.Entry{
+				DN: "CN=user1,DC=example,DC=com",
+				Attributes: []*ldap_v3.EntryAttribute{
+					{Name: "userAccountControl", Values: []string{"514"}},
+				},
+			},
+			attrConfig: map[string]*framework.AttributeConfig{
+				"userAccountControl":                      {Type: framework.AttributeTypeInt64},
+				"userAccountControl.ACCOUNTDISABLE":       {Type: framework.AttributeTypeBool},
+				"userAccountControl.NORMAL_ACCOUNT":       {Type: framework.AttributeTypeBool},
+				"userAccountControl.DONT_EXPIRE_PASSWORD": {Type: framework.AttributeTypeBool},
+			},
+			wantObject: map[string]any{
+				"dn":                                      "CN=user1,DC=example,DC=com",
+				"userAccountControl":                      float64(514),
+				"userAccountControl.ACCOUNTDISABLE":       true,
+				"userAccountControl.NORMAL_ACCOUNT":       true,
+				"userAccountControl.DONT_EXPIRE_PASSWORD": false,
+			},
+		},
+		"user_account_control_flags_without_user_account_control_attribute": {
+			entry: &ldap_v3.Entry{
+				DN: "CN=user1,DC=example,DC=com",
+				Attributes: []*ldap_v3.EntryAttribute{
+					{Name: "userAccountControl", Values: []string{"66048"}},
+				},
+			},
+			attrConfig: map[string]*framework.AttributeConfig{
+				"userAccountControl.DONT_EXPIRE_PASSWORD": {Type: framework.AttributeTypeBool},
+			},
+			wantObject: map[string]any{
+				"dn": "CN=user1,DC=example,DC=com",
+				"userAccountControl.DONT_EXPIRE_PASSWORD": true,
+			},
+		},
+		"sid_history_list": {
+			entry: &ldap_v3.Entry{
+				DN: "CN=user1,DC=example,DC=com",
+				Attributes: []*ldap_v3.EntryAttribute{
+					{Name: "SIDHistory", Values: []string{string(sid)}, ByteValues: [][]byte{sid}},
+				},
+			},
+			attrConfig: map[string]*framework.AttributeConfig{
+				"SIDHistory": {Type: framework.AttributeTypeString, List: true},
+			},
+			wantObject: map[string]any{
+				"dn":         "CN=user1,DC=example,DC=com",
+				"SIDHistory": []any{"S-1-5-21-1-2-3-500"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObject, gotErr := ldap.EntryToObject(tt.entry, tt.attrConfig)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(gotObject, tt.wantObject) {
+				t.Errorf("gotObject: %v, wantObject: %v", gotObject, tt.wantObject)
+			}
+		})
+	}
+}
+
+func TestProcessLDAPSearchResultCanonicalObjectGUID(t *testing.T) {
+	guid := []byte{0x04, 0x03, 0x02, 0x01, 0x06, 0x05, 0x08, 0x07, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+
+	tests := map[string]struct {
+		canonicalObjectGUID bool
+		wantGUID            string
+	}{
+		"storage_order": {
+			canonicalObjectGUID: false,
+			wantGUID:            "04030201-0605-0807-090a-0b0c0d0e0f10",
+		},
+		"canonical": {
+			canonicalObjectGUID: true,
+			wantGUID:            "01020304-0506-0708-090a-0b0c0d0e0f10",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := &ldap_v3.SearchResult{
+				Entries: []*ldap_v3.Entry{
+					{
+						DN: "CN=user1,DC=example,DC=com",
+						Attributes: []*ldap_v3.EntryAttribute{
+							{Name: "objectGUID", Values: []string{string(guid)}, ByteValues: [][]byte{guid}},
+						},
+					},
+				},
+			}
+
+			gotResponse, gotErr := ldap.ProcessLDAPSearchResult(result, &ldap.Request{
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "objectGUID", Type: framework.AttributeTypeString, UniqueId: true},
+				},
+				CanonicalObjectGUID: tt.canonicalObjectGUID,
+			})
+			if gotErr != nil {
+				t.Fatalf("unexpected error: %v", gotErr)
+			}
+
+			if got := gotResponse.Objects[0]["objectGUID"]; got != tt.wantGUID {
+				t.Errorf("got: %v, want: %v", got, tt.wantGUID)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -58,7 +59,7 @@ func (a *Adapter) requestPageFromDatasource(
 	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
 
 	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[string](request.Cursor)
+	cursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
@@ -73,7 +74,7 @@ func (a *Adapter) requestPageFromDatasource(
 
 	adReq := &Request{
 		BaseURL:          request.Address,
-		PageSize:         request.PageSize,
+		PageSize:         commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID: request.Entity.ExternalId,
 		Attributes:       request.Entity.Attributes,
 		ConnectionParams: ConnectionParams{
@@ -88,6 +89,8 @@ func (a *Adapter) requestPageFromDatasource(
 		Cursor:                cursor,
 		EntityConfigMap:       request.Config.EntityConfigMap,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
+		CanonicalObjectGUID:   request.Config.CanonicalObjectGUID,
+		VirtualListView:       request.Config.VirtualListView,
 	}
 
 	resp, err := a.ADClient.GetPage(ctx, adReq)
@@ -101,6 +104,8 @@ func (a *Adapter) requestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The ldap.SearchResults object from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
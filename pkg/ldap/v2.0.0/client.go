@@ -69,6 +69,13 @@ type Request struct {
 	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
 	// This should be set to the number of seconds to wait before timing out.
 	RequestTimeoutSeconds int `json:"requestTimeoutSeconds"`
+
+	// CanonicalObjectGUID enables returning objectGUID values in the canonical GUID string format.
+	CanonicalObjectGUID bool `json:"canonicalObjectGUID,omitempty"`
+
+	// VirtualListView enables paging with the server-side sort and Virtual List View controls,
+	// if supported by the server.
+	VirtualListView bool `json:"virtualListView,omitempty"`
 }
 
 // Response is a response returned by the datasource.
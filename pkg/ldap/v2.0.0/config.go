@@ -21,6 +21,7 @@ const (
 {
     "baseDN": "dc=org,dc=example,dc=io",
     "certificateChain": "....",
+    "canonicalObjectGUID": true,
     "entityConfig": {
         "User": {
             "query": "(&(objectCategory=user)(objectClass=user)(distinguishedName=*))",
@@ -50,6 +51,11 @@ type EntityConfig struct {
 	MemberOf                  *string `json:"memberOf,omitempty"`
 	MemberAttribute           *string `json:"memberAttribute,omitempty"`
 	MemberOfGroupBatchSize    int64   `json:"memberOfGroupBatchSize,omitempty"`
+
+	// SortAttribute is the attribute used to sort the entries when paging with the Virtual List View
+	// control. Defaults to the unique ID attribute of the entity. The attribute must have an ordering
+	// matching rule, e.g. objectGUID can't be sorted on in Active Directory.
+	SortAttribute *string `json:"sortAttribute,omitempty"`
 }
 
 type Config struct {
@@ -64,6 +70,17 @@ type Config struct {
 	// EntityConfigMap is an map containing the config required for each entity associated with this
 	// datasource. The key is the entity's external_name and value is EntityConfig.
 	EntityConfigMap map[string]*EntityConfig `json:"entityConfig"`
+
+	// CanonicalObjectGUID enables returning objectGUID values in the canonical GUID string format,
+	// e.g. as displayed by Active Directory tools. By default, objectGUID values are formatted from
+	// their bytes in storage order, which differs in the byte order of the first three fields.
+	CanonicalObjectGUID bool `json:"canonicalObjectGUID,omitempty"`
+
+	// VirtualListView enables paging with the server-side sort and Virtual List View controls instead
+	// of the simple paged results control, for directories advertising both controls. Pages are then
+	// addressed by their offset in the sorted result set, which remains stable across connections.
+	// Paging falls back to the simple paged results control for other directories.
+	VirtualListView bool `json:"virtualListView,omitempty"`
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
@@ -147,6 +147,7 @@ func (c *ldapClient) ProxyRequest(
 			customerror.WithRequestTimeoutMessage(
 				err, request.RequestTimeoutSeconds,
 			),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -260,10 +261,42 @@ func (c *ldapClient) Request(ctx context.Context, request *Request) (*Response,
 		}
 	}
 
-	// Set cursor from the request (if exists)
-	pageControl, pageErr := setPageControl(request)
-	if pageErr != nil {
-		return nil, pageErr
+	// Page with the server-side sort and VLV controls if enabled and supported by the server,
+	// with the simple paged results control otherwise.
+	useVLV := false
+
+	if request.VirtualListView {
+		supportedControls, err := session.SupportedControls(conn)
+		if err != nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Failed to read the controls supported by the LDAP server: %v.", err),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			}
+		}
+
+		useVLV = supportsVirtualListView(supportedControls)
+		if !useVLV {
+			logger.Warn("LDAP server does not support the Virtual List View control, using the paged results control")
+		}
+	}
+
+	var controls []ldap_v3.Control
+
+	if useVLV {
+		vlvControls, vlvErr := setVLVControls(request)
+		if vlvErr != nil {
+			return nil, vlvErr
+		}
+
+		controls = vlvControls
+	} else {
+		// Set cursor from the request (if exists)
+		pageControl, pageErr := setPageControl(request)
+		if pageErr != nil {
+			return nil, pageErr
+		}
+
+		controls = []ldap_v3.Control{pageControl}
 	}
 
 	filters, filterErr := SetFilters(request)
@@ -271,22 +304,19 @@ func (c *ldapClient) Request(ctx context.Context, request *Request) (*Response,
 		return nil, filterErr
 	}
 
-	attributes := make([]string, 0, len(request.Attributes))
-	for _, attr := range request.Attributes {
-		attributes = append(attributes, attr.ExternalId)
-	}
+	attributes := searchAttributes(request.Attributes)
 
 	// Define LDAP search with filtering, attributes and paging.
 	searchRequest := ldap_v3.NewSearchRequest(
-		request.BaseDN,                 // BaseDN
-		ldap_v3.ScopeWholeSubtree,      // Scope
-		ldap_v3.DerefAlways,            // DeferAliases
-		0,                              // SizeLimit
-		request.RequestTimeoutSeconds,  // TimeLimit
-		false,                          // TypesOnly
-		filters,                        // Filters
-		attributes,                     // Attributes
-		[]ldap_v3.Control{pageControl}, // Controls
+		request.BaseDN,                // BaseDN
+		ldap_v3.ScopeWholeSubtree,     // Scope
+		ldap_v3.DerefAlways,           // DeferAliases
+		0,                             // SizeLimit
+		request.RequestTimeoutSeconds, // TimeLimit
+		false,                         // TypesOnly
+		filters,                       // Filters
+		attributes,                    // Attributes
+		controls,                      // Controls
 	)
 
 	// Perform search
@@ -312,6 +342,7 @@ func (c *ldapClient) Request(ctx context.Context, request *Request) (*Response,
 			customerror.WithRequestTimeoutMessage(
 				err, request.RequestTimeoutSeconds,
 			),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -324,7 +355,17 @@ func (c *ldapClient) Request(ctx context.Context, request *Request) (*Response,
 		}, nil
 	}
 
-	response, ferr := ProcessLDAPSearchResult(searchResult, request)
+	var (
+		response *Response
+		ferr     *framework.Error
+	)
+
+	if useVLV {
+		response, ferr = ProcessVLVSearchResult(searchResult, request)
+	} else {
+		response, ferr = ProcessLDAPSearchResult(searchResult, request)
+	}
+
 	if ferr != nil {
 		return nil, ferr
 	}
@@ -441,6 +482,13 @@ type PageInfo struct {
 
 	// RangeAttribute is the attribute used for range queries.
 	RangeAttribute bool `json:"rangeAttribute,omitempty"`
+
+	// VLVOffset is the offset of the first entry of the next page in the sorted result set,
+	// when paging with the Virtual List View control.
+	VLVOffset int64 `json:"vlvOffset,omitempty"`
+
+	// VLVContextID is the context identifier returned by the server with the last Virtual List View response.
+	VLVContextID *string `json:"vlvContextID,omitempty"`
 }
 
 func getPageInfo(req *Request) (*PageInfo, *framework.Error) {
@@ -571,6 +619,7 @@ func (d *Datasource) getMemberOfPage(
 		PageSize:              entityConfig.MemberOfGroupBatchSize,
 		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
 		Cursor:                request.Cursor,
+		CanonicalObjectGUID:   request.CanonicalObjectGUID,
 	}
 
 	memberOfResp, err := d.getPage(ctx, memberOfReq, nil)
@@ -673,6 +722,7 @@ func (d *Datasource) getMemberOfPage(
 				UniqueIDAttribute:     request.UniqueIDAttribute,
 				EntityConfigMap:       modifiedEntityConfigMap,
 				RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+				CanonicalObjectGUID:   request.CanonicalObjectGUID,
 				Attributes: []*framework.AttributeConfig{
 					{
 						ExternalId: *entityConfig.MemberOfUniqueIDAttribute,
@@ -892,6 +942,15 @@ func ProcessLDAPSearchResult(result *ldap_v3.SearchResult, request *Request) (*R
 		return nil, frameworkErr
 	}
 
+	if request.CanonicalObjectGUID {
+		if err := canonicalizeObjectGUIDs(objects); err != nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Failed to parse attribute %s: %v.", objectGUID, err),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ATTRIBUTE_TYPE,
+			}
+		}
+	}
+
 	// Indicating a successful LDAP search operation.
 	// In case of no error (err == nil), ldap_v3.Search is considered successful,
 	// returning LDAP Result Code Success(0) equivalent to HTTP status code StatusOK.
@@ -1023,9 +1082,32 @@ func EntryToObject(e *ldap_v3.Entry,
 			return nil, err
 		}
 
+		// Skip attributes without a value, e.g. FILETIME attributes that are not set.
+		if value == nil {
+			continue
+		}
+
 		result[attribute.Name] = value
 	}
 
+	// Attributes holding userAccountControl flags are derived from the userAccountControl attribute.
+	if value := e.GetAttributeValue(userAccountControl); value != "" {
+		bits, parseErr := strconv.ParseInt(value, 10, 64)
+		if parseErr != nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf(ErrorMsgAttributeTypeDoesNotMatchFmt,
+					userAccountControl, reflect.TypeOf(value), "int64"),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ATTRIBUTE_TYPE,
+			}
+		}
+
+		for attributeID := range attrConfig {
+			if flag, ok := userAccountControlFlag(attributeID); ok {
+				result[attributeID] = bits&flag != 0
+			}
+		}
+	}
+
 	return result, nil
 }
 
@@ -1054,12 +1136,17 @@ func StringAttrValuesToRequestedType(
 
 		values := make([]any, 0, len(attr.Values))
 
-		for _, v := range attr.Values {
+		for i, v := range attr.Values {
 			listAttr := &ldap_v3.EntryAttribute{
 				Name:   attr.Name,
 				Values: []string{v},
 			}
 
+			// Keep the raw value for the attributes of special AD syntaxes, e.g. SIDHistory.
+			if i < len(attr.ByteValues) {
+				listAttr.ByteValues = [][]byte{attr.ByteValues[i]}
+			}
+
 			value, err := StringAttrValuesToRequestedType(listAttr, false, attrType)
 			if err != nil {
 				return nil, err
@@ -1129,7 +1216,25 @@ func StringAttrValuesToRequestedType(
 			return attr.Values[0], nil
 		}
 	case getAttrType(api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME):
-		return attr.Values[0], nil
+		if !isFileTimeAttribute(attr.Name) {
+			return attr.Values[0], nil
+		}
+
+		value, isSet, err := FileTimeToRFC3339(attr.Values[0])
+		if err != nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf(ErrorMsgAttributeTypeDoesNotMatchFmt,
+					attr.Name, reflect.TypeOf(attr.Values[0]), "FILETIME"),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ATTRIBUTE_TYPE,
+			}
+		}
+
+		// Omit FILETIME attributes that are not set, instead of returning the FILETIME epoch.
+		if !isSet {
+			return nil, nil
+		}
+
+		return value, nil
 	case getAttrType(api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL):
 		value, err := strconv.ParseBool(attr.Values[0])
 		if err != nil {
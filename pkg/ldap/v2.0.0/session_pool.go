@@ -17,6 +17,9 @@ type Session struct {
 	newKey   string    // new session key (used during pagination)
 	lastUsed time.Time // timestamp of last access to manage TTL-based cleanup
 	mu       sync.Mutex
+
+	// supportedControls are the OIDs of the controls advertised by the server, read on first use.
+	supportedControls map[string]bool
 }
 
 // GetOrCreateConn retrieves the existing LDAP connection if it's healthy,
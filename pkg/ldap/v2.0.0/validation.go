@@ -104,6 +104,13 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
+	if err := validateUserAccountControlFlagAttributes(request.Entity.Attributes); err != nil {
+		return &framework.Error{
+			Message: fmt.Sprintf("Requested entity attributes are invalid: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
 	if request.Ordered {
 		return &framework.Error{
 			Message: "Ordered must be set to false.",
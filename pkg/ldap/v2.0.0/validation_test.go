@@ -74,6 +74,81 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
 			},
 		},
+		"valid_user_account_control_flag": {
+			request: &framework.Request[ldap_adapter.Config]{
+				Address: mockLDAPSAddr,
+				Auth:    validAuthCredentials,
+				Entity: framework.EntityConfig{
+					ExternalId: "Person",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "dn",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "userAccountControl.ACCOUNTDISABLE",
+							Type:       framework.AttributeTypeBool,
+						},
+					},
+				},
+				Config:   validCommonConfig,
+				PageSize: 2,
+			},
+			wantErr: nil,
+		},
+		"invalid_unknown_user_account_control_flag": {
+			request: &framework.Request[ldap_adapter.Config]{
+				Address: mockLDAPSAddr,
+				Auth:    validAuthCredentials,
+				Entity: framework.EntityConfig{
+					ExternalId: "Person",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "dn",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "userAccountControl.DISABLED",
+							Type:       framework.AttributeTypeBool,
+						},
+					},
+				},
+				Config:   validCommonConfig,
+				PageSize: 2,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are invalid: attribute userAccountControl.DISABLED refers to an unknown userAccountControl flag.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_user_account_control_flag_type": {
+			request: &framework.Request[ldap_adapter.Config]{
+				Address: mockLDAPSAddr,
+				Auth:    validAuthCredentials,
+				Entity: framework.EntityConfig{
+					ExternalId: "Person",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "dn",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+						{
+							ExternalId: "userAccountControl.ACCOUNTDISABLE",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config:   validCommonConfig,
+				PageSize: 2,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are invalid: attribute userAccountControl.ACCOUNTDISABLE must be a single-valued boolean.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
 		"invalid_missing_auth": {
 			request: &framework.Request[ldap_adapter.Config]{
 				Address: mockLDAPAddr,
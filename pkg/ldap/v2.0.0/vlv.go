@@ -0,0 +1,351 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package ldap
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	ldap_v3 "github.com/go-ldap/ldap/v3"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Server-side sorting and Virtual List View (VLV) controls.
+//
+// Unlike the simple paged results control, whose cookie refers to a result set held by the server for a
+// single connection, VLV requests address a window of the sorted result set by offset. Paging with VLV
+// is therefore stable across connections and doesn't rely on the server keeping a paging state around,
+// which some directories discard or corrupt under heavy churn.
+//
+// See: https://datatracker.ietf.org/doc/html/rfc2891 and
+// https://datatracker.ietf.org/doc/html/draft-ietf-ldapext-ldapv3-vlv-09
+const (
+	// vlvTagByOffset is the context-specific tag of the byOffset target of a VLV request.
+	vlvTagByOffset = 0
+
+	// sortTagOrderingRule is the context-specific tag of the orderingRule of a sort key.
+	sortTagOrderingRule = 0
+)
+
+// rootDSESupportedControl is the root DSE attribute listing the OIDs of the controls supported by the server.
+const rootDSESupportedControl = "supportedControl"
+
+// searcher is the subset of *ldap_v3.Conn used to read the root DSE.
+type searcher interface {
+	Search(searchRequest *ldap_v3.SearchRequest) (*ldap_v3.SearchResult, error)
+}
+
+// ControlSortRequest implements the server-side sort request control on a single attribute, in
+// ascending order. The default ordering rule of the attribute is used if OrderingRule is empty.
+//
+// ldap_v3.ControlServerSideSorting always encodes an orderingRule, which servers reject when empty.
+type ControlSortRequest struct {
+	AttributeType string
+	OrderingRule  string
+}
+
+// GetControlType returns the OID.
+func (c *ControlSortRequest) GetControlType() string {
+	return ldap_v3.ControlTypeServerSideSorting
+}
+
+// Encode returns the ber packet representation.
+func (c *ControlSortRequest) Encode() *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Control")
+	packet.AppendChild(
+		ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, c.GetControlType(), "Control Type"),
+	)
+
+	sortKey := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "SortKey")
+	sortKey.AppendChild(
+		ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, c.AttributeType, "attributeType"),
+	)
+
+	if c.OrderingRule != "" {
+		sortKey.AppendChild(
+			ber.NewString(ber.ClassContext, ber.TypePrimitive, sortTagOrderingRule, c.OrderingRule, "orderingRule"),
+		)
+	}
+
+	sortKeyList := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "SortKeyList")
+	sortKeyList.AppendChild(sortKey)
+
+	value := ber.Encode(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, nil, "Control Value")
+	value.AppendChild(sortKeyList)
+
+	packet.AppendChild(value)
+
+	return packet
+}
+
+// String returns a human-readable description.
+func (c *ControlSortRequest) String() string {
+	return fmt.Sprintf("Control Type: %s (%q) AttributeType: %s OrderingRule: %s",
+		ldap_v3.ControlTypeMap[c.GetControlType()], c.GetControlType(), c.AttributeType, c.OrderingRule)
+}
+
+// ControlVLVRequest implements the VLV request control, targeting an entry by its offset in the
+// sorted result set.
+type ControlVLVRequest struct {
+	// BeforeCount is the number of entries to return before the target entry.
+	BeforeCount int64
+
+	// AfterCount is the number of entries to return after the target entry.
+	AfterCount int64
+
+	// Offset is the 1-based position of the target entry in the sorted result set.
+	Offset int64
+
+	// ContentCount is the estimated number of entries in the result set. When 0, the server
+	// interprets Offset as an absolute position.
+	ContentCount int64
+
+	// ContextID is the opaque identifier returned by the server in the previous VLV response, if any.
+	ContextID []byte
+}
+
+// GetControlType returns the OID.
+func (c *ControlVLVRequest) GetControlType() string {
+	return ldap_v3.ControlTypeVLVRequest
+}
+
+// Encode returns the ber packet representation.
+func (c *ControlVLVRequest) Encode() *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Control")
+	packet.AppendChild(
+		ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, c.GetControlType(), "Control Type"),
+	)
+	packet.AppendChild(ber.NewBoolean(ber.ClassUniversal, ber.TypePrimitive, ber.TagBoolean, true, "Criticality"))
+
+	request := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "VirtualListViewRequest")
+	request.AppendChild(
+		ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, c.BeforeCount, "beforeCount"),
+	)
+	request.AppendChild(
+		ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, c.AfterCount, "afterCount"),
+	)
+
+	byOffset := ber.Encode(ber.ClassContext, ber.TypeConstructed, vlvTagByOffset, nil, "byOffset")
+	byOffset.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, c.Offset, "offset"))
+	byOffset.AppendChild(
+		ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, c.ContentCount, "contentCount"),
+	)
+	request.AppendChild(byOffset)
+
+	if len(c.ContextID) != 0 {
+		request.AppendChild(
+			ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, string(c.ContextID), "contextID"),
+		)
+	}
+
+	value := ber.Encode(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, nil, "Control Value")
+	value.AppendChild(request)
+
+	packet.AppendChild(value)
+
+	return packet
+}
+
+// String returns a human-readable description.
+func (c *ControlVLVRequest) String() string {
+	return fmt.Sprintf("Control Type: %s (%q) BeforeCount: %d AfterCount: %d Offset: %d ContentCount: %d",
+		"Virtual List View Request", c.GetControlType(), c.BeforeCount, c.AfterCount, c.Offset, c.ContentCount)
+}
+
+// VLVResponse is the decoded value of the VLV response control.
+type VLVResponse struct {
+	// TargetPosition is the 1-based position of the target entry in the sorted result set.
+	TargetPosition int64
+
+	// ContentCount is the number of entries in the sorted result set.
+	ContentCount int64
+
+	// Result is the LDAP result code of the VLV operation.
+	Result int64
+
+	// ContextID is the opaque identifier to send in the next VLV request, if any.
+	ContextID []byte
+}
+
+// DecodeVLVResponse decodes the VLV response control returned with a search result. go-ldap doesn't
+// know this control and returns it as a *ldap_v3.ControlString holding the raw control value.
+func DecodeVLVResponse(control ldap_v3.Control) (*VLVResponse, error) {
+	ctrl, ok := control.(*ldap_v3.ControlString)
+	if !ok || ctrl == nil {
+		return nil, errors.New("VLV response control is missing")
+	}
+
+	packet, err := ber.DecodePacketErr([]byte(ctrl.ControlValue))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode VLV response control: %w", err)
+	}
+
+	if len(packet.Children) < 3 {
+		return nil, errors.New("VLV response control is malformed")
+	}
+
+	response := &VLVResponse{}
+
+	for i, target := range []*int64{&response.TargetPosition, &response.ContentCount, &response.Result} {
+		value, err := ber.ParseInt64(packet.Children[i].Data.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode VLV response control: %w", err)
+		}
+
+		*target = value
+	}
+
+	if len(packet.Children) > 3 {
+		response.ContextID = packet.Children[3].Data.Bytes()
+	}
+
+	return response, nil
+}
+
+// SupportedControls returns the OIDs of the controls advertised in the root DSE of the server. The
+// controls are read once per session.
+func (s *Session) SupportedControls(conn searcher) (map[string]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.supportedControls != nil {
+		return s.supportedControls, nil
+	}
+
+	result, err := conn.Search(ldap_v3.NewSearchRequest(
+		"",                                // BaseDN
+		ldap_v3.ScopeBaseObject,           // Scope
+		ldap_v3.NeverDerefAliases,         // DeferAliases
+		0,                                 // SizeLimit
+		0,                                 // TimeLimit
+		false,                             // TypesOnly
+		"(objectClass=*)",                 // Filters
+		[]string{rootDSESupportedControl}, // Attributes
+		nil,                               // Controls
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	supportedControls := make(map[string]bool)
+
+	for _, entry := range result.Entries {
+		for _, oid := range entry.GetAttributeValues(rootDSESupportedControl) {
+			supportedControls[oid] = true
+		}
+	}
+
+	s.supportedControls = supportedControls
+
+	return supportedControls, nil
+}
+
+// supportsVirtualListView returns true if the server supports both the server-side sort and the
+// VLV request controls.
+func supportsVirtualListView(supportedControls map[string]bool) bool {
+	return supportedControls[ldap_v3.ControlTypeServerSideSorting] && supportedControls[ldap_v3.ControlTypeVLVRequest]
+}
+
+// sortAttribute returns the attribute used to sort the entries of the requested entity.
+// Defaults to the unique ID attribute of the entity.
+func sortAttribute(request *Request) string {
+	if entityConfig, ok := request.EntityConfigMap[request.EntityExternalID]; ok && entityConfig != nil &&
+		entityConfig.SortAttribute != nil && *entityConfig.SortAttribute != "" {
+		return *entityConfig.SortAttribute
+	}
+
+	return request.UniqueIDAttribute
+}
+
+// setVLVControls returns the server-side sort and VLV request controls for the page of the request.
+func setVLVControls(request *Request) ([]ldap_v3.Control, *framework.Error) {
+	pageInfo, ferr := getPageInfo(request)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	vlvControl := &ControlVLVRequest{
+		AfterCount: request.PageSize - 1,
+		Offset:     1,
+	}
+
+	if pageInfo.VLVOffset > 0 {
+		vlvControl.Offset = pageInfo.VLVOffset
+	}
+
+	if pageInfo.VLVContextID != nil {
+		contextID, err := OctetStringToBytes(*pageInfo.VLVContextID)
+		if err != nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Failed to parse cursor value: %v.", err),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		vlvControl.ContextID = contextID
+	}
+
+	return []ldap_v3.Control{&ControlSortRequest{AttributeType: sortAttribute(request)}, vlvControl}, nil
+}
+
+// ProcessVLVSearchResult processes the result of a search made with the VLV request control. The
+// cursor of the next page holds the offset of the entry following the last returned entry.
+func ProcessVLVSearchResult(result *ldap_v3.SearchResult, request *Request) (*Response, *framework.Error) {
+	vlvResponse, err := DecodeVLVResponse(ldap_v3.FindControl(result.Controls, ldap_v3.ControlTypeVLVResponse))
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to process the Virtual List View response: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+	}
+
+	if vlvResponse.Result != ldap_v3.LDAPResultSuccess {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Virtual List View request failed: LDAP Result Code %d %q.",
+				vlvResponse.Result, ldap_v3.LDAPResultCodeMap[uint16(vlvResponse.Result)],
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+	}
+
+	response, ferr := ProcessLDAPSearchResult(result, request)
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	nextOffset := vlvResponse.TargetPosition + int64(len(result.Entries))
+	if len(result.Entries) == 0 || nextOffset > vlvResponse.ContentCount {
+		response.NextCursor = nil
+
+		return response, nil
+	}
+
+	pageInfo := &PageInfo{
+		VLVOffset: nextOffset,
+	}
+
+	if len(vlvResponse.ContextID) != 0 {
+		pageInfo.VLVContextID = BytesToOctetString(vlvResponse.ContextID)
+	}
+
+	b, marshalErr := json.Marshal(pageInfo)
+	if marshalErr != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to create updated cursor: %v.", marshalErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	encodedCursor := base64.StdEncoding.EncodeToString(b)
+	response.NextCursor = &pagination.CompositeCursor[string]{
+		Cursor: &encodedCursor,
+	}
+
+	return response, nil
+}
@@ -0,0 +1,268 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package ldap_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	ldap_v3 "github.com/go-ldap/ldap/v3"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	ldap "github.com/sgnl-ai/adapters/pkg/ldap/v2.0.0"
+)
+
+func vlvResponseControl(targetPosition, contentCount, result int64, contextID string) ldap_v3.Control {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "VirtualListViewResponse")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, targetPosition, ""))
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, contentCount, ""))
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, result, ""))
+
+	if contextID != "" {
+		packet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, contextID, ""))
+	}
+
+	return ldap_v3.NewControlString(ldap_v3.ControlTypeVLVResponse, false, string(packet.Bytes()))
+}
+
+func TestControlVLVRequestEncode(t *testing.T) {
+	control := &ldap.ControlVLVRequest{
+		AfterCount: 99,
+		Offset:     101,
+		ContextID:  []byte{0x00, 0x01},
+	}
+
+	packet, err := ber.DecodePacketErr(control.Encode().Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := packet.Children[0].Value; got != ldap_v3.ControlTypeVLVRequest {
+		t.Errorf("got control type: %v, want: %v", got, ldap_v3.ControlTypeVLVRequest)
+	}
+
+	value, err := ber.DecodePacketErr(packet.Children[2].Data.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(value.Children) != 4 {
+		t.Fatalf("got %d children, want 4", len(value.Children))
+	}
+
+	gotCounts := []any{value.Children[0].Value, value.Children[1].Value}
+	if !reflect.DeepEqual(gotCounts, []any{int64(0), int64(99)}) {
+		t.Errorf("got before and after counts: %v, want: [0 99]", gotCounts)
+	}
+
+	byOffset := value.Children[2]
+	if byOffset.ClassType != ber.ClassContext || byOffset.Tag != 0 || len(byOffset.Children) != 2 {
+		t.Fatalf("got malformed byOffset target: %v", byOffset)
+	}
+
+	gotOffset, err := ber.ParseInt64(byOffset.Children[0].Data.Bytes())
+	if err != nil || gotOffset != 101 {
+		t.Errorf("got offset: %v (%v), want: 101", gotOffset, err)
+	}
+
+	if got := value.Children[3].Data.Bytes(); !reflect.DeepEqual(got, []byte{0x00, 0x01}) {
+		t.Errorf("got context ID: %v, want: [0 1]", got)
+	}
+}
+
+func TestControlSortRequestEncode(t *testing.T) {
+	packet, err := ber.DecodePacketErr((&ldap.ControlSortRequest{AttributeType: "cn"}).Encode().Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	value, err := ber.DecodePacketErr(packet.Children[1].Data.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The orderingRule must be omitted when not set.
+	sortKey := value.Children[0]
+	if len(sortKey.Children) != 1 || sortKey.Children[0].Value != "cn" {
+		t.Errorf("got malformed sort key: %v", sortKey.Children)
+	}
+}
+
+func TestDecodeVLVResponse(t *testing.T) {
+	tests := map[string]struct {
+		control      ldap_v3.Control
+		wantResponse *ldap.VLVResponse
+		wantErr      bool
+	}{
+		"valid": {
+			control: vlvResponseControl(1, 250, 0, ""),
+			wantResponse: &ldap.VLVResponse{
+				TargetPosition: 1,
+				ContentCount:   250,
+			},
+		},
+		"valid_with_context_id": {
+			control: vlvResponseControl(101, 250, 0, "ctx"),
+			wantResponse: &ldap.VLVResponse{
+				TargetPosition: 101,
+				ContentCount:   250,
+				ContextID:      []byte("ctx"),
+			},
+		},
+		"missing_control": {
+			control: nil,
+			wantErr: true,
+		},
+		"malformed_control": {
+			control: ldap_v3.NewControlString(ldap_v3.ControlTypeVLVResponse, false, "invalid"),
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := ldap.DecodeVLVResponse(tt.control)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+		})
+	}
+}
+
+func TestProcessVLVSearchResult(t *testing.T) {
+	entries := []*ldap_v3.Entry{
+		{DN: "CN=user1,DC=example,DC=com", Attributes: []*ldap_v3.EntryAttribute{{Name: "cn", Values: []string{"user1"}}}},
+		{DN: "CN=user2,DC=example,DC=com", Attributes: []*ldap_v3.EntryAttribute{{Name: "cn", Values: []string{"user2"}}}},
+	}
+
+	tests := map[string]struct {
+		control       ldap_v3.Control
+		wantVLVOffset int64
+		wantContextID *string
+		wantErr       *framework.Error
+	}{
+		"first_page": {
+			control:       vlvResponseControl(1, 5, 0, ""),
+			wantVLVOffset: 3,
+		},
+		"page_with_context_id": {
+			control:       vlvResponseControl(3, 5, 0, "ctx"),
+			wantVLVOffset: 5,
+			wantContextID: ldap.BytesToOctetString([]byte("ctx")),
+		},
+		"last_page": {
+			control: vlvResponseControl(4, 5, 0, ""),
+		},
+		"vlv_error": {
+			control: vlvResponseControl(0, 0, 61, ""),
+			wantErr: &framework.Error{
+				Message: "Virtual List View request failed: LDAP Result Code 61 \"Result Offset Range Error\".",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := ldap.ProcessVLVSearchResult(
+				&ldap_v3.SearchResult{Entries: entries, Controls: []ldap_v3.Control{tt.control}},
+				&ldap.Request{
+					Attributes: []*framework.AttributeConfig{
+						{ExternalId: "cn", Type: framework.AttributeTypeString, UniqueId: true},
+					},
+				},
+			)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if len(gotResponse.Objects) != len(entries) {
+				t.Errorf("got %d objects, want %d", len(gotResponse.Objects), len(entries))
+			}
+
+			if tt.wantVLVOffset == 0 {
+				if gotResponse.NextCursor != nil {
+					t.Errorf("got next cursor: %v, want nil", gotResponse.NextCursor)
+				}
+
+				return
+			}
+
+			if gotResponse.NextCursor == nil {
+				t.Fatalf("got nil next cursor")
+			}
+
+			pageInfo, decodeErr := ldap.DecodePageInfo(gotResponse.NextCursor.Cursor)
+			if decodeErr != nil {
+				t.Fatalf("unexpected error: %v", decodeErr)
+			}
+
+			if pageInfo.VLVOffset != tt.wantVLVOffset || !reflect.DeepEqual(pageInfo.VLVContextID, tt.wantContextID) {
+				t.Errorf("got page info: %+v, want offset: %v, context ID: %v", pageInfo, tt.wantVLVOffset, tt.wantContextID)
+			}
+		})
+	}
+}
+
+type mockSearcher struct {
+	result   *ldap_v3.SearchResult
+	err      error
+	searches int
+}
+
+func (m *mockSearcher) Search(*ldap_v3.SearchRequest) (*ldap_v3.SearchResult, error) {
+	m.searches++
+
+	return m.result, m.err
+}
+
+func TestSessionSupportedControls(t *testing.T) {
+	conn := &mockSearcher{
+		result: &ldap_v3.SearchResult{
+			Entries: []*ldap_v3.Entry{
+				{
+					Attributes: []*ldap_v3.EntryAttribute{
+						{
+							Name:   "supportedControl",
+							Values: []string{ldap_v3.ControlTypeServerSideSorting, ldap_v3.ControlTypeVLVRequest},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	session := &ldap.Session{}
+
+	for range 2 {
+		got, err := session.SupportedControls(conn)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := map[string]bool{ldap_v3.ControlTypeServerSideSorting: true, ldap_v3.ControlTypeVLVRequest: true}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got: %v, want: %v", got, want)
+		}
+	}
+
+	if conn.searches != 1 {
+		t.Errorf("got %d root DSE searches, want 1", conn.searches)
+	}
+
+	if _, err := (&ldap.Session{}).SupportedControls(&mockSearcher{err: errors.New("search failed")}); err == nil {
+		t.Errorf("expected error")
+	}
+}
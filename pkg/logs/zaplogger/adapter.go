@@ -0,0 +1,52 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package zaplogger
+
+import (
+	"context"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	framework_logs "github.com/sgnl-ai/adapter-framework/pkg/logs"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"go.uber.org/zap"
+)
+
+type adapter[Config any] struct {
+	next   framework.Adapter[Config]
+	fields []zap.Field
+}
+
+// NewAdapter wraps an adapter to attach the standard request fields to the logger of the request context, so that
+// every entry logged while serving a GetPage request carries them, whichever adapter logs it.
+//
+// The framework attaches the datasource ID and type, the entity and the page size of the request. The wrapper adds
+// the adapter type and version, parsed from the datasource type, e.g. "Okta" and "1.0.1" for "Okta-1.0.1", and a
+// hash of the request cursor, see fields.RequestCursorHash.
+func NewAdapter[Config any](next framework.Adapter[Config], datasourceType string) framework.Adapter[Config] {
+	adapterType, adapterVersion, _ := strings.Cut(datasourceType, "-")
+
+	return &adapter[Config]{
+		next:   next,
+		fields: []zap.Field{fields.AdapterType(adapterType), fields.AdapterVersion(adapterVersion)},
+	}
+}
+
+// GetPage implements framework.Adapter.
+func (a *adapter[Config]) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	logger := zap.L()
+
+	// Leave the loggers of other implementations untouched, e.g. in tests.
+	if frameworkLogger := framework_logs.FromContext(ctx); frameworkLogger != nil {
+		zapLogger, ok := UnwrapLogger(frameworkLogger)
+		if !ok {
+			return a.next.GetPage(ctx, request)
+		}
+
+		logger = zapLogger
+	}
+
+	logger = logger.With(a.fields...).With(fields.RequestCursorHash(request.Cursor))
+
+	return a.next.GetPage(framework_logs.NewContextWithLogger(ctx, NewFrameworkLogger(logger)), request)
+}
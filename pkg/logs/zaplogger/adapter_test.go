@@ -0,0 +1,75 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package zaplogger_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	framework_logs "github.com/sgnl-ai/adapter-framework/pkg/logs"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type TestConfig struct{}
+
+// loggingAdapter logs a message with the logger of the request context.
+type loggingAdapter struct{}
+
+func (a *loggingAdapter) GetPage(ctx context.Context, _ *framework.Request[TestConfig]) framework.Response {
+	zaplogger.FromContext(ctx).Info("Starting datasource request")
+
+	return framework.Response{Success: &framework.Page{}}
+}
+
+func TestAdapterGetPage(t *testing.T) {
+	tests := map[string]struct {
+		datasourceType string
+		cursor         string
+		wantFields     map[string]any
+	}{
+		"first_page": {
+			datasourceType: "Okta-1.0.1",
+			wantFields: map[string]any{
+				"datasourceId":      "datasource",
+				"adapterType":       "Okta",
+				"adapterVersion":    "1.0.1",
+				"requestCursorHash": "",
+			},
+		},
+		"next_page": {
+			datasourceType: "MySQL-0.0.2-alpha",
+			cursor:         "cursor",
+			wantFields: map[string]any{
+				"datasourceId":      "datasource",
+				"adapterType":       "MySQL",
+				"adapterVersion":    "0.0.2-alpha",
+				"requestCursorHash": "46a4eebd20d881ec",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			observedCore, observedLogs := observer.New(zapcore.InfoLevel)
+			logger := zap.New(observedCore).With(zap.String(framework_logs.FieldDatasourceID, "datasource"))
+			ctx := framework_logs.NewContextWithLogger(context.Background(), zaplogger.NewFrameworkLogger(logger))
+
+			adapter := zaplogger.NewAdapter[TestConfig](&loggingAdapter{}, tt.datasourceType)
+			adapter.GetPage(ctx, &framework.Request[TestConfig]{Cursor: tt.cursor})
+
+			gotLogs := observedLogs.All()
+			if len(gotLogs) != 1 {
+				t.Fatalf("expected 1 log, got %d", len(gotLogs))
+			}
+
+			if gotFields := gotLogs[0].ContextMap(); !reflect.DeepEqual(gotFields, tt.wantFields) {
+				t.Errorf("gotFields: %v, wantFields: %v", gotFields, tt.wantFields)
+			}
+		})
+	}
+}
@@ -29,6 +29,15 @@ type Config struct {
 
 	// ServiceName is an optional field that, if set, adds the service name to each log entry.
 	ServiceName string `yaml:"service_name" json:"service_name" mapstructure:"service_name"`
+
+	// SamplingInitial enables the sampling of the debug and info logs if greater than 0, to limit the volume of
+	// the logs repeated for every page of large syncs. Each second, the first SamplingInitial entries with the
+	// same level and message are logged, then every SamplingThereafter-th entry. Warnings and errors are
+	// never sampled.
+	SamplingInitial int `yaml:"sampling_initial" json:"sampling_initial" mapstructure:"sampling_initial"`
+	// SamplingThereafter sets the sampling rate once SamplingInitial entries have been logged in a second.
+	// If 0, the entries past SamplingInitial are dropped.
+	SamplingThereafter int `yaml:"sampling_thereafter" json:"sampling_thereafter" mapstructure:"sampling_thereafter"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -43,6 +52,8 @@ func LoadConfig() (*Config, error) {
 	v.SetDefault("file_max_days", 7)
 	v.SetDefault("file_max_backups", 10)
 	v.SetDefault("service_name", "")
+	v.SetDefault("sampling_initial", 0)
+	v.SetDefault("sampling_thereafter", 0)
 
 	var cfg Config
 
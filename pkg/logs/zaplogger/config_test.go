@@ -33,22 +33,26 @@ func TestLoadConfiguration(t *testing.T) {
 		},
 		"set_config": {
 			inputEnvVariables: map[string]string{
-				"SGNL_LOG_LEVEL":            "DEBUG",
-				"SGNL_LOG_MODE":             "file,console",
-				"SGNL_LOG_FILE_PATH":        "/var/log/sgnl/adapter-sgnl.log",
-				"SGNL_LOG_FILE_MAX_SIZE":    "200",
-				"SGNL_LOG_FILE_MAX_BACKUPS": "20",
-				"SGNL_LOG_FILE_MAX_DAYS":    "14",
-				"SGNL_LOG_SERVICE_NAME":     "my-service",
+				"SGNL_LOG_LEVEL":               "DEBUG",
+				"SGNL_LOG_MODE":                "file,console",
+				"SGNL_LOG_FILE_PATH":           "/var/log/sgnl/adapter-sgnl.log",
+				"SGNL_LOG_FILE_MAX_SIZE":       "200",
+				"SGNL_LOG_FILE_MAX_BACKUPS":    "20",
+				"SGNL_LOG_FILE_MAX_DAYS":       "14",
+				"SGNL_LOG_SERVICE_NAME":        "my-service",
+				"SGNL_LOG_SAMPLING_INITIAL":    "10",
+				"SGNL_LOG_SAMPLING_THEREAFTER": "100",
 			},
 			wantConfiguration: &zaplogger.Config{
-				Mode:           []string{"file", "console"},
-				Level:          "DEBUG",
-				FilePath:       "/var/log/sgnl/adapter-sgnl.log",
-				FileMaxSize:    200,
-				FileMaxBackups: 20,
-				FileMaxDays:    14,
-				ServiceName:    "my-service",
+				Mode:               []string{"file", "console"},
+				Level:              "DEBUG",
+				FilePath:           "/var/log/sgnl/adapter-sgnl.log",
+				FileMaxSize:        200,
+				FileMaxBackups:     20,
+				FileMaxDays:        14,
+				ServiceName:        "my-service",
+				SamplingInitial:    10,
+				SamplingThereafter: 100,
 			},
 			wantError: nil,
 		},
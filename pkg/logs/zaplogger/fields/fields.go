@@ -3,19 +3,38 @@
 package fields
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
+	"time"
 
 	"go.uber.org/zap"
 )
 
 // Log fields which are commonly used throughout adapters.
 const (
+	FieldAdapterType              = "adapterType"
+	FieldAdapterVersion           = "adapterVersion"
 	FieldBaseURL                  = "baseUrl"
 	FieldConnectorID              = "connectorId"
 	FieldConnectorSourceID        = "connectorSourceId"
 	FieldConnectorSourceType      = "connectorSourceType"
 	FieldDatabase                 = "database"
+	FieldDeprecationHeader        = "deprecationHeader"
+	FieldDeprecationHeaderValue   = "deprecationHeaderValue"
+	FieldDeprecationResponseCount = "deprecationResponseCount"
+	FieldDuplicateObjectCount     = "duplicateObjectCount"
+	FieldGRPCMethod               = "grpcMethod"
+	FieldPanicStack               = "panicStack"
+	FieldPanicValue               = "panicValue"
+	FieldRateLimitInterval        = "rateLimitInterval"
+	FieldRateLimitLimit           = "rateLimitLimit"
+	FieldRateLimitRemaining       = "rateLimitRemaining"
+	FieldRateLimitReset           = "rateLimitReset"
+	FieldRateLimitWait            = "rateLimitWait"
+	FieldRequestCursorHash        = "requestCursorHash"
 	FieldRequestEntityExternalID  = "requestEntityExternalId"
 	FieldRequestPageSize          = "requestPageSize"
 	FieldRequestURL               = "requestUrl"
@@ -24,6 +43,14 @@ const (
 	FieldResponseObjectCount      = "responseObjectCount"
 	FieldResponseRetryAfterHeader = "responseRetryAfterHeader"
 	FieldResponseStatusCode       = "responseStatusCode"
+	FieldSchemaDriftExamples      = "schemaDriftExamples"
+	FieldSchemaDriftMissing       = "schemaDriftMissingAttributes"
+	FieldSchemaDriftNewFields     = "schemaDriftNewFields"
+	FieldSyncChecksum             = "syncChecksum"
+	FieldSyncDuplicateObjectCount = "syncDuplicateObjectCount"
+	FieldSyncObjectCount          = "syncObjectCount"
+	FieldSyncPageCount            = "syncPageCount"
+	FieldSyncSummaryPartial       = "syncSummaryPartial"
 	FieldTotalRemainingObjects    = "totalRemainingObjects"
 
 	// FieldSGNLEventType is a special field used by SGNL to identify the type of event being logged.
@@ -31,6 +58,14 @@ const (
 	SGNLEventTypeErrorValue = "sgnl.adapterSvc.error"
 )
 
+func AdapterType(adapterType string) zap.Field {
+	return zap.String(FieldAdapterType, adapterType)
+}
+
+func AdapterVersion(version string) zap.Field {
+	return zap.String(FieldAdapterVersion, version)
+}
+
 func BaseURL(url string) zap.Field {
 	return zap.String(FieldBaseURL, url)
 }
@@ -51,6 +86,67 @@ func Database(database string) zap.Field {
 	return zap.String(FieldDatabase, database)
 }
 
+func DeprecationHeader(header string) zap.Field {
+	return zap.String(FieldDeprecationHeader, header)
+}
+
+func DeprecationHeaderValue(value string) zap.Field {
+	return zap.String(FieldDeprecationHeaderValue, value)
+}
+
+func DeprecationResponseCount(count int) zap.Field {
+	return zap.Int(FieldDeprecationResponseCount, count)
+}
+
+func DuplicateObjectCount(count int64) zap.Field {
+	return zap.Int64(FieldDuplicateObjectCount, count)
+}
+
+func GRPCMethod(method string) zap.Field {
+	return zap.String(FieldGRPCMethod, method)
+}
+
+func PanicStack(stack []byte) zap.Field {
+	return zap.ByteString(FieldPanicStack, stack)
+}
+
+func PanicValue(value any) zap.Field {
+	return zap.String(FieldPanicValue, fmt.Sprint(value))
+}
+
+func RateLimitInterval(interval time.Duration) zap.Field {
+	return zap.Duration(FieldRateLimitInterval, interval)
+}
+
+func RateLimitLimit(limit int) zap.Field {
+	return zap.Int(FieldRateLimitLimit, limit)
+}
+
+func RateLimitRemaining(remaining int) zap.Field {
+	return zap.Int(FieldRateLimitRemaining, remaining)
+}
+
+func RateLimitReset(reset time.Time) zap.Field {
+	return zap.Time(FieldRateLimitReset, reset)
+}
+
+func RateLimitWait(wait time.Duration) zap.Field {
+	return zap.Duration(FieldRateLimitWait, wait)
+}
+
+// RequestCursorHash returns a zap field containing a short hash of the request cursor, which allows
+// correlating the logs of the requests for the same page without logging the cursor, which may contain
+// sensitive data. The hash of an empty cursor, i.e. of the first page, is empty.
+func RequestCursorHash(cursor string) zap.Field {
+	if cursor == "" {
+		return zap.String(FieldRequestCursorHash, "")
+	}
+
+	hash := sha256.Sum256([]byte(cursor))
+
+	return zap.String(FieldRequestCursorHash, hex.EncodeToString(hash[:8]))
+}
+
 func RequestEntityExternalID(entityExternalID string) zap.Field {
 	return zap.String(FieldRequestEntityExternalID, entityExternalID)
 }
@@ -105,6 +201,38 @@ func SGNLEventTypeError() zap.Field {
 	return zap.String(FieldSGNLEventType, SGNLEventTypeErrorValue)
 }
 
+func SchemaDriftExamples(examples map[string][]string) zap.Field {
+	return zap.Any(FieldSchemaDriftExamples, examples)
+}
+
+func SchemaDriftMissingAttributes(attributes []string) zap.Field {
+	return zap.Strings(FieldSchemaDriftMissing, attributes)
+}
+
+func SchemaDriftNewFields(fields []string) zap.Field {
+	return zap.Strings(FieldSchemaDriftNewFields, fields)
+}
+
+func SyncChecksum(checksum string) zap.Field {
+	return zap.String(FieldSyncChecksum, checksum)
+}
+
+func SyncDuplicateObjectCount(count int64) zap.Field {
+	return zap.Int64(FieldSyncDuplicateObjectCount, count)
+}
+
+func SyncObjectCount(count int64) zap.Field {
+	return zap.Int64(FieldSyncObjectCount, count)
+}
+
+func SyncPageCount(count int64) zap.Field {
+	return zap.Int64(FieldSyncPageCount, count)
+}
+
+func SyncSummaryPartial(partial bool) zap.Field {
+	return zap.Bool(FieldSyncSummaryPartial, partial)
+}
+
 func TotalRemainingObjects(totalRemaining int64) zap.Field {
 	return zap.Int64(FieldTotalRemainingObjects, totalRemaining)
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"slices"
+	"time"
 
 	framework_logs "github.com/sgnl-ai/adapter-framework/pkg/logs"
 	"go.uber.org/zap"
@@ -91,28 +92,48 @@ func New(cfg Config, zapOpts ...zap.Option) *zap.Logger {
 
 	jsonEncoder := zapcore.NewJSONEncoder(encoderCfg)
 
-	zapCores := make([]zapcore.Core, 0, len(cfg.Mode))
+	writers := make([]zapcore.WriteSyncer, 0, len(cfg.Mode))
 
 	if slices.Contains(cfg.Mode, LogModeFile) {
-		zapCores = append(zapCores, zapcore.NewCore(
-			jsonEncoder,
-			zapcore.AddSync(&lumberjack.Logger{
-				Filename:   cfg.FilePath,
-				MaxSize:    cfg.FileMaxSize, // megabytes
-				MaxBackups: cfg.FileMaxBackups,
-				MaxAge:     cfg.FileMaxDays, // days
-				Compress:   true,
-			}),
-			logLevel,
-		))
+		writers = append(writers, zapcore.AddSync(&lumberjack.Logger{
+			Filename:   cfg.FilePath,
+			MaxSize:    cfg.FileMaxSize, // megabytes
+			MaxBackups: cfg.FileMaxBackups,
+			MaxAge:     cfg.FileMaxDays, // days
+			Compress:   true,
+		}))
 	}
 
 	if slices.Contains(cfg.Mode, LogModeConsole) {
-		zapCores = append(zapCores, zapcore.NewCore(
-			jsonEncoder,
-			zapcore.AddSync(os.Stdout),
-			logLevel,
-		))
+		writers = append(writers, zapcore.AddSync(os.Stdout))
+	}
+
+	zapCores := make([]zapcore.Core, 0, len(writers))
+
+	for _, writer := range writers {
+		if cfg.SamplingInitial <= 0 {
+			zapCores = append(zapCores, zapcore.NewCore(jsonEncoder, writer, logLevel))
+
+			continue
+		}
+
+		// Only sample the entries below the warn level, so that warnings and errors are always logged.
+		sampledLevels := zap.LevelEnablerFunc(func(level zapcore.Level) bool {
+			return logLevel.Enabled(level) && level < zapcore.WarnLevel
+		})
+		unsampledLevels := zap.LevelEnablerFunc(func(level zapcore.Level) bool {
+			return logLevel.Enabled(level) && level >= zapcore.WarnLevel
+		})
+
+		zapCores = append(zapCores,
+			zapcore.NewSamplerWithOptions(
+				zapcore.NewCore(jsonEncoder, writer, sampledLevels),
+				time.Second,
+				cfg.SamplingInitial,
+				cfg.SamplingThereafter,
+			),
+			zapcore.NewCore(jsonEncoder, writer, unsampledLevels),
+		)
 	}
 
 	core := zapcore.NewTee(zapCores...)
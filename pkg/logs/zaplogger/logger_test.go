@@ -170,6 +170,36 @@ func TestNew(t *testing.T) {
 				},
 			},
 		},
+		"sampled_info_logs": {
+			config: zaplogger.Config{
+				Mode:               []string{"file"},
+				Level:              "INFO",
+				FilePath:           filepath.Join(t.TempDir(), "test.log"),
+				FileMaxSize:        100,
+				FileMaxBackups:     10,
+				FileMaxDays:        7,
+				SamplingInitial:    2,
+				SamplingThereafter: 3,
+			},
+			writeLogs: func(logger *zap.Logger) {
+				for i := range 6 {
+					logger.Info("info message", zap.Int("page", i))
+					logger.Warn("warn message", zap.Int("page", i))
+				}
+			},
+			expectedFileLines: []map[string]any{
+				{"level": "info", "msg": "Zap logger initialized"},
+				{"level": "info", "msg": "info message", "page": float64(0)},
+				{"level": "warn", "msg": "warn message", "page": float64(0)},
+				{"level": "info", "msg": "info message", "page": float64(1)},
+				{"level": "warn", "msg": "warn message", "page": float64(1)},
+				{"level": "warn", "msg": "warn message", "page": float64(2)},
+				{"level": "warn", "msg": "warn message", "page": float64(3)},
+				{"level": "info", "msg": "info message", "page": float64(4)},
+				{"level": "warn", "msg": "warn message", "page": float64(4)},
+				{"level": "warn", "msg": "warn message", "page": float64(5)},
+			},
+		},
 	}
 
 	for name, test := range tests {
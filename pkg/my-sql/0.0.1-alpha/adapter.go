@@ -10,6 +10,7 @@ import (
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -43,7 +44,7 @@ func (a *Adapter) RequestPageFromDatasource(
 		Username:     request.Auth.Basic.Username,
 		Password:     request.Auth.Basic.Password,
 		BaseURL:      request.Address,
-		PageSize:     request.PageSize,
+		PageSize:     request.Config.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityConfig: request.Entity,
 		Database:     request.Config.Database,
 	}
@@ -80,6 +81,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
@@ -9,6 +9,7 @@ import (
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -39,12 +40,15 @@ func (a *Adapter) RequestPageFromDatasource(
 	ctx context.Context, request *framework.Request[Config],
 ) framework.Response {
 	req := &Request{
-		Username:     request.Auth.Basic.Username,
-		Password:     request.Auth.Basic.Password,
-		BaseURL:      request.Address,
-		PageSize:     request.PageSize,
-		EntityConfig: request.Entity,
-		Database:     request.Config.Database,
+		Username:            request.Auth.Basic.Username,
+		Password:            request.Auth.Basic.Password,
+		BaseURL:             request.Address,
+		PageSize:            request.Config.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
+		EntityConfig:        request.Entity,
+		Database:            request.Config.Database,
+		TLS:                 request.Config.TLS,
+		IAMAuth:             request.Config.IAMAuth,
+		SchemaIntrospection: request.Config.SchemaIntrospection,
 	}
 
 	if request.Cursor != "" {
@@ -59,6 +63,12 @@ func (a *Adapter) RequestPageFromDatasource(
 		}
 	}
 
+	// Entities backed by a custom query are paginated using the keyset column of the query.
+	if customQuery, ok := request.Config.CustomQueries[request.Entity.ExternalId]; ok {
+		req.CustomQuery = &customQuery
+		req.UniqueAttributeExternalID = customQuery.KeysetColumn
+	}
+
 	if request.Config.Filters != nil {
 		if curFilter, ok := request.Config.Filters[request.Entity.ExternalId]; ok {
 			req.Filter = &curFilter
@@ -76,6 +86,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
@@ -0,0 +1,293 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package mysql
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-sql-driver/mysql"
+)
+
+// TLSMode is the level of verification of the server certificate when connecting over TLS.
+type TLSMode string
+
+const (
+	// TLSModeRequire encrypts the connection without verifying the server certificate.
+	TLSModeRequire TLSMode = "require"
+
+	// TLSModeVerifyCA encrypts the connection and verifies that the server certificate is signed
+	// by a trusted CA, without verifying the server hostname.
+	TLSModeVerifyCA TLSMode = "verify-ca"
+
+	// TLSModeVerifyIdentity encrypts the connection and verifies that the server certificate is signed
+	// by a trusted CA and issued for the server hostname.
+	TLSModeVerifyIdentity TLSMode = "verify-identity"
+)
+
+// IAMAuthProvider is the cloud provider issuing the auth tokens used as database passwords.
+type IAMAuthProvider string
+
+const (
+	// IAMAuthProviderAWSRDS uses AWS RDS IAM database authentication. Tokens are signed with the
+	// configured AWS access key ID and the secret access key provided as the basic auth password.
+	IAMAuthProviderAWSRDS IAMAuthProvider = "AWS_RDS"
+)
+
+const (
+	// defaultPort is the port MySQL listens on by default.
+	defaultPort = "3306"
+
+	// rdsAuthTokenExpiry is the maximum validity of an RDS auth token.
+	rdsAuthTokenExpiry = 15 * time.Minute
+
+	// emptyPayloadHash is the SHA-256 hash of an empty payload, used to presign RDS auth tokens.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// TLSConfig is the TLS configuration used to connect to the MySQL instance.
+type TLSConfig struct {
+	// Mode is the level of verification of the server certificate.
+	Mode TLSMode `json:"mode"`
+
+	// CertificateChain is the base64 encoded PEM chain of the CAs trusted to sign the server certificate.
+	// If not set, the system CAs are trusted. Ignored in the require mode.
+	CertificateChain string `json:"certificateChain,omitempty"`
+}
+
+// Validate validates that a TLSConfig is valid.
+func (c *TLSConfig) Validate() error {
+	switch c.Mode {
+	case TLSModeRequire, TLSModeVerifyCA, TLSModeVerifyIdentity:
+	default:
+		return fmt.Errorf("mode must be one of %s, %s or %s, got %q",
+			TLSModeRequire, TLSModeVerifyCA, TLSModeVerifyIdentity, c.Mode)
+	}
+
+	if _, err := c.certPool(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// certPool returns the pool of CAs trusted to sign the server certificate, or nil to trust the system CAs.
+func (c *TLSConfig) certPool() (*x509.CertPool, error) {
+	if c.CertificateChain == "" {
+		return nil, nil
+	}
+
+	decodedCertChain, err := base64.StdEncoding.DecodeString(c.CertificateChain)
+	if err != nil {
+		return nil, fmt.Errorf("certificateChain is not base64 encoded: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(decodedCertChain) {
+		return nil, errors.New("certificateChain contains no PEM encoded certificates")
+	}
+
+	return pool, nil
+}
+
+// ClientTLSConfig returns the tls.Config used to connect to the MySQL instance at address,
+// in the host:port format.
+func (c *TLSConfig) ClientTLSConfig(address string) (*tls.Config, error) {
+	pool, err := c.certPool()
+	if err != nil {
+		return nil, err
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		// The address has no port, the default MySQL port is used.
+		host = address
+	}
+
+	switch c.Mode {
+	case TLSModeRequire:
+		return &tls.Config{
+			InsecureSkipVerify: true,
+		}, nil
+	case TLSModeVerifyCA:
+		// The hostname verification performed by crypto/tls can't be disabled on its own, so it is
+		// skipped altogether and the chain is verified against the trusted CAs only.
+		return &tls.Config{
+			InsecureSkipVerify: true,
+			VerifyConnection: func(state tls.ConnectionState) error {
+				if len(state.PeerCertificates) == 0 {
+					return errors.New("server presented no certificate")
+				}
+
+				opts := x509.VerifyOptions{
+					Roots:         pool,
+					Intermediates: x509.NewCertPool(),
+				}
+
+				for _, cert := range state.PeerCertificates[1:] {
+					opts.Intermediates.AddCert(cert)
+				}
+
+				_, err := state.PeerCertificates[0].Verify(opts)
+
+				return err
+			},
+		}, nil
+	case TLSModeVerifyIdentity:
+		return &tls.Config{
+			RootCAs:    pool,
+			ServerName: host,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported TLS mode %q", c.Mode)
+	}
+}
+
+// registerTLSConfig registers the tls.Config used to connect to the MySQL instance at address with the
+// MySQL driver, and returns the name to reference it in the DSN. The name is derived from the
+// configuration, so that connections to the same instance with the same configuration share it.
+func (c *TLSConfig) registerTLSConfig(address string) (string, error) {
+	tlsConfig, err := c.ClientTLSConfig(address)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join([]string{string(c.Mode), c.CertificateChain, address}, "\x00")))
+	name := "sgnl-" + hex.EncodeToString(hash[:16])
+
+	if err := mysql.RegisterTLSConfig(name, tlsConfig); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// IAMAuthConfig is the configuration of the cloud IAM authentication used to connect to the MySQL instance.
+// When set, the password of the basic auth credentials holds the provider credentials, from which a
+// short-lived auth token is generated for the username for each request.
+type IAMAuthConfig struct {
+	// Provider is the cloud provider issuing the auth tokens.
+	Provider IAMAuthProvider `json:"provider"`
+
+	// Region is the AWS region of the RDS instance. Required for the AWS_RDS provider.
+	Region string `json:"region,omitempty"`
+
+	// AccessKeyID is the ID of the AWS access key signing the auth tokens, whose secret access key is the
+	// password of the basic auth credentials. Required for the AWS_RDS provider.
+	AccessKeyID string `json:"accessKeyId,omitempty"`
+}
+
+// Validate validates that an IAMAuthConfig is valid.
+func (c *IAMAuthConfig) Validate() error {
+	switch c.Provider {
+	case IAMAuthProviderAWSRDS:
+		if c.Region == "" {
+			return errors.New("region is required for the AWS_RDS provider")
+		}
+
+		if c.AccessKeyID == "" {
+			return errors.New("accessKeyId is required for the AWS_RDS provider")
+		}
+	default:
+		return fmt.Errorf("provider must be %s, got %q", IAMAuthProviderAWSRDS, c.Provider)
+	}
+
+	return nil
+}
+
+// AuthTokenProvider generates the auth tokens used as passwords for requests with IAM auth.
+type AuthTokenProvider interface {
+	AuthToken(ctx context.Context, request *Request) (string, error)
+}
+
+type defaultAuthTokenProvider struct{}
+
+// NewDefaultAuthTokenProvider returns an AuthTokenProvider generating tokens with the credentials of the
+// request. Only the credentials of the request are used, never the cloud credentials available to the adapter.
+func NewDefaultAuthTokenProvider() AuthTokenProvider {
+	return &defaultAuthTokenProvider{}
+}
+
+// AuthToken returns an auth token for the username of the request.
+func (p *defaultAuthTokenProvider) AuthToken(ctx context.Context, request *Request) (string, error) {
+	if request.IAMAuth == nil {
+		return "", errors.New("request has no IAM auth config")
+	}
+
+	if request.Password == "" {
+		return "", errors.New("request has no IAM credentials")
+	}
+
+	switch request.IAMAuth.Provider {
+	case IAMAuthProviderAWSRDS:
+		address := request.BaseURL
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			address = net.JoinHostPort(address, defaultPort)
+		}
+
+		credentialsProvider := credentials.NewStaticCredentialsProvider(
+			request.IAMAuth.AccessKeyID, request.Password, "",
+		)
+
+		return BuildRDSAuthToken(ctx, address, request.IAMAuth.Region, request.Username, credentialsProvider, time.Now())
+	default:
+		return "", fmt.Errorf("unsupported IAM auth provider %q", request.IAMAuth.Provider)
+	}
+}
+
+// BuildRDSAuthToken returns an RDS IAM auth token for the user of the RDS instance at address,
+// in the host:port format, valid for 15 minutes from signingTime.
+//
+// The token is a SigV4 presigned URL of the connect action, without its scheme.
+func BuildRDSAuthToken(
+	ctx context.Context,
+	address, region, user string,
+	credentialsProvider aws.CredentialsProvider,
+	signingTime time.Time,
+) (string, error) {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return "", fmt.Errorf("address must be in the host:port format: %w", err)
+	}
+
+	creds, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+
+	query := url.Values{
+		"Action":        []string{"connect"},
+		"DBUser":        []string{user},
+		"X-Amz-Expires": []string{strconv.Itoa(int(rdsAuthTokenExpiry.Seconds()))},
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, "https://"+address+"/?"+query.Encode(), nil,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	signedURL, _, err := v4.NewSigner().PresignHTTP(
+		ctx, creds, req, emptyPayloadHash, "rds-db", region, signingTime,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign auth token: %w", err)
+	}
+
+	return strings.TrimPrefix(signedURL, "https://"), nil
+}
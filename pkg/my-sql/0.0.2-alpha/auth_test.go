@@ -0,0 +1,209 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package mysql_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	mysql_0_0_2_alpha "github.com/sgnl-ai/adapters/pkg/my-sql/0.0.2-alpha"
+)
+
+// nolint: lll
+func TestBuildRDSAuthToken(t *testing.T) {
+	tests := map[string]struct {
+		address     string
+		credentials aws.CredentialsProvider
+		wantToken   string
+		wantErr     bool
+	}{
+		"valid": {
+			address: "db.example.com:3306",
+			credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+				return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+			}),
+			wantToken: "db.example.com:3306/?Action=connect&DBUser=sgnl&X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Credential=AKID%2F20260101%2Fus-west-2%2Frds-db%2Faws4_request&X-Amz-Date=20260101T000000Z&X-Amz-Expires=900&X-Amz-SignedHeaders=host&X-Amz-Signature=",
+		},
+		"address_without_port": {
+			address: "db.example.com",
+			credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+				return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+			}),
+			wantErr: true,
+		},
+		"credentials_error": {
+			address: "db.example.com:3306",
+			credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+				return aws.Credentials{}, errors.New("no credentials")
+			}),
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotToken, gotErr := mysql_0_0_2_alpha.BuildRDSAuthToken(
+				t.Context(), tt.address, "us-west-2", "sgnl", tt.credentials, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			// The signature is a 64 characters long hex encoded HMAC.
+			if len(gotToken) != len(tt.wantToken)+64 || gotToken[:len(tt.wantToken)] != tt.wantToken {
+				t.Errorf("gotToken: %v, want prefix: %v", gotToken, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestTLSConfigClientTLSConfig(t *testing.T) {
+	tests := map[string]struct {
+		config                 *mysql_0_0_2_alpha.TLSConfig
+		wantInsecureSkipVerify bool
+		wantVerifyConnection   bool
+		wantServerName         string
+		wantErr                bool
+	}{
+		"require": {
+			config:                 &mysql_0_0_2_alpha.TLSConfig{Mode: mysql_0_0_2_alpha.TLSModeRequire},
+			wantInsecureSkipVerify: true,
+		},
+		"verify_ca": {
+			config:                 &mysql_0_0_2_alpha.TLSConfig{Mode: mysql_0_0_2_alpha.TLSModeVerifyCA},
+			wantInsecureSkipVerify: true,
+			wantVerifyConnection:   true,
+		},
+		"verify_identity": {
+			config:         &mysql_0_0_2_alpha.TLSConfig{Mode: mysql_0_0_2_alpha.TLSModeVerifyIdentity},
+			wantServerName: "db.example.com",
+		},
+		"invalid_mode": {
+			config:  &mysql_0_0_2_alpha.TLSConfig{Mode: "prefer"},
+			wantErr: true,
+		},
+		"invalid_certificate_chain": {
+			config: &mysql_0_0_2_alpha.TLSConfig{
+				Mode:             mysql_0_0_2_alpha.TLSModeVerifyIdentity,
+				CertificateChain: "not base64",
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := tt.config.ClientTLSConfig("db.example.com:3306")
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.InsecureSkipVerify != tt.wantInsecureSkipVerify {
+				t.Errorf("InsecureSkipVerify: got %v, want %v", got.InsecureSkipVerify, tt.wantInsecureSkipVerify)
+			}
+
+			if (got.VerifyConnection != nil) != tt.wantVerifyConnection {
+				t.Errorf("VerifyConnection set: got %v, want %v", got.VerifyConnection != nil, tt.wantVerifyConnection)
+			}
+
+			if got.ServerName != tt.wantServerName {
+				t.Errorf("ServerName: got %v, want %v", got.ServerName, tt.wantServerName)
+			}
+
+			if tt.wantVerifyConnection {
+				if err := got.VerifyConnection(tls.ConnectionState{}); err == nil {
+					t.Errorf("expected VerifyConnection to fail without peer certificates")
+				}
+			}
+		})
+	}
+}
+
+// nolint: lll
+func TestDefaultAuthTokenProviderAuthToken(t *testing.T) {
+	tests := map[string]struct {
+		request         *mysql_0_0_2_alpha.Request
+		wantTokenPrefix string
+		wantErr         bool
+	}{
+		"aws_rds_signed_with_request_credentials": {
+			request: &mysql_0_0_2_alpha.Request{
+				BaseURL:  "db.example.com",
+				Username: "sgnl",
+				Password: "SECRET",
+				IAMAuth: &mysql_0_0_2_alpha.IAMAuthConfig{
+					Provider:    mysql_0_0_2_alpha.IAMAuthProviderAWSRDS,
+					Region:      "us-west-2",
+					AccessKeyID: "AKID",
+				},
+			},
+			wantTokenPrefix: "db.example.com:3306/?Action=connect&DBUser=sgnl&X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Credential=AKID%2F",
+		},
+		"missing_credentials": {
+			request: &mysql_0_0_2_alpha.Request{
+				BaseURL:  "db.example.com:3306",
+				Username: "sgnl",
+				IAMAuth: &mysql_0_0_2_alpha.IAMAuthConfig{
+					Provider:    mysql_0_0_2_alpha.IAMAuthProviderAWSRDS,
+					Region:      "us-west-2",
+					AccessKeyID: "AKID",
+				},
+			},
+			wantErr: true,
+		},
+		"unsupported_provider": {
+			request: &mysql_0_0_2_alpha.Request{
+				BaseURL:  "db.example.com:3306",
+				Username: "sgnl@example.iam",
+				Password: "ya29.token",
+				IAMAuth: &mysql_0_0_2_alpha.IAMAuthConfig{
+					Provider: "CLOUD_SQL",
+				},
+			},
+			wantErr: true,
+		},
+		"missing_iam_auth": {
+			request: &mysql_0_0_2_alpha.Request{
+				BaseURL:  "db.example.com:3306",
+				Username: "sgnl",
+				Password: "SECRET",
+			},
+			wantErr: true,
+		},
+	}
+
+	provider := mysql_0_0_2_alpha.NewDefaultAuthTokenProvider()
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotToken, gotErr := provider.AuthToken(t.Context(), tt.request)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if !strings.HasPrefix(gotToken, tt.wantTokenPrefix) {
+				t.Errorf("gotToken: %v, want prefix: %v", gotToken, tt.wantTokenPrefix)
+			}
+		})
+	}
+}
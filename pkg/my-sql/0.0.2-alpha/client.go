@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/go-sql-driver/mysql"
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapters/pkg/condexpr"
@@ -49,19 +50,60 @@ type Request struct {
 	// UniqueAttributeExternalID is used to specify the unique ID that should be used when ordering results from
 	// the specified table.
 	UniqueAttributeExternalID string `json:"uniqueAttributeExternalID"`
+
+	// CustomQuery is the query backing the entity, if configured. If set, rows are selected from the
+	// results of this query instead of from the table named after the entity, and UniqueAttributeExternalID
+	// is the keyset column of the query.
+	CustomQuery *CustomQuery `json:"customQuery,omitempty"`
+
+	// TLS is the TLS configuration used to connect to the MySQL instance. If nil, TLS is not used.
+	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// IAMAuth is the cloud IAM authentication configuration. If set, Password is replaced by an auth token
+	// before connecting to the MySQL instance.
+	IAMAuth *IAMAuthConfig `json:"iamAuth,omitempty"`
+
+	// SchemaIntrospection enables validating and converting attribute values based on the column types
+	// of the entity table. Ignored if CustomQuery is set.
+	SchemaIntrospection bool `json:"schemaIntrospection,omitempty"`
 }
 
 // DatasourceName to connect to.
-func (r *Request) DatasourceName() string {
-	return fmt.Sprintf("%s:%s@tcp(%s)/%s",
-		r.Username, r.Password, r.BaseURL, r.Database,
-	)
+func (r *Request) DatasourceName() (string, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = r.Username
+	cfg.Passwd = r.Password
+	cfg.Net = "tcp"
+	cfg.Addr = r.BaseURL
+	cfg.DBName = r.Database
+
+	if r.TLS != nil {
+		name, err := r.TLS.registerTLSConfig(r.BaseURL)
+		if err != nil {
+			return "", fmt.Errorf("invalid TLS config: %w", err)
+		}
+
+		cfg.TLSConfig = name
+	}
+
+	// Auth tokens are sent with the cleartext authentication plugin, which is only safe over TLS
+	// to a server whose identity is verified.
+	if r.IAMAuth != nil {
+		if r.TLS == nil || r.TLS.Mode != TLSModeVerifyIdentity {
+			return "", fmt.Errorf("IAM auth requires the %s TLS mode", TLSModeVerifyIdentity)
+		}
+
+		cfg.AllowCleartextPasswords = true
+	}
+
+	return cfg.FormatDSN(), nil
 }
 
 // SimpleSQLValidation performs simple validation on specific fields to prevent SQL Ingestion attacks,
 // since we can't use table names or column names in prepared queries which leaves us vulnerable.
 func (r *Request) SimpleSQLValidation() *framework.Error {
-	if valid := validSQLIdentifier.MatchString(r.EntityConfig.ExternalId); !valid {
+	// The table name is not used in the query of entities backed by a custom query.
+	if valid := validSQLIdentifier.MatchString(r.EntityConfig.ExternalId); !valid && r.CustomQuery == nil {
 		return &framework.Error{
 			Message: "SQL table name validation failed: unsupported characters found or length is not in range 1-128.",
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
@@ -0,0 +1,111 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package mysql_test
+
+import (
+	"testing"
+
+	mysql_0_0_2_alpha "github.com/sgnl-ai/adapters/pkg/my-sql/0.0.2-alpha"
+)
+
+func TestCustomQueryValidate(t *testing.T) {
+	tests := map[string]struct {
+		query      string
+		parameters []any
+		wantErr    string
+	}{
+		"select": {
+			query:      "SELECT id, name FROM users WHERE app = ?",
+			parameters: []any{"acme"},
+		},
+		"placeholder_and_semicolon_in_string": {
+			query:      "SELECT * FROM t WHERE note = 'why?;' AND app = ?",
+			parameters: []any{"acme"},
+		},
+		"escaped_quotes_in_strings": {
+			query: `SELECT * FROM t WHERE note = 'it''s?' OR note = "say \"hi?\"" OR note = 'a\'?'`,
+		},
+		"placeholder_in_quoted_identifier": {
+			query: "SELECT `why?` AS id FROM t",
+		},
+		"leading_comments": {
+			query:      "-- users of an app\n# with ? in comments\n/* ; */ SELECT * FROM users WHERE app = ?",
+			parameters: []any{"acme"},
+		},
+		"trailing_comment": {
+			query: "SELECT * FROM users --",
+		},
+		"double_dash_without_whitespace_is_code": {
+			query:      "SELECT id, 1--? AS n FROM users",
+			parameters: []any{1},
+		},
+		"with_cte": {
+			query:      "WITH admins AS (SELECT * FROM users WHERE role = ?) SELECT * FROM admins",
+			parameters: []any{"admin"},
+		},
+		"parenthesized_select": {
+			query: "(SELECT id FROM users) UNION (SELECT id FROM groups)",
+		},
+		"not_set": {
+			query:   "  ",
+			wantErr: "query is not set",
+		},
+		"not_select": {
+			query:   "DELETE FROM users",
+			wantErr: "query must be a SELECT statement",
+		},
+		"select_in_comment_only": {
+			query:   "/* SELECT */ DELETE FROM users",
+			wantErr: "query must be a SELECT statement",
+		},
+		"select_prefix_of_identifier": {
+			query:   "SELECTED",
+			wantErr: "query must be a SELECT statement",
+		},
+		"multiple_statements": {
+			query:   "SELECT * FROM users; DROP TABLE users",
+			wantErr: "query must be a single statement",
+		},
+		"placeholder_count_mismatch": {
+			query:   "SELECT * FROM t WHERE note = 'why?' AND app = ?",
+			wantErr: "query has 1 placeholders but 0 parameters are set",
+		},
+		"unterminated_string": {
+			query:   "SELECT * FROM t WHERE note = 'why?",
+			wantErr: "query is invalid: quoted ' is not terminated",
+		},
+		"unterminated_comment": {
+			query:   "SELECT * FROM t /* comment",
+			wantErr: "query is invalid: comment is not terminated",
+		},
+		"executable_comment": {
+			query:   "SELECT * FROM t /*!80000 ; DROP TABLE t */",
+			wantErr: "query is invalid: executable comments are not supported",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			query := &mysql_0_0_2_alpha.CustomQuery{
+				Query:        tt.query,
+				Parameters:   tt.parameters,
+				KeysetColumn: "id",
+			}
+
+			gotErr := query.Validate()
+
+			if tt.wantErr == "" {
+				if gotErr != nil {
+					t.Errorf("gotErr: %v, wantErr: nil", gotErr)
+				}
+
+				return
+			}
+
+			if gotErr == nil || gotErr.Error() != tt.wantErr {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
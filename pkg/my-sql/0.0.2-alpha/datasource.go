@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
@@ -25,12 +26,17 @@ import (
 
 type Datasource struct {
 	Client SQLClient
+
+	// AuthTokenProvider generates the auth tokens of requests with IAM auth.
+	// If nil, tokens are generated with the credentials of the request.
+	AuthTokenProvider AuthTokenProvider
 }
 
 // NewClient returns a Client to query the datasource.
 func NewClient(client SQLClient) Client {
 	return &Datasource{
-		Client: client,
+		Client:            client,
+		AuthTokenProvider: NewDefaultAuthTokenProvider(),
 	}
 }
 
@@ -189,7 +195,15 @@ func (d *Datasource) Request(ctx context.Context, request *Request) (*Response,
 		return nil, err
 	}
 
-	db, err := d.Client.Connect(request.DatasourceName())
+	datasourceName, err := request.DatasourceName()
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to construct datasource name: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	db, err := d.Client.Connect(datasourceName)
 	if err != nil {
 		logger.Error("Failed to connect to datasource",
 			fields.SGNLEventTypeError(),
@@ -202,6 +216,24 @@ func (d *Datasource) Request(ctx context.Context, request *Request) (*Response,
 		}
 	}
 
+	var columnTypes SQLColumnTypes
+
+	if request.SchemaIntrospection && request.CustomQuery == nil {
+		var frameworkErr *framework.Error
+
+		columnTypes, frameworkErr = d.introspectColumnTypes(db, request)
+		if frameworkErr != nil {
+			logger.Error("Failed to introspect the entity table",
+				fields.SGNLEventTypeError(),
+				zap.String("error_message", frameworkErr.Message),
+			)
+
+			db.Close()
+
+			return nil, frameworkErr
+		}
+	}
+
 	query, args, err := ConstructQuery(request)
 	if err != nil {
 		return nil, &framework.Error{
@@ -244,7 +276,7 @@ func (d *Datasource) Request(ctx context.Context, request *Request) (*Response,
 	}()
 
 	// Parse the rows to a list of objects and the total remaining count
-	objs, totalRemaining, frameworkErr := ParseResponse(rows, request)
+	objs, totalRemaining, frameworkErr := ParseResponse(rows, request, columnTypes)
 	if frameworkErr != nil {
 		return nil, frameworkErr
 	}
@@ -295,6 +327,12 @@ func (d *Datasource) Request(ctx context.Context, request *Request) (*Response,
 
 // GetPage for requesting data from a datasource.
 func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	if request.IAMAuth != nil {
+		if err := d.setAuthToken(ctx, request); err != nil {
+			return nil, err
+		}
+	}
+
 	// Make sure if the connector context is set and client can proxy the request.
 	if d.Client.IsProxied() {
 		if ci, ok := connector.FromContext(ctx); ok {
@@ -305,8 +343,71 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	return d.Request(ctx, request)
 }
 
+// setAuthToken replaces the password of a request with IAM auth by a newly generated auth token.
+func (d *Datasource) setAuthToken(ctx context.Context, request *Request) *framework.Error {
+	provider := d.AuthTokenProvider
+	if provider == nil {
+		provider = NewDefaultAuthTokenProvider()
+	}
+
+	token, err := provider.AuthToken(ctx, request)
+	if err != nil {
+		zaplogger.FromContext(ctx).Error("Failed to generate IAM auth token",
+			fields.SGNLEventTypeError(),
+			zap.Error(err),
+		)
+
+		return &framework.Error{
+			Message: fmt.Sprintf("Failed to generate IAM auth token: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+		}
+	}
+
+	request.Password = token
+
+	return nil
+}
+
+// introspectColumnTypes reads the column types of the entity table and validates the requested attributes
+// against them.
+func (d *Datasource) introspectColumnTypes(db *sql.DB, request *Request) (SQLColumnTypes, *framework.Error) {
+	query, args, err := ConstructColumnTypesQuery(request)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to construct column types query: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+	}
+
+	rows, err := d.Client.Query(db, query, args...)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to query column types: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+	}
+	defer rows.Close()
+
+	columnTypes, err := ParseColumnTypes(rows)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to parse column types: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+	}
+
+	if frameworkErr := ValidateAttributeTypes(columnTypes, request); frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	return columnTypes, nil
+}
+
 // ParseResponse for parsing the SQL query response.
-func ParseResponse(rows *sql.Rows, request *Request) ([]map[string]any, int64, *framework.Error) {
+// If columnTypes is set, values are converted based on the data types of their columns.
+func ParseResponse(
+	rows *sql.Rows, request *Request, columnTypes SQLColumnTypes,
+) ([]map[string]any, int64, *framework.Error) {
 	objects := make([]map[string]any, 0)
 
 	var totalRemaining int64 = -1 // -1 indicates that count could not be parsed, or the count column is unavailable
@@ -384,6 +485,22 @@ func ParseResponse(rows *sql.Rows, request *Request) ([]map[string]any, int64, *
 				continue
 			}
 
+			if dataType, ok := columnTypes[strings.ToLower(columnName)]; ok {
+				value, keep, convertErr := ConvertColumnValue(*b, attribute.Type, dataType)
+				if convertErr != nil {
+					return nil, -1, &framework.Error{
+						Message: fmt.Sprintf("Failed to convert attribute: (%s) %v.", columnName, convertErr),
+						Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ATTRIBUTE_TYPE,
+					}
+				}
+
+				if keep {
+					objects[idx][columnName] = value
+				}
+
+				continue
+			}
+
 			str := string(*b)
 
 			var castErr error
@@ -200,6 +200,148 @@ func TestGivenRequestWithoutConnectorCtxWhenGetPageRequestedThenSQLResponseStatu
 	testutil.ValidateLogOutput(t, observedLogs, expectedLogs)
 }
 
+type mockAuthTokenProvider struct {
+	token string
+	err   error
+}
+
+func (p *mockAuthTokenProvider) AuthToken(_ context.Context, _ *Request) (string, error) {
+	return p.token, p.err
+}
+
+func TestGivenRequestWithIAMAuthWhenGetPageRequestedThenAuthTokenIsUsedAsPassword(t *testing.T) {
+	tests := map[string]struct {
+		tokenProvider   *mockAuthTokenProvider
+		wantDSNContains string
+		wantErr         *framework.Error
+	}{
+		"token_used_as_password": {
+			tokenProvider:   &mockAuthTokenProvider{token: "testtoken"},
+			wantDSNContains: "testuser:testtoken@tcp(localhost:3306)/testdb?allowCleartextPasswords=true&tls=",
+		},
+		"token_provider_error": {
+			tokenProvider: &mockAuthTokenProvider{err: errors.New("no credentials")},
+			wantErr: &framework.Error{
+				Message: "Failed to generate IAM auth token: no credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotDSN string
+
+			ds := Datasource{
+				Client: &mockSQLClient{
+					mockConnect: func(name string) (*sql.DB, error) {
+						gotDSN = name
+
+						return nil, errors.New("connect not supported")
+					},
+				},
+				AuthTokenProvider: tt.tokenProvider,
+			}
+
+			_, err := ds.GetPage(t.Context(), &Request{
+				EntityConfig: framework.EntityConfig{
+					ExternalId: "users",
+				},
+				UniqueAttributeExternalID: "id",
+				Username:                  "testuser",
+				Password:                  "testaccesstoken",
+				BaseURL:                   "localhost:3306",
+				Database:                  "testdb",
+				TLS:                       &TLSConfig{Mode: TLSModeVerifyIdentity},
+				IAMAuth: &IAMAuthConfig{
+					Provider:    IAMAuthProviderAWSRDS,
+					Region:      "us-west-2",
+					AccessKeyID: "AKID",
+				},
+			})
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+
+				return
+			}
+
+			assert.Contains(t, gotDSN, tt.wantDSNContains)
+		})
+	}
+}
+
+func TestGivenRequestWithSchemaIntrospectionWhenGetPageRequestedThenValuesAreConvertedToAttributeTypes(t *testing.T) {
+	tests := map[string]struct {
+		activeType  framework.AttributeType
+		wantObjects []map[string]any
+		wantErr     *framework.Error
+	}{
+		"compatible_attribute_types": {
+			activeType: framework.AttributeTypeBool,
+			wantObjects: []map[string]any{
+				{"id": "1", "active": true},
+				{"id": "2", "active": false},
+			},
+		},
+		"incompatible_attribute_type": {
+			activeType: framework.AttributeTypeDateTime,
+			wantErr: &framework.Error{
+				Message: "Attribute active of type DateTime can't be read from column of type TINYINT.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ATTRIBUTE_TYPE,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, _ := sqlmock.New()
+
+			mock.ExpectQuery(regexp.QuoteMeta("FROM `INFORMATION_SCHEMA`.`COLUMNS`")).
+				WithArgs("testdb", "users").
+				WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "DATA_TYPE"}).
+					AddRow("id", "varchar").
+					AddRow("active", "tinyint"))
+			mock.ExpectQuery(regexp.QuoteMeta("FROM `users`")).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "active", "total_remaining_rows"}).
+					AddRow("1", "2", 2).
+					AddRow("2", "0", 2))
+
+			ds := Datasource{
+				Client: &mockSQLClient{
+					mockDB: db,
+					mockQuery: func(db *sql.DB, query string, args ...any) (*sql.Rows, error) {
+						return db.Query(query, args...)
+					},
+				},
+			}
+
+			resp, err := ds.GetPage(t.Context(), &Request{
+				EntityConfig: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: []*framework.AttributeConfig{
+						{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+						{ExternalId: "active", Type: tt.activeType},
+					},
+				},
+				UniqueAttributeExternalID: "id",
+				PageSize:                  100,
+				Database:                  "testdb",
+				SchemaIntrospection:       true,
+			})
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.wantObjects, resp.Objects)
+		})
+	}
+}
+
 func TestGivenRequestWithConnectorCtxAndWithoutProxyWhenGetPageRequestedThenSQLResponseStatusIsOk(t *testing.T) {
 	// Arrange
 	db, mock, _ := sqlmock.New()
@@ -16,6 +16,9 @@ import (
 // that provides the total count of rows matching the query conditions.
 const TotalRemainingRowsColumn = "total_remaining_rows"
 
+// CustomQueryAlias is the alias of the derived table of entities backed by a custom query.
+const CustomQueryAlias = "custom_query"
+
 func ConstructQuery(request *Request) (string, []any, error) {
 	if request == nil {
 		return "", nil, errors.New("nil request provided")
@@ -23,11 +26,18 @@ func ConstructQuery(request *Request) (string, []any, error) {
 
 	dialect := goqu.Dialect("mysql")
 
+	// Entities backed by a custom query select from the results of the query as a derived table.
+	// The parameters of the query are bound to its placeholders, before any other argument.
+	var from any = request.EntityConfig.ExternalId
+	if request.CustomQuery != nil {
+		from = goqu.L("("+request.CustomQuery.Query+")", request.CustomQuery.Parameters...).As(CustomQueryAlias)
+	}
+
 	expr := dialect.Select(
 		"*",
 		goqu.Cast(goqu.I(request.UniqueAttributeExternalID), "CHAR(50)").As("str_id"),
 		goqu.L("COUNT(*) OVER()").As(TotalRemainingRowsColumn),
-	).From(request.EntityConfig.ExternalId).Prepared(true)
+	).From(from).Prepared(true)
 
 	if request.Cursor != nil && *request.Cursor != "" {
 		expr = expr.Where(goqu.Cast(goqu.I(request.UniqueAttributeExternalID), "CHAR(50)").Gt(*request.Cursor))
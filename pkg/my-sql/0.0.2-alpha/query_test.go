@@ -259,6 +259,34 @@ func TestConstructQuery(t *testing.T) {
 				int64(100),
 			},
 		},
+		"custom_query_with_filter": {
+			inputRequest: &mysql_0_0_2_alpha.Request{
+				EntityConfig: framework.EntityConfig{
+					ExternalId: "user_roles",
+				},
+				Filter: testutil.GenPtr(condexpr.Condition{
+					Field:    "name",
+					Operator: "=",
+					Value:    "admin",
+				}),
+				CustomQuery: &mysql_0_0_2_alpha.CustomQuery{
+					Query:        "SELECT ur.id, r.name FROM user_roles ur JOIN roles r ON r.id = ur.role_id WHERE r.app = ? AND r.level > ?",
+					Parameters:   []any{"acme", float64(2)},
+					KeysetColumn: "id",
+				},
+				UniqueAttributeExternalID: "id",
+				PageSize:                  100,
+				Cursor:                    testutil.GenPtr("500"),
+			},
+			wantQuery: "SELECT *, CAST(`id` AS CHAR(50)) AS `str_id`, COUNT(*) OVER() AS `total_remaining_rows` FROM (SELECT ur.id, r.name FROM user_roles ur JOIN roles r ON r.id = ur.role_id WHERE r.app = ? AND r.level > ?) AS `custom_query` WHERE ((CAST(`id` AS CHAR(50)) > ?) AND (`name` = ?)) ORDER BY `str_id` ASC LIMIT ?",
+			wantAttrs: []any{
+				"acme",
+				float64(2),
+				"500",
+				"admin",
+				int64(100),
+			},
+		},
 	}
 
 	for name, tt := range tests {
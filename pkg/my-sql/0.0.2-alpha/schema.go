@@ -0,0 +1,221 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// MySQL data types, as reported in the DATA_TYPE column of INFORMATION_SCHEMA.COLUMNS, grouped by
+// the attribute types they can be converted to.
+var (
+	integerDataTypes  = []string{"tinyint", "smallint", "mediumint", "int", "integer", "bigint", "year"}
+	decimalDataTypes  = []string{"decimal", "numeric", "float", "double", "real"}
+	dateTimeDataTypes = []string{"date", "datetime", "timestamp"}
+	textDataTypes     = []string{"char", "varchar", "tinytext", "text", "mediumtext", "longtext", "enum", "set"}
+)
+
+// compatibleDataTypes maps attribute types to the data types of the columns they can be read from.
+// String attributes can be read from columns of any data type.
+var compatibleDataTypes = map[framework.AttributeType][][]string{
+	framework.AttributeTypeBool:     {integerDataTypes, textDataTypes, {"bit"}},
+	framework.AttributeTypeInt64:    {integerDataTypes, decimalDataTypes, textDataTypes},
+	framework.AttributeTypeDouble:   {integerDataTypes, decimalDataTypes, textDataTypes},
+	framework.AttributeTypeDateTime: {dateTimeDataTypes, textDataTypes},
+	framework.AttributeTypeDuration: {integerDataTypes, textDataTypes, {"time"}},
+}
+
+// attributeTypeNames maps attribute types to their names in error messages.
+var attributeTypeNames = map[framework.AttributeType]string{
+	framework.AttributeTypeBool:     "Bool",
+	framework.AttributeTypeDateTime: "DateTime",
+	framework.AttributeTypeDouble:   "Double",
+	framework.AttributeTypeDuration: "Duration",
+	framework.AttributeTypeInt64:    "Int64",
+	framework.AttributeTypeString:   "String",
+}
+
+// mysqlDateTimeFormat is the format of DATETIME and TIMESTAMP values returned by MySQL.
+// The fractional seconds are only returned for columns with a fractional seconds precision.
+const mysqlDateTimeFormat = "2006-01-02 15:04:05.999999"
+
+// ConstructColumnTypesQuery returns the query selecting the name and data type of each column of the
+// table of the requested entity from INFORMATION_SCHEMA.
+func ConstructColumnTypesQuery(request *Request) (string, []any, error) {
+	if request == nil {
+		return "", nil, errors.New("nil request provided")
+	}
+
+	return goqu.Dialect("mysql").
+		From(goqu.S("INFORMATION_SCHEMA").Table("COLUMNS")).
+		Select("COLUMN_NAME", "DATA_TYPE").
+		Where(
+			goqu.C("TABLE_SCHEMA").Eq(request.Database),
+			goqu.C("TABLE_NAME").Eq(request.EntityConfig.ExternalId),
+		).
+		Prepared(true).
+		ToSQL()
+}
+
+// ParseColumnTypes reads the rows returned by the query constructed by ConstructColumnTypesQuery.
+// The keys of the returned map are the lowercase column names, as MySQL column names are case-insensitive.
+func ParseColumnTypes(rows *sql.Rows) (SQLColumnTypes, error) {
+	columnTypes := make(SQLColumnTypes)
+
+	for rows.Next() {
+		var columnName, dataType string
+
+		if err := rows.Scan(&columnName, &dataType); err != nil {
+			return nil, err
+		}
+
+		columnTypes[strings.ToLower(columnName)] = strings.ToLower(dataType)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return columnTypes, nil
+}
+
+// ValidateAttributeTypes validates that each requested attribute matches a column of the entity table,
+// and that the data type of the column can be converted to the type of the attribute.
+func ValidateAttributeTypes(columnTypes SQLColumnTypes, request *Request) *framework.Error {
+	if len(columnTypes) == 0 {
+		return &framework.Error{
+			Message: fmt.Sprintf("Table %s does not exist in database %s.", request.EntityConfig.ExternalId, request.Database),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	for _, attribute := range request.EntityConfig.Attributes {
+		if attribute == nil {
+			continue
+		}
+
+		dataType, ok := columnTypes[strings.ToLower(attribute.ExternalId)]
+		if !ok {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s does not match any column of table %s.", attribute.ExternalId, request.EntityConfig.ExternalId,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+
+		if !isCompatibleDataType(attribute.Type, dataType) {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s of type %s can't be read from column of type %s.",
+					attribute.ExternalId, attributeTypeNames[attribute.Type], strings.ToUpper(dataType),
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ATTRIBUTE_TYPE,
+			}
+		}
+	}
+
+	return nil
+}
+
+func isCompatibleDataType(attributeType framework.AttributeType, dataType string) bool {
+	if attributeType == framework.AttributeTypeString {
+		return true
+	}
+
+	for _, dataTypes := range compatibleDataTypes[attributeType] {
+		if slices.Contains(dataTypes, dataType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConvertColumnValue converts the raw value of a column of the given data type to the attribute type.
+// Returns false if the value must be omitted, i.e. for the zero dates MySQL allows in place of NULL.
+func ConvertColumnValue(value []byte, attributeType framework.AttributeType, dataType string) (any, bool, error) {
+	str := string(value)
+
+	switch attributeType {
+	case framework.AttributeTypeBool:
+		switch {
+		case dataType == "bit":
+			// BIT values are returned as big-endian binary strings.
+			for _, b := range value {
+				if b != 0 {
+					return true, true, nil
+				}
+			}
+
+			return false, true, nil
+		case slices.Contains(integerDataTypes, dataType):
+			// BOOLEAN columns are TINYINT(1) columns, which may hold any value of the TINYINT range.
+			i, err := strconv.ParseInt(str, 10, 64)
+
+			return i != 0, true, err
+		default:
+			b, err := strconv.ParseBool(str)
+
+			return b, true, err
+		}
+	case framework.AttributeTypeInt64:
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, false, err
+		}
+
+		// DECIMAL values are returned with their scale, e.g. "42.00".
+		if f != math.Trunc(f) {
+			return nil, false, fmt.Errorf("value %s of %s column is not an integer", str, strings.ToUpper(dataType))
+		}
+
+		return f, true, nil
+	case framework.AttributeTypeDouble:
+		f, err := strconv.ParseFloat(str, 64)
+
+		return f, true, err
+	case framework.AttributeTypeDateTime:
+		return convertDateTimeValue(str, dataType)
+	case framework.AttributeTypeString, framework.AttributeTypeDuration:
+		return str, true, nil
+	default:
+		return nil, false, fmt.Errorf("unsupported attribute type %d", attributeType)
+	}
+}
+
+// convertDateTimeValue validates the value of a DATE, DATETIME or TIMESTAMP column. Values are returned
+// unchanged, without a time zone, so that the configured local time zone offset is applied to them.
+func convertDateTimeValue(str, dataType string) (any, bool, error) {
+	var layout string
+
+	switch dataType {
+	case "date":
+		layout = time.DateOnly
+	case "datetime", "timestamp":
+		layout = mysqlDateTimeFormat
+	default:
+		return str, true, nil
+	}
+
+	if strings.HasPrefix(str, "0000-00-00") {
+		return nil, false, nil
+	}
+
+	if _, err := time.Parse(layout, str); err != nil {
+		return nil, false, fmt.Errorf("value %s of %s column is not a valid date: %w", str, strings.ToUpper(dataType), err)
+	}
+
+	return str, true, nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package mysql_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	mysql_0_0_2_alpha "github.com/sgnl-ai/adapters/pkg/my-sql/0.0.2-alpha"
+)
+
+func TestConstructColumnTypesQuery(t *testing.T) {
+	gotQuery, gotArgs, gotErr := mysql_0_0_2_alpha.ConstructColumnTypesQuery(&mysql_0_0_2_alpha.Request{
+		Database: "sgnl",
+		EntityConfig: framework.EntityConfig{
+			ExternalId: "users",
+		},
+	})
+	if gotErr != nil {
+		t.Fatalf("unexpected error: %v", gotErr)
+	}
+
+	wantQuery := "SELECT `COLUMN_NAME`, `DATA_TYPE` FROM `INFORMATION_SCHEMA`.`COLUMNS` " +
+		"WHERE ((`TABLE_SCHEMA` = ?) AND (`TABLE_NAME` = ?))"
+	if gotQuery != wantQuery {
+		t.Errorf("gotQuery: %v, wantQuery: %v", gotQuery, wantQuery)
+	}
+
+	if wantArgs := []any{"sgnl", "users"}; !reflect.DeepEqual(gotArgs, wantArgs) {
+		t.Errorf("gotArgs: %v, wantArgs: %v", gotArgs, wantArgs)
+	}
+}
+
+func TestValidateAttributeTypes(t *testing.T) {
+	columnTypes := mysql_0_0_2_alpha.SQLColumnTypes{
+		"id":          "varchar",
+		"active":      "tinyint",
+		"balance":     "decimal",
+		"createdat":   "datetime",
+		"permissions": "json",
+	}
+
+	tests := map[string]struct {
+		columnTypes mysql_0_0_2_alpha.SQLColumnTypes
+		attributes  []*framework.AttributeConfig
+		wantErr     *framework.Error
+	}{
+		"compatible_types": {
+			columnTypes: columnTypes,
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString},
+				{ExternalId: "active", Type: framework.AttributeTypeBool},
+				{ExternalId: "balance", Type: framework.AttributeTypeDouble},
+				{ExternalId: "createdAt", Type: framework.AttributeTypeDateTime},
+				{ExternalId: "permissions", Type: framework.AttributeTypeString},
+			},
+		},
+		"missing_table": {
+			columnTypes: mysql_0_0_2_alpha.SQLColumnTypes{},
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString},
+			},
+			wantErr: &framework.Error{
+				Message: "Table users does not exist in database sgnl.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"missing_column": {
+			columnTypes: columnTypes,
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "email", Type: framework.AttributeTypeString},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute email does not match any column of table users.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"incompatible_datetime_column": {
+			columnTypes: columnTypes,
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "createdAt", Type: framework.AttributeTypeInt64},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute createdAt of type Int64 can't be read from column of type DATETIME.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ATTRIBUTE_TYPE,
+			},
+		},
+		"incompatible_decimal_column": {
+			columnTypes: columnTypes,
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "balance", Type: framework.AttributeTypeDateTime},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute balance of type DateTime can't be read from column of type DECIMAL.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ATTRIBUTE_TYPE,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := mysql_0_0_2_alpha.ValidateAttributeTypes(tt.columnTypes, &mysql_0_0_2_alpha.Request{
+				Database: "sgnl",
+				EntityConfig: framework.EntityConfig{
+					ExternalId: "users",
+					Attributes: tt.attributes,
+				},
+			})
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConvertColumnValue(t *testing.T) {
+	tests := map[string]struct {
+		value         []byte
+		attributeType framework.AttributeType
+		dataType      string
+		wantValue     any
+		wantKeep      bool
+		wantErr       bool
+	}{
+		"bit_to_bool_true": {
+			value:         []byte{0x01},
+			attributeType: framework.AttributeTypeBool,
+			dataType:      "bit",
+			wantValue:     true,
+			wantKeep:      true,
+		},
+		"bit_to_bool_false": {
+			value:         []byte{0x00},
+			attributeType: framework.AttributeTypeBool,
+			dataType:      "bit",
+			wantValue:     false,
+			wantKeep:      true,
+		},
+		"tinyint_to_bool": {
+			value:         []byte("2"),
+			attributeType: framework.AttributeTypeBool,
+			dataType:      "tinyint",
+			wantValue:     true,
+			wantKeep:      true,
+		},
+		"varchar_to_bool": {
+			value:         []byte("false"),
+			attributeType: framework.AttributeTypeBool,
+			dataType:      "varchar",
+			wantValue:     false,
+			wantKeep:      true,
+		},
+		"decimal_to_int64": {
+			value:         []byte("42.00"),
+			attributeType: framework.AttributeTypeInt64,
+			dataType:      "decimal",
+			wantValue:     float64(42),
+			wantKeep:      true,
+		},
+		"decimal_with_fraction_to_int64": {
+			value:         []byte("42.50"),
+			attributeType: framework.AttributeTypeInt64,
+			dataType:      "decimal",
+			wantErr:       true,
+		},
+		"decimal_to_double": {
+			value:         []byte("42.50"),
+			attributeType: framework.AttributeTypeDouble,
+			dataType:      "decimal",
+			wantValue:     42.5,
+			wantKeep:      true,
+		},
+		"datetime": {
+			value:         []byte("2026-01-02 03:04:05"),
+			attributeType: framework.AttributeTypeDateTime,
+			dataType:      "datetime",
+			wantValue:     "2026-01-02 03:04:05",
+			wantKeep:      true,
+		},
+		"datetime_with_fractional_seconds": {
+			value:         []byte("2026-01-02 03:04:05.123456"),
+			attributeType: framework.AttributeTypeDateTime,
+			dataType:      "timestamp",
+			wantValue:     "2026-01-02 03:04:05.123456",
+			wantKeep:      true,
+		},
+		"zero_datetime_is_omitted": {
+			value:         []byte("0000-00-00 00:00:00"),
+			attributeType: framework.AttributeTypeDateTime,
+			dataType:      "datetime",
+			wantKeep:      false,
+		},
+		"zero_date_is_omitted": {
+			value:         []byte("0000-00-00"),
+			attributeType: framework.AttributeTypeDateTime,
+			dataType:      "date",
+			wantKeep:      false,
+		},
+		"invalid_date": {
+			value:         []byte("2026-13-01"),
+			attributeType: framework.AttributeTypeDateTime,
+			dataType:      "date",
+			wantErr:       true,
+		},
+		"json_to_string": {
+			value:         []byte(`{"admin":true}`),
+			attributeType: framework.AttributeTypeString,
+			dataType:      "json",
+			wantValue:     `{"admin":true}`,
+			wantKeep:      true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotValue, gotKeep, gotErr := mysql_0_0_2_alpha.ConvertColumnValue(tt.value, tt.attributeType, tt.dataType)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if gotKeep != tt.wantKeep {
+				t.Errorf("gotKeep: %v, wantKeep: %v", gotKeep, tt.wantKeep)
+			}
+
+			if gotKeep && !reflect.DeepEqual(gotValue, tt.wantValue) {
+				t.Errorf("gotValue: %v, wantValue: %v", gotValue, tt.wantValue)
+			}
+		})
+	}
+}
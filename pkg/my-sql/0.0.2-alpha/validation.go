@@ -5,6 +5,7 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
@@ -19,14 +20,30 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
-	if request.Auth == nil || request.Auth.Basic == nil ||
-		request.Auth.Basic.Username == "" || request.Auth.Basic.Password == "" {
+	// The password holds the credentials the auth token is generated from when IAM auth is used.
+	if request.Auth == nil || request.Auth.Basic == nil || request.Auth.Basic.Username == "" ||
+		request.Auth.Basic.Password == "" {
 		return &framework.Error{
 			Message: "Provided datasource auth is missing required basic authorization credentials.",
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 		}
 	}
 
+	// The keyset column of a custom query must be requested, as the cursor is read from its value in the
+	// last row of each page.
+	if customQuery, ok := request.Config.CustomQueries[request.Entity.ExternalId]; ok {
+		if !slices.ContainsFunc(request.Entity.Attributes, func(attribute *framework.AttributeConfig) bool {
+			return attribute.ExternalId == customQuery.KeysetColumn
+		}) {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Custom query keyset column %s is not a requested attribute of the entity.", customQuery.KeysetColumn,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+	}
+
 	if len(request.Entity.ChildEntities) > 0 {
 		return &framework.Error{
 			Message: "Requested entity does not support child entities.",
@@ -0,0 +1,226 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package normalize normalizes the values of string attributes before the objects are returned by the adapters,
+// so that objects from different datasources can be joined despite formatting differences, e.g. email
+// addresses in different cases or enum values spelled differently.
+//
+// The rules are configured per entity in the datasource config, see config.CommonConfig.
+package normalize
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
+)
+
+// AttributeRules are the normalization rules of an attribute. The rules are applied in the order of the fields.
+type AttributeRules struct {
+	// Split is the separator on which each value is split into multiple values, e.g. "," or ";".
+	// Empty and whitespace only values are removed after splitting. The attribute must be a list.
+	Split string `json:"split,omitempty"`
+
+	// TrimSpace removes the leading and trailing whitespace of each value.
+	TrimSpace bool `json:"trimSpace,omitempty"`
+
+	// Lowercase converts each value to lowercase, e.g. for email addresses.
+	Lowercase bool `json:"lowercase,omitempty"`
+
+	// Synonyms maps values to their canonical value, e.g. {"enabled": "ACTIVE", "active": "ACTIVE"}.
+	// Values without a synonym are left unchanged.
+	Synonyms map[string]string `json:"synonyms,omitempty"`
+}
+
+// EntityRules maps the external ID of the attributes of an entity to their normalization rules.
+type EntityRules map[string]*AttributeRules
+
+// RulesProvider provides the normalization rules of the entities. It is implemented by config.CommonConfig,
+// and therefore by the configs of all adapters.
+type RulesProvider interface {
+	// NormalizationRules returns the normalization rules of the entity, or nil if it has none.
+	NormalizationRules(entityExternalID string) EntityRules
+}
+
+// Validate checks that the rules can be applied to the entity and its child entities: only string attributes
+// can be normalized, and only list attributes can be split.
+func Validate(provider RulesProvider, entity *framework.EntityConfig) *framework.Error {
+	entityRules := provider.NormalizationRules(entity.ExternalId)
+
+	for _, attribute := range entity.Attributes {
+		rules, found := entityRules[attribute.ExternalId]
+		if !found || rules == nil {
+			continue
+		}
+
+		if attribute.Type != framework.AttributeTypeString {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s of entity %s must be a string to be normalized.",
+					attribute.ExternalId, entity.ExternalId,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+
+		if rules.Split != "" && !attribute.List {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s of entity %s must be a list to be split.",
+					attribute.ExternalId, entity.ExternalId,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+	}
+
+	for _, childEntity := range entity.ChildEntities {
+		if err := Validate(provider, childEntity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Normalize applies the rules to the objects of the entity and of its child entities, in place.
+func Normalize(provider RulesProvider, entity *framework.EntityConfig, objects []framework.Object) {
+	entityRules := provider.NormalizationRules(entity.ExternalId)
+
+	for _, object := range objects {
+		for attribute, rules := range entityRules {
+			if value, found := object[attribute]; found && rules != nil {
+				object[attribute] = rules.apply(value)
+			}
+		}
+
+		for _, childEntity := range entity.ChildEntities {
+			if childObjects, ok := object[childEntity.ExternalId].([]framework.Object); ok {
+				Normalize(provider, childEntity, childObjects)
+			}
+		}
+	}
+}
+
+// apply normalizes a single value or a list of values. Values which are not strings are left unchanged,
+// as they are rejected by Validate.
+func (r *AttributeRules) apply(value any) any {
+	switch v := value.(type) {
+	case string:
+		if r.Split != "" {
+			return r.applyList([]string{v})
+		}
+
+		return r.applyString(v)
+	case *string:
+		if v == nil {
+			return value
+		}
+
+		if r.Split != "" {
+			return r.applyList([]string{*v})
+		}
+
+		normalized := r.applyString(*v)
+
+		return &normalized
+	case []string:
+		return r.applyList(v)
+	case []*string:
+		values := make([]string, 0, len(v))
+
+		for _, s := range v {
+			if s != nil {
+				values = append(values, *s)
+			}
+		}
+
+		return r.applyList(values)
+	default:
+		return value
+	}
+}
+
+func (r *AttributeRules) applyList(values []string) []string {
+	if r.Split != "" {
+		split := make([]string, 0, len(values))
+
+		for _, value := range values {
+			for s := range strings.SplitSeq(value, r.Split) {
+				if strings.TrimSpace(s) != "" {
+					split = append(split, s)
+				}
+			}
+		}
+
+		values = split
+	}
+
+	normalized := make([]string, len(values))
+
+	for i, value := range values {
+		normalized[i] = r.applyString(value)
+	}
+
+	return normalized
+}
+
+func (r *AttributeRules) applyString(value string) string {
+	if r.TrimSpace {
+		value = strings.TrimSpace(value)
+	}
+
+	if r.Lowercase {
+		value = strings.ToLower(value)
+	}
+
+	if synonym, found := r.Synonyms[value]; found {
+		value = synonym
+	}
+
+	return value
+}
+
+// adapter applies the normalization rules of the request config to the objects returned by the next adapter.
+type adapter[Config any] struct {
+	next framework.Adapter[Config]
+}
+
+// NewAdapter wraps an adapter to apply the normalization rules of the request config to the objects it returns.
+// The config of the adapter must implement RulesProvider for the rules to be applied. Null attribute values are
+// omitted from the objects regardless, see commonutil.OmitNullAttributes.
+func NewAdapter[Config any](next framework.Adapter[Config]) framework.Adapter[Config] {
+	return &adapter[Config]{
+		next: next,
+	}
+}
+
+// GetPage implements framework.Adapter.
+func (a *adapter[Config]) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	var provider RulesProvider
+
+	if request.Config != nil {
+		provider, _ = any(request.Config).(RulesProvider)
+	}
+
+	if provider != nil {
+		if err := Validate(provider, &request.Entity); err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+	}
+
+	response := a.next.GetPage(ctx, request)
+	if response.Success == nil {
+		return response
+	}
+
+	commonutil.OmitNullAttributes(response.Success.Objects)
+
+	if provider != nil {
+		Normalize(provider, &request.Entity, response.Success.Objects)
+	}
+
+	return response
+}
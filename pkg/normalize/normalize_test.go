@@ -0,0 +1,197 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package normalize_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/normalize"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestNewAdapter(t *testing.T) {
+	entity := framework.EntityConfig{
+		ExternalId: "User",
+		Attributes: []*framework.AttributeConfig{
+			{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+			{ExternalId: "email", Type: framework.AttributeTypeString},
+			{ExternalId: "status", Type: framework.AttributeTypeString},
+			{ExternalId: "departments", Type: framework.AttributeTypeString, List: true},
+			{ExternalId: "aliases", Type: framework.AttributeTypeString, List: true},
+		},
+		ChildEntities: []*framework.EntityConfig{
+			{
+				ExternalId: "Manager",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "email", Type: framework.AttributeTypeString},
+				},
+			},
+		},
+	}
+
+	commonConfig := &config.CommonConfig{
+		EntityNormalization: map[string]normalize.EntityRules{
+			"User": {
+				"email": {TrimSpace: true, Lowercase: true},
+				"status": {
+					TrimSpace: true,
+					Lowercase: true,
+					Synonyms:  map[string]string{"active": "ACTIVE", "enabled": "ACTIVE", "disabled": "INACTIVE"},
+				},
+				"departments": {Split: ";", TrimSpace: true},
+				"aliases":     {Lowercase: true},
+			},
+			"Manager": {
+				"email": {Lowercase: true},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		config      *testutil.Config
+		objects     []framework.Object
+		wantObjects []framework.Object
+	}{
+		"normalized": {
+			config: &testutil.Config{CommonConfig: commonConfig},
+			objects: []framework.Object{
+				{
+					"id":          "00u1",
+					"email":       " John.Doe@Example.com ",
+					"status":      "Enabled",
+					"departments": "Engineering; Security;;",
+					"aliases":     []*string{ptr("JDoe"), nil},
+					"Manager":     []framework.Object{{"email": "Jane@Example.com"}},
+				},
+				{
+					"id":          "00u2",
+					"email":       ptr("JANE@EXAMPLE.COM"),
+					"status":      "suspended",
+					"departments": []string{"Sales;Marketing"},
+				},
+			},
+			wantObjects: []framework.Object{
+				{
+					"id":          "00u1",
+					"email":       "john.doe@example.com",
+					"status":      "ACTIVE",
+					"departments": []string{"Engineering", "Security"},
+					"aliases":     []string{"jdoe"},
+					"Manager":     []framework.Object{{"email": "jane@example.com"}},
+				},
+				{
+					"id":          "00u2",
+					"email":       ptr("jane@example.com"),
+					"status":      "suspended",
+					"departments": []string{"Sales", "Marketing"},
+				},
+			},
+		},
+		"no_common_config": {
+			config:      &testutil.Config{},
+			objects:     []framework.Object{{"id": "00u1", "email": "John.Doe@Example.com", "status": nil}},
+			wantObjects: []framework.Object{{"id": "00u1", "email": "John.Doe@Example.com"}},
+		},
+		"no_config": {
+			config:      nil,
+			objects:     []framework.Object{{"id": "00u1", "email": "John.Doe@Example.com"}},
+			wantObjects: []framework.Object{{"id": "00u1", "email": "John.Doe@Example.com"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := &testutil.Adapter{
+				Pages: map[string]*framework.Page{"": {Objects: tt.objects}},
+			}
+
+			gotResponse := normalize.NewAdapter[testutil.Config](next).GetPage(
+				t.Context(), &framework.Request[testutil.Config]{Config: tt.config, Entity: entity},
+			)
+
+			if gotResponse.Error != nil {
+				t.Fatalf("unexpected error: %v", gotResponse.Error)
+			}
+
+			if !reflect.DeepEqual(gotResponse.Success.Objects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotResponse.Success.Objects, tt.wantObjects)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	commonConfig := &config.CommonConfig{
+		EntityNormalization: map[string]normalize.EntityRules{
+			"User": {
+				"email":       {Lowercase: true},
+				"departments": {Split: ";"},
+			},
+			"Group": {
+				"memberCount": {TrimSpace: true},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		entity  *framework.EntityConfig
+		wantErr *framework.Error
+	}{
+		"valid": {
+			entity: &framework.EntityConfig{
+				ExternalId: "User",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "email", Type: framework.AttributeTypeString},
+					{ExternalId: "departments", Type: framework.AttributeTypeString, List: true},
+				},
+			},
+		},
+		"split_not_list": {
+			entity: &framework.EntityConfig{
+				ExternalId: "User",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "departments", Type: framework.AttributeTypeString},
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute departments of entity User must be a list to be split.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"child_entity_not_string": {
+			entity: &framework.EntityConfig{
+				ExternalId: "User",
+				ChildEntities: []*framework.EntityConfig{
+					{
+						ExternalId: "Group",
+						Attributes: []*framework.AttributeConfig{
+							{ExternalId: "memberCount", Type: framework.AttributeTypeInt64},
+						},
+					},
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute memberCount of entity Group must be a string to be normalized.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := normalize.Validate(commonConfig, tt.entity)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -12,19 +12,33 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
 // from datasources.
 type Adapter struct {
 	OktaClient Client
+
+	// Now returns the current time, used to compute the derived attributes of the users.
+	// Defaults to time.Now if nil.
+	Now func() time.Time
 }
 
 // NewAdapter instantiates a new Adapter.
 func NewAdapter(client Client) framework.Adapter[Config] {
 	return &Adapter{
 		OktaClient: client,
+		Now:        time.Now,
+	}
+}
+
+func (a *Adapter) now() time.Time {
+	if a.Now == nil {
+		return time.Now()
 	}
+
+	return a.Now()
 }
 
 // GetPage is called by SGNL's ingestion service to query a page of objects
@@ -34,6 +48,10 @@ func (a *Adapter) GetPage(ctx context.Context, request *framework.Request[Config
 		return framework.NewGetPageResponseError(err)
 	}
 
+	if len(request.Config.Orgs) > 0 {
+		return a.requestPageFromOrgs(ctx, request)
+	}
+
 	return a.RequestPageFromDatasource(ctx, request)
 }
 
@@ -51,7 +69,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	oktaReq := &Request{
 		BaseURL:               request.Address,
 		Token:                 request.Auth.HTTPAuthorization,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		APIVersion:            request.Config.APIVersion,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
@@ -59,15 +77,37 @@ func (a *Adapter) RequestPageFromDatasource(
 		Search:                request.Config.Search[request.Entity.ExternalId],
 	}
 
+	if request.Config.RateLimitBudgetPercent != nil {
+		oktaReq.RateLimitBudgetPercent = *request.Config.RateLimitBudgetPercent
+	}
+
 	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[string](request.Cursor)
+	cursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
 
 	oktaReq.Cursor = cursor
 
-	resp, err := a.OktaClient.GetPage(ctx, oktaReq)
+	var resp *Response
+
+	// The profile attributes are validated at the start of the sync of the users, before requesting them.
+	if request.Config.ValidateProfileAttributes && request.Entity.ExternalId == Users && cursor == nil {
+		resp, err = a.ValidateProfileAttributes(ctx, oktaReq, request.Entity.Attributes)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+	}
+
+	switch {
+	case resp != nil:
+		// A request for the user schemas wasn't successful, its status code is returned below.
+	case request.Entity.ExternalId == UserSchemaAttributes:
+		resp, err = a.GetUserSchemaAttributesPage(ctx, oktaReq)
+	default:
+		resp, err = a.OktaClient.GetPage(ctx, oktaReq)
+	}
+
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
@@ -78,6 +118,28 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	if request.Config.DerivedAttributes && request.Entity.ExternalId == Users && len(resp.Objects) > 0 {
+		var adminUserIDs map[string]struct{}
+
+		// The admin role assignments are only requested if the admin flag is requested.
+		if requestsAttribute(request.Entity, IsAdminAttribute) {
+			adminResp, err := a.OktaClient.GetAdminUserIDs(ctx, oktaReq)
+			if err != nil {
+				return framework.NewGetPageResponseError(err)
+			}
+
+			if adapterErr := web.HTTPError(adminResp.StatusCode, adminResp.RetryAfterHeader); adapterErr != nil {
+				return framework.NewGetPageResponseError(adapterErr)
+			}
+
+			adminUserIDs = adminResp.UserIDs
+		}
+
+		AddDerivedAttributes(resp.Objects, a.now(), adminUserIDs)
+	}
+
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
@@ -12,6 +12,12 @@ import (
 // Client is a client that allows querying the Okta datasource which contains JSON objects.
 type Client interface {
 	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+
+	// GetUserSchema returns the profile attributes of the user schema with the ID, using the Schemas API.
+	GetUserSchema(ctx context.Context, request *Request, schemaID string) (*UserSchemaResponse, *framework.Error)
+
+	// GetAdminUserIDs returns the IDs of the users assigned an admin role, using the Role Assignment API.
+	GetAdminUserIDs(ctx context.Context, request *Request) (*AdminUsersResponse, *framework.Error)
 }
 
 // Request is a request to Okta.
@@ -47,6 +53,10 @@ type Request struct {
 	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
 	// This should be set to the number of seconds to wait before timing out.
 	RequestTimeoutSeconds int
+
+	// RateLimitBudgetPercent is the percentage of the Okta rate limit budgets the requests may consume.
+	// The requests are not paced if 0. See RatePlanner.
+	RateLimitBudgetPercent int
 }
 
 // Response is a response returned by the datasource.
@@ -29,7 +29,16 @@ var supportedAPIVersions = map[string]struct{}{
     },
 	"search": {
         "User": "profile.department eq \"Engineering\""
-    }
+    },
+    "rateLimitBudgetPercent": 50,
+    "validateProfileAttributes": true,
+    "derivedAttributes": true,
+    "orgs": [
+        {
+            "url": "https://acme-eu.okta.com",
+            "token": "SSWS 00abc"
+        }
+    ]
 }
 */
 type Config struct {
@@ -38,6 +47,27 @@ type Config struct {
 	APIVersion string            `json:"apiVersion,omitempty"`
 	Filters    map[string]string `json:"filters,omitempty"`
 	Search     map[string]string `json:"search,omitempty"`
+
+	// RateLimitBudgetPercent is the percentage of the Okta rate limit budgets the syncs may consume, between
+	// 1 and 100. The requests are spread over each rate limit window to leave the rest of the budget to the
+	// other integrations of the tenant. If not set, the requests are not paced.
+	RateLimitBudgetPercent *int `json:"rateLimitBudgetPercent,omitempty"`
+
+	// Orgs are the orgs to sync, in order, each with its own token, e.g. to consolidate several Okta orgs in a
+	// single sync. If set, the datasource address and auth aren't used, and the URL of the org of each object is
+	// set in the OrgURLAttribute attribute, if requested. See OrgCursor.
+	Orgs []Org `json:"orgs,omitempty"`
+
+	// ValidateProfileAttributes validates the requested profile attributes of the User entity, e.g.
+	// $.profile.costCenter, at the start of its sync: each attribute must be a base or custom profile attribute of
+	// the schema of at least one user type, and its type must match the type of the profile attribute. The profile
+	// attributes and their types are listed by the UserSchemaAttribute entity.
+	ValidateProfileAttributes bool `json:"validateProfileAttributes,omitempty"`
+
+	// DerivedAttributes sets the derived attributes of the User entity, if requested: daysSinceLastLogin,
+	// passwordAgeDays and isAdmin. See AddDerivedAttributes. The admin role assignments are requested for each
+	// page of users only if isAdmin is requested.
+	DerivedAttributes bool `json:"derivedAttributes,omitempty"`
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
@@ -52,6 +82,10 @@ func (c *Config) Validate(_ context.Context) error {
 			return fmt.Errorf("apiVersion is not supported: %v", c.APIVersion)
 		}
 
+		if c.RateLimitBudgetPercent != nil && (*c.RateLimitBudgetPercent < 1 || *c.RateLimitBudgetPercent > 100) {
+			return errors.New("rateLimitBudgetPercent must be between 1 and 100")
+		}
+
 		return nil
 	}
 }
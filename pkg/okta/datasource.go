@@ -4,15 +4,16 @@ package okta
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
-	"time"
+	"strconv"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
-	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/jsonstream"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
@@ -22,31 +23,43 @@ import (
 // Datasource directly implements a Client interface to allow querying an external datasource.
 type Datasource struct {
 	Client *http.Client
-}
 
-type DatasourceResponse = []map[string]any
+	// RatePlanner paces the requests to stay within the share of the Okta rate limit budgets allotted to the
+	// adapter. Requests are not paced if nil.
+	RatePlanner *RatePlanner
+}
 
 const (
 	Users        string = "User"
 	Groups       string = "Group"
 	GroupMembers string = "GroupMember"
 	Applications string = "Application"
+
+	// UserTypes are the user types of the org, each with its own user schema.
+	UserTypes string = "UserType"
+
+	// UserSchemaAttributes are the base and custom profile attributes of the schemas of the user types, with the
+	// external ID and the type of the attribute to sync each of them as an attribute of the User entity.
+	UserSchemaAttributes string = "UserSchemaAttribute"
 )
 
 var (
 	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
 	ValidEntityExternalIDs = map[string]struct{}{
-		Users:        {},
-		Groups:       {},
-		GroupMembers: {},
-		Applications: {},
+		Users:                {},
+		Groups:               {},
+		GroupMembers:         {},
+		Applications:         {},
+		UserTypes:            {},
+		UserSchemaAttributes: {},
 	}
 )
 
 // NewClient returns a Client to query the datasource.
 func NewClient(client *http.Client) Client {
 	return &Datasource{
-		Client: client,
+		Client:      client,
+		RatePlanner: NewRatePlanner(),
 	}
 }
 
@@ -62,12 +75,13 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	// `CollectionCursor` (GroupCursor).
 	if request.EntityExternalID == GroupMembers {
 		groupRequest := &Request{
-			Token:                 request.Token,
-			APIVersion:            request.APIVersion,
-			BaseURL:               request.BaseURL,
-			EntityExternalID:      Groups,
-			PageSize:              1,
-			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+			Token:                  request.Token,
+			APIVersion:             request.APIVersion,
+			BaseURL:                request.BaseURL,
+			EntityExternalID:       Groups,
+			PageSize:               1,
+			RequestTimeoutSeconds:  request.RequestTimeoutSeconds,
+			RateLimitBudgetPercent: request.RateLimitBudgetPercent,
 		}
 
 		// If the CollectionCursor (GroupCursor) is set, use that as the Cursor
@@ -128,74 +142,51 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		return nil, endpointErr
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
+	if rateLimitedResponse, paceErr := d.pace(ctx, request, logger); rateLimitedResponse != nil || paceErr != nil {
+		return rateLimitedResponse, paceErr
 	}
 
-	// Timeout API calls that take longer than the configured timeout.
-	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
-	defer cancel()
-
-	req = req.WithContext(apiCtx)
+	var (
+		objects []map[string]any
+		hooks   = &httpds.Hooks{}
+	)
 
-	req.Header.Add("Authorization", request.Token)
-	req.Header.Add("Content-Type", "application/json;okta-response=omitCredentials,omitCredentialsLinks")
+	if d.RatePlanner != nil {
+		hooks.InspectResponse = func(res *http.Response) {
+			d.RatePlanner.Update(rateLimitBucketKey(request), res.Header)
+		}
+	}
 
-	logger.Info("Sending request to datasource", fields.RequestURL(endpoint))
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpoint,
+		Header: http.Header{
+			"Authorization": {request.Token},
+			"Content-Type":  {"application/json;okta-response=omitCredentials,omitCredentialsLinks"},
+		},
+		DatasourceName:        "Okta",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		var parseErr *framework.Error
 
-	res, err := d.Client.Do(req)
-	if err != nil {
-		logger.Error("Request to datasource failed",
-			fields.RequestURL(endpoint),
-			fields.SGNLEventTypeError(),
-			zap.Error(err),
-		)
+		objects, parseErr = ParseResponse(body)
 
-		return nil, customerror.UpdateError(&framework.Error{
-			Message: fmt.Sprintf("Failed to execute Okta request: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		},
-			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
-		)
+		return parseErr
+	}, hooks)
+	if frameworkErr != nil {
+		return nil, frameworkErr
 	}
 
-	defer res.Body.Close()
-
 	response := &Response{
-		StatusCode:       res.StatusCode,
-		RetryAfterHeader: res.Header.Get("Retry-After"),
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
 	}
 
-	if res.StatusCode != http.StatusOK {
-		logger.Error("Datasource responded with an error",
-			fields.RequestURL(endpoint),
-			fields.ResponseStatusCode(res.StatusCode),
-			fields.ResponseRetryAfterHeader(response.RetryAfterHeader),
-			fields.ResponseBody(res.Body),
-			fields.SGNLEventTypeError(),
-		)
-
+	if response.StatusCode != http.StatusOK {
 		return response, nil
 	}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to read Okta response: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
-	objects, frameworkErr := ParseResponse(body)
-	if frameworkErr != nil {
-		return nil, frameworkErr
-	}
-
-	response.NextCursor = pagination.GetNextCursorFromLinkHeader(res.Header.Values("link"))
+	response.NextCursor = pagination.GetNextCursorFromLinkHeader(httpResponse.Header.Values("link"))
 
 	// [GroupMembers] Set `id`, `userId` and `groupId`.
 	if request.EntityExternalID == GroupMembers {
@@ -240,15 +231,53 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	return response, nil
 }
 
-func ParseResponse(body []byte) (objects []map[string]any, err *framework.Error) {
-	var data DatasourceResponse
+// pace waits until the request can be sent within the share of the rate limit budget allotted to the adapter.
+// If the request can't be sent before the deadline of the context, a 429 response is returned instead, so that
+// the page is retried once the budget allows it.
+func (d *Datasource) pace(ctx context.Context, request *Request, logger *zap.Logger) (*Response, *framework.Error) {
+	if d.RatePlanner == nil || request.RateLimitBudgetPercent == 0 {
+		return nil, nil
+	}
+
+	plan, planned := d.RatePlanner.Plan(rateLimitBucketKey(request), request.RateLimitBudgetPercent)
+	if !planned {
+		return nil, nil
+	}
+
+	logger.Info("Planned request pace within the datasource rate limit budget",
+		fields.RateLimitLimit(plan.Limit),
+		fields.RateLimitRemaining(plan.Remaining),
+		fields.RateLimitReset(plan.Reset),
+		fields.RateLimitInterval(plan.Interval),
+		fields.RateLimitWait(plan.Wait),
+	)
+
+	if deadline, ok := ctx.Deadline(); ok && d.RatePlanner.Now().Add(plan.Wait).After(deadline) {
+		return &Response{
+			StatusCode:       http.StatusTooManyRequests,
+			RetryAfterHeader: strconv.FormatInt(int64(math.Ceil(plan.Wait.Seconds())), 10),
+		}, nil
+	}
+
+	if err := wait(ctx, plan.Wait); err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to wait for the Okta rate limit budget: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return nil, nil
+}
 
-	if unmarshalErr := json.Unmarshal(body, &data); unmarshalErr != nil || data == nil {
+// ParseResponse decodes the JSON array of objects returned by Okta list endpoints.
+func ParseResponse(body io.Reader) (objects []map[string]any, err *framework.Error) {
+	objects, _, decodeErr := jsonstream.DecodeList(body, "")
+	if decodeErr != nil {
 		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", unmarshalErr),
+			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", decodeErr),
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		}
 	}
 
-	return data, nil
+	return objects, nil
 }
@@ -0,0 +1,64 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package okta_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/okta"
+)
+
+// BenchmarkParseResponse benchmarks parsing a multi-MB page of Okta users, to catch performance regressions in
+// the parsing of the responses.
+//
+//	go test -run '^$' -bench BenchmarkParse -benchmem ./pkg/okta/
+func BenchmarkParseResponse(b *testing.B) {
+	body := generateUsersPage(5000)
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		objects, err := okta.ParseResponse(bytes.NewReader(body))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if len(objects) != 5000 {
+			b.Fatalf("got %d objects, want 5000", len(objects))
+		}
+	}
+}
+
+// generateUsersPage returns a response of the List Users endpoint with objectCount users.
+func generateUsersPage(objectCount int) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("[")
+
+	for i := range objectCount {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		fmt.Fprintf(&buf,
+			`{"id":"00u%017d","status":"ACTIVE","created":"2026-01-02T03:04:05.000Z","activated":"2026-01-02T03:04:06.000Z",`+
+				`"statusChanged":"2026-01-02T03:04:06.000Z","lastLogin":"2026-03-04T05:06:07.000Z","lastUpdated":"2026-03-04T05:06:07.000Z",`+
+				`"passwordChanged":"2026-01-02T03:04:06.000Z","type":{"id":"oty1a2b3c4d5e6f7g8h9"},`+
+				`"profile":{"firstName":"First%d","lastName":"Last%d","mobilePhone":null,"secondEmail":null,`+
+				`"login":"user%d@example.com","email":"user%d@example.com","department":"Engineering","title":"Engineer",`+
+				`"manager":"Manager %d","employeeNumber":"%d","costCenter":"CC-%d","organization":"SGNL"},`+
+				`"credentials":{"password":{},"emails":[{"value":"user%d@example.com","status":"VERIFIED","type":"PRIMARY"}],`+
+				`"provider":{"type":"OKTA","name":"OKTA"}},`+
+				`"_links":{"self":{"href":"https://test-instance.okta.com/api/v1/users/00u%017d"}}}`,
+			i, i, i, i, i, i%100, i, i%50, i, i,
+		)
+	}
+
+	buf.WriteString("]")
+
+	return buf.Bytes()
+}
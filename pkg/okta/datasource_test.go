@@ -4,6 +4,7 @@
 package okta_test
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 	"net/http/httptest"
@@ -596,7 +597,7 @@ func TestParseResponse(t *testing.T) {
 		"invalid_object_structure": {
 			body: []byte(`{"result": [{"id": "00ub0oNGTSWTBKOLGLNR","status": "ACTIVE"}, {"id": "00ub0oNGTSWTBKOCHDKE","status": "ACTIVE"}]}`),
 			wantErr: testutil.GenPtr(framework.Error{
-				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal object into Go value of type []map[string]interface {}.",
+				Message: "Failed to unmarshal the datasource response: expected start of JSON array but found {.",
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 			}),
 		},
@@ -611,7 +612,7 @@ func TestParseResponse(t *testing.T) {
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotObjects, gotErr := okta.ParseResponse(tt.body)
+			gotObjects, gotErr := okta.ParseResponse(bytes.NewReader(tt.body))
 
 			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
 				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
@@ -0,0 +1,161 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package okta
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+)
+
+const (
+	// DaysSinceLastLoginAttribute is the derived attribute of the User entity set to the number of whole days
+	// since the last login of the user. Not set if the user never logged in.
+	DaysSinceLastLoginAttribute = "daysSinceLastLogin"
+
+	// PasswordAgeDaysAttribute is the derived attribute of the User entity set to the number of whole days since
+	// the password of the user was last changed. Not set if the user has no password, e.g. federated users.
+	PasswordAgeDaysAttribute = "passwordAgeDays"
+
+	// IsAdminAttribute is the derived attribute of the User entity set to whether the user is assigned an admin
+	// role, directly or through a group.
+	IsAdminAttribute = "isAdmin"
+
+	// adminUsersPageSize is the maximum page size of the role assignees endpoint.
+	adminUsersPageSize = 100
+)
+
+// AdminUsersResponse is a response of the role assignees endpoint returned by the datasource.
+type AdminUsersResponse struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// UserIDs are the IDs of the users assigned an admin role.
+	UserIDs map[string]struct{}
+}
+
+// roleAssigneesPage is a page of the users assigned an admin role, returned by GET /api/v1/iam/assignees/users.
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/RoleAssignmentAUser/
+type roleAssigneesPage struct {
+	Value []struct {
+		ID string `json:"id"`
+	} `json:"value"`
+	Links struct {
+		Next *struct {
+			Href string `json:"href"`
+		} `json:"next"`
+	} `json:"_links"`
+}
+
+// GetAdminUserIDs returns the IDs of the users assigned an admin role, directly or through a group, requesting all
+// the pages of the role assignees endpoint.
+func (d *Datasource) GetAdminUserIDs(ctx context.Context, request *Request) (*AdminUsersResponse, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(fields.RequestEntityExternalID(request.EntityExternalID))
+
+	response := &AdminUsersResponse{
+		UserIDs: make(map[string]struct{}),
+	}
+
+	nextURL := request.BaseURL + "/api/" + request.APIVersion + "/iam/assignees/users?" + url.Values{
+		"limit": {strconv.Itoa(adminUsersPageSize)},
+	}.Encode()
+
+	for nextURL != "" {
+		var page roleAssigneesPage
+
+		httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+			URL: nextURL,
+			Header: http.Header{
+				"Authorization": {request.Token},
+				"Accept":        {"application/json"},
+			},
+			DatasourceName:        "Okta",
+			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+			Logger:                logger,
+		}, func(body io.Reader) *framework.Error {
+			bodyBytes, readErr := httpds.ReadAll(body, "Okta")
+			if readErr != nil {
+				return readErr
+			}
+
+			return httpds.UnmarshalJSON(bodyBytes, &page)
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if httpResponse.StatusCode != http.StatusOK {
+			response.StatusCode = httpResponse.StatusCode
+			response.RetryAfterHeader = httpResponse.RetryAfterHeader
+
+			return response, nil
+		}
+
+		for _, assignee := range page.Value {
+			response.UserIDs[assignee.ID] = struct{}{}
+		}
+
+		nextURL = ""
+
+		// The last page may still link to an empty next page.
+		if page.Links.Next != nil && len(page.Value) > 0 {
+			nextURL = page.Links.Next.Href
+		}
+	}
+
+	response.StatusCode = http.StatusOK
+
+	return response, nil
+}
+
+// AddDerivedAttributes sets the derived attributes of the users of a page, computed from their lastLogin and
+// passwordChanged attributes and, if adminUserIDs isn't nil, from the users assigned an admin role. They are common
+// signals of orphaned and service accounts, e.g. active users that haven't logged in for months, or whose password
+// hasn't changed in years.
+func AddDerivedAttributes(users []map[string]any, now time.Time, adminUserIDs map[string]struct{}) {
+	for _, user := range users {
+		if days, ok := daysSince(user["lastLogin"], now); ok {
+			user[DaysSinceLastLoginAttribute] = days
+		}
+
+		if days, ok := daysSince(user["passwordChanged"], now); ok {
+			user[PasswordAgeDaysAttribute] = days
+		}
+
+		if adminUserIDs != nil {
+			id, _ := user[uniqueIDAttribute].(string)
+			_, isAdmin := adminUserIDs[id]
+
+			user[IsAdminAttribute] = isAdmin
+		}
+	}
+}
+
+// daysSince returns the number of whole days between an RFC 3339 date-time value and now, as a JSON number.
+// Returns false if the value isn't set or isn't a date-time.
+func daysSince(value any, now time.Time) (float64, bool) {
+	dateTime, ok := value.(string)
+	if !ok {
+		return 0, false
+	}
+
+	parsed, err := time.Parse(time.RFC3339, dateTime)
+	if err != nil {
+		return 0, false
+	}
+
+	// Clock skew may put a recent date-time slightly in the future.
+	return math.Max(0, math.Floor(now.Sub(parsed).Hours()/24)), true
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package okta_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/okta"
+)
+
+func TestAdapterGetPageWithDerivedAttributes(t *testing.T) {
+	var adminRequests int
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/api/v1/users?limit=3":
+			w.Write([]byte(`[
+				{"id": "00u1", "status": "ACTIVE", "lastLogin": "2024-03-01T09:00:00.000Z", "passwordChanged": "2022-03-10T12:00:00.000Z"},
+				{"id": "00u2", "status": "ACTIVE", "lastLogin": null, "passwordChanged": "2024-03-09T23:00:00.000Z"},
+				{"id": "00u3", "status": "ACTIVE", "lastLogin": "2024-03-10T11:00:00.000Z", "passwordChanged": null}
+			]`))
+		case "/api/v1/iam/assignees/users?limit=100":
+			adminRequests++
+
+			w.Write([]byte(`{
+				"value": [{"id": "00u9", "orn": "orn:okta:directory:00o1:users:00u9"}],
+				"_links": {"next": {"href": "https://` + r.Host + `/api/v1/iam/assignees/users?after=00u9&limit=100"}}
+			}`))
+		case "/api/v1/iam/assignees/users?after=00u9&limit=100":
+			adminRequests++
+
+			w.Write([]byte(`{
+				"value": [{"id": "00u3", "orn": "orn:okta:directory:00o1:users:00u3"}],
+				"_links": {"next": {"href": "https://` + r.Host + `/api/v1/iam/assignees/users?after=00u3&limit=100"}}
+			}`))
+		case "/api/v1/iam/assignees/users?after=00u3&limit=100":
+			adminRequests++
+
+			w.Write([]byte(`{"value": [], "_links": {}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	adapter := &okta.Adapter{
+		OktaClient: &okta.Datasource{Client: server.Client()},
+		Now: func() time.Time {
+			return time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+		},
+	}
+
+	newRequest := func(derivedAttributes bool, attributes ...*framework.AttributeConfig) *framework.Request[okta.Config] {
+		return &framework.Request[okta.Config]{
+			Address: server.URL,
+			Auth: &framework.DatasourceAuthCredentials{
+				HTTPAuthorization: "SSWS testtoken",
+			},
+			Config: &okta.Config{
+				APIVersion:        "v1",
+				DerivedAttributes: derivedAttributes,
+			},
+			Entity: framework.EntityConfig{
+				ExternalId: okta.Users,
+				Attributes: append([]*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString},
+				}, attributes...),
+			},
+			PageSize: 3,
+		}
+	}
+
+	tests := map[string]struct {
+		request           *framework.Request[okta.Config]
+		wantResponse      framework.Response
+		wantAdminRequests int
+	}{
+		"all_derived_attributes": {
+			request: newRequest(true,
+				&framework.AttributeConfig{ExternalId: okta.DaysSinceLastLoginAttribute, Type: framework.AttributeTypeInt64},
+				&framework.AttributeConfig{ExternalId: okta.PasswordAgeDaysAttribute, Type: framework.AttributeTypeInt64},
+				&framework.AttributeConfig{ExternalId: okta.IsAdminAttribute, Type: framework.AttributeTypeBool},
+			),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "00u1", "daysSinceLastLogin": int64(9), "passwordAgeDays": int64(731), "isAdmin": false},
+						{"id": "00u2", "passwordAgeDays": int64(0), "isAdmin": false},
+						{"id": "00u3", "daysSinceLastLogin": int64(0), "isAdmin": true},
+					},
+				},
+			},
+			wantAdminRequests: 3,
+		},
+		"admin_flag_not_requested": {
+			request: newRequest(true,
+				&framework.AttributeConfig{ExternalId: okta.DaysSinceLastLoginAttribute, Type: framework.AttributeTypeInt64},
+			),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "00u1", "daysSinceLastLogin": int64(9)},
+						{"id": "00u2"},
+						{"id": "00u3", "daysSinceLastLogin": int64(0)},
+					},
+				},
+			},
+		},
+		"derived_attributes_disabled": {
+			request: newRequest(false,
+				&framework.AttributeConfig{ExternalId: okta.DaysSinceLastLoginAttribute, Type: framework.AttributeTypeInt64},
+				&framework.AttributeConfig{ExternalId: okta.IsAdminAttribute, Type: framework.AttributeTypeBool},
+			),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "00u1"},
+						{"id": "00u2"},
+						{"id": "00u3"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			adminRequests = 0
+
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(tt.wantResponse, gotResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+
+			if adminRequests != tt.wantAdminRequests {
+				t.Errorf("gotAdminRequests: %d, wantAdminRequests: %d", adminRequests, tt.wantAdminRequests)
+			}
+		})
+	}
+}
+
+func TestAdapterGetPageWithDerivedAttributesAdminError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users":
+			w.Write([]byte(`[{"id": "00u1", "status": "ACTIVE"}]`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	adapter := okta.NewAdapter(&okta.Datasource{Client: server.Client()})
+
+	gotResponse := adapter.GetPage(context.Background(), &framework.Request[okta.Config]{
+		Address: server.URL,
+		Auth: &framework.DatasourceAuthCredentials{
+			HTTPAuthorization: "SSWS testtoken",
+		},
+		Config: &okta.Config{
+			APIVersion:        "v1",
+			DerivedAttributes: true,
+		},
+		Entity: framework.EntityConfig{
+			ExternalId: okta.Users,
+			Attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString},
+				{ExternalId: okta.IsAdminAttribute, Type: framework.AttributeTypeBool},
+			},
+		},
+		PageSize: 1,
+	})
+
+	if gotResponse.Error == nil || gotResponse.Error.Code != api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED {
+		t.Errorf("gotResponse: %v, want an ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED error", gotResponse)
+	}
+}
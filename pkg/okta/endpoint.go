@@ -48,6 +48,7 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 		// [Filtered Apps]	baseURL + "/api/" + apiVersion + "/apps?filter="
 		//					+ `status eq \"ACTIVE\"` + "&limit=" + pageSize
 		// [GroupMembers] 	baseURL + "/api/" + apiVersion + "/groups/" + groupId + "/users?limit=" + pageSize
+		// [UserTypes]		baseURL + "/api/" + apiVersion + "/meta/types/user"
 		sb.Grow(len(request.BaseURL) + len(request.APIVersion) + len(formattedPageSize) + 12)
 
 		sb.WriteString(request.BaseURL)
@@ -158,6 +159,11 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 				sb.WriteString(filter)
 				sb.WriteString("&")
 			}
+		case UserTypes:
+			// The user types aren't paginated.
+			sb.WriteString("meta/types/user")
+
+			return sb.String(), nil
 		default:
 			return "", &framework.Error{
 				Message: "Provided entity external ID is invalid.",
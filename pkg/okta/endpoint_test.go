@@ -164,6 +164,16 @@ func TestConstructEndpoint(t *testing.T) {
 			},
 			wantEndpoint: "https://test-instance.oktapreview.com/api/v1/apps?filter=status+eq+%22ACTIVE%22&after=0oav0szjt4RXG5wFN697&limit=100",
 		},
+		"user_types": {
+			request: &okta.Request{
+				BaseURL:          "https://test-instance.oktapreview.com",
+				APIVersion:       "v1",
+				EntityExternalID: "UserType",
+				PageSize:         100,
+				Token:            "SSWS testtoken",
+			},
+			wantEndpoint: "https://test-instance.oktapreview.com/api/v1/meta/types/user",
+		},
 		"invalid_entity": {
 			request: &okta.Request{
 				BaseURL:          "https://test-instance.oktapreview.com",
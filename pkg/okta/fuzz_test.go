@@ -0,0 +1,31 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package okta_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/okta"
+)
+
+// FuzzParseResponse checks that malformed Okta responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`[{"id":"00ub0oNGTSWTBKOLGLNR","status":"ACTIVE","profile":{"login":"john.doe@example.com"}}]`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := okta.ParseResponse(bytes.NewReader(body))
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package okta
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/validation"
+)
+
+const (
+	// OrgURLAttribute is the attribute set on each object synced from the configured orgs, with the URL of the
+	// org the object was retrieved from. Object IDs are only unique within an org.
+	OrgURLAttribute = "orgUrl"
+
+	// orgCursorShape is the expected JSON shape of an OrgCursor, used in cursor errors.
+	orgCursorShape = `{"orgUrl":<string>,"cursor":<string>}`
+)
+
+// Org is an Okta org synced with its own API token, see Config.Orgs.
+type Org struct {
+	// URL is the URL of the org, e.g. "https://acme-eu.okta.com".
+	URL string `json:"url"`
+
+	// Token is the Authorization header value to query the org, with the "SSWS " or "Bearer " prefix.
+	Token string `json:"token"`
+}
+
+/*
+OrgCursor is the cursor of the entities synced from the configured orgs. It wraps the cursor of the next page
+within the current org, i.e. the cursor returned when syncing a single org, e.g.:
+
+	{
+	    "orgUrl": "https://acme-eu.okta.com",
+	    "cursor": "eyJjdXJzb3IiOiIvYXBpL3YxL3VzZXJzP2FmdGVyPTAwdWIwb05HVFNXVEJLT0xHTE5SIn0="
+	}
+
+The orgs are synced one after the other, in the configured order. A cursor without a cursor identifies the
+first page of the org.
+*/
+type OrgCursor struct {
+	OrgURL string `json:"orgUrl"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// MarshalOrgCursor marshals the struct and b64 encodes it.
+func MarshalOrgCursor(cursor *OrgCursor) (string, *framework.Error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	cursorBytes, err := json.Marshal(cursor)
+	if err != nil {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal org cursor into JSON: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(cursorBytes), nil
+}
+
+// UnmarshalOrgCursor decodes the b64 encoded string and unmarshals it.
+// nil is returned for the first page.
+func UnmarshalOrgCursor(cursor string, entityExternalID string) (*OrgCursor, *framework.Error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	cursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, orgCursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var orgCursor OrgCursor
+
+	if err := json.Unmarshal(cursorBytes, &orgCursor); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, orgCursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	return &orgCursor, nil
+}
+
+// validateOrgs validates the configured orgs, and normalizes their URLs with the https:// scheme if not provided.
+func validateOrgs(orgs []Org) *framework.Error {
+	orgURLs := make(map[string]struct{}, len(orgs))
+
+	for i, org := range orgs {
+		trimmedURL, parsed, err := validation.ParseAndValidateAddress(org.URL, []string{"https"})
+		if err != nil {
+			return err
+		}
+
+		if parsed.Scheme == "" {
+			trimmedURL = "https://" + trimmedURL
+		}
+
+		if _, found := orgURLs[trimmedURL]; found {
+			return &framework.Error{
+				Message: fmt.Sprintf("Okta config is invalid: org %s is specified more than once.", trimmedURL),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+
+		if !strings.HasPrefix(org.Token, "Bearer ") && !strings.HasPrefix(org.Token, "SSWS ") {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					`Okta config is invalid: the token of org %s is missing required "Bearer " or "SSWS " prefix.`,
+					trimmedURL,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+
+		orgURLs[trimmedURL] = struct{}{}
+		orgs[i].URL = trimmedURL
+	}
+
+	return nil
+}
+
+// requestPageFromOrgs requests a page of objects from one of the configured orgs, with the token of the org.
+// The cursor of the page within the org is handled by RequestPageFromDatasource.
+func (a *Adapter) requestPageFromOrgs(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	orgs := request.Config.Orgs
+
+	cursor, err := UnmarshalOrgCursor(request.Cursor, request.Entity.ExternalId)
+	if err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	orgIndex := 0
+
+	if cursor != nil {
+		orgIndex = -1
+
+		for i, org := range orgs {
+			if org.URL == cursor.OrgURL {
+				orgIndex = i
+
+				break
+			}
+		}
+
+		if orgIndex == -1 {
+			return framework.NewGetPageResponseError(pagination.NewCursorError(
+				request.Entity.ExternalId, orgCursorShape, fmt.Sprintf("org %s is not configured", cursor.OrgURL),
+			))
+		}
+	}
+
+	org := orgs[orgIndex]
+
+	orgRequest := *request
+	orgRequest.Address = org.URL
+	orgRequest.Auth = &framework.DatasourceAuthCredentials{HTTPAuthorization: org.Token}
+	orgRequest.Cursor = ""
+
+	if cursor != nil {
+		orgRequest.Cursor = cursor.Cursor
+	}
+
+	response := a.RequestPageFromDatasource(ctx, &orgRequest)
+	if response.Success == nil {
+		return response
+	}
+
+	if requestsAttribute(request.Entity, OrgURLAttribute) {
+		for _, object := range response.Success.Objects {
+			object[OrgURLAttribute] = org.URL
+		}
+	}
+
+	var nextCursor *OrgCursor
+
+	switch {
+	case response.Success.NextCursor != "":
+		nextCursor = &OrgCursor{OrgURL: org.URL, Cursor: response.Success.NextCursor}
+	case orgIndex+1 < len(orgs):
+		nextCursor = &OrgCursor{OrgURL: orgs[orgIndex+1].URL}
+	}
+
+	if response.Success.NextCursor, err = MarshalOrgCursor(nextCursor); err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	return response
+}
+
+// requestsAttribute returns whether the attribute is requested for the entity.
+func requestsAttribute(entity framework.EntityConfig, attributeExternalID string) bool {
+	for _, attribute := range entity.Attributes {
+		if attribute.ExternalId == attributeExternalID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll
+package okta_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	okta_adapter "github.com/sgnl-ai/adapters/pkg/okta"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// orgClient returns a page with a user of the org of the request, and a next cursor for the first page of
+// each org.
+type orgClient struct {
+	gotRequests []*okta_adapter.Request
+}
+
+func (c *orgClient) GetPage(_ context.Context, request *okta_adapter.Request) (*okta_adapter.Response, *framework.Error) {
+	c.gotRequests = append(c.gotRequests, request)
+
+	response := &okta_adapter.Response{
+		StatusCode: http.StatusOK,
+		Objects:    []map[string]any{{"id": "00ub0oNGTSWTBKOLGLNR"}},
+	}
+
+	if request.Cursor == nil {
+		response.NextCursor = &pagination.CompositeCursor[string]{
+			Cursor: testutil.GenPtr(request.BaseURL + "/api/v1/users?after=00ub0oNGTSWTBKOLGLNR&limit=1"),
+		}
+	}
+
+	return response, nil
+}
+
+func (c *orgClient) GetUserSchema(
+	_ context.Context, _ *okta_adapter.Request, _ string,
+) (*okta_adapter.UserSchemaResponse, *framework.Error) {
+	return nil, nil
+}
+
+func (c *orgClient) GetAdminUserIDs(
+	_ context.Context, _ *okta_adapter.Request,
+) (*okta_adapter.AdminUsersResponse, *framework.Error) {
+	return nil, nil
+}
+
+func TestAdapterGetPageWithOrgs(t *testing.T) {
+	encodeCursor := func(cursor string) string {
+		return base64.StdEncoding.EncodeToString([]byte(cursor))
+	}
+
+	// The cursors of the second pages within the orgs, with "&" escaped by json.Marshal.
+	org1Page2Cursor := encodeCursor(`{"cursor":"https://acme.okta.com/api/v1/users?after=00ub0oNGTSWTBKOLGLNR\u0026limit=1"}`)
+	org2Page2Cursor := encodeCursor(`{"cursor":"https://acme-eu.okta.com/api/v1/users?after=00ub0oNGTSWTBKOLGLNR\u0026limit=1"}`)
+
+	tests := map[string]struct {
+		cursor       string
+		wantResponse framework.Response
+		wantBaseURL  string
+		wantToken    string
+	}{
+		"first_page_of_first_org": {
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "00ub0oNGTSWTBKOLGLNR", "orgUrl": "https://acme.okta.com"},
+					},
+					NextCursor: encodeCursor(`{"orgUrl":"https://acme.okta.com","cursor":"` + org1Page2Cursor + `"}`),
+				},
+			},
+			wantBaseURL: "https://acme.okta.com",
+			wantToken:   "SSWS token1",
+		},
+		"last_page_of_first_org": {
+			cursor: encodeCursor(`{"orgUrl":"https://acme.okta.com","cursor":"` + org1Page2Cursor + `"}`),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "00ub0oNGTSWTBKOLGLNR", "orgUrl": "https://acme.okta.com"},
+					},
+					NextCursor: encodeCursor(`{"orgUrl":"https://acme-eu.okta.com"}`),
+				},
+			},
+			wantBaseURL: "https://acme.okta.com",
+			wantToken:   "SSWS token1",
+		},
+		"first_page_of_last_org": {
+			cursor: encodeCursor(`{"orgUrl":"https://acme-eu.okta.com"}`),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "00ub0oNGTSWTBKOLGLNR", "orgUrl": "https://acme-eu.okta.com"},
+					},
+					NextCursor: encodeCursor(`{"orgUrl":"https://acme-eu.okta.com","cursor":"` + org2Page2Cursor + `"}`),
+				},
+			},
+			wantBaseURL: "https://acme-eu.okta.com",
+			wantToken:   "Bearer token2",
+		},
+		"last_page_of_last_org": {
+			cursor: encodeCursor(`{"orgUrl":"https://acme-eu.okta.com","cursor":"` + org2Page2Cursor + `"}`),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "00ub0oNGTSWTBKOLGLNR", "orgUrl": "https://acme-eu.okta.com"},
+					},
+				},
+			},
+			wantBaseURL: "https://acme-eu.okta.com",
+			wantToken:   "Bearer token2",
+		},
+		"unknown_org": {
+			cursor: encodeCursor(`{"orgUrl":"https://unknown.okta.com"}`),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Invalid cursor for entity User: org https://unknown.okta.com is not configured. " +
+						`Expected cursor shape: {"orgUrl":<string>,"cursor":<string>}. ` + pagination.RestartSyncHint,
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &orgClient{}
+			adapter := okta_adapter.NewAdapter(client)
+
+			request := &framework.Request[okta_adapter.Config]{
+				Address: "acme.okta.com",
+				Config: &okta_adapter.Config{
+					APIVersion: "v1",
+					Orgs: []okta_adapter.Org{
+						{URL: "acme.okta.com", Token: "SSWS token1"},
+						{URL: "https://acme-eu.okta.com", Token: "Bearer token2"},
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{ExternalId: "id", Type: framework.AttributeTypeString},
+						{ExternalId: okta_adapter.OrgURLAttribute, Type: framework.AttributeTypeString},
+					},
+				},
+				PageSize: 1,
+				Cursor:   tt.cursor,
+			}
+
+			gotResponse := adapter.GetPage(context.Background(), request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if tt.wantBaseURL == "" {
+				return
+			}
+
+			if len(client.gotRequests) != 1 {
+				t.Fatalf("got %d requests, want 1", len(client.gotRequests))
+			}
+
+			if got := client.gotRequests[0]; got.BaseURL != tt.wantBaseURL || got.Token != tt.wantToken {
+				t.Errorf("got request to %s with token %s, want %s with token %s",
+					got.BaseURL, got.Token, tt.wantBaseURL, tt.wantToken)
+			}
+		})
+	}
+}
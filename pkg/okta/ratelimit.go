@@ -0,0 +1,195 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package okta
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Okta rate limit response headers.
+// https://developer.okta.com/docs/reference/rl-best-practices/#check-your-rate-limits-with-okta-s-rate-limit-headers
+const (
+	rateLimitLimitHeader     = "X-Rate-Limit-Limit"
+	rateLimitRemainingHeader = "X-Rate-Limit-Remaining"
+	rateLimitResetHeader     = "X-Rate-Limit-Reset"
+)
+
+// rateLimitBuckets maps the external IDs of the entities to the Okta rate limit bucket of their endpoint.
+// Group members are listed from the groups endpoint, so they share the bucket of the groups.
+// https://developer.okta.com/docs/reference/rl-global-mgmt/
+var rateLimitBuckets = map[string]string{
+	Users:        "/api/v1/users",
+	Groups:       "/api/v1/groups",
+	GroupMembers: "/api/v1/groups",
+	Applications: "/api/v1/apps",
+}
+
+// RateLimitBudget is the rate limit budget of an Okta rate limit bucket, as last reported by Okta.
+type RateLimitBudget struct {
+	// Limit is the number of requests allowed in each rate limit window.
+	Limit int
+
+	// Remaining is the number of requests left in the current rate limit window.
+	Remaining int
+
+	// Reset is the end of the current rate limit window.
+	Reset time.Time
+}
+
+// RateLimitPlan is the planned pace of the requests to an Okta rate limit bucket.
+type RateLimitPlan struct {
+	RateLimitBudget
+
+	// Interval is the planned interval between requests to stay within the share of the budget of the adapter.
+	Interval time.Duration
+
+	// Wait is the time to wait before sending the next request.
+	Wait time.Duration
+}
+
+// parseRateLimitBudget parses the rate limit headers of an Okta response. false is returned if the headers
+// are missing or invalid.
+func parseRateLimitBudget(header http.Header) (RateLimitBudget, bool) {
+	limit, limitErr := strconv.Atoi(header.Get(rateLimitLimitHeader))
+	remaining, remainingErr := strconv.Atoi(header.Get(rateLimitRemainingHeader))
+	reset, resetErr := strconv.ParseInt(header.Get(rateLimitResetHeader), 10, 64)
+
+	if limitErr != nil || remainingErr != nil || resetErr != nil || limit <= 0 {
+		return RateLimitBudget{}, false
+	}
+
+	return RateLimitBudget{Limit: limit, Remaining: remaining, Reset: time.Unix(reset, 0)}, true
+}
+
+/*
+RatePlanner spreads the requests sent to each Okta rate limit bucket of each tenant over the rate limit
+window, so that syncs only consume a share of the rate limit budget and leave the rest to the other
+integrations of the tenant, instead of bursting through the whole budget at the start of each window.
+
+Okta doesn't expose the rate limit budgets outside of the rate limit headers of its responses, so the budget
+of a bucket is learned from the first response of a sync, and updated with every response. Until then, the
+requests to the bucket are not paced.
+
+Given the remaining budget and the time left until the end of the window, the requests are planned at a
+regular interval, so that the share of the limit not allotted to the adapter is still left at the end of
+the window. Once the share of the adapter is exhausted, requests wait for the next window.
+
+A RatePlanner is shared by the requests of all the syncs served by an adapter, and is safe for concurrent use.
+*/
+type RatePlanner struct {
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*rateLimitBucket
+}
+
+type rateLimitBucket struct {
+	budget RateLimitBudget
+
+	// next is the earliest time at which the next request may be sent.
+	next time.Time
+}
+
+// NewRatePlanner returns a RatePlanner without any known budget.
+func NewRatePlanner() *RatePlanner {
+	return &RatePlanner{
+		Now:     time.Now,
+		buckets: make(map[string]*rateLimitBucket),
+	}
+}
+
+// rateLimitBucketKey returns the key of the rate limit bucket of a request.
+func rateLimitBucketKey(request *Request) string {
+	return request.BaseURL + rateLimitBuckets[request.EntityExternalID]
+}
+
+// Plan reserves a request to the rate limit bucket of the request, and returns the planned pace of the
+// requests to the bucket, including the time to wait before sending the request. budgetPercent is the
+// percentage of the limit of the bucket allotted to the adapter. false is returned if the budget of the
+// bucket is unknown or has expired, in which case the request can be sent immediately.
+func (p *RatePlanner) Plan(key string, budgetPercent int) (RateLimitPlan, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.Now()
+
+	bucket, found := p.buckets[key]
+	if !found || !now.Before(bucket.budget.Reset) {
+		return RateLimitPlan{}, false
+	}
+
+	plan := RateLimitPlan{RateLimitBudget: bucket.budget}
+
+	reserved := bucket.budget.Limit * (100 - budgetPercent) / 100
+	untilReset := bucket.budget.Reset.Sub(now)
+
+	allowed := bucket.budget.Remaining - reserved
+	if allowed <= 0 {
+		plan.Interval = untilReset
+		plan.Wait = untilReset
+
+		return plan, true
+	}
+
+	plan.Interval = untilReset / time.Duration(allowed)
+
+	start := now
+	if bucket.next.After(now) {
+		start = bucket.next
+	}
+
+	plan.Wait = start.Sub(now)
+
+	bucket.next = start.Add(plan.Interval)
+	bucket.budget.Remaining--
+
+	return plan, true
+}
+
+// Update updates the budget of a rate limit bucket from the rate limit headers of a response.
+func (p *RatePlanner) Update(key string, header http.Header) {
+	budget, ok := parseRateLimitBudget(header)
+	if !ok {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	bucket, found := p.buckets[key]
+	if !found {
+		p.buckets[key] = &rateLimitBucket{budget: budget}
+
+		return
+	}
+
+	// Responses to concurrent requests may arrive out of order, so keep the lowest remaining budget of the
+	// current window.
+	if budget.Reset.Equal(bucket.budget.Reset) && budget.Remaining > bucket.budget.Remaining {
+		return
+	}
+
+	bucket.budget = budget
+}
+
+// wait waits for the duration, or until the context is done.
+func wait(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
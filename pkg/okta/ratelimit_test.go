@@ -0,0 +1,137 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package okta_test
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	okta_adapter "github.com/sgnl-ai/adapters/pkg/okta"
+)
+
+func rateLimitHeader(limit, remaining int, reset time.Time) http.Header {
+	header := http.Header{}
+	header.Set("X-Rate-Limit-Limit", strconv.Itoa(limit))
+	header.Set("X-Rate-Limit-Remaining", strconv.Itoa(remaining))
+	header.Set("X-Rate-Limit-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+	return header
+}
+
+func TestRatePlanner(t *testing.T) {
+	now := time.Unix(1767225600, 0)
+	reset := now.Add(time.Minute)
+
+	tests := map[string]struct {
+		// updates are the headers of the responses received before planning.
+		updates       []http.Header
+		budgetPercent int
+		wantPlans     []okta_adapter.RateLimitPlan
+		wantPlanned   bool
+	}{
+		"unknown_budget": {
+			budgetPercent: 50,
+			wantPlans:     []okta_adapter.RateLimitPlan{{}},
+		},
+		"missing_headers": {
+			updates:       []http.Header{{}},
+			budgetPercent: 50,
+			wantPlans:     []okta_adapter.RateLimitPlan{{}},
+		},
+		"expired_budget": {
+			updates:       []http.Header{rateLimitHeader(600, 0, now)},
+			budgetPercent: 50,
+			wantPlans:     []okta_adapter.RateLimitPlan{{}},
+		},
+		"paced": {
+			updates:       []http.Header{rateLimitHeader(600, 500, reset)},
+			budgetPercent: 50,
+			wantPlans: []okta_adapter.RateLimitPlan{
+				{
+					RateLimitBudget: okta_adapter.RateLimitBudget{Limit: 600, Remaining: 500, Reset: reset},
+					Interval:        300 * time.Millisecond,
+				},
+				{
+					RateLimitBudget: okta_adapter.RateLimitBudget{Limit: 600, Remaining: 499, Reset: reset},
+					Interval:        time.Minute / 199,
+					Wait:            300 * time.Millisecond,
+				},
+			},
+			wantPlanned: true,
+		},
+		"full_budget": {
+			updates:       []http.Header{rateLimitHeader(600, 600, reset)},
+			budgetPercent: 100,
+			wantPlans: []okta_adapter.RateLimitPlan{
+				{
+					RateLimitBudget: okta_adapter.RateLimitBudget{Limit: 600, Remaining: 600, Reset: reset},
+					Interval:        100 * time.Millisecond,
+				},
+			},
+			wantPlanned: true,
+		},
+		"exhausted_budget": {
+			updates:       []http.Header{rateLimitHeader(600, 300, reset)},
+			budgetPercent: 50,
+			wantPlans: []okta_adapter.RateLimitPlan{
+				{
+					RateLimitBudget: okta_adapter.RateLimitBudget{Limit: 600, Remaining: 300, Reset: reset},
+					Interval:        time.Minute,
+					Wait:            time.Minute,
+				},
+			},
+			wantPlanned: true,
+		},
+		"out_of_order_responses": {
+			updates:       []http.Header{rateLimitHeader(600, 400, reset), rateLimitHeader(600, 450, reset)},
+			budgetPercent: 50,
+			wantPlans: []okta_adapter.RateLimitPlan{
+				{
+					RateLimitBudget: okta_adapter.RateLimitBudget{Limit: 600, Remaining: 400, Reset: reset},
+					Interval:        600 * time.Millisecond,
+				},
+			},
+			wantPlanned: true,
+		},
+		"next_window": {
+			updates: []http.Header{
+				rateLimitHeader(600, 300, reset),
+				rateLimitHeader(600, 599, reset.Add(time.Minute)),
+			},
+			budgetPercent: 50,
+			wantPlans: []okta_adapter.RateLimitPlan{
+				{
+					RateLimitBudget: okta_adapter.RateLimitBudget{Limit: 600, Remaining: 599, Reset: reset.Add(time.Minute)},
+					Interval:        2 * time.Minute / 299,
+				},
+			},
+			wantPlanned: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			planner := okta_adapter.NewRatePlanner()
+			planner.Now = func() time.Time { return now }
+
+			for _, header := range tt.updates {
+				planner.Update("https://test-instance.oktapreview.com/api/v1/users", header)
+			}
+
+			for i, wantPlan := range tt.wantPlans {
+				gotPlan, gotPlanned := planner.Plan("https://test-instance.oktapreview.com/api/v1/users", tt.budgetPercent)
+
+				if gotPlanned != tt.wantPlanned {
+					t.Errorf("plan %d: gotPlanned: %v, wantPlanned: %v", i, gotPlanned, tt.wantPlanned)
+				}
+
+				if !reflect.DeepEqual(gotPlan, wantPlan) {
+					t.Errorf("plan %d: gotPlan: %+v, wantPlan: %+v", i, gotPlan, wantPlan)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package okta
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+const (
+	// profileAttributePrefix is the prefix of the JSONPath external IDs of the attributes of the user profiles.
+	profileAttributePrefix = "$.profile."
+
+	// complexProfileAttributePrefix is the prefix of the complex external IDs of the attributes of the user profiles.
+	complexProfileAttributePrefix = "profile__"
+)
+
+// profileAttributeTypes maps the types of the Okta profile attributes to the types of the SGNL attributes.
+// The Okta dates are strings, e.g. "2024-03-05", so the string attributes may also be synced as DateTime.
+var profileAttributeTypes = map[string]framework.AttributeType{
+	"string":  framework.AttributeTypeString,
+	"boolean": framework.AttributeTypeBool,
+	"integer": framework.AttributeTypeInt64,
+	"number":  framework.AttributeTypeDouble,
+}
+
+// attributeTypeNames are the names of the SGNL attribute types, as set in the attributeType attribute of the
+// UserSchemaAttribute entity.
+var attributeTypeNames = map[framework.AttributeType]string{
+	framework.AttributeTypeBool:     "Bool",
+	framework.AttributeTypeDateTime: "DateTime",
+	framework.AttributeTypeDouble:   "Double",
+	framework.AttributeTypeDuration: "Duration",
+	framework.AttributeTypeInt64:    "Int64",
+	framework.AttributeTypeString:   "String",
+}
+
+// UserSchemaResponse is a response of the Schemas API returned by the datasource.
+type UserSchemaResponse struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Attributes are the profile attributes of the schema, sorted by scope (base first) and name.
+	// Each attribute is an object of the UserSchemaAttribute entity, without the user type attributes.
+	Attributes []map[string]any
+}
+
+// userSchema is a user schema returned by the Schemas API.
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/Schema/#tag/Schema/operation/getUserSchema
+type userSchema struct {
+	Definitions map[string]struct {
+		Properties map[string]userSchemaProperty `json:"properties"`
+	} `json:"definitions"`
+}
+
+// userSchemaProperty is a profile attribute of a user schema.
+type userSchemaProperty struct {
+	Title      string `json:"title"`
+	Type       string `json:"type"`
+	Required   bool   `json:"required"`
+	Mutability string `json:"mutability"`
+	Items      *struct {
+		Type string `json:"type"`
+	} `json:"items"`
+}
+
+// GetUserSchema requests the user schema with the ID, e.g. "default" for the schema of the default user type, and
+// returns its base and custom profile attributes.
+func (d *Datasource) GetUserSchema(
+	ctx context.Context, request *Request, schemaID string,
+) (*UserSchemaResponse, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(fields.RequestEntityExternalID(request.EntityExternalID))
+
+	var schema userSchema
+
+	httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: request.BaseURL + "/api/" + request.APIVersion + "/meta/schemas/user/" + schemaID,
+		Header: http.Header{
+			"Authorization": {request.Token},
+			"Accept":        {"application/json"},
+		},
+		DatasourceName:        "Okta",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Okta")
+		if readErr != nil {
+			return readErr
+		}
+
+		return httpds.UnmarshalJSON(bodyBytes, &schema)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserSchemaResponse{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	for _, scope := range []string{"base", "custom"} {
+		properties := schema.Definitions[scope].Properties
+
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+
+		slices.Sort(names)
+
+		for _, name := range names {
+			response.Attributes = append(response.Attributes, newUserSchemaAttribute(scope, name, properties[name]))
+		}
+	}
+
+	return response, nil
+}
+
+// newUserSchemaAttribute returns the object of the UserSchemaAttribute entity of a profile attribute, with the
+// external ID and the type of the SGNL attribute to sync it.
+func newUserSchemaAttribute(scope, name string, property userSchemaProperty) map[string]any {
+	attribute := map[string]any{
+		"scope":      scope,
+		"name":       name,
+		"title":      property.Title,
+		"type":       property.Type,
+		"required":   property.Required,
+		"mutability": property.Mutability,
+		"externalId": profileAttributePrefix + name,
+		"list":       property.Type == "array",
+	}
+
+	valueType := property.Type
+
+	if property.Items != nil {
+		attribute["itemsType"] = property.Items.Type
+		valueType = property.Items.Type
+	}
+
+	if attributeType, found := profileAttributeTypes[valueType]; found {
+		attribute["attributeType"] = attributeTypeNames[attributeType]
+	}
+
+	return attribute
+}
+
+// GetUserSchemaAttributesPage returns a page of the UserSchemaAttribute entity: the profile attributes of the
+// schemas of all the user types. The user types and their schemas aren't paginated by Okta, so they're requested
+// for each page, and the CompositeCursor.Cursor string stores the offset of the next page.
+func (a *Adapter) GetUserSchemaAttributesPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	attributes, response, err := a.getUserSchemaAttributes(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+
+	page, nextOffset, err := pagination.PaginateObjects(attributes, request.PageSize, request.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	response = &Response{
+		StatusCode: http.StatusOK,
+		Objects:    page,
+	}
+
+	if nextOffset != nil {
+		response.NextCursor = &pagination.CompositeCursor[string]{
+			Cursor: nextOffset,
+		}
+	}
+
+	return response, nil
+}
+
+// getUserSchemaAttributes returns the profile attributes of the schemas of all the user types, with the ID and
+// the name of their user type. If a request isn't successful, its response is returned instead.
+func (a *Adapter) getUserSchemaAttributes(
+	ctx context.Context, request *Request,
+) ([]map[string]any, *Response, *framework.Error) {
+	userTypesRequest := *request
+	userTypesRequest.EntityExternalID = UserTypes
+	userTypesRequest.Cursor = nil
+	userTypesRequest.Filter = ""
+	userTypesRequest.Search = ""
+
+	userTypes, err := a.OktaClient.GetPage(ctx, &userTypesRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if userTypes.StatusCode != http.StatusOK {
+		return nil, userTypes, nil
+	}
+
+	var attributes []map[string]any
+
+	for _, userType := range userTypes.Objects {
+		userTypeID, _ := userType[uniqueIDAttribute].(string)
+		userTypeName, _ := userType["name"].(string)
+
+		schemaID, err := userTypeSchemaID(userType)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		schema, err := a.OktaClient.GetUserSchema(ctx, request, schemaID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if schema.StatusCode != http.StatusOK {
+			return nil, &Response{
+				StatusCode:       schema.StatusCode,
+				RetryAfterHeader: schema.RetryAfterHeader,
+			}, nil
+		}
+
+		for _, attribute := range schema.Attributes {
+			attribute[uniqueIDAttribute] = fmt.Sprintf("%s-%s.%s", userTypeID, attribute["scope"], attribute["name"])
+			attribute["userTypeId"] = userTypeID
+			attribute["userTypeName"] = userTypeName
+
+			attributes = append(attributes, attribute)
+		}
+	}
+
+	return attributes, nil, nil
+}
+
+// userTypeSchemaID returns the ID of the schema of a user type, the last segment of its schema link, e.g.
+// "oscfin4ml7QyBGmLb0g4" for "https://acme.okta.com/api/v1/meta/schemas/user/oscfin4ml7QyBGmLb0g4".
+func userTypeSchemaID(userType map[string]any) (string, *framework.Error) {
+	links, _ := userType["_links"].(map[string]any)
+	schemaLink, _ := links["schema"].(map[string]any)
+	href, _ := schemaLink["href"].(string)
+
+	if href == "" {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Failed to parse the schema link of Okta user type %v.", userType[uniqueIDAttribute]),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return path.Base(href), nil
+}
+
+// ValidateProfileAttributes validates that the requested attributes of the user profiles, e.g. $.profile.costCenter
+// or profile__costCenter, are profile attributes of the schema of at least one user type, and that their types
+// match the types of the profile attributes. If a request isn't successful, its response is returned instead.
+func (a *Adapter) ValidateProfileAttributes(
+	ctx context.Context, request *Request, attributes []*framework.AttributeConfig,
+) (*Response, *framework.Error) {
+	schemaAttributes, response, err := a.getUserSchemaAttributes(ctx, request)
+	if err != nil || response != nil {
+		return response, err
+	}
+
+	profileAttributes := make(map[string]map[string]any, len(schemaAttributes))
+	for _, attribute := range schemaAttributes {
+		profileAttributes[attribute["name"].(string)] = attribute
+	}
+
+	for _, attribute := range attributes {
+		name, found := strings.CutPrefix(attribute.ExternalId, profileAttributePrefix)
+		if !found {
+			if name, found = strings.CutPrefix(attribute.ExternalId, complexProfileAttributePrefix); !found {
+				continue
+			}
+		}
+
+		profileAttribute, found := profileAttributes[name]
+		if !found {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s is not a profile attribute of the Okta user types.", attribute.ExternalId,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+
+		wantType, _ := profileAttribute["attributeType"].(string)
+		gotType := attributeTypeNames[attribute.Type]
+
+		typeMatches := gotType == wantType || (wantType == "String" && gotType == "DateTime")
+
+		if !typeMatches || attribute.List != profileAttribute["list"].(bool) {
+			if attribute.List {
+				gotType = "list of " + gotType
+			}
+
+			profileType := profileAttribute["type"]
+			if itemsType, found := profileAttribute["itemsType"]; found {
+				profileType = fmt.Sprintf("%v of %v", profileType, itemsType)
+			}
+
+			return nil, &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s of type %s does not match the Okta profile attribute of type %s.",
+					attribute.ExternalId, gotType, profileType,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package okta_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/okta"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+var TestSchemaServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.RequestURI() {
+	case "/api/v1/meta/types/user":
+		w.Write([]byte(`[
+			{
+				"id": "oty1",
+				"name": "user",
+				"default": true,
+				"_links": {"schema": {"href": "https://test-instance.okta.com/api/v1/meta/schemas/user/osc1"}}
+			},
+			{
+				"id": "oty2",
+				"name": "contractor",
+				"default": false,
+				"_links": {"schema": {"href": "https://test-instance.okta.com/api/v1/meta/schemas/user/osc2"}}
+			}
+		]`))
+	case "/api/v1/meta/schemas/user/osc1":
+		w.Write([]byte(`{
+			"id": "https://test-instance.okta.com/meta/schemas/user/osc1",
+			"definitions": {
+				"custom": {
+					"id": "#custom",
+					"properties": {
+						"costCenter": {"title": "Cost center", "type": "integer", "mutability": "READ_WRITE"},
+						"skills": {"title": "Skills", "type": "array", "items": {"type": "string"}, "mutability": "READ_WRITE"}
+					}
+				},
+				"base": {
+					"id": "#base",
+					"properties": {
+						"login": {"title": "Username", "type": "string", "required": true, "mutability": "READ_WRITE"}
+					}
+				}
+			}
+		}`))
+	case "/api/v1/meta/schemas/user/osc2":
+		w.Write([]byte(`{
+			"id": "https://test-instance.okta.com/meta/schemas/user/osc2",
+			"definitions": {
+				"custom": {
+					"id": "#custom",
+					"properties": {
+						"agency": {"title": "Agency", "type": "string", "mutability": "READ_WRITE"}
+					}
+				},
+				"base": {
+					"id": "#base",
+					"properties": {
+						"login": {"title": "Username", "type": "string", "required": true, "mutability": "READ_WRITE"}
+					}
+				}
+			}
+		}`))
+	case "/api/v1/users?limit=2":
+		w.Write([]byte(`[{"id": "00u1", "profile": {"login": "alice@example.com", "costCenter": 42}}]`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestAdapterGetUserSchemaAttributePage(t *testing.T) {
+	server := httptest.NewTLSServer(TestSchemaServerHandler)
+	adapter := okta.NewAdapter(&okta.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[string]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	newRequest := func(cursor string) *framework.Request[okta.Config] {
+		return &framework.Request[okta.Config]{
+			Address: server.URL,
+			Auth: &framework.DatasourceAuthCredentials{
+				HTTPAuthorization: "SSWS testtoken",
+			},
+			Config: &okta.Config{
+				APIVersion: "v1",
+			},
+			Entity: framework.EntityConfig{
+				ExternalId: okta.UserSchemaAttributes,
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString},
+					{ExternalId: "userTypeName", Type: framework.AttributeTypeString},
+					{ExternalId: "scope", Type: framework.AttributeTypeString},
+					{ExternalId: "externalId", Type: framework.AttributeTypeString},
+					{ExternalId: "attributeType", Type: framework.AttributeTypeString},
+					{ExternalId: "list", Type: framework.AttributeTypeBool},
+				},
+			},
+			PageSize: 3,
+			Cursor:   cursor,
+		}
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[okta.Config]
+		wantResponse framework.Response
+	}{
+		"first_page": {
+			request: newRequest(""),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "oty1-base.login", "userTypeName": "user", "scope": "base", "externalId": "$.profile.login", "attributeType": "String", "list": false},
+						{"id": "oty1-custom.costCenter", "userTypeName": "user", "scope": "custom", "externalId": "$.profile.costCenter", "attributeType": "Int64", "list": false},
+						{"id": "oty1-custom.skills", "userTypeName": "user", "scope": "custom", "externalId": "$.profile.skills", "attributeType": "String", "list": true},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{Cursor: testutil.GenPtr("3")}),
+				},
+			},
+		},
+		"last_page": {
+			request: newRequest(marshalCursor(&pagination.CompositeCursor[string]{Cursor: testutil.GenPtr("3")})),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "oty2-base.login", "userTypeName": "contractor", "scope": "base", "externalId": "$.profile.login", "attributeType": "String", "list": false},
+						{"id": "oty2-custom.agency", "userTypeName": "contractor", "scope": "custom", "externalId": "$.profile.agency", "attributeType": "String", "list": false},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(tt.wantResponse, gotResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdapterGetUserPageWithValidateProfileAttributes(t *testing.T) {
+	server := httptest.NewTLSServer(TestSchemaServerHandler)
+	adapter := okta.NewAdapter(&okta.Datasource{
+		Client: server.Client(),
+	})
+
+	newRequest := func(attributes ...*framework.AttributeConfig) *framework.Request[okta.Config] {
+		return &framework.Request[okta.Config]{
+			Address: server.URL,
+			Auth: &framework.DatasourceAuthCredentials{
+				HTTPAuthorization: "SSWS testtoken",
+			},
+			Config: &okta.Config{
+				APIVersion:                "v1",
+				ValidateProfileAttributes: true,
+			},
+			Entity: framework.EntityConfig{
+				ExternalId: okta.Users,
+				Attributes: append([]*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString},
+				}, attributes...),
+			},
+			PageSize: 2,
+		}
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[okta.Config]
+		wantResponse framework.Response
+	}{
+		"valid_profile_attributes": {
+			request: newRequest(
+				&framework.AttributeConfig{ExternalId: "$.profile.login", Type: framework.AttributeTypeString},
+				&framework.AttributeConfig{ExternalId: "profile__costCenter", Type: framework.AttributeTypeInt64},
+				&framework.AttributeConfig{ExternalId: "$.profile.agency", Type: framework.AttributeTypeString},
+			),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": "00u1", "$.profile.login": "alice@example.com", "profile__costCenter": int64(42)},
+					},
+				},
+			},
+		},
+		"unknown_profile_attribute": {
+			request: newRequest(
+				&framework.AttributeConfig{ExternalId: "$.profile.costCentre", Type: framework.AttributeTypeInt64},
+			),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Attribute $.profile.costCentre is not a profile attribute of the Okta user types.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+				},
+			},
+		},
+		"mismatched_type": {
+			request: newRequest(
+				&framework.AttributeConfig{ExternalId: "$.profile.costCenter", Type: framework.AttributeTypeString},
+			),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Attribute $.profile.costCenter of type String does not match the Okta profile attribute of type integer.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+				},
+			},
+		},
+		"mismatched_list": {
+			request: newRequest(
+				&framework.AttributeConfig{ExternalId: "$.profile.skills", Type: framework.AttributeTypeString},
+			),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Attribute $.profile.skills of type String does not match the Okta profile attribute of type array of string.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(tt.wantResponse, gotResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -44,7 +44,14 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		request.Address = trimmedAddress
 	}
 
-	if request.Auth == nil || request.Auth.HTTPAuthorization == "" {
+	// The orgs are queried with their own tokens, instead of the datasource auth.
+	usesOrgs := len(request.Config.Orgs) > 0
+
+	if usesOrgs {
+		if err := validateOrgs(request.Config.Orgs); err != nil {
+			return err
+		}
+	} else if request.Auth == nil || request.Auth.HTTPAuthorization == "" {
 		return &framework.Error{
 			Message: "Provided datasource auth is missing required http authorization credentials.",
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
@@ -58,7 +65,7 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
-	if !strings.HasPrefix(request.Auth.HTTPAuthorization, "Bearer ") &&
+	if !usesOrgs && !strings.HasPrefix(request.Auth.HTTPAuthorization, "Bearer ") &&
 		!strings.HasPrefix(request.Auth.HTTPAuthorization, "SSWS ") {
 		return &framework.Error{
 			Message: `Provided auth token is missing required "Bearer " or "SSWS " prefix.`,
@@ -10,6 +10,7 @@ import (
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	okta_adapter "github.com/sgnl-ai/adapters/pkg/okta"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
 )
 
 func TestValidateGetPageRequest(t *testing.T) {
@@ -111,6 +112,33 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"invalid_rate_limit_budget_percent": {
+			request: &framework.Request[okta_adapter.Config]{
+				Address: "test-instance.oktapreview.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "SSWS testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &okta_adapter.Config{
+					APIVersion:             "v1",
+					RateLimitBudgetPercent: testutil.GenPtr(0),
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Okta config is invalid: rateLimitBudgetPercent must be between 1 and 100.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_api_version": {
 			request: &framework.Request[okta_adapter.Config]{
 				Address: "test-instance.oktapreview.com",
@@ -450,6 +478,80 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
 			},
 		},
+		"valid_orgs_without_auth": {
+			request: &framework.Request[okta_adapter.Config]{
+				Address: "test-instance.oktapreview.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &okta_adapter.Config{
+					APIVersion: "v1",
+					Orgs: []okta_adapter.Org{
+						{URL: "test-instance.oktapreview.com", Token: "SSWS testtoken"},
+						{URL: "https://test-instance-eu.oktapreview.com", Token: "Bearer testtoken"},
+					},
+				},
+				PageSize: 250,
+			},
+			wantErr: nil,
+		},
+		"invalid_org_token_prefix": {
+			request: &framework.Request[okta_adapter.Config]{
+				Address: "test-instance.oktapreview.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &okta_adapter.Config{
+					APIVersion: "v1",
+					Orgs: []okta_adapter.Org{
+						{URL: "test-instance.oktapreview.com", Token: "testtoken"},
+					},
+				},
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: `Okta config is invalid: the token of org https://test-instance.oktapreview.com is missing required "Bearer " or "SSWS " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_duplicate_orgs": {
+			request: &framework.Request[okta_adapter.Config]{
+				Address: "test-instance.oktapreview.com",
+				Entity: framework.EntityConfig{
+					ExternalId: "User",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &okta_adapter.Config{
+					APIVersion: "v1",
+					Orgs: []okta_adapter.Org{
+						{URL: "test-instance.oktapreview.com", Token: "SSWS testtoken"},
+						{URL: "https://test-instance.oktapreview.com", Token: "SSWS testtoken2"},
+					},
+				},
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Okta config is invalid: org https://test-instance.oktapreview.com is specified more than once.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 	}
 
 	adapter := &okta_adapter.Adapter{}
@@ -0,0 +1,148 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package oncall resolves who is on call from the on-call shifts returned by incident management systems, e.g. the
+// on-calls of PagerDuty or the shifts of the Opsgenie and Rootly schedules.
+//
+// The adapters convert the objects of their datasource to Shifts, and the resolution is shared: the users on call
+// for each schedule at a given time, and the shifts overlapping a time window, merged and clipped to the window.
+package oncall
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Shift is a period a user is on call for a schedule, or for a level of an escalation policy.
+type Shift struct {
+	// ScheduleID is the ID of the schedule the user is on call for.
+	// Empty if the user is on call directly through the escalation policy, without a schedule.
+	ScheduleID string
+
+	// EscalationPolicyID is the ID of the escalation policy the user is on call for, if any.
+	EscalationPolicyID string
+
+	// EscalationLevel is the level of the escalation policy the user is on call for, starting at 1.
+	// 0 if the datasource has no escalation levels.
+	EscalationLevel int
+
+	// UserID is the ID of the user on call.
+	UserID string
+
+	// Start is the start of the shift. The zero time if the shift has no start, e.g. a user permanently on call.
+	Start time.Time
+
+	// End is the end of the shift, excluded. The zero time if the shift has no end.
+	End time.Time
+}
+
+// Contains returns whether the user is on call at the time.
+func (s *Shift) Contains(t time.Time) bool {
+	return (s.Start.IsZero() || !t.Before(s.Start)) && (s.End.IsZero() || t.Before(s.End))
+}
+
+// Overlaps returns whether the user is on call during the window between start and end, excluded.
+func (s *Shift) Overlaps(start, end time.Time) bool {
+	return (s.Start.IsZero() || s.Start.Before(end)) && (s.End.IsZero() || s.End.After(start))
+}
+
+// ParseTime parses the start or end of a shift in RFC 3339 format, e.g. "2024-03-05T14:00:00Z".
+// An empty string is parsed as the zero time, i.e. a shift without a start or an end.
+func ParseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid shift time %q: %w", value, err)
+	}
+
+	return t, nil
+}
+
+// Current returns the IDs of the users on call for each schedule at the time, sorted, by schedule ID.
+// The users on call directly through an escalation policy are returned for the empty schedule ID.
+// A user on call for a schedule through several escalation policies or levels is returned once.
+func Current(shifts []Shift, at time.Time) map[string][]string {
+	current := make(map[string][]string)
+
+	for i := range shifts {
+		if shifts[i].Contains(at) {
+			current[shifts[i].ScheduleID] = append(current[shifts[i].ScheduleID], shifts[i].UserID)
+		}
+	}
+
+	for scheduleID, userIDs := range current {
+		slices.Sort(userIDs)
+
+		current[scheduleID] = slices.Compact(userIDs)
+	}
+
+	return current
+}
+
+// InWindow returns the shifts overlapping the window between start and end, excluded, clipped to the window.
+//
+// The shifts of a user for the same schedule, escalation policy and level which overlap or are contiguous are
+// merged, as the datasources may split a shift at the boundaries of the layers or the rotations of a schedule.
+// The shifts are sorted by schedule ID, start, escalation policy ID, escalation level and user ID.
+func InWindow(shifts []Shift, start, end time.Time) []Shift {
+	var window []Shift
+
+	for i := range shifts {
+		if !shifts[i].Overlaps(start, end) {
+			continue
+		}
+
+		shift := shifts[i]
+
+		if shift.Start.IsZero() || shift.Start.Before(start) {
+			shift.Start = start
+		}
+
+		if shift.End.IsZero() || shift.End.After(end) {
+			shift.End = end
+		}
+
+		window = append(window, shift)
+	}
+
+	slices.SortFunc(window, func(a, b Shift) int {
+		return cmp.Or(
+			cmp.Compare(a.ScheduleID, b.ScheduleID),
+			cmp.Compare(a.EscalationPolicyID, b.EscalationPolicyID),
+			cmp.Compare(a.EscalationLevel, b.EscalationLevel),
+			cmp.Compare(a.UserID, b.UserID),
+			a.Start.Compare(b.Start),
+		)
+	})
+
+	// The shifts of the same user, schedule, escalation policy and level are now adjacent, sorted by start.
+	merged := make([]Shift, 0, len(window))
+
+	for _, shift := range window {
+		if n := len(merged); n > 0 && sameAssignment(&merged[n-1], &shift) && !shift.Start.After(merged[n-1].End) {
+			if shift.End.After(merged[n-1].End) {
+				merged[n-1].End = shift.End
+			}
+
+			continue
+		}
+
+		merged = append(merged, shift)
+	}
+
+	slices.SortStableFunc(merged, func(a, b Shift) int {
+		return cmp.Or(cmp.Compare(a.ScheduleID, b.ScheduleID), a.Start.Compare(b.Start))
+	})
+
+	return merged
+}
+
+// sameAssignment returns whether the shifts are for the same user, schedule, escalation policy and level.
+func sameAssignment(a, b *Shift) bool {
+	return a.UserID == b.UserID && a.ScheduleID == b.ScheduleID &&
+		a.EscalationPolicyID == b.EscalationPolicyID && a.EscalationLevel == b.EscalationLevel
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package oncall_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sgnl-ai/adapters/pkg/oncall"
+)
+
+func hour(h int) time.Time {
+	return time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC).Add(time.Duration(h) * time.Hour)
+}
+
+func TestShiftContains(t *testing.T) {
+	tests := map[string]struct {
+		shift oncall.Shift
+		at    time.Time
+		want  bool
+	}{
+		"inside": {
+			shift: oncall.Shift{Start: hour(8), End: hour(16)},
+			at:    hour(12),
+			want:  true,
+		},
+		"at_start": {
+			shift: oncall.Shift{Start: hour(8), End: hour(16)},
+			at:    hour(8),
+			want:  true,
+		},
+		"at_end": {
+			shift: oncall.Shift{Start: hour(8), End: hour(16)},
+			at:    hour(16),
+			want:  false,
+		},
+		"before_start": {
+			shift: oncall.Shift{Start: hour(8), End: hour(16)},
+			at:    hour(7),
+			want:  false,
+		},
+		"unbounded": {
+			shift: oncall.Shift{},
+			at:    hour(7),
+			want:  true,
+		},
+		"no_end": {
+			shift: oncall.Shift{Start: hour(8)},
+			at:    hour(100),
+			want:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.shift.Contains(tt.at); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShiftOverlaps(t *testing.T) {
+	tests := map[string]struct {
+		shift      oncall.Shift
+		start, end time.Time
+		want       bool
+	}{
+		"overlapping_start": {
+			shift: oncall.Shift{Start: hour(8), End: hour(16)},
+			start: hour(4),
+			end:   hour(9),
+			want:  true,
+		},
+		"containing_window": {
+			shift: oncall.Shift{Start: hour(8), End: hour(16)},
+			start: hour(10),
+			end:   hour(12),
+			want:  true,
+		},
+		"ending_at_window_start": {
+			shift: oncall.Shift{Start: hour(8), End: hour(16)},
+			start: hour(16),
+			end:   hour(20),
+			want:  false,
+		},
+		"starting_at_window_end": {
+			shift: oncall.Shift{Start: hour(8), End: hour(16)},
+			start: hour(4),
+			end:   hour(8),
+			want:  false,
+		},
+		"no_start": {
+			shift: oncall.Shift{End: hour(16)},
+			start: hour(-100),
+			end:   hour(-99),
+			want:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.shift.Overlaps(tt.start, tt.end); got != tt.want {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		want    time.Time
+		wantErr string
+	}{
+		"empty": {
+			value: "",
+			want:  time.Time{},
+		},
+		"utc": {
+			value: "2024-03-05T08:00:00Z",
+			want:  hour(8),
+		},
+		"offset": {
+			value: "2024-03-05T10:00:00+02:00",
+			want:  hour(8),
+		},
+		"invalid": {
+			value:   "2024-03-05",
+			wantErr: `invalid shift time "2024-03-05": parsing time "2024-03-05" as "2006-01-02T15:04:05Z07:00": cannot parse "" as "T"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := oncall.ParseTime(tt.value)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("ParseTime() error = %v, wantErr %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseTime() unexpected error: %v", err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrent(t *testing.T) {
+	shifts := []oncall.Shift{
+		{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U2", Start: hour(8), End: hour(16)},
+		{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(0), End: hour(8)},
+		{ScheduleID: "S1", EscalationPolicyID: "EP2", EscalationLevel: 2, UserID: "U2", Start: hour(8), End: hour(16)},
+		{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U3", Start: hour(8), End: hour(16)},
+		{ScheduleID: "S2", EscalationPolicyID: "EP3", EscalationLevel: 1, UserID: "U4", Start: hour(0), End: hour(24)},
+		{EscalationPolicyID: "EP1", EscalationLevel: 2, UserID: "U5"},
+	}
+
+	tests := map[string]struct {
+		at   time.Time
+		want map[string][]string
+	}{
+		"morning": {
+			at: hour(4),
+			want: map[string][]string{
+				"S1": {"U1"},
+				"S2": {"U4"},
+				"":   {"U5"},
+			},
+		},
+		"handoff": {
+			at: hour(8),
+			want: map[string][]string{
+				"S1": {"U2", "U3"},
+				"S2": {"U4"},
+				"":   {"U5"},
+			},
+		},
+		"next_day": {
+			at: hour(30),
+			want: map[string][]string{
+				"": {"U5"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := oncall.Current(shifts, tt.at)
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Current() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInWindow(t *testing.T) {
+	tests := map[string]struct {
+		shifts     []oncall.Shift
+		start, end time.Time
+		want       []oncall.Shift
+	}{
+		"clipped": {
+			shifts: []oncall.Shift{
+				{ScheduleID: "S1", UserID: "U1", Start: hour(0), End: hour(12)},
+				{ScheduleID: "S1", UserID: "U2", Start: hour(12), End: hour(24)},
+				{ScheduleID: "S1", UserID: "U3", Start: hour(24), End: hour(36)},
+			},
+			start: hour(6),
+			end:   hour(18),
+			want: []oncall.Shift{
+				{ScheduleID: "S1", UserID: "U1", Start: hour(6), End: hour(12)},
+				{ScheduleID: "S1", UserID: "U2", Start: hour(12), End: hour(18)},
+			},
+		},
+		"unbounded": {
+			shifts: []oncall.Shift{
+				{EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1"},
+			},
+			start: hour(6),
+			end:   hour(18),
+			want: []oncall.Shift{
+				{EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(6), End: hour(18)},
+			},
+		},
+		"merged": {
+			shifts: []oncall.Shift{
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(8), End: hour(12)},
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(0), End: hour(8)},
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(10), End: hour(14)},
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(16), End: hour(20)},
+			},
+			start: hour(0),
+			end:   hour(24),
+			want: []oncall.Shift{
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(0), End: hour(14)},
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(16), End: hour(20)},
+			},
+		},
+		"not_merged_across_levels": {
+			shifts: []oncall.Shift{
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 2, UserID: "U1", Start: hour(8), End: hour(12)},
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(0), End: hour(8)},
+			},
+			start: hour(0),
+			end:   hour(24),
+			want: []oncall.Shift{
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 1, UserID: "U1", Start: hour(0), End: hour(8)},
+				{ScheduleID: "S1", EscalationPolicyID: "EP1", EscalationLevel: 2, UserID: "U1", Start: hour(8), End: hour(12)},
+			},
+		},
+		"sorted_by_schedule": {
+			shifts: []oncall.Shift{
+				{ScheduleID: "S2", UserID: "U1", Start: hour(0), End: hour(8)},
+				{ScheduleID: "S1", UserID: "U3", Start: hour(8), End: hour(16)},
+				{ScheduleID: "S1", UserID: "U2", Start: hour(0), End: hour(8)},
+			},
+			start: hour(0),
+			end:   hour(24),
+			want: []oncall.Shift{
+				{ScheduleID: "S1", UserID: "U2", Start: hour(0), End: hour(8)},
+				{ScheduleID: "S1", UserID: "U3", Start: hour(8), End: hour(16)},
+				{ScheduleID: "S2", UserID: "U1", Start: hour(0), End: hour(8)},
+			},
+		},
+		"none": {
+			shifts: []oncall.Shift{
+				{ScheduleID: "S1", UserID: "U1", Start: hour(0), End: hour(8)},
+			},
+			start: hour(8),
+			end:   hour(16),
+			want:  []oncall.Shift{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := oncall.InWindow(tt.shifts, tt.start, tt.end)
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("InWindow() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
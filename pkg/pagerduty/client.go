@@ -33,7 +33,7 @@ type Request struct {
 
 	// EntityExternalID is the external ID of the entity.
 	// The external ID should match the API's resource name, e.g. "users", "teams", "schedules", etc.,
-	// with the only exception being "members" for team members.
+	// with the exceptions being "members" for team members and "contact_methods" for user contact methods.
 	EntityExternalID string
 
 	// Cursor identifies the first object of the page to return, as returned by
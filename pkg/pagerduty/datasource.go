@@ -23,12 +23,23 @@ import (
 )
 
 const (
-	Users   string = "users"
-	Teams   string = "teams"
-	Members string = "members"
-	OnCalls string = "oncalls"
+	Users          string = "users"
+	Teams          string = "teams"
+	Members        string = "members"
+	OnCalls        string = "oncalls"
+	ContactMethods string = "contact_methods"
 )
 
+// collectionEntities maps the external IDs of the entities listed per object of a collection entity to the
+// external ID of the collection entity, e.g. team members are listed per team.
+var collectionEntities = map[string]string{
+	// https://developer.pagerduty.com/api-reference/737e5d0fd8d4b-list-members-of-a-team.
+	Members: Teams,
+
+	// https://developer.pagerduty.com/api-reference/50d46c0eb020d-list-a-user-s-contact-methods.
+	ContactMethods: Users,
+}
+
 // Datasource implements the PagerDuty Client interface to allow querying the PagerDuty datasource.
 type Datasource struct {
 	Client *http.Client
@@ -51,74 +62,79 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 
 	cursor := request.Cursor
 
+	collectionEntity, isMemberEntity := collectionEntities[request.EntityExternalID]
+
 	if cursor == nil || cursor.Cursor == nil {
 		var zero int64
 
-		switch request.EntityExternalID {
-		case Members:
-			var teamCursor *int64
+		switch {
+		case isMemberEntity:
+			var collectionCursor *int64
 			if cursor != nil {
-				teamCursor = cursor.CollectionCursor
+				collectionCursor = cursor.CollectionCursor
 			}
 
-			if teamCursor == nil {
-				teamCursor = &zero
+			if collectionCursor == nil {
+				collectionCursor = &zero
 			}
 
-			// We have no more members to query for the last requested team,
+			// We have no more objects to query for the last requested collection object (e.g. team),
 			// or this is a request for the first page.
-			// Get the ID of the next team.
-			pagerDutyTeamsReq := &Request{
+			// Get the ID of the next collection object.
+			pagerDutyCollectionReq := &Request{
 				BaseURL:               request.BaseURL,
 				Token:                 request.Token,
 				PageSize:              1,
-				Cursor:                &pagination.CompositeCursor[int64]{Cursor: teamCursor},
-				EntityExternalID:      Teams,
+				Cursor:                &pagination.CompositeCursor[int64]{Cursor: collectionCursor},
+				EntityExternalID:      collectionEntity,
 				RequestTimeoutSeconds: request.RequestTimeoutSeconds,
 			}
 
-			teamsRes, err := d.GetPage(ctx, pagerDutyTeamsReq)
+			collectionRes, err := d.GetPage(ctx, pagerDutyCollectionReq)
 			if err != nil {
 				return nil, err
 			}
 
-			// If we fail to get teams, then we can't get members. Terminate and return the error.
-			if teamsRes.StatusCode != http.StatusOK {
-				return teamsRes, nil
+			// If we fail to get the collection objects, then we can't get their members.
+			// Terminate and return the error.
+			if collectionRes.StatusCode != http.StatusOK {
+				return collectionRes, nil
 			}
 
-			// There are no more teams. Return an empty last page.
-			if len(teamsRes.Objects) == 0 {
+			// There are no more collection objects. Return an empty last page.
+			if len(collectionRes.Objects) == 0 {
 				return &Response{
 					StatusCode: 202,
 				}, nil
 			}
 
-			firstTeamIDAsAny, found := teamsRes.Objects[0][UniqueIDAttribute]
+			firstCollectionIDAsAny, found := collectionRes.Objects[0][UniqueIDAttribute]
 			if !found {
 				return nil, &framework.Error{
-					Message: fmt.Sprintf("PagerDuty team object contains no %s field.", UniqueIDAttribute),
+					Message: fmt.Sprintf("PagerDuty %s object contains no %s field.", collectionEntity, UniqueIDAttribute),
 					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
 				}
 			}
 
-			teamID, ok := firstTeamIDAsAny.(string)
+			collectionID, ok := firstCollectionIDAsAny.(string)
 			if !ok {
 				return nil, &framework.Error{
-					Message: fmt.Sprintf("Failed to convert PagerDuty team object %s field to string.", UniqueIDAttribute),
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+					Message: fmt.Sprintf(
+						"Failed to convert PagerDuty %s object %s field to string.", collectionEntity, UniqueIDAttribute,
+					),
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
 				}
 			}
 
 			cursor = &pagination.CompositeCursor[int64]{
-				CollectionID: &teamID,
+				CollectionID: &collectionID,
 				Cursor:       &zero,
 			}
 
-			if teamsRes.NextCursor == nil {
+			if collectionRes.NextCursor == nil {
 				cursor.CollectionCursor = nil
 			} else {
-				cursor.CollectionCursor = teamsRes.NextCursor.Cursor
+				cursor.CollectionCursor = collectionRes.NextCursor.Cursor
 			}
 
 		default:
@@ -132,7 +148,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	validationErr := pagination.ValidateCompositeCursor(
 		cursor,
 		request.EntityExternalID,
-		request.EntityExternalID == Members,
+		isMemberEntity,
 	)
 	if validationErr != nil {
 		return nil, validationErr
@@ -149,16 +165,17 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	sb.WriteString(request.BaseURL)
 	sb.WriteRune('/')
 
-	if request.EntityExternalID == Members {
+	if isMemberEntity {
 		// If we sync team members, the endpoint becomes the following:
 		// baseURL/ + teams/:teamID/members + query params
-		escapedTeamID := url.PathEscape(*cursor.CollectionID)
-		sb.Grow(len(escapedTeamID) + 14)
-		sb.WriteString(Teams)
+		// Similarly for user contact methods: baseURL/ + users/:userID/contact_methods + query params
+		escapedCollectionID := url.PathEscape(*cursor.CollectionID)
+		sb.Grow(len(collectionEntity) + len(escapedCollectionID) + len(request.EntityExternalID) + 2)
+		sb.WriteString(collectionEntity)
 		sb.WriteRune('/')
-		sb.WriteString(escapedTeamID)
+		sb.WriteString(escapedCollectionID)
 		sb.WriteRune('/')
-		sb.WriteString(Members)
+		sb.WriteString(request.EntityExternalID)
 	} else {
 		// Otherwise, baseURL/ + :EntityExternalID + query params
 		sb.WriteString(request.EntityExternalID)
@@ -219,6 +236,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 	defer res.Body.Close()
@@ -299,6 +317,17 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		}
 
 		objects = teamMemberObjects
+		response.NextCursor.CollectionID = cursor.CollectionID
+		response.NextCursor.CollectionCursor = cursor.CollectionCursor
+	case ContactMethods:
+		// The contact methods of a user are not paginated, all of them are returned at once.
+		nextCursor = nil
+		response.NextCursor.Cursor = nil
+
+		for _, object := range objects {
+			object["userId"] = *cursor.CollectionID
+		}
+
 		response.NextCursor.CollectionID = cursor.CollectionID
 		response.NextCursor.CollectionCursor = cursor.CollectionCursor
 	case OnCalls:
@@ -306,77 +335,13 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		// Instead, we create it using the following fields: {escalation_policy.id}-{user.id}-{start}-{end}.
 		// If start and end are null, they are set to empty strings.
 		for _, object := range objects {
-			escalationPolicyMap, ok := object["escalation_policy"].(map[string]any)
-			if !ok {
-				return nil, &framework.Error{
-					Message: "Failed to parse a PagerDuty OnCall object's escalation_policy field as map[string]any.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}
-			}
-
-			escalationPolicyID, ok := escalationPolicyMap[UniqueIDAttribute].(string)
-			if !ok {
-				return nil, &framework.Error{
-					Message: fmt.Sprintf(
-						"Failed to parse a field in a PagerDuty OnCall object's escalation_policy object as string: %s.",
-						UniqueIDAttribute,
-					),
-					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}
-			}
-
-			userMap, ok := object["user"].(map[string]any)
-			if !ok {
-				return nil, &framework.Error{
-					Message: "Failed to parse a PagerDuty OnCall object's user field as map[string]any.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}
-			}
-
-			userID, ok := userMap[UniqueIDAttribute].(string)
-			if !ok {
-				return nil, &framework.Error{
-					Message: fmt.Sprintf(
-						"Failed to parse a field in a PagerDuty OnCall object's user object as string: %s.",
-						UniqueIDAttribute,
-					),
-					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}
-			}
-
-			startDate, endDate := object["start"], object["end"]
-
-			if startDate == nil {
-				startDate = ""
-			}
-
-			if endDate == nil {
-				endDate = ""
-			}
-
-			startDateString, ok := startDate.(string)
-			if !ok {
-				return nil, &framework.Error{
-					Message: fmt.Sprintf(
-						"Failed to parse a PagerDuty OnCall object's start field as string: %v.",
-						startDate,
-					),
-					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}
-			}
-
-			endDateString, ok := endDate.(string)
-			if !ok {
-				return nil, &framework.Error{
-					Message: fmt.Sprintf(
-						"Failed to parse a PagerDuty OnCall object's end field as string: %v.",
-						endDate,
-					),
-					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}
+			onCall, err := parseOnCall(object)
+			if err != nil {
+				return nil, err
 			}
 
-			object[UniqueIDAttribute] = escalationPolicyID + "-" + userID + "-" + startDateString + "-" + endDateString
+			object[UniqueIDAttribute] = onCall.escalationPolicyID + "-" + onCall.userID + "-" +
+				onCall.start + "-" + onCall.end
 		}
 	}
 
@@ -62,7 +62,18 @@ var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Req
 		w.Write([]byte(`{"members": [{"user": {"id": "user1"}}], "more": true}`))
 	case "/teams/team2/members?offset=1&limit=1":
 		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{"members": [{"user": {"id": "user3"}}], "more": false}`))
+		w.Write([]byte(`{"members": [{"user": {"id": "user3"}, "role": "manager"}], "more": false}`))
+
+	// Contact method endpoints, which are not paginated.
+	case "/users/user1/contact_methods?offset=0&limit=1":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"contact_methods": [
+			{"id": "PTDVERC", "type": "email_contact_method", "address": "user1@example.com"},
+			{"id": "PWEN34G", "type": "sms_contact_method", "address": "5555555555"}
+		]}`))
+	case "/users/user3/contact_methods?offset=0&limit=1":
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"contact_methods": []}`))
 
 	case "/oncalls?offset=0&limit=1":
 		w.WriteHeader(http.StatusOK)
@@ -512,8 +523,49 @@ func TestGetPage(t *testing.T) {
 			wantRes: &pagerduty.Response{
 				StatusCode: http.StatusOK,
 				Objects: []map[string]interface{}{
-					{"id": "team2-user3", "userId": "user3", "teamId": "team2"},
+					{"id": "team2-user3", "userId": "user3", "teamId": "team2", "role": "manager"},
+				},
+			},
+			wantErr: nil,
+		},
+		"first_contact_method_page": {
+			context: context.Background(),
+			request: &pagerduty.Request{
+				BaseURL:               server.URL,
+				RequestTimeoutSeconds: 5,
+				Token:                 "Token token=1234",
+				EntityExternalID:      pagerduty.ContactMethods,
+				PageSize:              1,
+			},
+			wantRes: &pagerduty.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]interface{}{
+					{"id": "PTDVERC", "type": "email_contact_method", "address": "user1@example.com", "userId": "user1"},
+					{"id": "PWEN34G", "type": "sms_contact_method", "address": "5555555555", "userId": "user1"},
 				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					CollectionID:     testutil.GenPtr("user1"),
+					CollectionCursor: testutil.GenPtr[int64](1),
+				},
+			},
+			wantErr: nil,
+		},
+		"last_contact_method_page": {
+			context: context.Background(),
+			request: &pagerduty.Request{
+				BaseURL:               server.URL,
+				RequestTimeoutSeconds: 5,
+				Token:                 "Token token=1234",
+				EntityExternalID:      pagerduty.ContactMethods,
+				PageSize:              1,
+				Cursor: &pagination.CompositeCursor[int64]{
+					CollectionID:     testutil.GenPtr("user2"),
+					CollectionCursor: testutil.GenPtr[int64](2),
+				},
+			},
+			wantRes: &pagerduty.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]interface{}{},
 			},
 			wantErr: nil,
 		},
@@ -0,0 +1,43 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package pagerduty_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/pagerduty"
+)
+
+// FuzzParseResponse checks that malformed PagerDuty responses are rejected with an error instead of a panic, for
+// all the entities.
+func FuzzParseResponse(f *testing.F) {
+	entityExternalIDs := []string{
+		pagerduty.Users,
+		pagerduty.Teams,
+		pagerduty.Members,
+		pagerduty.OnCalls,
+		pagerduty.ContactMethods,
+	}
+
+	for _, seed := range []string{
+		`{"users":[{"id":"PXPGF42","name":"Earline Greenholt"}],"limit":1,"offset":0,"more":true}`,
+		`{"members":[{"user":{"id":"PXPGF42"},"role":"manager"}],"more":false}`,
+		`{"oncalls":[{"user":{"id":"PXPGF42"},"schedule":{"id":"PI7DH85"},"escalation_level":1}],"more":"false"}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), uint8(0), int64(1), int64(0))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, entityIndex uint8, pageSize int64, cursor int64) {
+		entityExternalID := entityExternalIDs[int(entityIndex)%len(entityExternalIDs)]
+
+		_, _, err := pagerduty.ParseResponse(body, entityExternalID, pageSize, cursor)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for entity %s and body %q", entityExternalID, body)
+		}
+	})
+}
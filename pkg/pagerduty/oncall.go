@@ -0,0 +1,145 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pagerduty
+
+import (
+	"fmt"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/oncall"
+)
+
+// onCallFields are the fields of a PagerDuty OnCall object identifying it.
+// The start and end are empty strings if they are null, i.e. the user is permanently on call.
+type onCallFields struct {
+	escalationPolicyID string
+	userID             string
+	start              string
+	end                string
+}
+
+// parseOnCall parses the fields identifying a PagerDuty OnCall object.
+func parseOnCall(object map[string]any) (*onCallFields, *framework.Error) {
+	escalationPolicyMap, ok := object["escalation_policy"].(map[string]any)
+	if !ok {
+		return nil, &framework.Error{
+			Message: "Failed to parse a PagerDuty OnCall object's escalation_policy field as map[string]any.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	escalationPolicyID, ok := escalationPolicyMap[UniqueIDAttribute].(string)
+	if !ok {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Failed to parse a field in a PagerDuty OnCall object's escalation_policy object as string: %s.",
+				UniqueIDAttribute,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	userMap, ok := object["user"].(map[string]any)
+	if !ok {
+		return nil, &framework.Error{
+			Message: "Failed to parse a PagerDuty OnCall object's user field as map[string]any.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	userID, ok := userMap[UniqueIDAttribute].(string)
+	if !ok {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Failed to parse a field in a PagerDuty OnCall object's user object as string: %s.",
+				UniqueIDAttribute,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	startDate, endDate := object["start"], object["end"]
+
+	if startDate == nil {
+		startDate = ""
+	}
+
+	if endDate == nil {
+		endDate = ""
+	}
+
+	startDateString, ok := startDate.(string)
+	if !ok {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Failed to parse a PagerDuty OnCall object's start field as string: %v.",
+				startDate,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	endDateString, ok := endDate.(string)
+	if !ok {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Failed to parse a PagerDuty OnCall object's end field as string: %v.",
+				endDate,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return &onCallFields{
+		escalationPolicyID: escalationPolicyID,
+		userID:             userID,
+		start:              startDateString,
+		end:                endDateString,
+	}, nil
+}
+
+// OnCallShifts converts PagerDuty OnCall objects, as returned for the "oncalls" entity, to the shifts resolved by
+// the oncall package, e.g. to find the users currently on call for each schedule.
+// https://developer.pagerduty.com/api-reference/3a6b910f11050-list-all-of-the-on-calls.
+func OnCallShifts(objects []map[string]any) ([]oncall.Shift, *framework.Error) {
+	shifts := make([]oncall.Shift, 0, len(objects))
+
+	for _, object := range objects {
+		onCall, err := parseOnCall(object)
+		if err != nil {
+			return nil, err
+		}
+
+		shift := oncall.Shift{
+			EscalationPolicyID: onCall.escalationPolicyID,
+			UserID:             onCall.userID,
+		}
+
+		// The schedule is null if the user is a target of the escalation policy rather than of a schedule.
+		if schedule, ok := object["schedule"].(map[string]any); ok {
+			shift.ScheduleID, _ = schedule[UniqueIDAttribute].(string)
+		}
+
+		if level, ok := object["escalation_level"].(float64); ok {
+			shift.EscalationLevel = int(level)
+		}
+
+		var parseErr error
+
+		if shift.Start, parseErr = oncall.ParseTime(onCall.start); parseErr == nil {
+			shift.End, parseErr = oncall.ParseTime(onCall.end)
+		}
+
+		if parseErr != nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Failed to parse a PagerDuty OnCall object's shift: %v.", parseErr),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		shifts = append(shifts, shift)
+	}
+
+	return shifts, nil
+}
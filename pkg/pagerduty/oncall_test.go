@@ -0,0 +1,97 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package pagerduty_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/oncall"
+	"github.com/sgnl-ai/adapters/pkg/pagerduty"
+)
+
+func TestOnCallShifts(t *testing.T) {
+	tests := map[string]struct {
+		objects    []map[string]any
+		wantShifts []oncall.Shift
+		wantErr    *framework.Error
+	}{
+		"schedule_and_permanent": {
+			objects: []map[string]any{
+				{
+					"escalation_policy": map[string]any{"id": "EP1"},
+					"escalation_level":  float64(1),
+					"schedule":          map[string]any{"id": "S1"},
+					"user":              map[string]any{"id": "U1"},
+					"start":             "2024-03-05T08:00:00Z",
+					"end":               "2024-03-05T16:00:00Z",
+				},
+				{
+					"escalation_policy": map[string]any{"id": "EP1"},
+					"escalation_level":  float64(2),
+					"schedule":          nil,
+					"user":              map[string]any{"id": "U2"},
+					"start":             nil,
+					"end":               nil,
+				},
+			},
+			wantShifts: []oncall.Shift{
+				{
+					ScheduleID:         "S1",
+					EscalationPolicyID: "EP1",
+					EscalationLevel:    1,
+					UserID:             "U1",
+					Start:              time.Date(2024, 3, 5, 8, 0, 0, 0, time.UTC),
+					End:                time.Date(2024, 3, 5, 16, 0, 0, 0, time.UTC),
+				},
+				{
+					EscalationPolicyID: "EP1",
+					EscalationLevel:    2,
+					UserID:             "U2",
+				},
+			},
+		},
+		"missing_user": {
+			objects: []map[string]any{
+				{
+					"escalation_policy": map[string]any{"id": "EP1"},
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Failed to parse a PagerDuty OnCall object's user field as map[string]any.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"invalid_start": {
+			objects: []map[string]any{
+				{
+					"escalation_policy": map[string]any{"id": "EP1"},
+					"user":              map[string]any{"id": "U1"},
+					"start":             "yesterday",
+				},
+			},
+			wantErr: &framework.Error{
+				Message: `Failed to parse a PagerDuty OnCall object's shift: invalid shift time "yesterday": parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006".`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotShifts, gotErr := pagerduty.OnCallShifts(tt.objects)
+
+			if !reflect.DeepEqual(gotShifts, tt.wantShifts) {
+				t.Errorf("gotShifts: %v, wantShifts: %v", gotShifts, tt.wantShifts)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pagination
+
+import (
+	"fmt"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// RestartSyncHint is appended to every cursor error to tell the caller how to recover.
+const RestartSyncHint = "Restart the sync for this entity to discard the invalid cursor."
+
+// CursorError describes a cursor that could not be decoded or that is invalid for the requested entity.
+type CursorError struct {
+	// EntityExternalID is the external ID of the entity the cursor was provided for.
+	// May be empty if the entity is unknown.
+	EntityExternalID string
+
+	// ExpectedShape describes the JSON object the base64 encoded cursor is expected to contain,
+	// e.g. `{"cursor":<int64>}`.
+	ExpectedShape string
+
+	// Reason describes why the cursor is invalid.
+	Reason string
+}
+
+// Error returns the message of the cursor error in the following format:
+// "Invalid cursor for entity <entity>: <reason>. Expected cursor shape: <shape>. <hint>".
+func (e *CursorError) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString("Invalid cursor")
+
+	if e.EntityExternalID != "" {
+		fmt.Fprintf(&sb, " for entity %s", e.EntityExternalID)
+	}
+
+	fmt.Fprintf(&sb, ": %s.", e.Reason)
+
+	if e.ExpectedShape != "" {
+		fmt.Fprintf(&sb, " Expected cursor shape: %s.", e.ExpectedShape)
+	}
+
+	sb.WriteString(" ")
+	sb.WriteString(RestartSyncHint)
+
+	return sb.String()
+}
+
+// FrameworkError converts the cursor error into a framework.Error returned to the caller of GetPage.
+func (e *CursorError) FrameworkError() *framework.Error {
+	return &framework.Error{
+		Message: e.Error(),
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+	}
+}
+
+// NewCursorError returns a framework.Error describing an invalid cursor for the given entity.
+func NewCursorError(entityExternalID, expectedShape, reason string) *framework.Error {
+	return (&CursorError{
+		EntityExternalID: entityExternalID,
+		ExpectedShape:    expectedShape,
+		Reason:           reason,
+	}).FrameworkError()
+}
+
+// CompositeCursorShape returns the expected JSON shape of a CompositeCursor.
+// If isMemberEntity is true, the collection fields are included in the shape.
+func CompositeCursorShape[T int64 | string](isMemberEntity bool) string {
+	var zero T
+
+	valueType := "string"
+	if _, ok := any(zero).(int64); ok {
+		valueType = "int64"
+	}
+
+	if isMemberEntity {
+		return fmt.Sprintf(`{"cursor":<%s>,"collectionId":<string>,"collectionCursor":<%s>}`, valueType, valueType)
+	}
+
+	return fmt.Sprintf(`{"cursor":<%s>}`, valueType)
+}
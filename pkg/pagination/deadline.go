@@ -0,0 +1,60 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pagination
+
+import (
+	"context"
+	"time"
+)
+
+// DeadlineGuard tracks the time left to serve a page for adapters that send multiple datasource
+// requests to build a single page.
+//
+// Before sending another datasource request, adapters should check Expiring. If the next request is
+// not expected to complete before the deadline, the adapter should return the objects accumulated so
+// far with a cursor to resume from, instead of failing the whole page once the timeout expires.
+type DeadlineGuard struct {
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	// deadline is the deadline of the page. Zero if the page has no deadline.
+	deadline time.Time
+
+	// longestRequest is the duration of the longest datasource request tracked so far.
+	// It is used as an estimate of the duration of the next request.
+	longestRequest time.Duration
+}
+
+// NewDeadlineGuard returns a DeadlineGuard with the deadline of ctx, i.e. the deadline of the GetPage call
+// set by the caller. The request timeout of the config isn't used, as it applies to each datasource
+// request rather than to the whole page. If ctx has no deadline, the guard never expires.
+func NewDeadlineGuard(ctx context.Context) *DeadlineGuard {
+	deadline, _ := ctx.Deadline()
+
+	return &DeadlineGuard{
+		Now:      time.Now,
+		deadline: deadline,
+	}
+}
+
+// Track marks the start of a datasource request. The returned function must be called once the
+// request completes to record its duration.
+func (g *DeadlineGuard) Track() func() {
+	start := g.Now()
+
+	return func() {
+		if elapsed := g.Now().Sub(start); elapsed > g.longestRequest {
+			g.longestRequest = elapsed
+		}
+	}
+}
+
+// Expiring returns true if the deadline has passed or if the time left before the deadline is shorter
+// than the longest datasource request tracked so far.
+func (g *DeadlineGuard) Expiring() bool {
+	if g.deadline.IsZero() {
+		return false
+	}
+
+	return g.deadline.Sub(g.Now()) <= g.longestRequest
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pagination_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+func TestDeadlineGuard(t *testing.T) {
+	start := time.Now()
+
+	tests := map[string]struct {
+		// ctxDeadline is the deadline of the context, relative to start. Not set if 0.
+		ctxDeadline time.Duration
+		// requests are the durations of the datasource requests tracked before checking the guard.
+		requests     []time.Duration
+		wantExpiring bool
+	}{
+		"no_requests": {
+			ctxDeadline:  10 * time.Second,
+			wantExpiring: false,
+		},
+		"enough_time_for_next_request": {
+			ctxDeadline:  10 * time.Second,
+			requests:     []time.Duration{2 * time.Second, 3 * time.Second},
+			wantExpiring: false,
+		},
+		"not_enough_time_for_longest_request": {
+			ctxDeadline:  10 * time.Second,
+			requests:     []time.Duration{4 * time.Second, 1 * time.Second, 2 * time.Second},
+			wantExpiring: true,
+		},
+		"deadline_passed": {
+			ctxDeadline:  10 * time.Second,
+			requests:     []time.Duration{11 * time.Second},
+			wantExpiring: true,
+		},
+		"no_context_deadline": {
+			requests:     []time.Duration{time.Hour, time.Hour},
+			wantExpiring: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if tt.ctxDeadline != 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithDeadline(ctx, start.Add(tt.ctxDeadline))
+				defer cancel()
+			}
+
+			now := start
+
+			guard := pagination.NewDeadlineGuard(ctx)
+			guard.Now = func() time.Time { return now }
+
+			for _, duration := range tt.requests {
+				done := guard.Track()
+				now = now.Add(duration)
+
+				done()
+			}
+
+			if got := guard.Expiring(); got != tt.wantExpiring {
+				t.Errorf("got: %v, want: %v", got, tt.wantExpiring)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll
+package pagination_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// FuzzUnmarshalCursor checks that malformed cursors are rejected with an error instead of a panic, and that the
+// valid cursors are marshaled back to an equivalent cursor.
+func FuzzUnmarshalCursor(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"eyJjdXJzb3IiOjJ9",
+		base64.StdEncoding.EncodeToString([]byte(`{"cursor":"abc","collectionId":"group1","collectionCursor":"def"}`)),
+		base64.StdEncoding.EncodeToString([]byte(`{"cursor":10,"collectionId":"group1","collectionCursor":20}`)),
+		base64.StdEncoding.EncodeToString([]byte(`{"cursor":null}`)),
+		base64.StdEncoding.EncodeToString([]byte(`{"cursor":1e400}`)),
+		base64.StdEncoding.EncodeToString([]byte(`[]`)),
+		base64.StdEncoding.EncodeToString([]byte(`{"cursor":`)),
+		"not base64",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, cursor string) {
+		int64Cursor, err := pagination.UnmarshalCursor[int64](cursor, "User")
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for cursor %q", cursor)
+		}
+
+		if err == nil && int64Cursor != nil {
+			marshaledCursor, marshalErr := pagination.MarshalCursor(int64Cursor)
+			if marshalErr != nil {
+				t.Fatalf("failed to marshal the unmarshaled cursor %q: %v", cursor, marshalErr)
+			}
+
+			if _, err := pagination.UnmarshalCursor[int64](marshaledCursor, "User"); err != nil {
+				t.Errorf("failed to unmarshal the marshaled cursor %q: %v", marshaledCursor, err)
+			}
+		}
+
+		if _, err := pagination.UnmarshalCursor[string](cursor, "User"); err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for cursor %q", cursor)
+		}
+	})
+}
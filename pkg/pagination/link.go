@@ -0,0 +1,83 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pagination
+
+import (
+	"net/url"
+	"strings"
+)
+
+// NextLink returns the URL of the link with the "next" relation type in the values of Link headers
+// (RFC 8288), or an empty string if there is none.
+//
+// Each link is formatted as `<URL>; param=value; ...`. Links are usually separated by commas, but semicolons
+// are tolerated too, as is a missing opening `<`.
+// The rel parameter may be quoted and may contain several space-separated relation types, e.g. `rel="next last"`.
+func NextLink(values []string) string {
+	return LinkWithRelation(values, "next")
+}
+
+// LinkWithRelation returns the URL of the first link with the given relation type in the values of Link headers
+// (RFC 8288), or an empty string if there is none.
+func LinkWithRelation(values []string, relation string) string {
+	for _, value := range values {
+		for {
+			end := strings.IndexByte(value, '>')
+			if end == -1 {
+				break
+			}
+
+			// The opening < is tolerated to be missing, in which case the link starts after the previous separator.
+			link := value[:end]
+			if start := strings.LastIndexByte(link, '<'); start != -1 {
+				link = link[start+1:]
+			} else {
+				link = strings.TrimLeft(link, " \t,;")
+			}
+
+			value = value[end+1:]
+
+			// The parameters of the link run until the next link.
+			params := value
+			if next := strings.IndexAny(value, "<>"); next != -1 {
+				params = value[:next]
+			}
+
+			if hasRelation(params, relation) {
+				return strings.TrimSpace(link)
+			}
+		}
+	}
+
+	return ""
+}
+
+// hasRelation returns whether the rel parameter in the parameters of a link contains the relation type.
+func hasRelation(params, relation string) bool {
+	for _, param := range strings.FieldsFunc(params, func(r rune) bool { return r == ';' || r == ',' }) {
+		name, value, found := strings.Cut(param, "=")
+		if !found || !strings.EqualFold(strings.TrimSpace(name), "rel") {
+			continue
+		}
+
+		for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+			if strings.EqualFold(rel, relation) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CursorParam returns the value of the query parameter holding the cursor in a next page URL, e.g. the
+// "after" parameter in `https://example.okta.com/api/v1/users?after=00u1&limit=200`.
+// Returns an empty string if the URL is invalid or doesn't contain the parameter.
+func CursorParam(nextURL, param string) string {
+	parsed, err := url.Parse(nextURL)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Query().Get(param)
+}
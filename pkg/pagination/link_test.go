@@ -0,0 +1,117 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pagination_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+func TestNextLink(t *testing.T) {
+	tests := map[string]struct {
+		values []string
+		want   string
+	}{
+		"nil_values": {
+			values: nil,
+			want:   "",
+		},
+		"single_next_link": {
+			values: []string{`<https://localhost/api/users?page=2>; rel="next"`},
+			want:   "https://localhost/api/users?page=2",
+		},
+		"github_link_header": {
+			values: []string{
+				`<https://api.github.com/orgs/sgnl/repos?page=1>; rel="prev", ` +
+					`<https://api.github.com/orgs/sgnl/repos?page=3>; rel="next", ` +
+					`<https://api.github.com/orgs/sgnl/repos?page=5>; rel="last"`,
+			},
+			want: "https://api.github.com/orgs/sgnl/repos?page=3",
+		},
+		"okta_link_headers": {
+			values: []string{
+				`<https://sgnl.okta.com/api/v1/users?limit=200>; rel="self"`,
+				`<https://sgnl.okta.com/api/v1/users?after=00u1&limit=200>; rel="next"`,
+			},
+			want: "https://sgnl.okta.com/api/v1/users?after=00u1&limit=200",
+		},
+		"unquoted_rel_without_spaces": {
+			values: []string{`<https://localhost/api/users?page=2>;rel=next`},
+			want:   "https://localhost/api/users?page=2",
+		},
+		"multiple_relation_types": {
+			values: []string{`<https://localhost/api/users?page=2>; rel="next last"`},
+			want:   "https://localhost/api/users?page=2",
+		},
+		"rel_after_other_params": {
+			values: []string{`<https://localhost/api/users?page=2>; title="Page 2"; REL="Next"`},
+			want:   "https://localhost/api/users?page=2",
+		},
+		"comma_in_url": {
+			values: []string{`<https://localhost/api/users?fields=id,name&page=2>; rel="next"`},
+			want:   "https://localhost/api/users?fields=id,name&page=2",
+		},
+		"relation_prefix_is_not_a_match": {
+			values: []string{`<https://localhost/api/users?page=2>; rel="nextpage"`},
+			want:   "",
+		},
+		"missing_next_link": {
+			values: []string{`<https://localhost/api/users?page=1>; rel="first", <https://localhost/api/users?page=1>; rel="prev"`},
+			want:   "",
+		},
+		"missing_opening_angle_bracket": {
+			values: []string{`https://localhost/api/users?page=2>;rel="next"`},
+			want:   "https://localhost/api/users?page=2",
+		},
+		"missing_closing_angle_bracket": {
+			values: []string{`<https://localhost/api/users?page=2; rel="next"`},
+			want:   "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := pagination.NextLink(tt.values); got != tt.want {
+				t.Errorf("NextLink() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorParam(t *testing.T) {
+	tests := map[string]struct {
+		nextURL string
+		param   string
+		want    string
+	}{
+		"param_found": {
+			nextURL: "https://sgnl.okta.com/api/v1/users?after=00u1&limit=200",
+			param:   "after",
+			want:    "00u1",
+		},
+		"escaped_param": {
+			nextURL: "https://localhost/api/users?cursor=a%2Bb%3D",
+			param:   "cursor",
+			want:    "a+b=",
+		},
+		"param_missing": {
+			nextURL: "https://localhost/api/users?page=2",
+			param:   "cursor",
+			want:    "",
+		},
+		"invalid_url": {
+			nextURL: "https://localhost/api/users?%zz\x7f",
+			param:   "cursor",
+			want:    "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := pagination.CursorParam(tt.nextURL, tt.param); got != tt.want {
+				t.Errorf("CursorParam() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,11 +8,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapter-framework/web"
-	"github.com/sgnl-ai/adapters/pkg/extractor"
 )
 
 // CompositeCursor is used to store all required information for pagination.
@@ -41,9 +41,9 @@ type CompositeCursor[T int64 | string] struct {
 	CollectionCursor *T `json:"collectionCursor,omitempty"`
 }
 
-// UnmarshalCursor unmarshals the cursor from a base64 encoded JSON string.
-// If unmarshalling fails, an error is returned.
-func UnmarshalCursor[T int64 | string](cursor string) (*CompositeCursor[T], *framework.Error) {
+// UnmarshalCursor unmarshals the cursor provided for the given entity from a base64 encoded JSON string.
+// If unmarshalling fails, a CursorError describing the expected cursor shape is returned.
+func UnmarshalCursor[T int64 | string](cursor string, entityExternalID string) (*CompositeCursor[T], *framework.Error) {
 	if cursor == "" {
 		return nil, nil
 	}
@@ -52,18 +52,20 @@ func UnmarshalCursor[T int64 | string](cursor string) (*CompositeCursor[T], *fra
 
 	cursorBytes, err := base64.StdEncoding.DecodeString(cursor)
 	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to decode base64 cursor: %v.", err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
-		}
+		return nil, NewCursorError(
+			entityExternalID,
+			CompositeCursorShape[T](true),
+			fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
 	}
 
 	unmarshalErr := json.Unmarshal(cursorBytes, unmarshaledCursor)
 	if unmarshalErr != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to unmarshal JSON cursor: %v.", unmarshalErr),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
-		}
+		return nil, NewCursorError(
+			entityExternalID,
+			CompositeCursorShape[T](true),
+			fmt.Sprintf("failed to unmarshal JSON cursor: %v", unmarshalErr),
+		)
 	}
 
 	return unmarshaledCursor, nil
@@ -103,22 +105,21 @@ func ValidateCompositeCursor[T int64 | string](
 	switch isMemberEntity {
 	case true:
 		if cursor.CollectionID == nil {
-			return &framework.Error{
-				Message: fmt.Sprintf("Cursor does not have CollectionID set for entity %s.", entityExternalID),
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
-			}
+			return NewCursorError(
+				entityExternalID,
+				CompositeCursorShape[T](true),
+				"cursor does not have CollectionID set",
+			)
 		}
 
 		return nil
 	default:
 		if cursor.CollectionID != nil || cursor.CollectionCursor != nil {
-			return &framework.Error{
-				Message: fmt.Sprintf(
-					"Cursor must not contain CollectionID or CollectionCursor fields for entity %s.",
-					entityExternalID,
-				),
-				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
-			}
+			return NewCursorError(
+				entityExternalID,
+				CompositeCursorShape[T](false),
+				"cursor must not contain CollectionID or CollectionCursor fields",
+			)
 		}
 
 		return nil
@@ -146,8 +147,9 @@ func GetNextCursorFromPageSize(objectsInPage int, pageSize int64, currentCursor
 // <https://test-instance.com/api/v3/repositories/1/issues?per_page=1&page=3>; rel="last",
 // <https://test-instance.com/api/v3/repositories/1/issues?per_page=1&page=1>; rel="first"
 // We want to retrieve the "next" link, or return a nil cursor if it is missing to indicate the end of the sync.
+// Next links that don't use https are ignored, so that credentials are never sent in cleartext.
 func GetNextCursorFromLinkHeader(links []string) *CompositeCursor[string] {
-	if cursor := extractor.ValueFromList(links, "https://", ">;rel=\"next\""); cursor != "" {
+	if cursor := NextLink(links); strings.HasPrefix(cursor, "https://") {
 		return &CompositeCursor[string]{
 			Cursor: &cursor,
 		}
@@ -45,16 +45,20 @@ func TestUnmarshalCursorInt64(t *testing.T) {
 			inputCursor:         "NOT_B64_ENCODED",
 			wantCompositeCursor: nil,
 			wantErr: &framework.Error{
-				Message: "Failed to decode base64 cursor: illegal base64 data at input byte 3.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity User: failed to decode base64 cursor: illegal base64 data at input byte 3. " +
+					`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 		"invalid_cursor_struct": {
 			inputCursor:         "ImZvbyI=", // "foo" b64 encoded.
 			wantCompositeCursor: nil,
 			wantErr: &framework.Error{
-				Message: "Failed to unmarshal JSON cursor: json: cannot unmarshal string into Go " +
-					"value of type pagination.CompositeCursor[int64].",
+				Message: "Invalid cursor for entity User: failed to unmarshal JSON cursor: json: cannot unmarshal string into Go " +
+					"value of type pagination.CompositeCursor[int64]. " +
+					`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
 				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
@@ -62,7 +66,7 @@ func TestUnmarshalCursorInt64(t *testing.T) {
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotCompositeCursor, gotErr := pagination.UnmarshalCursor[int64](tt.inputCursor)
+			gotCompositeCursor, gotErr := pagination.UnmarshalCursor[int64](tt.inputCursor, "User")
 
 			if !reflect.DeepEqual(gotCompositeCursor, tt.wantCompositeCursor) {
 				t.Errorf("gotCompositeCursor: %v, wantCompositeCursor: %v", gotCompositeCursor, tt.wantCompositeCursor)
@@ -108,16 +112,20 @@ func TestUnmarshalCursorString(t *testing.T) {
 			inputCursor:         "NOT_B64_ENCODED",
 			wantCompositeCursor: nil,
 			wantErr: &framework.Error{
-				Message: "Failed to decode base64 cursor: illegal base64 data at input byte 3.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity User: failed to decode base64 cursor: illegal base64 data at input byte 3. " +
+					`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 		"invalid_cursor_struct": {
 			inputCursor:         "ImZvbyI=", // "foo" b64 encoded.
 			wantCompositeCursor: nil,
 			wantErr: &framework.Error{
-				Message: "Failed to unmarshal JSON cursor: json: cannot unmarshal string into Go " +
-					"value of type pagination.CompositeCursor[string].",
+				Message: "Invalid cursor for entity User: failed to unmarshal JSON cursor: json: cannot unmarshal string into Go " +
+					"value of type pagination.CompositeCursor[string]. " +
+					`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
 				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
@@ -125,7 +133,7 @@ func TestUnmarshalCursorString(t *testing.T) {
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotCompositeCursor, gotErr := pagination.UnmarshalCursor[string](tt.inputCursor)
+			gotCompositeCursor, gotErr := pagination.UnmarshalCursor[string](tt.inputCursor, "User")
 
 			if !reflect.DeepEqual(gotCompositeCursor, tt.wantCompositeCursor) {
 				t.Errorf("gotCompositeCursor: %v, wantCompositeCursor: %v", gotCompositeCursor, tt.wantCompositeCursor)
@@ -227,8 +235,10 @@ func TestValidateCompositeCursor(t *testing.T) {
 			inputEntityExternalID: "Member",
 			inputIsMemberEntity:   true,
 			wantErr: &framework.Error{
-				Message: "Cursor does not have CollectionID set for entity Member.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity Member: cursor does not have CollectionID set. " +
+					`Expected cursor shape: {"cursor":<int64>,"collectionId":<string>,"collectionCursor":<int64>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 		"group_cursor_field_should_not_be_present": {
@@ -239,8 +249,10 @@ func TestValidateCompositeCursor(t *testing.T) {
 			inputEntityExternalID: "User",
 			inputIsMemberEntity:   false,
 			wantErr: &framework.Error{
-				Message: "Cursor must not contain CollectionID or CollectionCursor fields for entity User.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity User: cursor must not contain CollectionID or CollectionCursor fields. " +
+					`Expected cursor shape: {"cursor":<int64>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 		"group_id_field_should_not_be_present": {
@@ -251,8 +263,10 @@ func TestValidateCompositeCursor(t *testing.T) {
 			inputEntityExternalID: "User",
 			inputIsMemberEntity:   false,
 			wantErr: &framework.Error{
-				Message: "Cursor must not contain CollectionID or CollectionCursor fields for entity User.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Message: "Invalid cursor for entity User: cursor must not contain CollectionID or CollectionCursor fields. " +
+					`Expected cursor shape: {"cursor":<int64>}.` +
+					" Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
 	}
@@ -341,6 +355,10 @@ func TestGetNextCursorFromLinkHeader(t *testing.T) {
 				Cursor: testutil.GenPtr("https://localhost/api/users?page=2"),
 			},
 		},
+		"http_next_link_header": {
+			inputLinkHeader: []string{"<http://localhost/api/users?page=2>; rel=\"next\""},
+			wantNextCursor:  nil,
+		},
 		"missing_next_link_header": {
 			inputLinkHeader: []string{"<https://localhost/api/users?page=2>; rel=\"first\"; <https://localhost/api/users?page=1>; rel=\"prev\""},
 			wantNextCursor:  nil,
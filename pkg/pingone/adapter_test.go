@@ -0,0 +1,274 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package pingone_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/pingone"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := pingone.NewAdapter(&pingone.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[string]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	config := &pingone.Config{
+		EnvironmentID: "env-1",
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[pingone.Config]
+		wantResponse framework.Response
+	}{
+		"users_first_page": {
+			request: &framework.Request[pingone.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: pingone.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "username",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "enabled",
+							Type:       framework.AttributeTypeBool,
+						},
+						{
+							ExternalId: "createdAt",
+							Type:       framework.AttributeTypeDateTime,
+						},
+						{
+							ExternalId: "$.population.id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":              "u1",
+							"username":        "alice",
+							"enabled":         true,
+							"createdAt":       time.Date(2024, 3, 5, 14, 12, 37, 123000000, time.UTC),
+							"$.population.id": "pop-1",
+						},
+						{
+							"id":              "u2",
+							"username":        "bob",
+							"enabled":         false,
+							"createdAt":       time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC),
+							"$.population.id": "pop-1",
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						Cursor: testutil.GenPtr("dXNlcjI="),
+					}),
+				},
+			},
+		},
+		"group_memberships_first_page": {
+			request: &framework.Request[pingone.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: pingone.GroupMembership,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "userId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "groupId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "type",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":      "u1-g1",
+							"userId":  "u1",
+							"groupId": "g1",
+							"type":    "DIRECT",
+						},
+						{
+							"id":      "u1-g2",
+							"userId":  "u1",
+							"groupId": "g2",
+							"type":    "INDIRECT",
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						CollectionID:     testutil.GenPtr("u1"),
+						CollectionCursor: testutil.GenPtr("dTI="),
+					}),
+				},
+			},
+		},
+		"application_role_assignments_client_credentials": {
+			request: &framework.Request[pingone.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "worker-client",
+						Password: "worker-secret",
+					},
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: pingone.ApplicationRoleAssignment,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "applicationId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.role.id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.scope.type",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":            "ra-1",
+							"applicationId": "app-1",
+							"$.role.id":     "role-identity-data-admin",
+							"$.scope.type":  "ENVIRONMENT",
+						},
+						{
+							"id":            "ra-2",
+							"applicationId": "app-1",
+							"$.role.id":     "role-client-app-developer",
+							"$.scope.type":  "ENVIRONMENT",
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[string]{
+						CollectionID:     testutil.GenPtr("app-1"),
+						CollectionCursor: testutil.GenPtr("1"),
+					}),
+				},
+			},
+		},
+		"invalid_client_credentials": {
+			request: &framework.Request[pingone.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "worker-client",
+						Password: "invalid",
+					},
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: pingone.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to get an access token for PingOne environment env-1: Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[pingone.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-invalid",
+				},
+				Config: config,
+				Entity: framework.EntityConfig{
+					ExternalId: pingone.Group,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 1,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
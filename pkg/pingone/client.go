@@ -0,0 +1,69 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pingone
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Client is a client that allows querying the PingOne datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+
+	// GetToken returns the Authorization header value to query the Management API with the OAuth client
+	// credentials of a worker application.
+	GetToken(ctx context.Context, request *TokenRequest) (string, *framework.Error)
+}
+
+// Request is a request to the PingOne Management API.
+type Request struct {
+	// BaseURL is the base URL of the Management API of the region of the environment,
+	// e.g. "https://api.pingone.com".
+	BaseURL string
+
+	// AuthorizationHeader is the Authorization header value to authenticate a request: the Bearer token of an
+	// access token of a worker application with the roles reading the identities and applications of the
+	// environment. The access tokens of the client credentials of the worker applications are requested with
+	// GetToken.
+	AuthorizationHeader string
+
+	// EnvironmentID is the ID of the PingOne environment.
+	EnvironmentID string
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity. The cursor is the value of the "cursor" parameter of the next page link
+	// for the entities paginated by PingOne, and the offset of the next page in the objects returned by PingOne
+	// for the other entities.
+	// nil in the request for the first page.
+	Cursor *pagination.CompositeCursor[string]
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *pagination.CompositeCursor[string]
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pingone
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// PingOne Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "environmentId": "5f8e4b1c-2a3d-4e6f-8a9b-0c1d2e3f4a5b",
+    "authUrl": "https://auth.pingone.eu"
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// EnvironmentID is the ID of the PingOne environment, shown in the settings of the environment.
+	EnvironmentID string `json:"environmentId,omitempty"`
+
+	// AuthURL is the base URL of the authentication service of the region of the environment, requesting the
+	// access tokens of the client credentials, e.g. "https://auth.pingone.eu".
+	// This defaults to the address of the datasource with its "api." subdomain replaced by "auth.".
+	AuthURL string `json:"authUrl,omitempty"`
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	switch {
+	case c == nil:
+		return errors.New("request contains no config")
+	case c.EnvironmentID == "":
+		return errors.New("environmentId is not set")
+	case c.AuthURL != "":
+		if parsed, err := url.Parse(c.AuthURL); err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return errors.New("authUrl must be an https URL")
+		}
+	}
+
+	return c.CommonConfig.ValidateSyncMode()
+}
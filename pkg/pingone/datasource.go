@@ -0,0 +1,336 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+
+	// tokens caches the access tokens of the client credentials of the worker applications.
+	tokens tokenCache
+}
+
+// ListResponse is the format of the HAL list responses of the Management API, embedding the objects under the
+// name of their collection, e.g. "users", and linking to the next page.
+// https://apidocs.pingidentity.com/pingone/platform/v1/api/#pagination.
+type ListResponse struct {
+	Embedded map[string][]map[string]any `json:"_embedded"`
+	Links    struct {
+		Next *struct {
+			Href string `json:"href"`
+		} `json:"next"`
+	} `json:"_links"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// path is the path of the endpoint of the entity, relative to the environment, or to the collection object
+	// for the member entities.
+	path string
+	// embedded is the name of the collection of the objects in the "_embedded" object of the responses.
+	embedded string
+	// paginated is whether PingOne paginates the objects of the entity. The other endpoints return all the
+	// objects at once, which the adapter paginates.
+	paginated bool
+	// memberOf is the external ID of the collection entity the entity is listed for, if any.
+	memberOf *string
+	// setMemberAttributes sets the unique ID of an object listed for the collection, if it doesn't have one,
+	// and the attribute referencing the collection.
+	setMemberAttributes func(collectionID string, object map[string]any)
+}
+
+const (
+	User                      string = "User"
+	Group                     string = "Group"
+	GroupMembership           string = "GroupMembership"
+	Population                string = "Population"
+	Application               string = "Application"
+	ApplicationRoleAssignment string = "ApplicationRoleAssignment"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// https://apidocs.pingidentity.com/pingone/platform/v1/api/#get-read-all-users.
+		User: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/users",
+			embedded:               "users",
+			paginated:              true,
+		},
+		// https://apidocs.pingidentity.com/pingone/platform/v1/api/#get-read-all-groups.
+		Group: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/groups",
+			embedded:               "groups",
+			paginated:              true,
+		},
+		// https://apidocs.pingidentity.com/pingone/platform/v1/api/#get-read-group-memberships-for-a-user.
+		// Connection entity for Users <-> Groups, listing the groups of each user.
+		GroupMembership: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/memberOfGroups",
+			embedded:               "groupMemberships",
+			paginated:              true,
+			memberOf: func() *string {
+				s := User
+
+				return &s
+			}(),
+			setMemberAttributes: func(userID string, membership map[string]any) {
+				groupID, _ := membership["id"].(string)
+
+				membership["id"] = userID + "-" + groupID
+				membership["groupId"] = groupID
+				membership["userId"] = userID
+			},
+		},
+		// https://apidocs.pingidentity.com/pingone/platform/v1/api/#get-read-all-populations.
+		Population: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/populations",
+			embedded:               "populations",
+			paginated:              true,
+		},
+		// https://apidocs.pingidentity.com/pingone/platform/v1/api/#get-read-applications.
+		Application: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/applications",
+			embedded:               "applications",
+		},
+		// https://apidocs.pingidentity.com/pingone/platform/v1/api/#get-read-application-role-assignments.
+		// Connection entity for Applications <-> Roles, with the scope of each role assigned to the application.
+		ApplicationRoleAssignment: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/roleAssignments",
+			embedded:               "roleAssignments",
+			memberOf: func() *string {
+				s := Application
+
+				return &s
+			}(),
+			setMemberAttributes: func(applicationID string, assignment map[string]any) {
+				assignment["applicationId"] = applicationID
+			},
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	// [MemberEntities] The members are listed one collection object at a time, e.g. the group memberships of a
+	// user, so set the `CollectionID` to the ID of the current collection object, and the `CollectionCursor` to
+	// the cursor of the next one.
+	if entity.memberOf != nil {
+		collectionReq := &Request{
+			BaseURL:               request.BaseURL,
+			AuthorizationHeader:   request.AuthorizationHeader,
+			EnvironmentID:         request.EnvironmentID,
+			PageSize:              1,
+			EntityExternalID:      *entity.memberOf,
+			RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		}
+
+		if request.Cursor != nil && request.Cursor.CollectionCursor != nil {
+			collectionReq.Cursor = &pagination.CompositeCursor[string]{
+				Cursor: request.Cursor.CollectionCursor,
+			}
+		}
+
+		if request.Cursor == nil {
+			request.Cursor = &pagination.CompositeCursor[string]{}
+		}
+
+		isEmptyLastPage, cursorErr := pagination.UpdateNextCursorFromCollectionAPI(
+			ctx,
+			request.Cursor,
+			func(ctx context.Context, collectionReq *Request) (
+				int, string, []map[string]any, *pagination.CompositeCursor[string], *framework.Error,
+			) {
+				resp, err := d.GetPage(ctx, collectionReq)
+				if err != nil {
+					return 0, "", nil, nil, err
+				}
+
+				return resp.StatusCode, resp.RetryAfterHeader, resp.Objects, resp.NextCursor, nil
+			},
+			collectionReq,
+			ValidEntityExternalIDs[*entity.memberOf].uniqueIDAttrExternalID,
+		)
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		if isEmptyLastPage {
+			return &Response{
+				StatusCode: http.StatusOK,
+			}, nil
+		}
+	}
+
+	validationErr := pagination.ValidateCompositeCursor(
+		request.Cursor,
+		request.EntityExternalID,
+		// Send a bool indicating if the entity is a member of a collection.
+		entity.memberOf != nil,
+	)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects        []map[string]any
+		nextPageCursor *string
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpoint,
+		Header: http.Header{
+			"Authorization": {request.AuthorizationHeader},
+			"Accept":        {"application/json"},
+		},
+		DatasourceName:        "PingOne",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "PingOne")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, nextPageCursor, parseErr = ParseResponse(bodyBytes, request.EntityExternalID)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	nextCursor := nextPageCursor
+
+	// [Applications, ApplicationRoleAssignments] The endpoints aren't paginated, so the page is taken from all
+	// the objects of the response.
+	if !entity.paginated {
+		var paginateErr *framework.Error
+
+		objects, nextCursor, paginateErr = pagination.PaginateObjects(objects, request.PageSize, request.Cursor)
+		if paginateErr != nil {
+			return nil, paginateErr
+		}
+	}
+
+	if nextCursor != nil {
+		response.NextCursor = &pagination.CompositeCursor[string]{
+			Cursor: nextCursor,
+		}
+	}
+
+	// [MemberEntities] Set the attribute referencing the collection, and the cursor of the next page of members,
+	// or of the next collection object.
+	if entity.memberOf != nil {
+		collectionID := *request.Cursor.CollectionID
+
+		for _, member := range objects {
+			entity.setMemberAttributes(collectionID, member)
+		}
+
+		request.Cursor.Cursor = nextCursor
+		response.NextCursor = nil
+
+		if request.Cursor.Cursor != nil || request.Cursor.CollectionCursor != nil {
+			response.NextCursor = request.Cursor
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the objects of the entity from a PingOne Management API response, and the value of the
+// "cursor" parameter of the link to the next page, if any.
+func ParseResponse(body []byte, entityExternalID string) (
+	objects []map[string]any,
+	nextCursor *string,
+	err *framework.Error,
+) {
+	var data ListResponse
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	objects = data.Embedded[ValidEntityExternalIDs[entityExternalID].embedded]
+
+	if data.Links.Next == nil || data.Links.Next.Href == "" {
+		return objects, nil, nil
+	}
+
+	// The next page is requested from the address of the datasource rather than from the link, so that a
+	// tampered response can't redirect the credentials to another host.
+	cursor := pagination.CursorParam(data.Links.Next.Href, "cursor")
+	if cursor == "" {
+		return nil, nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"PingOne response for %s has a next page link without a cursor: %s.", entityExternalID, data.Links.Next.Href,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return objects, &cursor, nil
+}
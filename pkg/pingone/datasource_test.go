@@ -0,0 +1,483 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package pingone_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/pingone"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock PingOne Management API server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	// Token of the worker-client worker application.
+	if r.URL.Path == "/env-1/as/token" {
+		clientID, clientSecret, _ := r.BasicAuth()
+
+		if r.Method != http.MethodPost || r.PostFormValue("grant_type") != "client_credentials" ||
+			clientID != "worker-client" || clientSecret != "worker-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error": "invalid_client", "error_description": "Request denied: Unsupported authentication method"}`))
+
+			return
+		}
+
+		w.Write([]byte(`{"access_token": "p1-test", "token_type": "Bearer", "expires_in": 3600}`))
+
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer p1-test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code": "INVALID_TOKEN", "message": "The request could not be completed. The provided access token is invalid."}`))
+
+		return
+	}
+
+	switch r.URL.RequestURI() {
+	// Users Page 1
+	case "/v1/environments/env-1/users?limit=2":
+		w.Write([]byte(`{"_links": {"self": {"href": "https://api.pingone.com/v1/environments/env-1/users?limit=2"}, "next": {"href": "https://api.pingone.com/v1/environments/env-1/users?cursor=dXNlcjI%3D&limit=2"}}, "_embedded": {"users": [
+			{"id": "u1", "username": "alice", "email": "alice@acme.com", "enabled": true, "createdAt": "2024-03-05T14:12:37.123Z", "population": {"id": "pop-1"}},
+			{"id": "u2", "username": "bob", "email": "bob@acme.com", "enabled": false, "createdAt": "2024-03-06T09:00:00.000Z", "population": {"id": "pop-1"}}
+		]}, "count": 3, "size": 2}`))
+
+	// Users Page 2
+	case "/v1/environments/env-1/users?cursor=dXNlcjI%3D&limit=2":
+		w.Write([]byte(`{"_links": {"self": {"href": "https://api.pingone.com/v1/environments/env-1/users?cursor=dXNlcjI%3D&limit=2"}}, "_embedded": {"users": [
+			{"id": "u3", "username": "carol", "email": "carol@acme.com", "enabled": true, "createdAt": "2024-03-07T09:00:00.000Z", "population": {"id": "pop-2"}}
+		]}, "count": 3, "size": 1}`))
+
+	// Users, one at a time, listed for the group memberships
+	case "/v1/environments/env-1/users?limit=1":
+		w.Write([]byte(`{"_links": {"next": {"href": "https://api.pingone.com/v1/environments/env-1/users?cursor=dTI%3D&limit=1"}}, "_embedded": {"users": [{"id": "u1", "username": "alice"}]}}`))
+	case "/v1/environments/env-1/users?cursor=dTI%3D&limit=1":
+		w.Write([]byte(`{"_links": {"next": {"href": "https://api.pingone.com/v1/environments/env-1/users?cursor=dTM%3D&limit=1"}}, "_embedded": {"users": [{"id": "u2", "username": "bob"}]}}`))
+	case "/v1/environments/env-1/users?cursor=dTM%3D&limit=1":
+		w.Write([]byte(`{"_links": {}, "_embedded": {"users": [{"id": "u3", "username": "carol"}]}}`))
+
+	// Group Memberships of u1
+	case "/v1/environments/env-1/users/u1/memberOfGroups?limit=2":
+		w.Write([]byte(`{"_links": {}, "_embedded": {"groupMemberships": [
+			{"id": "g1", "name": "Engineering", "type": "DIRECT"},
+			{"id": "g2", "name": "Everyone", "type": "INDIRECT"}
+		]}}`))
+
+	// Group Memberships of u2
+	case "/v1/environments/env-1/users/u2/memberOfGroups?limit=2":
+		w.Write([]byte(`{"_links": {}, "_embedded": {"groupMemberships": []}}`))
+
+	// Group Memberships of u3
+	case "/v1/environments/env-1/users/u3/memberOfGroups?limit=2":
+		w.Write([]byte(`{"_links": {}, "_embedded": {"groupMemberships": [{"id": "g2", "name": "Everyone", "type": "DIRECT"}]}}`))
+
+	// Groups
+	case "/v1/environments/env-1/groups?limit=2":
+		w.Write([]byte(`{"_links": {}, "_embedded": {"groups": [
+			{"id": "g1", "name": "Engineering", "description": "Engineers", "population": {"id": "pop-1"}},
+			{"id": "g2", "name": "Everyone"}
+		]}}`))
+
+	// Populations
+	case "/v1/environments/env-1/populations?limit=2":
+		w.Write([]byte(`{"_links": {}, "_embedded": {"populations": [{"id": "pop-1", "name": "Employees", "userCount": 2}]}}`))
+
+	// Applications
+	case "/v1/environments/env-1/applications":
+		w.Write([]byte(`{"_links": {}, "_embedded": {"applications": [
+			{"id": "app-1", "name": "Worker", "type": "WORKER", "protocol": "OPENID_CONNECT", "enabled": true},
+			{"id": "app-2", "name": "Portal", "type": "WEB_APP", "protocol": "OPENID_CONNECT", "enabled": true},
+			{"id": "app-3", "name": "Legacy", "type": "SAML_APP", "protocol": "SAML", "enabled": false}
+		]}, "count": 3, "size": 3}`))
+
+	// Role Assignments of app-1
+	case "/v1/environments/env-1/applications/app-1/roleAssignments":
+		w.Write([]byte(`{"_links": {}, "_embedded": {"roleAssignments": [
+			{"id": "ra-1", "role": {"id": "role-identity-data-admin"}, "scope": {"id": "env-1", "type": "ENVIRONMENT"}, "readOnly": false},
+			{"id": "ra-2", "role": {"id": "role-client-app-developer"}, "scope": {"id": "env-1", "type": "ENVIRONMENT"}, "readOnly": false}
+		]}}`))
+
+	// Role Assignments of app-2 and app-3
+	case "/v1/environments/env-1/applications/app-2/roleAssignments",
+		"/v1/environments/env-1/applications/app-3/roleAssignments":
+		w.Write([]byte(`{"_links": {}, "_embedded": {"roleAssignments": []}}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body             []byte
+		entityExternalID string
+		wantObjects      []map[string]any
+		wantNextCursor   *string
+		wantErr          *framework.Error
+	}{
+		"last_page": {
+			body:             []byte(`{"_links": {"self": {"href": "https://api.pingone.com/v1/environments/env-1/groups"}}, "_embedded": {"groups": [{"id": "g1"}]}}`),
+			entityExternalID: pingone.Group,
+			wantObjects:      []map[string]any{{"id": "g1"}},
+		},
+		"next_page": {
+			body:             []byte(`{"_links": {"next": {"href": "https://api.pingone.com/v1/environments/env-1/users?cursor=abc%2B1&limit=1"}}, "_embedded": {"users": [{"id": "u1"}]}}`),
+			entityExternalID: pingone.User,
+			wantObjects:      []map[string]any{{"id": "u1"}},
+			wantNextCursor:   testutil.GenPtr("abc+1"),
+		},
+		"no_objects": {
+			body:             []byte(`{"_links": {}, "count": 0, "size": 0}`),
+			entityExternalID: pingone.Population,
+		},
+		"next_page_without_cursor": {
+			body:             []byte(`{"_links": {"next": {"href": "https://api.pingone.com/v1/environments/env-1/users?page=2"}}, "_embedded": {"users": [{"id": "u1"}]}}`),
+			entityExternalID: pingone.User,
+			wantErr: &framework.Error{
+				Message: "PingOne response for User has a next page link without a cursor: https://api.pingone.com/v1/environments/env-1/users?page=2.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"invalid_response": {
+			body:             []byte(`[]`),
+			entityExternalID: pingone.Group,
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type pingone.ListResponse.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextCursor, gotErr := pingone.ParseResponse(tt.body, tt.entityExternalID)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextCursor, tt.wantNextCursor) {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", gotNextCursor, tt.wantNextCursor)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := pingone.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *pingone.Request
+		wantResponse *pingone.Response
+		wantErr      *framework.Error
+	}{
+		"users_first_page": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-test",
+				EnvironmentID:       "env-1",
+				PageSize:            2,
+				EntityExternalID:    pingone.User,
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "u1", "username": "alice", "email": "alice@acme.com", "enabled": true, "createdAt": "2024-03-05T14:12:37.123Z", "population": map[string]any{"id": "pop-1"}},
+					{"id": "u2", "username": "bob", "email": "bob@acme.com", "enabled": false, "createdAt": "2024-03-06T09:00:00.000Z", "population": map[string]any{"id": "pop-1"}},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("dXNlcjI="),
+				},
+			},
+		},
+		"users_last_page": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-test",
+				EnvironmentID:       "env-1",
+				PageSize:            2,
+				EntityExternalID:    pingone.User,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("dXNlcjI="),
+				},
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "u3", "username": "carol", "email": "carol@acme.com", "enabled": true, "createdAt": "2024-03-07T09:00:00.000Z", "population": map[string]any{"id": "pop-2"}},
+				},
+			},
+		},
+		"applications_first_page": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-test",
+				EnvironmentID:       "env-1",
+				PageSize:            2,
+				EntityExternalID:    pingone.Application,
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "app-1", "name": "Worker", "type": "WORKER", "protocol": "OPENID_CONNECT", "enabled": true},
+					{"id": "app-2", "name": "Portal", "type": "WEB_APP", "protocol": "OPENID_CONNECT", "enabled": true},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("2"),
+				},
+			},
+		},
+		"applications_last_page": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-test",
+				EnvironmentID:       "env-1",
+				PageSize:            2,
+				EntityExternalID:    pingone.Application,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("2"),
+				},
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "app-3", "name": "Legacy", "type": "SAML_APP", "protocol": "SAML", "enabled": false},
+				},
+			},
+		},
+		"group_memberships_first_user": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-test",
+				EnvironmentID:       "env-1",
+				PageSize:            2,
+				EntityExternalID:    pingone.GroupMembership,
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "u1-g1", "userId": "u1", "groupId": "g1", "name": "Engineering", "type": "DIRECT"},
+					{"id": "u1-g2", "userId": "u1", "groupId": "g2", "name": "Everyone", "type": "INDIRECT"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("u1"),
+					CollectionCursor: testutil.GenPtr("dTI="),
+				},
+			},
+		},
+		"group_memberships_user_without_groups": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-test",
+				EnvironmentID:       "env-1",
+				PageSize:            2,
+				EntityExternalID:    pingone.GroupMembership,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("u1"),
+					CollectionCursor: testutil.GenPtr("dTI="),
+				},
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]any{},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("u2"),
+					CollectionCursor: testutil.GenPtr("dTM="),
+				},
+			},
+		},
+		"group_memberships_last_user": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-test",
+				EnvironmentID:       "env-1",
+				PageSize:            2,
+				EntityExternalID:    pingone.GroupMembership,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("u2"),
+					CollectionCursor: testutil.GenPtr("dTM="),
+				},
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "u3-g2", "userId": "u3", "groupId": "g2", "name": "Everyone", "type": "DIRECT"},
+				},
+			},
+		},
+		"application_role_assignments_first_application": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-test",
+				EnvironmentID:       "env-1",
+				PageSize:            1,
+				EntityExternalID:    pingone.ApplicationRoleAssignment,
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "ra-1", "applicationId": "app-1", "role": map[string]any{"id": "role-identity-data-admin"}, "scope": map[string]any{"id": "env-1", "type": "ENVIRONMENT"}, "readOnly": false},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr("1"),
+					CollectionID:     testutil.GenPtr("app-1"),
+					CollectionCursor: testutil.GenPtr("1"),
+				},
+			},
+		},
+		"application_role_assignments_last_application": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-test",
+				EnvironmentID:       "env-1",
+				PageSize:            1,
+				EntityExternalID:    pingone.ApplicationRoleAssignment,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("app-2"),
+					CollectionCursor: testutil.GenPtr("2"),
+				},
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]any{},
+			},
+		},
+		"unauthorized": {
+			request: &pingone.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Bearer p1-invalid",
+				EnvironmentID:       "env-1",
+				PageSize:            1,
+				EntityExternalID:    pingone.Group,
+			},
+			wantResponse: &pingone.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetToken(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := pingone.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request   *pingone.TokenRequest
+		wantToken string
+		wantErr   *framework.Error
+	}{
+		"valid_client_credentials": {
+			request: &pingone.TokenRequest{
+				AuthURL:       server.URL,
+				EnvironmentID: "env-1",
+				ClientID:      "worker-client",
+				ClientSecret:  "worker-secret",
+			},
+			wantToken: "Bearer p1-test",
+		},
+		"invalid_client_credentials": {
+			request: &pingone.TokenRequest{
+				AuthURL:       server.URL,
+				EnvironmentID: "env-1",
+				ClientID:      "worker-client",
+				ClientSecret:  "invalid",
+			},
+			wantErr: &framework.Error{
+				Message: "Failed to get an access token for PingOne environment env-1: Failed to authenticate with datasource. Check datasource configuration details and try again.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotToken, gotErr := client.GetToken(context.Background(), tt.request)
+
+			if gotToken != tt.wantToken {
+				t.Errorf("gotToken: %v, wantToken: %v", gotToken, tt.wantToken)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("cached_token", func(t *testing.T) {
+		request := &pingone.TokenRequest{
+			AuthURL:       server.URL,
+			EnvironmentID: "env-1",
+			ClientID:      "worker-client",
+			ClientSecret:  "worker-secret",
+		}
+
+		if _, err := client.GetToken(context.Background(), request); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// The cached token is returned without requesting the token endpoint.
+		server.Close()
+
+		gotToken, gotErr := client.GetToken(context.Background(), request)
+		if gotErr != nil || gotToken != "Bearer p1-test" {
+			t.Errorf("gotToken: %v, gotErr: %v, wantToken: Bearer p1-test", gotToken, gotErr)
+		}
+	})
+}
+
+func TestDefaultAuthURL(t *testing.T) {
+	tests := map[string]struct {
+		address     string
+		wantAuthURL string
+	}{
+		"north_america": {
+			address:     "https://api.pingone.com",
+			wantAuthURL: "https://auth.pingone.com",
+		},
+		"europe": {
+			address:     "https://api.pingone.eu",
+			wantAuthURL: "https://auth.pingone.eu",
+		},
+		"not_an_api_subdomain": {
+			address:     "https://pingone.example.com",
+			wantAuthURL: "https://pingone.example.com",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if gotAuthURL := pingone.DefaultAuthURL(tt.address); gotAuthURL != tt.wantAuthURL {
+				t.Errorf("gotAuthURL: %v, wantAuthURL: %v", gotAuthURL, tt.wantAuthURL)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pingone
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// For example, the endpoint of the second page of 100 users is:
+// https://api.pingone.com/v1/environments/{environmentId}/users?cursor={cursor}&limit=100.
+// The applications and their role assignments aren't paginated, so their endpoint is the same for all the pages,
+// e.g. https://api.pingone.com/v1/environments/{environmentId}/applications/{applicationId}/roleAssignments.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if entity.memberOf != nil && (request.Cursor == nil || request.Cursor.CollectionID == nil) {
+		return "", &framework.Error{
+			Message: fmt.Sprintf("Unable to construct the %s endpoint without a collection ID.", request.EntityExternalID),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	endpoint := request.BaseURL + "/v1/environments/" + url.PathEscape(request.EnvironmentID)
+
+	// [MemberEntities] The path of the members is relative to the collection object,
+	// e.g. /users/{userId}/memberOfGroups.
+	if entity.memberOf != nil {
+		endpoint += ValidEntityExternalIDs[*entity.memberOf].path + "/" + url.PathEscape(*request.Cursor.CollectionID)
+	}
+
+	endpoint += entity.path
+
+	if !entity.paginated {
+		return endpoint, nil
+	}
+
+	params := url.Values{}
+	params.Set("limit", strconv.FormatInt(request.PageSize, 10))
+
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		params.Set("cursor", *request.Cursor.Cursor)
+	}
+
+	return endpoint + "?" + params.Encode(), nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package pingone_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/pingone"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *pingone.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &pingone.Request{
+				BaseURL:          "https://api.pingone.com",
+				EnvironmentID:    "env-1",
+				PageSize:         100,
+				EntityExternalID: "Role",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"users": {
+			request: &pingone.Request{
+				BaseURL:          "https://api.pingone.com",
+				EnvironmentID:    "env-1",
+				PageSize:         100,
+				EntityExternalID: pingone.User,
+			},
+			wantEndpoint: "https://api.pingone.com/v1/environments/env-1/users?limit=100",
+		},
+		"groups_next_page": {
+			request: &pingone.Request{
+				BaseURL:          "https://api.pingone.eu",
+				EnvironmentID:    "env-1",
+				PageSize:         100,
+				EntityExternalID: pingone.Group,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("Zz1n+2="),
+				},
+			},
+			wantEndpoint: "https://api.pingone.eu/v1/environments/env-1/groups?cursor=Zz1n%2B2%3D&limit=100",
+		},
+		"populations": {
+			request: &pingone.Request{
+				BaseURL:          "https://api.pingone.ca",
+				EnvironmentID:    "env-1",
+				PageSize:         10,
+				EntityExternalID: pingone.Population,
+			},
+			wantEndpoint: "https://api.pingone.ca/v1/environments/env-1/populations?limit=10",
+		},
+		"applications_next_page": {
+			request: &pingone.Request{
+				BaseURL:          "https://api.pingone.com",
+				EnvironmentID:    "env-1",
+				PageSize:         10,
+				EntityExternalID: pingone.Application,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("10"),
+				},
+			},
+			wantEndpoint: "https://api.pingone.com/v1/environments/env-1/applications",
+		},
+		"group_memberships": {
+			request: &pingone.Request{
+				BaseURL:          "https://api.pingone.com",
+				EnvironmentID:    "env-1",
+				PageSize:         10,
+				EntityExternalID: pingone.GroupMembership,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor:       testutil.GenPtr("bTI="),
+					CollectionID: testutil.GenPtr("u1"),
+				},
+			},
+			wantEndpoint: "https://api.pingone.com/v1/environments/env-1/users/u1/memberOfGroups?cursor=bTI%3D&limit=10",
+		},
+		"application_role_assignments": {
+			request: &pingone.Request{
+				BaseURL:          "https://api.pingone.com",
+				EnvironmentID:    "env-1",
+				PageSize:         10,
+				EntityExternalID: pingone.ApplicationRoleAssignment,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID: testutil.GenPtr("app-1"),
+				},
+			},
+			wantEndpoint: "https://api.pingone.com/v1/environments/env-1/applications/app-1/roleAssignments",
+		},
+		"application_role_assignments_without_collection_id": {
+			request: &pingone.Request{
+				BaseURL:          "https://api.pingone.com",
+				EnvironmentID:    "env-1",
+				PageSize:         10,
+				EntityExternalID: pingone.ApplicationRoleAssignment,
+			},
+			wantErr: &framework.Error{
+				Message: "Unable to construct the ApplicationRoleAssignment endpoint without a collection ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := pingone.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
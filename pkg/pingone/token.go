@@ -0,0 +1,162 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+	"github.com/sgnl-ai/adapters/pkg/auth"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+)
+
+// tokenExpiryLeeway is subtracted from the lifetime of the cached access tokens, so that a token doesn't expire
+// while a page is being requested.
+const tokenExpiryLeeway = time.Minute
+
+// TokenRequest is a request for an access token of a worker application, using the OAuth client credentials flow:
+// https://apidocs.pingidentity.com/pingone/platform/v1/api/#post-token-client_credentials-client_secret_basic.
+type TokenRequest struct {
+	// AuthURL is the base URL of the authentication service of the region of the environment,
+	// e.g. "https://auth.pingone.com".
+	AuthURL string
+
+	// EnvironmentID is the ID of the environment of the worker application.
+	EnvironmentID string
+
+	// ClientID is the client ID of the worker application.
+	ClientID string
+
+	// ClientSecret is the client secret of the worker application, which must use the "Client Secret Basic"
+	// token endpoint authentication method.
+	ClientSecret string
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	RequestTimeoutSeconds int
+}
+
+// tokenResponse is the response of the token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// cachedToken is an access token cached by the Datasource until it expires.
+type cachedToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// tokenCache caches the access tokens of the worker applications across pages.
+type tokenCache struct {
+	mu     sync.Mutex
+	tokens map[TokenRequest]cachedToken
+}
+
+func (c *tokenCache) get(request TokenRequest) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, found := c.tokens[request]
+	if !found || !time.Now().Before(cached.expiresAt) {
+		return "", false
+	}
+
+	return cached.token, true
+}
+
+func (c *tokenCache) set(request TokenRequest, token string, expiresIn int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tokens == nil {
+		c.tokens = make(map[TokenRequest]cachedToken)
+	}
+
+	c.tokens[request] = cachedToken{
+		token:     token,
+		expiresAt: time.Now().Add(time.Duration(expiresIn)*time.Second - tokenExpiryLeeway),
+	}
+}
+
+// DefaultAuthURL returns the base URL of the authentication service of the region of the Management API at the
+// address, e.g. "https://auth.pingone.eu" for "https://api.pingone.eu". The address is returned unchanged if its
+// host isn't an "api." subdomain.
+func DefaultAuthURL(address string) string {
+	parsed, err := url.Parse(address)
+	if err != nil || !strings.HasPrefix(parsed.Host, "api.") {
+		return address
+	}
+
+	parsed.Host = "auth." + strings.TrimPrefix(parsed.Host, "api.")
+
+	return parsed.String()
+}
+
+// GetToken returns the Authorization header value, i.e. "Bearer <token>", to query the Management API with the
+// client credentials of the request. Tokens are cached until they expire.
+func (d *Datasource) GetToken(ctx context.Context, request *TokenRequest) (string, *framework.Error) {
+	if token, found := d.tokens.get(*request); found {
+		return token, nil
+	}
+
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+	}
+
+	var token tokenResponse
+
+	httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+		Method: http.MethodPost,
+		URL:    request.AuthURL + "/" + url.PathEscape(request.EnvironmentID) + "/as/token",
+		Body:   []byte(form.Encode()),
+		Header: http.Header{
+			"Authorization": {auth.BasicAuthHeader(request.ClientID, request.ClientSecret)},
+			"Content-Type":  {"application/x-www-form-urlencoded"},
+		},
+		DatasourceName:        "PingOne token",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, err := httpds.ReadAll(body, "PingOne token")
+		if err != nil {
+			return err
+		}
+
+		return httpds.UnmarshalJSON(bodyBytes, &token)
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if adapterErr := web.HTTPError(httpResponse.StatusCode, httpResponse.RetryAfterHeader); adapterErr != nil {
+		adapterErr.Message = fmt.Sprintf(
+			"Failed to get an access token for PingOne environment %s: %s", request.EnvironmentID, adapterErr.Message,
+		)
+
+		return "", adapterErr
+	}
+
+	if token.AccessToken == "" {
+		return "", &framework.Error{
+			Message: fmt.Sprintf(
+				"PingOne token response for environment %s is missing an access token.", request.EnvironmentID,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	authorization := "Bearer " + token.AccessToken
+
+	d.tokens.set(*request, authorization, token.ExpiresIn)
+
+	return authorization, nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package pingone_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pingone"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: pingone.User,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "userName",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	validConfig := &pingone.Config{
+		EnvironmentID: "env-1",
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[pingone.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request": {
+			request: &framework.Request[pingone.Config]{
+				Address: "api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantAddress: "https://api.pingone.com",
+		},
+		"invalid_request_nil_config": {
+			request: &framework.Request[pingone.Config]{
+				Address: "https://api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "PingOne config is invalid: request contains no config.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_environment_id": {
+			request: &framework.Request[pingone.Config]{
+				Address: "https://api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Entity:   validEntity,
+				Config:   &pingone.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "PingOne config is invalid: environmentId is not set.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_auth_url": {
+			request: &framework.Request[pingone.Config]{
+				Address: "https://api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Entity: validEntity,
+				Config: &pingone.Config{
+					EnvironmentID: "env-1",
+					AuthURL:       "http://auth.pingone.com",
+				},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "PingOne config is invalid: authUrl must be an https URL.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[pingone.Config]{
+				Address: "http://api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"valid_request_client_credentials": {
+			request: &framework.Request[pingone.Config]{
+				Address: "https://api.pingone.eu",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "worker-client",
+						Password: "worker-secret",
+					},
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantAddress: "https://api.pingone.eu",
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[pingone.Config]{
+				Address:  "https://api.pingone.com",
+				Auth:     &framework.DatasourceAuthCredentials{},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Request to PingOne is missing OAuth client credentials or Bearer token credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[pingone.Config]{
+				Address: "https://api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "p1-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[pingone.Config]{
+				Address: "https://api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Role",
+				},
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[pingone.Config]{
+				Address: "https://api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: pingone.GroupMembership,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "groupId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config:   validConfig,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[pingone.Config]{
+				Address: "https://api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[pingone.Config]{
+				Address: "https://api.pingone.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer p1-test",
+				},
+				Entity:   validEntity,
+				Config:   validConfig,
+				PageSize: 1001,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1001) exceeds the maximum allowed (1000).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &pingone.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
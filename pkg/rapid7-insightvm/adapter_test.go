@@ -0,0 +1,249 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package rapid7insightvm_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	rapid7insightvm "github.com/sgnl-ai/adapters/pkg/rapid7-insightvm"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := rapid7insightvm.NewAdapter(&rapid7insightvm.Datasource{
+		Client: server.Client(),
+	})
+
+	marshalCursor := func(cursor *pagination.CompositeCursor[int64]) string {
+		encodedCursor, err := pagination.MarshalCursor(cursor)
+		if err != nil {
+			t.Fatalf("failed to marshal cursor: %v", err)
+		}
+
+		return encodedCursor
+	}
+
+	basicAuth := &framework.DatasourceAuthCredentials{
+		Basic: &framework.BasicAuthCredentials{
+			Username: "svc-sgnl",
+			Password: "secret",
+		},
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[rapid7insightvm.Config]
+		wantResponse framework.Response
+	}{
+		"assets_first_page": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address: server.URL,
+				Auth:    basicAuth,
+				Config:  &rapid7insightvm.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: rapid7insightvm.Asset,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "hostName",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "riskScore",
+							Type:       framework.AttributeTypeDouble,
+						},
+						{
+							ExternalId: "$.vulnerabilities.critical",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":                         int64(1),
+							"hostName":                   "web-01.acme.com",
+							"riskScore":                  1520.5,
+							"$.vulnerabilities.critical": int64(2),
+						},
+						{
+							"id":                         int64(4),
+							"hostName":                   "db-01.acme.com",
+							"riskScore":                  float64(0),
+							"$.vulnerabilities.critical": int64(0),
+						},
+					},
+					NextCursor: marshalCursor(&pagination.CompositeCursor[int64]{
+						Cursor: testutil.GenPtr[int64](1),
+					}),
+				},
+			},
+		},
+		"assets_last_page": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address: server.URL,
+				Auth:    basicAuth,
+				Config:  &rapid7insightvm.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: rapid7insightvm.Asset,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 2,
+				Cursor: marshalCursor(&pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](1),
+				}),
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"id": int64(7)},
+					},
+				},
+			},
+		},
+		"vulnerabilities": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address: server.URL,
+				Auth:    basicAuth,
+				Config:  &rapid7insightvm.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: rapid7insightvm.Vulnerability,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "severity",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.cvss.v3.score",
+							Type:       framework.AttributeTypeDouble,
+						},
+						{
+							ExternalId: "published",
+							Type:       framework.AttributeTypeDateTime,
+						},
+						{
+							ExternalId: "modified",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":              "msft-cve-2024-21338",
+							"severity":        "Critical",
+							"$.cvss.v3.score": 7.8,
+							"published":       time.Date(2024, 2, 13, 0, 0, 0, 0, time.UTC),
+							"modified":        time.Date(2024, 3, 5, 14, 12, 37, 123000000, time.UTC),
+						},
+					},
+				},
+			},
+		},
+		"users": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address: server.URL,
+				Auth:    basicAuth,
+				Config:  &rapid7insightvm.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: rapid7insightvm.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+						{
+							ExternalId: "login",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "enabled",
+							Type:       framework.AttributeTypeBool,
+						},
+						{
+							ExternalId: "$.role.superuser",
+							Type:       framework.AttributeTypeBool,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":               int64(1),
+							"login":            "admin",
+							"enabled":          true,
+							"$.role.superuser": true,
+						},
+					},
+				},
+			},
+		},
+		"unauthorized": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "svc-sgnl",
+						Password: "invalid",
+					},
+				},
+				Config: &rapid7insightvm.Config{},
+				Entity: framework.EntityConfig{
+					ExternalId: rapid7insightvm.Site,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeInt64,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
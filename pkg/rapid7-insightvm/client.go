@@ -0,0 +1,57 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package rapid7insightvm
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Client is a client that allows querying the Rapid7 InsightVM datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+}
+
+// Request is a request to the InsightVM console API.
+type Request struct {
+	// BaseURL is the base URL of the Security Console, e.g. "https://insightvm.acme.com:3780".
+	BaseURL string
+
+	// AuthorizationHeader is the Authorization header value to authenticate a request: the Basic credentials of a
+	// console user with the permissions to view the assets, vulnerabilities, sites, users and asset groups.
+	AuthorizationHeader string
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity. The cursor is the zero-based index of the page, i.e. the "page" parameter.
+	// nil in the request for the first page.
+	Cursor *pagination.CompositeCursor[int64]
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *pagination.CompositeCursor[int64]
+}
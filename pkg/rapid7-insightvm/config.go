@@ -0,0 +1,33 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package rapid7insightvm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Rapid7 InsightVM Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	if c == nil {
+		return errors.New("request contains no config")
+	}
+
+	return c.CommonConfig.ValidateSyncMode()
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package rapid7insightvm
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+}
+
+// ListResponse is the format of the paged resources responses of the InsightVM API v3.
+// https://help.rapid7.com/insightvm/en-us/api/index.html#section/Overview/Paging.
+type ListResponse struct {
+	Resources []map[string]any `json:"resources"`
+	Page      PageInfo         `json:"page"`
+}
+
+// PageInfo is the paging information of a paged resources response.
+type PageInfo struct {
+	// Number is the zero-based index of the page.
+	Number int64 `json:"number"`
+	// Size is the maximum number of resources of the page.
+	Size int64 `json:"size"`
+	// TotalPages is the total number of pages of the resources.
+	TotalPages int64 `json:"totalPages"`
+	// TotalResources is the total number of resources.
+	TotalResources int64 `json:"totalResources"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute.
+type Entity struct {
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// path is the path of the endpoint of the entity, relative to the API.
+	path string
+}
+
+const (
+	Asset         string = "Asset"
+	Vulnerability string = "Vulnerability"
+	Site          string = "Site"
+	User          string = "User"
+	AssetGroup    string = "AssetGroup"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// GET /api/3/assets.
+		Asset: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/assets",
+		},
+		// GET /api/3/vulnerabilities.
+		Vulnerability: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/vulnerabilities",
+		},
+		// GET /api/3/sites.
+		Site: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/sites",
+		},
+		// GET /api/3/users.
+		User: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/users",
+		},
+		// GET /api/3/asset_groups.
+		AssetGroup: {
+			uniqueIDAttrExternalID: "id",
+			path:                   "/asset_groups",
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	validationErr := pagination.ValidateCompositeCursor(request.Cursor, request.EntityExternalID, false)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects        []map[string]any
+		nextPageCursor *int64
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: endpoint,
+		Header: http.Header{
+			"Authorization": {request.AuthorizationHeader},
+			"Accept":        {"application/json"},
+		},
+		DatasourceName:        "Rapid7 InsightVM",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Rapid7 InsightVM")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, nextPageCursor, parseErr = ParseResponse(bodyBytes)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	if nextPageCursor != nil {
+		response.NextCursor = &pagination.CompositeCursor[int64]{
+			Cursor: nextPageCursor,
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the resources of an InsightVM API paged resources response, and the index of the next
+// page, if any.
+func ParseResponse(body []byte) (objects []map[string]any, nextCursor *int64, err *framework.Error) {
+	var data ListResponse
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	// An empty page ends the sync even if totalPages is stale, e.g. if resources were deleted during the sync.
+	if len(data.Resources) == 0 || data.Page.Number+1 >= data.Page.TotalPages {
+		return data.Resources, nil, nil
+	}
+
+	nextPage := data.Page.Number + 1
+
+	return data.Resources, &nextPage, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package rapid7insightvm_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	rapid7insightvm "github.com/sgnl-ai/adapters/pkg/rapid7-insightvm"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock InsightVM console API server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	// Basic credentials of the svc-sgnl user, with the password "secret".
+	if r.Header.Get("Authorization") != "Basic c3ZjLXNnbmw6c2VjcmV0" {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"status": 401, "message": "Authorization Required", "links": []}`))
+
+		return
+	}
+
+	switch r.URL.RequestURI() {
+	// Assets Page 1
+	case "/api/3/assets?page=0&size=2&sort=id%2CASC":
+		w.Write([]byte(`{"resources": [
+			{"id": 1, "hostName": "web-01.acme.com", "ip": "10.0.0.1", "os": "Ubuntu Linux 22.04", "riskScore": 1520.5, "vulnerabilities": {"critical": 2, "severe": 5, "moderate": 1, "total": 8}, "history": [{"type": "SCAN", "date": "2024-03-05T14:12:37.123Z"}]},
+			{"id": 4, "hostName": "db-01.acme.com", "ip": "10.0.0.4", "os": "Microsoft Windows Server 2019", "riskScore": 0.0, "vulnerabilities": {"critical": 0, "severe": 0, "moderate": 0, "total": 0}}
+		], "page": {"number": 0, "size": 2, "totalResources": 3, "totalPages": 2}, "links": [{"rel": "self", "href": "https://insightvm.acme.com:3780/api/3/assets?page=0&size=2"}]}`))
+
+	// Assets Page 2
+	case "/api/3/assets?page=1&size=2&sort=id%2CASC":
+		w.Write([]byte(`{"resources": [
+			{"id": 7, "hostName": "mail-01.acme.com", "ip": "10.0.0.7", "os": "Red Hat Enterprise Linux 9", "riskScore": 250.0, "vulnerabilities": {"critical": 0, "severe": 1, "moderate": 0, "total": 1}}
+		], "page": {"number": 1, "size": 2, "totalResources": 3, "totalPages": 2}, "links": []}`))
+
+	// Vulnerabilities
+	case "/api/3/vulnerabilities?page=0&size=2&sort=id%2CASC":
+		w.Write([]byte(`{"resources": [
+			{"id": "msft-cve-2024-21338", "title": "Microsoft Windows: CVE-2024-21338: Windows Kernel Elevation of Privilege Vulnerability", "severity": "Critical", "cvss": {"v3": {"score": 7.8}}, "published": "2024-02-13", "modified": "2024-03-05T14:12:37.123Z"}
+		], "page": {"number": 0, "size": 2, "totalResources": 1, "totalPages": 1}, "links": []}`))
+
+	// Sites
+	case "/api/3/sites?page=0&size=2&sort=id%2CASC":
+		w.Write([]byte(`{"resources": [
+			{"id": 1, "name": "Headquarters", "type": "static", "assets": 2, "riskScore": 1520.5},
+			{"id": 2, "name": "Cloud", "type": "dynamic", "assets": 1, "riskScore": 250.0}
+		], "page": {"number": 0, "size": 2, "totalResources": 2, "totalPages": 1}, "links": []}`))
+
+	// Users
+	case "/api/3/users?page=0&size=2&sort=id%2CASC":
+		w.Write([]byte(`{"resources": [
+			{"id": 1, "login": "admin", "name": "Administrator", "email": "admin@acme.com", "enabled": true, "locked": false, "role": {"id": "global-admin", "name": "Global Administrator", "superuser": true}}
+		], "page": {"number": 0, "size": 2, "totalResources": 1, "totalPages": 1}, "links": []}`))
+
+	// Asset Groups, with a stale totalPages after an asset group was deleted.
+	case "/api/3/asset_groups?page=0&size=2&sort=id%2CASC":
+		w.Write([]byte(`{"resources": [], "page": {"number": 0, "size": 2, "totalResources": 1, "totalPages": 1}, "links": []}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body           []byte
+		wantObjects    []map[string]any
+		wantNextCursor *int64
+		wantErr        *framework.Error
+	}{
+		"last_page": {
+			body:        []byte(`{"resources": [{"id": 1}], "page": {"number": 0, "size": 10, "totalResources": 1, "totalPages": 1}}`),
+			wantObjects: []map[string]any{{"id": float64(1)}},
+		},
+		"next_page": {
+			body:           []byte(`{"resources": [{"id": 11}], "page": {"number": 1, "size": 10, "totalResources": 25, "totalPages": 3}}`),
+			wantObjects:    []map[string]any{{"id": float64(11)}},
+			wantNextCursor: testutil.GenPtr[int64](2),
+		},
+		"no_objects": {
+			body:        []byte(`{"resources": [], "page": {"number": 0, "size": 10, "totalResources": 0, "totalPages": 0}}`),
+			wantObjects: []map[string]any{},
+		},
+		"no_objects_with_stale_total_pages": {
+			body:        []byte(`{"resources": [], "page": {"number": 2, "size": 10, "totalResources": 40, "totalPages": 4}}`),
+			wantObjects: []map[string]any{},
+		},
+		"invalid_response": {
+			body: []byte(`[]`),
+			wantErr: &framework.Error{
+				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal array into Go value of type rapid7insightvm.ListResponse.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextCursor, gotErr := rapid7insightvm.ParseResponse(tt.body)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextCursor, tt.wantNextCursor) {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", gotNextCursor, tt.wantNextCursor)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	client := rapid7insightvm.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *rapid7insightvm.Request
+		wantResponse *rapid7insightvm.Response
+		wantErr      *framework.Error
+	}{
+		"assets_first_page": {
+			request: &rapid7insightvm.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Basic c3ZjLXNnbmw6c2VjcmV0",
+				PageSize:            2,
+				EntityExternalID:    rapid7insightvm.Asset,
+			},
+			wantResponse: &rapid7insightvm.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(1), "hostName": "web-01.acme.com", "ip": "10.0.0.1", "os": "Ubuntu Linux 22.04", "riskScore": 1520.5, "vulnerabilities": map[string]any{"critical": float64(2), "severe": float64(5), "moderate": float64(1), "total": float64(8)}, "history": []any{map[string]any{"type": "SCAN", "date": "2024-03-05T14:12:37.123Z"}}},
+					{"id": float64(4), "hostName": "db-01.acme.com", "ip": "10.0.0.4", "os": "Microsoft Windows Server 2019", "riskScore": float64(0), "vulnerabilities": map[string]any{"critical": float64(0), "severe": float64(0), "moderate": float64(0), "total": float64(0)}},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](1),
+				},
+			},
+		},
+		"assets_last_page": {
+			request: &rapid7insightvm.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Basic c3ZjLXNnbmw6c2VjcmV0",
+				PageSize:            2,
+				EntityExternalID:    rapid7insightvm.Asset,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](1),
+				},
+			},
+			wantResponse: &rapid7insightvm.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(7), "hostName": "mail-01.acme.com", "ip": "10.0.0.7", "os": "Red Hat Enterprise Linux 9", "riskScore": float64(250), "vulnerabilities": map[string]any{"critical": float64(0), "severe": float64(1), "moderate": float64(0), "total": float64(1)}},
+				},
+			},
+		},
+		"sites": {
+			request: &rapid7insightvm.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Basic c3ZjLXNnbmw6c2VjcmV0",
+				PageSize:            2,
+				EntityExternalID:    rapid7insightvm.Site,
+			},
+			wantResponse: &rapid7insightvm.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": float64(1), "name": "Headquarters", "type": "static", "assets": float64(2), "riskScore": 1520.5},
+					{"id": float64(2), "name": "Cloud", "type": "dynamic", "assets": float64(1), "riskScore": float64(250)},
+				},
+			},
+		},
+		"asset_groups_empty_page": {
+			request: &rapid7insightvm.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Basic c3ZjLXNnbmw6c2VjcmV0",
+				PageSize:            2,
+				EntityExternalID:    rapid7insightvm.AssetGroup,
+			},
+			wantResponse: &rapid7insightvm.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]any{},
+			},
+		},
+		"invalid_cursor": {
+			request: &rapid7insightvm.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Basic c3ZjLXNnbmw6c2VjcmV0",
+				PageSize:            2,
+				EntityExternalID:    rapid7insightvm.User,
+				Cursor: &pagination.CompositeCursor[int64]{
+					CollectionID: testutil.GenPtr("1"),
+				},
+			},
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity User: cursor must not contain CollectionID or CollectionCursor fields. Expected cursor shape: {"cursor":<int64>}. Restart the sync for this entity to discard the invalid cursor.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"unauthorized": {
+			request: &rapid7insightvm.Request{
+				BaseURL:             server.URL,
+				AuthorizationHeader: "Basic aW52YWxpZDppbnZhbGlk",
+				PageSize:            2,
+				EntityExternalID:    rapid7insightvm.User,
+			},
+			wantResponse: &rapid7insightvm.Response{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
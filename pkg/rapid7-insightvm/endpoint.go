@@ -0,0 +1,45 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package rapid7insightvm
+
+import (
+	"net/url"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// For example, the endpoint of the second page of 100 assets is:
+// https://insightvm.acme.com:3780/api/3/assets?page=1&size=100&sort=id%2CASC.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	var page int64
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		page = *request.Cursor.Cursor
+	}
+
+	params := url.Values{}
+	params.Set("page", strconv.FormatInt(page, 10))
+	params.Set("size", strconv.FormatInt(request.PageSize, 10))
+
+	// The pages are sorted by ID so that they're stable while the sync is in progress.
+	params.Set("sort", entity.uniqueIDAttrExternalID+",ASC")
+
+	return request.BaseURL + "/api/3" + entity.path + "?" + params.Encode(), nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package rapid7insightvm_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	rapid7insightvm "github.com/sgnl-ai/adapters/pkg/rapid7-insightvm"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *rapid7insightvm.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &rapid7insightvm.Request{
+				BaseURL:          "https://insightvm.acme.com:3780",
+				PageSize:         100,
+				EntityExternalID: "Scan",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"assets": {
+			request: &rapid7insightvm.Request{
+				BaseURL:          "https://insightvm.acme.com:3780",
+				PageSize:         100,
+				EntityExternalID: rapid7insightvm.Asset,
+			},
+			wantEndpoint: "https://insightvm.acme.com:3780/api/3/assets?page=0&size=100&sort=id%2CASC",
+		},
+		"assets_next_page": {
+			request: &rapid7insightvm.Request{
+				BaseURL:          "https://insightvm.acme.com:3780",
+				PageSize:         100,
+				EntityExternalID: rapid7insightvm.Asset,
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr[int64](3),
+				},
+			},
+			wantEndpoint: "https://insightvm.acme.com:3780/api/3/assets?page=3&size=100&sort=id%2CASC",
+		},
+		"vulnerabilities": {
+			request: &rapid7insightvm.Request{
+				BaseURL:          "https://insightvm.acme.com:3780",
+				PageSize:         500,
+				EntityExternalID: rapid7insightvm.Vulnerability,
+			},
+			wantEndpoint: "https://insightvm.acme.com:3780/api/3/vulnerabilities?page=0&size=500&sort=id%2CASC",
+		},
+		"sites": {
+			request: &rapid7insightvm.Request{
+				BaseURL:          "https://insightvm.acme.com:3780",
+				PageSize:         100,
+				EntityExternalID: rapid7insightvm.Site,
+			},
+			wantEndpoint: "https://insightvm.acme.com:3780/api/3/sites?page=0&size=100&sort=id%2CASC",
+		},
+		"users": {
+			request: &rapid7insightvm.Request{
+				BaseURL:          "https://insightvm.acme.com:3780",
+				PageSize:         100,
+				EntityExternalID: rapid7insightvm.User,
+			},
+			wantEndpoint: "https://insightvm.acme.com:3780/api/3/users?page=0&size=100&sort=id%2CASC",
+		},
+		"asset_groups": {
+			request: &rapid7insightvm.Request{
+				BaseURL:          "https://insightvm.acme.com:3780",
+				PageSize:         100,
+				EntityExternalID: rapid7insightvm.AssetGroup,
+			},
+			wantEndpoint: "https://insightvm.acme.com:3780/api/3/asset_groups?page=0&size=100&sort=id%2CASC",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := rapid7insightvm.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
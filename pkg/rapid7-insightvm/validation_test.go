@@ -0,0 +1,179 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package rapid7insightvm_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	rapid7insightvm "github.com/sgnl-ai/adapters/pkg/rapid7-insightvm"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: rapid7insightvm.Asset,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeInt64,
+			},
+			{
+				ExternalId: "hostName",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	basicAuth := &framework.DatasourceAuthCredentials{
+		Basic: &framework.BasicAuthCredentials{
+			Username: "svc-sgnl",
+			Password: "secret",
+		},
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[rapid7insightvm.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address:  "insightvm.acme.com:3780",
+				Auth:     basicAuth,
+				Entity:   validEntity,
+				Config:   &rapid7insightvm.Config{},
+				PageSize: 500,
+			},
+			wantAddress: "https://insightvm.acme.com:3780",
+		},
+		"valid_request_https_address": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address:  "https://insightvm.acme.com:3780",
+				Auth:     basicAuth,
+				Entity:   validEntity,
+				Config:   &rapid7insightvm.Config{},
+				PageSize: 100,
+			},
+			wantAddress: "https://insightvm.acme.com:3780",
+		},
+		"invalid_request_nil_config": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address:  "https://insightvm.acme.com:3780",
+				Auth:     basicAuth,
+				Entity:   validEntity,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Rapid7 InsightVM config is invalid: request contains no config.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address:  "http://insightvm.acme.com:3780",
+				Auth:     basicAuth,
+				Entity:   validEntity,
+				Config:   &rapid7insightvm.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_basic_auth": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address: "https://insightvm.acme.com:3780",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer token",
+				},
+				Entity:   validEntity,
+				Config:   &rapid7insightvm.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Request to Rapid7 InsightVM is missing Basic credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address: "https://insightvm.acme.com:3780",
+				Auth:    basicAuth,
+				Entity: framework.EntityConfig{
+					ExternalId: "Scan",
+					Attributes: validEntity.Attributes,
+				},
+				Config:   &rapid7insightvm.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address: "https://insightvm.acme.com:3780",
+				Auth:    basicAuth,
+				Entity: framework.EntityConfig{
+					ExternalId: rapid7insightvm.Asset,
+					Attributes: validEntity.Attributes[1:],
+				},
+				Config:   &rapid7insightvm.Config{},
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address:  "https://insightvm.acme.com:3780",
+				Auth:     basicAuth,
+				Entity:   validEntity,
+				Config:   &rapid7insightvm.Config{},
+				Ordered:  true,
+				PageSize: 100,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[rapid7insightvm.Config]{
+				Address:  "https://insightvm.acme.com:3780",
+				Auth:     basicAuth,
+				Entity:   validEntity,
+				Config:   &rapid7insightvm.Config{},
+				PageSize: 501,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (501) exceeds the maximum allowed (500).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &rapid7insightvm.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
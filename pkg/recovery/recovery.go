@@ -0,0 +1,106 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package recovery recovers the adapter gRPC servers from the panics of the adapters.
+//
+// A panic in the goroutine handling a gRPC call crashes the whole server, failing the syncs of all the
+// datasources it serves, e.g. a nil pointer dereference while parsing an unexpected response of a single
+// datasource. The interceptors of this package recover from the panics of the handlers, log them with their
+// stack trace, and return an internal error to the caller instead, which retries the page as for any other
+// internal error.
+//
+// Panics in goroutines started by the adapters can't be recovered by the interceptors, and still crash the server.
+package recovery
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorMessage is the message of the errors returned for the calls whose handler panicked.
+// The panic value isn't returned to the caller as it may contain sensitive data, only logged.
+const ErrorMessage = "Adapter encountered an internal error while processing the request."
+
+// ServerOptions returns the options of a gRPC server recovering from the panics of its handlers.
+// They must be passed to grpc.NewServer before the options of any other interceptor, so that the panics of
+// the other interceptors are recovered too.
+func ServerOptions(logger *zap.Logger) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor(logger)),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor(logger)),
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor recovering from the panics of the unary call handlers.
+// The GetPage calls return a response with an ERROR_CODE_INTERNAL error, like the errors returned by the
+// adapters, and the other calls return an error with the Internal gRPC status code.
+func UnaryServerInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logPanic(logger, info.FullMethod, req, r)
+
+				if _, ok := req.(*api_adapter_v1.GetPageRequest); ok {
+					resp, err = api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+						Message: ErrorMessage,
+						Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					}), nil
+
+					return
+				}
+
+				resp, err = nil, status.Error(codes.Internal, ErrorMessage)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor recovering from the panics of the streaming call handlers,
+// returning an error with the Internal gRPC status code.
+func StreamServerInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logPanic(logger, info.FullMethod, nil, r)
+
+				err = status.Error(codes.Internal, ErrorMessage)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// logPanic logs a recovered panic with the stack trace of the panicking goroutine, and the adapter type and version
+// and the entity of the GetPage requests.
+func logPanic(logger *zap.Logger, method string, req any, r any) {
+	logFields := []zap.Field{
+		fields.SGNLEventTypeError(),
+		fields.GRPCMethod(method),
+		fields.PanicValue(r),
+		fields.PanicStack(debug.Stack()),
+	}
+
+	if getPageReq, ok := req.(*api_adapter_v1.GetPageRequest); ok {
+		adapterType, adapterVersion, _ := strings.Cut(getPageReq.GetDatasource().GetType(), "-")
+
+		logFields = append(logFields,
+			fields.AdapterType(adapterType),
+			fields.AdapterVersion(adapterVersion),
+			fields.RequestEntityExternalID(getPageReq.GetEntity().GetExternalId()),
+		)
+	}
+
+	logger.Error("Recovered from a panic while handling a gRPC call", logFields...)
+}
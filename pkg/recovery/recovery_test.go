@@ -0,0 +1,252 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package recovery_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/recovery"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// panickingAdapterServer simulates an adapter panicking while handling the GetPage requests of the
+// "Panicking-1.0.0" datasource type.
+type panickingAdapterServer struct {
+	api_adapter_v1.UnimplementedAdapterServer
+}
+
+func (s *panickingAdapterServer) GetPage(
+	_ context.Context, req *api_adapter_v1.GetPageRequest,
+) (*api_adapter_v1.GetPageResponse, error) {
+	if req.GetDatasource().GetType() == "Panicking-1.0.0" {
+		switch req.GetCursor() {
+		case "nil_pointer":
+			var datasource *api_adapter_v1.DatasourceConfig
+
+			return nil, errors.New(datasource.Id)
+		case "error":
+			panic(errors.New("unexpected response"))
+		default:
+			panic("unexpected response")
+		}
+	}
+
+	return api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{NextCursor: "next"}), nil
+}
+
+func newTestServer(t *testing.T, logger *zap.Logger) api_adapter_v1.AdapterClient {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	s := grpc.NewServer(recovery.ServerOptions(logger)...)
+	api_adapter_v1.RegisterAdapterServer(s, &panickingAdapterServer{})
+
+	go s.Serve(listener)
+
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	t.Cleanup(func() { conn.Close() })
+
+	return api_adapter_v1.NewAdapterClient(conn)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tests := map[string]struct {
+		req            *api_adapter_v1.GetPageRequest
+		wantResponse   *api_adapter_v1.GetPageResponse
+		wantPanicValue string
+	}{
+		"panic_with_string": {
+			req: &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{Type: "Panicking-1.0.0"},
+				Entity:     &api_adapter_v1.EntityConfig{ExternalId: "users"},
+			},
+			wantResponse: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message: recovery.ErrorMessage,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}),
+			wantPanicValue: "unexpected response",
+		},
+		"panic_with_error": {
+			req: &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{Type: "Panicking-1.0.0"},
+				Entity:     &api_adapter_v1.EntityConfig{ExternalId: "users"},
+				Cursor:     "error",
+			},
+			wantResponse: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message: recovery.ErrorMessage,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}),
+			wantPanicValue: "unexpected response",
+		},
+		"nil_pointer_dereference": {
+			req: &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{Type: "Panicking-1.0.0"},
+				Entity:     &api_adapter_v1.EntityConfig{ExternalId: "users"},
+				Cursor:     "nil_pointer",
+			},
+			wantResponse: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message: recovery.ErrorMessage,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}),
+			wantPanicValue: "runtime error: invalid memory address or nil pointer dereference",
+		},
+		"no_panic": {
+			req: &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{Type: "Okta-1.0.1"},
+				Entity:     &api_adapter_v1.EntityConfig{ExternalId: "users"},
+			},
+			wantResponse: api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{NextCursor: "next"}),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			core, observedLogs := observer.New(zapcore.ErrorLevel)
+			client := newTestServer(t, zap.New(core))
+
+			gotResponse, err := client.GetPage(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !proto.Equal(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			// The server keeps serving the requests after a panic.
+			healthyResponse, err := client.GetPage(context.Background(), &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{Type: "Okta-1.0.1"},
+			})
+			if err != nil || healthyResponse.GetSuccess() == nil {
+				t.Fatalf("server stopped serving requests after a panic: %v, %v", healthyResponse, err)
+			}
+
+			gotLogs := observedLogs.All()
+
+			if tt.wantPanicValue == "" {
+				if len(gotLogs) != 0 {
+					t.Errorf("expected no logs, got %d", len(gotLogs))
+				}
+
+				return
+			}
+
+			if len(gotLogs) != 1 {
+				t.Fatalf("expected 1 log, got %d", len(gotLogs))
+			}
+
+			gotLog := gotLogs[0].ContextMap()
+
+			wantFields := map[string]any{
+				fields.FieldSGNLEventType:           fields.SGNLEventTypeErrorValue,
+				fields.FieldGRPCMethod:              api_adapter_v1.Adapter_GetPage_FullMethodName,
+				fields.FieldPanicValue:              tt.wantPanicValue,
+				fields.FieldAdapterType:             "Panicking",
+				fields.FieldAdapterVersion:          "1.0.0",
+				fields.FieldRequestEntityExternalID: "users",
+			}
+
+			for key, want := range wantFields {
+				if gotLog[key] != want {
+					t.Errorf("log field %s: got: %v, want: %v", key, gotLog[key], want)
+				}
+			}
+
+			// The stack trace points to the panicking adapter.
+			if stack, _ := gotLog[fields.FieldPanicStack].(string); !strings.Contains(stack, "panickingAdapterServer") {
+				t.Errorf("log field %s doesn't contain the adapter frame: %s", fields.FieldPanicStack, stack)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptorOtherMethods(t *testing.T) {
+	core, observedLogs := observer.New(zapcore.ErrorLevel)
+	interceptor := recovery.UnaryServerInterceptor(zap.New(core))
+
+	_, err := interceptor(
+		context.Background(),
+		"request",
+		&grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"},
+		func(context.Context, any) (any, error) {
+			panic("unexpected request")
+		},
+	)
+
+	if status.Code(err) != codes.Internal {
+		t.Errorf("gotErr: %v, want code: %v", err, codes.Internal)
+	}
+
+	if observedLogs.Len() != 1 {
+		t.Errorf("expected 1 log, got %d", observedLogs.Len())
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	tests := map[string]struct {
+		handler  grpc.StreamHandler
+		wantCode codes.Code
+		wantLogs int
+	}{
+		"panic": {
+			handler: func(any, grpc.ServerStream) error {
+				panic("unexpected message")
+			},
+			wantCode: codes.Internal,
+			wantLogs: 1,
+		},
+		"error": {
+			handler: func(any, grpc.ServerStream) error {
+				return status.Error(codes.Unavailable, "unavailable")
+			},
+			wantCode: codes.Unavailable,
+		},
+		"no_error": {
+			handler: func(any, grpc.ServerStream) error {
+				return nil
+			},
+			wantCode: codes.OK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			core, observedLogs := observer.New(zapcore.ErrorLevel)
+			interceptor := recovery.StreamServerInterceptor(zap.New(core))
+
+			err := interceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/grpc.health.v1.Health/Watch"}, tt.handler)
+
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("gotErr: %v, wantCode: %v", err, tt.wantCode)
+			}
+
+			if observedLogs.Len() != tt.wantLogs {
+				t.Errorf("expected %d logs, got %d", tt.wantLogs, observedLogs.Len())
+			}
+		})
+	}
+}
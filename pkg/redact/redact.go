@@ -0,0 +1,308 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package redact transforms the values of sensitive attributes before the objects are returned by the adapters,
+// so that deployments with strict data residency rules can ingest identifiers without exporting raw PII
+// such as email addresses or national identification numbers.
+//
+// Each attribute of an entity can be hashed, masked or dropped, as configured per datasource type
+// in a JSON file read by Load.
+package redact
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// DefaultRulesKey is the key of the rules applied to the datasource types without rules of their own,
+// in the file read by Load.
+const DefaultRulesKey = "*"
+
+// visibleSuffixLength is the number of trailing characters left unmasked by MethodMask, for the values
+// longer than twice this length.
+const visibleSuffixLength = 4
+
+// Method is the transformation applied to the values of an attribute.
+type Method string
+
+const (
+	// MethodHash replaces each value with the hex encoded HMAC-SHA256 of the value. Hashed values can still
+	// be used to join objects, including across datasources, as long as the same key is used.
+	MethodHash Method = "hash"
+
+	// MethodMask replaces the characters of each value with "*", except the last 4 characters of values
+	// longer than 8 characters, or the first character and the domain of email addresses.
+	MethodMask Method = "mask"
+
+	// MethodDrop removes the attribute from the objects.
+	MethodDrop Method = "drop"
+)
+
+// EntityRules maps the external ID of each entity to the methods applied to its attributes, by attribute
+// external ID.
+type EntityRules map[string]map[string]Method
+
+// Rules holds the rules of each datasource type.
+type Rules map[string]EntityRules
+
+// For returns the rules of the datasource type.
+func (r Rules) For(datasourceType string) EntityRules {
+	if rules, ok := r[datasourceType]; ok {
+		return rules
+	}
+
+	return r[DefaultRulesKey]
+}
+
+// Redactor applies the rules to the objects returned by the adapters.
+type Redactor struct {
+	rules   Rules
+	hashKey []byte
+}
+
+// NewRedactor returns a Redactor applying the rules. The hash key is required if any attribute is hashed.
+func NewRedactor(rules Rules, hashKey []byte) (*Redactor, error) {
+	for datasourceType, entityRules := range rules {
+		for entity, attributeRules := range entityRules {
+			for attribute, method := range attributeRules {
+				switch method {
+				case MethodHash:
+					if len(hashKey) == 0 {
+						return nil, fmt.Errorf("a hash key is required to hash attribute %s of entity %s of %s",
+							attribute, entity, datasourceType)
+					}
+				case MethodMask, MethodDrop:
+				default:
+					return nil, fmt.Errorf("method %q of attribute %s of entity %s of %s is not one of %s, %s or %s",
+						method, attribute, entity, datasourceType, MethodHash, MethodMask, MethodDrop)
+				}
+			}
+		}
+	}
+
+	return &Redactor{
+		rules:   rules,
+		hashKey: hashKey,
+	}, nil
+}
+
+// Load returns a Redactor applying the rules read from the JSON file at rulesPath, hashing values with
+// the key read from the file at hashKeyPath, if any. It returns nil if rulesPath is empty.
+//
+// The file maps each datasource type (e.g. "Okta-1.0.1") to the external IDs of its entities, and each
+// entity to the methods applied to its attributes. The rules under DefaultRulesKey apply to the datasource
+// types not listed. For example:
+//
+//	{"Okta-1.0.1": {"User": {"$.profile.email": "hash", "$.profile.mobilePhone": "drop"}}}
+func Load(rulesPath, hashKeyPath string) (*Redactor, error) {
+	if rulesPath == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(rulesPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read redaction rules: %w", err)
+	}
+
+	var rules Rules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse redaction rules: %w", err)
+	}
+
+	var hashKey []byte
+
+	if hashKeyPath != "" {
+		if hashKey, err = os.ReadFile(hashKeyPath); err != nil {
+			return nil, fmt.Errorf("failed to read redaction hash key: %w", err)
+		}
+
+		hashKey = bytes.TrimSpace(hashKey)
+	}
+
+	return NewRedactor(rules, hashKey)
+}
+
+// Validate checks that the rules of the datasource type can be applied to the entity and its child entities:
+// only string attributes can be hashed or masked, and unique ID attributes can only be hashed.
+func (r *Redactor) Validate(datasourceType string, entity *framework.EntityConfig) *framework.Error {
+	attributeRules := r.rules.For(datasourceType)[entity.ExternalId]
+
+	for _, attribute := range entity.Attributes {
+		method, found := attributeRules[attribute.ExternalId]
+		if !found {
+			continue
+		}
+
+		if method != MethodDrop && attribute.Type != framework.AttributeTypeString {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s of entity %s must be a string to be redacted with method %s.",
+					attribute.ExternalId, entity.ExternalId, method,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+
+		if method != MethodHash && attribute.UniqueId {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Unique ID attribute %s of entity %s can only be redacted with method %s.",
+					attribute.ExternalId, entity.ExternalId, MethodHash,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+	}
+
+	for _, childEntity := range entity.ChildEntities {
+		if err := r.Validate(datasourceType, childEntity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Redact applies the rules of the datasource type to the objects of the entity and of its child entities,
+// in place.
+func (r *Redactor) Redact(datasourceType string, entity *framework.EntityConfig, objects []framework.Object) {
+	entityRules := r.rules.For(datasourceType)
+	if len(entityRules) == 0 {
+		return
+	}
+
+	r.redact(entityRules, entity, objects)
+}
+
+func (r *Redactor) redact(entityRules EntityRules, entity *framework.EntityConfig, objects []framework.Object) {
+	attributeRules := entityRules[entity.ExternalId]
+
+	for _, object := range objects {
+		for attribute, method := range attributeRules {
+			value, found := object[attribute]
+			if !found {
+				continue
+			}
+
+			if method == MethodDrop {
+				delete(object, attribute)
+
+				continue
+			}
+
+			object[attribute] = r.transform(method, value)
+		}
+
+		for _, childEntity := range entity.ChildEntities {
+			if childObjects, ok := object[childEntity.ExternalId].([]framework.Object); ok {
+				r.redact(entityRules, childEntity, childObjects)
+			}
+		}
+	}
+}
+
+// transform applies the method to a single value or to each value of a list. Values which are not strings
+// are left unchanged, as they are rejected by Validate.
+func (r *Redactor) transform(method Method, value any) any {
+	switch v := value.(type) {
+	case string:
+		return r.transformString(method, v)
+	case []string:
+		transformed := make([]string, len(v))
+
+		for i, s := range v {
+			transformed[i] = r.transformString(method, s)
+		}
+
+		return transformed
+	case []any:
+		transformed := make([]any, len(v))
+
+		for i, item := range v {
+			transformed[i] = r.transform(method, item)
+		}
+
+		return transformed
+	default:
+		return value
+	}
+}
+
+func (r *Redactor) transformString(method Method, value string) string {
+	switch method {
+	case MethodHash:
+		mac := hmac.New(sha256.New, r.hashKey)
+		mac.Write([]byte(value))
+
+		return hex.EncodeToString(mac.Sum(nil))
+	case MethodMask:
+		return Mask(value)
+	default:
+		return value
+	}
+}
+
+// Mask masks a value as described in MethodMask.
+func Mask(value string) string {
+	runes := []rune(value)
+
+	if at := strings.LastIndex(value, "@"); at > 0 {
+		local := []rune(value[:at])
+
+		return string(local[0]) + strings.Repeat("*", len(local)-1) + value[at:]
+	}
+
+	if len(runes) <= 2*visibleSuffixLength {
+		return strings.Repeat("*", len(runes))
+	}
+
+	return strings.Repeat("*", len(runes)-visibleSuffixLength) + string(runes[len(runes)-visibleSuffixLength:])
+}
+
+// adapter applies the redaction rules to the objects returned by the next adapter.
+type adapter[Config any] struct {
+	next           framework.Adapter[Config]
+	redactor       *Redactor
+	datasourceType string
+}
+
+// NewAdapter wraps an adapter of the datasource type to apply the redaction rules to the objects it returns.
+// The adapter is returned unchanged if the redactor is nil.
+func NewAdapter[Config any](
+	next framework.Adapter[Config],
+	redactor *Redactor,
+	datasourceType string,
+) framework.Adapter[Config] {
+	if redactor == nil {
+		return next
+	}
+
+	return &adapter[Config]{
+		next:           next,
+		redactor:       redactor,
+		datasourceType: datasourceType,
+	}
+}
+
+// GetPage implements framework.Adapter.
+func (a *adapter[Config]) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	if err := a.redactor.Validate(a.datasourceType, &request.Entity); err != nil {
+		return framework.NewGetPageResponseError(err)
+	}
+
+	response := a.next.GetPage(ctx, request)
+	if response.Success != nil {
+		a.redactor.Redact(a.datasourceType, &request.Entity, response.Success.Objects)
+	}
+
+	return response
+}
@@ -0,0 +1,287 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package redact_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/redact"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// hmac-sha256("john@example.com", "secret").
+const hashedEmail = "62f6d956c6a553410a5571d75aaf18a7ceaf78addce3d9999da2e289164e8598"
+
+func TestMask(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		want  string
+	}{
+		"email": {
+			value: "john.doe@example.com",
+			want:  "j*******@example.com",
+		},
+		"ssn": {
+			value: "123-45-6789",
+			want:  "*******6789",
+		},
+		"short": {
+			value: "Alice",
+			want:  "*****",
+		},
+		"empty": {
+			value: "",
+			want:  "",
+		},
+		"multibyte": {
+			value: "Zoë Ångström",
+			want:  "********tröm",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := redact.Mask(tt.value); got != tt.want {
+				t.Errorf("got: %q, want: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRedactor(t *testing.T) {
+	tests := map[string]struct {
+		rules   redact.Rules
+		hashKey []byte
+		wantErr bool
+	}{
+		"valid": {
+			rules: redact.Rules{
+				"Okta-1.0.1": {"User": {"email": redact.MethodHash, "ssn": redact.MethodDrop}},
+			},
+			hashKey: []byte("secret"),
+		},
+		"hash_without_key": {
+			rules: redact.Rules{
+				"Okta-1.0.1": {"User": {"email": redact.MethodHash}},
+			},
+			wantErr: true,
+		},
+		"unknown_method": {
+			rules: redact.Rules{
+				"Okta-1.0.1": {"User": {"email": "encrypt"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := redact.NewRedactor(tt.rules, tt.hashKey)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	rulesPath := filepath.Join(dir, "rules.json")
+	hashKeyPath := filepath.Join(dir, "hash-key")
+
+	if err := os.WriteFile(rulesPath, []byte(`{"*": {"User": {"email": "hash"}}}`), 0o600); err != nil {
+		t.Fatalf("failed to write rules: %v", err)
+	}
+
+	if err := os.WriteFile(hashKeyPath, []byte("secret\n"), 0o600); err != nil {
+		t.Fatalf("failed to write hash key: %v", err)
+	}
+
+	redactor, err := redact.Load("", "")
+	if err != nil || redactor != nil {
+		t.Errorf("got redactor: %v, error: %v, want no redactor without rules", redactor, err)
+	}
+
+	if _, err := redact.Load(rulesPath, ""); err == nil {
+		t.Errorf("expected error for hash rule without hash key")
+	}
+
+	redactor, err = redact.Load(rulesPath, hashKeyPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	objects := []framework.Object{{"email": "john@example.com"}}
+	redactor.Redact("Jira-1.0.0", &framework.EntityConfig{ExternalId: "User"}, objects)
+
+	// The trailing newline of the hash key file is ignored.
+	if got := objects[0]["email"]; got != hashedEmail {
+		t.Errorf("got: %v, want: %v", got, hashedEmail)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	redactor, err := redact.NewRedactor(redact.Rules{
+		"Okta-1.0.1": {
+			"User":  {"id": redact.MethodHash, "email": redact.MethodMask, "created": redact.MethodDrop},
+			"Group": {"id": redact.MethodDrop},
+			"Role":  {"priority": redact.MethodHash},
+		},
+	}, []byte("secret"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		entity  *framework.EntityConfig
+		wantErr *framework.Error
+	}{
+		"valid": {
+			entity: &framework.EntityConfig{
+				ExternalId: "User",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+					{ExternalId: "email", Type: framework.AttributeTypeString},
+					{ExternalId: "created", Type: framework.AttributeTypeDateTime},
+				},
+			},
+		},
+		"unique_id_dropped": {
+			entity: &framework.EntityConfig{
+				ExternalId: "Group",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Unique ID attribute id of entity Group can only be redacted with method hash.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"child_entity_non_string_hashed": {
+			entity: &framework.EntityConfig{
+				ExternalId: "User",
+				ChildEntities: []*framework.EntityConfig{
+					{
+						ExternalId: "Role",
+						Attributes: []*framework.AttributeConfig{
+							{ExternalId: "priority", Type: framework.AttributeTypeInt64},
+						},
+					},
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute priority of entity Role must be a string to be redacted with method hash.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := redactor.Validate("Okta-1.0.1", tt.entity)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewAdapter(t *testing.T) {
+	redactor, err := redact.NewRedactor(redact.Rules{
+		"Okta-1.0.1": {
+			"User":  {"email": redact.MethodHash, "phones": redact.MethodMask, "ssn": redact.MethodDrop},
+			"Group": {"name": redact.MethodMask},
+		},
+	}, []byte("secret"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entity := framework.EntityConfig{
+		ExternalId: "User",
+		Attributes: []*framework.AttributeConfig{
+			{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+			{ExternalId: "email", Type: framework.AttributeTypeString},
+			{ExternalId: "phones", Type: framework.AttributeTypeString, List: true},
+			{ExternalId: "ssn", Type: framework.AttributeTypeString},
+		},
+		ChildEntities: []*framework.EntityConfig{
+			{
+				ExternalId: "Group",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "name", Type: framework.AttributeTypeString},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		datasourceType string
+		objects        []framework.Object
+		wantObjects    []framework.Object
+	}{
+		"redacted": {
+			datasourceType: "Okta-1.0.1",
+			objects: []framework.Object{
+				{
+					"id":     "00u1",
+					"email":  "john@example.com",
+					"phones": []string{"+1 555 010 0123"},
+					"ssn":    "123-45-6789",
+					"Group":  []framework.Object{{"name": "Administrators"}},
+				},
+				{
+					"id": "00u2",
+				},
+			},
+			wantObjects: []framework.Object{
+				{
+					"id":     "00u1",
+					"email":  hashedEmail,
+					"phones": []string{"***********0123"},
+					"Group":  []framework.Object{{"name": "**********tors"}},
+				},
+				{
+					"id": "00u2",
+				},
+			},
+		},
+		"no_rules_for_datasource_type": {
+			datasourceType: "Jira-1.0.0",
+			objects:        []framework.Object{{"id": "00u1", "ssn": "123-45-6789"}},
+			wantObjects:    []framework.Object{{"id": "00u1", "ssn": "123-45-6789"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := &testutil.Adapter{
+				Pages: map[string]*framework.Page{"": {Objects: tt.objects}},
+			}
+
+			gotResponse := redact.NewAdapter[testutil.Config](next, redactor, tt.datasourceType).GetPage(
+				t.Context(), &framework.Request[testutil.Config]{Entity: entity},
+			)
+
+			if gotResponse.Error != nil {
+				t.Fatalf("unexpected error: %v", gotResponse.Error)
+			}
+
+			if !reflect.DeepEqual(gotResponse.Success.Objects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotResponse.Success.Objects, tt.wantObjects)
+			}
+		})
+	}
+
+	if got := redact.NewAdapter[testutil.Config](&testutil.Adapter{}, nil, "Okta-1.0.1"); !reflect.DeepEqual(got, &testutil.Adapter{}) {
+		t.Errorf("expected the adapter to be returned unchanged without a redactor")
+	}
+}
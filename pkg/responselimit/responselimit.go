@@ -0,0 +1,316 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package responselimit limits the size of the response bodies read from datasources, so that a single
+// pathological response can't exhaust the memory of the adapter process.
+//
+// The responses exceeding the maximum size fail with ErrResponseTooLarge. Adapters wrapped with NewAdapter
+// then retry the request with a smaller page size, until the responses fit or the page size can't be
+// reduced any further. The reduced page size is carried in the cursor and used for the rest of the sync, as
+// the cursors of many adapters are page numbers, which only address the next objects for a constant page size.
+package responselimit
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// ErrResponseTooLarge is returned when reading a response body exceeding the maximum size.
+var ErrResponseTooLarge = errors.New("response body exceeds the maximum size")
+
+// exceededKey is the context key of the flag set when a response body exceeds the maximum size
+// while processing a GetPage request.
+type exceededKey struct{}
+
+func markExceeded(ctx context.Context) {
+	if exceeded, ok := ctx.Value(exceededKey{}).(*atomic.Bool); ok {
+		exceeded.Store(true)
+	}
+}
+
+// Transport is an http.RoundTripper limiting the size of the response bodies.
+type Transport struct {
+	next     http.RoundTripper
+	maxBytes int64
+}
+
+// NewTransport returns a Transport sending the requests with the next RoundTripper and limiting
+// the size of the response bodies to maxBytes. http.DefaultTransport is used if next is nil.
+func NewTransport(next http.RoundTripper, maxBytes int64) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Transport{
+		next:     next,
+		maxBytes: maxBytes,
+	}
+}
+
+// RoundTrip implements http.RoundTripper. Responses announcing a larger Content-Length fail immediately,
+// other responses fail while reading their body once the maximum size is exceeded.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.ContentLength > t.maxBytes {
+		resp.Body.Close()
+		markExceeded(req.Context())
+
+		return nil, fmt.Errorf("%w of %d bytes: %d bytes", ErrResponseTooLarge, t.maxBytes, resp.ContentLength)
+	}
+
+	resp.Body = &limitedBody{
+		ReadCloser: resp.Body,
+		ctx:        req.Context(),
+		maxBytes:   t.maxBytes,
+		remaining:  t.maxBytes,
+	}
+
+	return resp, nil
+}
+
+// limitedBody is a response body failing once more than maxBytes are read, similarly to http.MaxBytesReader.
+type limitedBody struct {
+	io.ReadCloser
+
+	ctx       context.Context
+	maxBytes  int64
+	remaining int64
+	err       error
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	// Read one more byte than remaining to detect bodies exceeding the maximum size.
+	if int64(len(p))-1 > b.remaining {
+		p = p[:b.remaining+1]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) <= b.remaining {
+		b.remaining -= int64(n)
+		b.err = err
+
+		return n, err
+	}
+
+	n = int(b.remaining)
+	b.remaining = 0
+	b.err = fmt.Errorf("%w of %d bytes", ErrResponseTooLarge, b.maxBytes)
+
+	markExceeded(b.ctx)
+
+	return n, b.err
+}
+
+// WrapClient limits the size of the response bodies received by the client to maxBytes.
+// The client is returned unchanged if maxBytes is not positive.
+func WrapClient(client *http.Client, maxBytes int64) *http.Client {
+	if maxBytes <= 0 {
+		return client
+	}
+
+	client.Transport = NewTransport(client.Transport, maxBytes)
+
+	return client
+}
+
+// Limits holds the maximum size of the response bodies, in bytes, for each datasource type.
+type Limits struct {
+	// Default is the maximum size for the datasource types without a maximum size of their own.
+	// Not positive means no limit.
+	Default int64
+
+	// ByDatasourceType holds the maximum size of each datasource type.
+	ByDatasourceType map[string]int64
+}
+
+// ParseLimits parses a comma separated list of datasource types and maximum sizes in bytes, e.g.
+// "Okta-1.0.1=16777216,Workday-1.0.0=268435456", into the maximum sizes by datasource type.
+func ParseLimits(s string) (map[string]int64, error) {
+	limits := make(map[string]int64)
+
+	for entry := range strings.SplitSeq(s, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+
+		datasourceType, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("limit %s is not of the form <datasource type>=<bytes>", entry)
+		}
+
+		maxBytes, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("limit of %s is not an integer: %w", datasourceType, err)
+		}
+
+		limits[strings.TrimSpace(datasourceType)] = maxBytes
+	}
+
+	return limits, nil
+}
+
+// For returns the maximum size of the response bodies of the datasource type.
+func (l *Limits) For(datasourceType string) int64 {
+	if maxBytes, ok := l.ByDatasourceType[datasourceType]; ok {
+		return maxBytes
+	}
+
+	return l.Default
+}
+
+// CursorPrefix is the prefix of the cursors carrying a reduced page size, followed by the base64 encoded JSON
+// PageSizeCursor.
+const CursorPrefix = "pagesize:"
+
+// cursorShape is the expected JSON shape of a PageSizeCursor, used in cursor errors.
+const cursorShape = `{"cursor":<string>,"pageSize":<int64>}`
+
+// PageSizeCursor is a cursor of the wrapped adapter along with the page size it was issued for, after the page
+// size was reduced during the sync.
+type PageSizeCursor struct {
+	// Cursor is the cursor of the wrapped adapter.
+	Cursor string `json:"cursor"`
+
+	// PageSize is the reduced page size, used for the rest of the sync.
+	PageSize int64 `json:"pageSize"`
+}
+
+// MarshalCursor returns the cursor carrying the reduced page size.
+func (c *PageSizeCursor) MarshalCursor() (string, error) {
+	cursorJSON, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return CursorPrefix + base64.StdEncoding.EncodeToString(cursorJSON), nil
+}
+
+// UnmarshalCursor returns the PageSizeCursor of a cursor, or nil if the cursor doesn't carry a reduced page size.
+func UnmarshalCursor(cursor, entityExternalID string) (*PageSizeCursor, *framework.Error) {
+	encoded, found := strings.CutPrefix(cursor, CursorPrefix)
+	if !found {
+		return nil, nil
+	}
+
+	cursorJSON, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, cursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var pageSizeCursor PageSizeCursor
+
+	if err := json.Unmarshal(cursorJSON, &pageSizeCursor); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, cursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	if pageSizeCursor.PageSize <= 0 {
+		return nil, pagination.NewCursorError(entityExternalID, cursorShape, "pageSize must be positive")
+	}
+
+	return &pageSizeCursor, nil
+}
+
+// adapter retries the GetPage requests failing because of a response exceeding the maximum size
+// with a smaller page size.
+type adapter[Config any] struct {
+	next framework.Adapter[Config]
+}
+
+// NewAdapter wraps an adapter to retry the requests failing because of a response exceeding the maximum
+// size with half the page size, until the page size is 1.
+//
+// Once reduced, the page size is carried in the next cursors, so that the following pages of the sync are
+// requested with the same page size. Adapters whose cursor is a page number may then return again some
+// objects of the page preceding the reduction, which are suppressed by dedup, but never skip objects.
+func NewAdapter[Config any](next framework.Adapter[Config]) framework.Adapter[Config] {
+	return &adapter[Config]{
+		next: next,
+	}
+}
+
+// GetPage implements framework.Adapter.
+func (a *adapter[Config]) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	pageSizeCursor, cursorErr := UnmarshalCursor(request.Cursor, request.Entity.ExternalId)
+	if cursorErr != nil {
+		return framework.NewGetPageResponseError(cursorErr)
+	}
+
+	pageSize := request.PageSize
+
+	if pageSizeCursor != nil {
+		unwrapped := *request
+		unwrapped.Cursor = pageSizeCursor.Cursor
+		unwrapped.PageSize = min(request.PageSize, pageSizeCursor.PageSize)
+		request = &unwrapped
+	}
+
+	for {
+		exceeded := &atomic.Bool{}
+
+		response := a.next.GetPage(context.WithValue(ctx, exceededKey{}, exceeded), request)
+		if response.Error == nil || !exceeded.Load() {
+			return withPageSize(response, request.PageSize, pageSize)
+		}
+
+		if request.PageSize <= 1 {
+			return framework.NewGetPageResponseError(&framework.Error{
+				Message: "Datasource response exceeded the maximum response size, even with a page size of 1.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			})
+		}
+
+		reduced := *request
+		reduced.PageSize = request.PageSize / 2
+		request = &reduced
+	}
+}
+
+// withPageSize carries the reduced page size in the next cursor of a response, if the page size of the sync was
+// reduced.
+func withPageSize(response framework.Response, reducedPageSize, pageSize int64) framework.Response {
+	if response.Success == nil || response.Success.NextCursor == "" || reducedPageSize >= pageSize {
+		return response
+	}
+
+	nextCursor, err := (&PageSizeCursor{
+		Cursor:   response.Success.NextCursor,
+		PageSize: reducedPageSize,
+	}).MarshalCursor()
+	if err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: fmt.Sprintf("Failed to marshal the page size cursor: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		})
+	}
+
+	response.Success.NextCursor = nextCursor
+
+	return response
+}
@@ -0,0 +1,333 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package responselimit_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/responselimit"
+)
+
+// newTestServer returns a server responding with a body of the size requested in the "size" query
+// parameter. The Content-Length header is omitted if the "chunked" query parameter is set.
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+
+		if r.URL.Query().Has("chunked") {
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+		} else {
+			w.Header().Set("Content-Length", strconv.Itoa(size))
+		}
+
+		w.Write([]byte(strings.Repeat("a", size)))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestWrapClient(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := map[string]struct {
+		maxBytes int64
+		query    string
+		wantErr  bool
+	}{
+		"below_limit": {
+			maxBytes: 10,
+			query:    "size=5",
+		},
+		"at_limit": {
+			maxBytes: 10,
+			query:    "size=10",
+		},
+		"content_length_above_limit": {
+			maxBytes: 10,
+			query:    "size=11",
+			wantErr:  true,
+		},
+		"chunked_at_limit": {
+			maxBytes: 10,
+			query:    "size=10&chunked",
+		},
+		"chunked_above_limit": {
+			maxBytes: 10,
+			query:    "size=11&chunked",
+			wantErr:  true,
+		},
+		"no_limit": {
+			maxBytes: 0,
+			query:    "size=1000",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := responselimit.WrapClient(&http.Client{}, tt.maxBytes)
+
+			resp, err := client.Get(server.URL + "?" + tt.query)
+			if err == nil {
+				defer resp.Body.Close()
+
+				_, err = io.ReadAll(resp.Body)
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr && !errors.Is(err, responselimit.ErrResponseTooLarge) {
+				t.Errorf("got error: %v, want: %v", err, responselimit.ErrResponseTooLarge)
+			}
+		})
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	tests := map[string]struct {
+		value      string
+		wantLimits map[string]int64
+		wantErr    bool
+	}{
+		"empty": {
+			value:      "",
+			wantLimits: map[string]int64{},
+		},
+		"multiple": {
+			value:      "Okta-1.0.1=1024, Workday-1.0.0 = 2048,",
+			wantLimits: map[string]int64{"Okta-1.0.1": 1024, "Workday-1.0.0": 2048},
+		},
+		"missing_separator": {
+			value:   "Okta-1.0.1",
+			wantErr: true,
+		},
+		"invalid_size": {
+			value:   "Okta-1.0.1=1MB",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotLimits, gotErr := responselimit.ParseLimits(tt.value)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(gotLimits, tt.wantLimits) {
+				t.Errorf("gotLimits: %v, wantLimits: %v", gotLimits, tt.wantLimits)
+			}
+		})
+	}
+
+	limits := &responselimit.Limits{Default: 10, ByDatasourceType: map[string]int64{"Okta-1.0.1": 20}}
+	if limits.For("Okta-1.0.1") != 20 || limits.For("Jira-1.0.0") != 10 {
+		t.Errorf("got limits %d and %d, want 20 and 10", limits.For("Okta-1.0.1"), limits.For("Jira-1.0.0"))
+	}
+}
+
+type testConfig struct{}
+
+// testAdapter queries a server responding with 10 bytes per object requested. The next cursor is the page
+// number following the page number of the cursor.
+type testAdapter struct {
+	client    *http.Client
+	url       string
+	pageSizes []int64
+	cursors   []string
+}
+
+func (a *testAdapter) GetPage(ctx context.Context, request *framework.Request[testConfig]) framework.Response {
+	a.pageSizes = append(a.pageSizes, request.PageSize)
+	a.cursors = append(a.cursors, request.Cursor)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?size=%d", a.url, request.PageSize*10), nil)
+	if err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{Message: err.Error()})
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: fmt.Sprintf("Failed to send request: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		})
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: fmt.Sprintf("Failed to read response: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		})
+	}
+
+	objects := make([]framework.Object, len(body)/10)
+	for i := range objects {
+		objects[i] = framework.Object{"id": strconv.Itoa(i)}
+	}
+
+	page, _ := strconv.Atoi(request.Cursor)
+
+	return framework.NewGetPageResponseSuccess(&framework.Page{Objects: objects, NextCursor: strconv.Itoa(page + 1)})
+}
+
+func TestNewAdapter(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := map[string]struct {
+		maxBytes      int64
+		pageSize      int64
+		wantPageSizes []int64
+		wantObjects   int
+		wantErr       *framework.Error
+	}{
+		"within_limit": {
+			maxBytes:      1000,
+			pageSize:      100,
+			wantPageSizes: []int64{100},
+			wantObjects:   100,
+		},
+		"page_size_reduced": {
+			maxBytes:      300,
+			pageSize:      100,
+			wantPageSizes: []int64{100, 50, 25},
+			wantObjects:   25,
+		},
+		"limit_exceeded_with_page_size_of_one": {
+			maxBytes:      5,
+			pageSize:      4,
+			wantPageSizes: []int64{4, 2, 1},
+			wantErr: &framework.Error{
+				Message: "Datasource response exceeded the maximum response size, even with a page size of 1.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			inner := &testAdapter{
+				client: responselimit.WrapClient(&http.Client{}, tt.maxBytes),
+				url:    server.URL,
+			}
+
+			gotResponse := responselimit.NewAdapter[testConfig](inner).GetPage(
+				t.Context(), &framework.Request[testConfig]{PageSize: tt.pageSize},
+			)
+
+			if !reflect.DeepEqual(gotResponse.Error, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotResponse.Error, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(inner.pageSizes, tt.wantPageSizes) {
+				t.Errorf("gotPageSizes: %v, wantPageSizes: %v", inner.pageSizes, tt.wantPageSizes)
+			}
+
+			if tt.wantErr == nil && len(gotResponse.Success.Objects) != tt.wantObjects {
+				t.Errorf("got %d objects, want %d", len(gotResponse.Success.Objects), tt.wantObjects)
+			}
+		})
+	}
+}
+
+func TestNewAdapterDoesNotRetryOtherErrors(t *testing.T) {
+	inner := &testAdapter{
+		client: responselimit.WrapClient(&http.Client{}, 10),
+		url:    "http://127.0.0.1:1",
+	}
+
+	gotResponse := responselimit.NewAdapter[testConfig](inner).GetPage(
+		t.Context(), &framework.Request[testConfig]{PageSize: 100},
+	)
+
+	if gotResponse.Error == nil || len(inner.pageSizes) != 1 {
+		t.Errorf("got error: %v after %d requests, want an error after 1 request", gotResponse.Error, len(inner.pageSizes))
+	}
+}
+
+func TestNewAdapterReusesReducedPageSize(t *testing.T) {
+	server := newTestServer(t)
+
+	inner := &testAdapter{
+		client: responselimit.WrapClient(&http.Client{}, 300),
+		url:    server.URL,
+	}
+
+	adapter := responselimit.NewAdapter[testConfig](inner)
+
+	firstResponse := adapter.GetPage(t.Context(), &framework.Request[testConfig]{PageSize: 100})
+	if firstResponse.Error != nil {
+		t.Fatalf("gotErr: %v", firstResponse.Error)
+	}
+
+	gotCursor, err := responselimit.UnmarshalCursor(firstResponse.Success.NextCursor, "")
+	if err != nil {
+		t.Fatalf("gotErr: %v", err)
+	}
+
+	wantCursor := &responselimit.PageSizeCursor{Cursor: "1", PageSize: 25}
+	if !reflect.DeepEqual(gotCursor, wantCursor) {
+		t.Errorf("gotCursor: %v, wantCursor: %v", gotCursor, wantCursor)
+	}
+
+	secondResponse := adapter.GetPage(t.Context(), &framework.Request[testConfig]{
+		PageSize: 100,
+		Cursor:   firstResponse.Success.NextCursor,
+	})
+	if secondResponse.Error != nil {
+		t.Fatalf("gotErr: %v", secondResponse.Error)
+	}
+
+	if wantPageSizes := []int64{100, 50, 25, 25}; !reflect.DeepEqual(inner.pageSizes, wantPageSizes) {
+		t.Errorf("gotPageSizes: %v, wantPageSizes: %v", inner.pageSizes, wantPageSizes)
+	}
+
+	if wantCursors := []string{"", "", "", "1"}; !reflect.DeepEqual(inner.cursors, wantCursors) {
+		t.Errorf("gotCursors: %v, wantCursors: %v", inner.cursors, wantCursors)
+	}
+
+	gotCursor, err = responselimit.UnmarshalCursor(secondResponse.Success.NextCursor, "")
+	if err != nil {
+		t.Fatalf("gotErr: %v", err)
+	}
+
+	wantCursor = &responselimit.PageSizeCursor{Cursor: "2", PageSize: 25}
+	if !reflect.DeepEqual(gotCursor, wantCursor) {
+		t.Errorf("gotCursor: %v, wantCursor: %v", gotCursor, wantCursor)
+	}
+}
+
+func TestNewAdapterDoesNotWrapCursorsWithoutReduction(t *testing.T) {
+	inner := &testAdapter{
+		client: responselimit.WrapClient(&http.Client{}, 1000),
+		url:    newTestServer(t).URL,
+	}
+
+	gotResponse := responselimit.NewAdapter[testConfig](inner).GetPage(
+		t.Context(), &framework.Request[testConfig]{PageSize: 100, Cursor: "1"},
+	)
+
+	if gotResponse.Error != nil || gotResponse.Success.NextCursor != "2" {
+		t.Errorf("got response: %v, want next cursor 2", gotResponse)
+	}
+}
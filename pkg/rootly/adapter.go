@@ -12,6 +12,8 @@ import (
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -59,25 +61,59 @@ func (a *Adapter) RequestPageFromDatasource(
 		authorizationHeader = request.Auth.HTTPAuthorization
 	}
 
-	var cursor *string
-	if request.Cursor != "" {
-		cursor = &request.Cursor
-	}
-
 	apiRequest := &Request{
 		BaseURL:               baseURL,
 		HTTPAuthorization:     authorizationHeader,
 		EntityExternalID:      request.Entity.ExternalId,
-		PageSize:              request.PageSize,
-		Cursor:                cursor,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
 		Filter:                a.getFilterForEntity(request),
 		Includes:              a.getIncludesForEntity(request),
 	}
 
-	response, err := a.RootlyClient.GetPage(ctx, apiRequest)
-	if err != nil {
-		return framework.NewGetPageResponseError(err)
+	var (
+		response   *Response
+		nextCursor *string
+	)
+
+	if isIncidentMemberEntity(request.Entity.ExternalId) {
+		cursor, err := pagination.UnmarshalCursor[string](request.Cursor, request.Entity.ExternalId)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		var incidentsFilter string
+		if request.Config.Filters != nil {
+			incidentsFilter = request.Config.Filters[Incidents]
+		}
+
+		memberResponse, memberNextCursor, err := a.requestIncidentMemberPage(ctx, apiRequest, cursor, incidentsFilter)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		encodedNextCursor, err := pagination.MarshalCursor(memberNextCursor)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		if encodedNextCursor != "" {
+			nextCursor = &encodedNextCursor
+		}
+
+		response = memberResponse
+	} else {
+		if request.Cursor != "" {
+			apiRequest.Cursor = &request.Cursor
+		}
+
+		pageResponse, err := a.RootlyClient.GetPage(ctx, apiRequest)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		response = pageResponse
+		nextCursor = pageResponse.NextCursor
 	}
 
 	// Type conversion for Rootly attributes
@@ -120,6 +156,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		response.Objects[i] = obj
 	}
 
+	schemadrift.Observe(ctx, response.Objects)
+
 	// Convert JSON objects to framework objects
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
 		&request.Entity,
@@ -146,8 +184,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		Objects: parsedObjects,
 	}
 
-	if response.NextCursor != nil {
-		page.NextCursor = *response.NextCursor
+	if nextCursor != nil {
+		page.NextCursor = *nextCursor
 	}
 
 	return framework.NewGetPageResponseSuccess(page)
@@ -34,6 +34,10 @@ type Request struct {
 	// nil in the request for the first page.
 	Cursor *string
 
+	// IncidentID is the ID of the incident to list the objects of, for the incident member entities
+	// (e.g. timeline events and action items). Empty for the other entities.
+	IncidentID string
+
 	// Filter contains the optional filter to apply to the current request.
 	Filter string
 
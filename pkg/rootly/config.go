@@ -24,7 +24,8 @@ var supportedAPIVersions = map[string]struct{}{
 	"apiVersion": "v1",
 	"filters": {
 		"users": "email=rufus_raynor@hegmann.test",
-		"incidents": "status=started&severity=high"
+		"incidents": "status=started&severity=high",
+		"incident_action_items": "status=open"
 	},
 	"includes": {
 		"users": "role,email_addresses",
@@ -41,6 +42,8 @@ type Config struct {
 
 	// Filters contains a map of filters for each entity associated with this
 	// datasource. The key is the entity's external_name, and the value is the filter string.
+	// The filter of the incidents entity also selects the incidents whose timeline events
+	// and action items are synced.
 	Filters map[string]string `json:"filters,omitempty"`
 
 	// Includes contains a map of fields to include for each entity associated with this
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
 )
 
 // castToBool attempts to convert a value to a boolean.
@@ -18,7 +20,7 @@ func castToBool(val interface{}) (bool, error) {
 	case bool:
 		return v, nil
 	case string:
-		return strconv.ParseBool(v)
+		return commonutil.ParseBool(v)
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
 		// Convert non-zero numbers to true, zero to false
 		rv := reflect.ValueOf(v)
@@ -69,7 +71,7 @@ func castToFloat64(val interface{}) (float64, error) {
 	case uint64:
 		return float64(v), nil
 	case string:
-		return strconv.ParseFloat(v, 64)
+		return commonutil.ParseFloat(v)
 	case bool:
 		if v {
 			return 1, nil
@@ -475,6 +475,26 @@ func TestDatasourceConstructEndpoint(t *testing.T) {
 			},
 			expectedURL: "https://api.rootly.com/v1/teams?page%5Bnumber%5D=1",
 		},
+		"incident_events_request": {
+			request: &rootly_adapter.Request{
+				BaseURL:          "https://api.rootly.com/v1",
+				EntityExternalID: "incident_events",
+				IncidentID:       "incident-1",
+				PageSize:         20,
+				Cursor:           strPtr("2"),
+			},
+			expectedURL: "https://api.rootly.com/v1/incidents/incident-1/events?page%5Bnumber%5D=2&page%5Bsize%5D=20",
+		},
+		"incident_action_items_request": {
+			request: &rootly_adapter.Request{
+				BaseURL:          "https://api.rootly.com/v1",
+				EntityExternalID: "incident_action_items",
+				IncidentID:       "incident-1",
+				PageSize:         20,
+				Filter:           "status=open",
+			},
+			expectedURL: "https://api.rootly.com/v1/incidents/incident-1/action_items?filter%5Bstatus%5D=open&page%5Bnumber%5D=1&page%5Bsize%5D=20",
+		},
 	}
 
 	for name, tt := range tests {
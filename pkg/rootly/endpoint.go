@@ -12,6 +12,13 @@ import (
 func ConstructEndpoint(request *Request) string {
 	endpoint := fmt.Sprintf("%s/%s", request.BaseURL, request.EntityExternalID)
 
+	if request.IncidentID != "" {
+		endpoint = fmt.Sprintf(
+			"%s/%s/%s/%s",
+			request.BaseURL, Incidents, url.PathEscape(request.IncidentID), incidentMemberEndpoints[request.EntityExternalID],
+		)
+	}
+
 	params := url.Values{}
 
 	// Add page size
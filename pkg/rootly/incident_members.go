@@ -0,0 +1,145 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package rootly
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+const (
+	// Incidents is the external ID of the incidents entity, the collection of the incident member entities.
+	Incidents = "incidents"
+
+	// TimelineEvent is the external ID of the incident timeline events entity.
+	TimelineEvent = "incident_events"
+
+	// ActionItem is the external ID of the incident action items (post-incident follow-ups) entity.
+	ActionItem = "incident_action_items"
+
+	// incidentIDAttribute is the attribute added to each incident member object, holding the ID of its incident.
+	incidentIDAttribute = "incident_id"
+)
+
+// incidentMemberEndpoints maps the external IDs of the incident member entities to the path of their
+// endpoint under an incident, e.g. /v1/incidents/{incident_id}/events. The members of each incident are
+// listed one incident at a time.
+var incidentMemberEndpoints = map[string]string{
+	TimelineEvent: "events",
+	ActionItem:    "action_items",
+}
+
+// isIncidentMemberEntity returns whether the entity is listed under each incident.
+func isIncidentMemberEntity(entityExternalID string) bool {
+	_, found := incidentMemberEndpoints[entityExternalID]
+
+	return found
+}
+
+// validateIncidentMemberCursor validates that the cursor of an incident member entity is a composite cursor
+// with valid page numbers.
+func validateIncidentMemberCursor(cursor string, entityExternalID string) *framework.Error {
+	compositeCursor, err := pagination.UnmarshalCursor[string](cursor, entityExternalID)
+	if err != nil || compositeCursor == nil {
+		return err
+	}
+
+	if err := validatePageNumber(compositeCursor.Cursor, "cursor", entityExternalID); err != nil {
+		return err
+	}
+
+	return validatePageNumber(compositeCursor.CollectionCursor, "collectionCursor", entityExternalID)
+}
+
+// validatePageNumber validates that the page number of a composite cursor field, if set, is an integer >= 1.
+func validatePageNumber(pageNumber *string, field string, entityExternalID string) *framework.Error {
+	if pageNumber == nil {
+		return nil
+	}
+
+	if number, err := strconv.Atoi(*pageNumber); err != nil || number < 1 {
+		return pagination.NewCursorError(
+			entityExternalID,
+			pagination.CompositeCursorShape[string](true),
+			fmt.Sprintf("%s (page[number]) must be an integer >= 1, got '%s'", field, *pageNumber),
+		)
+	}
+
+	return nil
+}
+
+// requestIncidentMemberPage requests a page of objects of an incident member entity. If the cursor doesn't
+// identify an incident, the next incident is requested first from the incidents endpoint, using the filter
+// of the incidents entity. The ID of the incident is added to each object of the page.
+func (a *Adapter) requestIncidentMemberPage(
+	ctx context.Context, apiRequest *Request, cursor *pagination.CompositeCursor[string], incidentsFilter string,
+) (*Response, *pagination.CompositeCursor[string], *framework.Error) {
+	if cursor == nil {
+		cursor = &pagination.CompositeCursor[string]{}
+	}
+
+	if cursor.CollectionID == nil {
+		incidentsResponse, err := a.RootlyClient.GetPage(ctx, &Request{
+			BaseURL:               apiRequest.BaseURL,
+			HTTPAuthorization:     apiRequest.HTTPAuthorization,
+			EntityExternalID:      Incidents,
+			PageSize:              1,
+			Cursor:                cursor.CollectionCursor,
+			Filter:                incidentsFilter,
+			RequestTimeoutSeconds: apiRequest.RequestTimeoutSeconds,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// No incidents are left, so the sync is complete.
+		if len(incidentsResponse.Objects) == 0 {
+			return &Response{Objects: []map[string]any{}}, nil, nil
+		}
+
+		incidentID, ok := incidentsResponse.Objects[0][uniqueIDAttribute].(string)
+		if !ok {
+			return nil, nil, &framework.Error{
+				Message: fmt.Sprintf("Failed to parse the %s attribute of the incident.", uniqueIDAttribute),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		cursor.CollectionID = &incidentID
+		cursor.CollectionCursor = incidentsResponse.NextCursor
+	}
+
+	apiRequest.IncidentID = *cursor.CollectionID
+	apiRequest.Cursor = cursor.Cursor
+
+	response, err := a.RootlyClient.GetPage(ctx, apiRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, obj := range response.Objects {
+		obj[incidentIDAttribute] = *cursor.CollectionID
+	}
+
+	var nextCursor *pagination.CompositeCursor[string]
+
+	switch {
+	case response.NextCursor != nil:
+		nextCursor = &pagination.CompositeCursor[string]{
+			Cursor:           response.NextCursor,
+			CollectionID:     cursor.CollectionID,
+			CollectionCursor: cursor.CollectionCursor,
+		}
+	case cursor.CollectionCursor != nil:
+		nextCursor = &pagination.CompositeCursor[string]{
+			CollectionCursor: cursor.CollectionCursor,
+		}
+	}
+
+	return response, nextCursor, nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package rootly_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	rootly_adapter "github.com/sgnl-ai/adapters/pkg/rootly"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// incidentMemberClient returns the incidents page for the incidents requests and the members page for the
+// other requests, and records the requests.
+type incidentMemberClient struct {
+	incidents   *rootly_adapter.Response
+	members     *rootly_adapter.Response
+	gotRequests []rootly_adapter.Request
+}
+
+func (c *incidentMemberClient) GetPage(_ context.Context, request *rootly_adapter.Request) (*rootly_adapter.Response, *framework.Error) {
+	c.gotRequests = append(c.gotRequests, *request)
+
+	if request.EntityExternalID == rootly_adapter.Incidents {
+		return c.incidents, nil
+	}
+
+	return c.members, nil
+}
+
+func TestAdapterGetIncidentMemberPage(t *testing.T) {
+	incidentsRequest := rootly_adapter.Request{
+		BaseURL:               "https://api.rootly.com/v1",
+		HTTPAuthorization:     "Bearer testtoken",
+		EntityExternalID:      "incidents",
+		PageSize:              1,
+		Filter:                "status=resolved",
+		RequestTimeoutSeconds: 10,
+	}
+
+	eventsRequest := rootly_adapter.Request{
+		BaseURL:               "https://api.rootly.com/v1",
+		HTTPAuthorization:     "Bearer testtoken",
+		EntityExternalID:      "incident_events",
+		PageSize:              2,
+		RequestTimeoutSeconds: 10,
+	}
+
+	withCursors := func(request rootly_adapter.Request, cursor *string, incidentID string) rootly_adapter.Request {
+		request.Cursor = cursor
+		request.IncidentID = incidentID
+
+		return request
+	}
+
+	events := []map[string]any{
+		{"id": "event-1", "type": "incident_events", "attributes": map[string]any{"event": "Incident started"}},
+	}
+
+	tests := map[string]struct {
+		cursor         *pagination.CompositeCursor[string]
+		incidents      *rootly_adapter.Response
+		members        *rootly_adapter.Response
+		wantRequests   []rootly_adapter.Request
+		wantObjects    []framework.Object
+		wantNextCursor *pagination.CompositeCursor[string]
+	}{
+		"first_page": {
+			incidents: &rootly_adapter.Response{
+				Objects:    []map[string]any{{"id": "incident-1"}},
+				NextCursor: testutil.GenPtr("2"),
+			},
+			members: &rootly_adapter.Response{
+				Objects:    events,
+				NextCursor: testutil.GenPtr("2"),
+			},
+			wantRequests: []rootly_adapter.Request{
+				incidentsRequest,
+				withCursors(eventsRequest, nil, "incident-1"),
+			},
+			wantObjects: []framework.Object{
+				{"id": "event-1", "$.attributes.event": "Incident started", "incident_id": "incident-1"},
+			},
+			wantNextCursor: &pagination.CompositeCursor[string]{
+				Cursor:           testutil.GenPtr("2"),
+				CollectionID:     testutil.GenPtr("incident-1"),
+				CollectionCursor: testutil.GenPtr("2"),
+			},
+		},
+		"last_page_of_incident": {
+			cursor: &pagination.CompositeCursor[string]{
+				Cursor:           testutil.GenPtr("2"),
+				CollectionID:     testutil.GenPtr("incident-1"),
+				CollectionCursor: testutil.GenPtr("2"),
+			},
+			members: &rootly_adapter.Response{
+				Objects: events,
+			},
+			wantRequests: []rootly_adapter.Request{
+				withCursors(eventsRequest, testutil.GenPtr("2"), "incident-1"),
+			},
+			wantObjects: []framework.Object{
+				{"id": "event-1", "$.attributes.event": "Incident started", "incident_id": "incident-1"},
+			},
+			wantNextCursor: &pagination.CompositeCursor[string]{
+				CollectionCursor: testutil.GenPtr("2"),
+			},
+		},
+		"last_page_of_last_incident": {
+			cursor: &pagination.CompositeCursor[string]{
+				CollectionCursor: testutil.GenPtr("2"),
+			},
+			incidents: &rootly_adapter.Response{
+				Objects: []map[string]any{{"id": "incident-2"}},
+			},
+			members: &rootly_adapter.Response{
+				Objects: []map[string]any{},
+			},
+			wantRequests: []rootly_adapter.Request{
+				withCursors(incidentsRequest, testutil.GenPtr("2"), ""),
+				withCursors(eventsRequest, nil, "incident-2"),
+			},
+		},
+		"no_incidents": {
+			incidents: &rootly_adapter.Response{
+				Objects: []map[string]any{},
+			},
+			wantRequests: []rootly_adapter.Request{
+				incidentsRequest,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &incidentMemberClient{incidents: tt.incidents, members: tt.members}
+			adapter := rootly_adapter.NewAdapter(client)
+
+			cursor, err := pagination.MarshalCursor(tt.cursor)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response := adapter.GetPage(context.Background(), &framework.Request[rootly_adapter.Config]{
+				Address: "api.rootly.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Config: &rootly_adapter.Config{
+					APIVersion: "v1",
+					Filters: map[string]string{
+						"incidents": "status=resolved",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "incident_events",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.attributes.event",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "incident_id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+				Cursor:   cursor,
+			})
+
+			if response.Error != nil {
+				t.Fatalf("unexpected error: %v", response.Error)
+			}
+
+			if !reflect.DeepEqual(client.gotRequests, tt.wantRequests) {
+				t.Errorf("gotRequests: %+v, wantRequests: %+v", client.gotRequests, tt.wantRequests)
+			}
+
+			if !reflect.DeepEqual(response.Success.Objects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", response.Success.Objects, tt.wantObjects)
+			}
+
+			wantNextCursor, err := pagination.MarshalCursor(tt.wantNextCursor)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if response.Success.NextCursor != wantNextCursor {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", response.Success.NextCursor, wantNextCursor)
+			}
+		})
+	}
+}
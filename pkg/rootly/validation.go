@@ -81,8 +81,13 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
-	// Validate that the cursor (page[number]) is a valid integer >= 1, if provided
-	if request.Cursor != "" {
+	// Validate that the cursor of the incident member entities is a valid composite cursor, and that the
+	// cursor (page[number]) of the other entities is a valid integer >= 1, if provided
+	if isIncidentMemberEntity(request.Entity.ExternalId) {
+		if err := validateIncidentMemberCursor(request.Cursor, request.Entity.ExternalId); err != nil {
+			return err
+		}
+	} else if request.Cursor != "" {
 		pageNum, err := strconv.Atoi(request.Cursor)
 		if err != nil || pageNum < 1 {
 			return &framework.Error{
@@ -295,6 +295,35 @@ func TestValidateGetPageRequest(t *testing.T) {
 			wantErrCode: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			wantErrMsg:  "Provided page size (0) does not fall within the allowed range (1-1000).",
 		},
+		"invalid_action_items_cursor_page_number": {
+			ctx: context.Background(),
+			request: &framework.Request[rootly_adapter.Config]{
+				Address: "https://api.rootly.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Config: &rootly_adapter.Config{
+					APIVersion: "v1",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "incident_action_items",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							List:       false,
+						},
+					},
+				},
+				PageSize: 100,
+				// {"cursor":"0","collectionId":"incident-1"}
+				Cursor: "eyJjdXJzb3IiOiIwIiwiY29sbGVjdGlvbklkIjoiaW5jaWRlbnQtMSJ9",
+			},
+			wantErrCode: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			wantErrMsg: "Invalid cursor for entity incident_action_items: cursor (page[number]) must be an integer >= 1, got '0'. " +
+				`Expected cursor shape: {"cursor":<string>,"collectionId":<string>,"collectionCursor":<string>}. ` +
+				"Restart the sync for this entity to discard the invalid cursor.",
+		},
 	}
 
 	for name, tt := range tests {
@@ -5,6 +5,7 @@ package salesforce
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
@@ -12,6 +13,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/commonutil"
 	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -66,7 +68,7 @@ func (a *Adapter) RequestPageFromDatasource(
 	salesforceReq := &Request{
 		BaseURL:               request.Address,
 		Token:                 request.Auth.HTTPAuthorization,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		APIVersion:            request.Config.APIVersion,
 		Attributes:            queryAttributes,
@@ -81,10 +83,60 @@ func (a *Adapter) RequestPageFromDatasource(
 
 	salesforceReq.Cursor = nil
 
-	if request.Cursor != "" {
+	if watermarkField, found := EventEntities[request.Entity.ExternalId]; found {
+		salesforceReq.WatermarkField = watermarkField
+
+		switch {
+		case request.Cursor != "":
+			watermark, err := ParseWatermarkCursor(request.Cursor, request.Entity.ExternalId)
+			if err != nil {
+				return framework.NewGetPageResponseError(err)
+			}
+
+			salesforceReq.Watermark = watermark
+		case commonConfig.ChangedSince() != nil:
+			// The first page of an incremental sync starts at the records created since the last sync.
+			salesforceReq.Watermark = &Watermark{Time: *commonConfig.ChangedSince()}
+		}
+	} else if request.Cursor != "" {
 		salesforceReq.Cursor = &request.Cursor
 	}
 
+	var objectFields []Field
+
+	// The object is described at the start of a sync to validate the attributes, and its cached fields are then
+	// used to coerce the picklist and formula values of each page.
+	if request.Config.DescribeObjects {
+		isFirstPage := request.Cursor == ""
+
+		describe, err := a.SalesforceClient.Describe(ctx, salesforceReq, isFirstPage)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+
+		if describe.StatusCode == http.StatusNotFound {
+			return framework.NewGetPageResponseError(&framework.Error{
+				Message: fmt.Sprintf(
+					"Salesforce object %s does not exist or is not accessible with the provided token.",
+					request.Entity.ExternalId,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			})
+		}
+
+		if adapterErr := web.HTTPError(describe.StatusCode, describe.RetryAfterHeader); adapterErr != nil {
+			return framework.NewGetPageResponseError(adapterErr)
+		}
+
+		if isFirstPage {
+			if validationErr := ValidateAttributes(&request.Entity, describe.Fields); validationErr != nil {
+				return framework.NewGetPageResponseError(validationErr)
+			}
+		}
+
+		objectFields = describe.Fields
+	}
+
 	resp, err := a.SalesforceClient.GetPage(ctx, salesforceReq)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
@@ -110,6 +162,14 @@ func (a *Adapter) RequestPageFromDatasource(
 		)
 	}
 
+	if objectFields != nil {
+		if coerceErr := CoerceFields(objectsToConvert, request.Entity.Attributes, objectFields); coerceErr != nil {
+			return framework.NewGetPageResponseError(coerceErr)
+		}
+	}
+
+	schemadrift.Observe(ctx, objectsToConvert)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
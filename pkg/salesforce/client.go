@@ -11,6 +11,10 @@ import (
 // Client is a client that allows querying the Salesforce datasource which contains JSON objects.
 type Client interface {
 	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+
+	// Describe returns the fields of the object of the request. The fields are cached across pages, unless
+	// refresh is set.
+	Describe(ctx context.Context, request *Request, refresh bool) (*DescribeResponse, *framework.Error)
 }
 
 // Request is a request to Salesforce.
@@ -37,6 +41,14 @@ type Request struct {
 	// Filter contains the optional filter to apply to the current request.
 	Filter *string
 
+	// WatermarkField is the field containing the creation time of the records of an event entity,
+	// see EventEntities. Empty for other entities.
+	WatermarkField string
+
+	// Watermark is the position of the last record returned for an event entity. The page starts after it.
+	// nil in the request for the first page of a full sync, or for other entities.
+	Watermark *Watermark
+
 	// Attributes contains the list of attributes to request along with the current request.
 	Attributes []*framework.AttributeConfig
 
@@ -29,8 +29,12 @@ var supportedAPIVersions = map[string]struct{}{
     "apiVersion": "58.0",
     "filters": {
         "User": "isActive=true",
-        "Case": "isClosed=false"
-    }
+        "Case": "isClosed=false",
+        "LoginHistory": "Status='Success'"
+    },
+    "syncMode": "INCREMENTAL",
+    "incrementalSyncSince": "2026-01-01T00:00:00Z",
+    "describeObjects": true
 }
 */
 type Config struct {
@@ -43,6 +47,13 @@ type Config struct {
 	// Filters contains a map of filters for each entity associated with this
 	// datasource. The key is the entity's external_name, and the value is the filter string.
 	Filters map[string]string `json:"filters,omitempty"`
+
+	// DescribeObjects enables describing the object of each entity at the start of a sync, to validate that the
+	// attributes are fields of the object with matching types, and to convert the values of the picklist and
+	// formula fields into the types of their attributes, e.g. a picklist of numbers into an Int64 attribute.
+	// Without it, a misspelled attribute fails the query, and the picklist and formula values are synced as
+	// returned by Salesforce.
+	DescribeObjects bool `json:"describeObjects,omitempty"`
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
@@ -57,6 +68,6 @@ func (c *Config) Validate(_ context.Context) error {
 			return fmt.Errorf("apiVersion is not supported: %v", c.APIVersion)
 		}
 
-		return nil
+		return c.CommonConfig.ValidateSyncMode()
 	}
 }
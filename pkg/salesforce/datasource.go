@@ -21,6 +21,9 @@ import (
 // Datasource directly implements a Client interface to allow querying an external datasource.
 type Datasource struct {
 	Client *http.Client
+
+	// describes caches the fields of the described objects across pages.
+	describes describeCache
 }
 
 type DatasourceResponse struct {
@@ -77,6 +80,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -115,6 +119,24 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	response.Objects = objects
 	response.NextCursor = nextCursor
 
+	// Event entities are paged by a watermark instead of the query locator. A page with fewer records than
+	// the limit of the query is the last page, unless the query API split it into smaller batches.
+	if request.WatermarkField != "" {
+		response.NextCursor = nil
+
+		hasMoreRecords := nextCursor != nil && *nextCursor != ""
+
+		if len(objects) > 0 && (int64(len(objects)) >= request.PageSize || hasMoreRecords) {
+			watermark, watermarkErr := nextWatermark(request.WatermarkField, objects)
+			if watermarkErr != nil {
+				return nil, watermarkErr
+			}
+
+			watermarkCursor := MarshalWatermarkCursor(watermark)
+			response.NextCursor = &watermarkCursor
+		}
+	}
+
 	logger.Info("Datasource request completed successfully",
 		fields.ResponseStatusCode(response.StatusCode),
 		fields.ResponseObjectCount(len(response.Objects)),
@@ -127,7 +149,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 func ParseResponse(body []byte) (objects []map[string]any, nextCursor *string, err *framework.Error) {
 	var data *DatasourceResponse
 
-	if unmarshalErr := json.Unmarshal(body, &data); unmarshalErr != nil {
+	if unmarshalErr := json.Unmarshal(body, &data); unmarshalErr != nil || data == nil {
 		return nil, nil, &framework.Error{
 			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", unmarshalErr),
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
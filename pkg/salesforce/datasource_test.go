@@ -333,6 +333,15 @@ func TestParseResponse(t *testing.T) {
 				Code:    adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
 			}),
 		},
+		"null_response": {
+			body:             []byte(`null`),
+			entityExternalID: "User",
+			wantNextCursor:   nil,
+			wantErr: testutil.GenPtr(framework.Error{
+				Message: "Failed to unmarshal the datasource response: <nil>.",
+				Code:    adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}),
+		},
 		"invalid_objects": {
 			body:             []byte(`{"records": ["500Hu000020yLuHIAU", "500Hu000020yLuMIAU"], "done": true}`),
 			entityExternalID: "User",
@@ -532,3 +541,109 @@ func TestGetPage(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEventPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/services/data/v58.0/query?q=SELECT+Id,LoginTime+FROM+LoginHistory+ORDER+BY+LoginTime+ASC,Id+ASC+LIMIT+2":
+			w.Write([]byte(`{
+				"totalSize": 2,
+				"done": true,
+				"records": [
+					{"Id": "0Ya1", "LoginTime": "2026-01-01T09:00:00.000+0000"},
+					{"Id": "0Ya2", "LoginTime": "2026-01-01T10:00:00.000+0000"}
+				]
+			}`))
+		case "/services/data/v58.0/query?q=SELECT+Id,LoginTime+FROM+LoginHistory+WHERE+%28LoginTime+%3E+2026-01-01T10%3A00%3A00Z+OR+%28LoginTime+%3D+2026-01-01T10%3A00%3A00Z+AND+Id+%3E+%270Ya2%27%29%29+ORDER+BY+LoginTime+ASC,Id+ASC+LIMIT+2":
+			w.Write([]byte(`{
+				"totalSize": 1,
+				"done": true,
+				"records": [
+					{"Id": "0Ya3", "LoginTime": "2026-01-01T10:00:00.000+0000"}
+				]
+			}`))
+		case "/services/data/v58.0/query?q=SELECT+Id,LoginTime+FROM+LoginHistory+WHERE+LoginTime+%3E%3D+2026-02-01T00%3A00%3A00Z+ORDER+BY+LoginTime+ASC,Id+ASC+LIMIT+2":
+			w.Write([]byte(`{
+				"totalSize": 2,
+				"done": true,
+				"records": [
+					{"Id": "0Ya4", "LoginTime": "2026-02-01T00:00:00.000+0000"},
+					{"Id": "0Ya5", "LoginTime": "invalid"}
+				]
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	salesforceClient := salesforce.NewClient(&http.Client{Timeout: 5 * time.Second})
+
+	newRequest := func(watermark *salesforce.Watermark) *salesforce.Request {
+		return &salesforce.Request{
+			BaseURL:               server.URL,
+			RequestTimeoutSeconds: 5,
+			Token:                 "Bearer testtoken",
+			EntityExternalID:      salesforce.LoginHistory,
+			PageSize:              2,
+			APIVersion:            "58.0",
+			Attributes: []*framework.AttributeConfig{
+				{
+					ExternalId: "Id",
+					Type:       framework.AttributeTypeString,
+				},
+			},
+			WatermarkField: "LoginTime",
+			Watermark:      watermark,
+		}
+	}
+
+	tests := map[string]struct {
+		request *salesforce.Request
+		wantRes *salesforce.Response
+		wantErr *framework.Error
+	}{
+		"first_page": {
+			request: newRequest(nil),
+			wantRes: &salesforce.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"Id": "0Ya1", "LoginTime": "2026-01-01T09:00:00.000+0000"},
+					{"Id": "0Ya2", "LoginTime": "2026-01-01T10:00:00.000+0000"},
+				},
+				// {"time":"2026-01-01T10:00:00Z","id":"0Ya2"}
+				NextCursor: testutil.GenPtr("eyJ0aW1lIjoiMjAyNi0wMS0wMVQxMDowMDowMFoiLCJpZCI6IjBZYTIifQ=="),
+			},
+		},
+		"last_page": {
+			request: newRequest(&salesforce.Watermark{Time: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), ID: "0Ya2"}),
+			wantRes: &salesforce.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"Id": "0Ya3", "LoginTime": "2026-01-01T10:00:00.000+0000"},
+				},
+			},
+		},
+		"invalid_watermark_field": {
+			request: newRequest(&salesforce.Watermark{Time: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)}),
+			wantErr: &framework.Error{
+				Message: `Failed to parse the LoginTime field of the last record as a date-time: "invalid".`,
+				Code:    adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := salesforceClient.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotRes, tt.wantRes) {
+				t.Errorf("gotRes: %v, wantRes: %v", gotRes, tt.wantRes)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
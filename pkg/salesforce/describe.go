@@ -0,0 +1,480 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/commonutil"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+)
+
+// describeCacheTTL is how long the describe results are cached by the Datasource, to coerce the values of the
+// pages following the first page of a sync without describing the object for each page.
+const describeCacheTTL = 15 * time.Minute
+
+// attributeTypeNames are the names of the SGNL attribute types, used in the validation errors.
+var attributeTypeNames = map[framework.AttributeType]string{
+	framework.AttributeTypeBool:     "Bool",
+	framework.AttributeTypeDateTime: "DateTime",
+	framework.AttributeTypeDouble:   "Double",
+	framework.AttributeTypeDuration: "Duration",
+	framework.AttributeTypeInt64:    "Int64",
+	framework.AttributeTypeString:   "String",
+}
+
+// fieldAttributeTypes maps the types of the Salesforce fields to the types of the SGNL attributes they can be
+// synced as. The fields of other types, e.g. base64 or anyType, aren't type checked.
+// https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/field_types.htm
+var fieldAttributeTypes = map[string][]framework.AttributeType{
+	"boolean":         {framework.AttributeTypeBool},
+	"int":             {framework.AttributeTypeInt64, framework.AttributeTypeDouble},
+	"long":            {framework.AttributeTypeInt64, framework.AttributeTypeDouble},
+	"double":          {framework.AttributeTypeDouble},
+	"currency":        {framework.AttributeTypeDouble},
+	"percent":         {framework.AttributeTypeDouble},
+	"date":            {framework.AttributeTypeDateTime, framework.AttributeTypeString},
+	"datetime":        {framework.AttributeTypeDateTime, framework.AttributeTypeString},
+	"time":            {framework.AttributeTypeString},
+	"id":              {framework.AttributeTypeString},
+	"reference":       {framework.AttributeTypeString},
+	"string":          {framework.AttributeTypeString},
+	"textarea":        {framework.AttributeTypeString},
+	"email":           {framework.AttributeTypeString},
+	"phone":           {framework.AttributeTypeString},
+	"url":             {framework.AttributeTypeString},
+	"combobox":        {framework.AttributeTypeString},
+	"encryptedstring": {framework.AttributeTypeString},
+	"multipicklist":   {framework.AttributeTypeString},
+}
+
+// compoundFieldTypes are the types of the compound fields, whose components are selected with a JSONPath,
+// e.g. $.BillingAddress.city.
+var compoundFieldTypes = map[string]struct{}{
+	"address":  {},
+	"location": {},
+}
+
+// Field is a field of a Salesforce object, as returned by the describe endpoint, a subset of the Field type of the
+// DescribeSObjectResult.
+type Field struct {
+	// Name is the API name of the field, e.g. "Status" or "Region__c".
+	Name string `json:"name"`
+
+	// Type is the type of the field, e.g. "string", "picklist" or "double".
+	Type string `json:"type"`
+
+	// Calculated is whether the field is a formula field. The type of a formula field is the type of the
+	// values returned by the formula.
+	Calculated bool `json:"calculated"`
+
+	// RelationshipName is the name of the relationship of a reference field, e.g. "Account" for AccountId.
+	RelationshipName string `json:"relationshipName"`
+
+	// Scale is the number of digits after the decimal point of a double field.
+	Scale int `json:"scale"`
+}
+
+// isCoerced returns whether the values of the field are coerced into the type of the attribute syncing them,
+// rather than type checked. The picklist values are strings, and the type of the formula fields may change
+// with their formula, so they can be synced as any type their values can be converted to.
+func (f *Field) isCoerced() bool {
+	return f.Type == "picklist" || f.Calculated
+}
+
+// DescribeResponse is a response of the describe endpoint returned by the datasource.
+type DescribeResponse struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Fields are the fields of the object visible to the user of the token.
+	Fields []Field
+}
+
+// describeCacheKey identifies the describe results of an object. The token is part of the key, as the fields
+// visible to the users depend on their field-level security.
+type describeCacheKey struct {
+	baseURL    string
+	apiVersion string
+	object     string
+	token      string
+}
+
+type cachedDescribe struct {
+	fields    []Field
+	expiresAt time.Time
+}
+
+// describeCache caches the fields of the objects across pages.
+type describeCache struct {
+	mu      sync.Mutex
+	objects map[describeCacheKey]cachedDescribe
+}
+
+func (c *describeCache) get(key describeCacheKey) ([]Field, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, found := c.objects[key]
+	if !found || !time.Now().Before(cached.expiresAt) {
+		return nil, false
+	}
+
+	return cached.fields, true
+}
+
+func (c *describeCache) set(key describeCacheKey, fields []Field) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.objects == nil {
+		c.objects = make(map[describeCacheKey]cachedDescribe)
+	}
+
+	c.objects[key] = cachedDescribe{
+		fields:    fields,
+		expiresAt: time.Now().Add(describeCacheTTL),
+	}
+}
+
+// Describe returns the fields of the object of the request, requested with the describe endpoint, e.g.
+// /services/data/v58.0/sobjects/Case/describe. The fields are cached for describeCacheTTL, unless refresh is
+// set, e.g. at the start of a sync.
+func (d *Datasource) Describe(
+	ctx context.Context, request *Request, refresh bool,
+) (*DescribeResponse, *framework.Error) {
+	key := describeCacheKey{
+		baseURL:    request.BaseURL,
+		apiVersion: request.APIVersion,
+		object:     request.EntityExternalID,
+		token:      request.Token,
+	}
+
+	if !refresh {
+		if cachedFields, found := d.describes.get(key); found {
+			return &DescribeResponse{
+				StatusCode: http.StatusOK,
+				Fields:     cachedFields,
+			}, nil
+		}
+	}
+
+	logger := zaplogger.FromContext(ctx).With(fields.RequestEntityExternalID(request.EntityExternalID))
+
+	var describe struct {
+		Fields []Field `json:"fields"`
+	}
+
+	httpResponse, err := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL: request.BaseURL + "/services/data/v" + url.PathEscape(request.APIVersion) + "/sobjects/" +
+			url.PathEscape(request.EntityExternalID) + "/describe",
+		Header: http.Header{
+			"Authorization": {request.Token},
+		},
+		DatasourceName:        "Salesforce",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Salesforce")
+		if readErr != nil {
+			return readErr
+		}
+
+		return httpds.UnmarshalJSON(bodyBytes, &describe)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DescribeResponse{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+		Fields:           describe.Fields,
+	}
+
+	if response.StatusCode == http.StatusOK {
+		d.describes.set(key, describe.Fields)
+	}
+
+	return response, nil
+}
+
+// ValidateAttributes validates that the attributes and the child entities of the entity are fields of the
+// described object, with the exact case of their API name, and that the types of the attributes match the types
+// of the fields. The relationship fields, e.g. $.Account.Name, are only validated up to the relationship name,
+// as the fields of the related object aren't described.
+func ValidateAttributes(entity *framework.EntityConfig, objectFields []Field) *framework.Error {
+	fieldsByName := make(map[string]*Field, len(objectFields))
+	relationships := make(map[string]*Field)
+
+	for i := range objectFields {
+		field := &objectFields[i]
+
+		fieldsByName[strings.ToLower(field.Name)] = field
+
+		if field.RelationshipName != "" {
+			relationships[strings.ToLower(field.RelationshipName)] = field
+		}
+	}
+
+	for _, attribute := range entity.Attributes {
+		if err := validateAttribute(entity.ExternalId, attribute, fieldsByName, relationships); err != nil {
+			return err
+		}
+	}
+
+	for _, childEntity := range entity.ChildEntities {
+		field, err := findField(entity.ExternalId, childEntity.ExternalId, childEntity.ExternalId, fieldsByName)
+		if err != nil {
+			return err
+		}
+
+		if field.Type != "multipicklist" {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Child entity %s is not a multi-select picklist field of Salesforce object %s: its type is %s.",
+					childEntity.ExternalId, entity.ExternalId, field.Type,
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAttribute validates an attribute against the fields and the relationships of the object.
+func validateAttribute(
+	object string, attribute *framework.AttributeConfig, fieldsByName, relationships map[string]*Field,
+) *framework.Error {
+	name := extractFieldName(attribute.ExternalId)
+
+	// [Relationships and compound fields] Only the first segment of the path is a field of the object,
+	// e.g. Account for $.Account.Name, or BillingAddress for $.BillingAddress.city.
+	if first, _, isPath := strings.Cut(name, "."); isPath {
+		if field, found := fieldsByName[strings.ToLower(first)]; found && field.Name == first {
+			if _, isCompound := compoundFieldTypes[field.Type]; isCompound {
+				return nil
+			}
+		}
+
+		relationship, found := relationships[strings.ToLower(first)]
+		if !found {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Attribute %s is not a relationship of Salesforce object %s.%s",
+					attribute.ExternalId, object, suggestion(first, relationships, func(f *Field) string {
+						return f.RelationshipName
+					}),
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			}
+		}
+
+		if relationship.RelationshipName != first {
+			return caseMismatchError(attribute.ExternalId, object, relationship.RelationshipName)
+		}
+
+		return nil
+	}
+
+	field, err := findField(object, attribute.ExternalId, name, fieldsByName)
+	if err != nil {
+		return err
+	}
+
+	if field.isCoerced() {
+		return nil
+	}
+
+	allowedTypes, found := fieldAttributeTypes[field.Type]
+	if !found {
+		return nil
+	}
+
+	// The double fields without decimals, e.g. Number(18, 0), can be synced as integers.
+	if field.Type == "double" && field.Scale == 0 {
+		allowedTypes = append(allowedTypes, framework.AttributeTypeInt64)
+	}
+
+	for _, allowedType := range allowedTypes {
+		if attribute.Type == allowedType {
+			return nil
+		}
+	}
+
+	return &framework.Error{
+		Message: fmt.Sprintf(
+			"Attribute %s of type %s does not match field %s of Salesforce object %s of type %s.",
+			attribute.ExternalId, attributeTypeNames[attribute.Type], field.Name, object, field.Type,
+		),
+		Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+	}
+}
+
+// findField returns the field of the object with the name, which must have the exact case of its API name, as
+// the fields of the records are keyed by their API name.
+func findField(object, externalID, name string, fieldsByName map[string]*Field) (*Field, *framework.Error) {
+	field, found := fieldsByName[strings.ToLower(name)]
+	if !found {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Attribute %s is not a field of Salesforce object %s.%s",
+				externalID, object, suggestion(name, fieldsByName, func(f *Field) string { return f.Name }),
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	if field.Name != name {
+		return nil, caseMismatchError(externalID, object, field.Name)
+	}
+
+	return field, nil
+}
+
+func caseMismatchError(externalID, object, name string) *framework.Error {
+	return &framework.Error{
+		Message: fmt.Sprintf(
+			"Attribute %s does not match the case of %s of Salesforce object %s.", externalID, name, object,
+		),
+		Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+	}
+}
+
+// suggestion returns a " Did you mean X?" suggestion of the closest name to a misspelled one, or an empty string
+// if no name is close enough, i.e. within an edit distance of a third of its length.
+func suggestion(misspelled string, candidates map[string]*Field, nameOf func(*Field) string) string {
+	var (
+		closest      string
+		bestDistance = len(misspelled)/3 + 1
+	)
+
+	for _, candidate := range candidates {
+		name := nameOf(candidate)
+
+		distance := editDistance(strings.ToLower(misspelled), strings.ToLower(name))
+		if distance < bestDistance || (distance == bestDistance && closest != "" && name < closest) {
+			closest, bestDistance = name, distance
+		}
+	}
+
+	if closest == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(" Did you mean %s?", closest)
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+// CoerceFields converts the values of the picklist and formula fields of the objects into the types of the
+// attributes syncing them, e.g. a "3" picklist value into 3 for an Int64 attribute, or a 42.5 formula value
+// into "42.5" for a String attribute. Only the fields of the object are coerced, not the relationship fields.
+func CoerceFields(
+	objects []map[string]any, attributes []*framework.AttributeConfig, objectFields []Field,
+) *framework.Error {
+	coercedAttributes := make([]*framework.AttributeConfig, 0, len(attributes))
+
+	for _, attribute := range attributes {
+		name := extractFieldName(attribute.ExternalId)
+
+		for i := range objectFields {
+			if objectFields[i].Name == name && objectFields[i].isCoerced() {
+				coercedAttributes = append(coercedAttributes, attribute)
+
+				break
+			}
+		}
+	}
+
+	for _, object := range objects {
+		for _, attribute := range coercedAttributes {
+			name := extractFieldName(attribute.ExternalId)
+
+			value, found := object[name]
+			if !found || value == nil {
+				continue
+			}
+
+			coerced, err := coerceValue(value, attribute.Type)
+			if err != nil {
+				return &framework.Error{
+					Message: fmt.Sprintf(
+						"Failed to convert the value of field %s of Salesforce record %v to attribute type %s: %v.",
+						name, object[uniqueIDAttribute], attributeTypeNames[attribute.Type], err,
+					),
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+				}
+			}
+
+			object[name] = coerced
+		}
+	}
+
+	return nil
+}
+
+// coerceValue converts a JSON value into a JSON value of the attribute type. The DateTime and Duration values
+// are returned as is, to be parsed by the conversion of the objects.
+func coerceValue(value any, attributeType framework.AttributeType) (any, error) {
+	switch attributeType {
+	case framework.AttributeTypeBool:
+		return commonutil.ParseBool(value)
+	case framework.AttributeTypeInt64:
+		i, err := commonutil.ParseInt64(value)
+
+		return float64(i), err
+	case framework.AttributeTypeDouble:
+		return commonutil.ParseFloat(value)
+	case framework.AttributeTypeString:
+		switch v := value.(type) {
+		case bool:
+			return strconv.FormatBool(v), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		}
+	}
+
+	return value, nil
+}
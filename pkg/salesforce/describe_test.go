@@ -0,0 +1,350 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package salesforce_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	salesforce_adapter "github.com/sgnl-ai/adapters/pkg/salesforce"
+)
+
+var caseFields = []salesforce_adapter.Field{
+	{Name: "Id", Type: "id"},
+	{Name: "CaseNumber", Type: "string"},
+	{Name: "Status", Type: "picklist"},
+	{Name: "Severity__c", Type: "picklist"},
+	{Name: "Score__c", Type: "double", Calculated: true, Scale: 2},
+	{Name: "Amount__c", Type: "currency", Scale: 2},
+	{Name: "Headcount__c", Type: "double", Scale: 0},
+	{Name: "IsClosed", Type: "boolean"},
+	{Name: "ClosedDate", Type: "datetime"},
+	{Name: "AccountId", Type: "reference", RelationshipName: "Account"},
+	{Name: "Topics__c", Type: "multipicklist"},
+	{Name: "SuppliedAddress__c", Type: "address"},
+}
+
+func TestValidateAttributes(t *testing.T) {
+	tests := map[string]struct {
+		attributes    []*framework.AttributeConfig
+		childEntities []*framework.EntityConfig
+		wantErr       *framework.Error
+	}{
+		"valid_attributes": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "CaseNumber", Type: framework.AttributeTypeString},
+				{ExternalId: "$.IsClosed", Type: framework.AttributeTypeBool},
+				{ExternalId: "ClosedDate", Type: framework.AttributeTypeDateTime},
+				{ExternalId: "Amount__c", Type: framework.AttributeTypeDouble},
+				{ExternalId: "Headcount__c", Type: framework.AttributeTypeInt64},
+				{ExternalId: "$.Account.Owner.Name", Type: framework.AttributeTypeString},
+				{ExternalId: "$.SuppliedAddress__c.city", Type: framework.AttributeTypeString},
+			},
+			childEntities: []*framework.EntityConfig{
+				{ExternalId: "Topics__c"},
+			},
+		},
+		"picklist_and_formula_fields_of_any_type": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "Severity__c", Type: framework.AttributeTypeInt64},
+				{ExternalId: "Status", Type: framework.AttributeTypeBool},
+				{ExternalId: "$.Score__c", Type: framework.AttributeTypeString},
+			},
+		},
+		"misspelled_field": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "Stauts", Type: framework.AttributeTypeString},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute Stauts is not a field of Salesforce object Case. Did you mean Status?",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"unknown_field_without_suggestion": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "$.Region__c", Type: framework.AttributeTypeString},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute $.Region__c is not a field of Salesforce object Case.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"field_case_mismatch": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "casenumber", Type: framework.AttributeTypeString},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute casenumber does not match the case of CaseNumber of Salesforce object Case.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"type_mismatch": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "Amount__c", Type: framework.AttributeTypeInt64},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute Amount__c of type Int64 does not match field Amount__c of Salesforce object Case of type currency.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"misspelled_relationship": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "$.Acount.Name", Type: framework.AttributeTypeString},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute $.Acount.Name is not a relationship of Salesforce object Case. Did you mean Account?",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"relationship_case_mismatch": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "$.account.Name", Type: framework.AttributeTypeString},
+			},
+			wantErr: &framework.Error{
+				Message: "Attribute $.account.Name does not match the case of Account of Salesforce object Case.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"child_entity_not_multipicklist": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+			},
+			childEntities: []*framework.EntityConfig{
+				{ExternalId: "Status"},
+			},
+			wantErr: &framework.Error{
+				Message: "Child entity Status is not a multi-select picklist field of Salesforce object Case: its type is picklist.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			entity := &framework.EntityConfig{
+				ExternalId:    "Case",
+				Attributes:    tt.attributes,
+				ChildEntities: tt.childEntities,
+			}
+
+			gotErr := salesforce_adapter.ValidateAttributes(entity, caseFields)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCoerceFields(t *testing.T) {
+	tests := map[string]struct {
+		attributes  []*framework.AttributeConfig
+		objects     []map[string]any
+		wantObjects []map[string]any
+		wantErr     *framework.Error
+	}{
+		"picklist_and_formula_values": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "Severity__c", Type: framework.AttributeTypeInt64},
+				{ExternalId: "Status", Type: framework.AttributeTypeBool},
+				{ExternalId: "$.Score__c", Type: framework.AttributeTypeString},
+				{ExternalId: "CaseNumber", Type: framework.AttributeTypeString},
+			},
+			objects: []map[string]any{
+				{"Id": "500A", "Severity__c": "3", "Status": "true", "Score__c": 42.5, "CaseNumber": "00001026"},
+				{"Id": "500B", "Severity__c": nil, "Status": "False", "Score__c": float64(7), "CaseNumber": "00001027"},
+			},
+			wantObjects: []map[string]any{
+				{"Id": "500A", "Severity__c": float64(3), "Status": true, "Score__c": "42.5", "CaseNumber": "00001026"},
+				{"Id": "500B", "Severity__c": nil, "Status": false, "Score__c": "7", "CaseNumber": "00001027"},
+			},
+		},
+		"invalid_picklist_value": {
+			attributes: []*framework.AttributeConfig{
+				{ExternalId: "Id", Type: framework.AttributeTypeString},
+				{ExternalId: "Severity__c", Type: framework.AttributeTypeInt64},
+			},
+			objects: []map[string]any{
+				{"Id": "500A", "Severity__c": "High"},
+			},
+			wantObjects: []map[string]any{
+				{"Id": "500A", "Severity__c": "High"},
+			},
+			wantErr: &framework.Error{
+				Message: `Failed to convert the value of field Severity__c of Salesforce record 500A to attribute type Int64: invalid numeric value: "High".`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := salesforce_adapter.CoerceFields(tt.objects, tt.attributes, caseFields)
+
+			if !reflect.DeepEqual(tt.objects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", tt.objects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdapterGetPageWithDescribe(t *testing.T) {
+	var describeCalls atomic.Int32
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/services/data/v58.0/sobjects/Case/describe":
+			describeCalls.Add(1)
+
+			w.Write([]byte(`{"name": "Case", "fields": [
+				{"name": "Id", "type": "id", "calculated": false},
+				{"name": "Status", "type": "picklist", "calculated": false},
+				{"name": "Severity__c", "type": "picklist", "calculated": false},
+				{"name": "Score__c", "type": "double", "calculated": true, "scale": 2}
+			]}`))
+		case "/services/data/v58.0/sobjects/Widget__c/describe":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`[{"errorCode": "NOT_FOUND", "message": "The requested resource does not exist"}]`))
+		case "/services/data/v58.0/query?q=SELECT+Id,Status,Severity__c,Score__c+FROM+Case+ORDER+BY+Id+ASC":
+			w.Write([]byte(`{"totalSize": 3, "done": false, "nextRecordsUrl": "/services/data/v58.0/query/01gHu-200", "records": [
+				{"Id": "500A", "Status": "New", "Severity__c": "3", "Score__c": 42.5},
+				{"Id": "500B", "Status": "Closed", "Severity__c": "1", "Score__c": null}
+			]}`))
+		case "/services/data/v58.0/query/01gHu-200":
+			w.Write([]byte(`{"totalSize": 3, "done": true, "records": [
+				{"Id": "500C", "Status": "New", "Severity__c": "2", "Score__c": 7}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	adapter := salesforce_adapter.NewAdapter(&salesforce_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	newRequest := func(entity, cursor string, attributes ...string) *framework.Request[salesforce_adapter.Config] {
+		request := &framework.Request[salesforce_adapter.Config]{
+			Address: server.URL,
+			Auth: &framework.DatasourceAuthCredentials{
+				HTTPAuthorization: "Bearer Testtoken",
+			},
+			Config: &salesforce_adapter.Config{
+				APIVersion:      "58.0",
+				DescribeObjects: true,
+			},
+			Entity: framework.EntityConfig{
+				ExternalId: entity,
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "Id", Type: framework.AttributeTypeString},
+					{ExternalId: "Status", Type: framework.AttributeTypeString},
+					{ExternalId: "Severity__c", Type: framework.AttributeTypeInt64},
+					{ExternalId: "$.Score__c", Type: framework.AttributeTypeString},
+				},
+			},
+			Ordered:  true,
+			PageSize: 200,
+			Cursor:   cursor,
+		}
+
+		for _, attribute := range attributes {
+			request.Entity.Attributes = append(request.Entity.Attributes, &framework.AttributeConfig{
+				ExternalId: attribute,
+				Type:       framework.AttributeTypeString,
+			})
+		}
+
+		return request
+	}
+
+	// The pages are requested in order, to check that the object is only described at the start of the sync.
+	tests := []struct {
+		name              string
+		request           *framework.Request[salesforce_adapter.Config]
+		wantResponse      framework.Response
+		wantDescribeCalls int32
+	}{
+		{
+			name:    "first_page",
+			request: newRequest("Case", ""),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"Id": "500A", "Status": "New", "Severity__c": int64(3), "$.Score__c": "42.5"},
+						{"Id": "500B", "Status": "Closed", "Severity__c": int64(1)},
+					},
+					NextCursor: "/services/data/v58.0/query/01gHu-200",
+				},
+			},
+			wantDescribeCalls: 1,
+		},
+		{
+			name:    "next_page_with_cached_describe",
+			request: newRequest("Case", "/services/data/v58.0/query/01gHu-200"),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"Id": "500C", "Status": "New", "Severity__c": int64(2), "$.Score__c": "7"},
+					},
+				},
+			},
+			wantDescribeCalls: 1,
+		},
+		{
+			name:    "misspelled_attribute",
+			request: newRequest("Case", "", "Subjet"),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Attribute Subjet is not a field of Salesforce object Case.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+				},
+			},
+			wantDescribeCalls: 2,
+		},
+		{
+			name:    "unknown_object",
+			request: newRequest("Widget__c", ""),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Salesforce object Widget__c does not exist or is not accessible with the provided token.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+				},
+			},
+			wantDescribeCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+		if diff := cmp.Diff(tt.wantResponse, gotResponse); diff != "" {
+			t.Errorf("%s: adapter.GetPage() mismatch (-want +got):\n%s", tt.name, diff)
+		}
+
+		if gotDescribeCalls := describeCalls.Load(); gotDescribeCalls != tt.wantDescribeCalls {
+			t.Errorf("%s: gotDescribeCalls: %d, wantDescribeCalls: %d", tt.name, gotDescribeCalls, tt.wantDescribeCalls)
+		}
+	}
+}
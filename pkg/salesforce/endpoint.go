@@ -4,6 +4,7 @@ package salesforce
 
 import (
 	"net/url"
+	"strconv"
 	"strings"
 
 	framework "github.com/sgnl-ai/adapter-framework"
@@ -31,7 +32,7 @@ func ConstructEndpoint(request *Request) string {
 
 	escapedAPIVersion := url.QueryEscape(request.APIVersion)
 	escapedEntityExternalID := url.QueryEscape(request.EntityExternalID)
-	encodedAttributes := encodedAttributes(request.Attributes)
+	encodedAttributes := encodedAttributes(request.Attributes, request.WatermarkField)
 
 	sb.Grow(len(escapedAPIVersion) + len(encodedAttributes) + len(escapedEntityExternalID) + 63)
 
@@ -42,6 +43,12 @@ func ConstructEndpoint(request *Request) string {
 	sb.WriteString("+FROM+")
 	sb.WriteString(escapedEntityExternalID)
 
+	if request.WatermarkField != "" {
+		writeWatermarkQuery(&sb, request)
+
+		return sb.String()
+	}
+
 	if request.Filter != nil {
 		sb.WriteString("+WHERE+")
 		sb.WriteString(url.QueryEscape(*request.Filter))
@@ -52,11 +59,40 @@ func ConstructEndpoint(request *Request) string {
 	return sb.String()
 }
 
-func encodedAttributes(attributes []*framework.AttributeConfig) string {
+// writeWatermarkQuery writes the end of the query of an event entity, after the FROM clause.
+// The records are ordered by creation time and ID, and limited to the page size. They are restricted to
+// those created after the watermark of the request, if any.
+func writeWatermarkQuery(sb *strings.Builder, request *Request) {
+	conditions := make([]string, 0, 2)
+
+	if request.Filter != nil {
+		conditions = append(conditions, "("+*request.Filter+")")
+	}
+
+	if request.Watermark != nil {
+		conditions = append(conditions, watermarkCondition(request.WatermarkField, request.Watermark))
+	}
+
+	if len(conditions) > 0 {
+		sb.WriteString("+WHERE+")
+		sb.WriteString(url.QueryEscape(strings.Join(conditions, " AND ")))
+	}
+
+	sb.WriteString("+ORDER+BY+")
+	sb.WriteString(url.QueryEscape(request.WatermarkField))
+	sb.WriteString("+ASC,Id+ASC+LIMIT+")
+	sb.WriteString(strconv.FormatInt(request.PageSize, 10))
+}
+
+// encodedAttributes returns the encoded fields to select after the Id field. The watermark field, if not empty,
+// is selected even if it's not requested, to compute the cursor of the next page.
+func encodedAttributes(attributes []*framework.AttributeConfig, watermarkField string) string {
 	var attributesBuilder strings.Builder
 	// Guesstimating initial buffer need, len(attributes) * 6 byte strings
 	attributesBuilder.Grow(len(attributes) * 6)
 
+	watermarkFieldFound := watermarkField == ""
+
 	for _, attribute := range attributes {
 		// Extract the actual field name from JSONPath or use as-is
 		fieldName := extractFieldName(attribute.ExternalId)
@@ -66,10 +102,19 @@ func encodedAttributes(attributes []*framework.AttributeConfig) string {
 			continue
 		}
 
+		if fieldName == watermarkField {
+			watermarkFieldFound = true
+		}
+
 		attributesBuilder.WriteRune(',')
 		attributesBuilder.WriteString(url.QueryEscape(fieldName))
 	}
 
+	if !watermarkFieldFound {
+		attributesBuilder.WriteRune(',')
+		attributesBuilder.WriteString(url.QueryEscape(watermarkField))
+	}
+
 	return attributesBuilder.String()
 }
 
@@ -5,6 +5,7 @@ package salesforce
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	"github.com/sgnl-ai/adapters/pkg/testutil"
@@ -76,6 +77,71 @@ func TestConstructEndpoint(t *testing.T) {
 			},
 			wantEndpoint: "https://test.salesforce.com/services/data/v58.0/query/0r8Hu1lKCluUiC9IMK-200",
 		},
+		"event_entity_first_page": {
+			request: &Request{
+				BaseURL:          "https://test.salesforce.com",
+				APIVersion:       "58.0",
+				EntityExternalID: SetupAuditTrail,
+				PageSize:         200,
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "Id",
+						Type:       framework.AttributeTypeString,
+					},
+					{
+						ExternalId: "Action",
+						Type:       framework.AttributeTypeString,
+					},
+				},
+				WatermarkField: "CreatedDate",
+			},
+			// The watermark field is selected even if it's not requested.
+			wantEndpoint: "https://test.salesforce.com/services/data/v58.0/query?q=SELECT+Id,Action,CreatedDate+" +
+				"FROM+SetupAuditTrail+ORDER+BY+CreatedDate+ASC,Id+ASC+LIMIT+200",
+		},
+		"event_entity_incremental_first_page_with_filter": {
+			request: &Request{
+				BaseURL:          "https://test.salesforce.com",
+				APIVersion:       "58.0",
+				EntityExternalID: LoginHistory,
+				PageSize:         200,
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "Id",
+						Type:       framework.AttributeTypeString,
+					},
+					{
+						ExternalId: "LoginTime",
+						Type:       framework.AttributeTypeDateTime,
+					},
+				},
+				Filter:         testutil.GenPtr("Status = 'Success'"),
+				WatermarkField: "LoginTime",
+				Watermark:      &Watermark{Time: time.Date(2026, 1, 1, 1, 0, 0, 0, time.FixedZone("UTC+1", 3600))},
+			},
+			wantEndpoint: "https://test.salesforce.com/services/data/v58.0/query?q=SELECT+Id,LoginTime+" +
+				"FROM+LoginHistory+WHERE+%28Status+%3D+%27Success%27%29+AND+LoginTime+%3E%3D+2026-01-01T00%3A00%3A00Z+" +
+				"ORDER+BY+LoginTime+ASC,Id+ASC+LIMIT+200",
+		},
+		"event_entity_next_page": {
+			request: &Request{
+				BaseURL:          "https://test.salesforce.com",
+				APIVersion:       "58.0",
+				EntityExternalID: LoginHistory,
+				PageSize:         200,
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "Id",
+						Type:       framework.AttributeTypeString,
+					},
+				},
+				WatermarkField: "LoginTime",
+				Watermark:      &Watermark{Time: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), ID: "0Ya2"},
+			},
+			wantEndpoint: "https://test.salesforce.com/services/data/v58.0/query?q=SELECT+Id,LoginTime+" +
+				"FROM+LoginHistory+WHERE+%28LoginTime+%3E+2026-01-01T10%3A00%3A00Z+OR+%28LoginTime+%3D+" +
+				"2026-01-01T10%3A00%3A00Z+AND+Id+%3E+%270Ya2%27%29%29+ORDER+BY+LoginTime+ASC,Id+ASC+LIMIT+200",
+		},
 	}
 
 	for name, tt := range tests {
@@ -0,0 +1,133 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package salesforce
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+const (
+	// LoginHistory is the entity of the login attempts of the users.
+	// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_objects_loginhistory.htm
+	LoginHistory = "LoginHistory"
+
+	// SetupAuditTrail is the entity of the setup changes made by the administrators.
+	// https://developer.salesforce.com/docs/atlas.en-us.object_reference.meta/object_reference/sforce_api_objects_setupaudittrail.htm
+	SetupAuditTrail = "SetupAuditTrail"
+
+	// watermarkCursorShape is the expected JSON shape of a Watermark, used in cursor errors.
+	watermarkCursorShape = `{"time":<RFC 3339 string>,"id":<string>}`
+
+	// soqlDateTimeFormat is the format of the date-time literals in SOQL queries.
+	// https://developer.salesforce.com/docs/atlas.en-us.soql_sosl.meta/soql_sosl/sforce_api_calls_soql_select_dateformats.htm
+	soqlDateTimeFormat = "2006-01-02T15:04:05Z"
+
+	// recordDateTimeFormat is the format of the date-time fields of the records returned by the query API.
+	recordDateTimeFormat = "2006-01-02T15:04:05.000Z0700"
+)
+
+// EventEntities maps the external IDs of the event entities to the field containing the creation time of their
+// records. The records of event entities are never updated, and there can be too many of them to be queried with
+// a single query locator, so they are paged by a watermark on their creation time instead.
+// LoginHistory has no CreatedDate field, its records are created at their LoginTime.
+var EventEntities = map[string]string{
+	LoginHistory:    "LoginTime",
+	SetupAuditTrail: "CreatedDate",
+}
+
+// Watermark is the position of the last record returned for an event entity. It is the cursor of the event
+// entities, marshaled into JSON and then base64 encoded.
+type Watermark struct {
+	// Time is the creation time of the last record returned.
+	Time time.Time `json:"time"`
+
+	// ID is the ID of the last record returned, to page through records created at the same time.
+	// Empty in the request for the first page of an incremental sync, in which case the records created at
+	// Time are returned.
+	ID string `json:"id,omitempty"`
+}
+
+// ParseWatermarkCursor parses the cursor of a request for an event entity.
+func ParseWatermarkCursor(cursor string, entityExternalID string) (*Watermark, *framework.Error) {
+	cursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID,
+			watermarkCursorShape,
+			fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var watermark Watermark
+
+	if err := json.Unmarshal(cursorBytes, &watermark); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID,
+			watermarkCursorShape,
+			fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	if watermark.Time.IsZero() || watermark.ID == "" {
+		return nil, pagination.NewCursorError(entityExternalID, watermarkCursorShape, "time and id must be set")
+	}
+
+	return &watermark, nil
+}
+
+// MarshalWatermarkCursor marshals the cursor of the next page of an event entity.
+func MarshalWatermarkCursor(watermark *Watermark) string {
+	// A Watermark can always be marshaled.
+	cursorBytes, _ := json.Marshal(watermark)
+
+	return base64.StdEncoding.EncodeToString(cursorBytes)
+}
+
+// watermarkCondition returns the SOQL condition restricting the records to those created after the watermark.
+// Records created at the same time as the watermark are ordered by ID.
+func watermarkCondition(field string, watermark *Watermark) string {
+	// SOQL date-time literals have a precision of one second, and so do the date-time fields of the records.
+	literal := watermark.Time.UTC().Format(soqlDateTimeFormat)
+
+	if watermark.ID == "" {
+		return field + " >= " + literal
+	}
+
+	return fmt.Sprintf(
+		"(%s > %s OR (%s = %s AND Id > '%s'))",
+		field, literal, field, literal, strings.ReplaceAll(watermark.ID, "'", `\'`),
+	)
+}
+
+// nextWatermark returns the watermark of the last record of a page of an event entity.
+func nextWatermark(field string, objects []map[string]any) (*Watermark, *framework.Error) {
+	last := objects[len(objects)-1]
+
+	createdRaw, _ := last[field].(string)
+
+	created, err := time.Parse(recordDateTimeFormat, createdRaw)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to parse the %s field of the last record as a date-time: %q.", field, createdRaw),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	id, _ := last[uniqueIDAttribute].(string)
+	if id == "" {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to parse the %s field of the last record as a string.", uniqueIDAttribute),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return &Watermark{Time: created, ID: id}, nil
+}
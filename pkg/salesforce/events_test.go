@@ -0,0 +1,60 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package salesforce_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/salesforce"
+)
+
+func TestParseWatermarkCursor(t *testing.T) {
+	tests := map[string]struct {
+		cursor        string
+		wantWatermark *salesforce.Watermark
+		wantErr       *framework.Error
+	}{
+		"valid": {
+			// {"time":"2026-01-01T10:00:00Z","id":"0Ya2"}
+			cursor:        "eyJ0aW1lIjoiMjAyNi0wMS0wMVQxMDowMDowMFoiLCJpZCI6IjBZYTIifQ==",
+			wantWatermark: &salesforce.Watermark{Time: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), ID: "0Ya2"},
+		},
+		"invalid_base64": {
+			cursor: "/services/data/v58.0/query/0r8Hu1lKCluUiC9IMK-200",
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity LoginHistory: failed to decode base64 cursor: illegal base64 data " +
+					`at input byte 18. Expected cursor shape: {"time":<RFC 3339 string>,"id":<string>}. ` +
+					"Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"missing_id": {
+			// {"time":"2026-01-01T10:00:00Z"}
+			cursor: "eyJ0aW1lIjoiMjAyNi0wMS0wMVQxMDowMDowMFoifQ==",
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity LoginHistory: time and id must be set. " +
+					`Expected cursor shape: {"time":<RFC 3339 string>,"id":<string>}. ` +
+					"Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotWatermark, gotErr := salesforce.ParseWatermarkCursor(tt.cursor, salesforce.LoginHistory)
+
+			if !reflect.DeepEqual(gotWatermark, tt.wantWatermark) {
+				t.Errorf("gotWatermark: %v, wantWatermark: %v", gotWatermark, tt.wantWatermark)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
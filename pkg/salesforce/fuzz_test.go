@@ -0,0 +1,30 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package salesforce_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/salesforce"
+)
+
+// FuzzParseResponse checks that malformed Salesforce responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"totalSize":2,"done":false,"nextRecordsUrl":"/services/data/v58.0/query/01gRO0000016PIAYA2-1","records":[{"attributes":{"type":"User"},"Id":"005Hs00000FQhVEIA1"}]}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, _, err := salesforce.ParseResponse(body)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
 	salesforce_adapter "github.com/sgnl-ai/adapters/pkg/salesforce"
 )
 
@@ -134,6 +135,35 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"invalid_incremental_sync_without_since": {
+			request: &framework.Request[salesforce_adapter.Config]{
+				Address: "sgnl-dev.my.salesforce.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "LoginHistory",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "Id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &salesforce_adapter.Config{
+					CommonConfig: &config.CommonConfig{
+						SyncMode: config.SyncModeIncremental,
+					},
+					APIVersion: "58.0",
+				},
+				Ordered:  true,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Salesforce config is invalid: incrementalSyncSince is required when syncMode is INCREMENTAL.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_ordered_false": {
 			request: &framework.Request[salesforce_adapter.Config]{
 				Address: "sgnl-dev.my.salesforce.com",
@@ -0,0 +1,322 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package schemadrift detects the drift between the objects returned by a datasource and the attributes configured
+// for their entity, so that changes of the schema of a datasource are noticed before they silently null out
+// attributes. The drift is made of:
+//   - the new fields: the top-level fields of the objects that aren't configured as attributes, e.g. a field added
+//     to the API or a column added to a CSV file, possibly replacing a renamed field.
+//   - the missing attributes: the configured attributes absent from every object of a page, e.g. a field removed
+//     from the API or a column removed from a CSV file.
+//
+// The adapters record the raw JSON objects of each page, or the rows of CSV files, with Observe before converting
+// them, and the drift is logged by the adapters wrapped with NewAdapter, with the unique IDs of a few example
+// objects. The same drift is only logged once per LogInterval for each entity of a datasource.
+//
+// The detection is enabled per datasource in the datasource config, see config.CommonConfig.
+package schemadrift
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+)
+
+const (
+	// MaxExamples is the maximum number of example objects logged for each drifted field.
+	MaxExamples = 3
+
+	// LogInterval is the interval during which the same drift of an entity is only logged once.
+	LogInterval = time.Hour
+)
+
+// Provider provides whether the schema drift detection is enabled. It is implemented by config.CommonConfig,
+// and therefore by the configs of all adapters.
+type Provider interface {
+	// SchemaDriftDetectionEnabled returns whether the schema drift of the objects is detected and logged.
+	SchemaDriftDetectionEnabled() bool
+}
+
+// Field is a drifted field, with the unique IDs of up to MaxExamples objects exhibiting the drift.
+type Field struct {
+	Name     string
+	Examples []string
+}
+
+// Report is the drift detected in the objects of a page.
+type Report struct {
+	// NewFields are the fields of the objects that aren't configured as attributes, sorted by name.
+	NewFields []Field
+
+	// MissingAttributes are the configured attributes absent from every object, sorted by name.
+	MissingAttributes []Field
+}
+
+// Empty returns whether no drift was detected.
+func (r *Report) Empty() bool {
+	return r == nil || len(r.NewFields) == 0 && len(r.MissingAttributes) == 0
+}
+
+// signature identifies the drift, regardless of the example objects.
+func (r *Report) signature() string {
+	var b strings.Builder
+
+	for _, field := range r.NewFields {
+		b.WriteString("+" + field.Name + "\n")
+	}
+
+	for _, field := range r.MissingAttributes {
+		b.WriteString("-" + field.Name + "\n")
+	}
+
+	return b.String()
+}
+
+// Recorder records the fields of the objects of a page of an entity.
+type Recorder struct {
+	mu sync.Mutex
+
+	// fields maps the top-level field of each configured attribute, and the external ID of each child entity,
+	// to whether it was found in an object.
+	fields map[string]bool
+
+	// uniqueIDField is the top-level field of the unique ID attribute of the entity, if any.
+	uniqueIDField string
+
+	newFields map[string][]string
+	examples  []string
+	objects   int
+}
+
+// NewRecorder returns a Recorder of the objects of the entity.
+func NewRecorder(entity *framework.EntityConfig) *Recorder {
+	r := &Recorder{
+		fields:    make(map[string]bool, len(entity.Attributes)+len(entity.ChildEntities)),
+		newFields: make(map[string][]string),
+	}
+
+	for _, attribute := range entity.Attributes {
+		if attribute == nil {
+			continue
+		}
+
+		field := topLevelField(attribute.ExternalId)
+		r.fields[field] = false
+
+		if attribute.UniqueId && r.uniqueIDField == "" {
+			r.uniqueIDField = field
+		}
+	}
+
+	for _, childEntity := range entity.ChildEntities {
+		if childEntity != nil {
+			r.fields[childEntity.ExternalId] = false
+		}
+	}
+
+	return r
+}
+
+// Observe records the fields of the objects of a page.
+func (r *Recorder) Observe(objects []map[string]any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, object := range objects {
+		r.objects++
+
+		example := r.example(object)
+
+		if len(r.examples) < MaxExamples {
+			r.examples = append(r.examples, example)
+		}
+
+		for field := range object {
+			if _, configured := r.fields[field]; configured {
+				r.fields[field] = true
+
+				continue
+			}
+
+			if len(r.newFields[field]) < MaxExamples {
+				r.newFields[field] = append(r.newFields[field], example)
+			}
+		}
+	}
+}
+
+// example returns the unique ID of an object, or its position in the page if the entity has no unique ID attribute.
+func (r *Recorder) example(object map[string]any) string {
+	if value, found := object[r.uniqueIDField]; found && r.uniqueIDField != "" && value != nil {
+		return fmt.Sprint(value)
+	}
+
+	return fmt.Sprintf("#%d", r.objects)
+}
+
+// Report returns the drift of the recorded objects, or nil if no object was recorded.
+func (r *Recorder) Report() *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.objects == 0 {
+		return nil
+	}
+
+	report := &Report{}
+
+	for field, examples := range r.newFields {
+		report.NewFields = append(report.NewFields, Field{Name: field, Examples: examples})
+	}
+
+	for field, found := range r.fields {
+		if !found {
+			report.MissingAttributes = append(report.MissingAttributes, Field{Name: field, Examples: r.examples})
+		}
+	}
+
+	compare := func(a, b Field) int { return strings.Compare(a.Name, b.Name) }
+
+	slices.SortFunc(report.NewFields, compare)
+	slices.SortFunc(report.MissingAttributes, compare)
+
+	return report
+}
+
+// topLevelField returns the top-level field of the objects an attribute is read from: the attribute itself,
+// or the first member of a JSONPath attribute, e.g. "user" for "$.user.id" or "emails" for "$.emails[0].value".
+func topLevelField(attributeExternalID string) string {
+	path, found := strings.CutPrefix(attributeExternalID, "$.")
+	if !found {
+		return attributeExternalID
+	}
+
+	if end := strings.IndexAny(path, ".["); end != -1 {
+		return path[:end]
+	}
+
+	return path
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the recorder, see Observe.
+func NewContext(ctx context.Context, recorder *Recorder) context.Context {
+	return context.WithValue(ctx, contextKey{}, recorder)
+}
+
+// Observe records the fields of the raw objects of a page with the recorder of the context, if any. It is called
+// by the adapters before converting the objects.
+func Observe(ctx context.Context, objects []map[string]any) {
+	if recorder, ok := ctx.Value(contextKey{}).(*Recorder); ok && recorder != nil {
+		recorder.Observe(objects)
+	}
+}
+
+// loggedDrift is the last drift logged for an entity of a datasource.
+type loggedDrift struct {
+	signature string
+	at        time.Time
+}
+
+// adapter detects the schema drift of the objects returned by the next adapter.
+type adapter[Config any] struct {
+	next framework.Adapter[Config]
+
+	mu     sync.Mutex
+	logged map[string]loggedDrift
+	now    func() time.Time
+}
+
+// NewAdapter wraps an adapter to log the schema drift of the objects it returns. The config of the adapter must
+// implement Provider and enable the detection, otherwise the requests are left unchanged.
+func NewAdapter[Config any](next framework.Adapter[Config]) framework.Adapter[Config] {
+	return newAdapter(next, time.Now)
+}
+
+func newAdapter[Config any](next framework.Adapter[Config], now func() time.Time) *adapter[Config] {
+	return &adapter[Config]{
+		next:   next,
+		logged: make(map[string]loggedDrift),
+		now:    now,
+	}
+}
+
+// GetPage implements framework.Adapter.
+func (a *adapter[Config]) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	var provider Provider
+
+	if request.Config != nil {
+		provider, _ = any(request.Config).(Provider)
+	}
+
+	if provider == nil || !provider.SchemaDriftDetectionEnabled() {
+		return a.next.GetPage(ctx, request)
+	}
+
+	recorder := NewRecorder(&request.Entity)
+
+	response := a.next.GetPage(NewContext(ctx, recorder), request)
+	if response.Success == nil {
+		return response
+	}
+
+	report := recorder.Report()
+	if report.Empty() || !a.shouldLog(request.Address+"\n"+request.Entity.ExternalId, report) {
+		return response
+	}
+
+	zaplogger.FromContext(ctx).Warn("Schema drift detected",
+		fields.RequestEntityExternalID(request.Entity.ExternalId),
+		fields.SchemaDriftNewFields(fieldNames(report.NewFields)),
+		fields.SchemaDriftMissingAttributes(fieldNames(report.MissingAttributes)),
+		fields.SchemaDriftExamples(examples(report)),
+	)
+
+	return response
+}
+
+// shouldLog returns whether the drift of an entity must be logged, i.e. whether it differs from the last drift
+// logged for the entity, or was logged more than LogInterval ago.
+func (a *adapter[Config]) shouldLog(key string, report *Report) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	signature := report.signature()
+
+	if last, found := a.logged[key]; found && last.signature == signature && now.Sub(last.at) < LogInterval {
+		return false
+	}
+
+	a.logged[key] = loggedDrift{signature: signature, at: now}
+
+	return true
+}
+
+func fieldNames(fields []Field) []string {
+	names := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+
+	return names
+}
+
+// examples maps each drifted field to the unique IDs of its example objects.
+func examples(report *Report) map[string][]string {
+	examples := make(map[string][]string, len(report.NewFields)+len(report.MissingAttributes))
+
+	for _, field := range slices.Concat(report.NewFields, report.MissingAttributes) {
+		examples[field.Name] = field.Examples
+	}
+
+	return examples
+}
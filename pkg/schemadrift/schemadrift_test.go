@@ -0,0 +1,266 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package schemadrift
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+var testEntity = framework.EntityConfig{
+	ExternalId: "User",
+	Attributes: []*framework.AttributeConfig{
+		{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+		{ExternalId: "email", Type: framework.AttributeTypeString},
+		{ExternalId: "$.profile.department", Type: framework.AttributeTypeString},
+		{ExternalId: "$.emails[0].value", Type: framework.AttributeTypeString},
+	},
+	ChildEntities: []*framework.EntityConfig{
+		{
+			ExternalId: "groups",
+			Attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+			},
+		},
+	},
+}
+
+func TestRecorderReport(t *testing.T) {
+	tests := map[string]struct {
+		entity     framework.EntityConfig
+		pages      [][]map[string]any
+		wantReport *Report
+	}{
+		"no_objects": {
+			entity:     testEntity,
+			pages:      [][]map[string]any{{}},
+			wantReport: nil,
+		},
+		"no_drift": {
+			entity: testEntity,
+			pages: [][]map[string]any{
+				{
+					{"id": "00u1", "email": "john@example.com", "profile": map[string]any{}, "emails": []any{}, "groups": []any{}},
+					{"id": "00u2", "email": nil},
+				},
+			},
+			wantReport: &Report{},
+		},
+		"new_fields_and_missing_attributes": {
+			entity: testEntity,
+			pages: [][]map[string]any{
+				{
+					{"id": "00u1", "mail": "john@example.com", "profile": map[string]any{}, "emails": []any{}, "groups": []any{}, "costCenter": "A"},
+					{"id": "00u2", "mail": "jane@example.com", "profile": map[string]any{}, "emails": []any{}},
+				},
+				{
+					{"id": "00u3", "mail": "jim@example.com"},
+					{"id": "00u4", "mail": "joe@example.com"},
+				},
+			},
+			wantReport: &Report{
+				NewFields: []Field{
+					{Name: "costCenter", Examples: []string{"00u1"}},
+					{Name: "mail", Examples: []string{"00u1", "00u2", "00u3"}},
+				},
+				MissingAttributes: []Field{
+					{Name: "email", Examples: []string{"00u1", "00u2", "00u3"}},
+				},
+			},
+		},
+		"no_unique_id_attribute": {
+			entity: framework.EntityConfig{
+				ExternalId: "Row",
+				Attributes: []*framework.AttributeConfig{
+					{ExternalId: "name", Type: framework.AttributeTypeString},
+					{ExternalId: "title", Type: framework.AttributeTypeString},
+				},
+			},
+			pages: [][]map[string]any{
+				{
+					{"name": "John", "jobTitle": "Engineer"},
+					{"name": "Jane", "jobTitle": "Manager"},
+				},
+			},
+			wantReport: &Report{
+				NewFields: []Field{
+					{Name: "jobTitle", Examples: []string{"#1", "#2"}},
+				},
+				MissingAttributes: []Field{
+					{Name: "title", Examples: []string{"#1", "#2"}},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := NewRecorder(&tt.entity)
+
+			for _, page := range tt.pages {
+				Observe(NewContext(context.Background(), recorder), page)
+			}
+
+			if gotReport := recorder.Report(); !reflect.DeepEqual(gotReport, tt.wantReport) {
+				t.Errorf("gotReport: %+v, wantReport: %+v", gotReport, tt.wantReport)
+			}
+		})
+	}
+}
+
+func TestObserveWithoutRecorder(t *testing.T) {
+	// Observe is a no-op if the detection is disabled, i.e. without a recorder in the context.
+	Observe(context.Background(), []map[string]any{{"id": "00u1"}})
+}
+
+func TestNewAdapter(t *testing.T) {
+	next := &testutil.Adapter{
+		Pages: map[string]*framework.Page{
+			"": {
+				Objects: []framework.Object{{"id": "00u1", "mail": "john@example.com"}},
+			},
+			"page2": {
+				Objects: []framework.Object{{"id": "00u2", "mail": "jane@example.com"}},
+			},
+			"page3": {
+				Objects: []framework.Object{
+					{"id": "00u3", "email": "jim@example.com", "profile": map[string]any{}, "emails": []any{}, "groups": []any{}},
+				},
+			},
+		},
+		// The objects are observed like the adapters do.
+		OnPage: func(ctx context.Context, page *framework.Page) {
+			objects := make([]map[string]any, 0, len(page.Objects))
+			for _, object := range page.Objects {
+				objects = append(objects, object)
+			}
+
+			Observe(ctx, objects)
+		},
+	}
+
+	enabled := &testutil.Config{CommonConfig: &config.CommonConfig{SchemaDriftDetection: true}}
+
+	wantDriftLog := map[string]any{
+		"level":                             "warn",
+		"msg":                               "Schema drift detected",
+		fields.FieldRequestEntityExternalID: "User",
+		fields.FieldSchemaDriftNewFields:    []any{"mail"},
+		fields.FieldSchemaDriftMissing:      []any{"email", "emails", "groups", "profile"},
+		fields.FieldSchemaDriftExamples: map[string][]string{
+			"mail":    {"00u1"},
+			"email":   {"00u1"},
+			"emails":  {"00u1"},
+			"groups":  {"00u1"},
+			"profile": {"00u1"},
+		},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		adapter := newAdapter[testutil.Config](next, func() time.Time { return now })
+
+		ctx, observedLogs := testutil.NewContextWithObservableLogger(context.Background())
+
+		getPage := func(cursor string) {
+			response := adapter.GetPage(ctx, &framework.Request[testutil.Config]{
+				Address: "example.okta.com",
+				Config:  enabled,
+				Entity:  testEntity,
+				Cursor:  cursor,
+			})
+			if response.Error != nil {
+				t.Fatalf("unexpected error: %v", response.Error)
+			}
+		}
+
+		getPage("")
+
+		// The same drift is only logged once per LogInterval, regardless of the example objects.
+		getPage("page2")
+
+		// No drift.
+		getPage("page3")
+
+		now = now.Add(LogInterval)
+
+		getPage("")
+
+		testutil.ValidateLogOutput(t, observedLogs, []map[string]any{wantDriftLog, wantDriftLog})
+	})
+
+	t.Run("different_drift", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		ctx, observedLogs := testutil.NewContextWithObservableLogger(context.Background())
+
+		adapter.GetPage(ctx, &framework.Request[testutil.Config]{Address: "example.okta.com", Config: enabled, Entity: testEntity})
+
+		// The drift of the entity changed, e.g. after the configured attributes were updated.
+		entity := testEntity
+		entity.Attributes = append([]*framework.AttributeConfig{{ExternalId: "mail"}}, testEntity.Attributes...)
+
+		adapter.GetPage(ctx, &framework.Request[testutil.Config]{Address: "example.okta.com", Config: enabled, Entity: entity})
+
+		testutil.ValidateLogOutput(t, observedLogs, []map[string]any{
+			wantDriftLog,
+			{
+				"level":                             "warn",
+				"msg":                               "Schema drift detected",
+				fields.FieldRequestEntityExternalID: "User",
+				fields.FieldSchemaDriftNewFields:    []any{},
+				fields.FieldSchemaDriftMissing:      []any{"email", "emails", "groups", "profile"},
+				fields.FieldSchemaDriftExamples: map[string][]string{
+					"email":   {"00u1"},
+					"emails":  {"00u1"},
+					"groups":  {"00u1"},
+					"profile": {"00u1"},
+				},
+			},
+		})
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		ctx, observedLogs := testutil.NewContextWithObservableLogger(context.Background())
+
+		response := adapter.GetPage(ctx, &framework.Request[testutil.Config]{Config: &testutil.Config{}, Entity: testEntity})
+
+		if !reflect.DeepEqual(response, framework.NewGetPageResponseSuccess(next.Pages[""])) {
+			t.Errorf("gotResponse: %v, wantResponse: %v", response, framework.NewGetPageResponseSuccess(next.Pages[""]))
+		}
+
+		if observedLogs.Len() != 0 {
+			t.Errorf("unexpected logs: %v", observedLogs.All())
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		adapter := NewAdapter[testutil.Config](next)
+
+		response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{
+			Config: enabled,
+			Entity: testEntity,
+			Cursor: "unknown",
+		})
+
+		wantErr := &framework.Error{
+			Message: "Datasource failed.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+
+		if !reflect.DeepEqual(response.Error, wantErr) {
+			t.Errorf("gotErr: %v, wantErr: %v", response.Error, wantErr)
+		}
+	})
+}
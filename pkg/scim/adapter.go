@@ -11,6 +11,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/auth"
 	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -71,9 +72,10 @@ func (a *Adapter) RequestPageFromDatasource(
 	req := &Request{
 		BaseURL:               request.Address,
 		AuthorizationHeader:   authorizationHeader,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		Cursor:                request.Cursor,
+		LastModifiedSince:     commonConfig.ChangedSince(),
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
 	}
 
@@ -94,6 +96,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
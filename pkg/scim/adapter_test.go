@@ -329,7 +329,7 @@ func TestAdapterGetPage(t *testing.T) {
 				Error: &framework.Error{
 					Message: `Failed to execute SCIM request: ` +
 						`Get "https://localhost:1/Users?startIndex=1&count=1": dial tcp [::1]:1: connect: connection refused.`,
-					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
 				},
 			},
 		},
@@ -424,7 +424,7 @@ func TestAdapterGetPage(t *testing.T) {
 			wantResponse: framework.Response{
 				Error: &framework.Error{
 					Message: "Failed to execute SCIM request: Get \"" + baseURL + "/Users?startIndex=408&count=1\": context deadline exceeded. Request exceeded configured timeout of 1 seconds. Please increase the request timeout.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
 				},
 			},
 		},
@@ -487,7 +487,7 @@ func TestAdapterGetPage(t *testing.T) {
 			wantResponse: framework.Response{
 				Error: &framework.Error{
 					Message: "Failed to execute SCIM request: Get \"" + baseURL + "/Groups?startIndex=408&count=1\": context deadline exceeded. Request exceeded configured timeout of 1 seconds. Please increase the request timeout.",
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
 				},
 			},
 		},
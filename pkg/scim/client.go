@@ -4,6 +4,7 @@ package scim
 
 import (
 	"context"
+	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 )
@@ -38,6 +39,10 @@ type Request struct {
 	// Optional. If not set, return the first page for this entity.
 	Cursor string
 
+	// LastModifiedSince restricts the returned objects to those modified since this time during an
+	// incremental sync, if the SoR supports filtering. nil during a full sync.
+	LastModifiedSince *time.Time
+
 	// QueryParams contains the query parameters required to generate the URL for the datasource request
 	QueryParams QueryParams
 
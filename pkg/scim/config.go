@@ -24,6 +24,8 @@ type QueryParams struct {
 {
     "requestTimeoutSeconds": 10,
     "localTimeZoneOffset": 43200,
+    "syncMode": "INCREMENTAL",
+    "incrementalSyncSince": "2026-01-01T00:00:00Z",
     "queryParams": {
         "Users": {
             "filter": "userType eq \"Employee\" and (emails co \"sgnl.com\" or emails.value co \"sgnl.org\"",
@@ -23,6 +23,9 @@ import (
 // an external datasource.
 type Datasource struct {
 	Client *http.Client
+
+	// Now returns the current time, used as the watermark of incremental syncs. Defaults to time.Now.
+	Now func() time.Time
 }
 
 type Response struct {
@@ -36,6 +39,7 @@ type Response struct {
 func NewClient(client *http.Client) Client {
 	return &Datasource{
 		Client: client,
+		Now:    time.Now,
 	}
 }
 
@@ -50,9 +54,43 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*AdapterRes
 
 	logger.Info("Starting datasource request")
 
-	cursor := "1"
-	if request.Cursor != "" {
-		cursor = request.Cursor
+	cursor, lastModifiedUntil, cursorErr := ParseCursor(request.Cursor, request.EntityExternalID)
+	if cursorErr != nil {
+		return nil, cursorErr
+	}
+
+	// An incremental sync is started on the first page only if the SoR supports filtering. Otherwise, the sync
+	// falls back to a full sync, which is recorded in the cursors of the next pages as plain start indexes.
+	if request.LastModifiedSince != nil && request.Cursor == "" {
+		supported, err := d.filterSupported(ctx, request)
+
+		switch {
+		case err != nil:
+			logger.Warn("Failed to get the service provider config, falling back to a full sync", zap.Error(err))
+		case !supported:
+			logger.Info("Datasource does not support filtering, falling back to a full sync")
+		default:
+			now := time.Now
+			if d.Now != nil {
+				now = d.Now
+			}
+
+			watermark := now().UTC().Truncate(time.Second)
+			lastModifiedUntil = &watermark
+		}
+	}
+
+	queryParams := request.QueryParams
+
+	if lastModifiedUntil != nil {
+		if request.LastModifiedSince == nil {
+			return nil, &framework.Error{
+				Message: "Cursor of an incremental sync provided for a full sync.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			}
+		}
+
+		queryParams.Filter = LastModifiedFilter(queryParams.Filter, *request.LastModifiedSince, *lastModifiedUntil)
 	}
 
 	url := GenerateURL(
@@ -60,7 +98,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*AdapterRes
 		request.EntityExternalID,
 		request.PageSize,
 		cursor,
-		request.QueryParams,
+		queryParams,
 	)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -94,6 +132,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*AdapterRes
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -132,6 +171,10 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*AdapterRes
 	response.Objects = objects
 	response.NextCursor = nextCursor
 
+	if lastModifiedUntil != nil && nextCursor != "" {
+		response.NextCursor = MarshalIncrementalCursor(nextCursor, *lastModifiedUntil)
+	}
+
 	logger.Info("Datasource request completed successfully",
 		fields.ResponseStatusCode(response.StatusCode),
 		fields.ResponseObjectCount(len(response.Objects)),
@@ -144,7 +187,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*AdapterRes
 func ParseResponse(body []byte, pageSize int64) (objects []map[string]any, nextCursor string, err *framework.Error) {
 	var scimResponse *Response
 
-	if unmarshalErr := json.Unmarshal(body, &scimResponse); unmarshalErr != nil {
+	if unmarshalErr := json.Unmarshal(body, &scimResponse); unmarshalErr != nil || scimResponse == nil {
 		return nil, "", &framework.Error{
 			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", unmarshalErr),
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
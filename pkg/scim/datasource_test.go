@@ -4,6 +4,7 @@ package scim_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -11,7 +12,9 @@ import (
 	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
 	"github.com/sgnl-ai/adapters/pkg/scim"
 	"github.com/sgnl-ai/adapters/pkg/testutil"
 )
@@ -512,3 +515,170 @@ func TestConstructURL(t *testing.T) {
 		})
 	}
 }
+
+func TestIncrementalGetPage(t *testing.T) {
+	filterSupported := true
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/ServiceProviderConfig":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"filter": {"supported": %t, "maxResults": 200}}`, filterSupported)
+		case r.URL.Query().Get("filter") == `meta.lastModified ge "2026-01-01T00:00:00Z" and `+
+			`meta.lastModified le "2026-01-02T03:04:05Z"` && r.URL.Query().Get("startIndex") == "1":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"totalResults": 3,
+				"itemsPerPage": 2,
+				"startIndex": 1,
+				"Resources": [
+					{"id": "2819c223-7f76-453a-919d-413861904646", "userName": "Alex"},
+					{"id": "c75ad752-64ae-4823-840d-ffa80929976c", "userName": "Bacong"}
+				]
+			}`))
+		case r.URL.Query().Get("filter") == `(userType eq "Employee") and meta.lastModified ge "2026-01-01T00:00:00Z" `+
+			`and meta.lastModified le "2026-01-02T03:04:05Z"` && r.URL.Query().Get("startIndex") == "3":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{
+				"totalResults": 3,
+				"itemsPerPage": 1,
+				"startIndex": 3,
+				"Resources": [
+					{"id": "e2be737c-61f5-4abe-8797-1e816b15cec8", "userName": "Carol"}
+				]
+			}`))
+		case r.URL.RequestURI() == "/Users?startIndex=1&count=2":
+			TestServerHandler(w, r)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	scimClient := &scim.Datasource{
+		Client: &http.Client{
+			Timeout: time.Duration(60) * time.Second,
+		},
+		Now: func() time.Time {
+			return time.Date(2026, 1, 2, 4, 4, 5, 500000000, time.FixedZone("UTC+1", 3600))
+		},
+	}
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		filterSupported bool
+		request         *scim.Request
+		wantRes         *scim.AdapterResponse
+		wantErr         *framework.Error
+	}{
+		"first_page": {
+			filterSupported: true,
+			request: &scim.Request{
+				BaseURL:               server.URL,
+				RequestTimeoutSeconds: 5,
+
+				EntityExternalID:  scimUser,
+				PageSize:          2,
+				LastModifiedSince: &since,
+			},
+			wantRes: &scim.AdapterResponse{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]interface{}{
+					{"id": "2819c223-7f76-453a-919d-413861904646", "userName": "Alex"},
+					{"id": "c75ad752-64ae-4823-840d-ffa80929976c", "userName": "Bacong"},
+				},
+				// {"startIndex":3,"lastModifiedUntil":"2026-01-02T03:04:05Z"}
+				NextCursor: "eyJzdGFydEluZGV4IjozLCJsYXN0TW9kaWZpZWRVbnRpbCI6IjIwMjYtMDEtMDJUMDM6MDQ6MDVaIn0=",
+			},
+		},
+		"last_page_with_filter": {
+			filterSupported: true,
+			request: &scim.Request{
+				BaseURL:               server.URL,
+				RequestTimeoutSeconds: 5,
+
+				EntityExternalID:  scimUser,
+				PageSize:          2,
+				LastModifiedSince: &since,
+				Cursor:            "eyJzdGFydEluZGV4IjozLCJsYXN0TW9kaWZpZWRVbnRpbCI6IjIwMjYtMDEtMDJUMDM6MDQ6MDVaIn0=",
+				QueryParams: scim.QueryParams{
+					Filter: `userType eq "Employee"`,
+				},
+			},
+			wantRes: &scim.AdapterResponse{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]interface{}{
+					{"id": "e2be737c-61f5-4abe-8797-1e816b15cec8", "userName": "Carol"},
+				},
+				NextCursor: "",
+			},
+		},
+		"filter_not_supported_falls_back_to_full_sync": {
+			filterSupported: false,
+			request: &scim.Request{
+				BaseURL:               server.URL,
+				RequestTimeoutSeconds: 5,
+
+				EntityExternalID:  scimUser,
+				PageSize:          2,
+				LastModifiedSince: &since,
+			},
+			wantRes: &scim.AdapterResponse{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]interface{}{
+					{"id": "2819c223-7f76-453a-919d-413861904646", "userName": "Alex"},
+					{"id": "c75ad752-64ae-4823-840d-ffa80929976c", "userName": "Bacong"},
+				},
+				NextCursor: "3",
+			},
+		},
+		"incremental_cursor_in_full_sync": {
+			request: &scim.Request{
+				BaseURL:               server.URL,
+				RequestTimeoutSeconds: 5,
+
+				EntityExternalID: scimUser,
+				PageSize:         2,
+				Cursor:           "eyJzdGFydEluZGV4IjozLCJsYXN0TW9kaWZpZWRVbnRpbCI6IjIwMjYtMDEtMDJUMDM6MDQ6MDVaIn0=",
+			},
+			wantErr: &framework.Error{
+				Message: "Cursor of an incremental sync provided for a full sync.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"invalid_cursor": {
+			request: &scim.Request{
+				BaseURL:               server.URL,
+				RequestTimeoutSeconds: 5,
+
+				EntityExternalID:  scimUser,
+				PageSize:          2,
+				LastModifiedSince: &since,
+				Cursor:            "eyJzdGFydEluZGV4IjowfQ==", // {"startIndex":0}
+			},
+			wantErr: &framework.Error{
+				Message: `Invalid cursor for entity Users: startIndex must be greater than 0 and lastModifiedUntil must be set. ` +
+					`Expected cursor shape: {"startIndex":<int64>,"lastModifiedUntil":<RFC 3339 string>}. ` +
+					pagination.RestartSyncHint,
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			filterSupported = tt.filterSupported
+
+			gotRes, gotErr := scimClient.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotRes, tt.wantRes) {
+				t.Errorf("gotRes: %+v, wantRes: %+v", gotRes, tt.wantRes)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
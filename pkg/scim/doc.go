@@ -19,5 +19,16 @@ and to ignore the `members` attribute on the Group resource.
 
 Group members are ingested as child entities and a relationship is to be created between the
 child entity and the parent entity to allow traversal in snippets.
+
+## Incremental syncs
+
+If the sync mode of the config is INCREMENTAL, only the resources whose `meta.lastModified` attribute is
+between `incrementalSyncSince` and the start of the sync are requested, using a filter combined with the filter
+configured for the entity. The start of the sync is the watermark of the sync and is carried in the cursor,
+so that resources modified during the sync do not shift the pages already returned.
+
+As filtering is optional in SCIM, the `/ServiceProviderConfig` endpoint is requested on the first page to check
+whether the server supports filtering. If it does not, the sync falls back to a full sync. ETags are not used,
+as SCIM only defines them for individual resources, not for list responses.
 */
 package scim
@@ -0,0 +1,31 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package scim_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/scim"
+)
+
+// FuzzParseResponse checks that malformed SCIM list responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":2,"startIndex":1,"itemsPerPage":1,"Resources":[{"id":"2819c223-7f76-453a-919d-413861904646","userName":"bjensen"}]}`,
+		`{"totalResults":0,"startIndex":1,"itemsPerPage":0,"Resources":[]}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), int64(1))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, pageSize int64) {
+		_, _, err := scim.ParseResponse(body, pageSize)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
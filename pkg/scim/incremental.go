@@ -0,0 +1,144 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package scim
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// incrementalCursorShape is the expected JSON shape of an IncrementalCursor, used in cursor errors.
+const incrementalCursorShape = `{"startIndex":<int64>,"lastModifiedUntil":<RFC 3339 string>}`
+
+// IncrementalCursor is the cursor of an incremental sync. It is marshaled into JSON and then base64 encoded.
+// The cursor of a full sync is the start index of the next page, as a plain decimal string.
+type IncrementalCursor struct {
+	// StartIndex is the 1-based index of the first object of the page to return.
+	StartIndex int64 `json:"startIndex"`
+
+	// LastModifiedUntil is the watermark of the sync, i.e. the time at which the sync started.
+	// Objects modified after the watermark are left to the next sync, so that objects modified
+	// during the sync do not shift the pages already returned.
+	LastModifiedUntil time.Time `json:"lastModifiedUntil"`
+}
+
+// ServiceProviderConfig is the subset of the SCIM service provider configuration used by the adapter.
+// https://datatracker.ietf.org/doc/html/rfc7643#section-5
+type ServiceProviderConfig struct {
+	Filter struct {
+		Supported bool `json:"supported"`
+	} `json:"filter"`
+}
+
+// ParseCursor parses the cursor of a request. It returns the start index of the page to return and,
+// during an incremental sync, the watermark of the sync. An empty cursor is the first page.
+func ParseCursor(cursor string, entityExternalID string) (string, *time.Time, *framework.Error) {
+	if cursor == "" {
+		return "1", nil, nil
+	}
+
+	// Full syncs and incremental syncs falling back to full syncs use plain start indexes.
+	if _, err := strconv.ParseInt(cursor, 10, 64); err == nil {
+		return cursor, nil, nil
+	}
+
+	cursorBytes, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", nil, pagination.NewCursorError(
+			entityExternalID,
+			incrementalCursorShape,
+			fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var incrementalCursor IncrementalCursor
+
+	if err := json.Unmarshal(cursorBytes, &incrementalCursor); err != nil {
+		return "", nil, pagination.NewCursorError(
+			entityExternalID,
+			incrementalCursorShape,
+			fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	if incrementalCursor.StartIndex < 1 || incrementalCursor.LastModifiedUntil.IsZero() {
+		return "", nil, pagination.NewCursorError(
+			entityExternalID,
+			incrementalCursorShape,
+			"startIndex must be greater than 0 and lastModifiedUntil must be set",
+		)
+	}
+
+	return strconv.FormatInt(incrementalCursor.StartIndex, 10), &incrementalCursor.LastModifiedUntil, nil
+}
+
+// MarshalIncrementalCursor marshals the cursor of the next page of an incremental sync.
+func MarshalIncrementalCursor(startIndex string, lastModifiedUntil time.Time) string {
+	// startIndex is always formatted from an int64 by ParseResponse.
+	index, _ := strconv.ParseInt(startIndex, 10, 64)
+
+	cursorBytes, _ := json.Marshal(&IncrementalCursor{
+		StartIndex:        index,
+		LastModifiedUntil: lastModifiedUntil,
+	})
+
+	return base64.StdEncoding.EncodeToString(cursorBytes)
+}
+
+// LastModifiedFilter returns the filter expression restricting the objects to those modified between since and
+// until, both inclusive, combined with the filter configured for the entity, if any.
+func LastModifiedFilter(filter string, since, until time.Time) string {
+	lastModifiedFilter := fmt.Sprintf(
+		`meta.lastModified ge "%s" and meta.lastModified le "%s"`,
+		since.UTC().Format(time.RFC3339),
+		until.UTC().Format(time.RFC3339),
+	)
+
+	if filter == "" {
+		return lastModifiedFilter
+	}
+
+	return "(" + filter + ") and " + lastModifiedFilter
+}
+
+// filterSupported returns whether the SCIM SoR supports filtering, according to its service provider config.
+// Servers which do not expose their service provider config are assumed not to support filtering.
+func (d *Datasource) filterSupported(ctx context.Context, request *Request) (bool, error) {
+	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(apiCtx, http.MethodGet, request.BaseURL+"/ServiceProviderConfig", nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Add("Accept", "application/scim+json")
+	req.Header.Add("Authorization", request.AuthorizationHeader)
+
+	res, err := d.Client.Do(req)
+	if err != nil {
+		return false, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	var serviceProviderConfig ServiceProviderConfig
+
+	if err := json.NewDecoder(res.Body).Decode(&serviceProviderConfig); err != nil {
+		return false, err
+	}
+
+	return serviceProviderConfig.Filter.Supported, nil
+}
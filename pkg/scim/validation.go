@@ -3,6 +3,8 @@
 package scim
 
 import (
+	"fmt"
+
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 
@@ -11,6 +13,15 @@ import (
 
 // ValidateGetPageRequest validates the fields of the GetPage Request.
 func (a *Adapter) ValidateGetPageRequest(request *framework.Request[Config]) *framework.Error {
+	if request.Config != nil {
+		if err := request.Config.CommonConfig.ValidateSyncMode(); err != nil {
+			return &framework.Error{
+				Message: fmt.Sprintf("SCIM config is invalid: %v.", err.Error()),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+	}
+
 	trimmedAddress, parsed, err := validation.ParseAndValidateAddress(request.Address, []string{"https"})
 	if err != nil {
 		return err
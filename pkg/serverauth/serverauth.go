@@ -0,0 +1,260 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package serverauth authenticates the callers of the adapter gRPC servers with mutual TLS.
+//
+// The adapter framework already requires each GetPage request to carry one of the shared tokens
+// listed in the file at AUTH_TOKENS_PATH. Mutual TLS additionally requires callers to present a
+// client certificate issued by a trusted CA, and optionally restricts the accepted callers to a list
+// of identities, so that a leaked token alone doesn't grant access to the adapter.
+package serverauth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Config configures mutual TLS on an adapter gRPC server.
+type Config struct {
+	// CertPath is the path of the PEM encoded certificate chain of the server.
+	CertPath string
+
+	// KeyPath is the path of the PEM encoded private key of the server.
+	KeyPath string
+
+	// ClientCAPath is the path of the PEM encoded certificates of the CAs issuing client certificates.
+	ClientCAPath string
+
+	// AllowedClientIdentities restricts the accepted client certificates to the ones with a matching
+	// identity, i.e. subject common name, DNS name or URI (e.g. a SPIFFE ID) SAN.
+	// If empty, any client certificate issued by a trusted CA is accepted.
+	AllowedClientIdentities []string
+}
+
+// Enabled returns true if mutual TLS is configured.
+func (c *Config) Enabled() bool {
+	return c != nil && (c.CertPath != "" || c.KeyPath != "" || c.ClientCAPath != "")
+}
+
+// Validate returns an error if mutual TLS is partially configured.
+func (c *Config) Validate() error {
+	switch {
+	case c.CertPath == "":
+		return errors.New("server certificate path is not set")
+	case c.KeyPath == "":
+		return errors.New("server key path is not set")
+	case c.ClientCAPath == "":
+		return errors.New("client CA path is not set")
+	default:
+		return nil
+	}
+}
+
+// ParseIdentities parses a comma separated list of client identities.
+func ParseIdentities(s string) []string {
+	var identities []string
+
+	for identity := range strings.SplitSeq(s, ",") {
+		if identity = strings.TrimSpace(identity); identity != "" {
+			identities = append(identities, identity)
+		}
+	}
+
+	return identities
+}
+
+// ServerOptions returns the gRPC server options enabling mutual TLS. Returns no options if mutual TLS
+// is not configured, in which case the server accepts plaintext connections.
+//
+// The certificates are read again when their files are modified, so that they can be rotated without
+// restarting the server.
+func ServerOptions(cfg *Config) ([]grpc.ServerOption, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	reloader := &tlsConfigReloader{cfg: cfg}
+
+	// Load the certificates once upfront to fail fast on invalid files.
+	if _, err := reloader.TLSConfig(); err != nil {
+		return nil, err
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+			GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+				return reloader.TLSConfig()
+			},
+		})),
+	}
+
+	if len(cfg.AllowedClientIdentities) > 0 {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(UnaryServerInterceptor(cfg.AllowedClientIdentities)),
+			grpc.ChainStreamInterceptor(StreamServerInterceptor(cfg.AllowedClientIdentities)),
+		)
+	}
+
+	return opts, nil
+}
+
+// UnaryServerInterceptor returns an interceptor rejecting the unary calls of clients whose verified
+// certificate has none of the allowed identities.
+func UnaryServerInterceptor(allowedIdentities []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorize(ctx, allowedIdentities); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor rejecting the streaming calls of clients whose verified
+// certificate has none of the allowed identities.
+func StreamServerInterceptor(allowedIdentities []string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), allowedIdentities); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// ClientIdentities returns the identities of a client certificate: its subject common name, DNS names
+// and URIs.
+func ClientIdentities(cert *x509.Certificate) []string {
+	identities := make([]string, 0, 1+len(cert.DNSNames)+len(cert.URIs))
+
+	if cert.Subject.CommonName != "" {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+
+	identities = append(identities, cert.DNSNames...)
+
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	return identities
+}
+
+func authorize(ctx context.Context, allowedIdentities []string) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing peer information")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return status.Error(codes.Unauthenticated, "missing verified client certificate")
+	}
+
+	for _, identity := range ClientIdentities(tlsInfo.State.VerifiedChains[0][0]) {
+		if slices.Contains(allowedIdentities, identity) {
+			return nil
+		}
+	}
+
+	return status.Error(codes.PermissionDenied, "client certificate identity is not allowed")
+}
+
+// tlsConfigReloader builds the server TLS config from the configured files, and builds it again
+// when any of the files is modified.
+type tlsConfigReloader struct {
+	cfg *Config
+
+	mu        sync.Mutex
+	tlsConfig *tls.Config
+	modTimes  [3]time.Time
+}
+
+// TLSConfig returns the TLS config built from the current content of the configured files. If the
+// files can't be loaded after having been modified, e.g. while being rotated, the previous TLS config
+// is returned until they can.
+func (r *tlsConfigReloader) TLSConfig() (*tls.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	modTimes, err := r.modTimesOf()
+	if err == nil && r.tlsConfig != nil && modTimes == r.modTimes {
+		return r.tlsConfig, nil
+	}
+
+	var tlsConfig *tls.Config
+
+	if err == nil {
+		tlsConfig, err = r.load()
+	}
+
+	if err != nil {
+		if r.tlsConfig != nil {
+			return r.tlsConfig, nil
+		}
+
+		return nil, err
+	}
+
+	r.tlsConfig = tlsConfig
+	r.modTimes = modTimes
+
+	return tlsConfig, nil
+}
+
+func (r *tlsConfigReloader) modTimesOf() ([3]time.Time, error) {
+	var modTimes [3]time.Time
+
+	for i, path := range []string{r.cfg.CertPath, r.cfg.KeyPath, r.cfg.ClientCAPath} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return modTimes, err
+		}
+
+		modTimes[i] = info.ModTime()
+	}
+
+	return modTimes, nil
+}
+
+func (r *tlsConfigReloader) load() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertPath, r.cfg.KeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	clientCAs, err := os.ReadFile(r.cfg.ClientCAPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA certificates: %w", err)
+	}
+
+	clientCAPool := x509.NewCertPool()
+	if !clientCAPool.AppendCertsFromPEM(clientCAs) {
+		return nil, errors.New("failed to parse client CA certificates")
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAPool,
+	}, nil
+}
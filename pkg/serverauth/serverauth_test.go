@@ -0,0 +1,285 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package serverauth_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sgnl-ai/adapters/pkg/serverauth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+func newTestCert(t *testing.T, template *x509.Certificate, issuer *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	parent, signer := template, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key}
+}
+
+func writePEM(t *testing.T, path, blockType string, data []byte) {
+	t.Helper()
+
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+// newMTLSServer starts a gRPC server serving the health service with mutual TLS, and returns its address.
+func newMTLSServer(t *testing.T, ca *testCert, allowedIdentities []string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	serverCert := newTestCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "adapter"},
+		DNSNames:    []string{"localhost"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+
+	serverKey, err := x509.MarshalECPrivateKey(serverCert.key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	cfg := &serverauth.Config{
+		CertPath:                filepath.Join(dir, "tls.crt"),
+		KeyPath:                 filepath.Join(dir, "tls.key"),
+		ClientCAPath:            filepath.Join(dir, "ca.crt"),
+		AllowedClientIdentities: allowedIdentities,
+	}
+
+	writePEM(t, cfg.CertPath, "CERTIFICATE", serverCert.der)
+	writePEM(t, cfg.KeyPath, "EC PRIVATE KEY", serverKey)
+	writePEM(t, cfg.ClientCAPath, "CERTIFICATE", ca.der)
+
+	opts, err := serverauth.ServerOptions(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	s := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(s, health.NewServer())
+
+	go s.Serve(listener)
+
+	t.Cleanup(s.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestServerOptionsMutualTLS(t *testing.T) {
+	ca := newTestCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+	otherCA := newTestCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "other-ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+
+	spiffeID, _ := url.Parse("spiffe://sgnl.ai/ingestion")
+
+	clientCert := func(commonName string, issuer *testCert, uris ...*url.URL) *testCert {
+		return newTestCert(t, &x509.Certificate{
+			Subject:     pkix.Name{CommonName: commonName},
+			URIs:        uris,
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}, issuer)
+	}
+
+	tests := map[string]struct {
+		allowedIdentities []string
+		clientCert        *testCert
+		wantCode          codes.Code
+	}{
+		"trusted_client": {
+			clientCert: clientCert("ingestion", ca),
+			wantCode:   codes.OK,
+		},
+		"allowed_common_name": {
+			allowedIdentities: []string{"ingestion"},
+			clientCert:        clientCert("ingestion", ca),
+			wantCode:          codes.OK,
+		},
+		"allowed_uri": {
+			allowedIdentities: []string{"spiffe://sgnl.ai/ingestion"},
+			clientCert:        clientCert("", ca, spiffeID),
+			wantCode:          codes.OK,
+		},
+		"identity_not_allowed": {
+			allowedIdentities: []string{"ingestion"},
+			clientCert:        clientCert("other", ca),
+			wantCode:          codes.PermissionDenied,
+		},
+		"untrusted_client": {
+			clientCert: clientCert("ingestion", otherCA),
+			wantCode:   codes.Unavailable,
+		},
+		"missing_client_certificate": {
+			wantCode: codes.Unavailable,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			address := newMTLSServer(t, ca, tt.allowedIdentities)
+
+			rootCAs := x509.NewCertPool()
+			rootCAs.AddCert(ca.cert)
+
+			tlsConfig := &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				RootCAs:    rootCAs,
+				ServerName: "localhost",
+			}
+
+			if tt.clientCert != nil {
+				tlsConfig.Certificates = []tls.Certificate{tt.clientCert.tlsCertificate()}
+			}
+
+			conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			defer conn.Close()
+
+			_, err = healthpb.NewHealthClient(conn).Check(t.Context(), &healthpb.HealthCheckRequest{})
+
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("got code: %v, want: %v (err: %v)", got, tt.wantCode, err)
+			}
+		})
+	}
+}
+
+func TestServerOptions(t *testing.T) {
+	tests := map[string]struct {
+		config   *serverauth.Config
+		wantOpts bool
+		wantErr  bool
+	}{
+		"nil_config": {
+			config: nil,
+		},
+		"disabled": {
+			config: &serverauth.Config{},
+		},
+		"missing_client_ca_path": {
+			config: &serverauth.Config{
+				CertPath: "tls.crt",
+				KeyPath:  "tls.key",
+			},
+			wantErr: true,
+		},
+		"missing_files": {
+			config: &serverauth.Config{
+				CertPath:     "/does/not/exist/tls.crt",
+				KeyPath:      "/does/not/exist/tls.key",
+				ClientCAPath: "/does/not/exist/ca.crt",
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotOpts, gotErr := serverauth.ServerOptions(tt.config)
+
+			if (gotErr != nil) != tt.wantErr {
+				t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if (len(gotOpts) != 0) != tt.wantOpts {
+				t.Errorf("got %d options, wantOpts: %v", len(gotOpts), tt.wantOpts)
+			}
+		})
+	}
+}
+
+func TestParseIdentities(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		want  []string
+	}{
+		"empty": {
+			value: "",
+			want:  nil,
+		},
+		"single": {
+			value: "ingestion",
+			want:  []string{"ingestion"},
+		},
+		"multiple_with_spaces": {
+			value: " ingestion, spiffe://sgnl.ai/ingestion ,,",
+			want:  []string{"ingestion", "spiffe://sgnl.ai/ingestion"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := serverauth.ParseIdentities(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/sgnl-ai/adapters/pkg/auth"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -66,12 +67,14 @@ func (a *Adapter) RequestPageFromDatasource(
 	servicenowReq := &Request{
 		BaseURL:               request.Address,
 		AuthorizationHeader:   authorizationHeader,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		EntityExternalID:      request.Entity.ExternalId,
 		APIVersion:            request.Config.APIVersion,
 		Attributes:            request.Entity.Attributes,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
 		CustomURLPath:         request.Config.CustomURLPath,
+		QueryNoDomain:         request.Config.QueryNoDomain,
+		KeysetPagination:      request.Config.KeysetPagination,
 	}
 
 	var (
@@ -105,7 +108,33 @@ func (a *Adapter) RequestPageFromDatasource(
 		}
 	}
 
-	if usingImplicitFilters {
+	// The filters are validated at the start of the sync of the entity, as they don't change during the sync.
+	if request.Config.ValidateFilters && request.Cursor == "" && !usingImplicitFilters && !usingRelatedFilters {
+		tables := []string{request.Entity.ExternalId}
+		if request.Entity.ExternalId == TableCount {
+			tables = request.Config.CountTables
+		}
+
+		if err := a.ValidateFilters(ctx, *servicenowReq, tables, request.Config.Filters); err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+	}
+
+	if request.Config.Explain {
+		// The datasource isn't queried: the URL of the first request is logged and an empty page is returned.
+		a = &Adapter{ServicenowClient: explainClient{}}
+	}
+
+	if request.Entity.ExternalId == TableCount {
+		// The incremental sync condition is also applied to the counts, so that they match the number of
+		// objects returned for the tables during the same sync.
+		servicenowReq.UpdatedSince = commonConfig.ChangedSince()
+
+		resp, err = a.GetTableCountPage(ctx, request.Config, *servicenowReq)
+		if err != nil {
+			return framework.NewGetPageResponseError(err)
+		}
+	} else if usingImplicitFilters {
 		resp, err = a.GetPageUsingImplicitFilters(ctx, implicitFilters[request.Entity.ExternalId], *servicenowReq)
 		if err != nil {
 			return framework.NewGetPageResponseError(err)
@@ -122,7 +151,16 @@ func (a *Adapter) RequestPageFromDatasource(
 			}
 		}
 
-		resp, err = a.ServicenowClient.GetPage(ctx, servicenowReq)
+		// Incremental syncs are only supported for entities without advanced filters, as the scope entities
+		// of advanced filters must always be fully retrieved to determine the members in scope.
+		servicenowReq.UpdatedSince = commonConfig.ChangedSince()
+
+		if len(request.Config.Domains) > 0 {
+			resp, err = a.GetPageByDomain(ctx, request.Config.Domains, request.Cursor, *servicenowReq)
+		} else {
+			resp, err = a.ServicenowClient.GetPage(ctx, servicenowReq)
+		}
+
 		if err != nil {
 			return framework.NewGetPageResponseError(err)
 		}
@@ -134,6 +172,12 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	if request.Config.Explain {
+		return framework.NewGetPageResponseSuccess(&framework.Page{})
+	}
+
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// Nested attributes are flattened and delimited by the delimiter specified.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
@@ -217,6 +261,8 @@ func (a *Adapter) GetFilteredEntities(
 		Filter:                &filter.ScopeEntityFilter,
 		APIVersion:            baseReq.APIVersion,
 		RequestTimeoutSeconds: baseReq.RequestTimeoutSeconds,
+		QueryNoDomain:         baseReq.QueryNoDomain,
+		KeysetPagination:      baseReq.KeysetPagination,
 	}
 
 	if filterCursor != nil && filterCursor.Cursor != nil && filterCursor.Cursor.CollectionCursor != nil {
@@ -259,6 +305,8 @@ func (a *Adapter) GetFilteredEntities(
 		PageSize:              baseReq.PageSize,
 		APIVersion:            baseReq.APIVersion,
 		RequestTimeoutSeconds: baseReq.RequestTimeoutSeconds,
+		QueryNoDomain:         baseReq.QueryNoDomain,
+		KeysetPagination:      baseReq.KeysetPagination,
 	}
 
 	if filterCursor != nil && filterCursor.Cursor != nil && filterCursor.Cursor.Cursor != nil {
@@ -478,6 +526,8 @@ func (a *Adapter) GetPageUsingRelatedFilters(
 		Filter:                &updatedFilter,
 		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
 		Attributes:            request.Attributes,
+		QueryNoDomain:         request.QueryNoDomain,
+		KeysetPagination:      request.KeysetPagination,
 	}
 
 	if advancedFilterCursor.RelatedFilterCursor.EntityCursor != nil {
@@ -1275,3 +1275,107 @@ func TestAdapterGetPageWithAdvancedFilters(t *testing.T) {
 		})
 	}
 }
+
+func TestAdapterGetTableCountPage(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/api/now/stats/sys_user?sysparm_count=true&sysparm_query=active%3Dtrue":
+			w.Write([]byte(`{"result": {"stats": {"count": "1250"}}}`))
+		case "/api/now/stats/incident?sysparm_count=true":
+			w.Write([]byte(`{"result": {"stats": {"count": "87"}}}`))
+		case "/api/now/stats/change_request?sysparm_count=true":
+			w.Write([]byte(`{"result": {"stats": {"count": "0"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	adapter := servicenow_adapter.NewAdapter(&servicenow_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	newRequest := func(cursor string) *framework.Request[servicenow_adapter.Config] {
+		return &framework.Request[servicenow_adapter.Config]{
+			Address: server.URL,
+			Auth: &framework.DatasourceAuthCredentials{
+				Basic: &framework.BasicAuthCredentials{
+					Username: "username",
+					Password: "password",
+				},
+			},
+			Config: &servicenow_adapter.Config{
+				APIVersion: "v2",
+				Filters: map[string]string{
+					"sys_user": "active=true",
+				},
+				CountTables: []string{"sys_user", "incident", "change_request"},
+			},
+			Entity: framework.EntityConfig{
+				ExternalId: "table_count",
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "table",
+						Type:       framework.AttributeTypeString,
+						UniqueId:   true,
+					},
+					{
+						ExternalId: "count",
+						Type:       framework.AttributeTypeInt64,
+					},
+				},
+			},
+			PageSize: 2,
+			Cursor:   cursor,
+		}
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[servicenow_adapter.Config]
+		wantResponse framework.Response
+	}{
+		"first_page": {
+			request: newRequest(""),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"table": "sys_user", "count": int64(1250)},
+						{"table": "incident", "count": int64(87)},
+					},
+					NextCursor: "2",
+				},
+			},
+		},
+		"last_page": {
+			request: newRequest("2"),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"table": "change_request", "count": int64(0)},
+					},
+				},
+			},
+		},
+		"invalid_cursor": {
+			request: newRequest("3"),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: `Invalid cursor for entity table_count: "3" is not the index of a table in countTables. ` +
+						"Expected cursor shape: <int64 between 0 and 2>. " +
+						"Restart the sync for this entity to discard the invalid cursor.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+		})
+	}
+}
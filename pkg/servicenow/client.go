@@ -4,6 +4,7 @@ package servicenow
 
 import (
 	"context"
+	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 )
@@ -11,6 +12,9 @@ import (
 // Client is a client that allows querying the Servicenow datasource which contains JSON objects.
 type Client interface {
 	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+
+	// GetCount returns the number of rows of the table of the request, using the Aggregate API.
+	GetCount(ctx context.Context, request *Request) (*CountResponse, *framework.Error)
 }
 
 // Request is a request to Servicenow.
@@ -36,6 +40,10 @@ type Request struct {
 	// Filter contains the optional filter to apply to the current request.
 	Filter *string
 
+	// UpdatedSince restricts the returned objects to those updated since this time during an
+	// incremental sync. nil during a full backfill.
+	UpdatedSince *time.Time
+
 	// Attributes contains the list of attributes to request along with the current request.
 	Attributes []*framework.AttributeConfig
 
@@ -48,6 +56,21 @@ type Request struct {
 
 	// CustomURLPath is an optional custom URL path to use instead of the default /api/now path.
 	CustomURLPath string
+
+	// QueryNoDomain sets sysparm_query_no_domain to query the rows of all the domains the user has access to
+	// on domain-separated instances, instead of only the rows of the domain of the user.
+	QueryNoDomain bool
+
+	// Domain is the sys_id of the domain to restrict the rows to on domain-separated instances.
+	// nil to not restrict the rows to a domain.
+	Domain *string
+
+	// KeysetPagination pages through the rows by sys_id instead of by offset. See Config.KeysetPagination.
+	KeysetPagination bool
+
+	// AfterSysID restricts the returned rows to those with a sys_id greater than this one, i.e. the rows after
+	// the last row of the previous page when KeysetPagination is set. nil for the first page.
+	AfterSysID *string
 }
 
 // Response is a response returned by the datasource.
@@ -66,3 +89,15 @@ type Response struct {
 	// nil if this is the last page in this full sync.
 	NextCursor *string
 }
+
+// CountResponse is a response of the Aggregate API returned by the datasource.
+type CountResponse struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Count is the number of rows of the table matching the query of the request.
+	Count int64
+}
@@ -24,7 +24,14 @@ var supportedAPIVersions = map[string]struct{}{
     "apiVersion": "v2",
     "filters": {
         "incident": "active=true^priority=1"
-    }
+    },
+    "countTables": ["sys_user", "incident"],
+    "syncMode": "INCREMENTAL",
+    "incrementalSyncSince": "2026-01-01T00:00:00Z",
+    "domains": ["c90d4b084a362312013398f051272c0d", "5d643c6a3771300054b6a3549dbe5db0"],
+    "validateFilters": true,
+    "explain": false,
+    "keysetPagination": true
 }
 */
 type Config struct {
@@ -42,9 +49,43 @@ type Config struct {
 	// See advanced_filters.go for more information.
 	AdvancedFilters *AdvancedFilters `json:"advancedFilters,omitempty"`
 
+	// CountTables is the list of tables whose rows are counted by the table_count entity, e.g. to detect
+	// truncated syncs or dramatic drops in the number of objects of an entity. The filter of each table in
+	// Filters, if any, is applied to its count.
+	CountTables []string `json:"countTables,omitempty"`
+
 	// CustomURLPath is an optional custom URL path to use instead of the default /api/now path.
 	// If not specified, the default "/api/now" path will be used.
 	CustomURLPath string `json:"customURLPath,omitempty"`
+
+	// QueryNoDomain queries the rows of all the domains the integration user has access to on domain-separated
+	// instances, e.g. the instances of managed service providers, instead of only the rows of the domain of the
+	// user, by setting the sysparm_query_no_domain parameter of each request.
+	QueryNoDomain bool `json:"queryNoDomain,omitempty"`
+
+	// Domains is the optional list of the sys_ids of the domains to query on domain-separated instances.
+	// If set, the rows of each entity are queried one domain after the other, in order, instead of all at
+	// once, and QueryNoDomain is implied. Entities with advanced filters and the table_count entity are not
+	// queried per domain.
+	Domains []string `json:"domains,omitempty"`
+
+	// ValidateFilters validates the filters in Filters at the start of the sync of each entity, i.e. on its first
+	// page, instead of letting ServiceNow ignore the conditions on unknown fields and return the whole table.
+	// The fields referenced by the filter of the table of the entity are looked up in the dictionary
+	// (sys_dictionary) with the tables it extends (sys_db_object), so the integration user must be able to read
+	// both tables. For the table_count entity, the filters of all the tables of CountTables are validated.
+	ValidateFilters bool `json:"validateFilters,omitempty"`
+
+	// Explain is a dry-run mode: instead of querying the rows of each entity, the URL of the first request of the
+	// entity is logged and an empty page is returned. The filters are still validated if ValidateFilters is set.
+	Explain bool `json:"explain,omitempty"`
+
+	// KeysetPagination pages through the rows of each entity by sys_id instead of by offset: each page after the
+	// first one requests the rows with a sys_id greater than the last sys_id of the previous page, in sys_id
+	// order, instead of following the sysparm_offset link returned by ServiceNow. Offset pages are shifted by the
+	// rows inserted or deleted during the sync, which skips or duplicates rows, and get slower as the offset grows
+	// on tables with millions of rows.
+	KeysetPagination bool `json:"keysetPagination,omitempty"`
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
@@ -53,6 +94,24 @@ func (c *Config) Validate(_ context.Context) error {
 		return errors.New("request contains no config")
 	}
 
+	if err := c.CommonConfig.ValidateSyncMode(); err != nil {
+		return err
+	}
+
+	domains := make(map[string]struct{}, len(c.Domains))
+
+	for _, domain := range c.Domains {
+		if domain == "" {
+			return errors.New("domains must not contain empty values")
+		}
+
+		if _, found := domains[domain]; found {
+			return fmt.Errorf("domains must not contain duplicate values: %v", domain)
+		}
+
+		domains[domain] = struct{}{}
+	}
+
 	// Only validate apiVersion if it's supplied
 	if c.APIVersion != "" {
 		if _, found := supportedAPIVersions[c.APIVersion]; !found {
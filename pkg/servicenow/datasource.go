@@ -14,9 +14,10 @@ import (
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 	customerror "github.com/sgnl-ai/adapters/pkg/errors"
-	"github.com/sgnl-ai/adapters/pkg/extractor"
+	"github.com/sgnl-ai/adapters/pkg/jsonstream"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
 	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
 	"go.uber.org/zap"
 )
 
@@ -35,10 +36,6 @@ type Datasource struct {
 	Client *http.Client
 }
 
-type DatasourceResponse struct {
-	Result []map[string]any `json:"result"`
-}
-
 type DatasourceErrorResponse struct {
 	Error struct {
 		Message string `json:"message"`
@@ -95,6 +92,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -105,18 +103,18 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		RetryAfterHeader: res.Header.Get("Retry-After"),
 	}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to read response (%d): %v.", res.StatusCode, err),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
-	}
-
 	// Edge case: If the `sysparm_query` parameter is too large and the page size is too small,
 	// ServiceNow will return a 400 Bad Request with a message "Pagination not supported" and the reason.
 	// We need to surface this error to the user.
 	if res.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Failed to read response (%d): %v.", res.StatusCode, err),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
 		logger.Error("Datasource responded with an error",
 			fields.RequestURL(endpoint),
 			fields.ResponseStatusCode(res.StatusCode),
@@ -143,15 +141,21 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		return response, nil
 	}
 
-	objects, frameworkErr := ParseResponse(body)
+	objects, frameworkErr := ParseResponse(res.Body)
 	if frameworkErr != nil {
 		return nil, frameworkErr
 	}
 
 	response.Objects = objects
 
-	if cursor := extractor.ValueFromList(res.Header.Values("Link"), "https://", ">;rel=\"next\""); cursor != "" {
-		response.NextCursor = &cursor
+	if nextCursor := pagination.GetNextCursorFromLinkHeader(res.Header.Values("Link")); nextCursor != nil {
+		response.NextCursor = nextCursor.Cursor
+
+		if request.KeysetPagination {
+			if keysetCursor := nextKeysetCursor(request, objects); keysetCursor != nil {
+				response.NextCursor = keysetCursor
+			}
+		}
 	}
 
 	logger.Info("Datasource request completed successfully",
@@ -163,15 +167,38 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	return response, nil
 }
 
-func ParseResponse(body []byte) ([]map[string]any, *framework.Error) {
-	var data *DatasourceResponse
+// nextKeysetCursor returns the URL of the page after the given objects with keyset pagination, i.e. of the rows
+// with a sys_id greater than the sys_id of the last object. The Link header still tells whether there is a next
+// page. Returns nil if the last object has no sys_id, e.g. if ACLs removed all the rows of the page, in which
+// case the offset link is followed instead.
+func nextKeysetCursor(request *Request, objects []map[string]any) *string {
+	if len(objects) == 0 {
+		return nil
+	}
+
+	lastSysID, _ := objects[len(objects)-1][uniqueIDAttribute].(string)
+	if lastSysID == "" {
+		return nil
+	}
+
+	nextRequest := *request
+	nextRequest.Cursor = nil
+	nextRequest.AfterSysID = &lastSysID
+
+	nextCursor := ConstructEndpoint(&nextRequest)
+
+	return &nextCursor
+}
 
-	if unmarshalErr := json.Unmarshal(body, &data); unmarshalErr != nil {
+// ParseResponse decodes the objects in the `result` member of a Table API response.
+func ParseResponse(body io.Reader) ([]map[string]any, *framework.Error) {
+	objects, _, decodeErr := jsonstream.DecodeList(body, "result")
+	if decodeErr != nil {
 		return nil, &framework.Error{
-			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", unmarshalErr),
+			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", decodeErr),
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		}
 	}
 
-	return data.Result, nil
+	return objects, nil
 }
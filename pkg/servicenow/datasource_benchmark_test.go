@@ -0,0 +1,62 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package servicenow_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/servicenow"
+)
+
+// BenchmarkParseResponse benchmarks parsing a multi-MB page of the sys_user table, to catch performance
+// regressions in the parsing of the responses.
+//
+//	go test -run '^$' -bench BenchmarkParse -benchmem ./pkg/servicenow/
+func BenchmarkParseResponse(b *testing.B) {
+	body := generateUsersPage(5000)
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		objects, err := servicenow.ParseResponse(bytes.NewReader(body))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if len(objects) != 5000 {
+			b.Fatalf("got %d objects, want 5000", len(objects))
+		}
+	}
+}
+
+// generateUsersPage returns a Table API response of the sys_user table with objectCount users.
+func generateUsersPage(objectCount int) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(`{"result":[`)
+
+	for i := range objectCount {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		fmt.Fprintf(&buf,
+			`{"sys_id":"%032x","user_name":"user%d","first_name":"First%d","last_name":"Last%d","name":"First%d Last%d",`+
+				`"email":"user%d@example.com","active":"true","locked_out":"false","title":"Engineer","phone":"",`+
+				`"sys_created_on":"2026-01-02 03:04:05","sys_updated_on":"2026-03-04 05:06:07","sys_created_by":"admin",`+
+				`"sys_updated_by":"admin","sys_mod_count":"%d","employee_number":"%d",`+
+				`"department":{"link":"https://test-instance.service-now.com/api/now/v2/table/cmn_department/%032x","value":"%032x"},`+
+				`"manager":{"link":"https://test-instance.service-now.com/api/now/v2/table/sys_user/%032x","value":"%032x"},`+
+				`"location":{"link":"https://test-instance.service-now.com/api/now/v2/table/cmn_location/%032x","value":"%032x"}}`,
+			i, i, i, i, i, i, i, i%10, i, i%50, i%50, i%100, i%100, i%20, i%20,
+		)
+	}
+
+	buf.WriteString(`]}`)
+
+	return buf.Bytes()
+}
@@ -4,6 +4,7 @@
 package servicenow_test
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 	"net/http/httptest"
@@ -688,7 +689,7 @@ func TestParseResponse(t *testing.T) {
 			entityExternalID: "sys_user",
 			wantNextCursor:   nil,
 			wantErr: testutil.GenPtr(framework.Error{
-				Message: "Failed to unmarshal the datasource response: json: cannot unmarshal object into Go struct field DatasourceResponse.result of type []map[string]interface {}.",
+				Message: "Failed to unmarshal the datasource response: expected start of JSON array but found {.",
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 			}),
 		},
@@ -697,7 +698,7 @@ func TestParseResponse(t *testing.T) {
 			entityExternalID: "sys_user",
 			wantNextCursor:   nil,
 			wantErr: testutil.GenPtr(framework.Error{
-				Message: `Failed to unmarshal the datasource response: json: cannot unmarshal string into Go struct field DatasourceResponse.result of type map[string]interface {}.`,
+				Message: `Failed to unmarshal the datasource response: json: cannot unmarshal string into Go value of type map[string]interface {}.`,
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 			}),
 		},
@@ -714,7 +715,7 @@ func TestParseResponse(t *testing.T) {
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotObjects, gotErr := servicenow.ParseResponse(tt.body)
+			gotObjects, gotErr := servicenow.ParseResponse(bytes.NewReader(tt.body))
 
 			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
 				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
@@ -929,3 +930,91 @@ func TestGetUsersPage(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPageWithKeysetPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/api/now/v2/table/sys_user?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=2&sysparm_query=active%3Dtrue%5EORDERBYsys_id":
+			w.Header().Set("Link", `<https://localhost/api/now/v2/table/sys_user?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=2&sysparm_query=active%3Dtrue%5EORDERBYsys_id&sysparm_offset=2>;rel="next"`)
+			w.Write([]byte(`{"result": [{"sys_id": "9a826bf03710200044e0bfc8bcbe5dd1"}, {"sys_id": "a2826bf03710200044e0bfc8bcbe5ddb"}]}`))
+		case "/api/now/v2/table/sys_user?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=2&sysparm_query=active%3Dtrue%5Esys_id%3Ea2826bf03710200044e0bfc8bcbe5ddb%5EORDERBYsys_id":
+			w.Header().Set("Link", `<https://localhost/api/now/v2/table/sys_user?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=2&sysparm_query=active%3Dtrue%5Esys_id%3Ea2826bf03710200044e0bfc8bcbe5ddb%5EORDERBYsys_id&sysparm_offset=2>;rel="next"`)
+			w.Write([]byte(`{"result": []}`))
+		case "/api/now/v2/table/sys_user?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=2&sysparm_query=active%3Dtrue%5Esys_id%3Eaa826bf03710200044e0bfc8bcbe5ddf%5EORDERBYsys_id":
+			w.Write([]byte(`{"result": [{"sys_id": "cf1ec0b4530360100999ddeeff7b129f"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	servicenowClient := servicenow.NewClient(server.Client())
+
+	newRequest := func(cursor *string) *servicenow.Request {
+		return &servicenow.Request{
+			RequestTimeoutSeconds: 5,
+			AuthorizationHeader:   "Bearer testtoken",
+			BaseURL:               server.URL,
+			EntityExternalID:      "sys_user",
+			PageSize:              2,
+			APIVersion:            "v2",
+			Attributes: []*framework.AttributeConfig{
+				{
+					ExternalId: "sys_id",
+					Type:       framework.AttributeTypeString,
+				},
+			},
+			Filter:           testutil.GenPtr("active=true"),
+			KeysetPagination: true,
+			Cursor:           cursor,
+		}
+	}
+
+	tests := map[string]struct {
+		request *servicenow.Request
+		wantRes *servicenow.Response
+	}{
+		"first_page": {
+			request: newRequest(nil),
+			wantRes: &servicenow.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"sys_id": "9a826bf03710200044e0bfc8bcbe5dd1"},
+					{"sys_id": "a2826bf03710200044e0bfc8bcbe5ddb"},
+				},
+				NextCursor: testutil.GenPtr(server.URL + "/api/now/v2/table/sys_user?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=2&sysparm_query=active%3Dtrue%5Esys_id%3Ea2826bf03710200044e0bfc8bcbe5ddb%5EORDERBYsys_id"),
+			},
+		},
+		"empty_page_follows_offset_link": {
+			request: newRequest(testutil.GenPtr(server.URL + "/api/now/v2/table/sys_user?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=2&sysparm_query=active%3Dtrue%5Esys_id%3Ea2826bf03710200044e0bfc8bcbe5ddb%5EORDERBYsys_id")),
+			wantRes: &servicenow.Response{
+				StatusCode: http.StatusOK,
+				Objects:    []map[string]any{},
+				NextCursor: testutil.GenPtr("https://localhost/api/now/v2/table/sys_user?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=2&sysparm_query=active%3Dtrue%5Esys_id%3Ea2826bf03710200044e0bfc8bcbe5ddb%5EORDERBYsys_id&sysparm_offset=2"),
+			},
+		},
+		"last_page": {
+			request: newRequest(testutil.GenPtr(server.URL + "/api/now/v2/table/sys_user?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=2&sysparm_query=active%3Dtrue%5Esys_id%3Eaa826bf03710200044e0bfc8bcbe5ddf%5EORDERBYsys_id")),
+			wantRes: &servicenow.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"sys_id": "cf1ec0b4530360100999ddeeff7b129f"},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := servicenowClient.GetPage(context.Background(), tt.request)
+
+			if gotErr != nil {
+				t.Fatalf("gotErr: %v, wantErr: nil", gotErr)
+			}
+
+			if !reflect.DeepEqual(gotRes, tt.wantRes) {
+				t.Errorf("gotRes: %v, wantRes: %v", gotRes, tt.wantRes)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package servicenow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// GetPageByDomain retrieves a page of the entity of the request from one of the domains of a domain-separated
+// instance. The domains are queried one after the other, in order.
+//
+// The cursor is a CompositeCursor whose CollectionID is the index of the domain in domains, and Cursor is the
+// ServiceNow cursor of the next page within the domain, if any. For example, the cursor of the first page of
+// the second domain is:
+//
+//	{
+//	    "collectionId": "1"
+//	}
+func (a *Adapter) GetPageByDomain(
+	ctx context.Context,
+	domains []string,
+	cursor string,
+	req Request,
+) (*Response, *framework.Error) {
+	domainCursor, err := pagination.UnmarshalCursor[string](cursor, req.EntityExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainIndex := 0
+	req.Cursor = nil
+
+	if domainCursor != nil {
+		if domainCursor.CollectionID != nil {
+			index, convErr := strconv.Atoi(*domainCursor.CollectionID)
+			if convErr != nil || index < 0 || index >= len(domains) {
+				return nil, &framework.Error{
+					Message: fmt.Sprintf("Cursor has an invalid domain index: %s.", *domainCursor.CollectionID),
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				}
+			}
+
+			domainIndex = index
+		}
+
+		req.Cursor = domainCursor.Cursor
+	}
+
+	// The rows of domains other than the domain of the integration user are only returned when
+	// sysparm_query_no_domain is set.
+	req.Domain = &domains[domainIndex]
+	req.QueryNoDomain = true
+
+	res, err := a.ServicenowClient.GetPage(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return res, nil
+	}
+
+	var nextCursor *pagination.CompositeCursor[string]
+
+	switch {
+	case res.NextCursor != nil:
+		// There are more pages for the current domain.
+		domainIndexAsCollectionID := strconv.Itoa(domainIndex)
+		nextCursor = &pagination.CompositeCursor[string]{
+			CollectionID: &domainIndexAsCollectionID,
+			Cursor:       res.NextCursor,
+		}
+	case domainIndex+1 < len(domains):
+		// Move on to the first page of the next domain.
+		domainIndexAsCollectionID := strconv.Itoa(domainIndex + 1)
+		nextCursor = &pagination.CompositeCursor[string]{
+			CollectionID: &domainIndexAsCollectionID,
+		}
+	}
+
+	res.NextCursor = nil
+
+	if nextCursor != nil {
+		marshalledCursor, err := pagination.MarshalCursor(nextCursor)
+		if err != nil {
+			return nil, err
+		}
+
+		res.NextCursor = &marshalledCursor
+	}
+
+	return res, nil
+}
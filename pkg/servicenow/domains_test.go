@@ -0,0 +1,142 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package servicenow_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/servicenow"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// domainClient returns a page of objects for each domain, and records the requests it receives.
+type domainClient struct {
+	responses map[string]*servicenow.Response
+	requests  []servicenow.Request
+}
+
+func (c *domainClient) GetPage(
+	_ context.Context, request *servicenow.Request,
+) (*servicenow.Response, *framework.Error) {
+	c.requests = append(c.requests, *request)
+
+	res := *c.responses[*request.Domain]
+
+	return &res, nil
+}
+
+func (c *domainClient) GetCount(
+	_ context.Context, _ *servicenow.Request,
+) (*servicenow.CountResponse, *framework.Error) {
+	return nil, nil
+}
+
+func TestGetPageByDomain(t *testing.T) {
+	domains := []string{"domain1", "domain2"}
+
+	tests := map[string]struct {
+		cursor         *pagination.CompositeCursor[string]
+		rawCursor      string
+		wantDomain     string
+		wantReqCursor  *string
+		wantNextCursor *pagination.CompositeCursor[string]
+		wantErr        *framework.Error
+	}{
+		"first_page": {
+			wantDomain: "domain1",
+			wantNextCursor: &pagination.CompositeCursor[string]{
+				CollectionID: testutil.GenPtr("0"),
+				Cursor:       testutil.GenPtr("https://test-instance.service-now.com/next"),
+			},
+		},
+		"last_page_of_first_domain": {
+			cursor: &pagination.CompositeCursor[string]{
+				CollectionID: testutil.GenPtr("0"),
+				Cursor:       testutil.GenPtr("https://test-instance.service-now.com/next"),
+			},
+			wantDomain:    "domain1",
+			wantReqCursor: testutil.GenPtr("https://test-instance.service-now.com/next"),
+			wantNextCursor: &pagination.CompositeCursor[string]{
+				CollectionID: testutil.GenPtr("1"),
+			},
+		},
+		"last_page_of_last_domain": {
+			cursor: &pagination.CompositeCursor[string]{
+				CollectionID: testutil.GenPtr("1"),
+			},
+			wantDomain: "domain2",
+		},
+		"invalid_domain_index": {
+			cursor: &pagination.CompositeCursor[string]{
+				CollectionID: testutil.GenPtr("2"),
+			},
+			wantErr: &framework.Error{
+				Message: "Cursor has an invalid domain index: 2.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &domainClient{
+				responses: map[string]*servicenow.Response{
+					"domain1": {StatusCode: http.StatusOK},
+					"domain2": {StatusCode: http.StatusOK},
+				},
+			}
+
+			// The first domain has a second page, unless the second page is requested.
+			if tt.cursor == nil {
+				client.responses["domain1"].NextCursor = testutil.GenPtr("https://test-instance.service-now.com/next")
+			}
+
+			cursor, _ := pagination.MarshalCursor(tt.cursor)
+
+			adapter := &servicenow.Adapter{ServicenowClient: client}
+
+			gotRes, gotErr := adapter.GetPageByDomain(context.Background(), domains, cursor, servicenow.Request{
+				EntityExternalID: "sys_user",
+			})
+
+			if diff := cmp.Diff(tt.wantErr, gotErr); diff != "" {
+				t.Fatalf("Unexpected error (-want +got):\n%s", diff)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if len(client.requests) != 1 {
+				t.Fatalf("expected 1 request, got %d", len(client.requests))
+			}
+
+			gotReq := client.requests[0]
+
+			if *gotReq.Domain != tt.wantDomain || !gotReq.QueryNoDomain {
+				t.Errorf("Unexpected domain: %s, queryNoDomain: %v", *gotReq.Domain, gotReq.QueryNoDomain)
+			}
+
+			if diff := cmp.Diff(tt.wantReqCursor, gotReq.Cursor); diff != "" {
+				t.Errorf("Unexpected request cursor (-want +got):\n%s", diff)
+			}
+
+			wantNextCursor, _ := pagination.MarshalCursor(tt.wantNextCursor)
+
+			var gotNextCursor string
+			if gotRes.NextCursor != nil {
+				gotNextCursor = *gotRes.NextCursor
+			}
+
+			if gotNextCursor != wantNextCursor {
+				t.Errorf("Unexpected next cursor: %s, want: %s", gotNextCursor, wantNextCursor)
+			}
+		})
+	}
+}
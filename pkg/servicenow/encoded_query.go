@@ -0,0 +1,302 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package servicenow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+)
+
+const (
+	// tableHierarchyTable is the table of the definitions of the tables, whose super_class is the table they extend.
+	tableHierarchyTable = "sys_db_object"
+
+	// dictionaryTable is the table of the definitions of the fields of the tables.
+	dictionaryTable = "sys_dictionary"
+
+	// maxTableHierarchyDepth is the maximum number of tables extended by a table, to stop on cyclic hierarchies.
+	maxTableHierarchyDepth = 10
+)
+
+var (
+	// orderByKeywords are the keywords of the encoded queries followed by a field name rather than a condition.
+	orderByKeywords = []string{"ORDERBYDESC", "ORDERBY", "GROUPBY"}
+
+	// pseudoFields are the fields of the conditions which aren't fields of the table, e.g. the keyword search.
+	pseudoFields = []string{"123TEXTQUERY321", "IR_AND_OR_QUERY", "IR_AND_QUERY", "IR_OR_QUERY"}
+)
+
+// ParseEncodedQueryFields returns the fields of the table referenced by the conditions and the ordering of an
+// encoded query, sorted and deduplicated. The fields are the columns of the table: for a dot-walked field,
+// e.g. caller_id.active, the reference field (caller_id) is returned, as the fields of the referenced table
+// aren't known.
+//
+// ServiceNow ignores the conditions on fields that don't exist in the table instead of failing the request, so a
+// misspelled field silently returns the whole table. The conditions of related list queries (RLQUERY) are on
+// another table and are skipped.
+func ParseEncodedQueryFields(query string) ([]string, error) {
+	var (
+		fields        []string
+		inRelatedList bool
+	)
+
+	for _, condition := range splitEncodedQuery(query) {
+		switch {
+		case inRelatedList:
+			inRelatedList = !strings.HasPrefix(condition, "ENDRLQUERY")
+
+			continue
+		case strings.HasPrefix(condition, "RLQUERY"):
+			inRelatedList = true
+
+			continue
+		case condition == "" || condition == "EQ":
+			continue
+		case slices.ContainsFunc(pseudoFields, func(field string) bool { return strings.HasPrefix(condition, field) }):
+			continue
+		}
+
+		// ^OR and ^NQ conditions start with the keyword, immediately followed by the lowercase field name.
+		for _, keyword := range []string{"OR", "NQ"} {
+			if rest, found := strings.CutPrefix(condition, keyword); found && rest != "" && isFieldNameByte(rest[0]) {
+				condition = rest
+
+				break
+			}
+		}
+
+		field, operator, err := splitCondition(condition)
+		if err != nil {
+			return nil, err
+		}
+
+		if field == "" {
+			// Only the ordering keywords have no field before the operator, e.g. ORDERBYnumber.
+			for _, keyword := range orderByKeywords {
+				if rest, found := strings.CutPrefix(operator, keyword); found {
+					field = rest
+
+					break
+				}
+			}
+
+			if field == "" || strings.IndexFunc(field, func(r rune) bool { return r != '.' && !isFieldNameByte(byte(r)) }) >= 0 {
+				return nil, fmt.Errorf("condition %q has no field", condition)
+			}
+		}
+
+		if strings.HasPrefix(field, ".") || strings.HasSuffix(field, ".") || strings.Contains(field, "..") {
+			return nil, fmt.Errorf("condition %q has an invalid dot-walked field: %s", condition, field)
+		}
+
+		field, _, _ = strings.Cut(field, ".")
+
+		fields = append(fields, field)
+	}
+
+	slices.Sort(fields)
+
+	return slices.Compact(fields), nil
+}
+
+// splitEncodedQuery splits an encoded query into its conditions, separated by "^". A "^^" is an escaped "^" in
+// the value of a condition.
+func splitEncodedQuery(query string) []string {
+	var (
+		conditions []string
+		sb         strings.Builder
+	)
+
+	for i := 0; i < len(query); i++ {
+		if query[i] != '^' {
+			sb.WriteByte(query[i])
+
+			continue
+		}
+
+		if i+1 < len(query) && query[i+1] == '^' {
+			sb.WriteByte('^')
+
+			i++
+
+			continue
+		}
+
+		conditions = append(conditions, sb.String())
+		sb.Reset()
+	}
+
+	return append(conditions, sb.String())
+}
+
+// splitCondition splits a condition into its field, whose name is lowercase, and its operator followed by its value.
+// The operators are either symbols, e.g. "=" and "!=", or uppercase keywords, e.g. "STARTSWITH" and "ISEMPTY".
+func splitCondition(condition string) (string, string, error) {
+	i := 0
+	for i < len(condition) && (isFieldNameByte(condition[i]) || condition[i] == '.') {
+		i++
+	}
+
+	field, operator := condition[:i], condition[i:]
+
+	if operator == "" {
+		return "", "", fmt.Errorf("condition %q has no operator", condition)
+	}
+
+	if c := operator[0]; !strings.ContainsRune("=!<>", rune(c)) && (c < 'A' || c > 'Z') {
+		return "", "", fmt.Errorf("condition %q has an invalid operator", condition)
+	}
+
+	return field, operator, nil
+}
+
+// isFieldNameByte returns whether the byte is valid in the name of a field, which is lowercase.
+func isFieldNameByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '_'
+}
+
+// ValidateFilters validates that the filters of the tables are valid encoded queries and only reference fields of
+// the tables, looking up the fields of each table and of the tables it extends in the dictionary. The tables without
+// a filter are skipped.
+func (a *Adapter) ValidateFilters(
+	ctx context.Context, baseReq Request, tables []string, filters map[string]string,
+) *framework.Error {
+	for _, table := range tables {
+		filter, found := filters[table]
+		if !found || filter == "" {
+			continue
+		}
+
+		queryFields, parseErr := ParseEncodedQueryFields(filter)
+		if parseErr != nil {
+			return &framework.Error{
+				Message: fmt.Sprintf("Filter of table %s is not a valid encoded query: %v.", table, parseErr),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+
+		hierarchy, err := a.getTableHierarchy(ctx, baseReq, table)
+		if err != nil {
+			return err
+		}
+
+		tableFields, err := a.getTableFields(ctx, baseReq, hierarchy)
+		if err != nil {
+			return err
+		}
+
+		var unknownFields []string
+
+		for _, field := range queryFields {
+			if _, found := tableFields[field]; !found {
+				unknownFields = append(unknownFields, field)
+			}
+		}
+
+		if len(unknownFields) > 0 {
+			return &framework.Error{
+				Message: fmt.Sprintf(
+					"Filter of table %s references fields not found in the table: %s.",
+					table, strings.Join(unknownFields, ", "),
+				),
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+	}
+
+	return nil
+}
+
+// getTableHierarchy returns the table followed by the tables it extends, e.g. [incident task].
+func (a *Adapter) getTableHierarchy(ctx context.Context, baseReq Request, table string) ([]string, *framework.Error) {
+	hierarchy := []string{table}
+
+	for len(hierarchy) <= maxTableHierarchyDepth {
+		filter := "name=" + hierarchy[len(hierarchy)-1]
+
+		res, err := a.ServicenowClient.GetPage(ctx, &Request{
+			BaseURL:               baseReq.BaseURL,
+			AuthorizationHeader:   baseReq.AuthorizationHeader,
+			PageSize:              1,
+			EntityExternalID:      tableHierarchyTable,
+			Filter:                &filter,
+			Attributes:            []*framework.AttributeConfig{{ExternalId: "super_class.name"}},
+			APIVersion:            baseReq.APIVersion,
+			RequestTimeoutSeconds: baseReq.RequestTimeoutSeconds,
+			CustomURLPath:         baseReq.CustomURLPath,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if adapterErr := web.HTTPError(res.StatusCode, res.RetryAfterHeader); adapterErr != nil {
+			return nil, adapterErr
+		}
+
+		if len(res.Objects) == 0 {
+			return nil, &framework.Error{
+				Message: fmt.Sprintf("Table %s is not found in the dictionary.", hierarchy[len(hierarchy)-1]),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+
+		superClass, _ := res.Objects[0]["super_class.name"].(string)
+		if superClass == "" || slices.Contains(hierarchy, superClass) {
+			return hierarchy, nil
+		}
+
+		hierarchy = append(hierarchy, superClass)
+	}
+
+	return hierarchy, nil
+}
+
+// getTableFields returns the set of the names of the fields of the tables in the dictionary.
+func (a *Adapter) getTableFields(
+	ctx context.Context, baseReq Request, tables []string,
+) (map[string]struct{}, *framework.Error) {
+	filter := "nameIN" + strings.Join(tables, ",") + "^elementISNOTEMPTY"
+
+	req := &Request{
+		BaseURL:               baseReq.BaseURL,
+		AuthorizationHeader:   baseReq.AuthorizationHeader,
+		PageSize:              maxPageSize,
+		EntityExternalID:      dictionaryTable,
+		Filter:                &filter,
+		Attributes:            []*framework.AttributeConfig{{ExternalId: "element"}},
+		APIVersion:            baseReq.APIVersion,
+		RequestTimeoutSeconds: baseReq.RequestTimeoutSeconds,
+		CustomURLPath:         baseReq.CustomURLPath,
+	}
+
+	fields := make(map[string]struct{})
+
+	for {
+		res, err := a.ServicenowClient.GetPage(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if adapterErr := web.HTTPError(res.StatusCode, res.RetryAfterHeader); adapterErr != nil {
+			return nil, adapterErr
+		}
+
+		for _, object := range res.Objects {
+			if element, ok := object["element"].(string); ok {
+				fields[element] = struct{}{}
+			}
+		}
+
+		if res.NextCursor == nil {
+			return fields, nil
+		}
+
+		req.Cursor = res.NextCursor
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package servicenow_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	servicenow_adapter "github.com/sgnl-ai/adapters/pkg/servicenow"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestParseEncodedQueryFields(t *testing.T) {
+	tests := map[string]struct {
+		query      string
+		wantFields []string
+		wantErr    string
+	}{
+		"single_condition": {
+			query:      "active=true",
+			wantFields: []string{"active"},
+		},
+		"and_or_conditions": {
+			query:      "active=true^priority<=2^ORurgency!=3^short_descriptionLIKEpassword",
+			wantFields: []string{"active", "priority", "short_description", "urgency"},
+		},
+		"new_query_and_ordering": {
+			query:      "numberSTARTSWITHINC^NQassigned_toISEMPTY^ORDERBYDESCsys_created_on^ORDERBYnumber",
+			wantFields: []string{"assigned_to", "number", "sys_created_on"},
+		},
+		"dot_walked_field": {
+			query:      "caller_id.active=true^caller_id.department.name=IT",
+			wantFields: []string{"caller_id"},
+		},
+		"escaped_caret_in_value": {
+			query:      "short_description=a^^b^active=true",
+			wantFields: []string{"active", "short_description"},
+		},
+		"javascript_value": {
+			query:      "sys_created_on>javascript:gs.daysAgoStart(7)",
+			wantFields: []string{"sys_created_on"},
+		},
+		"related_list_query_and_keyword_search": {
+			query:      "active=true^RLQUERYsys_user_grmember.user,>=1^group.name=IT^ENDRLQUERY^123TEXTQUERY321=vpn^EQ",
+			wantFields: []string{"active"},
+		},
+		"missing_operator": {
+			query:   "active=true^priority",
+			wantErr: `condition "priority" has no operator`,
+		},
+		"invalid_operator": {
+			query:   "active true",
+			wantErr: `condition "active true" has an invalid operator`,
+		},
+		"missing_field": {
+			query:   "=true",
+			wantErr: `condition "=true" has no field`,
+		},
+		"invalid_dot_walked_field": {
+			query:   "caller_id..active=true",
+			wantErr: `condition "caller_id..active=true" has an invalid dot-walked field: caller_id..active`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotFields, gotErr := servicenow_adapter.ParseEncodedQueryFields(tt.query)
+
+			if tt.wantErr != "" {
+				if gotErr == nil || gotErr.Error() != tt.wantErr {
+					t.Fatalf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+				}
+
+				return
+			}
+
+			if gotErr != nil {
+				t.Fatalf("unexpected error: %v", gotErr)
+			}
+
+			if !reflect.DeepEqual(gotFields, tt.wantFields) {
+				t.Errorf("gotFields: %v, wantFields: %v", gotFields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestAdapterGetPageWithValidateFilters(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("sysparm_query")
+
+		switch r.URL.Path + "?" + query {
+		case "/api/now/v2/table/sys_db_object?name=incident^ORDERBYsys_id":
+			w.Write([]byte(`{"result": [{"sys_id": "1", "super_class.name": "task"}]}`))
+		case "/api/now/v2/table/sys_db_object?name=task^ORDERBYsys_id":
+			w.Write([]byte(`{"result": [{"sys_id": "2", "super_class.name": ""}]}`))
+		case "/api/now/v2/table/sys_db_object?name=u_missing^ORDERBYsys_id":
+			w.Write([]byte(`{"result": []}`))
+		case "/api/now/v2/table/sys_dictionary?nameINincident,task^elementISNOTEMPTY^ORDERBYsys_id":
+			w.Write([]byte(`{"result": [{"sys_id": "3", "element": "sys_id"}, {"sys_id": "4", "element": "caller_id"}, {"sys_id": "5", "element": "active"}, {"sys_id": "6", "element": "priority"}]}`))
+		case "/api/now/v2/table/incident?active=true^caller_id.active=true^ORDERBYsys_id":
+			w.Write([]byte(`{"result": [{"sys_id": "inc1"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	adapter := servicenow_adapter.NewAdapter(&servicenow_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	newRequest := func(entity, filter, cursor string) *framework.Request[servicenow_adapter.Config] {
+		return &framework.Request[servicenow_adapter.Config]{
+			Address: server.URL,
+			Auth: &framework.DatasourceAuthCredentials{
+				HTTPAuthorization: "Bearer token",
+			},
+			Config: &servicenow_adapter.Config{
+				APIVersion:      "v2",
+				Filters:         map[string]string{entity: filter},
+				ValidateFilters: true,
+			},
+			Entity: framework.EntityConfig{
+				ExternalId: entity,
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "sys_id",
+						Type:       framework.AttributeTypeString,
+						UniqueId:   true,
+					},
+				},
+			},
+			PageSize: 10,
+			Cursor:   cursor,
+		}
+	}
+
+	tests := map[string]struct {
+		request      *framework.Request[servicenow_adapter.Config]
+		wantResponse framework.Response
+	}{
+		"valid_filter_with_inherited_fields": {
+			request: newRequest("incident", "active=true^caller_id.active=true", ""),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"sys_id": "inc1"},
+					},
+				},
+			},
+		},
+		"unknown_fields": {
+			request: newRequest("incident", "active=true^priorty=1^ORurgncy=1", ""),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Filter of table incident references fields not found in the table: priorty, urgncy.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+				},
+			},
+		},
+		"malformed_filter": {
+			request: newRequest("incident", "active=true^priority", ""),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: `Filter of table incident is not a valid encoded query: condition "priority" has no operator.`,
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+				},
+			},
+		},
+		"unknown_table": {
+			request: newRequest("u_missing", "active=true", ""),
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Table u_missing is not found in the dictionary.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+				},
+			},
+		},
+		"not_validated_after_first_page": {
+			request: newRequest("incident", "active=true^priorty=1", server.URL+"/api/now/v2/table/incident?sysparm_query=active%3Dtrue%5Ecaller_id.active%3Dtrue%5EORDERBYsys_id"),
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"sys_id": "inc1"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+		})
+	}
+}
+
+func TestAdapterGetPageWithExplain(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("unexpected request to datasource in explain mode")
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	adapter := servicenow_adapter.NewAdapter(&servicenow_adapter.Datasource{
+		Client: server.Client(),
+	})
+
+	tests := map[string]struct {
+		entity   string
+		config   *servicenow_adapter.Config
+		wantLogs []map[string]any
+	}{
+		"table": {
+			entity: "incident",
+			config: &servicenow_adapter.Config{
+				APIVersion: "v2",
+				Filters:    map[string]string{"incident": "active=true"},
+				Explain:    true,
+			},
+			wantLogs: []map[string]any{
+				{
+					"level":                   "info",
+					"msg":                     "Explain mode, not sending request to datasource",
+					"requestEntityExternalId": "incident",
+					"requestUrl":              server.URL + "/api/now/v2/table/incident?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=10&sysparm_query=active%3Dtrue%5EORDERBYsys_id",
+				},
+			},
+		},
+		"table_count": {
+			entity: "table_count",
+			config: &servicenow_adapter.Config{
+				APIVersion:  "v2",
+				CountTables: []string{"sys_user", "incident"},
+				Explain:     true,
+			},
+			wantLogs: []map[string]any{
+				{
+					"level":                   "info",
+					"msg":                     "Explain mode, not sending request to datasource",
+					"requestEntityExternalId": "sys_user",
+					"requestUrl":              server.URL + "/api/now/stats/sys_user?sysparm_count=true",
+				},
+				{
+					"level":                   "info",
+					"msg":                     "Explain mode, not sending request to datasource",
+					"requestEntityExternalId": "incident",
+					"requestUrl":              server.URL + "/api/now/stats/incident?sysparm_count=true",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, observedLogs := testutil.NewContextWithObservableLogger(context.Background())
+
+			uniqueIDAttribute := "sys_id"
+			if tt.entity == "table_count" {
+				uniqueIDAttribute = "table"
+			}
+
+			gotResponse := adapter.GetPage(ctx, &framework.Request[servicenow_adapter.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer token",
+				},
+				Config: tt.config,
+				Entity: framework.EntityConfig{
+					ExternalId: tt.entity,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: uniqueIDAttribute,
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+				},
+				PageSize: 10,
+			})
+
+			wantResponse := framework.NewGetPageResponseSuccess(&framework.Page{})
+
+			if !reflect.DeepEqual(gotResponse, wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, wantResponse)
+			}
+
+			testutil.ValidateLogOutput(t, observedLogs, tt.wantLogs)
+		})
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"strings"
 )
 
+// updatedSinceDateTimeFormat is the format of the sys_updated_on values in encoded queries.
+const updatedSinceDateTimeFormat = "2006-01-02 15:04:05"
+
 // ConstructEndpoint constructs and returns the endpoint to query the datasource.
 func ConstructEndpoint(request *Request) string {
 	if request == nil {
@@ -22,6 +25,10 @@ func ConstructEndpoint(request *Request) string {
 	// baseURL + "/api/now/" + apiVersion + "/table/" + tableName + "?sysparm_fields=sys_id"
 	// 		+ "&sysparm_exclude_reference_link=true&sysparm_limit=" + pageSize
 	// 		+ ["&sysparm_query=" + filter + "%5EORDERBYsys_id"] | ["&sysparm_query=ORDERBYsys_id"]
+	// During an incremental sync, "sys_updated_on>=" + updatedSince + "^" is inserted before ORDERBYsys_id.
+	// On domain-separated instances, "&sysparm_query_no_domain=true" is appended to sysparm_limit and
+	// "sys_domain=" + domain + "^" is inserted before ORDERBYsys_id if the request is restricted to a domain.
+	// With keyset pagination, "sys_id>" + afterSysID + "^" is inserted before ORDERBYsys_id after the first page.
 	// OR with custom URL path:
 	// baseURL + customURLPath + "/" + apiVersion + "/table/" + tableName + "?sysparm_fields=sys_id" + ...
 
@@ -73,17 +80,83 @@ func ConstructEndpoint(request *Request) string {
 	sb.WriteString("&sysparm_exclude_reference_link=true&sysparm_limit=")
 	sb.WriteString(pageSizeStr)
 
-	escapedFilter := ""
+	if request.QueryNoDomain {
+		sb.WriteString("&sysparm_query_no_domain=true")
+	}
 
-	if request.Filter != nil && *request.Filter != "" {
-		escapedFilter = url.QueryEscape(*request.Filter) + "%5E"
+	escapedQuery := encodedQuery(request)
+	if escapedQuery != "" {
+		escapedQuery += "%5E"
 	}
 
-	sb.Grow(31 + len(escapedFilter))
+	sb.Grow(31 + len(escapedQuery))
 
 	sb.WriteString("&sysparm_query=")
-	sb.WriteString(escapedFilter)
+	sb.WriteString(escapedQuery)
 	sb.WriteString("ORDERBYsys_id")
 
 	return sb.String()
 }
+
+// ConstructCountEndpoint constructs and returns the endpoint to count the rows of the table of the request
+// with the Aggregate API, applying the same filter and incremental sync condition as ConstructEndpoint.
+func ConstructCountEndpoint(request *Request) string {
+	if request == nil {
+		return ""
+	}
+
+	// URL Format:
+	// baseURL + "/api/now/stats/" + tableName + "?sysparm_count=true" + ["&sysparm_query=" + query]
+	// OR with custom URL path:
+	// baseURL + customURLPath + "/stats/" + tableName + "?sysparm_count=true" + ...
+	var sb strings.Builder
+
+	sb.WriteString(request.BaseURL)
+
+	if request.CustomURLPath != "" {
+		sb.WriteString(request.CustomURLPath)
+	} else {
+		sb.WriteString("/api/now")
+	}
+
+	sb.WriteString("/stats/")
+	sb.WriteString(url.PathEscape(request.EntityExternalID))
+	sb.WriteString("?sysparm_count=true")
+
+	if escapedQuery := encodedQuery(request); escapedQuery != "" {
+		sb.WriteString("&sysparm_query=")
+		sb.WriteString(escapedQuery)
+	}
+
+	return sb.String()
+}
+
+// encodedQuery returns the URL encoded conditions of the encoded query of the request, joined by "^", or an
+// empty string if the request has no conditions.
+func encodedQuery(request *Request) string {
+	conditions := make([]string, 0, 4)
+
+	if request.Filter != nil && *request.Filter != "" {
+		conditions = append(conditions, url.QueryEscape(*request.Filter))
+	}
+
+	if request.Domain != nil {
+		conditions = append(conditions, "sys_domain%3D"+url.QueryEscape(*request.Domain))
+	}
+
+	// During an incremental sync, only request the objects updated since the given time. sys_updated_on
+	// is indexed on every table, so this avoids scanning the whole table for each page. The time is
+	// formatted in UTC, which is the timezone ServiceNow stores sys_updated_on values in.
+	if request.UpdatedSince != nil {
+		conditions = append(conditions, "sys_updated_on%3E%3D"+
+			url.QueryEscape(request.UpdatedSince.UTC().Format(updatedSinceDateTimeFormat)))
+	}
+
+	// With keyset pagination, only request the rows after the last row of the previous page. sys_id is the
+	// primary key of every table, so the rows are seeked to instead of skipped over as with sysparm_offset.
+	if request.AfterSysID != nil {
+		conditions = append(conditions, "sys_id%3E"+url.QueryEscape(*request.AfterSysID))
+	}
+
+	return strings.Join(conditions, "%5E")
+}
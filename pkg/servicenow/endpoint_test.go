@@ -5,6 +5,7 @@ package servicenow
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	"github.com/sgnl-ai/adapters/pkg/testutil"
@@ -36,6 +37,46 @@ func TestConstructEndpoint(t *testing.T) {
 				"?sysparm_fields=sys_id,%21url_encoding%21&sysparm_exclude_reference_link=true" +
 				"&sysparm_limit=100&sysparm_query=ORDERBYsys_id",
 		},
+		"domain_separated": {
+			request: &Request{
+				BaseURL:          "https://test-instance.service-now.com",
+				APIVersion:       "v2",
+				EntityExternalID: "sys_user",
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "sys_id",
+						Type:       framework.AttributeTypeString,
+					},
+				},
+				PageSize:      100,
+				Filter:        testutil.GenPtr("active=true"),
+				QueryNoDomain: true,
+				Domain:        testutil.GenPtr("c90d4b084a362312013398f051272c0d"),
+			},
+			wantEndpoint: "https://test-instance.service-now.com/api/now/v2/table/sys_user?sysparm_fields=sys_id" +
+				"&sysparm_exclude_reference_link=true&sysparm_limit=100&sysparm_query_no_domain=true" +
+				"&sysparm_query=active%3Dtrue%5Esys_domain%3Dc90d4b084a362312013398f051272c0d%5EORDERBYsys_id",
+		},
+		"keyset_pagination": {
+			request: &Request{
+				BaseURL:          "https://test-instance.service-now.com",
+				APIVersion:       "v2",
+				EntityExternalID: "sys_user",
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "sys_id",
+						Type:       framework.AttributeTypeString,
+					},
+				},
+				PageSize:         100,
+				Filter:           testutil.GenPtr("active=true"),
+				KeysetPagination: true,
+				AfterSysID:       testutil.GenPtr("aa826bf03710200044e0bfc8bcbe5ddf"),
+			},
+			wantEndpoint: "https://test-instance.service-now.com/api/now/v2/table/sys_user?sysparm_fields=sys_id" +
+				"&sysparm_exclude_reference_link=true&sysparm_limit=100" +
+				"&sysparm_query=active%3Dtrue%5Esys_id%3Eaa826bf03710200044e0bfc8bcbe5ddf%5EORDERBYsys_id",
+		},
 		"simple_with_filter": {
 			request: &Request{
 				BaseURL:          "https://test-instance.service-now.com",
@@ -128,6 +169,25 @@ func TestConstructEndpoint(t *testing.T) {
 				"?sysparm_fields=sys_id&sysparm_exclude_reference_link=true" +
 				"&sysparm_limit=50&sysparm_query=active%3Dtrue%5Epriority%3D1%5EORDERBYsys_id",
 		},
+		"incremental_with_filter": {
+			request: &Request{
+				BaseURL:          "https://test-instance.service-now.com",
+				APIVersion:       "v2",
+				EntityExternalID: "sys_user",
+				Attributes: []*framework.AttributeConfig{
+					{
+						ExternalId: "sys_id",
+						Type:       framework.AttributeTypeString,
+					},
+				},
+				PageSize:     100,
+				Filter:       testutil.GenPtr("active=true"),
+				UpdatedSince: testutil.GenPtr(time.Date(2026, 1, 2, 4, 4, 5, 0, time.FixedZone("UTC+1", 3600))),
+			},
+			wantEndpoint: "https://test-instance.service-now.com/api/now/v2/table/sys_user" +
+				"?sysparm_fields=sys_id&sysparm_exclude_reference_link=true&sysparm_limit=100" +
+				"&sysparm_query=active%3Dtrue%5Esys_updated_on%3E%3D2026-01-02+03%3A04%3A05%5EORDERBYsys_id",
+		},
 	}
 
 	for name, tt := range tests {
@@ -140,3 +200,51 @@ func TestConstructEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestConstructCountEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *Request
+		wantEndpoint string
+	}{
+		"nil_request": {
+			request:      nil,
+			wantEndpoint: "",
+		},
+		"simple": {
+			request: &Request{
+				BaseURL:          "https://test-instance.service-now.com",
+				APIVersion:       "v2",
+				EntityExternalID: "sys_user",
+			},
+			wantEndpoint: "https://test-instance.service-now.com/api/now/stats/sys_user?sysparm_count=true",
+		},
+		"custom_url_path": {
+			request: &Request{
+				BaseURL:          "https://test-instance.service-now.com",
+				EntityExternalID: "sys_user",
+				CustomURLPath:    "/api/sgnl",
+			},
+			wantEndpoint: "https://test-instance.service-now.com/api/sgnl/stats/sys_user?sysparm_count=true",
+		},
+		"incremental_with_filter": {
+			request: &Request{
+				BaseURL:          "https://test-instance.service-now.com",
+				EntityExternalID: "incident",
+				Filter:           testutil.GenPtr("active=true"),
+				UpdatedSince:     testutil.GenPtr(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			wantEndpoint: "https://test-instance.service-now.com/api/now/stats/incident?sysparm_count=true" +
+				"&sysparm_query=active%3Dtrue%5Esys_updated_on%3E%3D2026-01-02+03%3A04%3A05",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint := ConstructCountEndpoint(tt.request)
+
+			if !reflect.DeepEqual(gotEndpoint, tt.wantEndpoint) {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+		})
+	}
+}
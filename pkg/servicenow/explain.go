@@ -0,0 +1,38 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package servicenow
+
+import (
+	"context"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+)
+
+// explainClient is the Client used in explain mode (Config.Explain). It logs the URL of each request instead of
+// sending it to the datasource, and responds with no objects.
+type explainClient struct{}
+
+func (explainClient) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	zaplogger.FromContext(ctx).Info("Explain mode, not sending request to datasource",
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestURL(ConstructEndpoint(request)),
+	)
+
+	return &Response{
+		StatusCode: http.StatusOK,
+	}, nil
+}
+
+func (explainClient) GetCount(ctx context.Context, request *Request) (*CountResponse, *framework.Error) {
+	zaplogger.FromContext(ctx).Info("Explain mode, not sending request to datasource",
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestURL(ConstructCountEndpoint(request)),
+	)
+
+	return &CountResponse{
+		StatusCode: http.StatusOK,
+	}, nil
+}
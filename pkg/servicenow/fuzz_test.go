@@ -0,0 +1,32 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package servicenow_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/servicenow"
+)
+
+// FuzzParseResponse checks that malformed ServiceNow responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"result":[{"sys_id":"62826bf03710200044e0bfc8bcbe5df1","user_name":"abel.tuter"}]}`,
+		`{"error":{"message":"Invalid table","detail":null},"status":"failure"}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, err := servicenow.ParseResponse(bytes.NewReader(body))
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
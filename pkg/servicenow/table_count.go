@@ -0,0 +1,204 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package servicenow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	customerror "github.com/sgnl-ai/adapters/pkg/errors"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"go.uber.org/zap"
+)
+
+const (
+	// TableCount is the entity of the number of rows of each table configured in Config.CountTables.
+	// It is not a ServiceNow table: each object is the count of a table, returned by the Aggregate API.
+	TableCount = "table_count"
+
+	// tableCountUniqueIDAttribute is the unique ID attribute of the TableCount entity, the name of the table.
+	tableCountUniqueIDAttribute = "table"
+
+	// tableCountCountAttribute is the attribute of the TableCount entity containing the number of rows.
+	tableCountCountAttribute = "count"
+)
+
+// AggregateResponse is the response of the Aggregate API when counting the rows of a table.
+type AggregateResponse struct {
+	Result struct {
+		Stats struct {
+			Count string `json:"count"`
+		} `json:"stats"`
+	} `json:"result"`
+}
+
+// GetCount makes a request to the Aggregate API to count the rows of the table of the request.
+func (d *Datasource) GetCount(ctx context.Context, request *Request) (*CountResponse, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(fields.RequestEntityExternalID(request.EntityExternalID))
+
+	endpoint := ConstructCountEndpoint(request)
+
+	// Timeout API calls that take longer than the configured timeout.
+	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(apiCtx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	req.Header.Add("Authorization", request.AuthorizationHeader)
+	req.Header.Add("Accept", "application/json")
+
+	logger.Info("Sending request to datasource", fields.RequestURL(endpoint))
+
+	res, err := d.Client.Do(req)
+	if err != nil {
+		logger.Error("Request to datasource failed",
+			fields.RequestURL(endpoint),
+			fields.SGNLEventTypeError(),
+			zap.Error(err),
+		)
+
+		return nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to execute request: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
+		)
+	}
+
+	defer res.Body.Close()
+
+	response := &CountResponse{
+		StatusCode:       res.StatusCode,
+		RetryAfterHeader: res.Header.Get("Retry-After"),
+	}
+
+	if res.StatusCode != http.StatusOK {
+		logger.Error("Datasource responded with an error",
+			fields.RequestURL(endpoint),
+			fields.ResponseStatusCode(res.StatusCode),
+			fields.ResponseRetryAfterHeader(response.RetryAfterHeader),
+			fields.ResponseBody(res.Body),
+			fields.SGNLEventTypeError(),
+		)
+
+		return response, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to read response body: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	var aggregateResponse AggregateResponse
+
+	if err := json.Unmarshal(body, &aggregateResponse); err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to unmarshal the datasource response: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	count, err := strconv.ParseInt(aggregateResponse.Result.Stats.Count, 10, 64)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Failed to parse the row count of table %s: %q.",
+				request.EntityExternalID, aggregateResponse.Result.Stats.Count,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	response.Count = count
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+	)
+
+	return response, nil
+}
+
+// GetTableCountPage returns a page of the TableCount entity, i.e. the number of rows of up to PageSize tables of
+// Config.CountTables. The filter of each table in Config.Filters and the incremental sync condition are applied to
+// its count, so that the counts match the number of objects returned for the entities of the tables.
+// The cursor is the index of the first table of the page in Config.CountTables.
+func (a *Adapter) GetTableCountPage(
+	ctx context.Context, cfg *Config, request Request,
+) (*Response, *framework.Error) {
+	start := int64(0)
+
+	if request.Cursor != nil {
+		var err error
+
+		start, err = strconv.ParseInt(*request.Cursor, 10, 64)
+		if err != nil || start < 0 || start >= int64(len(cfg.CountTables)) {
+			return nil, pagination.NewCursorError(
+				TableCount,
+				fmt.Sprintf("<int64 between 0 and %d>", len(cfg.CountTables)-1),
+				fmt.Sprintf("%q is not the index of a table in countTables", *request.Cursor),
+			)
+		}
+	}
+
+	end := min(start+request.PageSize, int64(len(cfg.CountTables)))
+
+	response := &Response{
+		StatusCode: http.StatusOK,
+		Objects:    make([]map[string]any, 0, end-start),
+	}
+
+	for _, table := range cfg.CountTables[start:end] {
+		countRequest := request
+		countRequest.EntityExternalID = table
+		countRequest.Cursor = nil
+		countRequest.Filter = nil
+
+		if filter, found := cfg.Filters[table]; found {
+			countRequest.Filter = &filter
+		}
+
+		countResponse, err := a.ServicenowClient.GetCount(ctx, &countRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		if countResponse.StatusCode != http.StatusOK {
+			return &Response{
+				StatusCode:       countResponse.StatusCode,
+				RetryAfterHeader: countResponse.RetryAfterHeader,
+			}, nil
+		}
+
+		response.Objects = append(response.Objects, map[string]any{
+			tableCountUniqueIDAttribute: table,
+			// Numbers are converted from float64, like numbers unmarshaled from JSON.
+			tableCountCountAttribute: float64(countResponse.Count),
+		})
+	}
+
+	if end < int64(len(cfg.CountTables)) {
+		nextCursor := strconv.FormatInt(end, 10)
+		response.NextCursor = &nextCursor
+	}
+
+	return response, nil
+}
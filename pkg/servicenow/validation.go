@@ -66,12 +66,25 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
+	entityUniqueIDAttribute := uniqueIDAttribute
+
+	if request.Entity.ExternalId == TableCount {
+		if len(request.Config.CountTables) == 0 {
+			return &framework.Error{
+				Message: fmt.Sprintf("Entity %s requires at least one table in countTables.", TableCount),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+		}
+
+		entityUniqueIDAttribute = tableCountUniqueIDAttribute
+	}
+
 	// Validate that at least the unique ID attribute for the requested entity
 	// is requested.
 	var uniqueIDAttributeFound bool
 
 	for _, attribute := range request.Entity.Attributes {
-		if attribute.ExternalId == uniqueIDAttribute {
+		if attribute.ExternalId == entityUniqueIDAttribute {
 			uniqueIDAttributeFound = true
 
 			break
@@ -110,6 +110,40 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"duplicate_domains": {
+			request: &framework.Request[servicenow_adapter.Config]{
+				Address: "test-instance.service-now.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "username",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "sys_user",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "sys_id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "name",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &servicenow_adapter.Config{
+					APIVersion: "v2",
+					Domains:    []string{"domain1", "domain1"},
+				},
+				Ordered:  true,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Servicenow config is invalid: domains must not contain duplicate values: domain1.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_http_prefix": {
 			request: &framework.Request[servicenow_adapter.Config]{
 				Address: "http://test-instance.service-now.com",
@@ -325,6 +359,62 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"valid_table_count": {
+			request: &framework.Request[servicenow_adapter.Config]{
+				Address: "test-instance.service-now.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "username",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "table_count",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "table",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &servicenow_adapter.Config{
+					APIVersion:  "v2",
+					CountTables: []string{"sys_user"},
+				},
+				Ordered:  true,
+				PageSize: 250,
+			},
+			wantErr: nil,
+		},
+		"invalid_table_count_without_count_tables": {
+			request: &framework.Request[servicenow_adapter.Config]{
+				Address: "test-instance.service-now.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "username",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "table_count",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "table",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &servicenow_adapter.Config{
+					APIVersion: "v2",
+				},
+				Ordered:  true,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Entity table_count requires at least one table in countTables.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_missing_unique_attribute": {
 			request: &framework.Request[servicenow_adapter.Config]{
 				Address: "test-instance.service-now.com",
@@ -0,0 +1,220 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package slack_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/slack"
+)
+
+func TestAdapterGetPage(t *testing.T) {
+	server := httptest.NewTLSServer(TestServerHandler)
+	adapter := slack.NewAdapter(&slack.Datasource{
+		Client: server.Client(),
+	})
+
+	tests := map[string]struct {
+		request      *framework.Request[slack.Config]
+		wantResponse framework.Response
+	}{
+		"users_first_page": {
+			request: &framework.Request[slack.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: slack.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "$.profile.email",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "deleted",
+							Type:       framework.AttributeTypeBool,
+						},
+						{
+							ExternalId: "updated",
+							Type:       framework.AttributeTypeDateTime,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":              "U01",
+							"$.profile.email": "alice@example.com",
+							"deleted":         false,
+							"updated":         time.Date(2024, 1, 23, 20, 17, 36, 0, time.UTC),
+						},
+						{
+							"id":              "U02",
+							"$.profile.email": "bob@example.com",
+							"deleted":         false,
+							"updated":         time.Date(2024, 1, 23, 20, 17, 37, 0, time.UTC),
+						},
+					},
+					// {"cursor":"dXNlcjpVMDM="}
+					NextCursor: "eyJjdXJzb3IiOiJkWE5sY2pwVk1ETT0ifQ==",
+				},
+			},
+		},
+		"users_last_page": {
+			request: &framework.Request[slack.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: slack.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "deleted",
+							Type:       framework.AttributeTypeBool,
+						},
+					},
+				},
+				PageSize: 2,
+				Cursor:   "eyJjdXJzb3IiOiJkWE5sY2pwVk1ETT0ifQ==",
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":      "U03",
+							"deleted": true,
+						},
+					},
+				},
+			},
+		},
+		"channel_members_first_page": {
+			request: &framework.Request[slack.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Config: &slack.Config{
+					ChannelTypes: []string{"public_channel", "private_channel"},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: slack.ChannelMember,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "channelId",
+							Type:       framework.AttributeTypeString,
+						},
+						{
+							ExternalId: "userId",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{
+							"id":        "C01-U01",
+							"channelId": "C01",
+							"userId":    "U01",
+						},
+						{
+							"id":        "C01-U02",
+							"channelId": "C01",
+							"userId":    "U02",
+						},
+					},
+					// {"cursor":"bWVtYmVyOlUwMw==","collectionId":"C01","collectionCursor":"Y2hhbm5lbDpDMDI="}
+					NextCursor: "eyJjdXJzb3IiOiJiV1Z0WW1WeU9sVXdNdz09IiwiY29sbGVjdGlvbklkIjoiQzAxIiwiY29sbGVjdGlvbkN1cnNvciI6IlkyaGhibTVsYkRwRE1EST0ifQ==",
+				},
+			},
+		},
+		"invalid_auth": {
+			request: &framework.Request[slack.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-invalid",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: slack.User,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Slack request failed with error: invalid_auth.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				},
+			},
+		},
+		"invalid_config": {
+			request: &framework.Request[slack.Config]{
+				Address: server.URL,
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Config: &slack.Config{
+					ChannelTypes: []string{"shared_channel"},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: slack.Channel,
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				PageSize: 2,
+			},
+			wantResponse: framework.Response{
+				Error: &framework.Error{
+					Message: "Slack config is invalid: channelTypes contains an unsupported channel type: shared_channel.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse := adapter.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(gotResponse, tt.wantResponse); diff != "" {
+				t.Errorf("adapter.GetPage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
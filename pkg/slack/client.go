@@ -0,0 +1,66 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package slack
+
+import (
+	"context"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Client is a client that allows querying the Slack datasource which contains JSON objects.
+type Client interface {
+	GetPage(ctx context.Context, request *Request) (*Response, *framework.Error)
+}
+
+// Request is a request to the Slack Web API.
+type Request struct {
+	// BaseURL is the base URL of the Slack Web API. Should always be "https://slack.com".
+	BaseURL string
+
+	// Token is the Bearer token to authenticate a request, e.g. "Bearer xoxp-XXXX".
+	Token string
+
+	// PageSize is the maximum number of objects to return from the entity.
+	PageSize int64
+
+	// EntityExternalID is the external ID of the entity.
+	EntityExternalID string
+
+	// Cursor identifies the first object of the page to return, as returned by
+	// the last request for the entity.
+	// nil in the request for the first page.
+	Cursor *pagination.CompositeCursor[string]
+
+	// TeamID is the ID of the workspace of an Enterprise Grid organization to query.
+	// nil to query the workspace of the token, or every workspace of the organization for an org-wide token.
+	TeamID *string
+
+	// ChannelTypes are the types of the channels to query, e.g. "public_channel".
+	ChannelTypes []string
+
+	// ExcludeArchivedChannels excludes the archived channels from the channels.
+	ExcludeArchivedChannels bool
+
+	// RequestTimeoutSeconds is the timeout duration for requests made to datasources.
+	// This should be set to the number of seconds to wait before timing out.
+	RequestTimeoutSeconds int
+}
+
+// Response is a response returned by the datasource.
+type Response struct {
+	// StatusCode is an HTTP status code.
+	StatusCode int
+
+	// RetryAfterHeader is the Retry-After response HTTP header, if set.
+	RetryAfterHeader string
+
+	// Objects is the list of items returned by the datasource.
+	// May be empty.
+	Objects []map[string]any
+
+	// NextCursor is the cursor that identifies the first object of the next page.
+	// nil if this is the last page in this full sync.
+	NextCursor *pagination.CompositeCursor[string]
+}
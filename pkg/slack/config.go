@@ -0,0 +1,79 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// DefaultChannelTypes are the types of the channels synced if not configured.
+var DefaultChannelTypes = []string{"public_channel", "private_channel"}
+
+var supportedChannelTypes = map[string]struct{}{
+	"public_channel":  {},
+	"private_channel": {},
+	"mpim":            {},
+	"im":              {},
+}
+
+// Config is the configuration passed in each GetPage calls to the adapter.
+// Slack Adapter configuration example:
+// nolint: godot
+/*
+{
+    "requestTimeoutSeconds": 10,
+    "localTimeZoneOffset": 43200,
+    "teamId": "T0123456789",
+    "channelTypes": ["public_channel", "private_channel"],
+    "excludeArchivedChannels": true
+}
+*/
+type Config struct {
+	// Common configuration
+	*config.CommonConfig
+
+	// TeamID is the ID of the workspace of an Enterprise Grid organization to sync, e.g. "T0123456789".
+	// Required to sync the channels with an org-wide token, which can access every workspace of the organization.
+	// If not set, the workspace of the token is synced, or the whole organization for the users and user groups
+	// with an org-wide token.
+	TeamID *string `json:"teamId,omitempty"`
+
+	// ChannelTypes are the types of the channels to sync: "public_channel", "private_channel", "mpim" or "im".
+	// Defaults to DefaultChannelTypes.
+	ChannelTypes []string `json:"channelTypes,omitempty"`
+
+	// ExcludeArchivedChannels excludes the archived channels, and their members, from the sync.
+	ExcludeArchivedChannels bool `json:"excludeArchivedChannels,omitempty"`
+}
+
+// channelTypes returns the configured ChannelTypes, or the default.
+func (c *Config) channelTypes() []string {
+	if c == nil || len(c.ChannelTypes) == 0 {
+		return DefaultChannelTypes
+	}
+
+	return c.ChannelTypes
+}
+
+// ValidateConfig validates that a Config received in a GetPage call is valid.
+func (c *Config) Validate(_ context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.TeamID != nil && *c.TeamID == "" {
+		return errors.New("teamId cannot be an empty string")
+	}
+
+	for _, channelType := range c.ChannelTypes {
+		if _, found := supportedChannelTypes[channelType]; !found {
+			return fmt.Errorf("channelTypes contains an unsupported channel type: %v", channelType)
+		}
+	}
+
+	return c.CommonConfig.ValidateSyncMode()
+}
@@ -0,0 +1,363 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/httpds"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// Datasource directly implements a Client interface to allow querying an external datasource.
+type Datasource struct {
+	Client *http.Client
+}
+
+// ResponseMetadata is the metadata of a Slack Web API response, used for pagination.
+type ResponseMetadata struct {
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// DatasourceResponse is the format of the Slack Web API responses. The objects are returned in a member named
+// after the method, see Entity.responseMember.
+// https://api.slack.com/web#responses.
+type DatasourceResponse struct {
+	OK               bool              `json:"ok"`
+	Error            string            `json:"error,omitempty"`
+	ResponseMetadata *ResponseMetadata `json:"response_metadata,omitempty"`
+}
+
+// Entity contains entity specific information, such as the entity's unique ID attribute and the
+// Web API method to query that entity.
+type Entity struct {
+	// method is the Web API method to query the entity.
+	method string
+	// responseMember is the member of the response containing the objects.
+	responseMember string
+	// uniqueIDAttrExternalID is the external ID of the entity's uniqueId attribute.
+	uniqueIDAttrExternalID string
+	// paginated is whether the method supports cursor-based pagination.
+	// https://api.slack.com/apis/pagination.
+	paginated bool
+	// maxPageSize is the maximum value of the limit parameter accepted by the method.
+	maxPageSize int64
+	// memberOf is the external ID of the collection entity the entity is listed for, if any.
+	memberOf *string
+}
+
+const (
+	User          = "User"
+	UserGroup     = "UserGroup"
+	Channel       = "Channel"
+	ChannelMember = "ChannelMember"
+
+	// memberUserIDAttribute is the attribute of the channel members containing the ID of the user.
+	memberUserIDAttribute = "userId"
+)
+
+var (
+	// ValidEntityExternalIDs is a set of valid external IDs of entities that can be queried.
+	// The map value is the Entity struct which contains the unique ID attribute.
+	ValidEntityExternalIDs = map[string]Entity{
+		// https://api.slack.com/methods/users.list.
+		User: {
+			method:                 "users.list",
+			responseMember:         "members",
+			uniqueIDAttrExternalID: "id",
+			paginated:              true,
+			// Slack recommends no more than 200 users per page.
+			maxPageSize: 200,
+		},
+		// https://api.slack.com/methods/usergroups.list.
+		// The user groups are all returned at once, and paginated by the adapter.
+		UserGroup: {
+			method:                 "usergroups.list",
+			responseMember:         "usergroups",
+			uniqueIDAttrExternalID: "id",
+		},
+		// https://api.slack.com/methods/conversations.list.
+		Channel: {
+			method:                 "conversations.list",
+			responseMember:         "channels",
+			uniqueIDAttrExternalID: "id",
+			paginated:              true,
+			maxPageSize:            1000,
+		},
+		// https://api.slack.com/methods/conversations.members.
+		// The members are listed per channel, and only contain the IDs of the users.
+		ChannelMember: {
+			method:                 "conversations.members",
+			responseMember:         "members",
+			uniqueIDAttrExternalID: "id",
+			paginated:              true,
+			maxPageSize:            1000,
+			memberOf: func() *string {
+				s := Channel
+
+				return &s
+			}(),
+		},
+	}
+)
+
+// NewClient returns a Client to query the datasource.
+func NewClient(client *http.Client) Client {
+	return &Datasource{
+		Client: client,
+	}
+}
+
+func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response, *framework.Error) {
+	logger := zaplogger.FromContext(ctx).With(
+		fields.RequestEntityExternalID(request.EntityExternalID),
+		fields.RequestPageSize(request.PageSize),
+	)
+
+	logger.Info("Starting datasource request")
+
+	entity := ValidEntityExternalIDs[request.EntityExternalID]
+
+	// [MemberEntities] The members are listed one channel at a time, so set the `CollectionID` to the ID of the
+	// current channel, and the `CollectionCursor` to the cursor of the next channel.
+	if entity.memberOf != nil {
+		collectionReq := &Request{
+			BaseURL:                 request.BaseURL,
+			Token:                   request.Token,
+			PageSize:                1,
+			EntityExternalID:        *entity.memberOf,
+			TeamID:                  request.TeamID,
+			ChannelTypes:            request.ChannelTypes,
+			ExcludeArchivedChannels: request.ExcludeArchivedChannels,
+			RequestTimeoutSeconds:   request.RequestTimeoutSeconds,
+		}
+
+		if request.Cursor != nil && request.Cursor.CollectionCursor != nil {
+			collectionReq.Cursor = &pagination.CompositeCursor[string]{
+				Cursor: request.Cursor.CollectionCursor,
+			}
+		}
+
+		if request.Cursor == nil {
+			request.Cursor = &pagination.CompositeCursor[string]{}
+		}
+
+		isEmptyLastPage, cursorErr := pagination.UpdateNextCursorFromCollectionAPI(
+			ctx,
+			request.Cursor,
+			func(ctx context.Context, collectionReq *Request) (
+				int, string, []map[string]any, *pagination.CompositeCursor[string], *framework.Error,
+			) {
+				resp, err := d.GetPage(ctx, collectionReq)
+				if err != nil {
+					return 0, "", nil, nil, err
+				}
+
+				return resp.StatusCode, resp.RetryAfterHeader, resp.Objects, resp.NextCursor, nil
+			},
+			collectionReq,
+			ValidEntityExternalIDs[*entity.memberOf].uniqueIDAttrExternalID,
+		)
+		if cursorErr != nil {
+			return nil, cursorErr
+		}
+
+		if isEmptyLastPage {
+			return &Response{
+				StatusCode: http.StatusOK,
+			}, nil
+		}
+
+		// Slack may return a page without channels, but with a cursor to the next page, e.g. if the channels
+		// of the page are filtered out. Skip to the next channel.
+		if request.Cursor.CollectionID == nil {
+			return &Response{
+				StatusCode: http.StatusOK,
+				NextCursor: request.Cursor,
+			}, nil
+		}
+	}
+
+	validationErr := pagination.ValidateCompositeCursor(
+		request.Cursor,
+		request.EntityExternalID,
+		// Send a bool indicating if the entity is a member of a collection.
+		entity.memberOf != nil,
+	)
+	if validationErr != nil {
+		return nil, validationErr
+	}
+
+	endpoint, endpointErr := ConstructEndpoint(request)
+	if endpointErr != nil {
+		return nil, endpointErr
+	}
+
+	var (
+		objects        []map[string]any
+		nextCursorPage *string
+	)
+
+	httpResponse, frameworkErr := httpds.Do(ctx, d.Client, &httpds.Request{
+		URL:                   endpoint,
+		Header:                http.Header{"Authorization": {request.Token}},
+		DatasourceName:        "Slack",
+		RequestTimeoutSeconds: request.RequestTimeoutSeconds,
+		Logger:                logger,
+	}, func(body io.Reader) *framework.Error {
+		bodyBytes, readErr := httpds.ReadAll(body, "Slack")
+		if readErr != nil {
+			return readErr
+		}
+
+		var parseErr *framework.Error
+
+		objects, nextCursorPage, parseErr = ParseResponse(bodyBytes, request.EntityExternalID)
+
+		return parseErr
+	}, nil)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	response := &Response{
+		StatusCode:       httpResponse.StatusCode,
+		RetryAfterHeader: httpResponse.RetryAfterHeader,
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	// [UserGroups] No pagination support from the server side for this entity.
+	if !entity.paginated {
+		objects, nextCursorPage, frameworkErr = pagination.PaginateObjects(objects, request.PageSize, request.Cursor)
+		if frameworkErr != nil {
+			return nil, frameworkErr
+		}
+	}
+
+	if nextCursorPage != nil {
+		response.NextCursor = &pagination.CompositeCursor[string]{
+			Cursor: nextCursorPage,
+		}
+	}
+
+	// [MemberEntities] Set `id`, `channelId` and `userId`, and the cursor of the next page of members, or of
+	// the next channel.
+	if entity.memberOf != nil {
+		channelID := *request.Cursor.CollectionID
+
+		for _, member := range objects {
+			userID, _ := member[memberUserIDAttribute].(string)
+
+			member["id"] = channelID + "-" + userID
+			member["channelId"] = channelID
+		}
+
+		request.Cursor.Cursor = nextCursorPage
+		response.NextCursor = nil
+
+		if request.Cursor.Cursor != nil || request.Cursor.CollectionCursor != nil {
+			response.NextCursor = request.Cursor
+		}
+	}
+
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
+// ParseResponse parses the objects of the entity, and the cursor of the next page, from a Slack Web API response.
+// A response with "ok": false is returned as an error, see slackError.
+func ParseResponse(body []byte, entityExternalID string) (
+	objects []map[string]any,
+	nextCursor *string,
+	err *framework.Error,
+) {
+	var data DatasourceResponse
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &data); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	if !data.OK {
+		return nil, nil, slackError(data.Error)
+	}
+
+	var members map[string]json.RawMessage
+
+	if unmarshalErr := httpds.UnmarshalJSON(body, &members); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	rawObjects, found := members[ValidEntityExternalIDs[entityExternalID].responseMember]
+	if !found {
+		return nil, nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Slack response is missing the %s field.", ValidEntityExternalIDs[entityExternalID].responseMember,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	// [MemberEntities] The members of a channel are the IDs of the users.
+	if entityExternalID == ChannelMember {
+		var userIDs []string
+
+		if unmarshalErr := httpds.UnmarshalJSON(rawObjects, &userIDs); unmarshalErr != nil {
+			return nil, nil, unmarshalErr
+		}
+
+		objects = make([]map[string]any, 0, len(userIDs))
+
+		for _, userID := range userIDs {
+			objects = append(objects, map[string]any{memberUserIDAttribute: userID})
+		}
+	} else if unmarshalErr := httpds.UnmarshalJSON(rawObjects, &objects); unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	// The last page has an empty next_cursor.
+	if data.ResponseMetadata != nil && data.ResponseMetadata.NextCursor != "" {
+		nextCursor = &data.ResponseMetadata.NextCursor
+	}
+
+	return objects, nextCursor, nil
+}
+
+// slackError returns the error of a Slack Web API response with "ok": false. Slack responds with HTTP 200 and
+// an error code in the body, e.g. "invalid_auth": https://api.slack.com/web#evaluating_responses.
+func slackError(errorCode string) *framework.Error {
+	code := api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED
+
+	switch errorCode {
+	case "invalid_auth", "not_authed", "account_inactive", "token_revoked", "token_expired":
+		code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED
+	case "missing_scope", "not_allowed_token_type", "no_permission":
+		code = api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_AUTH
+	case "ratelimited":
+		code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS
+	case "internal_error", "fatal_error", "service_unavailable", "request_timeout":
+		code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE
+	}
+
+	return &framework.Error{
+		Message: fmt.Sprintf("Slack request failed with error: %s.", errorCode),
+		Code:    code,
+	}
+}
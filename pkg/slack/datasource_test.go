@@ -0,0 +1,455 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package slack_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/slack"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+// Define the endpoints and responses for the mock Slack server.
+// This handler is intended to be re-used throughout the test package.
+var TestServerHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer xoxp-test" {
+		w.Write([]byte(`{"ok": false, "error": "invalid_auth"}`))
+
+		return
+	}
+
+	switch r.URL.RequestURI() {
+	// Users Page 1
+	case "/api/users.list?limit=2":
+		w.Write([]byte(`{
+			"ok": true,
+			"members": [
+				{
+					"id": "U01",
+					"team_id": "T0123456789",
+					"name": "alice",
+					"deleted": false,
+					"real_name": "Alice Smith",
+					"profile": {"email": "alice@example.com"},
+					"is_admin": true,
+					"updated": 1706041056
+				},
+				{
+					"id": "U02",
+					"team_id": "T0123456789",
+					"name": "bob",
+					"deleted": false,
+					"real_name": "Bob Jones",
+					"profile": {"email": "bob@example.com"},
+					"is_admin": false,
+					"updated": 1706041057
+				}
+			],
+			"response_metadata": {"next_cursor": "dXNlcjpVMDM="}
+		}`))
+
+	// Users Page 2
+	case "/api/users.list?cursor=dXNlcjpVMDM%3D&limit=2":
+		w.Write([]byte(`{
+			"ok": true,
+			"members": [
+				{
+					"id": "U03",
+					"team_id": "T0123456789",
+					"name": "carol",
+					"deleted": true,
+					"real_name": "Carol White",
+					"profile": {"email": "carol@example.com"},
+					"is_admin": false,
+					"updated": 1706041058
+				}
+			],
+			"response_metadata": {"next_cursor": ""}
+		}`))
+
+	// Users of an unknown workspace
+	case "/api/users.list?limit=2&team_id=T0000000000":
+		w.Write([]byte(`{"ok": false, "error": "team_not_found"}`))
+
+	// User Groups
+	case "/api/usergroups.list?include_disabled=true&include_users=true":
+		w.Write([]byte(`{
+			"ok": true,
+			"usergroups": [
+				{"id": "S01", "team_id": "T0123456789", "name": "Admins", "handle": "admins", "users": ["U01"]},
+				{"id": "S02", "team_id": "T0123456789", "name": "Engineering", "handle": "eng", "users": ["U01", "U02"]},
+				{"id": "S03", "team_id": "T0123456789", "name": "Sales", "handle": "sales", "users": []}
+			]
+		}`))
+
+	// Channels Page 1
+	case "/api/conversations.list?limit=1&types=public_channel%2Cprivate_channel":
+		w.Write([]byte(`{
+			"ok": true,
+			"channels": [
+				{"id": "C01", "name": "general", "is_private": false, "is_archived": false, "created": 1449252889}
+			],
+			"response_metadata": {"next_cursor": "Y2hhbm5lbDpDMDI="}
+		}`))
+
+	// Channels Page 2
+	case "/api/conversations.list?cursor=Y2hhbm5lbDpDMDI%3D&limit=1&types=public_channel%2Cprivate_channel":
+		w.Write([]byte(`{
+			"ok": true,
+			"channels": [
+				{"id": "C02", "name": "secret", "is_private": true, "is_archived": false, "created": 1449252890}
+			],
+			"response_metadata": {"next_cursor": ""}
+		}`))
+
+	// Channel Members of C01 Page 1
+	case "/api/conversations.members?channel=C01&limit=2":
+		w.Write([]byte(`{
+			"ok": true,
+			"members": ["U01", "U02"],
+			"response_metadata": {"next_cursor": "bWVtYmVyOlUwMw=="}
+		}`))
+
+	// Channel Members of C01 Page 2
+	case "/api/conversations.members?channel=C01&cursor=bWVtYmVyOlUwMw%3D%3D&limit=2":
+		w.Write([]byte(`{
+			"ok": true,
+			"members": ["U03"],
+			"response_metadata": {"next_cursor": ""}
+		}`))
+
+	// Channel Members of C02
+	case "/api/conversations.members?channel=C02&limit=2":
+		w.Write([]byte(`{
+			"ok": true,
+			"members": ["U01"],
+			"response_metadata": {"next_cursor": ""}
+		}`))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestParseResponse(t *testing.T) {
+	tests := map[string]struct {
+		body             []byte
+		entityExternalID string
+		wantObjects      []map[string]any
+		wantNextCursor   *string
+		wantErr          *framework.Error
+	}{
+		"users": {
+			body:             []byte(`{"ok": true, "members": [{"id": "U01"}], "response_metadata": {"next_cursor": "abc"}}`),
+			entityExternalID: slack.User,
+			wantObjects:      []map[string]any{{"id": "U01"}},
+			wantNextCursor:   testutil.GenPtr("abc"),
+		},
+		"users_last_page": {
+			body:             []byte(`{"ok": true, "members": [{"id": "U01"}], "response_metadata": {"next_cursor": ""}}`),
+			entityExternalID: slack.User,
+			wantObjects:      []map[string]any{{"id": "U01"}},
+		},
+		"channel_members": {
+			body:             []byte(`{"ok": true, "members": ["U01", "U02"]}`),
+			entityExternalID: slack.ChannelMember,
+			wantObjects:      []map[string]any{{"userId": "U01"}, {"userId": "U02"}},
+		},
+		"missing_member": {
+			body:             []byte(`{"ok": true, "channels": []}`),
+			entityExternalID: slack.User,
+			wantErr: &framework.Error{
+				Message: "Slack response is missing the members field.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"invalid_auth": {
+			body:             []byte(`{"ok": false, "error": "invalid_auth"}`),
+			entityExternalID: slack.User,
+			wantErr: &framework.Error{
+				Message: "Slack request failed with error: invalid_auth.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+		},
+		"missing_scope": {
+			body:             []byte(`{"ok": false, "error": "missing_scope", "needed": "usergroups:read"}`),
+			entityExternalID: slack.UserGroup,
+			wantErr: &framework.Error{
+				Message: "Slack request failed with error: missing_scope.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_AUTH,
+			},
+		},
+		"ratelimited": {
+			body:             []byte(`{"ok": false, "error": "ratelimited"}`),
+			entityExternalID: slack.Channel,
+			wantErr: &framework.Error{
+				Message: "Slack request failed with error: ratelimited.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+			},
+		},
+		"unknown_error": {
+			body:             []byte(`{"ok": false, "error": "channel_not_found"}`),
+			entityExternalID: slack.ChannelMember,
+			wantErr: &framework.Error{
+				Message: "Slack request failed with error: channel_not_found.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextCursor, gotErr := slack.ParseResponse(tt.body, tt.entityExternalID)
+
+			if !reflect.DeepEqual(gotObjects, tt.wantObjects) {
+				t.Errorf("gotObjects: %v, wantObjects: %v", gotObjects, tt.wantObjects)
+			}
+
+			if !reflect.DeepEqual(gotNextCursor, tt.wantNextCursor) {
+				t.Errorf("gotNextCursor: %v, wantNextCursor: %v", gotNextCursor, tt.wantNextCursor)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetPage(t *testing.T) {
+	server := httptest.NewServer(TestServerHandler)
+	client := slack.NewClient(server.Client())
+
+	tests := map[string]struct {
+		request      *slack.Request
+		wantResponse *slack.Response
+		wantErr      *framework.Error
+	}{
+		"users_first_page": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-test",
+				PageSize:         2,
+				EntityExternalID: slack.User,
+			},
+			wantResponse: &slack.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"id":        "U01",
+						"team_id":   "T0123456789",
+						"name":      "alice",
+						"deleted":   false,
+						"real_name": "Alice Smith",
+						"profile":   map[string]any{"email": "alice@example.com"},
+						"is_admin":  true,
+						"updated":   float64(1706041056),
+					},
+					{
+						"id":        "U02",
+						"team_id":   "T0123456789",
+						"name":      "bob",
+						"deleted":   false,
+						"real_name": "Bob Jones",
+						"profile":   map[string]any{"email": "bob@example.com"},
+						"is_admin":  false,
+						"updated":   float64(1706041057),
+					},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("dXNlcjpVMDM="),
+				},
+			},
+		},
+		"users_last_page": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-test",
+				PageSize:         2,
+				EntityExternalID: slack.User,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("dXNlcjpVMDM="),
+				},
+			},
+			wantResponse: &slack.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"id":        "U03",
+						"team_id":   "T0123456789",
+						"name":      "carol",
+						"deleted":   true,
+						"real_name": "Carol White",
+						"profile":   map[string]any{"email": "carol@example.com"},
+						"is_admin":  false,
+						"updated":   float64(1706041058),
+					},
+				},
+			},
+		},
+		"users_unknown_team": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-test",
+				PageSize:         2,
+				EntityExternalID: slack.User,
+				TeamID:           testutil.GenPtr("T0000000000"),
+			},
+			wantErr: &framework.Error{
+				Message: "Slack request failed with error: team_not_found.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+		"user_groups_first_page": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-test",
+				PageSize:         2,
+				EntityExternalID: slack.UserGroup,
+			},
+			wantResponse: &slack.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "S01", "team_id": "T0123456789", "name": "Admins", "handle": "admins", "users": []any{"U01"}},
+					{"id": "S02", "team_id": "T0123456789", "name": "Engineering", "handle": "eng", "users": []any{"U01", "U02"}},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("2"),
+				},
+			},
+		},
+		"user_groups_last_page": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-test",
+				PageSize:         2,
+				EntityExternalID: slack.UserGroup,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("2"),
+				},
+			},
+			wantResponse: &slack.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "S03", "team_id": "T0123456789", "name": "Sales", "handle": "sales", "users": []any{}},
+				},
+			},
+		},
+		"channels_first_page": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-test",
+				PageSize:         1,
+				EntityExternalID: slack.Channel,
+				ChannelTypes:     slack.DefaultChannelTypes,
+			},
+			wantResponse: &slack.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "C01", "name": "general", "is_private": false, "is_archived": false, "created": float64(1449252889)},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("Y2hhbm5lbDpDMDI="),
+				},
+			},
+		},
+		"channel_members_first_page": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-test",
+				PageSize:         2,
+				EntityExternalID: slack.ChannelMember,
+				ChannelTypes:     slack.DefaultChannelTypes,
+			},
+			wantResponse: &slack.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "C01-U01", "channelId": "C01", "userId": "U01"},
+					{"id": "C01-U02", "channelId": "C01", "userId": "U02"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr("bWVtYmVyOlUwMw=="),
+					CollectionID:     testutil.GenPtr("C01"),
+					CollectionCursor: testutil.GenPtr("Y2hhbm5lbDpDMDI="),
+				},
+			},
+		},
+		"channel_members_last_page_of_channel": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-test",
+				PageSize:         2,
+				EntityExternalID: slack.ChannelMember,
+				ChannelTypes:     slack.DefaultChannelTypes,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor:           testutil.GenPtr("bWVtYmVyOlUwMw=="),
+					CollectionID:     testutil.GenPtr("C01"),
+					CollectionCursor: testutil.GenPtr("Y2hhbm5lbDpDMDI="),
+				},
+			},
+			wantResponse: &slack.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "C01-U03", "channelId": "C01", "userId": "U03"},
+				},
+				NextCursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("C01"),
+					CollectionCursor: testutil.GenPtr("Y2hhbm5lbDpDMDI="),
+				},
+			},
+		},
+		"channel_members_last_channel": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-test",
+				PageSize:         2,
+				EntityExternalID: slack.ChannelMember,
+				ChannelTypes:     slack.DefaultChannelTypes,
+				Cursor: &pagination.CompositeCursor[string]{
+					CollectionID:     testutil.GenPtr("C01"),
+					CollectionCursor: testutil.GenPtr("Y2hhbm5lbDpDMDI="),
+				},
+			},
+			wantResponse: &slack.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{"id": "C02-U01", "channelId": "C02", "userId": "U01"},
+				},
+			},
+		},
+		"invalid_auth": {
+			request: &slack.Request{
+				BaseURL:          server.URL,
+				Token:            "Bearer xoxp-invalid",
+				PageSize:         2,
+				EntityExternalID: slack.User,
+			},
+			wantErr: &framework.Error{
+				Message: "Slack request failed with error: invalid_auth.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResponse, gotErr := client.GetPage(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotResponse, tt.wantResponse) {
+				t.Errorf("gotResponse: %v, wantResponse: %v", gotResponse, tt.wantResponse)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package slack
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// ConstructEndpoint constructs and returns the endpoint to query the datasource.
+// For example, the endpoint of the second page of users of a workspace is:
+// https://slack.com/api/users.list?cursor=dXNlcjpVMDYxTkZUVDI%3D&limit=200&team_id=T0123456789.
+func ConstructEndpoint(request *Request) (string, *framework.Error) {
+	if request == nil {
+		return "", &framework.Error{
+			Message: "Request is nil.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	entity, found := ValidEntityExternalIDs[request.EntityExternalID]
+	if !found {
+		return "", &framework.Error{
+			Message: "Provided entity external ID is invalid.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	params := url.Values{}
+
+	switch request.EntityExternalID {
+	case UserGroup:
+		// The user groups aren't paginated by Slack, see ValidEntityExternalIDs.
+		params.Set("include_users", "true")
+		params.Set("include_disabled", "true")
+	case ChannelMember:
+		if request.Cursor == nil || request.Cursor.CollectionID == nil {
+			return "", &framework.Error{
+				Message: "Unable to construct the channel members endpoint without a channel ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			}
+		}
+
+		params.Set("channel", *request.Cursor.CollectionID)
+	case Channel:
+		params.Set("types", strings.Join(request.ChannelTypes, ","))
+
+		if request.ExcludeArchivedChannels {
+			params.Set("exclude_archived", "true")
+		}
+	}
+
+	if entity.paginated {
+		// Each method accepts a limit up to its own maximum, so cap the page size.
+		pageSize := request.PageSize
+		if pageSize > entity.maxPageSize {
+			pageSize = entity.maxPageSize
+		}
+
+		params.Set("limit", strconv.FormatInt(pageSize, 10))
+
+		if request.Cursor != nil && request.Cursor.Cursor != nil {
+			params.Set("cursor", *request.Cursor.Cursor)
+		}
+	}
+
+	// The members of a channel are listed regardless of the workspace.
+	if request.TeamID != nil && request.EntityExternalID != ChannelMember {
+		params.Set("team_id", *request.TeamID)
+	}
+
+	return request.BaseURL + "/api/" + entity.method + "?" + params.Encode(), nil
+}
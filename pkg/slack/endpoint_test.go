@@ -0,0 +1,122 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package slack_test
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/slack"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestConstructEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		request      *slack.Request
+		wantEndpoint string
+		wantErr      *framework.Error
+	}{
+		"nil_request": {
+			request: nil,
+			wantErr: &framework.Error{
+				Message: "Request is nil.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_entity": {
+			request: &slack.Request{
+				BaseURL:          "https://slack.com",
+				PageSize:         100,
+				EntityExternalID: "Invalid",
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"users_first_page": {
+			request: &slack.Request{
+				BaseURL:          "https://slack.com",
+				PageSize:         100,
+				EntityExternalID: slack.User,
+			},
+			wantEndpoint: "https://slack.com/api/users.list?limit=100",
+		},
+		"users_page_size_capped_with_cursor_and_team": {
+			request: &slack.Request{
+				BaseURL:          "https://slack.com",
+				PageSize:         500,
+				EntityExternalID: slack.User,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("dXNlcjpVMDYxTkZUVDI="),
+				},
+				TeamID: testutil.GenPtr("T0123456789"),
+			},
+			wantEndpoint: "https://slack.com/api/users.list?cursor=dXNlcjpVMDYxTkZUVDI%3D&limit=200&team_id=T0123456789",
+		},
+		"user_groups": {
+			request: &slack.Request{
+				BaseURL:          "https://slack.com",
+				PageSize:         100,
+				EntityExternalID: slack.UserGroup,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor: testutil.GenPtr("100"),
+				},
+				TeamID: testutil.GenPtr("T0123456789"),
+			},
+			wantEndpoint: "https://slack.com/api/usergroups.list?include_disabled=true&include_users=true&team_id=T0123456789",
+		},
+		"channels": {
+			request: &slack.Request{
+				BaseURL:                 "https://slack.com",
+				PageSize:                100,
+				EntityExternalID:        slack.Channel,
+				ChannelTypes:            []string{"public_channel", "private_channel", "mpim"},
+				ExcludeArchivedChannels: true,
+			},
+			wantEndpoint: "https://slack.com/api/conversations.list?exclude_archived=true&limit=100&types=public_channel%2Cprivate_channel%2Cmpim",
+		},
+		"channel_members": {
+			request: &slack.Request{
+				BaseURL:          "https://slack.com",
+				PageSize:         100,
+				EntityExternalID: slack.ChannelMember,
+				Cursor: &pagination.CompositeCursor[string]{
+					Cursor:       testutil.GenPtr("bWVtYmVyOlUwMw=="),
+					CollectionID: testutil.GenPtr("C01"),
+				},
+				TeamID: testutil.GenPtr("T0123456789"),
+			},
+			wantEndpoint: "https://slack.com/api/conversations.members?channel=C01&cursor=bWVtYmVyOlUwMw%3D%3D&limit=100",
+		},
+		"channel_members_missing_channel": {
+			request: &slack.Request{
+				BaseURL:          "https://slack.com",
+				PageSize:         100,
+				EntityExternalID: slack.ChannelMember,
+			},
+			wantErr: &framework.Error{
+				Message: "Unable to construct the channel members endpoint without a channel ID.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotEndpoint, gotErr := slack.ConstructEndpoint(tt.request)
+
+			if gotEndpoint != tt.wantEndpoint {
+				t.Errorf("gotEndpoint: %v, wantEndpoint: %v", gotEndpoint, tt.wantEndpoint)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package slack_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/slack"
+)
+
+// FuzzParseResponse checks that malformed Slack responses are rejected with an error instead of a panic, for all
+// the entities.
+func FuzzParseResponse(f *testing.F) {
+	entityExternalIDs := make([]string, 0, len(slack.ValidEntityExternalIDs))
+	for entityExternalID := range slack.ValidEntityExternalIDs {
+		entityExternalIDs = append(entityExternalIDs, entityExternalID)
+	}
+
+	slices.Sort(entityExternalIDs)
+
+	for _, seed := range []string{
+		`{"ok":true,"members":[{"id":"U0G9QF9C6","name":"john.doe"}],"response_metadata":{"next_cursor":"dXNlcjpVMEc5V0ZYTlo="}}`,
+		`{"ok":true,"channels":[{"id":"C012AB3CD","name":"general"}],"response_metadata":{"next_cursor":""}}`,
+		`{"ok":false,"error":"invalid_auth"}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), uint8(0))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, entityIndex uint8) {
+		entityExternalID := entityExternalIDs[int(entityIndex)%len(entityExternalIDs)]
+
+		_, _, err := slack.ParseResponse(body, entityExternalID)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for entity %s and body %q", entityExternalID, body)
+		}
+	})
+}
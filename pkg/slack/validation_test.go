@@ -0,0 +1,216 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: goconst
+package slack_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/slack"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestValidateGetPageRequest(t *testing.T) {
+	validEntity := framework.EntityConfig{
+		ExternalId: slack.User,
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+			},
+			{
+				ExternalId: "name",
+				Type:       framework.AttributeTypeString,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		request     *framework.Request[slack.Config]
+		wantErr     *framework.Error
+		wantAddress string
+	}{
+		"valid_request": {
+			request: &framework.Request[slack.Config]{
+				Address: "slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity: validEntity,
+				Config: &slack.Config{
+					TeamID:       testutil.GenPtr("T0123456789"),
+					ChannelTypes: []string{"public_channel", "im"},
+				},
+				PageSize: 200,
+			},
+			wantAddress: "https://slack.com",
+		},
+		"valid_request_nil_config": {
+			request: &framework.Request[slack.Config]{
+				Address: "https://slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity:   validEntity,
+				PageSize: 200,
+			},
+			wantAddress: "https://slack.com",
+		},
+		"invalid_request_empty_team_id": {
+			request: &framework.Request[slack.Config]{
+				Address: "https://slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity: validEntity,
+				Config: &slack.Config{
+					TeamID: testutil.GenPtr(""),
+				},
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: "Slack config is invalid: teamId cannot be an empty string.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_unsupported_channel_type": {
+			request: &framework.Request[slack.Config]{
+				Address: "https://slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity: validEntity,
+				Config: &slack.Config{
+					ChannelTypes: []string{"public_channel", "shared_channel"},
+				},
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: "Slack config is invalid: channelTypes contains an unsupported channel type: shared_channel.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_http_address": {
+			request: &framework.Request[slack.Config]{
+				Address: "http://slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity:   validEntity,
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: `Scheme "http" is not supported.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_auth": {
+			request: &framework.Request[slack.Config]{
+				Address:  "https://slack.com",
+				Entity:   validEntity,
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided datasource auth is missing required http authorization credentials.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_missing_bearer_prefix": {
+			request: &framework.Request[slack.Config]{
+				Address: "https://slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "xoxp-test",
+				},
+				Entity:   validEntity,
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: `Provided auth token is missing required "Bearer " prefix.`,
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_invalid_entity": {
+			request: &framework.Request[slack.Config]{
+				Address: "https://slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Message",
+					Attributes: validEntity.Attributes,
+				},
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided entity external ID is invalid.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_missing_unique_id": {
+			request: &framework.Request[slack.Config]{
+				Address: "https://slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: slack.User,
+					Attributes: validEntity.Attributes[1:],
+				},
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: "Requested entity attributes are missing unique ID attribute.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_ordered": {
+			request: &framework.Request[slack.Config]{
+				Address: "https://slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity:   validEntity,
+				Ordered:  true,
+				PageSize: 200,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_request_page_size_too_large": {
+			request: &framework.Request[slack.Config]{
+				Address: "https://slack.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer xoxp-test",
+				},
+				Entity:   validEntity,
+				PageSize: 1001,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1001) exceeds the maximum allowed (1000).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	adapter := &slack.Adapter{}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := adapter.ValidateGetPageRequest(context.Background(), tt.request)
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+
+			if tt.wantAddress != "" && tt.request.Address != tt.wantAddress {
+				t.Errorf("gotAddress: %v, wantAddress: %v", tt.request.Address, tt.wantAddress)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package statestore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileStore is a Store in a directory, with a file per key. It is only durable if the directory is on a
+// persistent volume, and only shared by the replicas of an adapter if the volume is.
+type FileStore struct {
+	dir string
+
+	// now returns the current time, replaced in tests.
+	now func() time.Time
+}
+
+// fileEntry is the content of the file of a key.
+type fileEntry struct {
+	Value     []byte     `json:"value"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// NewFileStore returns a FileStore in the directory, which is created if it doesn't exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		return nil, errors.New("state store directory is not set")
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create the state store directory: %w", err)
+	}
+
+	return &FileStore{
+		dir: dir,
+		now: time.Now,
+	}, nil
+}
+
+// path returns the path of the file of the key. Keys are hashed, as they may contain any character.
+func (s *FileStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get implements Store.
+func (s *FileStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state: %w", err)
+	}
+
+	var entry fileEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to parse state: %w", err)
+	}
+
+	if entry.ExpiresAt != nil && !s.now().Before(*entry.ExpiresAt) {
+		if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to delete expired state: %w", err)
+		}
+
+		return nil, ErrNotFound
+	}
+
+	return entry.Value, nil
+}
+
+// Set implements Store. The file is replaced atomically, so that a concurrent Get never reads a partial value.
+func (s *FileStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	entry := fileEntry{Value: value}
+	if ttl > 0 {
+		expiresAt := s.now().Add(ttl)
+		entry.ExpiresAt = &expiresAt
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, ".state-*")
+	if err != nil {
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path(key)); err != nil {
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+
+	return nil
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(_ context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete state: %w", err)
+	}
+
+	return nil
+}
+
+// Close implements Store.
+func (s *FileStore) Close() error {
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package statestore
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestFileStore(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+
+	store.now = func() time.Time { return clock }
+
+	testStore(t, store, &clock)
+}
+
+func TestFileStore_Persistence(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+
+	if err := store.Set(ctx, "users/../../etc/passwd", []byte("value"), 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	// The keys are hashed, so any key is stored in a single file of the directory.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("got %d files, want 1", len(entries))
+	}
+
+	reopened, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+
+	if got, err := reopened.Get(ctx, "users/../../etc/passwd"); err != nil || string(got) != "value" {
+		t.Errorf("Get: got %q, %v, want %q", got, err, "value")
+	}
+}
+
+func TestNewFileStore_NoDirectory(t *testing.T) {
+	if _, err := NewFileStore(""); err == nil || err.Error() != "state store directory is not set" {
+		t.Errorf("got error %v, want %q", err, "state store directory is not set")
+	}
+}
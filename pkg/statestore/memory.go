@@ -0,0 +1,81 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package statestore
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is a Store in memory.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+
+	// now returns the current time, replaced in tests.
+	now func() time.Time
+}
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]memoryEntry),
+		now:     time.Now,
+	}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	if !entry.expiresAt.IsZero() && !s.now().Before(entry.expiresAt) {
+		delete(s.entries, key)
+
+		return nil, ErrNotFound
+	}
+
+	return bytes.Clone(entry.value), nil
+}
+
+// Set implements Store.
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := memoryEntry{value: bytes.Clone(value)}
+	if ttl > 0 {
+		entry.expiresAt = s.now().Add(ttl)
+	}
+
+	s.entries[key] = entry
+
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+
+	return nil
+}
+
+// Close implements Store.
+func (s *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,280 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package statestore
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRedisPort is the port of the Redis server if not set in the URL.
+	defaultRedisPort = "6379"
+
+	// defaultRedisTimeout is the timeout of the commands sent without a context deadline.
+	defaultRedisTimeout = 5 * time.Second
+)
+
+// RedisStore is a Store in a Redis server, shared by the replicas of an adapter. Commands are sent with the
+// Redis serialization protocol (RESP) over a single connection, which is reopened after a network error.
+type RedisStore struct {
+	addr      string
+	tlsConfig *tls.Config
+	username  string
+	password  string
+	db        int
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// redisError is an error reply of the Redis server, e.g. "WRONGPASS invalid username-password pair".
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+// NewRedisStore returns a RedisStore connecting to the server at the URL, e.g. "redis://:password@host:6379/0",
+// or "rediss://" to connect with TLS. The connection is opened by the first command.
+func NewRedisStore(u *url.URL) (*RedisStore, error) {
+	if u.Hostname() == "" {
+		return nil, errors.New("redis state store URL has no host")
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = defaultRedisPort
+	}
+
+	store := &RedisStore{
+		addr: net.JoinHostPort(u.Hostname(), port),
+	}
+
+	if u.Scheme == "rediss" {
+		store.tlsConfig = &tls.Config{
+			ServerName: u.Hostname(),
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	if u.User != nil {
+		store.username = u.User.Username()
+		store.password, _ = u.User.Password()
+	}
+
+	if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+		var err error
+
+		if store.db, err = strconv.Atoi(db); err != nil {
+			return nil, fmt.Errorf("redis state store database %q is not a number", db)
+		}
+	}
+
+	return store, nil
+}
+
+// Get implements Store.
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, error) {
+	reply, err := s.do(ctx, "GET", key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return value, nil
+}
+
+// Set implements Store.
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	args := []string{"SET", key, string(value)}
+	if ttl > 0 {
+		args = append(args, "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	}
+
+	_, err := s.do(ctx, args...)
+
+	return err
+}
+
+// Delete implements Store.
+func (s *RedisStore) Delete(ctx context.Context, key string) error {
+	_, err := s.do(ctx, "DEL", key)
+
+	return err
+}
+
+// Close implements Store.
+func (s *RedisStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.closeConn()
+}
+
+// do sends a command and returns its reply: a []byte for bulk strings (nil if null), a string for simple strings,
+// or an int64 for integers.
+func (s *RedisStore) do(ctx context.Context, args ...string) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultRedisTimeout)
+	}
+
+	if s.conn == nil {
+		if err := s.connect(ctx, deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	reply, err := s.roundTrip(deadline, args)
+
+	// The connection is in an unknown state after a network error, e.g. a timeout in the middle of a reply.
+	var replyErr redisError
+	if err != nil && !errors.As(err, &replyErr) {
+		s.closeConn()
+	}
+
+	return reply, err
+}
+
+// connect opens the connection, and authenticates and selects the database if configured.
+func (s *RedisStore) connect(ctx context.Context, deadline time.Time) error {
+	dialer := &net.Dialer{Deadline: deadline}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	if s.tlsConfig != nil {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: s.tlsConfig}).DialContext(ctx, "tcp", s.addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", s.addr)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	s.conn = conn
+	s.reader = bufio.NewReader(conn)
+
+	if s.password != "" {
+		args := []string{"AUTH", s.password}
+		if s.username != "" {
+			args = []string{"AUTH", s.username, s.password}
+		}
+
+		if _, err := s.roundTrip(deadline, args); err != nil {
+			s.closeConn()
+
+			return err
+		}
+	}
+
+	if s.db != 0 {
+		if _, err := s.roundTrip(deadline, []string{"SELECT", strconv.Itoa(s.db)}); err != nil {
+			s.closeConn()
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *RedisStore) closeConn() error {
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn = nil
+	s.reader = nil
+
+	return err
+}
+
+// roundTrip writes a command as an array of bulk strings, and reads its reply.
+func (s *RedisStore) roundTrip(deadline time.Time, args []string) (any, error) {
+	if err := s.conn.SetDeadline(deadline); err != nil {
+		return nil, fmt.Errorf("failed to send redis command: %w", err)
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+
+	for _, arg := range args {
+		sb.WriteString("$" + strconv.Itoa(len(arg)) + "\r\n" + arg + "\r\n")
+	}
+
+	if _, err := io.WriteString(s.conn, sb.String()); err != nil {
+		return nil, fmt.Errorf("failed to send redis command: %w", err)
+	}
+
+	return s.readReply()
+}
+
+// readReply reads a reply of the RESP protocol. Arrays aren't supported, as no command sent returns one.
+func (s *RedisStore) readReply() (any, error) {
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("failed to read redis reply: %w", err)
+	}
+
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("failed to read redis reply: empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, redisError(line[1:])
+	case ':':
+		n, err := strconv.ParseInt(line[1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read redis reply: invalid integer %q", line[1:])
+		}
+
+		return n, nil
+	case '$':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read redis reply: invalid bulk string length %q", line[1:])
+		}
+
+		if size < 0 {
+			return nil, nil
+		}
+
+		value := make([]byte, size+2)
+		if _, err := io.ReadFull(s.reader, value); err != nil {
+			return nil, fmt.Errorf("failed to read redis reply: %w", err)
+		}
+
+		return value[:size], nil
+	default:
+		return nil, fmt.Errorf("failed to read redis reply: unsupported reply type %q", line[0])
+	}
+}
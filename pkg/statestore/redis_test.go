@@ -0,0 +1,282 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package statestore
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRedis is a Redis server supporting the commands sent by RedisStore, recording the commands received.
+type fakeRedis struct {
+	listener net.Listener
+	password string
+
+	mu       sync.Mutex
+	values   map[string]string
+	commands [][]string
+}
+
+func newFakeRedis(t *testing.T, password string) *fakeRedis {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+
+	server := &fakeRedis{
+		listener: listener,
+		password: password,
+		values:   make(map[string]string),
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go server.serve(conn)
+		}
+	}()
+
+	t.Cleanup(func() { listener.Close() })
+
+	return server
+}
+
+func (f *fakeRedis) url(userInfo string) *url.URL {
+	u, _ := url.Parse("redis://" + userInfo + f.listener.Addr().String() + "/2")
+
+	return u
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	authenticated := f.password == ""
+
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		f.mu.Lock()
+		f.commands = append(f.commands, args)
+
+		var reply string
+
+		switch {
+		case args[0] == "AUTH":
+			authenticated = args[len(args)-1] == f.password
+			reply = "+OK\r\n"
+
+			if !authenticated {
+				reply = "-WRONGPASS invalid username-password pair\r\n"
+			}
+		case !authenticated:
+			reply = "-NOAUTH Authentication required.\r\n"
+		case args[0] == "SELECT":
+			reply = "+OK\r\n"
+		case args[0] == "GET":
+			value, ok := f.values[args[1]]
+			reply = "$-1\r\n"
+
+			if ok {
+				reply = fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+			}
+		case args[0] == "SET":
+			f.values[args[1]] = args[2]
+			reply = "+OK\r\n"
+		case args[0] == "DEL":
+			_, ok := f.values[args[1]]
+			delete(f.values, args[1])
+			reply = ":0\r\n"
+
+			if ok {
+				reply = ":1\r\n"
+			}
+		default:
+			reply = "-ERR unknown command\r\n"
+		}
+
+		f.mu.Unlock()
+
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, count)
+
+	for range count {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "$")))
+		if err != nil {
+			return nil, err
+		}
+
+		arg := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, arg); err != nil {
+			return nil, err
+		}
+
+		args = append(args, string(arg[:size]))
+	}
+
+	return args, nil
+}
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeRedis(t, "secret")
+
+	store, err := NewRedisStore(server.url("default:secret@"))
+	if err != nil {
+		t.Fatalf("NewRedisStore: %v", err)
+	}
+
+	defer store.Close()
+
+	if _, err := store.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(missing): got error %v, want ErrNotFound", err)
+	}
+
+	if err := store.Set(ctx, "key", []byte("value\r\nwith a new line"), 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if err := store.Set(ctx, "expiring", []byte("soon"), time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if got, err := store.Get(ctx, "key"); err != nil || string(got) != "value\r\nwith a new line" {
+		t.Errorf("Get(key): got %q, %v, want %q", got, err, "value\r\nwith a new line")
+	}
+
+	if err := store.Delete(ctx, "key"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, err := store.Get(ctx, "key"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(deleted key): got error %v, want ErrNotFound", err)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	wantCommands := [][]string{
+		{"AUTH", "default", "secret"},
+		{"SELECT", "2"},
+		{"GET", "missing"},
+		{"SET", "key", "value\r\nwith a new line"},
+		{"SET", "expiring", "soon", "PX", "60000"},
+		{"GET", "key"},
+		{"DEL", "key"},
+		{"GET", "key"},
+	}
+
+	if !reflect.DeepEqual(server.commands, wantCommands) {
+		t.Errorf("got commands %q, want %q", server.commands, wantCommands)
+	}
+}
+
+func TestRedisStore_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("wrong_password", func(t *testing.T) {
+		server := newFakeRedis(t, "secret")
+
+		store, err := NewRedisStore(server.url(":wrong@"))
+		if err != nil {
+			t.Fatalf("NewRedisStore: %v", err)
+		}
+
+		defer store.Close()
+
+		wantErr := "redis: WRONGPASS invalid username-password pair"
+
+		if _, err := store.Get(ctx, "key"); err == nil || err.Error() != wantErr {
+			t.Errorf("got error %v, want %q", err, wantErr)
+		}
+	})
+
+	t.Run("reconnect_after_connection_closed", func(t *testing.T) {
+		server := newFakeRedis(t, "")
+
+		store, err := NewRedisStore(server.url(""))
+		if err != nil {
+			t.Fatalf("NewRedisStore: %v", err)
+		}
+
+		defer store.Close()
+
+		if err := store.Set(ctx, "key", []byte("value"), 0); err != nil {
+			t.Fatalf("Set: %v", err)
+		}
+
+		// Close the connection from the client side, as a server restart would.
+		store.mu.Lock()
+		store.conn.Close()
+		store.mu.Unlock()
+
+		if _, err := store.Get(ctx, "key"); err == nil {
+			t.Fatal("Get on a closed connection: got nil error")
+		}
+
+		if got, err := store.Get(ctx, "key"); err != nil || string(got) != "value" {
+			t.Errorf("Get after reconnecting: got %q, %v, want %q", got, err, "value")
+		}
+	})
+
+	t.Run("connection_refused", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("Listen: %v", err)
+		}
+
+		addr := listener.Addr().String()
+		listener.Close()
+
+		store, err := NewRedisStore(&url.URL{Scheme: "redis", Host: addr})
+		if err != nil {
+			t.Fatalf("NewRedisStore: %v", err)
+		}
+
+		if _, err := store.Get(ctx, "key"); err == nil || !strings.HasPrefix(err.Error(), "failed to connect to redis") {
+			t.Errorf("got error %v, want a connection error", err)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package statestore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+)
+
+// DefaultTable is the table of the state of the adapters in a Postgres store.
+const DefaultTable = "adapter_state"
+
+// validTable matches the table names accepted by NewSQLStore, which are interpolated in the queries.
+var validTable = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SQLStore is a Store in a Postgres table, shared by the replicas of an adapter. Expired rows are ignored by Get,
+// and overwritten by Set.
+type SQLStore struct {
+	db    *sql.DB
+	table string
+
+	// now returns the current time, replaced in tests.
+	now func() time.Time
+}
+
+// NewSQLStore returns a SQLStore in the table of the database. The table must exist, see CreateTable.
+func NewSQLStore(db *sql.DB, table string) (*SQLStore, error) {
+	if !validTable.MatchString(table) {
+		return nil, fmt.Errorf("state store table name %q is invalid", table)
+	}
+
+	return &SQLStore{
+		db:    db,
+		table: table,
+		now:   time.Now,
+	}, nil
+}
+
+// OpenPostgres opens a SQLStore in the DefaultTable of the Postgres database at the URL, and creates the table
+// if it doesn't exist. The binary must register a database/sql driver named "postgres".
+func OpenPostgres(ctx context.Context, rawURL string) (*SQLStore, error) {
+	if !slices.Contains(sql.Drivers(), "postgres") {
+		return nil, errors.New("postgres state store requires a database/sql driver named \"postgres\"")
+	}
+
+	db, err := sql.Open("postgres", rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the postgres state store: %w", err)
+	}
+
+	store, err := NewSQLStore(db, DefaultTable)
+	if err != nil {
+		db.Close()
+
+		return nil, err
+	}
+
+	if err := store.CreateTable(ctx); err != nil {
+		db.Close()
+
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// CreateTable creates the table of the store if it doesn't exist.
+func (s *SQLStore) CreateTable(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+s.table+
+		" (key TEXT PRIMARY KEY, value BYTEA NOT NULL, expires_at TIMESTAMPTZ)")
+	if err != nil {
+		return fmt.Errorf("failed to create the state store table: %w", err)
+	}
+
+	return nil
+}
+
+// Get implements Store.
+func (s *SQLStore) Get(ctx context.Context, key string) ([]byte, error) {
+	var value []byte
+
+	err := s.db.QueryRowContext(ctx,
+		"SELECT value FROM "+s.table+" WHERE key = $1 AND (expires_at IS NULL OR expires_at > $2)",
+		key, s.now(),
+	).Scan(&value)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, ErrNotFound
+	case err != nil:
+		return nil, fmt.Errorf("failed to get state: %w", err)
+	}
+
+	return value, nil
+}
+
+// Set implements Store.
+func (s *SQLStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	var expiresAt *time.Time
+
+	if ttl > 0 {
+		t := s.now().Add(ttl)
+		expiresAt = &t
+	}
+
+	_, err := s.db.ExecContext(ctx,
+		"INSERT INTO "+s.table+" (key, value, expires_at) VALUES ($1, $2, $3) "+
+			"ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at",
+		key, value, expiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set state: %w", err)
+	}
+
+	return nil
+}
+
+// Delete implements Store.
+func (s *SQLStore) Delete(ctx context.Context, key string) error {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM "+s.table+" WHERE key = $1", key); err != nil {
+		return fmt.Errorf("failed to delete state: %w", err)
+	}
+
+	return nil
+}
+
+// Close implements Store.
+func (s *SQLStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package statestore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestSQLStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+
+	store, err := NewSQLStore(db, DefaultTable)
+	if err != nil {
+		t.Fatalf("NewSQLStore: %v", err)
+	}
+
+	store.now = func() time.Time { return now }
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		"CREATE TABLE IF NOT EXISTS adapter_state (key TEXT PRIMARY KEY, value BYTEA NOT NULL, expires_at TIMESTAMPTZ)",
+	)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	getQuery := regexp.QuoteMeta("SELECT value FROM adapter_state WHERE key = $1 AND (expires_at IS NULL OR expires_at > $2)")
+	setQuery := regexp.QuoteMeta("INSERT INTO adapter_state (key, value, expires_at) VALUES ($1, $2, $3) ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at")
+
+	mock.ExpectQuery(getQuery).WithArgs("missing", now).WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(setQuery).WithArgs("key", []byte("value"), nil).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(setQuery).WithArgs("expiring", []byte("soon"), now.Add(time.Minute)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getQuery).WithArgs("key", now).WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow([]byte("value")))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM adapter_state WHERE key = $1")).WithArgs("key").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getQuery).WithArgs("key", now).WillReturnError(errors.New("connection reset"))
+	mock.ExpectClose()
+
+	if err := store.CreateTable(ctx); err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+
+	if _, err := store.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(missing): got error %v, want ErrNotFound", err)
+	}
+
+	if err := store.Set(ctx, "key", []byte("value"), 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if err := store.Set(ctx, "expiring", []byte("soon"), time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if got, err := store.Get(ctx, "key"); err != nil || string(got) != "value" {
+		t.Errorf("Get(key): got %q, %v, want %q", got, err, "value")
+	}
+
+	if err := store.Delete(ctx, "key"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, err := store.Get(ctx, "key"); err == nil || err.Error() != "failed to get state: connection reset" {
+		t.Errorf("Get(key): got error %v, want %q", err, "failed to get state: connection reset")
+	}
+
+	if err := store.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewSQLStore_InvalidTable(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+
+	defer db.Close()
+
+	wantErr := `state store table name "state; DROP TABLE users" is invalid`
+
+	if _, err := NewSQLStore(db, "state; DROP TABLE users"); err == nil || err.Error() != wantErr {
+		t.Errorf("got error %v, want %q", err, wantErr)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// Package syncsummary computes a summary of the objects returned for an entity across all the pages of a sync,
+// i.e. the number of objects and pages and a checksum of the objects, and logs it once the last page is returned,
+// so that operators can verify the completeness of a sync against the counts of the datasource.
+//
+// Adapters don't keep any state between pages, so the running summary is carried in the cursors returned
+// by the adapters wrapped with NewAdapter, along with the cursor of the wrapped adapter.
+//
+// The summary is enabled per datasource in the datasource config, see config.CommonConfig.
+package syncsummary
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// CursorPrefix is the prefix of the cursors carrying a summary, followed by the base64 encoded JSON Summary.
+const CursorPrefix = "syncsummary:"
+
+// cursorShape is the expected JSON shape of a Summary, used in cursor errors.
+const cursorShape = `{"cursor":<string>,"objects":<int64>,"pages":<int64>,"checksum":<string>,"partial":<bool>}`
+
+// Provider provides whether the summary of the syncs is enabled. It is implemented by config.CommonConfig,
+// and therefore by the configs of all adapters.
+type Provider interface {
+	// SyncSummaryEnabled returns whether the summary of the syncs is computed and logged.
+	SyncSummaryEnabled() bool
+}
+
+// Summary is the running summary of the objects returned for an entity during a sync.
+type Summary struct {
+	// Cursor is the cursor of the wrapped adapter.
+	Cursor string `json:"cursor,omitempty"`
+
+	// Objects is the number of objects returned so far, excluding child objects.
+	Objects int64 `json:"objects"`
+
+	// Pages is the number of pages returned so far.
+	Pages int64 `json:"pages"`
+
+	// Checksum is the hex encoded checksum of the objects returned so far, including child objects.
+	// It is the sum of the first 8 bytes of the SHA-256 hash of the JSON encoding of each object, so it
+	// doesn't depend on the order of the objects.
+	Checksum string `json:"checksum"`
+
+	// Partial is true if the summary doesn't cover the first pages of the sync, e.g. if the summary was enabled
+	// during the sync.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// Add adds the objects of a page to the summary.
+func (s *Summary) Add(objects []framework.Object) error {
+	checksum, err := s.checksum()
+	if err != nil {
+		return err
+	}
+
+	for _, object := range objects {
+		objectJSON, err := json.Marshal(object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal object: %w", err)
+		}
+
+		hash := sha256.Sum256(objectJSON)
+		checksum += binary.BigEndian.Uint64(hash[:8])
+	}
+
+	s.Objects += int64(len(objects))
+	s.Pages++
+	s.Checksum = fmt.Sprintf("%016x", checksum)
+
+	return nil
+}
+
+func (s *Summary) checksum() (uint64, error) {
+	if s.Checksum == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(s.Checksum, 16, 64)
+}
+
+// MarshalCursor returns the cursor carrying the summary.
+func (s *Summary) MarshalCursor() (string, error) {
+	summaryJSON, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+
+	return CursorPrefix + base64.StdEncoding.EncodeToString(summaryJSON), nil
+}
+
+// UnmarshalCursor returns the summary carried by a cursor. A cursor without the CursorPrefix, i.e. a cursor
+// returned by the wrapped adapter before the summary was enabled, is returned in a partial summary.
+func UnmarshalCursor(cursor, entityExternalID string) (*Summary, *framework.Error) {
+	if cursor == "" {
+		return &Summary{}, nil
+	}
+
+	encoded, found := strings.CutPrefix(cursor, CursorPrefix)
+	if !found {
+		return &Summary{Cursor: cursor, Partial: true}, nil
+	}
+
+	summaryJSON, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, cursorShape, fmt.Sprintf("failed to decode base64 cursor: %v", err),
+		)
+	}
+
+	var summary Summary
+
+	if err := json.Unmarshal(summaryJSON, &summary); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, cursorShape, fmt.Sprintf("failed to unmarshal JSON cursor: %v", err),
+		)
+	}
+
+	if _, err := summary.checksum(); err != nil {
+		return nil, pagination.NewCursorError(
+			entityExternalID, cursorShape, fmt.Sprintf("invalid checksum: %q", summary.Checksum),
+		)
+	}
+
+	return &summary, nil
+}
+
+// adapter computes the summary of the objects returned by the next adapter.
+type adapter[Config any] struct {
+	next framework.Adapter[Config]
+}
+
+// NewAdapter wraps an adapter to compute the summary of the objects it returns across the pages of a sync,
+// and to log it with the last page. The config of the adapter must implement Provider and enable the summary
+// for the summary to be computed, otherwise the requests and responses are left unchanged.
+func NewAdapter[Config any](next framework.Adapter[Config]) framework.Adapter[Config] {
+	return &adapter[Config]{
+		next: next,
+	}
+}
+
+// GetPage implements framework.Adapter.
+func (a *adapter[Config]) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	var provider Provider
+
+	if request.Config != nil {
+		provider, _ = any(request.Config).(Provider)
+	}
+
+	if provider == nil || !provider.SyncSummaryEnabled() {
+		return a.next.GetPage(ctx, request)
+	}
+
+	summary, cursorErr := UnmarshalCursor(request.Cursor, request.Entity.ExternalId)
+	if cursorErr != nil {
+		return framework.NewGetPageResponseError(cursorErr)
+	}
+
+	nextRequest := *request
+	nextRequest.Cursor = summary.Cursor
+
+	response := a.next.GetPage(ctx, &nextRequest)
+	if response.Success == nil {
+		return response
+	}
+
+	if err := summary.Add(response.Success.Objects); err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: fmt.Sprintf("Failed to compute the sync summary: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		})
+	}
+
+	if response.Success.NextCursor == "" {
+		zaplogger.FromContext(ctx).Info("Sync summary",
+			fields.RequestEntityExternalID(request.Entity.ExternalId),
+			fields.SyncObjectCount(summary.Objects),
+			fields.SyncPageCount(summary.Pages),
+			fields.SyncChecksum(summary.Checksum),
+			fields.SyncSummaryPartial(summary.Partial),
+		)
+
+		return response
+	}
+
+	summary.Cursor = response.Success.NextCursor
+
+	nextCursor, err := summary.MarshalCursor()
+	if err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: fmt.Sprintf("Failed to marshal the sync summary cursor: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		})
+	}
+
+	response.Success.NextCursor = nextCursor
+
+	return response
+}
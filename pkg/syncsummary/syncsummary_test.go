@@ -0,0 +1,209 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll
+package syncsummary_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
+	"github.com/sgnl-ai/adapters/pkg/logs/zaplogger/fields"
+	"github.com/sgnl-ai/adapters/pkg/syncsummary"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+)
+
+func TestNewAdapter(t *testing.T) {
+	pages := map[string]*framework.Page{
+		"": {
+			Objects: []framework.Object{
+				{"id": "00u1", "email": "john.doe@example.com"},
+				{"id": "00u2", "email": "jane.doe@example.com"},
+			},
+			NextCursor: "page2",
+		},
+		"page2": {
+			Objects: []framework.Object{
+				{"id": "00u3", "email": "john.smith@example.com", "Manager": []framework.Object{{"id": "00u1"}}},
+			},
+		},
+	}
+
+	entity := framework.EntityConfig{ExternalId: "User"}
+
+	t.Run("enabled", func(t *testing.T) {
+		next := &testutil.Adapter{Pages: pages}
+		adapter := syncsummary.NewAdapter[testutil.Config](next)
+		cfg := &testutil.Config{CommonConfig: &config.CommonConfig{SyncSummary: true}}
+
+		ctx, observedLogs := testutil.NewContextWithObservableLogger(context.Background())
+
+		firstResponse := adapter.GetPage(ctx, &framework.Request[testutil.Config]{Config: cfg, Entity: entity})
+		if firstResponse.Error != nil {
+			t.Fatalf("unexpected error: %v", firstResponse.Error)
+		}
+
+		if !strings.HasPrefix(firstResponse.Success.NextCursor, syncsummary.CursorPrefix) {
+			t.Fatalf("cursor does not carry the summary: %s", firstResponse.Success.NextCursor)
+		}
+
+		if !reflect.DeepEqual(firstResponse.Success.Objects, pages[""].Objects) {
+			t.Errorf("gotObjects: %v, wantObjects: %v", firstResponse.Success.Objects, pages[""].Objects)
+		}
+
+		if observedLogs.Len() != 0 {
+			t.Errorf("unexpected logs before the last page: %v", observedLogs.All())
+		}
+
+		lastResponse := adapter.GetPage(ctx, &framework.Request[testutil.Config]{
+			Config: cfg,
+			Entity: entity,
+			Cursor: firstResponse.Success.NextCursor,
+		})
+		if lastResponse.Error != nil {
+			t.Fatalf("unexpected error: %v", lastResponse.Error)
+		}
+
+		if lastResponse.Success.NextCursor != "" {
+			t.Errorf("unexpected next cursor: %s", lastResponse.Success.NextCursor)
+		}
+
+		if !reflect.DeepEqual(next.GotCursors, []string{"", "page2"}) {
+			t.Errorf("gotCursors: %v, wantCursors: %v", next.GotCursors, []string{"", "page2"})
+		}
+
+		// The checksum doesn't depend on the order of the objects.
+		wantSummary := &syncsummary.Summary{}
+
+		for _, object := range []framework.Object{pages["page2"].Objects[0], pages[""].Objects[1], pages[""].Objects[0]} {
+			if err := wantSummary.Add([]framework.Object{object}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		testutil.ValidateLogOutput(t, observedLogs, []map[string]any{
+			{
+				"level":                             "info",
+				"msg":                               "Sync summary",
+				fields.FieldRequestEntityExternalID: "User",
+				fields.FieldSyncObjectCount:         int64(3),
+				fields.FieldSyncPageCount:           int64(2),
+				fields.FieldSyncChecksum:            wantSummary.Checksum,
+				fields.FieldSyncSummaryPartial:      false,
+			},
+		})
+	})
+
+	t.Run("enabled_during_sync", func(t *testing.T) {
+		next := &testutil.Adapter{Pages: pages}
+		adapter := syncsummary.NewAdapter[testutil.Config](next)
+		cfg := &testutil.Config{CommonConfig: &config.CommonConfig{SyncSummary: true}}
+
+		ctx, observedLogs := testutil.NewContextWithObservableLogger(context.Background())
+
+		response := adapter.GetPage(ctx, &framework.Request[testutil.Config]{Config: cfg, Entity: entity, Cursor: "page2"})
+		if response.Error != nil {
+			t.Fatalf("unexpected error: %v", response.Error)
+		}
+
+		wantSummary := &syncsummary.Summary{}
+		if err := wantSummary.Add(pages["page2"].Objects); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		testutil.ValidateLogOutput(t, observedLogs, []map[string]any{
+			{
+				"level":                             "info",
+				"msg":                               "Sync summary",
+				fields.FieldRequestEntityExternalID: "User",
+				fields.FieldSyncObjectCount:         int64(1),
+				fields.FieldSyncPageCount:           int64(1),
+				fields.FieldSyncChecksum:            wantSummary.Checksum,
+				fields.FieldSyncSummaryPartial:      true,
+			},
+		})
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		next := &testutil.Adapter{Pages: pages}
+		adapter := syncsummary.NewAdapter[testutil.Config](next)
+
+		response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{
+			Config: &testutil.Config{},
+			Entity: entity,
+		})
+
+		if !reflect.DeepEqual(response, framework.NewGetPageResponseSuccess(pages[""])) {
+			t.Errorf("gotResponse: %v, wantResponse: %v", response, framework.NewGetPageResponseSuccess(pages[""]))
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		next := &testutil.Adapter{Pages: map[string]*framework.Page{}}
+		adapter := syncsummary.NewAdapter[testutil.Config](next)
+
+		response := adapter.GetPage(context.Background(), &framework.Request[testutil.Config]{
+			Config: &testutil.Config{CommonConfig: &config.CommonConfig{SyncSummary: true}},
+			Entity: entity,
+		})
+
+		wantErr := &framework.Error{
+			Message: "Datasource failed.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		}
+
+		if !reflect.DeepEqual(response.Error, wantErr) {
+			t.Errorf("gotErr: %v, wantErr: %v", response.Error, wantErr)
+		}
+	})
+}
+
+func TestUnmarshalCursor(t *testing.T) {
+	tests := map[string]struct {
+		cursor      string
+		wantSummary *syncsummary.Summary
+		wantErr     *framework.Error
+	}{
+		"empty": {
+			cursor:      "",
+			wantSummary: &syncsummary.Summary{},
+		},
+		"without_summary": {
+			cursor:      "page2",
+			wantSummary: &syncsummary.Summary{Cursor: "page2", Partial: true},
+		},
+		"with_summary": {
+			// {"cursor":"page2","objects":2,"pages":1,"checksum":"00000000000000ff"}
+			cursor: syncsummary.CursorPrefix +
+				"eyJjdXJzb3IiOiJwYWdlMiIsIm9iamVjdHMiOjIsInBhZ2VzIjoxLCJjaGVja3N1bSI6IjAwMDAwMDAwMDAwMDAwZmYifQ==",
+			wantSummary: &syncsummary.Summary{Cursor: "page2", Objects: 2, Pages: 1, Checksum: "00000000000000ff"},
+		},
+		"invalid_base64": {
+			cursor: syncsummary.CursorPrefix + "%%%",
+			wantErr: &framework.Error{
+				Message: "Invalid cursor for entity User: failed to decode base64 cursor: illegal base64 data at input byte 0. " +
+					`Expected cursor shape: {"cursor":<string>,"objects":<int64>,"pages":<int64>,"checksum":<string>,"partial":<bool>}. ` +
+					"Restart the sync for this entity to discard the invalid cursor.",
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotSummary, gotErr := syncsummary.UnmarshalCursor(tt.cursor, "User")
+
+			if !reflect.DeepEqual(gotSummary, tt.wantSummary) {
+				t.Errorf("gotSummary: %v, wantSummary: %v", gotSummary, tt.wantSummary)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("gotErr: %v, wantErr: %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
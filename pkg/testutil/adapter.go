@@ -0,0 +1,54 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package testutil
+
+import (
+	"context"
+	"slices"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/config"
+)
+
+// Config is the config of the Adapter. It embeds the common config like the configs of the adapters.
+type Config struct {
+	*config.CommonConfig
+}
+
+// Adapter is a fake adapter used to test the adapter wrappers. It returns the page keyed by the cursor of the
+// request, or a datasource error if no page is keyed by the cursor.
+type Adapter struct {
+	// Pages are the pages returned, keyed by the cursor of the request.
+	Pages map[string]*framework.Page
+
+	// OnPage, if set, is called with the context of the request and the page before returning it, e.g. to
+	// observe the objects of the page like the adapters do.
+	OnPage func(ctx context.Context, page *framework.Page)
+
+	// GotCursors are the cursors of the requests received, in order.
+	GotCursors []string
+}
+
+// GetPage returns a copy of the page keyed by the cursor of the request, so that the wrappers modifying the
+// objects of the page don't modify the pages returned for the next requests.
+func (a *Adapter) GetPage(ctx context.Context, request *framework.Request[Config]) framework.Response {
+	a.GotCursors = append(a.GotCursors, request.Cursor)
+
+	page, found := a.Pages[request.Cursor]
+	if !found {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: "Datasource failed.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		})
+	}
+
+	pageCopy := *page
+	pageCopy.Objects = slices.Clone(page.Objects)
+
+	if a.OnPage != nil {
+		a.OnPage(ctx, &pageCopy)
+	}
+
+	return framework.NewGetPageResponseSuccess(&pageCopy)
+}
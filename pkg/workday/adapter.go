@@ -12,6 +12,7 @@ import (
 	"github.com/sgnl-ai/adapter-framework/web"
 	"github.com/sgnl-ai/adapters/pkg/config"
 	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/schemadrift"
 )
 
 // Adapter implements the framework.Adapter interface to query pages of objects
@@ -49,23 +50,31 @@ func (a *Adapter) RequestPageFromDatasource(
 	commonConfig = config.SetMissingCommonConfigDefaults(commonConfig)
 
 	// Unmarshal the current cursor.
-	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor)
+	cursor, err := pagination.UnmarshalCursor[int64](request.Cursor, request.Entity.ExternalId)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
 	}
 
 	workdayReq := &Request{
 		BaseURL:               request.Address,
+		Transport:             request.Config.Transport,
 		Token:                 request.Auth.HTTPAuthorization,
-		PageSize:              request.PageSize,
+		PageSize:              commonConfig.PageSizeForEntity(request.Entity.ExternalId, request.PageSize),
 		Ordered:               request.Ordered,
 		EntityConfig:          &request.Entity,
 		APIVersion:            request.Config.APIVersion,
 		OrganizationID:        request.Config.OrganizationID,
+		AsOfEffectiveDate:     request.Config.AsOfEffectiveDate,
+		AsOfEntryDateTime:     request.Config.AsOfEntryDateTime,
 		Cursor:                cursor,
 		RequestTimeoutSeconds: *commonConfig.RequestTimeoutSeconds,
 	}
 
+	if request.Auth.Basic != nil {
+		workdayReq.Username = request.Auth.Basic.Username
+		workdayReq.Password = request.Auth.Basic.Password
+	}
+
 	resp, err := a.WorkdayClient.GetPage(ctx, workdayReq)
 	if err != nil {
 		return framework.NewGetPageResponseError(err)
@@ -77,6 +86,8 @@ func (a *Adapter) RequestPageFromDatasource(
 		return framework.NewGetPageResponseError(adapterErr)
 	}
 
+	schemadrift.Observe(ctx, resp.Objects)
+
 	// The raw JSON objects from the response must be parsed and converted into framework.Objects.
 	// DateTime values are parsed using the specified DateTimeFormatWithTimeZone.
 	parsedObjects, parserErr := web.ConvertJSONObjectList(
@@ -19,10 +19,24 @@ type Request struct {
 	// BaseURL is the Base URL of the datasource to query.
 	BaseURL string
 
+	// Transport is the transport used to query the datasource, either TransportWQL or TransportSOAP.
+	// Defaults to TransportWQL if empty.
+	Transport string
+
 	// Token is the Bearer API token to authenticate a request.
+	// This is only used when Transport is TransportWQL.
 	Token string
 
+	// Username is the username used in the WS-Security username token, in the format "{username}@{tenant}".
+	// This is only used when Transport is TransportSOAP.
+	Username string
+
+	// Password is the password used in the WS-Security username token.
+	// This is only used when Transport is TransportSOAP.
+	Password string
+
 	// APIVersion the API version to use.
+	// When Transport is TransportSOAP, this is the version of the Workday Web Services, e.g. "v43.0".
 	APIVersion string
 
 	// OrganizationID is the ID of the organization in Workday.
@@ -39,6 +53,13 @@ type Request struct {
 	// nil in the request for the first page.
 	Cursor *pagination.CompositeCursor[int64]
 
+	// AsOfEffectiveDate is the effective date of the data to query, e.g. "2024-06-30". The current date if empty.
+	AsOfEffectiveDate string
+
+	// AsOfEntryDateTime is the moment the data to query was entered, e.g. "2024-06-30T00:00:00Z".
+	// The current moment if empty.
+	AsOfEntryDateTime string
+
 	// EntityConfig contains the attributes that will be used to build the wql query.
 	EntityConfig *framework.EntityConfig
 
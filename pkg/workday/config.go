@@ -6,6 +6,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/sgnl-ai/adapters/pkg/config"
 )
@@ -14,6 +16,9 @@ var supportedAPIVersions = map[string]struct{}{
 	"v1": {},
 }
 
+// soapAPIVersionRegex matches the versions of the Workday Web Services, e.g. "v43.0".
+var soapAPIVersionRegex = regexp.MustCompile(`^v[0-9]+\.[0-9]+$`)
+
 // Config is the configuration passed in each GetPage calls to the adapter.
 // Workday Adapter configuration example:
 // nolint: godot
@@ -22,7 +27,9 @@ var supportedAPIVersions = map[string]struct{}{
     "requestTimeoutSeconds": 10,
     "localTimeZoneOffset": 43200,
     "apiVersion": "v1",
-	"organizationId": "SGNL"
+	"organizationId": "SGNL",
+    "asOfEffectiveDate": "2024-06-30",
+    "asOfEntryDateTime": "2024-06-30T00:00:00Z"
 }
 */
 type Config struct {
@@ -33,7 +40,23 @@ type Config struct {
 	APIVersion string `json:"apiVersion,omitempty"`
 
 	// OrganizationID is the ID of the organization in Workday.
+	// When Transport is SOAP, this is the name of the tenant.
 	OrganizationID string `json:"organizationId,omitempty"`
+
+	// Transport is the transport used to query Workday. Either "WQL" (default) to query data sources
+	// using WQL over the REST API, or "SOAP" to query the Get_Workers and Get_Organizations operations of
+	// the Human_Resources web service, for tenants whose WQL endpoints are not enabled.
+	// When Transport is SOAP, APIVersion is the version of the Workday Web Services, e.g. "v43.0".
+	Transport string `json:"transport,omitempty"`
+
+	// AsOfEffectiveDate is the effective date of the data to query, in the format "2006-01-02", e.g. to sync the
+	// workers as of a future date, including the future-dated terminations and hires. Defaults to the current date.
+	AsOfEffectiveDate string `json:"asOfEffectiveDate,omitempty"`
+
+	// AsOfEntryDateTime is the moment the data to query was entered in Workday, in the RFC 3339 format, e.g.
+	// "2024-06-30T00:00:00Z". The changes entered later, including the corrections of past effective dates, are
+	// excluded, so that the same snapshot is returned by each sync. Defaults to the current moment.
+	AsOfEntryDateTime string `json:"asOfEntryDateTime,omitempty"`
 }
 
 // ValidateConfig validates that a Config received in a GetPage call is valid.
@@ -45,6 +68,18 @@ func (c *Config) Validate(_ context.Context) error {
 		return errors.New("apiVersion is not set")
 	case c.OrganizationID == "":
 		return errors.New("organizationId is not set")
+	case c.AsOfEffectiveDate != "" && !isValidTime(time.DateOnly, c.AsOfEffectiveDate):
+		return fmt.Errorf("asOfEffectiveDate is not a valid date in the format YYYY-MM-DD: %v", c.AsOfEffectiveDate)
+	case c.AsOfEntryDateTime != "" && !isValidTime(time.RFC3339, c.AsOfEntryDateTime):
+		return fmt.Errorf("asOfEntryDateTime is not a valid RFC 3339 date-time: %v", c.AsOfEntryDateTime)
+	case c.Transport == TransportSOAP:
+		if !soapAPIVersionRegex.MatchString(c.APIVersion) {
+			return fmt.Errorf("apiVersion is not a valid Workday Web Services version: %v", c.APIVersion)
+		}
+
+		return nil
+	case c.Transport != "" && c.Transport != TransportWQL:
+		return fmt.Errorf("transport is not supported: %v", c.Transport)
 	default:
 		if _, found := supportedAPIVersions[c.APIVersion]; !found {
 			return fmt.Errorf("apiVersion is not supported: %v", c.APIVersion)
@@ -53,3 +88,10 @@ func (c *Config) Validate(_ context.Context) error {
 		return nil
 	}
 }
+
+// isValidTime returns whether the value is a valid time in the layout.
+func isValidTime(layout, value string) bool {
+	_, err := time.Parse(layout, value)
+
+	return err == nil
+}
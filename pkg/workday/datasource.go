@@ -3,6 +3,7 @@
 package workday
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -71,6 +72,10 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 		return nil, validationErr
 	}
 
+	if request.Transport == TransportSOAP {
+		return d.getSOAPPage(ctx, logger, request)
+	}
+
 	endpoint, endpointErr := ConstructEndpoint(request)
 	if endpointErr != nil {
 		return nil, endpointErr
@@ -105,6 +110,7 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
 		},
 			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
 		)
 	}
 
@@ -152,6 +158,111 @@ func (d *Datasource) GetPage(ctx context.Context, request *Request) (*Response,
 	return response, nil
 }
 
+// getSOAPPage requests a page of objects using the SOAP transport.
+func (d *Datasource) getSOAPPage(
+	ctx context.Context, logger *zap.Logger, request *Request,
+) (*Response, *framework.Error) {
+	nonce, err := newSOAPNonce()
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to generate the WS-Security nonce: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	requestBody, bodyErr := ConstructSOAPRequestBody(request, nonce, time.Now())
+	if bodyErr != nil {
+		return nil, bodyErr
+	}
+
+	endpoint := ConstructSOAPEndpoint(request)
+
+	// Timeout API calls that take longer than the configured timeout.
+	apiCtx, cancel := context.WithTimeout(ctx, time.Duration(request.RequestTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(apiCtx, http.MethodPost, endpoint, bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to create request to datasource: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	req.Header.Add("Content-Type", "text/xml; charset=utf-8")
+
+	logger.Info("Sending request to datasource", fields.RequestURL(endpoint))
+
+	res, err := d.Client.Do(req)
+	if err != nil {
+		logger.Error("Request to datasource failed",
+			fields.RequestURL(endpoint),
+			fields.SGNLEventTypeError(),
+			zap.Error(err),
+		)
+
+		return nil, customerror.UpdateError(&framework.Error{
+			Message: fmt.Sprintf("Failed to execute Workday SOAP request: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		},
+			customerror.WithRequestTimeoutMessage(err, request.RequestTimeoutSeconds),
+			customerror.WithRetryClassification(err),
+		)
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to read Workday response body: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	response := &Response{
+		StatusCode:       res.StatusCode,
+		RetryAfterHeader: res.Header.Get("Retry-After"),
+	}
+
+	// Workday returns SOAP faults, e.g. for invalid credentials, with a 500 status code.
+	// Surface the fault string when present instead of a generic HTTP error.
+	if res.StatusCode != http.StatusOK {
+		logger.Error("Datasource responded with an error",
+			fields.RequestURL(endpoint),
+			fields.ResponseStatusCode(res.StatusCode),
+			fields.ResponseRetryAfterHeader(res.Header.Get("Retry-After")),
+			fields.SGNLEventTypeError(),
+		)
+
+		if faultErr := ParseSOAPFault(body); faultErr != nil {
+			return nil, faultErr
+		}
+
+		return response, nil
+	}
+
+	objects, nextCursor, frameworkErr := ParseSOAPResponse(body)
+	if frameworkErr != nil {
+		return nil, frameworkErr
+	}
+
+	if operation := SOAPOperations[request.EntityConfig.ExternalId]; operation.WorkerReference != "" {
+		setSOAPWorkerTypes(objects, operation.WorkerReference)
+	}
+
+	response.NextCursor = nextCursor
+	response.Objects = objects
+
+	logger.Info("Datasource request completed successfully",
+		fields.ResponseStatusCode(response.StatusCode),
+		fields.ResponseObjectCount(len(response.Objects)),
+		fields.ResponseNextCursor(response.NextCursor),
+	)
+
+	return response, nil
+}
+
 func ParseResponse(body []byte, request *Request, endpoint string) (
 	objects []map[string]any,
 	nextCursor *pagination.CompositeCursor[int64],
@@ -51,7 +51,7 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 	params.Add("limit", strconv.FormatInt(request.PageSize, 10))
 	params.Add("offset", strconv.FormatInt(offset, 10))
 
-	query, err := BuildQuery(request.EntityConfig, request.Ordered)
+	query, err := BuildQuery(request.EntityConfig, request.Ordered, WQLDataSourceParameters(request))
 	if err != nil {
 		return "", err
 	}
@@ -67,7 +67,30 @@ func ConstructEndpoint(request *Request) (string, *framework.Error) {
 	return sb.String(), nil
 }
 
-func BuildQuery(entity *framework.EntityConfig, ordered bool) (string, *framework.Error) {
+// WQLDataSourceParameters returns the parameters of the data source of the WQL query setting the as-of dates of the
+// request, e.g. (effectiveAsOfDate = "2024-06-30", entryMoment = "2024-06-30T00:00:00Z"), or an empty string if
+// none is set.
+func WQLDataSourceParameters(request *Request) string {
+	var parameters []string
+
+	if request.AsOfEffectiveDate != "" {
+		parameters = append(parameters, `effectiveAsOfDate = "`+request.AsOfEffectiveDate+`"`)
+	}
+
+	if request.AsOfEntryDateTime != "" {
+		parameters = append(parameters, `entryMoment = "`+request.AsOfEntryDateTime+`"`)
+	}
+
+	if len(parameters) == 0 {
+		return ""
+	}
+
+	return " (" + strings.Join(parameters, ", ") + ")"
+}
+
+// BuildQuery returns the WQL query selecting the attributes and the child entities of the entity from its data
+// source, followed by the data source parameters, if any.
+func BuildQuery(entity *framework.EntityConfig, ordered bool, dataSourceParameters string) (string, *framework.Error) {
 	var sb strings.Builder
 
 	// Create a map to act as a set for storing unique attributes
@@ -128,6 +151,7 @@ func BuildQuery(entity *framework.EntityConfig, ordered bool) (string, *framewor
 	sb.WriteString(joinedAttributes)
 	sb.WriteString(" FROM ")
 	sb.WriteString(entity.ExternalId)
+	sb.WriteString(dataSourceParameters)
 
 	if ordered {
 		sb.WriteString(" ORDER BY ")
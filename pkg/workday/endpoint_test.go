@@ -96,6 +96,30 @@ func TestConstructEndpoint(t *testing.T) {
 			},
 			wantEndpoint: "https://test-instance.workday.com/api/wql/v1/SGNL/data?limit=50&offset=50&query=SELECT+FTE%2C+company%2C+email_Work%2C+employeeID%2C+employeeType%2C+gender%2C+hireDate%2C+jobTitle%2C+managementLevel%2C+positionID%2C+worker%2C+workerActive+FROM+allWorkers",
 		},
+		"as_of_dates": {
+			request: &workday.Request{
+				BaseURL:           "https://test-instance.workday.com",
+				APIVersion:        "v1",
+				OrganizationID:    "SGNL",
+				PageSize:          50,
+				Ordered:           true,
+				AsOfEffectiveDate: "2024-06-30",
+				AsOfEntryDateTime: "2024-06-30T00:00:00Z",
+				EntityConfig:      PopulateDefaultWorkerEntityConfig(),
+			},
+			wantEndpoint: "https://test-instance.workday.com/api/wql/v1/SGNL/data?limit=50&offset=0&query=SELECT+FTE%2C+company%2C+email_Work%2C+employeeID%2C+employeeType%2C+gender%2C+hireDate%2C+jobTitle%2C+managementLevel%2C+positionID%2C+worker%2C+workerActive+FROM+allWorkers+%28effectiveAsOfDate+%3D+%222024-06-30%22%2C+entryMoment+%3D+%222024-06-30T00%3A00%3A00Z%22%29+ORDER+BY+worker+ASC",
+		},
+		"as_of_effective_date": {
+			request: &workday.Request{
+				BaseURL:           "https://test-instance.workday.com",
+				APIVersion:        "v1",
+				OrganizationID:    "SGNL",
+				PageSize:          50,
+				AsOfEffectiveDate: "2024-06-30",
+				EntityConfig:      PopulateDefaultWorkerEntityConfig(),
+			},
+			wantEndpoint: "https://test-instance.workday.com/api/wql/v1/SGNL/data?limit=50&offset=0&query=SELECT+FTE%2C+company%2C+email_Work%2C+employeeID%2C+employeeType%2C+gender%2C+hireDate%2C+jobTitle%2C+managementLevel%2C+positionID%2C+worker%2C+workerActive+FROM+allWorkers+%28effectiveAsOfDate+%3D+%222024-06-30%22%29",
+		},
 		"uncommon_json_path_support": {
 			request: &workday.Request{
 				BaseURL:        "https://test-instance.workday.com",
@@ -0,0 +1,61 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package workday_test
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/workday"
+)
+
+// FuzzParseResponse checks that malformed WQL responses are rejected with an error instead of a panic.
+func FuzzParseResponse(f *testing.F) {
+	for _, seed := range []string{
+		`{"total":2,"data":[{"workdayID":"3aa5550b7fe348b98d7b5741afc65534","employeeID":"21001"}]}`,
+		`{"error":"invalid request: WQL error.","errors":[{"error":"Invalid WQL query.","field":"query","location":"body"}]}`,
+		`{"total":0,"data":[]}`,
+		`{}`,
+		`[]`,
+		`null`,
+		`{"`,
+	} {
+		f.Add([]byte(seed), int64(1), int64(0))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte, pageSize int64, cursor int64) {
+		request := &workday.Request{
+			PageSize: pageSize,
+			Cursor: &pagination.CompositeCursor[int64]{
+				Cursor: &cursor,
+			},
+		}
+
+		_, _, err := workday.ParseResponse(body, request, "/api/wql/v1/sgnl/data")
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
+
+// FuzzParseSOAPResponse checks that malformed SOAP responses are rejected with an error instead of a panic.
+func FuzzParseSOAPResponse(f *testing.F) {
+	for _, seed := range []string{
+		soapWorkersPage1,
+		soapWorkersPage2,
+		soapFault,
+		``,
+		`<?xml version="1.0" encoding="UTF-8"?><env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/"><env:Body>`,
+		`<Envelope><Body><Get_Workers_Response><Response_Results><Total_Pages>x</Total_Pages></Response_Results></Get_Workers_Response></Body></Envelope>`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		_, _, err := workday.ParseSOAPResponse(body)
+		if err != nil && err.Message == "" {
+			t.Errorf("got an error without a message for body %q", body)
+		}
+	})
+}
@@ -0,0 +1,557 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package workday
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+)
+
+// SOAP transport, used for Workday tenants whose WQL REST endpoints are not enabled.
+// Each supported entity maps to a Get_* operation of a web service, e.g. the Human_Resources web service:
+// https://community.workday.com/sites/default/files/file-hosting/productionapi/Human_Resources/index.html.
+
+const (
+	// TransportWQL queries entities using WQL over the REST API. This is the default.
+	TransportWQL = "WQL"
+
+	// TransportSOAP queries entities using the Workday Web Services SOAP API.
+	TransportSOAP = "SOAP"
+
+	// SOAPMaxPageSize is the maximum number of objects returned per page by the SOAP API.
+	SOAPMaxPageSize = 999
+
+	soapEnvelopeNamespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	workdayNamespace      = "urn:com.workday/bsvc"
+	wsseNamespace         = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd"
+	wsuNamespace          = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-utility-1.0.xsd"
+
+	// nolint: lll
+	wssePasswordTextType = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText"
+	// nolint: lll
+	wsseBase64BinaryEncoding = "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary"
+
+	// soapNonceLength is the length in bytes of the random nonce of the WS-Security username token.
+	soapNonceLength = 16
+
+	// soapTextKey is the key under which the text content of an element is stored when the element
+	// also has attributes.
+	soapTextKey = "#text"
+
+	// soapAttributePrefix is the prefix of the keys under which the attributes of an element are stored.
+	soapAttributePrefix = "@"
+
+	// soapDefaultService is the web service of the operations which don't specify one.
+	soapDefaultService = "Human_Resources"
+
+	// SOAPWorkerTypeAttribute is the attribute set on the objects of the entities of workers, with the type
+	// of the worker, see SOAPWorkerTypes.
+	SOAPWorkerTypeAttribute = "workerType"
+)
+
+// SOAPWorkerTypes maps the types of the IDs of a worker reference to the type of the worker set in the
+// SOAPWorkerTypeAttribute attribute.
+var SOAPWorkerTypes = map[string]string{
+	"Employee_ID":          "Employee",
+	"Contingent_Worker_ID": "Contingent_Worker",
+	"Applicant_ID":         "Pre_Hire",
+}
+
+// SOAPOperation describes the SOAP operation used to query an entity.
+type SOAPOperation struct {
+	// Service is the name of the web service of the operation, e.g. "Recruiting".
+	// Defaults to "Human_Resources" if empty.
+	Service string
+
+	// RequestName is the name of the request element, e.g. "Get_Workers_Request".
+	RequestName string
+
+	// RequestCriteria contains the Request_Criteria flags included in each request, in order.
+	RequestCriteria []string
+
+	// ResponseGroup contains the Response_Group flags included in each request, in order.
+	ResponseGroup []string
+
+	// WorkerReference is the name of the element of each object referencing the worker, e.g. "Worker_Reference".
+	// If set, the type of the worker is parsed from the types of the IDs of the reference, see SOAPWorkerTypes.
+	WorkerReference string
+}
+
+// SOAPOperations maps the external ID of the entities supported by the SOAP transport to the operation
+// used to query them.
+var SOAPOperations = map[string]SOAPOperation{
+	"Worker": {
+		RequestName: "Get_Workers_Request",
+		ResponseGroup: []string{
+			"Include_Reference",
+			"Include_Personal_Information",
+			"Include_Employment_Information",
+			"Include_Organizations",
+			"Include_Roles",
+		},
+		WorkerReference: "Worker_Reference",
+	},
+	// ContingentWorker is the subset of the workers who are not employees, e.g. contractors.
+	"ContingentWorker": {
+		RequestName:     "Get_Workers_Request",
+		RequestCriteria: []string{"Exclude_Employees"},
+		ResponseGroup: []string{
+			"Include_Reference",
+			"Include_Personal_Information",
+			"Include_Employment_Information",
+			"Include_Organizations",
+			"Include_Roles",
+		},
+		WorkerReference: "Worker_Reference",
+	},
+	// PreHire is a person who has been hired but is not a worker yet, i.e. an applicant before their first day.
+	"PreHire": {
+		Service:     "Recruiting",
+		RequestName: "Get_Applicants_Request",
+		ResponseGroup: []string{
+			"Include_Reference",
+			"Include_Personal_Data",
+			"Include_Recruiting_Data",
+		},
+		WorkerReference: "Applicant_Reference",
+	},
+	"Organization": {
+		RequestName: "Get_Organizations_Request",
+		ResponseGroup: []string{
+			"Include_Hierarchy_Data",
+			"Include_Roles_Data",
+		},
+	},
+}
+
+type soapEnvelope struct {
+	XMLName xml.Name   `xml:"env:Envelope"`
+	Env     string     `xml:"xmlns:env,attr"`
+	Header  soapHeader `xml:"env:Header"`
+	Body    soapBody   `xml:"env:Body"`
+}
+
+type soapHeader struct {
+	Security soapSecurity `xml:"wsse:Security"`
+}
+
+type soapSecurity struct {
+	Wsse           string            `xml:"xmlns:wsse,attr"`
+	Wsu            string            `xml:"xmlns:wsu,attr"`
+	MustUnderstand string            `xml:"env:mustUnderstand,attr"`
+	UsernameToken  soapUsernameToken `xml:"wsse:UsernameToken"`
+}
+
+type soapUsernameToken struct {
+	Username string         `xml:"wsse:Username"`
+	Password soapTypedValue `xml:"wsse:Password"`
+	Nonce    soapNonce      `xml:"wsse:Nonce"`
+	Created  string         `xml:"wsu:Created"`
+}
+
+type soapTypedValue struct {
+	Type  string `xml:"Type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type soapNonce struct {
+	EncodingType string `xml:"EncodingType,attr"`
+	Value        string `xml:",chardata"`
+}
+
+type soapBody struct {
+	Content []byte `xml:",innerxml"`
+}
+
+// newSOAPUsernameToken returns the WS-Security username token used to authenticate a SOAP request.
+// Workday only accepts passwords of type PasswordText, so the nonce and creation time are included to
+// allow Workday to reject replayed tokens. The username must be in the format "{username}@{tenant}".
+func newSOAPUsernameToken(username, password string, nonce []byte, created time.Time) soapUsernameToken {
+	return soapUsernameToken{
+		Username: username,
+		Password: soapTypedValue{
+			Type:  wssePasswordTextType,
+			Value: password,
+		},
+		Nonce: soapNonce{
+			EncodingType: wsseBase64BinaryEncoding,
+			Value:        base64.StdEncoding.EncodeToString(nonce),
+		},
+		Created: created.UTC().Format(time.RFC3339),
+	}
+}
+
+// ConstructSOAPRequestBody returns the SOAP envelope to request the page of the given entity.
+// The envelope is authenticated with a WS-Security username token built from the request credentials,
+// the given nonce and creation time.
+func ConstructSOAPRequestBody(request *Request, nonce []byte, created time.Time) ([]byte, *framework.Error) {
+	operation, found := SOAPOperations[request.EntityConfig.ExternalId]
+	if !found {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf(
+				"Entity %s is not supported by the Workday SOAP transport.", request.EntityConfig.ExternalId,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	page := int64(1)
+
+	if request.Cursor != nil && request.Cursor.Cursor != nil {
+		if *request.Cursor.Cursor <= 1 {
+			return nil, &framework.Error{
+				Message: "Cursor value must be greater than 1.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		page = *request.Cursor.Cursor
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`<wd:`)
+	sb.WriteString(operation.RequestName)
+	sb.WriteString(` xmlns:wd="` + workdayNamespace + `" wd:version="`)
+	sb.WriteString(request.APIVersion)
+	sb.WriteString(`">`)
+
+	if len(operation.RequestCriteria) > 0 {
+		sb.WriteString(`<wd:Request_Criteria>`)
+
+		for _, flag := range operation.RequestCriteria {
+			sb.WriteString(`<wd:` + flag + `>true</wd:` + flag + `>`)
+		}
+
+		sb.WriteString(`</wd:Request_Criteria>`)
+	}
+
+	sb.WriteString(`<wd:Response_Filter>`)
+
+	// The as-of dates precede the page in the Response_Filter.
+	if request.AsOfEffectiveDate != "" {
+		sb.WriteString(`<wd:As_Of_Effective_Date>` + request.AsOfEffectiveDate + `</wd:As_Of_Effective_Date>`)
+	}
+
+	if request.AsOfEntryDateTime != "" {
+		sb.WriteString(`<wd:As_Of_Entry_DateTime>` + request.AsOfEntryDateTime + `</wd:As_Of_Entry_DateTime>`)
+	}
+
+	sb.WriteString(`<wd:Page>`)
+	sb.WriteString(strconv.FormatInt(page, 10))
+	sb.WriteString(`</wd:Page><wd:Count>`)
+	sb.WriteString(strconv.FormatInt(request.PageSize, 10))
+	sb.WriteString(`</wd:Count></wd:Response_Filter><wd:Response_Group>`)
+
+	for _, flag := range operation.ResponseGroup {
+		sb.WriteString(`<wd:` + flag + `>true</wd:` + flag + `>`)
+	}
+
+	sb.WriteString(`</wd:Response_Group></wd:`)
+	sb.WriteString(operation.RequestName)
+	sb.WriteString(`>`)
+
+	envelope := soapEnvelope{
+		Env: soapEnvelopeNamespace,
+		Header: soapHeader{
+			Security: soapSecurity{
+				Wsse:           wsseNamespace,
+				Wsu:            wsuNamespace,
+				MustUnderstand: "1",
+				UsernameToken:  newSOAPUsernameToken(request.Username, request.Password, nonce, created),
+			},
+		},
+		Body: soapBody{
+			Content: []byte(sb.String()),
+		},
+	}
+
+	body, err := xml.Marshal(envelope)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to marshal the Workday SOAP request: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+// ConstructSOAPEndpoint returns the endpoint of the web service of the tenant used to query the entity.
+func ConstructSOAPEndpoint(request *Request) string {
+	service := soapDefaultService
+
+	if operation := SOAPOperations[request.EntityConfig.ExternalId]; operation.Service != "" {
+		service = operation.Service
+	}
+
+	return request.BaseURL + "/ccx/service/" + request.OrganizationID + "/" + service + "/" + request.APIVersion
+}
+
+// newSOAPNonce returns a random nonce for a WS-Security username token.
+func newSOAPNonce() ([]byte, error) {
+	nonce := make([]byte, soapNonceLength)
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return nonce, nil
+}
+
+// ParseSOAPResponse parses the objects and the next cursor from a SOAP response body.
+// Each child element of Response_Data is converted into an object, e.g. each Worker element of a
+// Get_Workers_Response. See XMLElementToObject for details on the conversion.
+func ParseSOAPResponse(body []byte) (
+	objects []map[string]any,
+	nextCursor *pagination.CompositeCursor[int64],
+	err *framework.Error,
+) {
+	objects, results, parseErr := parseSOAPEnvelope(xml.NewDecoder(bytes.NewReader(body)))
+	if parseErr != nil {
+		return nil, nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to parse the Workday SOAP response: %v.", parseErr),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	if results.fault != "" {
+		return nil, nil, soapFaultError(results.fault)
+	}
+
+	if results.page == nil || results.totalPages == nil {
+		return nil, nil, &framework.Error{
+			Message: "Response_Results are missing in the Workday SOAP response.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	if *results.page < *results.totalPages {
+		nextPage := *results.page + 1
+		nextCursor = &pagination.CompositeCursor[int64]{
+			Cursor: &nextPage,
+		}
+	}
+
+	return objects, nextCursor, nil
+}
+
+// setSOAPWorkerTypes sets the SOAPWorkerTypeAttribute attribute of each object to the type of the worker
+// referenced by its referenceName element, e.g. "Contingent_Worker" for a reference with a Contingent_Worker_ID.
+// Objects whose reference has no ID of a known type are left unchanged.
+func setSOAPWorkerTypes(objects []map[string]any, referenceName string) {
+	for _, object := range objects {
+		reference, ok := object[referenceName].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		ids, ok := reference["ID"].([]any)
+		if !ok {
+			ids = []any{reference["ID"]}
+		}
+
+		for _, id := range ids {
+			idObject, ok := id.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			idType, _ := idObject[soapAttributePrefix+"type"].(string)
+
+			if workerType, found := SOAPWorkerTypes[idType]; found {
+				object[SOAPWorkerTypeAttribute] = workerType
+
+				break
+			}
+		}
+	}
+}
+
+// ParseSOAPFault returns an error containing the faultstring of the SOAP fault in the response body,
+// or nil if the body doesn't contain a fault.
+func ParseSOAPFault(body []byte) *framework.Error {
+	_, results, err := parseSOAPEnvelope(xml.NewDecoder(bytes.NewReader(body)))
+	if err != nil || results.fault == "" {
+		return nil
+	}
+
+	return soapFaultError(results.fault)
+}
+
+func soapFaultError(fault string) *framework.Error {
+	return &framework.Error{
+		Message: fmt.Sprintf("Workday SOAP request failed: %s.", fault),
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+	}
+}
+
+// soapResults contains the paging information and fault of a SOAP response.
+type soapResults struct {
+	page       *int64
+	totalPages *int64
+	fault      string
+}
+
+// parseSOAPEnvelope walks the elements of a SOAP response and extracts the objects of Response_Data,
+// the paging information of Response_Results, and the faultstring of a Fault, if any.
+func parseSOAPEnvelope(dec *xml.Decoder) ([]map[string]any, *soapResults, error) {
+	objects := make([]map[string]any, 0)
+	results := &soapResults{}
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return objects, results, nil
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "Response_Data":
+			if objects, err = parseSOAPResponseData(dec); err != nil {
+				return nil, nil, err
+			}
+		case "Page", "Total_Pages":
+			var value string
+
+			if err := dec.DecodeElement(&value, &start); err != nil {
+				return nil, nil, err
+			}
+
+			parsed, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid %s value: %w", start.Name.Local, err)
+			}
+
+			if start.Name.Local == "Page" {
+				results.page = &parsed
+			} else {
+				results.totalPages = &parsed
+			}
+		case "faultstring":
+			if err := dec.DecodeElement(&results.fault, &start); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+}
+
+// parseSOAPResponseData converts each child element of the current Response_Data element into an object.
+func parseSOAPResponseData(dec *xml.Decoder) ([]map[string]any, error) {
+	objects := make([]map[string]any, 0)
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			value, err := XMLElementToObject(dec, t)
+			if err != nil {
+				return nil, err
+			}
+
+			object, ok := value.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s element is empty", t.Name.Local)
+			}
+
+			objects = append(objects, object)
+		case xml.EndElement:
+			return objects, nil
+		}
+	}
+}
+
+// XMLElementToObject converts the XML element starting with start into a value, reading tokens from dec
+// up to and including the matching end element. Namespace prefixes are dropped from element and
+// attribute names.
+//
+//   - An element without attributes nor child elements is converted into its text content.
+//   - Otherwise, it is converted into a map[string]any. Child elements are stored under their name, and
+//     repeated child elements are collected into a []any. Attributes are stored under their name prefixed
+//     with "@", and non-blank text content is stored under "#text".
+//
+// For example, <wd:ID wd:type="Employee_ID">21001</wd:ID> is converted into
+// {"@type": "Employee_ID", "#text": "21001"}.
+func XMLElementToObject(dec *xml.Decoder, start xml.StartElement) (any, error) {
+	var (
+		object map[string]any
+		text   strings.Builder
+	)
+
+	for _, attr := range start.Attr {
+		// Skip namespace declarations.
+		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+			continue
+		}
+
+		if object == nil {
+			object = make(map[string]any, len(start.Attr))
+		}
+
+		object[soapAttributePrefix+attr.Name.Local] = attr.Value
+	}
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			child, err := XMLElementToObject(dec, t)
+			if err != nil {
+				return nil, err
+			}
+
+			if object == nil {
+				object = make(map[string]any)
+			}
+
+			switch existing := object[t.Name.Local].(type) {
+			case nil:
+				object[t.Name.Local] = child
+			case []any:
+				object[t.Name.Local] = append(existing, child)
+			default:
+				object[t.Name.Local] = []any{existing, child}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if object == nil {
+				return text.String(), nil
+			}
+
+			if trimmed := strings.TrimSpace(text.String()); trimmed != "" {
+				object[soapTextKey] = trimmed
+			}
+
+			return object, nil
+		}
+	}
+}
@@ -0,0 +1,552 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll, goconst
+package workday_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	framework "github.com/sgnl-ai/adapter-framework"
+	adapter_api_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/pkg/pagination"
+	"github.com/sgnl-ai/adapters/pkg/testutil"
+	"github.com/sgnl-ai/adapters/pkg/workday"
+)
+
+const (
+	soapWorkersPage1 = `<?xml version="1.0" encoding="UTF-8"?>
+<env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/">
+	<env:Body>
+		<wd:Get_Workers_Response xmlns:wd="urn:com.workday/bsvc" wd:version="v43.0">
+			<wd:Response_Filter>
+				<wd:Page>1</wd:Page>
+				<wd:Count>2</wd:Count>
+			</wd:Response_Filter>
+			<wd:Response_Results>
+				<wd:Total_Results>3</wd:Total_Results>
+				<wd:Total_Pages>2</wd:Total_Pages>
+				<wd:Page_Results>2</wd:Page_Results>
+				<wd:Page>1</wd:Page>
+			</wd:Response_Results>
+			<wd:Response_Data>
+				<wd:Worker>
+					<wd:Worker_Reference wd:Descriptor="Logan McNeil">
+						<wd:ID wd:type="WID">3aa5550b7fe348b98d7b5741afc65534</wd:ID>
+						<wd:ID wd:type="Employee_ID">21001</wd:ID>
+					</wd:Worker_Reference>
+					<wd:Worker_Data>
+						<wd:Worker_ID>21001</wd:Worker_ID>
+						<wd:User_ID>lmcneil</wd:User_ID>
+					</wd:Worker_Data>
+				</wd:Worker>
+				<wd:Worker>
+					<wd:Worker_Reference wd:Descriptor="Joy Banks">
+						<wd:ID wd:type="WID">0e44c92412d34b01ace61e80a47aaf6d</wd:ID>
+						<wd:ID wd:type="Employee_ID">21002</wd:ID>
+					</wd:Worker_Reference>
+					<wd:Worker_Data>
+						<wd:Worker_ID>21002</wd:Worker_ID>
+						<wd:User_ID>jbanks</wd:User_ID>
+					</wd:Worker_Data>
+				</wd:Worker>
+			</wd:Response_Data>
+		</wd:Get_Workers_Response>
+	</env:Body>
+</env:Envelope>`
+
+	soapWorkersPage2 = `<?xml version="1.0" encoding="UTF-8"?>
+<env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/">
+	<env:Body>
+		<wd:Get_Workers_Response xmlns:wd="urn:com.workday/bsvc" wd:version="v43.0">
+			<wd:Response_Results>
+				<wd:Total_Results>3</wd:Total_Results>
+				<wd:Total_Pages>2</wd:Total_Pages>
+				<wd:Page_Results>1</wd:Page_Results>
+				<wd:Page>2</wd:Page>
+			</wd:Response_Results>
+			<wd:Response_Data>
+				<wd:Worker>
+					<wd:Worker_Data>
+						<wd:Worker_ID>21003</wd:Worker_ID>
+					</wd:Worker_Data>
+				</wd:Worker>
+			</wd:Response_Data>
+		</wd:Get_Workers_Response>
+	</env:Body>
+</env:Envelope>`
+
+	soapPreHiresPage = `<?xml version="1.0" encoding="UTF-8"?>
+<env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/">
+	<env:Body>
+		<wd:Get_Applicants_Response xmlns:wd="urn:com.workday/bsvc" wd:version="v43.0">
+			<wd:Response_Results>
+				<wd:Total_Results>1</wd:Total_Results>
+				<wd:Total_Pages>1</wd:Total_Pages>
+				<wd:Page_Results>1</wd:Page_Results>
+				<wd:Page>1</wd:Page>
+			</wd:Response_Results>
+			<wd:Response_Data>
+				<wd:Applicant>
+					<wd:Applicant_Reference wd:Descriptor="Ana Reyes">
+						<wd:ID wd:type="WID">8e1f3a9c52d84b6e9d0f7a3b1c2d4e5f</wd:ID>
+						<wd:ID wd:type="Applicant_ID">C00042</wd:ID>
+					</wd:Applicant_Reference>
+					<wd:Applicant_Data>
+						<wd:Applicant_ID>C00042</wd:Applicant_ID>
+					</wd:Applicant_Data>
+				</wd:Applicant>
+			</wd:Response_Data>
+		</wd:Get_Applicants_Response>
+	</env:Body>
+</env:Envelope>`
+
+	soapFault = `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/">
+	<SOAP-ENV:Body>
+		<SOAP-ENV:Fault>
+			<faultcode>SOAP-ENV:Client.authenticationError</faultcode>
+			<faultstring>invalid username or password</faultstring>
+		</SOAP-ENV:Fault>
+	</SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+)
+
+func TestConstructSOAPRequestBody(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	nonce := []byte("0123456789abcdef")
+
+	tests := map[string]struct {
+		request  *workday.Request
+		wantBody string
+		wantErr  *framework.Error
+	}{
+		"workers_first_page": {
+			request: &workday.Request{
+				Username:     "isu_sgnl@SGNL",
+				Password:     "p<a&ss",
+				APIVersion:   "v43.0",
+				PageSize:     100,
+				EntityConfig: &framework.EntityConfig{ExternalId: "Worker"},
+			},
+			wantBody: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/"><env:Header>` +
+				`<wsse:Security xmlns:wsse="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd" xmlns:wsu="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-utility-1.0.xsd" env:mustUnderstand="1">` +
+				`<wsse:UsernameToken><wsse:Username>isu_sgnl@SGNL</wsse:Username>` +
+				`<wsse:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText">p&lt;a&amp;ss</wsse:Password>` +
+				`<wsse:Nonce EncodingType="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary">MDEyMzQ1Njc4OWFiY2RlZg==</wsse:Nonce>` +
+				`<wsu:Created>2026-01-02T03:04:05Z</wsu:Created></wsse:UsernameToken></wsse:Security></env:Header>` +
+				`<env:Body><wd:Get_Workers_Request xmlns:wd="urn:com.workday/bsvc" wd:version="v43.0">` +
+				`<wd:Response_Filter><wd:Page>1</wd:Page><wd:Count>100</wd:Count></wd:Response_Filter>` +
+				`<wd:Response_Group><wd:Include_Reference>true</wd:Include_Reference>` +
+				`<wd:Include_Personal_Information>true</wd:Include_Personal_Information>` +
+				`<wd:Include_Employment_Information>true</wd:Include_Employment_Information>` +
+				`<wd:Include_Organizations>true</wd:Include_Organizations>` +
+				`<wd:Include_Roles>true</wd:Include_Roles></wd:Response_Group>` +
+				`</wd:Get_Workers_Request></env:Body></env:Envelope>`,
+		},
+		"organizations_with_cursor": {
+			request: &workday.Request{
+				Username:     "isu_sgnl@SGNL",
+				Password:     "password",
+				APIVersion:   "v43.0",
+				PageSize:     50,
+				EntityConfig: &framework.EntityConfig{ExternalId: "Organization"},
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr(int64(3)),
+				},
+			},
+			wantBody: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/"><env:Header>` +
+				`<wsse:Security xmlns:wsse="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd" xmlns:wsu="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-utility-1.0.xsd" env:mustUnderstand="1">` +
+				`<wsse:UsernameToken><wsse:Username>isu_sgnl@SGNL</wsse:Username>` +
+				`<wsse:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText">password</wsse:Password>` +
+				`<wsse:Nonce EncodingType="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary">MDEyMzQ1Njc4OWFiY2RlZg==</wsse:Nonce>` +
+				`<wsu:Created>2026-01-02T03:04:05Z</wsu:Created></wsse:UsernameToken></wsse:Security></env:Header>` +
+				`<env:Body><wd:Get_Organizations_Request xmlns:wd="urn:com.workday/bsvc" wd:version="v43.0">` +
+				`<wd:Response_Filter><wd:Page>3</wd:Page><wd:Count>50</wd:Count></wd:Response_Filter>` +
+				`<wd:Response_Group><wd:Include_Hierarchy_Data>true</wd:Include_Hierarchy_Data>` +
+				`<wd:Include_Roles_Data>true</wd:Include_Roles_Data></wd:Response_Group>` +
+				`</wd:Get_Organizations_Request></env:Body></env:Envelope>`,
+		},
+		"workers_as_of_dates": {
+			request: &workday.Request{
+				Username:          "isu_sgnl@SGNL",
+				Password:          "password",
+				APIVersion:        "v43.0",
+				PageSize:          100,
+				AsOfEffectiveDate: "2024-06-30",
+				AsOfEntryDateTime: "2024-06-30T00:00:00Z",
+				EntityConfig:      &framework.EntityConfig{ExternalId: "Worker"},
+			},
+			wantBody: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/"><env:Header>` +
+				`<wsse:Security xmlns:wsse="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd" xmlns:wsu="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-utility-1.0.xsd" env:mustUnderstand="1">` +
+				`<wsse:UsernameToken><wsse:Username>isu_sgnl@SGNL</wsse:Username>` +
+				`<wsse:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText">password</wsse:Password>` +
+				`<wsse:Nonce EncodingType="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary">MDEyMzQ1Njc4OWFiY2RlZg==</wsse:Nonce>` +
+				`<wsu:Created>2026-01-02T03:04:05Z</wsu:Created></wsse:UsernameToken></wsse:Security></env:Header>` +
+				`<env:Body><wd:Get_Workers_Request xmlns:wd="urn:com.workday/bsvc" wd:version="v43.0">` +
+				`<wd:Response_Filter><wd:As_Of_Effective_Date>2024-06-30</wd:As_Of_Effective_Date>` +
+				`<wd:As_Of_Entry_DateTime>2024-06-30T00:00:00Z</wd:As_Of_Entry_DateTime>` +
+				`<wd:Page>1</wd:Page><wd:Count>100</wd:Count></wd:Response_Filter>` +
+				`<wd:Response_Group><wd:Include_Reference>true</wd:Include_Reference>` +
+				`<wd:Include_Personal_Information>true</wd:Include_Personal_Information>` +
+				`<wd:Include_Employment_Information>true</wd:Include_Employment_Information>` +
+				`<wd:Include_Organizations>true</wd:Include_Organizations>` +
+				`<wd:Include_Roles>true</wd:Include_Roles></wd:Response_Group>` +
+				`</wd:Get_Workers_Request></env:Body></env:Envelope>`,
+		},
+		"contingent_workers_first_page": {
+			request: &workday.Request{
+				Username:     "isu_sgnl@SGNL",
+				Password:     "password",
+				APIVersion:   "v43.0",
+				PageSize:     100,
+				EntityConfig: &framework.EntityConfig{ExternalId: "ContingentWorker"},
+			},
+			wantBody: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/"><env:Header>` +
+				`<wsse:Security xmlns:wsse="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd" xmlns:wsu="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-utility-1.0.xsd" env:mustUnderstand="1">` +
+				`<wsse:UsernameToken><wsse:Username>isu_sgnl@SGNL</wsse:Username>` +
+				`<wsse:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordText">password</wsse:Password>` +
+				`<wsse:Nonce EncodingType="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary">MDEyMzQ1Njc4OWFiY2RlZg==</wsse:Nonce>` +
+				`<wsu:Created>2026-01-02T03:04:05Z</wsu:Created></wsse:UsernameToken></wsse:Security></env:Header>` +
+				`<env:Body><wd:Get_Workers_Request xmlns:wd="urn:com.workday/bsvc" wd:version="v43.0">` +
+				`<wd:Request_Criteria><wd:Exclude_Employees>true</wd:Exclude_Employees></wd:Request_Criteria>` +
+				`<wd:Response_Filter><wd:Page>1</wd:Page><wd:Count>100</wd:Count></wd:Response_Filter>` +
+				`<wd:Response_Group><wd:Include_Reference>true</wd:Include_Reference>` +
+				`<wd:Include_Personal_Information>true</wd:Include_Personal_Information>` +
+				`<wd:Include_Employment_Information>true</wd:Include_Employment_Information>` +
+				`<wd:Include_Organizations>true</wd:Include_Organizations>` +
+				`<wd:Include_Roles>true</wd:Include_Roles></wd:Response_Group>` +
+				`</wd:Get_Workers_Request></env:Body></env:Envelope>`,
+		},
+		"unsupported_entity": {
+			request: &workday.Request{
+				APIVersion:   "v43.0",
+				PageSize:     50,
+				EntityConfig: &framework.EntityConfig{ExternalId: "allWorkers"},
+			},
+			wantErr: &framework.Error{
+				Message: "Entity allWorkers is not supported by the Workday SOAP transport.",
+				Code:    adapter_api_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_cursor": {
+			request: &workday.Request{
+				APIVersion:   "v43.0",
+				PageSize:     50,
+				EntityConfig: &framework.EntityConfig{ExternalId: "Worker"},
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr(int64(1)),
+				},
+			},
+			wantErr: &framework.Error{
+				Message: "Cursor value must be greater than 1.",
+				Code:    adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotBody, gotErr := workday.ConstructSOAPRequestBody(tt.request, nonce, created)
+
+			if diff := cmp.Diff(tt.wantErr, gotErr); diff != "" {
+				t.Errorf("Unexpected error (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantBody, string(gotBody)); diff != "" {
+				t.Errorf("Unexpected body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseSOAPResponse(t *testing.T) {
+	tests := map[string]struct {
+		body           string
+		wantObjects    []map[string]any
+		wantNextCursor *pagination.CompositeCursor[int64]
+		wantErr        *framework.Error
+	}{
+		"first_page": {
+			body: soapWorkersPage1,
+			wantObjects: []map[string]any{
+				{
+					"Worker_Reference": map[string]any{
+						"@Descriptor": "Logan McNeil",
+						"ID": []any{
+							map[string]any{"@type": "WID", "#text": "3aa5550b7fe348b98d7b5741afc65534"},
+							map[string]any{"@type": "Employee_ID", "#text": "21001"},
+						},
+					},
+					"Worker_Data": map[string]any{
+						"Worker_ID": "21001",
+						"User_ID":   "lmcneil",
+					},
+				},
+				{
+					"Worker_Reference": map[string]any{
+						"@Descriptor": "Joy Banks",
+						"ID": []any{
+							map[string]any{"@type": "WID", "#text": "0e44c92412d34b01ace61e80a47aaf6d"},
+							map[string]any{"@type": "Employee_ID", "#text": "21002"},
+						},
+					},
+					"Worker_Data": map[string]any{
+						"Worker_ID": "21002",
+						"User_ID":   "jbanks",
+					},
+				},
+			},
+			wantNextCursor: &pagination.CompositeCursor[int64]{
+				Cursor: testutil.GenPtr(int64(2)),
+			},
+		},
+		"last_page": {
+			body: soapWorkersPage2,
+			wantObjects: []map[string]any{
+				{
+					"Worker_Data": map[string]any{
+						"Worker_ID": "21003",
+					},
+				},
+			},
+		},
+		"fault": {
+			body: soapFault,
+			wantErr: &framework.Error{
+				Message: "Workday SOAP request failed: invalid username or password.",
+				Code:    adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"missing_response_results": {
+			body: `<env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/"><env:Body></env:Body></env:Envelope>`,
+			wantErr: &framework.Error{
+				Message: "Response_Results are missing in the Workday SOAP response.",
+				Code:    adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"malformed_xml": {
+			body: `<env:Envelope><env:Body>`,
+			wantErr: &framework.Error{
+				Message: "Failed to parse the Workday SOAP response: XML syntax error on line 1: unexpected EOF.",
+				Code:    adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObjects, gotNextCursor, gotErr := workday.ParseSOAPResponse([]byte(tt.body))
+
+			if diff := cmp.Diff(tt.wantErr, gotErr); diff != "" {
+				t.Errorf("Unexpected error (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantObjects, gotObjects); diff != "" {
+				t.Errorf("Unexpected objects (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantNextCursor, gotNextCursor); diff != "" {
+				t.Errorf("Unexpected next cursor (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetSOAPPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || (r.URL.Path != "/ccx/service/SGNL/Human_Resources/v43.0" &&
+			r.URL.Path != "/ccx/service/SGNL/Recruiting/v43.0") {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		switch {
+		case !strings.Contains(string(body), "<wsse:Username>isu_sgnl@SGNL</wsse:Username>"):
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(soapFault))
+		case strings.Contains(string(body), "<wd:Get_Applicants_Request"):
+			w.Write([]byte(soapPreHiresPage))
+		case strings.Contains(string(body), "<wd:Page>2</wd:Page>"):
+			w.Write([]byte(soapWorkersPage2))
+		default:
+			w.Write([]byte(soapWorkersPage1))
+		}
+	}))
+	defer server.Close()
+
+	workdayClient := workday.NewClient(&http.Client{Timeout: 10 * time.Second})
+
+	tests := map[string]struct {
+		request *workday.Request
+		wantRes *workday.Response
+		wantErr *framework.Error
+	}{
+		"last_page": {
+			request: &workday.Request{
+				BaseURL:               server.URL,
+				Transport:             workday.TransportSOAP,
+				Username:              "isu_sgnl@SGNL",
+				Password:              "password",
+				APIVersion:            "v43.0",
+				OrganizationID:        "SGNL",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          &framework.EntityConfig{ExternalId: "Worker"},
+				Cursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr(int64(2)),
+				},
+			},
+			wantRes: &workday.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"Worker_Data": map[string]any{
+							"Worker_ID": "21003",
+						},
+					},
+				},
+			},
+		},
+		"workers_first_page": {
+			request: &workday.Request{
+				BaseURL:               server.URL,
+				Transport:             workday.TransportSOAP,
+				Username:              "isu_sgnl@SGNL",
+				Password:              "password",
+				APIVersion:            "v43.0",
+				OrganizationID:        "SGNL",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          &framework.EntityConfig{ExternalId: "Worker"},
+			},
+			wantRes: &workday.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"Worker_Reference": map[string]any{
+							"@Descriptor": "Logan McNeil",
+							"ID": []any{
+								map[string]any{"@type": "WID", "#text": "3aa5550b7fe348b98d7b5741afc65534"},
+								map[string]any{"@type": "Employee_ID", "#text": "21001"},
+							},
+						},
+						"Worker_Data": map[string]any{
+							"Worker_ID": "21001",
+							"User_ID":   "lmcneil",
+						},
+						"workerType": "Employee",
+					},
+					{
+						"Worker_Reference": map[string]any{
+							"@Descriptor": "Joy Banks",
+							"ID": []any{
+								map[string]any{"@type": "WID", "#text": "0e44c92412d34b01ace61e80a47aaf6d"},
+								map[string]any{"@type": "Employee_ID", "#text": "21002"},
+							},
+						},
+						"Worker_Data": map[string]any{
+							"Worker_ID": "21002",
+							"User_ID":   "jbanks",
+						},
+						"workerType": "Employee",
+					},
+				},
+				NextCursor: &pagination.CompositeCursor[int64]{
+					Cursor: testutil.GenPtr(int64(2)),
+				},
+			},
+		},
+		"pre_hires": {
+			request: &workday.Request{
+				BaseURL:               server.URL,
+				Transport:             workday.TransportSOAP,
+				Username:              "isu_sgnl@SGNL",
+				Password:              "password",
+				APIVersion:            "v43.0",
+				OrganizationID:        "SGNL",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          &framework.EntityConfig{ExternalId: "PreHire"},
+			},
+			wantRes: &workday.Response{
+				StatusCode: http.StatusOK,
+				Objects: []map[string]any{
+					{
+						"Applicant_Reference": map[string]any{
+							"@Descriptor": "Ana Reyes",
+							"ID": []any{
+								map[string]any{"@type": "WID", "#text": "8e1f3a9c52d84b6e9d0f7a3b1c2d4e5f"},
+								map[string]any{"@type": "Applicant_ID", "#text": "C00042"},
+							},
+						},
+						"Applicant_Data": map[string]any{
+							"Applicant_ID": "C00042",
+						},
+						"workerType": "Pre_Hire",
+					},
+				},
+			},
+		},
+		"invalid_credentials": {
+			request: &workday.Request{
+				BaseURL:               server.URL,
+				Transport:             workday.TransportSOAP,
+				Username:              "invalid@SGNL",
+				Password:              "password",
+				APIVersion:            "v43.0",
+				OrganizationID:        "SGNL",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          &framework.EntityConfig{ExternalId: "Worker"},
+			},
+			wantErr: &framework.Error{
+				Message: "Workday SOAP request failed: invalid username or password.",
+				Code:    adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"not_found": {
+			request: &workday.Request{
+				BaseURL:               server.URL,
+				Transport:             workday.TransportSOAP,
+				Username:              "isu_sgnl@SGNL",
+				Password:              "password",
+				APIVersion:            "v43.0",
+				OrganizationID:        "UNKNOWN",
+				PageSize:              2,
+				RequestTimeoutSeconds: 5,
+				EntityConfig:          &framework.EntityConfig{ExternalId: "Worker"},
+			},
+			wantRes: &workday.Response{
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotRes, gotErr := workdayClient.GetPage(context.Background(), tt.request)
+
+			if diff := cmp.Diff(tt.wantErr, gotErr); diff != "" {
+				t.Errorf("Unexpected error (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantRes, gotRes); diff != "" {
+				t.Errorf("Unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
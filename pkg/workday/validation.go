@@ -38,6 +38,10 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		request.Address = trimmedAddress
 	}
 
+	if request.Config.Transport == TransportSOAP {
+		return validateSOAPGetPageRequest(request)
+	}
+
 	if request.Auth == nil || request.Auth.HTTPAuthorization == "" {
 		return &framework.Error{
 			Message: "Provided datasource auth is missing required http authorization credentials.",
@@ -52,10 +56,44 @@ func (a *Adapter) ValidateGetPageRequest(ctx context.Context, request *framework
 		}
 	}
 
-	if request.PageSize > maxPageSize {
+	return validatePageSize(request.PageSize, maxPageSize)
+}
+
+// validateSOAPGetPageRequest validates the fields of a GetPage Request specific to the SOAP transport.
+func validateSOAPGetPageRequest(request *framework.Request[Config]) *framework.Error {
+	// The SOAP API authenticates requests with a WS-Security username token.
+	if request.Auth == nil || request.Auth.Basic == nil {
+		return &framework.Error{
+			Message: "Provided datasource auth is missing required basic credentials for the SOAP transport.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	if _, found := SOAPOperations[request.Entity.ExternalId]; !found {
+		return &framework.Error{
+			Message: fmt.Sprintf(
+				"Entity %s is not supported by the Workday SOAP transport.", request.Entity.ExternalId,
+			),
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	// The SOAP API does not support ordering results.
+	if request.Ordered {
+		return &framework.Error{
+			Message: "Ordered must be set to false for the Workday SOAP transport.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+		}
+	}
+
+	return validatePageSize(request.PageSize, SOAPMaxPageSize)
+}
+
+func validatePageSize(pageSize, maxPageSize int64) *framework.Error {
+	if pageSize > maxPageSize {
 		return &framework.Error{
 			Message: fmt.Sprintf(
-				"Provided page size (%d) exceeds the maximum allowed (%d).", request.PageSize, maxPageSize,
+				"Provided page size (%d) exceeds the maximum allowed (%d).", pageSize, maxPageSize,
 			),
 			Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 		}
@@ -149,6 +149,62 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 			},
 		},
+		"invalid_request_config_as_of_effective_date": {
+			request: &framework.Request[workday.Config]{
+				Address: "test-instance.workday.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Worker",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "$.worker.id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &workday.Config{
+					APIVersion:        "v1",
+					OrganizationID:    "testorgid",
+					AsOfEffectiveDate: "06/30/2024",
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Workday config is invalid: asOfEffectiveDate is not a valid date in the format YYYY-MM-DD: 06/30/2024.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_request_config_as_of_entry_date_time": {
+			request: &framework.Request[workday.Config]{
+				Address: "test-instance.workday.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Worker",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "$.worker.id",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &workday.Config{
+					APIVersion:        "v1",
+					OrganizationID:    "testorgid",
+					AsOfEntryDateTime: "2024-06-30",
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Workday config is invalid: asOfEntryDateTime is not a valid RFC 3339 date-time: 2024-06-30.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 		"invalid_request_missing_config": {
 			request: &framework.Request[workday.Config]{
 				Address: "test-instance.workday.com",
@@ -417,6 +473,214 @@ func TestValidateGetPageRequest(t *testing.T) {
 				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
 			},
 		},
+		"valid_soap_request": {
+			request: &framework.Request[workday.Config]{
+				Address: "test-instance.workday.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "isu_sgnl@SGNL",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Worker",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "$.Worker_Data.Worker_ID",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &workday.Config{
+					APIVersion:     "v43.0",
+					OrganizationID: "SGNL",
+					Transport:      workday.TransportSOAP,
+				},
+				Ordered:  false,
+				PageSize: 999,
+			},
+			wantErr: nil,
+		},
+		"invalid_soap_missing_basic_auth": {
+			request: &framework.Request[workday.Config]{
+				Address: "test-instance.workday.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Worker",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "$.Worker_Data.Worker_ID",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &workday.Config{
+					APIVersion:     "v43.0",
+					OrganizationID: "SGNL",
+					Transport:      workday.TransportSOAP,
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided datasource auth is missing required basic credentials for the SOAP transport.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_soap_unsupported_entity": {
+			request: &framework.Request[workday.Config]{
+				Address: "test-instance.workday.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "isu_sgnl@SGNL",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "allWorkers",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "$.Worker_Data.Worker_ID",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &workday.Config{
+					APIVersion:     "v43.0",
+					OrganizationID: "SGNL",
+					Transport:      workday.TransportSOAP,
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Entity allWorkers is not supported by the Workday SOAP transport.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_soap_ordered": {
+			request: &framework.Request[workday.Config]{
+				Address: "test-instance.workday.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "isu_sgnl@SGNL",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Worker",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "$.Worker_Data.Worker_ID",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &workday.Config{
+					APIVersion:     "v43.0",
+					OrganizationID: "SGNL",
+					Transport:      workday.TransportSOAP,
+				},
+				Ordered:  true,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Ordered must be set to false for the Workday SOAP transport.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			},
+		},
+		"invalid_soap_page_size_too_big": {
+			request: &framework.Request[workday.Config]{
+				Address: "test-instance.workday.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "isu_sgnl@SGNL",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Worker",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "$.Worker_Data.Worker_ID",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &workday.Config{
+					APIVersion:     "v43.0",
+					OrganizationID: "SGNL",
+					Transport:      workday.TransportSOAP,
+				},
+				Ordered:  false,
+				PageSize: 1000,
+			},
+			wantErr: &framework.Error{
+				Message: "Provided page size (1000) exceeds the maximum allowed (999).",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			},
+		},
+		"invalid_soap_api_version": {
+			request: &framework.Request[workday.Config]{
+				Address: "test-instance.workday.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					Basic: &framework.BasicAuthCredentials{
+						Username: "isu_sgnl@SGNL",
+						Password: "password",
+					},
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Worker",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "$.Worker_Data.Worker_ID",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &workday.Config{
+					APIVersion:     "v1",
+					OrganizationID: "SGNL",
+					Transport:      workday.TransportSOAP,
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Workday config is invalid: apiVersion is not a valid Workday Web Services version: v1.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
+		"invalid_transport": {
+			request: &framework.Request[workday.Config]{
+				Address: "test-instance.workday.com",
+				Auth: &framework.DatasourceAuthCredentials{
+					HTTPAuthorization: "Bearer testtoken",
+				},
+				Entity: framework.EntityConfig{
+					ExternalId: "Worker",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "$.Worker_Data.Worker_ID",
+							Type:       framework.AttributeTypeString,
+						},
+					},
+				},
+				Config: &workday.Config{
+					APIVersion:     "v1",
+					OrganizationID: "SGNL",
+					Transport:      "REST",
+				},
+				Ordered:  false,
+				PageSize: 250,
+			},
+			wantErr: &framework.Error{
+				Message: "Workday config is invalid: transport is not supported: REST.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		},
 	}
 
 	adapter := &workday.Adapter{}
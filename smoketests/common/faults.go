@@ -0,0 +1,236 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// FaultTransport is an http.RoundTripper injecting faults in the responses of the datasources, to test how the
+// adapters handle rate limits, outages, slow responses and dropped connections. The faults are configured with
+// FaultOptions, and applied in this order: latency, rate limit, server errors, truncated bodies.
+type FaultTransport struct {
+	next http.RoundTripper
+	now  func() time.Time
+
+	mu sync.Mutex
+
+	// latency is the delay before each response, cut short if the request is canceled.
+	latency time.Duration
+
+	// bucket rate limits the requests, if set.
+	bucket *tokenBucket
+
+	// serverErrors is the number of the next requests responded to with a 500 Internal Server Error.
+	serverErrors int
+
+	// truncateBodies is whether the bodies of the successful responses are cut in half, as if the connection was
+	// dropped while reading them.
+	truncateBodies bool
+}
+
+// FaultOption configures a FaultTransport.
+type FaultOption func(t *FaultTransport)
+
+// WithRateLimit rate limits the requests with a token bucket, like the rate limits of most datasources, e.g.
+// Zendesk: the bucket holds up to burst requests, and is refilled with one request every refillInterval.
+// The requests exceeding the rate limit are responded to with a 429 Too Many Requests, with the number of
+// seconds until the next request is allowed in the Retry-After header.
+// A burst of 0 rate limits all the requests.
+func WithRateLimit(burst int, refillInterval time.Duration) FaultOption {
+	return func(t *FaultTransport) {
+		t.bucket = &tokenBucket{
+			capacity:       float64(burst),
+			tokens:         float64(burst),
+			refillInterval: refillInterval,
+			refilledAt:     t.now(),
+		}
+	}
+}
+
+// WithServerErrors responds to the next count requests with a 500 Internal Server Error.
+func WithServerErrors(count int) FaultOption {
+	return func(t *FaultTransport) {
+		t.serverErrors = count
+	}
+}
+
+// WithLatency delays each response, e.g. to exceed the request timeout of the adapters.
+func WithLatency(latency time.Duration) FaultOption {
+	return func(t *FaultTransport) {
+		t.latency = latency
+	}
+}
+
+// WithTruncatedBodies cuts the bodies of the successful responses in half, and fails reading them with
+// io.ErrUnexpectedEOF, as if the connection was dropped.
+func WithTruncatedBodies() FaultOption {
+	return func(t *FaultTransport) {
+		t.truncateBodies = true
+	}
+}
+
+// NewFaultTransport returns a FaultTransport sending the requests which aren't failed with the next
+// RoundTripper. http.DefaultTransport is used if next is nil.
+func NewFaultTransport(next http.RoundTripper, opts ...FaultOption) *FaultTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	t := &FaultTransport{
+		next: next,
+		now:  time.Now,
+	}
+
+	t.SetFaults(opts...)
+
+	return t
+}
+
+// SetFaults replaces the faults injected by the transport, e.g. to inject different faults in each test case
+// without restarting the adapter server. No fault is injected if no option is given.
+func (t *FaultTransport) SetFaults(opts ...FaultOption) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.latency = 0
+	t.bucket = nil
+	t.serverErrors = 0
+	t.truncateBodies = false
+
+	for _, opt := range opts {
+		opt(t)
+	}
+}
+
+// NewFaultClient returns a copy of the client injecting the faults in its responses, and its FaultTransport.
+func NewFaultClient(client *http.Client, opts ...FaultOption) (*http.Client, *FaultTransport) {
+	transport := NewFaultTransport(client.Transport, opts...)
+
+	faultClient := *client
+	faultClient.Transport = transport
+
+	return &faultClient, transport
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FaultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	latency, truncateBodies := t.latency, t.truncateBodies
+	t.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	if statusCode, header := t.fault(); statusCode != 0 {
+		return newResponse(req, statusCode, header, []byte(http.StatusText(statusCode))), nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || !truncateBodies || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body[:len(body)/2]), errReader{io.ErrUnexpectedEOF}))
+	resp.ContentLength = -1
+	resp.Header.Del("Content-Length")
+
+	return resp, nil
+}
+
+// fault returns the status code and the headers of the response to fail the request with, or 0 if the request
+// must be sent.
+func (t *FaultTransport) fault() (int, http.Header) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.bucket != nil {
+		if wait, allowed := t.bucket.take(t.now()); !allowed {
+			return http.StatusTooManyRequests, http.Header{
+				"Retry-After": {strconv.Itoa(int(math.Ceil(wait.Seconds())))},
+			}
+		}
+	}
+
+	if t.serverErrors > 0 {
+		t.serverErrors--
+
+		return http.StatusInternalServerError, http.Header{}
+	}
+
+	return 0, nil
+}
+
+// tokenBucket is a token bucket rate limiter, with one token per request.
+type tokenBucket struct {
+	capacity       float64
+	tokens         float64
+	refillInterval time.Duration
+	refilledAt     time.Time
+}
+
+// take takes a token from the bucket if there is one, and otherwise returns the duration until the next token.
+func (b *tokenBucket) take(now time.Time) (time.Duration, bool) {
+	if b.refillInterval > 0 {
+		b.tokens = math.Min(b.capacity, b.tokens+float64(now.Sub(b.refilledAt))/float64(b.refillInterval))
+	}
+
+	b.refilledAt = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+
+		return 0, true
+	}
+
+	return time.Duration((1 - b.tokens) * float64(b.refillInterval)), false
+}
+
+func newResponse(req *http.Request, statusCode int, header http.Header, body []byte) *http.Response {
+	header.Set("Content-Type", "text/plain")
+	header.Set("Content-Length", fmt.Sprint(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// errReader is an io.Reader failing with err.
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -19,19 +19,31 @@ import (
 	s3 "github.com/sgnl-ai/adapters/pkg/aws-s3"
 	"github.com/sgnl-ai/adapters/pkg/azuread"
 	"github.com/sgnl-ai/adapters/pkg/bamboohr"
+	beyondtrustpasswordsafe "github.com/sgnl-ai/adapters/pkg/beyondtrust-passwordsafe"
+	"github.com/sgnl-ai/adapters/pkg/bitbucket"
+	bitbucketdatacenter "github.com/sgnl-ai/adapters/pkg/bitbucket-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/confluence"
 	"github.com/sgnl-ai/adapters/pkg/crowdstrike"
+	"github.com/sgnl-ai/adapters/pkg/databricks"
+	delineasecretserver "github.com/sgnl-ai/adapters/pkg/delinea-secretserver"
 	"github.com/sgnl-ai/adapters/pkg/duo"
 	"github.com/sgnl-ai/adapters/pkg/github"
+	"github.com/sgnl-ai/adapters/pkg/gitlab"
 	googleworkspace "github.com/sgnl-ai/adapters/pkg/google-workspace"
 	"github.com/sgnl-ai/adapters/pkg/identitynow"
+	"github.com/sgnl-ai/adapters/pkg/intune"
 	"github.com/sgnl-ai/adapters/pkg/jira"
 	jiradatacenter "github.com/sgnl-ai/adapters/pkg/jira-datacenter"
+	"github.com/sgnl-ai/adapters/pkg/kandji"
 	"github.com/sgnl-ai/adapters/pkg/okta"
 	"github.com/sgnl-ai/adapters/pkg/pagerduty"
+	"github.com/sgnl-ai/adapters/pkg/pingone"
+	rapid7insightvm "github.com/sgnl-ai/adapters/pkg/rapid7-insightvm"
 	"github.com/sgnl-ai/adapters/pkg/rootly"
 	"github.com/sgnl-ai/adapters/pkg/salesforce"
 	"github.com/sgnl-ai/adapters/pkg/scim"
 	"github.com/sgnl-ai/adapters/pkg/servicenow"
+	"github.com/sgnl-ai/adapters/pkg/slack"
 	"github.com/sgnl-ai/adapters/pkg/workday"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -73,6 +85,7 @@ func StartAdapterServer(t *testing.T, client *http.Client, port int) chan struct
 		&cfg,
 		1*1024*1024,  // 1MiB max row size
 		10*1024*1024, // 10MiB max processing bytes per page
+		1,            // sequential page data reads
 	)
 	if err != nil {
 		t.Fatalf("Failed to create a client for AWS S3 SoR: %v", err)
@@ -87,21 +100,37 @@ func StartAdapterServer(t *testing.T, client *http.Client, port int) chan struct
 	server.RegisterAdapter(adapterServer, "AWS-1.0.0", aws.NewAdapter(awsClient))
 	server.RegisterAdapter(adapterServer, "AzureAD-1.0.1", azuread.NewAdapter(azuread.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "BambooHR-1.0.0", bamboohr.NewAdapter(bamboohr.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "BeyondTrustPasswordSafe-1.0.0",
+		beyondtrustpasswordsafe.NewAdapter(beyondtrustpasswordsafe.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "Bitbucket-1.0.0", bitbucket.NewAdapter(bitbucket.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "BitbucketDatacenter-1.0.0",
+		bitbucketdatacenter.NewAdapter(bitbucketdatacenter.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "Confluence-1.0.0", confluence.NewAdapter(confluence.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "CrowdStrike-1.0.0", crowdstrike.NewAdapter(crowdstrike.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "Databricks-1.0.0", databricks.NewAdapter(databricks.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "DelineaSecretServer-1.0.0",
+		delineasecretserver.NewAdapter(delineasecretserver.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "Duo-1.0.0", duo.NewAdapter(duo.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "GitHub-1.0.0", github.NewAdapter(github.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "GitLab-1.0.0", gitlab.NewAdapter(gitlab.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "GoogleWorkspace-1.0.0",
 		googleworkspace.NewAdapter(googleworkspace.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "IdentityNow-1.0.0",
 		identitynow.NewAdapter(identitynow.NewClient(client, identitynow.DefaultAccountCollectionPageSize)))
+	server.RegisterAdapter(adapterServer, "Intune-1.0.0", intune.NewAdapter(intune.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "Jira-1.0.0", jira.NewAdapter(jira.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "JiraDatacenter-1.0.0",
 		jiradatacenter.NewAdapter(jiradatacenter.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "Kandji-1.0.0", kandji.NewAdapter(kandji.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "Okta-1.0.1", okta.NewAdapter(okta.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "PagerDuty-1.0.0", pagerduty.NewAdapter(pagerduty.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "PingOne-1.0.0", pingone.NewAdapter(pingone.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "Rapid7InsightVM-1.0.0",
+		rapid7insightvm.NewAdapter(rapid7insightvm.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "Rootly-1.0.0", rootly.NewAdapter(rootly.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "Salesforce-1.0.1", salesforce.NewAdapter(salesforce.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "SCIM2.0-1.0.0", scim.NewAdapter(scim.NewClient(client)))
+	server.RegisterAdapter(adapterServer, "Slack-1.0.0", slack.NewAdapter(slack.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "ServiceNow-1.0.1", servicenow.NewAdapter(servicenow.NewClient(client)))
 	server.RegisterAdapter(adapterServer, "S3-1.0.0", s3.NewAdapter(s3Client))
 	server.RegisterAdapter(adapterServer, "Workday-1.0.0", workday.NewAdapter(workday.NewClient(client)))
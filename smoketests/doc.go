@@ -22,5 +22,18 @@ r, err := recorder.NewWithOptions(
 
 )
 ```
+
+## Inject faults in the responses of the SoR
+To test how the adapters handle rate limits, outages, slow responses and dropped connections, wrap the client of the
+adapter server with a fault injecting transport. The faults of the transport can be replaced between test cases.
+
+```go
+httpClient, faultTransport := common.NewFaultClient(recorderClient, common.WithRateLimit(10, time.Second))
+
+faultTransport.SetFaults(common.WithServerErrors(3), common.WithLatency(5*time.Second))
+```
+
+TestAdapterResilience checks the errors returned by each adapter for each fault. Add new adapters to
+resilienceAdapters.
 */
 package smoketests
@@ -0,0 +1,489 @@
+// Copyright 2026 SGNL.ai, Inc.
+
+// nolint: lll
+package smoketests
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	adapter_api_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapters/smoketests/common"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// resilienceAdapter is the datasource and the entity of the request sent to an adapter by the resilience tests.
+type resilienceAdapter struct {
+	datasource       *adapter_api_v1.DatasourceConfig
+	entityExternalID string
+	uniqueIDAttr     string
+	ordered          bool
+	// pageSize is the page size of the request, if the adapter doesn't allow the default page size of 10.
+	pageSize int64
+}
+
+var (
+	bearerAuth = &adapter_api_v1.DatasourceAuthCredentials{
+		AuthMechanism: &adapter_api_v1.DatasourceAuthCredentials_HttpAuthorization{
+			HttpAuthorization: "Bearer {{OMITTED}}",
+		},
+	}
+
+	basicAuth = &adapter_api_v1.DatasourceAuthCredentials{
+		AuthMechanism: &adapter_api_v1.DatasourceAuthCredentials_Basic_{
+			Basic: &adapter_api_v1.DatasourceAuthCredentials_Basic{
+				Username: "{{OMITTED}}",
+				Password: "{{OMITTED}}",
+			},
+		},
+	}
+
+	// resilienceAdapters are the adapters querying their datasource over HTTP. The AWS and S3 adapters are
+	// excluded, as the AWS SDK retries the failed requests itself.
+	resilienceAdapters = map[string]resilienceAdapter{
+		"AzureAD": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "graph.microsoft.com",
+				Type:    "AzureAD-1.0.1",
+				Config:  []byte(`{"apiVersion":"v1.0"}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "id",
+		},
+		"BambooHR": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "api.bamboohr.com/api/gateway.php",
+				Type:    "BambooHR-1.0.0",
+				Config:  []byte(`{"apiVersion":"v1","companyDomain":"sgnltestdev"}`),
+			},
+			entityExternalID: "Employee",
+			uniqueIDAttr:     "id",
+		},
+		"BeyondTrustPasswordSafe": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "test-instance.ps.beyondtrustcloud.com",
+				Type:    "BeyondTrustPasswordSafe-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "UserID",
+		},
+		"Bitbucket": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "api.bitbucket.org",
+				Type:    "Bitbucket-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "Workspace",
+			uniqueIDAttr:     "uuid",
+		},
+		"BitbucketDatacenter": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "test-instance.bitbucketdc.ai",
+				Type:    "BitbucketDatacenter-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "id",
+		},
+		"Confluence": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "test-instance.atlassian.net",
+				Type:    "Confluence-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "Space",
+			uniqueIDAttr:     "id",
+		},
+		"CrowdStrike": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "https://api.us-2.crowdstrike.com",
+				Type:    "CrowdStrike-1.0.0",
+				Config:  []byte(`{"apiVersion":"v1"}`),
+			},
+			entityExternalID: "falcon_user",
+			uniqueIDAttr:     "uuid",
+		},
+		"Databricks": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "https://accounts.cloud.databricks.com",
+				Type:    "Databricks-1.0.0",
+				Config:  []byte(`{"accountId":"{{OMITTED}}"}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "id",
+		},
+		"DelineaSecretServer": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "test-instance.secretservercloud.com",
+				Type:    "DelineaSecretServer-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "id",
+		},
+		"Duo": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "test-instance.duosecurity.com",
+				Type:    "Duo-1.0.0",
+				Config:  []byte(`{"apiVersion":"v1"}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "user_id",
+		},
+		"GitHub": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "test-instance.com",
+				Type:    "GitHub-1.0.0",
+				Config:  []byte(`{"enterpriseSlug":"SGNL","isEnterpriseCloud":false,"apiVersion":"v3"}`),
+			},
+			entityExternalID: "Organization",
+			uniqueIDAttr:     "id",
+		},
+		"GitLab": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "gitlab.com",
+				Type:    "GitLab-1.0.0",
+				Config:  []byte(`{"apiVersion":"v4"}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "id",
+		},
+		"GoogleWorkspace": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "admin.googleapis.com",
+				Type:    "GoogleWorkspace-1.0.0",
+				Config:  []byte(`{"apiVersion":"v1","domain":"sgnldemos.com"}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "id",
+		},
+		"IdentityNow": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "test-instance.api.identitynow-demo.com",
+				Type:    "IdentityNow-1.0.0",
+				Config:  []byte(`{"apiVersion":"v3","entityConfig":{"accounts":{"uniqueIDAttribute":"id"}}}`),
+			},
+			entityExternalID: "accounts",
+			uniqueIDAttr:     "id",
+		},
+		"Intune": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "graph.microsoft.com",
+				Type:    "Intune-1.0.0",
+				Config:  []byte(`{"apiVersion":"v1.0"}`),
+			},
+			entityExternalID: "ManagedDevice",
+			uniqueIDAttr:     "id",
+		},
+		"Jira": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "test-instance.atlassian.net",
+				Type:    "Jira-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "accountId",
+		},
+		"JiraDatacenter": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "test-instance.jiradc.ai",
+				Type:    "JiraDatacenter-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "key",
+		},
+		"Kandji": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "acme.api.kandji.io",
+				Type:    "Kandji-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "Device",
+			uniqueIDAttr:     "device_id",
+		},
+		"Okta": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth: &adapter_api_v1.DatasourceAuthCredentials{
+					AuthMechanism: &adapter_api_v1.DatasourceAuthCredentials_HttpAuthorization{
+						HttpAuthorization: "SSWS {{OMITTED}}",
+					},
+				},
+				Address: "test-instance.okta.com",
+				Type:    "Okta-1.0.1",
+				Config:  []byte(`{"apiVersion":"v1"}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "id",
+		},
+		"PagerDuty": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth: &adapter_api_v1.DatasourceAuthCredentials{
+					AuthMechanism: &adapter_api_v1.DatasourceAuthCredentials_HttpAuthorization{
+						HttpAuthorization: "Token token={{OMITTED}}",
+					},
+				},
+				Address: "api.pagerduty.com",
+				Type:    "PagerDuty-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "users",
+			uniqueIDAttr:     "id",
+		},
+		"PingOne": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "https://api.pingone.com",
+				Type:    "PingOne-1.0.0",
+				Config:  []byte(`{"environmentId":"{{OMITTED}}"}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "id",
+		},
+		"Rapid7InsightVM": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "insightvm.test-instance.com:3780",
+				Type:    "Rapid7InsightVM-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "Asset",
+			uniqueIDAttr:     "id",
+		},
+		"Rootly": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "api.rootly.com",
+				Type:    "Rootly-1.0.0",
+				Config:  []byte(`{"apiVersion":"v1"}`),
+			},
+			entityExternalID: "users",
+			uniqueIDAttr:     "id",
+		},
+		"Salesforce": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "test-instance.my.salesforce.com",
+				Type:    "Salesforce-1.0.1",
+				Config:  []byte(`{"apiVersion":"58.0"}`),
+			},
+			entityExternalID: "Case",
+			uniqueIDAttr:     "Id",
+			ordered:          true,
+			pageSize:         200,
+		},
+		"SCIM": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "https://example-tenant.example-domain.com:8080/identityiq/scim/v2",
+				Type:    "SCIM2.0-1.0.0",
+				Config:  []byte(`{"scimProtocolVersion":2}`),
+			},
+			entityExternalID: "Users",
+			uniqueIDAttr:     "id",
+		},
+		"ServiceNow": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    basicAuth,
+				Address: "test-instance.service-now.com",
+				Type:    "ServiceNow-1.0.1",
+				Config:  []byte(`{"apiVersion":"v2"}`),
+			},
+			entityExternalID: "sys_user",
+			uniqueIDAttr:     "sys_id",
+		},
+		"Slack": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "slack.com",
+				Type:    "Slack-1.0.0",
+				Config:  []byte(`{}`),
+			},
+			entityExternalID: "User",
+			uniqueIDAttr:     "id",
+		},
+		"Workday": {
+			datasource: &adapter_api_v1.DatasourceConfig{
+				Auth:    bearerAuth,
+				Address: "test-instance.workday.com",
+				Type:    "Workday-1.0.0",
+				Config:  []byte(`{"apiVersion":"v1","organizationId":"{{OMITTED}}"}`),
+			},
+			entityExternalID: "allWorkers",
+			uniqueIDAttr:     "$.worker.id",
+		},
+	}
+)
+
+// okTransport responds to all the requests with a 200 OK and a JSON body, which the faults are injected in.
+type okTransport struct{}
+
+func (okTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"data":[],"value":[],"Resources":[],"totalResults":0}`)),
+		Request:    req,
+	}, nil
+}
+
+// TestAdapterResilience tests the errors returned by the adapters when their datasource is rate limiting the
+// requests, failing, slow or dropping the connections, to lock in how the retry and rate limit errors are
+// surfaced to the caller.
+func TestAdapterResilience(t *testing.T) {
+	tests := map[string]struct {
+		faults         []common.FaultOption
+		wantCode       adapter_api_v1.ErrorCode
+		wantRetryAfter *durationpb.Duration
+		// wantAdapterCodes are the codes of the adapters returning a different code than wantCode, e.g. the
+		// adapters not classifying the errors of the datasource yet.
+		wantAdapterCodes map[string]adapter_api_v1.ErrorCode
+	}{
+		"rate_limited": {
+			faults:         []common.FaultOption{common.WithRateLimit(0, 30*time.Second)},
+			wantCode:       adapter_api_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+			wantRetryAfter: durationpb.New(30 * time.Second),
+			wantAdapterCodes: map[string]adapter_api_v1.ErrorCode{
+				"Rootly": adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"server_error_burst": {
+			faults:   []common.FaultOption{common.WithServerErrors(3)},
+			wantCode: adapter_api_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			wantAdapterCodes: map[string]adapter_api_v1.ErrorCode{
+				"Rootly": adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"slow_response": {
+			faults:   []common.FaultOption{common.WithLatency(5 * time.Second)},
+			wantCode: adapter_api_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+			wantAdapterCodes: map[string]adapter_api_v1.ErrorCode{
+				"Rootly": adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"truncated_body": {
+			faults:   []common.FaultOption{common.WithTruncatedBodies()},
+			wantCode: adapter_api_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			wantAdapterCodes: map[string]adapter_api_v1.ErrorCode{
+				"SCIM": adapter_api_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			},
+		},
+	}
+
+	httpClient, faultTransport := common.NewFaultClient(&http.Client{Transport: okTransport{}})
+
+	port := common.AvailableTestPort(t)
+
+	// Start Adapter Server
+	go func() {
+		common.StartAdapterServer(t, httpClient, port)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+
+	adapterClient, conn := common.GetNewAdapterClient(t, port)
+	defer conn.Close()
+
+	for name, tt := range tests {
+		for adapterName, adapter := range resilienceAdapters {
+			t.Run(name+"/"+adapterName, func(t *testing.T) {
+				faultTransport.SetFaults(tt.faults...)
+
+				pageSize := adapter.pageSize
+				if pageSize == 0 {
+					pageSize = 10
+				}
+
+				ctx, cancelCtx := common.GetAdapterCtx()
+				defer cancelCtx()
+
+				gotResp, err := adapterClient.GetPage(ctx, &adapter_api_v1.GetPageRequest{
+					Datasource: withRequestTimeout(t, adapter.datasource, 1),
+					Entity: &adapter_api_v1.EntityConfig{
+						Id:         "Test",
+						ExternalId: adapter.entityExternalID,
+						Ordered:    adapter.ordered,
+						Attributes: []*adapter_api_v1.AttributeConfig{
+							{
+								Id:         "id",
+								ExternalId: adapter.uniqueIDAttr,
+								Type:       adapter_api_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+								UniqueId:   true,
+							},
+						},
+					},
+					PageSize: pageSize,
+				}, grpc.WaitForReady(true))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				gotErr := gotResp.GetError()
+				if gotErr == nil {
+					t.Fatalf("gotResp: %v, want an error", gotResp)
+				}
+
+				wantCode, ok := tt.wantAdapterCodes[adapterName]
+				if !ok {
+					wantCode = tt.wantCode
+				}
+
+				if gotErr.Code != wantCode {
+					t.Errorf("gotCode: %v, wantCode: %v, gotErr: %v", gotErr.Code, wantCode, gotErr.Message)
+				}
+
+				if wantCode == tt.wantCode && tt.wantRetryAfter != nil && gotErr.RetryAfter.AsDuration() != tt.wantRetryAfter.AsDuration() {
+					t.Errorf("gotRetryAfter: %v, wantRetryAfter: %v", gotErr.RetryAfter, tt.wantRetryAfter)
+				}
+			})
+		}
+	}
+}
+
+// withRequestTimeout returns a copy of the datasource with the request timeout set in its config.
+func withRequestTimeout(t *testing.T, datasource *adapter_api_v1.DatasourceConfig, seconds int) *adapter_api_v1.DatasourceConfig {
+	config := map[string]any{}
+
+	if err := json.Unmarshal(datasource.Config, &config); err != nil {
+		t.Fatal(err)
+	}
+
+	config["requestTimeoutSeconds"] = seconds
+
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &adapter_api_v1.DatasourceConfig{
+		Auth:    datasource.Auth,
+		Address: datasource.Address,
+		Id:      "Test",
+		Type:    datasource.Type,
+		Config:  configBytes,
+	}
+}